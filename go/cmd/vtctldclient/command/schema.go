@@ -40,7 +40,7 @@ import (
 var (
 	// ApplySchema makes an ApplySchema gRPC call to a vtctld.
 	ApplySchema = &cobra.Command{
-		Use:   "ApplySchema [--allow-long-unavailability] [--ddl-strategy <strategy>] [--uuid <uuid> ...] [--migration-context <context>] [--wait-replicas-timeout <duration>] [--skip-preflight] [--caller-id <caller_id>] {--sql-file <file> | --sql <sql>} <keyspace>",
+		Use:   "ApplySchema [--allow-long-unavailability] [--ddl-strategy <strategy>] [--uuid <uuid> ...] [--migration-context <context>] [--wait-replicas-timeout <duration>] [--skip-preflight] [--caller-id <caller_id>] [--declarative [--dry-run]] {--sql-file <file> | --sql <sql>} <keyspace>",
 		Short: "Applies the schema change to the specified keyspace on every primary, running in parallel on all shards. The changes are then propagated to replicas via replication.",
 		Long: `Applies the schema change to the specified keyspace on every primary, running in parallel on all shards. The changes are then propagated to replicas via replication.
 
@@ -103,6 +103,8 @@ var applySchemaOptions = struct {
 	WaitReplicasTimeout     time.Duration
 	SkipPreflight           bool
 	CallerID                string
+	Declarative             bool
+	DryRun                  bool
 }{}
 
 func commandApplySchema(cmd *cobra.Command, args []string) error {
@@ -146,11 +148,20 @@ func commandApplySchema(cmd *cobra.Command, args []string) error {
 		MigrationContext:        applySchemaOptions.MigrationContext,
 		WaitReplicasTimeout:     protoutil.DurationToProto(applySchemaOptions.WaitReplicasTimeout),
 		CallerId:                cid,
+		Declarative:             applySchemaOptions.Declarative,
+		DryRun:                  applySchemaOptions.DryRun,
 	})
 	if err != nil {
 		return err
 	}
 
+	if applySchemaOptions.DryRun {
+		for _, statement := range resp.Statements {
+			fmt.Printf("%s;\n", statement)
+		}
+		return nil
+	}
+
 	fmt.Println(strings.Join(resp.UuidList, "\n"))
 	return nil
 }
@@ -293,6 +304,8 @@ func init() {
 	ApplySchema.Flags().StringVar(&applySchemaOptions.CallerID, "caller-id", "", "Effective caller ID used for the operation and should map to an ACL name which grants this identity the necessary permissions to perform the operation (this is only necessary when strict table ACLs are used).")
 	ApplySchema.Flags().StringSliceVar(&applySchemaOptions.SQL, "sql", nil, "Semicolon-delimited, repeatable SQL commands to apply. Exactly one of --sql|--sql-file is required.")
 	ApplySchema.Flags().StringVar(&applySchemaOptions.SQLFile, "sql-file", "", "Path to a file containing semicolon-delimited SQL commands to apply. Exactly one of --sql|--sql-file is required.")
+	ApplySchema.Flags().BoolVar(&applySchemaOptions.Declarative, "declarative", false, "The SQL commands are the desired CREATE TABLE/VIEW statements of the whole keyspace. The changes to apply are computed by diffing against the current schema.")
+	ApplySchema.Flags().BoolVar(&applySchemaOptions.DryRun, "dry-run", false, "With --declarative, print the computed changes without applying them.")
 
 	Root.AddCommand(ApplySchema)
 
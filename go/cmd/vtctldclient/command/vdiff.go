@@ -0,0 +1,213 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package command
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+var (
+	// VDiff groups the commands that manage the vdiffs of a workflow, which
+	// run on its target primaries.
+	VDiff = &cobra.Command{
+		Use:   "VDiff",
+		Short: "Creates, shows, stops, resumes and deletes the vdiffs of a workflow, which compare its sources and targets.",
+		Args:  cobra.NoArgs,
+	}
+	// VDiffCreate makes a VDiffCreate gRPC call to a vtctld.
+	VDiffCreate = &cobra.Command{
+		Use:                   "create [--uuid UUID] [--source-cell CELL] [--tablet-types TYPES] [--tables t1,t2,...] [--limit N] [--only-pks] [--debug-query] [--max-extra-rows-to-compare N] [--filtered-replication-wait-time DURATION] <keyspace> <workflow>",
+		Short:                 "Starts a vdiff of the workflow on its target primaries, and prints its uuid.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(2),
+		RunE:                  commandVDiffCreate,
+	}
+	// VDiffShow makes a VDiffShow gRPC call to a vtctld.
+	VDiffShow = &cobra.Command{
+		Use:                   "show <keyspace> <workflow> [<uuid>]",
+		Short:                 "Displays the progress of a vdiff on each target shard, or of all the vdiffs of the workflow.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.RangeArgs(2, 3),
+		RunE:                  commandVDiffShow,
+	}
+	// VDiffStop makes a VDiffStop gRPC call to a vtctld.
+	VDiffStop = &cobra.Command{
+		Use:                   "stop <keyspace> <workflow> <uuid>",
+		Short:                 "Stops a running vdiff, which can be resumed later.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(3),
+		RunE:                  commandVDiffStop,
+	}
+	// VDiffResume makes a VDiffResume gRPC call to a vtctld.
+	VDiffResume = &cobra.Command{
+		Use:   "resume <keyspace> <workflow> <uuid>",
+		Short: "Resumes a stopped or failed vdiff where it stopped.",
+		Long: `Resumes a stopped or failed vdiff where it stopped.
+
+Resuming a completed vdiff compares the rows that were added to the tables
+since it completed, starting from the last compared primary keys.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(3),
+		RunE:                  commandVDiffResume,
+	}
+	// VDiffDelete makes a VDiffDelete gRPC call to a vtctld.
+	VDiffDelete = &cobra.Command{
+		Use:                   "delete <keyspace> <workflow> <uuid>",
+		Short:                 "Stops a vdiff, and deletes its progress.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(3),
+		RunE:                  commandVDiffDelete,
+	}
+)
+
+var vdiffCreateOptions = struct {
+	UUID                        string
+	SourceCell                  string
+	TabletTypes                 string
+	Tables                      []string
+	Limit                       int64
+	OnlyPKs                     bool
+	DebugQuery                  bool
+	MaxExtraRowsToCompare       int64
+	FilteredReplicationWaitTime time.Duration
+}{}
+
+func commandVDiffCreate(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.VDiffCreate(commandCtx, &vtctldatapb.VDiffCreateRequest{
+		Keyspace: cmd.Flags().Arg(0),
+		Workflow: cmd.Flags().Arg(1),
+		Uuid:     vdiffCreateOptions.UUID,
+		Options: &tabletmanagerdatapb.VDiffOptions{
+			SourceCell:                  vdiffCreateOptions.SourceCell,
+			TabletTypes:                 vdiffCreateOptions.TabletTypes,
+			Tables:                      vdiffCreateOptions.Tables,
+			MaxRows:                     vdiffCreateOptions.Limit,
+			OnlyPks:                     vdiffCreateOptions.OnlyPKs,
+			DebugQuery:                  vdiffCreateOptions.DebugQuery,
+			MaxExtraRowsToCompare:       vdiffCreateOptions.MaxExtraRowsToCompare,
+			FilteredReplicationWaitTime: int64(vdiffCreateOptions.FilteredReplicationWaitTime),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", resp.Uuid)
+
+	return nil
+}
+
+func commandVDiffShow(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.VDiffShow(commandCtx, &vtctldatapb.VDiffShowRequest{
+		Keyspace: cmd.Flags().Arg(0),
+		Workflow: cmd.Flags().Arg(1),
+		Uuid:     cmd.Flags().Arg(2),
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+func commandVDiffStop(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	_, err := client.VDiffStop(commandCtx, &vtctldatapb.VDiffStopRequest{
+		Keyspace: cmd.Flags().Arg(0),
+		Workflow: cmd.Flags().Arg(1),
+		Uuid:     cmd.Flags().Arg(2),
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Stopped vdiff %s.\n", cmd.Flags().Arg(2))
+
+	return nil
+}
+
+func commandVDiffResume(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	_, err := client.VDiffResume(commandCtx, &vtctldatapb.VDiffResumeRequest{
+		Keyspace: cmd.Flags().Arg(0),
+		Workflow: cmd.Flags().Arg(1),
+		Uuid:     cmd.Flags().Arg(2),
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Resumed vdiff %s.\n", cmd.Flags().Arg(2))
+
+	return nil
+}
+
+func commandVDiffDelete(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	_, err := client.VDiffDelete(commandCtx, &vtctldatapb.VDiffDeleteRequest{
+		Keyspace: cmd.Flags().Arg(0),
+		Workflow: cmd.Flags().Arg(1),
+		Uuid:     cmd.Flags().Arg(2),
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Deleted vdiff %s.\n", cmd.Flags().Arg(2))
+
+	return nil
+}
+
+func init() {
+	VDiffCreate.Flags().StringVar(&vdiffCreateOptions.UUID, "uuid", "", "The uuid of the vdiff. One is generated if it is not specified.")
+	VDiffCreate.Flags().StringVar(&vdiffCreateOptions.SourceCell, "source-cell", "", "The cells the source tablets are picked from, as a comma-separated list. All the cells are used if it is not specified.")
+	VDiffCreate.Flags().StringVar(&vdiffCreateOptions.TabletTypes, "tablet-types", "primary,replica,rdonly", "The types of the source tablets, as a comma-separated list.")
+	VDiffCreate.Flags().StringSliceVar(&vdiffCreateOptions.Tables, "tables", nil, "Only compare these tables of the workflow.")
+	VDiffCreate.Flags().Int64Var(&vdiffCreateOptions.Limit, "limit", 0, "The maximum number of rows to compare per table in each run, with 0 meaning no limit.")
+	VDiffCreate.Flags().BoolVar(&vdiffCreateOptions.OnlyPKs, "only-pks", false, "Only report the primary key columns of the rows that differ.")
+	VDiffCreate.Flags().BoolVar(&vdiffCreateOptions.DebugQuery, "debug-query", false, "Report the queries selecting the rows that differ.")
+	VDiffCreate.Flags().Int64Var(&vdiffCreateOptions.MaxExtraRowsToCompare, "max-extra-rows-to-compare", 1000, "The maximum number of extra rows compared a second time, to find the rows that only differ by the order in which MySQL returned them because of collations.")
+	VDiffCreate.Flags().DurationVar(&vdiffCreateOptions.FilteredReplicationWaitTime, "filtered-replication-wait-time", 30*time.Second, "The maximum time to wait for the sources and the streams to catch up before comparing a table.")
+	VDiff.AddCommand(VDiffCreate)
+	VDiff.AddCommand(VDiffShow)
+	VDiff.AddCommand(VDiffStop)
+	VDiff.AddCommand(VDiffResume)
+	VDiff.AddCommand(VDiffDelete)
+	Root.AddCommand(VDiff)
+}
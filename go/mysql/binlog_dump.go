@@ -48,11 +48,19 @@ func (c *Conn) parseComBinlogDumpGTID(data []byte) (logFile string, logPos uint6
 	if !ok {
 		return logFile, logPos, position, readPacketErr
 	}
-	if gtid := string(data[pos : pos+int(dataSize)]); gtid != "" {
-		position, err = DecodePosition(gtid)
-		if err != nil {
-			return logFile, logPos, position, err
-		}
+	if dataSize == 0 {
+		return logFile, logPos, position, nil
+	}
+	// MySQL replicas send the GTID set as a SID block, while Vitess
+	// clients send an encoded position.
+	gtidData := data[pos : pos+int(dataSize)]
+	if set, err := NewMysql56GTIDSetFromSIDBlock(gtidData); err == nil {
+		position = Position{GTIDSet: set}
+		return logFile, logPos, position, nil
+	}
+	position, err = DecodePosition(string(gtidData))
+	if err != nil {
+		return logFile, logPos, position, err
 	}
 
 	return logFile, logPos, position, nil
@@ -238,6 +238,67 @@ func TestMariadDBGTIDEVent(t *testing.T) {
 	}
 }
 
+func TestMySQL56GTIDEvent(t *testing.T) {
+	f := NewMySQL56BinlogFormat()
+	s := NewFakeBinlogStream()
+
+	sid, err := ParseSID("00010203-0405-0607-0809-0a0b0c0d0e0f")
+	if err != nil {
+		t.Fatalf("ParseSID failed: %v", err)
+	}
+	event := NewMySQL56GTIDEvent(f, s, Mysql56GTID{Server: sid, Sequence: 0x123456789abcdef0})
+	if !event.IsValid() {
+		t.Fatalf("NewMySQL56GTIDEvent().IsValid() is false")
+	}
+	if !event.IsGTID() {
+		t.Fatalf("NewMySQL56GTIDEvent().IsGTID() if false")
+	}
+	event, _, err = event.StripChecksum(f)
+	if err != nil {
+		t.Fatalf("StripChecksum failed: %v", err)
+	}
+
+	gtid, _, err := event.GTID(f)
+	if err != nil {
+		t.Fatalf("NewMySQL56GTIDEvent().GTID() returned error: %v", err)
+	}
+	want := Mysql56GTID{Server: sid, Sequence: 0x123456789abcdef0}
+	if gtid != want {
+		t.Fatalf("NewMySQL56GTIDEvent().GTID() returned invalid GTID: %v, want %v", gtid, want)
+	}
+}
+
+func TestTableMapEventManyColumns(t *testing.T) {
+	f := NewMySQL56BinlogFormat()
+	s := NewFakeBinlogStream()
+
+	// More than 250 columns need a multi-byte length encoding.
+	tm := &TableMap{
+		Database:  "my_database",
+		Name:      "my_table",
+		Types:     make([]byte, 300),
+		CanBeNull: NewServerBitmap(300),
+		Metadata:  make([]uint16, 300),
+	}
+	for i := range tm.Types {
+		tm.Types[i] = TypeVarchar
+		tm.Metadata[i] = 255
+	}
+
+	event := NewTableMapEvent(f, s, 0x102030405060, tm)
+	event, _, err := event.StripChecksum(f)
+	if err != nil {
+		t.Fatalf("StripChecksum failed: %v", err)
+	}
+	gotTm, err := event.TableMap(f)
+	if err != nil {
+		t.Fatalf("NewTableMapEvent().TableMapEvent() returned error: %v", err)
+	}
+	if !reflect.DeepEqual(gotTm, tm) {
+		t.Fatalf("NewTableMapEvent().TableMapEvent() got TableMap:\n%v\nexpected:\n%v", gotTm, tm)
+	}
+}
+
 func TestTableMapEvent(t *testing.T) {
 	f := NewMySQL56BinlogFormat()
 	s := NewFakeBinlogStream()
@@ -70,16 +70,20 @@ func (ev binlogEvent) TableMap(f BinlogFormat) (*TableMap, error) {
 	result.Name = string(data[pos+1 : pos+1+l])
 	pos += 1 + l + 1
 
-	// FIXME(alainjobart) this is varlength encoded.
-	columnCount := int(data[pos])
-	pos++
+	val, pos, ok := readLenEncInt(data, pos)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "cannot read column count (data=%v)", data)
+	}
+	columnCount := int(val)
 
 	result.Types = data[pos : pos+columnCount]
 	pos += columnCount
 
-	// FIXME(alainjobart) this is a var-len-string.
-	l = int(data[pos])
-	pos++
+	val, pos, ok = readLenEncInt(data, pos)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "cannot read metadata length (data=%v)", data)
+	}
+	l = int(val)
 
 	// Allocate and parse / copy Metadata.
 	result.Metadata = make([]uint16, columnCount)
@@ -986,9 +990,11 @@ func (ev binlogEvent) Rows(f BinlogFormat, tm *TableMap) (Rows, error) {
 		pos += int(extraDataLength)
 	}
 
-	// FIXME(alainjobart) this is var len encoded.
-	columnCount := int(data[pos])
-	pos++
+	val, pos, ok := readLenEncInt(data, pos)
+	if !ok {
+		return result, vterrors.Errorf(vtrpc.Code_INTERNAL, "cannot read column count (data=%v)", data)
+	}
+	columnCount := int(val)
 
 	numIdentifyColumns := 0
 	numDataColumns := 0
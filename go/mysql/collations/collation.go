@@ -18,6 +18,7 @@ package collations
 
 import (
 	"math"
+	"unicode"
 
 	"vitess.io/vitess/go/mysql/collations/internal/charset"
 )
@@ -179,6 +180,32 @@ func Slice(collation Collation, input []byte, from, to int) []byte {
 	return charset.Slice(collation.Charset(), input, from, to)
 }
 
+// Length returns the number of characters in `input`, which must be encoded
+// with the character set for the given collation.
+func Length(collation Collation, input []byte) int {
+	return charset.Length(collation.Charset(), input)
+}
+
+// ToUpper appends to `dst` the result of converting all the characters in `src`
+// to uppercase, according to the character set for the given collation. Binary
+// strings have no notion of letter case, so their input is appended verbatim.
+func ToUpper(dst []byte, collation Collation, src []byte) []byte {
+	if _, ok := collation.(*Collation_binary); ok {
+		return append(dst, src...)
+	}
+	return charset.Map(dst, collation.Charset(), src, unicode.ToUpper)
+}
+
+// ToLower appends to `dst` the result of converting all the characters in `src`
+// to lowercase, according to the character set for the given collation. Binary
+// strings have no notion of letter case, so their input is appended verbatim.
+func ToLower(dst []byte, collation Collation, src []byte) []byte {
+	if _, ok := collation.(*Collation_binary); ok {
+		return append(dst, src...)
+	}
+	return charset.Map(dst, collation.Charset(), src, unicode.ToLower)
+}
+
 // Validate returns whether the given `input` is properly encoded with the
 // character set for the given collation.
 func Validate(collation Collation, input []byte) bool {
@@ -21,14 +21,64 @@ func Slice(charset Charset, input []byte, from, to int) []byte {
 		return charset.Slice(input, from, to)
 	}
 	iter := input
+	start := -1
 	for i := 0; i < to; i++ {
+		if i == from {
+			start = len(input) - len(iter)
+		}
 		r, size := charset.DecodeRune(iter)
 		if r == RuneError && size < 2 {
 			break
 		}
 		iter = iter[size:]
 	}
-	return input[:len(input)-len(iter)]
+	end := len(input) - len(iter)
+	if start < 0 {
+		start = end
+	}
+	return input[start:end]
+}
+
+func Length(charset Charset, input []byte) int {
+	if charset, ok := charset.(interface{ Length([]byte) int }); ok {
+		return charset.Length(input)
+	}
+	var count int
+	for len(input) > 0 {
+		r, size := charset.DecodeRune(input)
+		if r == RuneError && size < 2 {
+			// count every invalid byte as a single character
+			size = 1
+		}
+		input = input[size:]
+		count++
+	}
+	return count
+}
+
+func Map(dst []byte, charset Charset, src []byte, mapping func(rune) rune) []byte {
+	var buf [4]byte
+	for len(src) > 0 {
+		r, size := charset.DecodeRune(src)
+		if r == RuneError && size < 2 {
+			if size == 0 {
+				size = 1
+			}
+			dst = append(dst, src[:size]...)
+			src = src[size:]
+			continue
+		}
+		if m := mapping(r); m != r {
+			if w := charset.EncodeRune(buf[:], m); w > 0 {
+				dst = append(dst, buf[:w]...)
+				src = src[size:]
+				continue
+			}
+		}
+		dst = append(dst, src[:size]...)
+		src = src[size:]
+	}
+	return dst
 }
 
 func Validate(charset Charset, input []byte) bool {
@@ -289,6 +289,20 @@ func (c *Conn) endWriterBuffering() error {
 	return c.bufferedWriter.Flush()
 }
 
+// flush sends the buffered data to the socket, if writes are buffered.
+// It is used when a response from the client is expected before
+// the end of the command.
+func (c *Conn) flush() error {
+	c.bufMu.Lock()
+	defer c.bufMu.Unlock()
+
+	if c.bufferedWriter == nil {
+		return nil
+	}
+	c.stopFlushTimer()
+	return c.bufferedWriter.Flush()
+}
+
 // getWriter returns the current writer. It may be either
 // the original connection or a wrapper. The returned unget
 // function must be invoked after the writing is finished.
@@ -929,7 +943,14 @@ func (c *Conn) handleNextCommand(handler Handler) bool {
 }
 
 func (c *Conn) handleComBinlogDumpGTID(handler Handler, data []byte) (kontinue bool) {
-	defer c.recycleReadPacket()
+	_, _, position, err := c.parseComBinlogDumpGTID(data)
+	// The handler streams events for as long as the replica is connected,
+	// so the read packet must be released before it starts writing.
+	c.recycleReadPacket()
+	if err != nil {
+		log.Errorf("conn %v: parseComBinlogDumpGTID failed: %v", c.ID(), err)
+		return false
+	}
 
 	c.startWriterBuffering()
 	defer func() {
@@ -939,14 +960,13 @@ func (c *Conn) handleComBinlogDumpGTID(handler Handler, data []byte) (kontinue b
 		}
 	}()
 
-	_, _, position, err := c.parseComBinlogDumpGTID(data)
-	if err != nil {
-		log.Errorf("conn %v: parseComBinlogDumpGTID failed: %v", c.ID(), err)
-		kontinue = false
+	if err := handler.ComBinlogDumpGTID(c, position.GTIDSet); err != nil {
+		c.writeErrorPacketFromErrorAndLog(err)
 	}
-	handler.ComBinlogDumpGTID(c, position.GTIDSet)
 
-	return true
+	// Like MySQL, close the connection once the binlog dump is over:
+	// BinlogDumpContext reads it until then.
+	return false
 }
 
 func (c *Conn) handleComResetConnection(handler Handler) {
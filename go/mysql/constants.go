@@ -83,9 +83,9 @@ const (
 	// CLIENT_ODBC 1 << 6
 	// No special behavior since 3.22.
 
-	// CLIENT_LOCAL_FILES 1 << 7
+	// CapabilityClientLocalFiles is CLIENT_LOCAL_FILES.
 	// Client can use LOCAL INFILE request of LOAD DATA|XML.
-	// We do not set it.
+	CapabilityClientLocalFiles = 1 << 7
 
 	// CLIENT_IGNORE_SPACE 1 << 8
 	// Parser can ignore spaces before '('.
@@ -254,6 +254,9 @@ const (
 
 	// NullValue is the encoded value of NULL.
 	NullValue = 0xfb
+
+	// LocalInfilePacket is the header of the LOCAL INFILE request packet.
+	LocalInfilePacket = 0xfb
 )
 
 // Auth packet types
@@ -514,6 +517,7 @@ const (
 	ERIncorrectGlobalLocalVar      = 1238
 	ERWrongFKDef                   = 1239
 	ERKeyRefDoNotMatchTableRef     = 1240
+	ERUnknownStmtHandler           = 1243
 	ERCyclicReference              = 1245
 	ERCollationCharsetMismatch     = 1253
 	ERCantAggregate2Collations     = 1267
@@ -0,0 +1,93 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"io"
+)
+
+// localInfileReader reads the content of a file sent by the client
+// in response to a LOCAL INFILE request. The client sends the file as
+// a sequence of packets, terminated by an empty packet.
+type localInfileReader struct {
+	c    *Conn
+	buf  []byte
+	done bool
+}
+
+// RequestLocalInfile asks the client to send the content of the named file,
+// as part of a LOAD DATA LOCAL INFILE statement.
+// Server -> Client.
+// It must be called from the ComQuery handler, before any result is sent
+// back. The returned reader must be closed before the handler sends its
+// result, so that the connection is ready for the next packet.
+// Returns a SQLError if the client does not support local files.
+func (c *Conn) RequestLocalInfile(fileName string) (io.ReadCloser, error) {
+	if c.Capabilities&CapabilityClientLocalFiles == 0 {
+		return nil, NewSQLError(ERNotAllowedCommand, SSClientError, "The used command is not allowed with this MySQL version")
+	}
+
+	data, pos := c.startEphemeralPacketWithHeader(1 + len(fileName))
+	data[pos] = LocalInfilePacket
+	copy(data[pos+1:], fileName)
+	if err := c.writeEphemeralPacket(); err != nil {
+		return nil, NewSQLError(CRServerGone, SSUnknownSQLState, "%v", err)
+	}
+	if err := c.flush(); err != nil {
+		return nil, NewSQLError(CRServerGone, SSUnknownSQLState, "%v", err)
+	}
+	return &localInfileReader{c: c}, nil
+}
+
+// Read is part of the io.Reader interface.
+func (r *localInfileReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		data, err := r.c.readPacket()
+		if err != nil {
+			r.done = true
+			return 0, NewSQLError(CRServerLost, SSUnknownSQLState, "%v", err)
+		}
+		if len(data) == 0 {
+			r.done = true
+			return 0, io.EOF
+		}
+		r.buf = data
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// Close is part of the io.Closer interface. It reads and discards
+// whatever the client has not sent yet.
+func (r *localInfileReader) Close() error {
+	r.buf = nil
+	for !r.done {
+		data, err := r.c.readPacket()
+		if err != nil {
+			r.done = true
+			return NewSQLError(CRServerLost, SSUnknownSQLState, "%v", err)
+		}
+		if len(data) == 0 {
+			r.done = true
+		}
+	}
+	return nil
+}
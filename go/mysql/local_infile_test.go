@@ -0,0 +1,170 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sendLocalInfile plays the client side of a LOCAL INFILE request:
+// it reads the request and sends back the given chunks of the file.
+// It runs in its own goroutine, so it reports failures as an error
+// instead of failing the test.
+func sendLocalInfile(cConn *Conn, fileName string, chunks ...string) error {
+	data, err := cConn.readPacket()
+	if err != nil {
+		return err
+	}
+	if want := append([]byte{LocalInfilePacket}, fileName...); !bytes.Equal(data, want) {
+		return fmt.Errorf("unexpected LOCAL INFILE request: got %q, want %q", data, want)
+	}
+	for _, chunk := range chunks {
+		if err := writeClientPacket(cConn, []byte(chunk)); err != nil {
+			return err
+		}
+	}
+	// the end of the file is signaled with an empty packet
+	return writeClientPacket(cConn, nil)
+}
+
+// writeClientPacket writes data as a single packet from the client side.
+func writeClientPacket(cConn *Conn, data []byte) error {
+	dataWithHeader := make([]byte, packetHeaderSize+len(data))
+	copy(dataWithHeader[packetHeaderSize:], data)
+	if err := cConn.writePacket(dataWithHeader); err != nil {
+		return fmt.Errorf("writePacket failed: %v", err)
+	}
+	return nil
+}
+
+func TestRequestLocalInfile(t *testing.T) {
+	listener, sConn, cConn := createSocketPair(t)
+	defer func() {
+		listener.Close()
+		sConn.Close()
+		cConn.Close()
+	}()
+	sConn.Capabilities |= CapabilityClientLocalFiles
+
+	done := make(chan error, 1)
+	go func() {
+		done <- sendLocalInfile(cConn, "/tmp/data.csv", "1\ta\n2\t", "b\n", "3\tc\n")
+	}()
+
+	r, err := sConn.RequestLocalInfile("/tmp/data.csv")
+	require.NoError(t, err)
+	content, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	assert.Equal(t, "1\ta\n2\tb\n3\tc\n", string(content))
+	require.NoError(t, <-done)
+}
+
+func TestRequestLocalInfileClose(t *testing.T) {
+	listener, sConn, cConn := createSocketPair(t)
+	defer func() {
+		listener.Close()
+		sConn.Close()
+		cConn.Close()
+	}()
+	sConn.Capabilities |= CapabilityClientLocalFiles
+
+	done := make(chan error, 1)
+	go func() {
+		done <- sendLocalInfile(cConn, "data.csv", "1\n", "2\n", "3\n")
+	}()
+
+	r, err := sConn.RequestLocalInfile("data.csv")
+	require.NoError(t, err)
+	buf := make([]byte, 1)
+	n, err := r.Read(buf)
+	require.NoError(t, err)
+	assert.Equal(t, "1", string(buf[:n]))
+	// closing the reader discards the rest of the file
+	require.NoError(t, r.Close())
+	require.NoError(t, <-done)
+
+	// the connection can be used for the next packet
+	written := make(chan error, 1)
+	go func() {
+		written <- writeClientPacket(cConn, []byte("next"))
+	}()
+	data, err := sConn.readPacket()
+	require.NoError(t, err)
+	assert.Equal(t, "next", string(data))
+	require.NoError(t, <-written)
+}
+
+func TestRequestLocalInfileNotAllowed(t *testing.T) {
+	listener, sConn, cConn := createSocketPair(t)
+	defer func() {
+		listener.Close()
+		sConn.Close()
+		cConn.Close()
+	}()
+
+	_, err := sConn.RequestLocalInfile("data.csv")
+	require.EqualError(t, err, "The used command is not allowed with this MySQL version (errno 1148) (sqlstate 42000)")
+}
+
+func TestLocalInfileCapability(t *testing.T) {
+	for _, allow := range []bool{false, true} {
+		t.Run(fmt.Sprintf("allow=%v", allow), func(t *testing.T) {
+			listener, sConn, cConn := createSocketPair(t)
+			defer func() {
+				listener.Close()
+				sConn.Close()
+				cConn.Close()
+			}()
+			l := &Listener{AllowLocalInfile: allow}
+
+			// the capability is only advertised when allowed
+			written := make(chan error, 1)
+			go func() {
+				_, err := sConn.writeHandshakeV10("8.0.0", NewAuthServerNone(), false, allow)
+				written <- err
+			}()
+			data, err := cConn.readPacket()
+			require.NoError(t, err)
+			require.NoError(t, <-written)
+			capabilities, _, err := cConn.parseInitialHandshakePacket(data)
+			require.NoError(t, err)
+			assert.Equal(t, allow, capabilities&CapabilityClientLocalFiles != 0)
+
+			// and it's only kept from the client flags when allowed
+			response := make([]byte, 4+4+1+23)
+			writeUint32(response, 0, CapabilityClientProtocol41|CapabilityClientLocalFiles)
+			response = append(response, "user1\x00"...)
+			response = append(response, 0)
+			_, _, _, err = l.parseClientHandshakePacket(sConn, true, response)
+			require.NoError(t, err)
+			assert.Equal(t, allow, sConn.Capabilities&CapabilityClientLocalFiles != 0)
+
+			// without the capability, LOCAL INFILE is refused
+			if !allow {
+				_, err = sConn.RequestLocalInfile("data.csv")
+				require.EqualError(t, err, "The used command is not allowed with this MySQL version (errno 1148) (sqlstate 42000)")
+			}
+		})
+	}
+}
@@ -17,6 +17,9 @@ limitations under the License.
 package mysql
 
 import (
+	"context"
+	"net"
+
 	"vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
 )
@@ -116,6 +119,47 @@ func (c *Conn) SendSemiSyncAck(binlogFilename string, binlogPos uint64) error {
 
 }
 
+// WriteBinlogEvent writes a binlog event as part of a binlog dump stream,
+// in response to a ComBinlogDumpGTID command.
+// See https://dev.mysql.com/doc/internals/en/binlog-network-stream.html
+func (c *Conn) WriteBinlogEvent(ev BinlogEvent) error {
+	buf := ev.Bytes()
+	length := 1 + // OK packet header
+		len(buf) // event
+	data, pos := c.startEphemeralPacketWithHeader(length)
+	pos = writeByte(data, pos, OKPacket)
+	copy(data[pos:], buf)
+	if err := c.writeEphemeralPacket(); err != nil {
+		return NewSQLError(CRServerGone, SSUnknownSQLState, "%v", err)
+	}
+	return nil
+}
+
+// BinlogDumpContext returns a context derived from ctx, which is cancelled
+// when the replica that asked for a binlog dump on this connection goes away.
+// Replicas send nothing once the binlog dump started, so the connection is
+// read in the background until it fails. The connection is closed once the
+// binlog dump is over.
+func (c *Conn) BinlogDumpContext(ctx context.Context) context.Context {
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		defer cancel()
+		var buf [1]byte
+		for {
+			_, err := c.conn.Read(buf[:])
+			if err == nil {
+				continue
+			}
+			// The listener can set a read timeout on the connection.
+			if netErr, ok := err.(net.Error); ok && netErr.Timeout() && !c.IsClosed() {
+				continue
+			}
+			return
+		}
+	}()
+	return ctx
+}
+
 // SemiSyncExtensionLoaded checks if the semisync extension has been loaded.
 // It should work for both MariaDB and MySQL.
 func (c *Conn) SemiSyncExtensionLoaded() bool {
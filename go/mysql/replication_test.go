@@ -17,8 +17,10 @@ limitations under the License.
 package mysql
 
 import (
+	"context"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestComBinlogDump(t *testing.T) {
@@ -129,6 +131,77 @@ func TestComBinlogDumpGTID(t *testing.T) {
 	}
 }
 
+func TestParseComBinlogDumpGTID(t *testing.T) {
+	listener, sConn, cConn := createSocketPair(t)
+	defer func() {
+		listener.Close()
+		sConn.Close()
+		cConn.Close()
+	}()
+
+	gtidSet, err := parseMysql56GTIDSet("00010203-0405-0607-0809-0a0b0c0d0e0f:1-5")
+	if err != nil {
+		t.Fatalf("parseMysql56GTIDSet failed: %v", err)
+	}
+	set := gtidSet.(Mysql56GTIDSet)
+
+	// MySQL replicas send a SID block.
+	if err := cConn.WriteComBinlogDumpGTID(0x01020304, "", 4, 0, set.SIDBlock()); err != nil {
+		t.Fatalf("WriteComBinlogDumpGTID failed: %v", err)
+	}
+	data, err := sConn.ReadPacket()
+	if err != nil {
+		t.Fatalf("sConn.ReadPacket - ComBinlogDumpGTID failed: %v", err)
+	}
+	_, _, position, err := sConn.parseComBinlogDumpGTID(data)
+	if err != nil {
+		t.Fatalf("parseComBinlogDumpGTID failed: %v", err)
+	}
+	if !position.GTIDSet.Equal(set) {
+		t.Errorf("parseComBinlogDumpGTID returned %v, want %v", position.GTIDSet, set)
+	}
+	sConn.sequence = 0
+
+	// Vitess clients send an encoded position.
+	if err := cConn.WriteComBinlogDumpGTID(0x01020304, "", 4, 0, []byte(EncodePosition(Position{GTIDSet: set}))); err != nil {
+		t.Fatalf("WriteComBinlogDumpGTID failed: %v", err)
+	}
+	data, err = sConn.ReadPacket()
+	if err != nil {
+		t.Fatalf("sConn.ReadPacket - ComBinlogDumpGTID failed: %v", err)
+	}
+	_, _, position, err = sConn.parseComBinlogDumpGTID(data)
+	if err != nil {
+		t.Fatalf("parseComBinlogDumpGTID failed: %v", err)
+	}
+	if !position.GTIDSet.Equal(set) {
+		t.Errorf("parseComBinlogDumpGTID returned %v, want %v", position.GTIDSet, set)
+	}
+}
+
+func TestWriteBinlogEvent(t *testing.T) {
+	listener, sConn, cConn := createSocketPair(t)
+	defer func() {
+		listener.Close()
+		sConn.Close()
+		cConn.Close()
+	}()
+
+	event := NewXIDEvent(NewMySQL56BinlogFormat(), NewFakeBinlogStream())
+	if err := sConn.WriteBinlogEvent(event); err != nil {
+		t.Fatalf("WriteBinlogEvent failed: %v", err)
+	}
+
+	data, err := cConn.ReadPacket()
+	if err != nil {
+		t.Fatalf("cConn.ReadPacket - WriteBinlogEvent failed: %v", err)
+	}
+	expectedData := append([]byte{OKPacket}, event.Bytes()...)
+	if !reflect.DeepEqual(data, expectedData) {
+		t.Errorf("WriteBinlogEvent returned unexpected data:\n%v\nwas expecting:\n%v", data, expectedData)
+	}
+}
+
 func TestSendSemiSyncAck(t *testing.T) {
 	listener, sConn, cConn := createSocketPair(t)
 	defer func() {
@@ -158,3 +231,30 @@ func TestSendSemiSyncAck(t *testing.T) {
 		t.Errorf("SendSemiSyncAck returned unexpected data:\n%v\nwas expecting:\n%v", data, expectedData)
 	}
 }
+
+func TestBinlogDumpContext(t *testing.T) {
+	listener, sConn, cConn := createSocketPair(t)
+	defer func() {
+		listener.Close()
+		sConn.Close()
+		cConn.Close()
+	}()
+
+	ctx := sConn.BinlogDumpContext(context.Background())
+
+	// Writing to the replica does not cancel the binlog dump.
+	if err := sConn.WriteBinlogEvent(NewXIDEvent(NewMySQL56BinlogFormat(), NewFakeBinlogStream())); err != nil {
+		t.Fatalf("WriteBinlogEvent failed: %v", err)
+	}
+	if err := ctx.Err(); err != nil {
+		t.Fatalf("binlog dump cancelled before the replica went away: %v", err)
+	}
+
+	// The binlog dump is cancelled when the replica goes away.
+	cConn.Close()
+	select {
+	case <-ctx.Done():
+	case <-time.After(10 * time.Second):
+		t.Fatalf("binlog dump not cancelled after the replica went away")
+	}
+}
@@ -194,6 +194,11 @@ type Listener struct {
 	// RequireSecureTransport configures the server to reject connections from insecure clients
 	RequireSecureTransport bool
 
+	// AllowLocalInfile configures the server to advertise CLIENT_LOCAL_FILES, so that the
+	// clients which honour it can send files for LOAD DATA LOCAL INFILE. It's off by default,
+	// like local_infile in MySQL, since such clients send the files the server asks for.
+	AllowLocalInfile bool
+
 	// PreHandleFunc is called for each incoming connection, immediately after
 	// accepting a new connection. By default it's no-op. Useful for custom
 	// connection inspection or TLS termination. The returned connection is
@@ -336,7 +341,7 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 	defer connCount.Add(-1)
 
 	// First build and send the server handshake packet.
-	serverAuthPluginData, err := c.writeHandshakeV10(l.ServerVersion, l.authServer, l.TLSConfig.Load() != nil)
+	serverAuthPluginData, err := c.writeHandshakeV10(l.ServerVersion, l.authServer, l.TLSConfig.Load() != nil, l.AllowLocalInfile)
 	if err != nil {
 		if err != io.EOF {
 			log.Errorf("Cannot send HandshakeV10 packet to %s: %v", c, err)
@@ -520,7 +525,7 @@ func (l *Listener) isShutdown() bool {
 
 // writeHandshakeV10 writes the Initial Handshake Packet, server side.
 // It returns the salt data.
-func (c *Conn) writeHandshakeV10(serverVersion string, authServer AuthServer, enableTLS bool) ([]byte, error) {
+func (c *Conn) writeHandshakeV10(serverVersion string, authServer AuthServer, enableTLS, allowLocalInfile bool) ([]byte, error) {
 	capabilities := CapabilityClientLongPassword |
 		CapabilityClientFoundRows |
 		CapabilityClientLongFlag |
@@ -537,6 +542,9 @@ func (c *Conn) writeHandshakeV10(serverVersion string, authServer AuthServer, en
 	if enableTLS {
 		capabilities |= CapabilityClientSSL
 	}
+	if allowLocalInfile {
+		capabilities |= CapabilityClientLocalFiles
+	}
 
 	// Grab the default auth method. This can only be either
 	// mysql_native_password or caching_sha2_password. Both
@@ -655,6 +663,9 @@ func (l *Listener) parseClientHandshakePacket(c *Conn, firstTime bool, data []by
 	// after SSL negotiation, do not overwrite capabilities.
 	if firstTime {
 		c.Capabilities = clientFlags & (CapabilityClientDeprecateEOF | CapabilityClientFoundRows)
+		if l.AllowLocalInfile {
+			c.Capabilities |= clientFlags & CapabilityClientLocalFiles
+		}
 	}
 
 	// set connection capability for executing multi statements
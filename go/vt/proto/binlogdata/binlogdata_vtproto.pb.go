@@ -462,6 +462,23 @@ func (m *Rule) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if m.SourcePriority != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.SourcePriority))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ConflictColumn) > 0 {
+		i -= len(m.ConflictColumn)
+		copy(dAtA[i:], m.ConflictColumn)
+		i = encodeVarint(dAtA, i, uint64(len(m.ConflictColumn)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ConflictPolicy != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ConflictPolicy))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.SourceUniqueKeyTargetColumns) > 0 {
 		i -= len(m.SourceUniqueKeyTargetColumns)
 		copy(dAtA[i:], m.SourceUniqueKeyTargetColumns)
@@ -2190,6 +2207,16 @@ func (m *Rule) SizeVT() (n int) {
 	if l > 0 {
 		n += 1 + l + sov(uint64(l))
 	}
+	if m.ConflictPolicy != 0 {
+		n += 1 + sov(uint64(m.ConflictPolicy))
+	}
+	l = len(m.ConflictColumn)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.SourcePriority != 0 {
+		n += 1 + sov(uint64(m.SourcePriority))
+	}
 	if m.unknownFields != nil {
 		n += len(m.unknownFields)
 	}
@@ -4206,6 +4233,76 @@ func (m *Rule) UnmarshalVT(dAtA []byte) error {
 			}
 			m.SourceUniqueKeyTargetColumns = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictPolicy", wireType)
+			}
+			m.ConflictPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConflictPolicy |= ConflictPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictColumn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConflictColumn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePriority", wireType)
+			}
+			m.SourcePriority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourcePriority |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
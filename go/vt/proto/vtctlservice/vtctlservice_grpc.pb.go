@@ -142,6 +142,8 @@ type VtctldClient interface {
 	// cells within the group (alias). Only primary traffic can be routed across
 	// cells not in the same group (alias).
 	AddCellsAlias(ctx context.Context, in *vtctldata.AddCellsAliasRequest, opts ...grpc.CallOption) (*vtctldata.AddCellsAliasResponse, error)
+	// ApplyQueryRules applies the VSchema query rules.
+	ApplyQueryRules(ctx context.Context, in *vtctldata.ApplyQueryRulesRequest, opts ...grpc.CallOption) (*vtctldata.ApplyQueryRulesResponse, error)
 	// ApplyRoutingRules applies the VSchema routing rules.
 	ApplyRoutingRules(ctx context.Context, in *vtctldata.ApplyRoutingRulesRequest, opts ...grpc.CallOption) (*vtctldata.ApplyRoutingRulesResponse, error)
 	// ApplySchema applies a schema to a keyspace.
@@ -206,6 +208,8 @@ type VtctldClient interface {
 	GetKeyspace(ctx context.Context, in *vtctldata.GetKeyspaceRequest, opts ...grpc.CallOption) (*vtctldata.GetKeyspaceResponse, error)
 	// GetKeyspaces returns the keyspace struct of all keyspaces in the topo.
 	GetKeyspaces(ctx context.Context, in *vtctldata.GetKeyspacesRequest, opts ...grpc.CallOption) (*vtctldata.GetKeyspacesResponse, error)
+	// GetQueryRules returns the VSchema query rules.
+	GetQueryRules(ctx context.Context, in *vtctldata.GetQueryRulesRequest, opts ...grpc.CallOption) (*vtctldata.GetQueryRulesResponse, error)
 	// GetRoutingRules returns the VSchema routing rules.
 	GetRoutingRules(ctx context.Context, in *vtctldata.GetRoutingRulesRequest, opts ...grpc.CallOption) (*vtctldata.GetRoutingRulesResponse, error)
 	// GetSchema returns the schema for a tablet, or just the schema for the
@@ -368,6 +372,19 @@ type VtctldClient interface {
 	ValidateVersionKeyspace(ctx context.Context, in *vtctldata.ValidateVersionKeyspaceRequest, opts ...grpc.CallOption) (*vtctldata.ValidateVersionKeyspaceResponse, error)
 	// ValidateVSchema compares the schema of each primary tablet in "keyspace/shards..." to the vschema and errs if there are differences.
 	ValidateVSchema(ctx context.Context, in *vtctldata.ValidateVSchemaRequest, opts ...grpc.CallOption) (*vtctldata.ValidateVSchemaResponse, error)
+	// VDiffCreate starts a vdiff of a workflow, run by the primaries of its
+	// target shards and resumable.
+	VDiffCreate(ctx context.Context, in *vtctldata.VDiffCreateRequest, opts ...grpc.CallOption) (*vtctldata.VDiffCreateResponse, error)
+	// VDiffDelete deletes a vdiff of a workflow.
+	VDiffDelete(ctx context.Context, in *vtctldata.VDiffDeleteRequest, opts ...grpc.CallOption) (*vtctldata.VDiffDeleteResponse, error)
+	// VDiffResume resumes a stopped or failed vdiff, or re-runs a completed
+	// one on the rows added since.
+	VDiffResume(ctx context.Context, in *vtctldata.VDiffResumeRequest, opts ...grpc.CallOption) (*vtctldata.VDiffResumeResponse, error)
+	// VDiffShow returns the progress of a vdiff on every target shard, or lists
+	// the vdiffs of a workflow.
+	VDiffShow(ctx context.Context, in *vtctldata.VDiffShowRequest, opts ...grpc.CallOption) (*vtctldata.VDiffShowResponse, error)
+	// VDiffStop stops a running vdiff.
+	VDiffStop(ctx context.Context, in *vtctldata.VDiffStopRequest, opts ...grpc.CallOption) (*vtctldata.VDiffStopResponse, error)
 }
 
 type vtctldClient struct {
@@ -396,6 +413,15 @@ func (c *vtctldClient) AddCellsAlias(ctx context.Context, in *vtctldata.AddCells
 	return out, nil
 }
 
+func (c *vtctldClient) ApplyQueryRules(ctx context.Context, in *vtctldata.ApplyQueryRulesRequest, opts ...grpc.CallOption) (*vtctldata.ApplyQueryRulesResponse, error) {
+	out := new(vtctldata.ApplyQueryRulesResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ApplyQueryRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) ApplyRoutingRules(ctx context.Context, in *vtctldata.ApplyRoutingRulesRequest, opts ...grpc.CallOption) (*vtctldata.ApplyRoutingRulesResponse, error) {
 	out := new(vtctldata.ApplyRoutingRulesResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ApplyRoutingRules", in, out, opts...)
@@ -649,6 +675,15 @@ func (c *vtctldClient) GetKeyspaces(ctx context.Context, in *vtctldata.GetKeyspa
 	return out, nil
 }
 
+func (c *vtctldClient) GetQueryRules(ctx context.Context, in *vtctldata.GetQueryRulesRequest, opts ...grpc.CallOption) (*vtctldata.GetQueryRulesResponse, error) {
+	out := new(vtctldata.GetQueryRulesResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetQueryRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) GetRoutingRules(ctx context.Context, in *vtctldata.GetRoutingRulesRequest, opts ...grpc.CallOption) (*vtctldata.GetRoutingRulesResponse, error) {
 	out := new(vtctldata.GetRoutingRulesResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetRoutingRules", in, out, opts...)
@@ -1104,6 +1139,51 @@ func (c *vtctldClient) ValidateVSchema(ctx context.Context, in *vtctldata.Valida
 	return out, nil
 }
 
+func (c *vtctldClient) VDiffCreate(ctx context.Context, in *vtctldata.VDiffCreateRequest, opts ...grpc.CallOption) (*vtctldata.VDiffCreateResponse, error) {
+	out := new(vtctldata.VDiffCreateResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/VDiffCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) VDiffDelete(ctx context.Context, in *vtctldata.VDiffDeleteRequest, opts ...grpc.CallOption) (*vtctldata.VDiffDeleteResponse, error) {
+	out := new(vtctldata.VDiffDeleteResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/VDiffDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) VDiffResume(ctx context.Context, in *vtctldata.VDiffResumeRequest, opts ...grpc.CallOption) (*vtctldata.VDiffResumeResponse, error) {
+	out := new(vtctldata.VDiffResumeResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/VDiffResume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) VDiffShow(ctx context.Context, in *vtctldata.VDiffShowRequest, opts ...grpc.CallOption) (*vtctldata.VDiffShowResponse, error) {
+	out := new(vtctldata.VDiffShowResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/VDiffShow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) VDiffStop(ctx context.Context, in *vtctldata.VDiffStopRequest, opts ...grpc.CallOption) (*vtctldata.VDiffStopResponse, error) {
+	out := new(vtctldata.VDiffStopResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/VDiffStop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VtctldServer is the server API for Vtctld service.
 // All implementations must embed UnimplementedVtctldServer
 // for forward compatibility
@@ -1118,6 +1198,8 @@ type VtctldServer interface {
 	// cells within the group (alias). Only primary traffic can be routed across
 	// cells not in the same group (alias).
 	AddCellsAlias(context.Context, *vtctldata.AddCellsAliasRequest) (*vtctldata.AddCellsAliasResponse, error)
+	// ApplyQueryRules applies the VSchema query rules.
+	ApplyQueryRules(context.Context, *vtctldata.ApplyQueryRulesRequest) (*vtctldata.ApplyQueryRulesResponse, error)
 	// ApplyRoutingRules applies the VSchema routing rules.
 	ApplyRoutingRules(context.Context, *vtctldata.ApplyRoutingRulesRequest) (*vtctldata.ApplyRoutingRulesResponse, error)
 	// ApplySchema applies a schema to a keyspace.
@@ -1182,6 +1264,8 @@ type VtctldServer interface {
 	GetKeyspace(context.Context, *vtctldata.GetKeyspaceRequest) (*vtctldata.GetKeyspaceResponse, error)
 	// GetKeyspaces returns the keyspace struct of all keyspaces in the topo.
 	GetKeyspaces(context.Context, *vtctldata.GetKeyspacesRequest) (*vtctldata.GetKeyspacesResponse, error)
+	// GetQueryRules returns the VSchema query rules.
+	GetQueryRules(context.Context, *vtctldata.GetQueryRulesRequest) (*vtctldata.GetQueryRulesResponse, error)
 	// GetRoutingRules returns the VSchema routing rules.
 	GetRoutingRules(context.Context, *vtctldata.GetRoutingRulesRequest) (*vtctldata.GetRoutingRulesResponse, error)
 	// GetSchema returns the schema for a tablet, or just the schema for the
@@ -1344,6 +1428,19 @@ type VtctldServer interface {
 	ValidateVersionKeyspace(context.Context, *vtctldata.ValidateVersionKeyspaceRequest) (*vtctldata.ValidateVersionKeyspaceResponse, error)
 	// ValidateVSchema compares the schema of each primary tablet in "keyspace/shards..." to the vschema and errs if there are differences.
 	ValidateVSchema(context.Context, *vtctldata.ValidateVSchemaRequest) (*vtctldata.ValidateVSchemaResponse, error)
+	// VDiffCreate starts a vdiff of a workflow, run by the primaries of its
+	// target shards and resumable.
+	VDiffCreate(context.Context, *vtctldata.VDiffCreateRequest) (*vtctldata.VDiffCreateResponse, error)
+	// VDiffDelete deletes a vdiff of a workflow.
+	VDiffDelete(context.Context, *vtctldata.VDiffDeleteRequest) (*vtctldata.VDiffDeleteResponse, error)
+	// VDiffResume resumes a stopped or failed vdiff, or re-runs a completed
+	// one on the rows added since.
+	VDiffResume(context.Context, *vtctldata.VDiffResumeRequest) (*vtctldata.VDiffResumeResponse, error)
+	// VDiffShow returns the progress of a vdiff on every target shard, or lists
+	// the vdiffs of a workflow.
+	VDiffShow(context.Context, *vtctldata.VDiffShowRequest) (*vtctldata.VDiffShowResponse, error)
+	// VDiffStop stops a running vdiff.
+	VDiffStop(context.Context, *vtctldata.VDiffStopRequest) (*vtctldata.VDiffStopResponse, error)
 	mustEmbedUnimplementedVtctldServer()
 }
 
@@ -1357,6 +1454,10 @@ func (UnimplementedVtctldServer) AddCellInfo(context.Context, *vtctldata.AddCell
 func (UnimplementedVtctldServer) AddCellsAlias(context.Context, *vtctldata.AddCellsAliasRequest) (*vtctldata.AddCellsAliasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddCellsAlias not implemented")
 }
+func (UnimplementedVtctldServer) ApplyQueryRules(context.Context, *vtctldata.ApplyQueryRulesRequest) (*vtctldata.ApplyQueryRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyQueryRules not implemented")
+}
+
 func (UnimplementedVtctldServer) ApplyRoutingRules(context.Context, *vtctldata.ApplyRoutingRulesRequest) (*vtctldata.ApplyRoutingRulesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApplyRoutingRules not implemented")
 }
@@ -1426,6 +1527,10 @@ func (UnimplementedVtctldServer) GetKeyspace(context.Context, *vtctldata.GetKeys
 func (UnimplementedVtctldServer) GetKeyspaces(context.Context, *vtctldata.GetKeyspacesRequest) (*vtctldata.GetKeyspacesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetKeyspaces not implemented")
 }
+func (UnimplementedVtctldServer) GetQueryRules(context.Context, *vtctldata.GetQueryRulesRequest) (*vtctldata.GetQueryRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueryRules not implemented")
+}
+
 func (UnimplementedVtctldServer) GetRoutingRules(context.Context, *vtctldata.GetRoutingRulesRequest) (*vtctldata.GetRoutingRulesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRoutingRules not implemented")
 }
@@ -1570,6 +1675,21 @@ func (UnimplementedVtctldServer) ValidateVersionKeyspace(context.Context, *vtctl
 func (UnimplementedVtctldServer) ValidateVSchema(context.Context, *vtctldata.ValidateVSchemaRequest) (*vtctldata.ValidateVSchemaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidateVSchema not implemented")
 }
+func (UnimplementedVtctldServer) VDiffCreate(context.Context, *vtctldata.VDiffCreateRequest) (*vtctldata.VDiffCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VDiffCreate not implemented")
+}
+func (UnimplementedVtctldServer) VDiffDelete(context.Context, *vtctldata.VDiffDeleteRequest) (*vtctldata.VDiffDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VDiffDelete not implemented")
+}
+func (UnimplementedVtctldServer) VDiffResume(context.Context, *vtctldata.VDiffResumeRequest) (*vtctldata.VDiffResumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VDiffResume not implemented")
+}
+func (UnimplementedVtctldServer) VDiffShow(context.Context, *vtctldata.VDiffShowRequest) (*vtctldata.VDiffShowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VDiffShow not implemented")
+}
+func (UnimplementedVtctldServer) VDiffStop(context.Context, *vtctldata.VDiffStopRequest) (*vtctldata.VDiffStopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VDiffStop not implemented")
+}
 func (UnimplementedVtctldServer) mustEmbedUnimplementedVtctldServer() {}
 
 // UnsafeVtctldServer may be embedded to opt out of forward compatibility for this service.
@@ -1619,6 +1739,24 @@ func _Vtctld_AddCellsAlias_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_ApplyQueryRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ApplyQueryRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ApplyQueryRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ApplyQueryRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ApplyQueryRules(ctx, req.(*vtctldata.ApplyQueryRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_ApplyRoutingRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.ApplyRoutingRulesRequest)
 	if err := dec(in); err != nil {
@@ -2039,6 +2177,24 @@ func _Vtctld_GetKeyspaces_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_GetQueryRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetQueryRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetQueryRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetQueryRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetQueryRules(ctx, req.(*vtctldata.GetQueryRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_GetRoutingRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.GetRoutingRulesRequest)
 	if err := dec(in); err != nil {
@@ -2906,6 +3062,96 @@ func _Vtctld_ValidateVSchema_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_VDiffCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.VDiffCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).VDiffCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/VDiffCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).VDiffCreate(ctx, req.(*vtctldata.VDiffCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_VDiffDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.VDiffDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).VDiffDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/VDiffDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).VDiffDelete(ctx, req.(*vtctldata.VDiffDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_VDiffResume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.VDiffResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).VDiffResume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/VDiffResume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).VDiffResume(ctx, req.(*vtctldata.VDiffResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_VDiffShow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.VDiffShowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).VDiffShow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/VDiffShow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).VDiffShow(ctx, req.(*vtctldata.VDiffShowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_VDiffStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.VDiffStopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).VDiffStop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/VDiffStop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).VDiffStop(ctx, req.(*vtctldata.VDiffStopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Vtctld_ServiceDesc is the grpc.ServiceDesc for Vtctld service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2921,6 +3167,10 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddCellsAlias",
 			Handler:    _Vtctld_AddCellsAlias_Handler,
 		},
+		{
+			MethodName: "ApplyQueryRules",
+			Handler:    _Vtctld_ApplyQueryRules_Handler,
+		},
 		{
 			MethodName: "ApplyRoutingRules",
 			Handler:    _Vtctld_ApplyRoutingRules_Handler,
@@ -3005,6 +3255,10 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetKeyspaces",
 			Handler:    _Vtctld_GetKeyspaces_Handler,
 		},
+		{
+			MethodName: "GetQueryRules",
+			Handler:    _Vtctld_GetQueryRules_Handler,
+		},
 		{
 			MethodName: "GetRoutingRules",
 			Handler:    _Vtctld_GetRoutingRules_Handler,
@@ -3193,6 +3447,26 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ValidateVSchema",
 			Handler:    _Vtctld_ValidateVSchema_Handler,
 		},
+		{
+			MethodName: "VDiffCreate",
+			Handler:    _Vtctld_VDiffCreate_Handler,
+		},
+		{
+			MethodName: "VDiffDelete",
+			Handler:    _Vtctld_VDiffDelete_Handler,
+		},
+		{
+			MethodName: "VDiffResume",
+			Handler:    _Vtctld_VDiffResume_Handler,
+		},
+		{
+			MethodName: "VDiffShow",
+			Handler:    _Vtctld_VDiffShow_Handler,
+		},
+		{
+			MethodName: "VDiffStop",
+			Handler:    _Vtctld_VDiffStop_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
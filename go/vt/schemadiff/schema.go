@@ -0,0 +1,452 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// Schema represents a database schema, which may contain entities such as tables and views.
+// Schema is not in itself an Entity, since it is more of a collection of entities.
+type Schema struct {
+	tables []*CreateTableEntity
+	views  []*CreateViewEntity
+
+	named  map[string]Entity
+	sorted []Entity
+}
+
+// newEmptySchema is used internally to initialize a Schema object
+func newEmptySchema() *Schema {
+	schema := &Schema{
+		tables: []*CreateTableEntity{},
+		views:  []*CreateViewEntity{},
+		named:  map[string]Entity{},
+		sorted: []Entity{},
+	}
+	return schema
+}
+
+// NewSchemaFromEntities creates a valid and normalized schema based on list of entities
+func NewSchemaFromEntities(entities []Entity) (*Schema, error) {
+	schema := newEmptySchema()
+	for _, e := range entities {
+		switch c := e.(type) {
+		case *CreateTableEntity:
+			schema.tables = append(schema.tables, c)
+		case *CreateViewEntity:
+			schema.views = append(schema.views, c)
+		default:
+			return nil, ErrUnsupportedEntity
+		}
+	}
+	if err := schema.normalize(); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// NewSchemaFromStatements creates a valid and normalized schema based on list of valid statements
+func NewSchemaFromStatements(statements []sqlparser.Statement) (*Schema, error) {
+	entities := make([]Entity, 0, len(statements))
+	for _, s := range statements {
+		switch stmt := s.(type) {
+		case *sqlparser.CreateTable:
+			if !stmt.IsFullyParsed() {
+				return nil, ErrNotFullyParsed
+			}
+			entities = append(entities, NewCreateTableEntity(stmt))
+		case *sqlparser.CreateView:
+			if !stmt.IsFullyParsed() {
+				return nil, ErrNotFullyParsed
+			}
+			entities = append(entities, NewCreateViewEntity(stmt))
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrUnsupportedStatement, sqlparser.String(s))
+		}
+	}
+	return NewSchemaFromEntities(entities)
+}
+
+// NewSchemaFromQueries creates a valid and normalized schema based on list of queries
+func NewSchemaFromQueries(queries []string) (*Schema, error) {
+	statements := make([]sqlparser.Statement, 0, len(queries))
+	for _, q := range queries {
+		stmt, err := sqlparser.ParseStrictDDL(q)
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements, stmt)
+	}
+	return NewSchemaFromStatements(statements)
+}
+
+// NewSchemaFromSQL creates a valid and normalized schema based on a SQL blob that contains
+// CREATE statements for various objects (tables, views)
+func NewSchemaFromSQL(sql string) (*Schema, error) {
+	queries, err := sqlparser.SplitStatementToPieces(sql)
+	if err != nil {
+		return nil, err
+	}
+	return NewSchemaFromQueries(queries)
+}
+
+// NewSchemaFromSchemaDefinition creates a valid and normalized schema based on a tablet's
+// schema definition, as returned by GetSchema
+func NewSchemaFromSchemaDefinition(sd *tabletmanagerdatapb.SchemaDefinition) (*Schema, error) {
+	queries := make([]string, 0, len(sd.TableDefinitions))
+	for _, td := range sd.TableDefinitions {
+		// View definitions have their database qualifier replaced with a placeholder,
+		// which is not valid SQL. The entities of a schema are unqualified anyway.
+		queries = append(queries, strings.ReplaceAll(td.Schema, "{{.DatabaseName}}.", ""))
+	}
+	return NewSchemaFromQueries(queries)
+}
+
+// getTableName returns the name of a table, which may be qualified
+func getTableName(tableName sqlparser.TableName) string {
+	return tableName.Name.String()
+}
+
+// getViewDependentTableNames returns the names of the tables and views a view reads from
+func getViewDependentTableNames(createView *sqlparser.CreateView) (names []string) {
+	cteNames := map[string]bool{}
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		switch node := node.(type) {
+		case *sqlparser.CommonTableExpr:
+			cteNames[node.TableID.String()] = true
+		case *sqlparser.AliasedTableExpr:
+			tableName, ok := node.Expr.(sqlparser.TableName)
+			if !ok {
+				return true, nil
+			}
+			// Tables in other databases, as well as DUAL, are not part of the schema
+			if !tableName.Qualifier.IsEmpty() || tableName.Name.String() == "dual" {
+				return true, nil
+			}
+			names = append(names, getTableName(tableName))
+		}
+		return true, nil
+	}, createView.Select)
+
+	// Common table expressions are not schema entities
+	dependencies := names[:0]
+	for _, name := range names {
+		if !cteNames[name] {
+			dependencies = append(dependencies, name)
+		}
+	}
+	return dependencies
+}
+
+// getForeignKeyParentTableNames returns the names of the tables referenced by the
+// foreign keys of the given table
+func getForeignKeyParentTableNames(createTable *sqlparser.CreateTable) (names []string) {
+	for _, cs := range createTable.TableSpec.Constraints {
+		if fk, ok := cs.Details.(*sqlparser.ForeignKeyDefinition); ok {
+			names = append(names, getTableName(fk.ReferenceDefinition.ReferencedTable))
+		}
+	}
+	return names
+}
+
+// sortByDependencies returns the given names such that each name comes after its
+// dependencies. Names are otherwise kept in their given order. The second return value
+// lists the names that could not be sorted, since they depend on each other.
+func sortByDependencies(names []string, dependencies func(name string) []string) (sorted []string, cyclic []string) {
+	pending := map[string]bool{}
+	for _, name := range names {
+		pending[name] = true
+	}
+	for len(pending) > 0 {
+		progress := false
+		for _, name := range names {
+			if !pending[name] {
+				continue
+			}
+			ready := true
+			for _, dep := range dependencies(name) {
+				if dep != name && pending[dep] {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				sorted = append(sorted, name)
+				delete(pending, name)
+				progress = true
+			}
+		}
+		if !progress {
+			for _, name := range names {
+				if pending[name] {
+					cyclic = append(cyclic, name)
+				}
+			}
+			return sorted, cyclic
+		}
+	}
+	return sorted, nil
+}
+
+// normalize is called as part of Schema creation process. The user may only get a hold of normalized schema.
+// It validates some cross-entity constraints, and orders entity based on dependencies (e.g. tables, views that read from tables, 2nd level views, etc.)
+func (s *Schema) normalize() error {
+	s.named = make(map[string]Entity, len(s.tables)+len(s.views))
+	s.sorted = make([]Entity, 0, len(s.tables)+len(s.views))
+	// Verify no two entities share the same name
+	for _, t := range s.tables {
+		name := t.Name()
+		if _, ok := s.named[name]; ok {
+			return fmt.Errorf("%w: %s", ErrDuplicateName, name)
+		}
+		s.named[name] = t
+	}
+	for _, v := range s.views {
+		name := v.Name()
+		if _, ok := s.named[name]; ok {
+			return fmt.Errorf("%w: %s", ErrDuplicateName, name)
+		}
+		s.named[name] = v
+	}
+
+	// Tables come first, sorted by name
+	sort.SliceStable(s.tables, func(i, j int) bool {
+		return s.tables[i].Name() < s.tables[j].Name()
+	})
+	for _, t := range s.tables {
+		s.sorted = append(s.sorted, t)
+	}
+	// Foreign keys must reference existing tables
+	for _, t := range s.tables {
+		for _, parent := range getForeignKeyParentTableNames(&t.CreateTable) {
+			if _, ok := s.named[parent].(*CreateTableEntity); !ok {
+				return fmt.Errorf("%w: table %s references %s", ErrForeignKeyDependencyUnresolved, t.Name(), parent)
+			}
+		}
+	}
+
+	// Views come next, such that a view comes after the tables and views it reads from
+	sort.SliceStable(s.views, func(i, j int) bool {
+		return s.views[i].Name() < s.views[j].Name()
+	})
+	viewNames := make([]string, 0, len(s.views))
+	for _, v := range s.views {
+		name := v.Name()
+		for _, dep := range getViewDependentTableNames(&v.CreateView) {
+			if _, ok := s.named[dep]; !ok {
+				return fmt.Errorf("%w: view %s references %s", ErrViewDependencyUnresolved, name, dep)
+			}
+		}
+		viewNames = append(viewNames, name)
+	}
+	sortedViewNames, cyclic := sortByDependencies(viewNames, func(name string) []string {
+		return getViewDependentTableNames(&s.named[name].(*CreateViewEntity).CreateView)
+	})
+	if len(cyclic) > 0 {
+		return fmt.Errorf("%w: %s", ErrCyclicViewDependency, strings.Join(cyclic, ", "))
+	}
+	s.views = s.views[:0]
+	for _, name := range sortedViewNames {
+		v := s.named[name].(*CreateViewEntity)
+		s.views = append(s.views, v)
+		s.sorted = append(s.sorted, v)
+	}
+	return nil
+}
+
+// Entities returns this schema's entities in good order (may be applied without error)
+func (s *Schema) Entities() []Entity {
+	return s.sorted
+}
+
+// EntityNames is a convenience function that returns just the names of entities, in good order
+func (s *Schema) EntityNames() []string {
+	var names []string
+	for _, e := range s.Entities() {
+		names = append(names, e.Name())
+	}
+	return names
+}
+
+// Tables returns this schema's tables in good order (may be applied without error)
+func (s *Schema) Tables() []*CreateTableEntity {
+	return s.tables
+}
+
+// TableNames is a convenience function that returns just the names of tables, in good order
+func (s *Schema) TableNames() []string {
+	var names []string
+	for _, e := range s.Tables() {
+		names = append(names, e.Name())
+	}
+	return names
+}
+
+// Views returns this schema's views in good order (may be applied without error)
+func (s *Schema) Views() []*CreateViewEntity {
+	return s.views
+}
+
+// ViewNames is a convenience function that returns just the names of views, in good order
+func (s *Schema) ViewNames() []string {
+	var names []string
+	for _, e := range s.Views() {
+		names = append(names, e.Name())
+	}
+	return names
+}
+
+// Entity returns an entity by name, or nil if nonexistent
+func (s *Schema) Entity(name string) Entity {
+	return s.named[name]
+}
+
+// Table returns a table by name, or nil if nonexistent
+func (s *Schema) Table(name string) *CreateTableEntity {
+	if t, ok := s.named[name].(*CreateTableEntity); ok {
+		return t
+	}
+	return nil
+}
+
+// View returns a view by name, or nil if nonexistent
+func (s *Schema) View(name string) *CreateViewEntity {
+	if v, ok := s.named[name].(*CreateViewEntity); ok {
+		return v
+	}
+	return nil
+}
+
+// ToStatements returns an ordered list of statements which can be applied to create the schema
+func (s *Schema) ToStatements() []sqlparser.Statement {
+	stmts := make([]sqlparser.Statement, 0, len(s.Entities()))
+	for _, e := range s.Entities() {
+		stmts = append(stmts, e.Create().Statement())
+	}
+	return stmts
+}
+
+// ToQueries returns an ordered list of queries which can be applied to create the schema
+func (s *Schema) ToQueries() []string {
+	queries := make([]string, 0, len(s.Entities()))
+	for _, e := range s.Entities() {
+		queries = append(queries, e.Create().StatementString())
+	}
+	return queries
+}
+
+// ToSQL returns a SQL blob with ordered sequence of queries which can be applied to create the schema
+func (s *Schema) ToSQL() string {
+	var buf strings.Builder
+	for _, query := range s.ToQueries() {
+		buf.WriteString(query)
+		buf.WriteString(";\n")
+	}
+	return buf.String()
+}
+
+// Diff compares this schema with another schema, and sees what it takes to make this schema look
+// like the other. It returns a list of diffs, in an order which can be applied without error:
+// - views are dropped first, dependent views before the views they read from
+// - tables are created, referenced tables before the tables that reference them
+// - tables are altered
+// - tables are dropped, referencing tables before the tables they reference
+// - views are created or altered, views before the views that read from them
+func (s *Schema) Diff(other *Schema, hints *DiffHints) (diffs []EntityDiff, err error) {
+	// dropped views, in reverse order
+	for i := len(s.views) - 1; i >= 0; i-- {
+		v := s.views[i]
+		if other.View(v.Name()) == nil {
+			diffs = append(diffs, v.Drop())
+		}
+	}
+	// created tables
+	var createdTables []string
+	for _, t := range other.tables {
+		if s.Table(t.Name()) == nil {
+			createdTables = append(createdTables, t.Name())
+		}
+	}
+	createdTables = sortByForeignKeys(createdTables, other, false)
+	for _, name := range createdTables {
+		diffs = append(diffs, other.Table(name).Create())
+	}
+	// altered tables
+	for _, t := range s.tables {
+		otherTable := other.Table(t.Name())
+		if otherTable == nil {
+			continue
+		}
+		diff, err := t.Diff(otherTable, hints)
+		if err != nil {
+			return nil, err
+		}
+		if !diff.IsEmpty() {
+			diffs = append(diffs, diff)
+		}
+	}
+	// dropped tables
+	var droppedTables []string
+	for _, t := range s.tables {
+		if other.Table(t.Name()) == nil {
+			droppedTables = append(droppedTables, t.Name())
+		}
+	}
+	droppedTables = sortByForeignKeys(droppedTables, s, true)
+	for _, name := range droppedTables {
+		diffs = append(diffs, s.Table(name).Drop())
+	}
+	// created and altered views
+	for _, v := range other.views {
+		thisView := s.View(v.Name())
+		if thisView == nil {
+			diffs = append(diffs, v.Create())
+			continue
+		}
+		diff, err := thisView.Diff(v, hints)
+		if err != nil {
+			return nil, err
+		}
+		if !diff.IsEmpty() {
+			diffs = append(diffs, diff)
+		}
+	}
+	return diffs, nil
+}
+
+// sortByForeignKeys orders the given tables of a schema such that referenced tables come before
+// the tables that reference them, or the other way around if reverse is set. Tables that
+// reference each other are kept in their given order.
+func sortByForeignKeys(names []string, schema *Schema, reverse bool) []string {
+	sorted, cyclic := sortByDependencies(names, func(name string) []string {
+		return getForeignKeyParentTableNames(&schema.Table(name).CreateTable)
+	})
+	sorted = append(sorted, cyclic...)
+	if reverse {
+		for i, j := 0, len(sorted)-1; i < j; i, j = i+1, j-1 {
+			sorted[i], sorted[j] = sorted[j], sorted[i]
+		}
+	}
+	return sorted
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+func TestNewSchemaFromQueries(t *testing.T) {
+	queries := []string{
+		"create view v3 as select * from v2, v1",
+		"create table t1 (id int primary key)",
+		"create view v2 as select * from v1 join t2 using (id)",
+		"create table t2 (id int primary key, t1_id int, foreign key (t1_id) references t1 (id))",
+		"create view v1 as select * from t1",
+		"create view v4 as with w as (select id from t1) select * from w, dual",
+	}
+	schema, err := NewSchemaFromQueries(queries)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"t1", "t2", "v1", "v2", "v3", "v4"}, schema.EntityNames())
+	assert.Equal(t, []string{"t1", "t2"}, schema.TableNames())
+	assert.Equal(t, []string{"v1", "v2", "v3", "v4"}, schema.ViewNames())
+	assert.NotNil(t, schema.Table("t1"))
+	assert.Nil(t, schema.Table("v1"))
+	assert.NotNil(t, schema.View("v1"))
+	assert.Nil(t, schema.Entity("t3"))
+
+	// The generated SQL recreates the same schema
+	other, err := NewSchemaFromSQL(schema.ToSQL())
+	require.NoError(t, err)
+	assert.Equal(t, schema.ToQueries(), other.ToQueries())
+}
+
+func TestNewSchemaFromQueriesErrors(t *testing.T) {
+	tt := []struct {
+		name    string
+		queries []string
+		err     error
+	}{
+		{
+			name:    "duplicate table",
+			queries: []string{"create table t1 (id int)", "create table t1 (id bigint)"},
+			err:     ErrDuplicateName,
+		},
+		{
+			name:    "view and table share a name",
+			queries: []string{"create table t1 (id int)", "create view t1 as select 1 from dual"},
+			err:     ErrDuplicateName,
+		},
+		{
+			name:    "view references missing table",
+			queries: []string{"create table t1 (id int)", "create view v1 as select * from t1 join t2"},
+			err:     ErrViewDependencyUnresolved,
+		},
+		{
+			name:    "views reference each other",
+			queries: []string{"create table t1 (id int)", "create view v1 as select * from v2", "create view v2 as select * from v1, t1"},
+			err:     ErrCyclicViewDependency,
+		},
+		{
+			name:    "foreign key references missing table",
+			queries: []string{"create table t1 (id int primary key, t2_id int, foreign key (t2_id) references t2 (id))"},
+			err:     ErrForeignKeyDependencyUnresolved,
+		},
+		{
+			name:    "unsupported statement",
+			queries: []string{"create table t1 (id int)", "alter table t1 add column i int"},
+			err:     ErrUnsupportedStatement,
+		},
+	}
+	for _, ts := range tt {
+		t.Run(ts.name, func(t *testing.T) {
+			_, err := NewSchemaFromQueries(ts.queries)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ts.err), "unexpected error: %v", err)
+		})
+	}
+}
+
+func TestNewSchemaSelfReferencingForeignKey(t *testing.T) {
+	_, err := NewSchemaFromQueries([]string{
+		"create table t1 (id int primary key, parent_id int, foreign key (parent_id) references t1 (id))",
+	})
+	assert.NoError(t, err)
+}
+
+func TestNewSchemaFromSchemaDefinition(t *testing.T) {
+	sd := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			{Name: "v1", Schema: "create view v1 as select * from t1", Type: "VIEW"},
+			{Name: "t1", Schema: "create table t1 (id int primary key)", Type: "BASE TABLE"},
+		},
+	}
+	schema, err := NewSchemaFromSchemaDefinition(sd)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"t1", "v1"}, schema.EntityNames())
+}
+
+func TestSchemaDiff(t *testing.T) {
+	tt := []struct {
+		name  string
+		from  []string
+		to    []string
+		diffs []string
+	}{
+		{
+			name: "identical",
+			from: []string{"create table t1 (id int primary key)", "create view v1 as select * from t1"},
+			to:   []string{"create view v1 as select * from t1", "create table t1 (id int primary key)"},
+		},
+		{
+			name: "create and drop",
+			from: []string{"create table t1 (id int primary key)", "create view v1 as select * from t1"},
+			to:   []string{"create table t2 (id int primary key)", "create view v2 as select * from t2"},
+			diffs: []string{
+				"drop view v1",
+				"create table t2 (\n\tid int primary key\n)",
+				"drop table t1",
+				"create view v2 as select * from t2",
+			},
+		},
+		{
+			name: "alter table and view",
+			from: []string{"create table t1 (id int primary key)", "create view v1 as select * from t1"},
+			to:   []string{"create table t1 (id int primary key, i int)", "create view v1 as select id from t1"},
+			diffs: []string{
+				"alter table t1 add column i int",
+				"alter view v1 as select id from t1",
+			},
+		},
+		{
+			name: "views ordered by dependencies",
+			from: []string{
+				"create table t1 (id int primary key)",
+				"create view v1 as select * from t1",
+				"create view v2 as select * from v1",
+			},
+			to: []string{
+				"create table t1 (id int primary key)",
+				"create view v3 as select * from t1",
+				"create view v0 as select * from v3",
+			},
+			diffs: []string{
+				"drop view v2",
+				"drop view v1",
+				"create view v3 as select * from t1",
+				"create view v0 as select * from v3",
+			},
+		},
+		{
+			name: "tables ordered by foreign keys",
+			from: []string{
+				"create table t1 (id int primary key)",
+				"create table t0 (id int primary key, t1_id int, foreign key (t1_id) references t1 (id))",
+			},
+			to: []string{
+				"create table t3 (id int primary key)",
+				"create table t2 (id int primary key, t3_id int, foreign key (t3_id) references t3 (id))",
+			},
+			diffs: []string{
+				"create table t3 (\n\tid int primary key\n)",
+				"create table t2 (\n\tid int primary key,\n\tt3_id int,\n\tforeign key (t3_id) references t3 (id)\n)",
+				"drop table t0",
+				"drop table t1",
+			},
+		},
+	}
+	hints := &DiffHints{}
+	for _, ts := range tt {
+		t.Run(ts.name, func(t *testing.T) {
+			from, err := NewSchemaFromQueries(ts.from)
+			require.NoError(t, err)
+			to, err := NewSchemaFromQueries(ts.to)
+			require.NoError(t, err)
+
+			diffs, err := from.Diff(to, hints)
+			require.NoError(t, err)
+			var statements []string
+			for _, diff := range diffs {
+				statements = append(statements, diff.StatementString())
+			}
+			assert.Equal(t, ts.diffs, statements)
+		})
+	}
+}
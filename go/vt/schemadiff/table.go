@@ -110,6 +110,11 @@ func NewCreateTableEntity(c *sqlparser.CreateTable) *CreateTableEntity {
 	return &CreateTableEntity{CreateTable: *c}
 }
 
+// Name implements Entity interface
+func (c *CreateTableEntity) Name() string {
+	return c.CreateTable.Table.Name.String()
+}
+
 // Diff implements Entity interface function
 func (c *CreateTableEntity) Diff(other Entity, hints *DiffHints) (EntityDiff, error) {
 	otherCreateTable, ok := other.(*CreateTableEntity)
@@ -130,6 +135,19 @@ func (c *CreateTableEntity) Diff(other Entity, hints *DiffHints) (EntityDiff, er
 	return d, nil
 }
 
+// Create implements Entity interface
+func (c *CreateTableEntity) Create() EntityDiff {
+	return &CreateTableEntityDiff{createTable: &c.CreateTable}
+}
+
+// Drop implements Entity interface
+func (c *CreateTableEntity) Drop() EntityDiff {
+	dropTable := &sqlparser.DropTable{
+		FromTables: []sqlparser.TableName{c.Table},
+	}
+	return &DropTableEntityDiff{dropTable: dropTable}
+}
+
 // Diff compares this table statement with another table statement, and sees what it takes to
 // change this table to look like the other table.
 // It returns an AlterTable statement if changes are found, or nil if not.
@@ -496,7 +514,7 @@ func (c *CreateTableEntity) diffKeys(alterTable *sqlparser.AlterTable,
 		if t1Key, ok := t1KeysMap[t2KeyName]; ok {
 			// key exists in both tables
 			// check diff between before/after columns:
-			if sqlparser.String(t2Key) != sqlparser.String(t1Key) {
+			if indexDefinitionString(t2Key) != indexDefinitionString(t1Key) {
 				// keys with same name have different definition. There is no ALTER INDEX statement,
 				// we're gonna drop and create.
 				dropKey := dropKeyStatement(t1Key.Info.Name)
@@ -516,6 +534,16 @@ func (c *CreateTableEntity) diffKeys(alterTable *sqlparser.AlterTable,
 	}
 }
 
+// indexDefinitionString formats an index definition for comparison. The index type is kept
+// as written in the original statement (e.g. "PRIMARY KEY" or "primary key"), hence normalized.
+func indexDefinitionString(key *sqlparser.IndexDefinition) string {
+	info := *key.Info
+	info.Type = strings.ToLower(info.Type)
+	normalized := *key
+	normalized.Info = &info
+	return sqlparser.String(&normalized)
+}
+
 // evaluateColumnReordering produces a minimal uni-directional reordering set of columns. To elaborate:
 // The function receives two sets of columns. the two must be permutations of one another. Specifically,
 // these are the columns shared between the from&to tables.
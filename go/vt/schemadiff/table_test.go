@@ -250,6 +250,11 @@ func TestCreateTableDiff(t *testing.T) {
 			from: "create table t1 (`id` int, i int, primary key(id), key i_idx(i), key i2_idx(i, `id`))",
 			to:   "create table t2 (`id` int, i int, key i2_idx (`i`, id), key i_idx ( i ), primary key(id) )",
 		},
+		{
+			name: "key type case change, no diff",
+			from: "CREATE TABLE `t1` (`id` int NOT NULL, `i` int, PRIMARY KEY (`id`), UNIQUE KEY `i_idx` (`i`))",
+			to:   "create table t1 (id int not null, i int, primary key (id), unique key i_idx (i))",
+		},
 		{
 			name: "reordered key, add key",
 			from: "create table t1 (`id` int primary key, i int, key i_idx(i), key i2_idx(i, `id`))",
@@ -32,10 +32,19 @@ var (
 	ErrNotFullyParsed                 = errors.New("unable to fully parse statement")
 	ErrExpectedCreateTable            = errors.New("expected a CREATE TABLE statement")
 	ErrExpectedCreateView             = errors.New("expected a CREATE VIEW statement")
+	ErrUnsupportedEntity              = errors.New("unsupported entity type")
+	ErrUnsupportedStatement           = errors.New("unsupported statement")
+	ErrDuplicateName                  = errors.New("duplicate name")
+	ErrViewDependencyUnresolved       = errors.New("view references nonexistent table or view")
+	ErrCyclicViewDependency           = errors.New("cyclic view dependency")
+	ErrForeignKeyDependencyUnresolved = errors.New("foreign key references nonexistent table")
 )
 
 type Entity interface {
+	Name() string
 	Diff(other Entity, hints *DiffHints) (diff EntityDiff, err error)
+	Create() EntityDiff
+	Drop() EntityDiff
 }
 
 type EntityDiff interface {
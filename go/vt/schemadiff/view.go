@@ -105,6 +105,11 @@ func NewCreateViewEntity(c *sqlparser.CreateView) *CreateViewEntity {
 	return &CreateViewEntity{CreateView: *c}
 }
 
+// Name implements Entity interface
+func (c *CreateViewEntity) Name() string {
+	return c.CreateView.ViewName.Name.String()
+}
+
 // Diff implements Entity interface function
 func (c *CreateViewEntity) Diff(other Entity, hints *DiffHints) (EntityDiff, error) {
 	otherCreateView, ok := other.(*CreateViewEntity)
@@ -114,6 +119,19 @@ func (c *CreateViewEntity) Diff(other Entity, hints *DiffHints) (EntityDiff, err
 	return c.ViewDiff(otherCreateView, hints)
 }
 
+// Create implements Entity interface
+func (c *CreateViewEntity) Create() EntityDiff {
+	return &CreateViewEntityDiff{createView: &c.CreateView}
+}
+
+// Drop implements Entity interface
+func (c *CreateViewEntity) Drop() EntityDiff {
+	dropView := &sqlparser.DropView{
+		FromTables: []sqlparser.TableName{c.ViewName},
+	}
+	return &DropViewEntityDiff{dropView: dropView}
+}
+
 // Diff compares this view statement with another view statement, and sees what it takes to
 // change this view to look like the other view.
 // It returns an AlterView statement if changes are found, or nil if not.
@@ -58,6 +58,9 @@ const (
 	StmtCallProc
 	StmtRevert
 	StmtShowMigrationLogs
+	StmtPrepare
+	StmtExecute
+	StmtDeallocate
 )
 
 //ASTToStatementType returns a StatementType from an AST stmt
@@ -111,6 +114,12 @@ func ASTToStatementType(stmt Statement) StatementType {
 		return StmtStream
 	case *VStream:
 		return StmtVStream
+	case *PrepareStmt:
+		return StmtPrepare
+	case *ExecuteStmt:
+		return StmtExecute
+	case *DeallocateStmt:
+		return StmtDeallocate
 	default:
 		return StmtUnknown
 	}
@@ -238,6 +247,12 @@ func Preview(sql string) StatementType {
 		return StmtRelease
 	case "rollback":
 		return StmtSRollback
+	case "prepare":
+		return StmtPrepare
+	case "execute":
+		return StmtExecute
+	case "deallocate":
+		return StmtDeallocate
 	}
 	return StmtUnknown
 }
@@ -294,6 +309,12 @@ func (s StatementType) String() string {
 		return "FLUSH"
 	case StmtCallProc:
 		return "CALL_PROC"
+	case StmtPrepare:
+		return "PREPARE"
+	case StmtExecute:
+		return "EXECUTE"
+	case StmtDeallocate:
+		return "DEALLOCATE_PREPARE"
 	default:
 		return "UNKNOWN"
 	}
@@ -429,6 +450,21 @@ func IsNull(node Expr) bool {
 	return false
 }
 
+// IsConstant returns true if the Expr does not reference any column,
+// like literals or functions of literals.
+func IsConstant(node Expr) bool {
+	constant := true
+	_ = Walk(func(node SQLNode) (bool, error) {
+		switch node.(type) {
+		case *ColName, *Subquery:
+			constant = false
+			return false, nil
+		}
+		return true, nil
+	}, node)
+	return constant
+}
+
 // IsSimpleTuple returns true if the Expr is a ValTuple that
 // contains simple values or if it's a list arg.
 func IsSimpleTuple(node Expr) bool {
@@ -72,6 +72,9 @@ func TestPreview(t *testing.T) {
 		{"revoke", StmtPriv},
 		{"truncate", StmtDDL},
 		{"flush", StmtFlush},
+		{"prepare", StmtPrepare},
+		{"execute", StmtExecute},
+		{"deallocate", StmtDeallocate},
 		{"unknown", StmtUnknown},
 
 		{"/* leading comment */ select ...", StmtSelect},
@@ -134,7 +134,7 @@ type (
 
 	// With contains the lists of common table expression and specifies if it is recursive or not
 	With struct {
-		ctes      []*CommonTableExpr
+		CTEs      []*CommonTableExpr
 		Recursive bool
 	}
 
@@ -235,6 +235,7 @@ type (
 		With        *With
 		GroupBy     GroupBy
 		Having      *Where
+		Windows     WindowDefinitions
 		OrderBy     OrderBy
 		Limit       *Limit
 		Lock        Lock
@@ -533,8 +534,29 @@ type (
 	// DDLAction is an enum for DDL.Action
 	DDLAction int8
 
-	// Load represents a LOAD statement
+	// Load represents a LOAD DATA statement.
+	// The options describing the format of the file are nil when the statement does not set them.
 	Load struct {
+		LowPriority        bool
+		Concurrent         bool
+		Local              bool
+		FileName           string
+		Action             InsertAction
+		Ignore             Ignore
+		Table              TableName
+		Partitions         Partitions
+		Charset            string
+		FieldsTerminatedBy *Literal
+		FieldsEnclosedBy   *Literal
+		OptionallyEnclosed bool
+		FieldsEscapedBy    *Literal
+		LinesStartingBy    *Literal
+		LinesTerminatedBy  *Literal
+		IgnoreLines        *Literal
+		// Columns are the columns the fields of the file are assigned to.
+		// They can also be user variables, that are used by SetExprs.
+		Columns  Columns
+		SetExprs UpdateExprs
 	}
 
 	// Show represents a show statement.
@@ -2128,13 +2150,27 @@ type (
 	}
 
 	// FuncExpr represents a function call.
+	// Over is only set when an aggregate function is used as a window function.
 	FuncExpr struct {
 		Qualifier TableIdent
 		Name      ColIdent
 		Distinct  bool
 		Exprs     SelectExprs
+		Over      *OverClause
+	}
+
+	// WindowFuncExpr represents a call to one of the dedicated window functions:
+	// ROW_NUMBER, RANK, DENSE_RANK, PERCENT_RANK, CUME_DIST, NTILE, LAG, LEAD,
+	// FIRST_VALUE, LAST_VALUE and NTH_VALUE.
+	WindowFuncExpr struct {
+		Type  WindowFuncType
+		Exprs Exprs
+		Over  *OverClause
 	}
 
+	// WindowFuncType is an enum for WindowFuncExpr.Type
+	WindowFuncType int8
+
 	// GroupConcatExpr represents a call to GROUP_CONCAT
 	GroupConcatExpr struct {
 		Distinct  bool
@@ -2264,6 +2300,7 @@ func (*GroupConcatExpr) iExpr()      {}
 func (*Default) iExpr()              {}
 func (*ExtractedSubquery) iExpr()    {}
 func (*TrimFuncExpr) iExpr()         {}
+func (*WindowFuncExpr) iExpr()       {}
 func (Offset) iExpr()                {}
 
 // iCallable marks all expressions that represent function calls
@@ -2279,6 +2316,7 @@ func (*SubstrExpr) iCallable()           {}
 func (*ConvertUsingExpr) iCallable()     {}
 func (*MatchExpr) iCallable()            {}
 func (*GroupConcatExpr) iCallable()      {}
+func (*WindowFuncExpr) iCallable()       {}
 
 // Exprs represents a list of value expressions.
 // It's not a valid expression because it's not parenthesized.
@@ -2311,6 +2349,52 @@ type Order struct {
 // OrderDirection is an enum for the direction in which to order - asc or desc.
 type OrderDirection int8
 
+// OverClause represents the OVER clause of a window function call.
+// Either WindowName refers to a named window, or WindowSpec holds an inline definition.
+type OverClause struct {
+	WindowName ColIdent
+	WindowSpec *WindowSpecification
+}
+
+// WindowSpecification represents the definition of a window:
+// [window_name] [PARTITION BY ...] [ORDER BY ...] [frame_clause]
+type WindowSpecification struct {
+	Name            ColIdent
+	PartitionClause Exprs
+	OrderClause     OrderBy
+	FrameClause     *FrameClause
+}
+
+// FrameClause represents the frame of a window specification.
+// End is nil when the frame is declared without BETWEEN.
+type FrameClause struct {
+	Unit  FrameUnitType
+	Start *FramePoint
+	End   *FramePoint
+}
+
+// FrameUnitType is an enum for FrameClause.Unit
+type FrameUnitType int8
+
+// FramePoint represents one of the bounds of a window frame.
+// Expr is only set for ExprPrecedingType and ExprFollowingType.
+type FramePoint struct {
+	Type FramePointType
+	Expr Expr
+}
+
+// FramePointType is an enum for FramePoint.Type
+type FramePointType int8
+
+// WindowDefinition represents a named window in the WINDOW clause of a SELECT.
+type WindowDefinition struct {
+	Name       ColIdent
+	WindowSpec *WindowSpecification
+}
+
+// WindowDefinitions represents the WINDOW clause of a SELECT.
+type WindowDefinitions []*WindowDefinition
+
 // Limit represents a LIMIT clause.
 type Limit struct {
 	Offset, Rowcount Expr
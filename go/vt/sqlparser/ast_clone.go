@@ -149,6 +149,10 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfForce(in)
 	case *ForeignKeyDefinition:
 		return CloneRefOfForeignKeyDefinition(in)
+	case *FrameClause:
+		return CloneRefOfFrameClause(in)
+	case *FramePoint:
+		return CloneRefOfFramePoint(in)
 	case *FuncExpr:
 		return CloneRefOfFuncExpr(in)
 	case GroupBy:
@@ -219,6 +223,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfOtherAdmin(in)
 	case *OtherRead:
 		return CloneRefOfOtherRead(in)
+	case *OverClause:
+		return CloneRefOfOverClause(in)
 	case *ParenTableExpr:
 		return CloneRefOfParenTableExpr(in)
 	case *PartitionDefinition:
@@ -345,6 +351,14 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfWhen(in)
 	case *Where:
 		return CloneRefOfWhere(in)
+	case *WindowDefinition:
+		return CloneRefOfWindowDefinition(in)
+	case WindowDefinitions:
+		return CloneWindowDefinitions(in)
+	case *WindowFuncExpr:
+		return CloneRefOfWindowFuncExpr(in)
+	case *WindowSpecification:
+		return CloneRefOfWindowSpecification(in)
 	case *With:
 		return CloneRefOfWith(in)
 	case *XorExpr:
@@ -1003,6 +1017,27 @@ func CloneRefOfForeignKeyDefinition(n *ForeignKeyDefinition) *ForeignKeyDefiniti
 	return &out
 }
 
+// CloneRefOfFrameClause creates a deep clone of the input.
+func CloneRefOfFrameClause(n *FrameClause) *FrameClause {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Start = CloneRefOfFramePoint(n.Start)
+	out.End = CloneRefOfFramePoint(n.End)
+	return &out
+}
+
+// CloneRefOfFramePoint creates a deep clone of the input.
+func CloneRefOfFramePoint(n *FramePoint) *FramePoint {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	return &out
+}
+
 // CloneRefOfFuncExpr creates a deep clone of the input.
 func CloneRefOfFuncExpr(n *FuncExpr) *FuncExpr {
 	if n == nil {
@@ -1012,6 +1047,7 @@ func CloneRefOfFuncExpr(n *FuncExpr) *FuncExpr {
 	out.Qualifier = CloneTableIdent(n.Qualifier)
 	out.Name = CloneColIdent(n.Name)
 	out.Exprs = CloneSelectExprs(n.Exprs)
+	out.Over = CloneRefOfOverClause(n.Over)
 	return &out
 }
 
@@ -1187,6 +1223,16 @@ func CloneRefOfLoad(n *Load) *Load {
 		return nil
 	}
 	out := *n
+	out.Table = CloneTableName(n.Table)
+	out.Partitions = ClonePartitions(n.Partitions)
+	out.FieldsTerminatedBy = CloneRefOfLiteral(n.FieldsTerminatedBy)
+	out.FieldsEnclosedBy = CloneRefOfLiteral(n.FieldsEnclosedBy)
+	out.FieldsEscapedBy = CloneRefOfLiteral(n.FieldsEscapedBy)
+	out.LinesStartingBy = CloneRefOfLiteral(n.LinesStartingBy)
+	out.LinesTerminatedBy = CloneRefOfLiteral(n.LinesTerminatedBy)
+	out.IgnoreLines = CloneRefOfLiteral(n.IgnoreLines)
+	out.Columns = CloneColumns(n.Columns)
+	out.SetExprs = CloneUpdateExprs(n.SetExprs)
 	return &out
 }
 
@@ -1343,6 +1389,17 @@ func CloneRefOfOtherRead(n *OtherRead) *OtherRead {
 	return &out
 }
 
+// CloneRefOfOverClause creates a deep clone of the input.
+func CloneRefOfOverClause(n *OverClause) *OverClause {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.WindowName = CloneColIdent(n.WindowName)
+	out.WindowSpec = CloneRefOfWindowSpecification(n.WindowSpec)
+	return &out
+}
+
 // CloneRefOfParenTableExpr creates a deep clone of the input.
 func CloneRefOfParenTableExpr(n *ParenTableExpr) *ParenTableExpr {
 	if n == nil {
@@ -1534,6 +1591,7 @@ func CloneRefOfSelect(n *Select) *Select {
 	out.With = CloneRefOfWith(n.With)
 	out.GroupBy = CloneGroupBy(n.GroupBy)
 	out.Having = CloneRefOfWhere(n.Having)
+	out.Windows = CloneWindowDefinitions(n.Windows)
 	out.OrderBy = CloneOrderBy(n.OrderBy)
 	out.Limit = CloneRefOfLimit(n.Limit)
 	out.Into = CloneRefOfSelectInto(n.Into)
@@ -2017,13 +2075,60 @@ func CloneRefOfWhere(n *Where) *Where {
 	return &out
 }
 
+// CloneRefOfWindowDefinition creates a deep clone of the input.
+func CloneRefOfWindowDefinition(n *WindowDefinition) *WindowDefinition {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	out.WindowSpec = CloneRefOfWindowSpecification(n.WindowSpec)
+	return &out
+}
+
+// CloneWindowDefinitions creates a deep clone of the input.
+func CloneWindowDefinitions(n WindowDefinitions) WindowDefinitions {
+	if n == nil {
+		return nil
+	}
+	res := make(WindowDefinitions, 0, len(n))
+	for _, x := range n {
+		res = append(res, CloneRefOfWindowDefinition(x))
+	}
+	return res
+}
+
+// CloneRefOfWindowFuncExpr creates a deep clone of the input.
+func CloneRefOfWindowFuncExpr(n *WindowFuncExpr) *WindowFuncExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Exprs = CloneExprs(n.Exprs)
+	out.Over = CloneRefOfOverClause(n.Over)
+	return &out
+}
+
+// CloneRefOfWindowSpecification creates a deep clone of the input.
+func CloneRefOfWindowSpecification(n *WindowSpecification) *WindowSpecification {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	out.PartitionClause = CloneExprs(n.PartitionClause)
+	out.OrderClause = CloneOrderBy(n.OrderClause)
+	out.FrameClause = CloneRefOfFrameClause(n.FrameClause)
+	return &out
+}
+
 // CloneRefOfWith creates a deep clone of the input.
 func CloneRefOfWith(n *With) *With {
 	if n == nil {
 		return nil
 	}
 	out := *n
-	out.ctes = CloneSliceOfRefOfCommonTableExpr(n.ctes)
+	out.CTEs = CloneSliceOfRefOfCommonTableExpr(n.CTEs)
 	return &out
 }
 
@@ -2118,6 +2223,8 @@ func CloneCallable(in Callable) Callable {
 		return CloneRefOfValuesFuncExpr(in)
 	case *WeightStringFuncExpr:
 		return CloneRefOfWeightStringFuncExpr(in)
+	case *WindowFuncExpr:
+		return CloneRefOfWindowFuncExpr(in)
 	default:
 		// this should never happen
 		return nil
@@ -2314,6 +2421,8 @@ func CloneExpr(in Expr) Expr {
 		return CloneRefOfValuesFuncExpr(in)
 	case *WeightStringFuncExpr:
 		return CloneRefOfWeightStringFuncExpr(in)
+	case *WindowFuncExpr:
+		return CloneRefOfWindowFuncExpr(in)
 	case *XorExpr:
 		return CloneRefOfXorExpr(in)
 	default:
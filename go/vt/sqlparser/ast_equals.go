@@ -404,6 +404,18 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfForeignKeyDefinition(a, b)
+	case *FrameClause:
+		b, ok := inB.(*FrameClause)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfFrameClause(a, b)
+	case *FramePoint:
+		b, ok := inB.(*FramePoint)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfFramePoint(a, b)
 	case *FuncExpr:
 		b, ok := inB.(*FuncExpr)
 		if !ok {
@@ -614,6 +626,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfOtherRead(a, b)
+	case *OverClause:
+		b, ok := inB.(*OverClause)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfOverClause(a, b)
 	case *ParenTableExpr:
 		b, ok := inB.(*ParenTableExpr)
 		if !ok {
@@ -992,6 +1010,30 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfWhere(a, b)
+	case *WindowDefinition:
+		b, ok := inB.(*WindowDefinition)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfWindowDefinition(a, b)
+	case WindowDefinitions:
+		b, ok := inB.(WindowDefinitions)
+		if !ok {
+			return false
+		}
+		return EqualsWindowDefinitions(a, b)
+	case *WindowFuncExpr:
+		b, ok := inB.(*WindowFuncExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfWindowFuncExpr(a, b)
+	case *WindowSpecification:
+		b, ok := inB.(*WindowSpecification)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfWindowSpecification(a, b)
 	case *With:
 		b, ok := inB.(*With)
 		if !ok {
@@ -1782,6 +1824,31 @@ func EqualsRefOfForeignKeyDefinition(a, b *ForeignKeyDefinition) bool {
 		EqualsRefOfReferenceDefinition(a.ReferenceDefinition, b.ReferenceDefinition)
 }
 
+// EqualsRefOfFrameClause does deep equals between the two objects.
+func EqualsRefOfFrameClause(a, b *FrameClause) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Unit == b.Unit &&
+		EqualsRefOfFramePoint(a.Start, b.Start) &&
+		EqualsRefOfFramePoint(a.End, b.End)
+}
+
+// EqualsRefOfFramePoint does deep equals between the two objects.
+func EqualsRefOfFramePoint(a, b *FramePoint) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Type == b.Type &&
+		EqualsExpr(a.Expr, b.Expr)
+}
+
 // EqualsRefOfFuncExpr does deep equals between the two objects.
 func EqualsRefOfFuncExpr(a, b *FuncExpr) bool {
 	if a == b {
@@ -1793,7 +1860,8 @@ func EqualsRefOfFuncExpr(a, b *FuncExpr) bool {
 	return a.Distinct == b.Distinct &&
 		EqualsTableIdent(a.Qualifier, b.Qualifier) &&
 		EqualsColIdent(a.Name, b.Name) &&
-		EqualsSelectExprs(a.Exprs, b.Exprs)
+		EqualsSelectExprs(a.Exprs, b.Exprs) &&
+		EqualsRefOfOverClause(a.Over, b.Over)
 }
 
 // EqualsGroupBy does deep equals between the two objects.
@@ -2003,7 +2071,24 @@ func EqualsRefOfLoad(a, b *Load) bool {
 	if a == nil || b == nil {
 		return false
 	}
-	return true
+	return a.LowPriority == b.LowPriority &&
+		a.Concurrent == b.Concurrent &&
+		a.Local == b.Local &&
+		a.FileName == b.FileName &&
+		a.Charset == b.Charset &&
+		a.OptionallyEnclosed == b.OptionallyEnclosed &&
+		a.Action == b.Action &&
+		a.Ignore == b.Ignore &&
+		EqualsTableName(a.Table, b.Table) &&
+		EqualsPartitions(a.Partitions, b.Partitions) &&
+		EqualsRefOfLiteral(a.FieldsTerminatedBy, b.FieldsTerminatedBy) &&
+		EqualsRefOfLiteral(a.FieldsEnclosedBy, b.FieldsEnclosedBy) &&
+		EqualsRefOfLiteral(a.FieldsEscapedBy, b.FieldsEscapedBy) &&
+		EqualsRefOfLiteral(a.LinesStartingBy, b.LinesStartingBy) &&
+		EqualsRefOfLiteral(a.LinesTerminatedBy, b.LinesTerminatedBy) &&
+		EqualsRefOfLiteral(a.IgnoreLines, b.IgnoreLines) &&
+		EqualsColumns(a.Columns, b.Columns) &&
+		EqualsUpdateExprs(a.SetExprs, b.SetExprs)
 }
 
 // EqualsRefOfLockOption does deep equals between the two objects.
@@ -2181,6 +2266,18 @@ func EqualsRefOfOtherRead(a, b *OtherRead) bool {
 	return true
 }
 
+// EqualsRefOfOverClause does deep equals between the two objects.
+func EqualsRefOfOverClause(a, b *OverClause) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsColIdent(a.WindowName, b.WindowName) &&
+		EqualsRefOfWindowSpecification(a.WindowSpec, b.WindowSpec)
+}
+
 // EqualsRefOfParenTableExpr does deep equals between the two objects.
 func EqualsRefOfParenTableExpr(a, b *ParenTableExpr) bool {
 	if a == b {
@@ -2407,6 +2504,7 @@ func EqualsRefOfSelect(a, b *Select) bool {
 		EqualsRefOfWith(a.With, b.With) &&
 		EqualsGroupBy(a.GroupBy, b.GroupBy) &&
 		EqualsRefOfWhere(a.Having, b.Having) &&
+		EqualsWindowDefinitions(a.Windows, b.Windows) &&
 		EqualsOrderBy(a.OrderBy, b.OrderBy) &&
 		EqualsRefOfLimit(a.Limit, b.Limit) &&
 		a.Lock == b.Lock &&
@@ -2966,6 +3064,58 @@ func EqualsRefOfWhere(a, b *Where) bool {
 		EqualsExpr(a.Expr, b.Expr)
 }
 
+// EqualsRefOfWindowDefinition does deep equals between the two objects.
+func EqualsRefOfWindowDefinition(a, b *WindowDefinition) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsColIdent(a.Name, b.Name) &&
+		EqualsRefOfWindowSpecification(a.WindowSpec, b.WindowSpec)
+}
+
+// EqualsWindowDefinitions does deep equals between the two objects.
+func EqualsWindowDefinitions(a, b WindowDefinitions) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsRefOfWindowDefinition(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsRefOfWindowFuncExpr does deep equals between the two objects.
+func EqualsRefOfWindowFuncExpr(a, b *WindowFuncExpr) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Type == b.Type &&
+		EqualsExprs(a.Exprs, b.Exprs) &&
+		EqualsRefOfOverClause(a.Over, b.Over)
+}
+
+// EqualsRefOfWindowSpecification does deep equals between the two objects.
+func EqualsRefOfWindowSpecification(a, b *WindowSpecification) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsColIdent(a.Name, b.Name) &&
+		EqualsExprs(a.PartitionClause, b.PartitionClause) &&
+		EqualsOrderBy(a.OrderClause, b.OrderClause) &&
+		EqualsRefOfFrameClause(a.FrameClause, b.FrameClause)
+}
+
 // EqualsRefOfWith does deep equals between the two objects.
 func EqualsRefOfWith(a, b *With) bool {
 	if a == b {
@@ -2975,7 +3125,7 @@ func EqualsRefOfWith(a, b *With) bool {
 		return false
 	}
 	return a.Recursive == b.Recursive &&
-		EqualsSliceOfRefOfCommonTableExpr(a.ctes, b.ctes)
+		EqualsSliceOfRefOfCommonTableExpr(a.CTEs, b.CTEs)
 }
 
 // EqualsRefOfXorExpr does deep equals between the two objects.
@@ -3200,6 +3350,12 @@ func EqualsCallable(inA, inB Callable) bool {
 			return false
 		}
 		return EqualsRefOfWeightStringFuncExpr(a, b)
+	case *WindowFuncExpr:
+		b, ok := inB.(*WindowFuncExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfWindowFuncExpr(a, b)
 	default:
 		// this should never happen
 		return false
@@ -3641,6 +3797,12 @@ func EqualsExpr(inA, inB Expr) bool {
 			return false
 		}
 		return EqualsRefOfWeightStringFuncExpr(a, b)
+	case *WindowFuncExpr:
+		b, ok := inB.(*WindowFuncExpr)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfWindowFuncExpr(a, b)
 	case *XorExpr:
 		b, ok := inB.(*XorExpr)
 		if !ok {
@@ -55,14 +55,17 @@ func (node *Select) Format(buf *TrackedBuffer) {
 		prefix = ", "
 	}
 
-	buf.astPrintf(node, "%v%v%v%v%v%s%v",
+	buf.astPrintf(node, "%v%v%v%v%v%v%s%v",
 		node.Where,
-		node.GroupBy, node.Having, node.OrderBy,
+		node.GroupBy, node.Having, node.Windows, node.OrderBy,
 		node.Limit, node.Lock.ToString(), node.Into)
 }
 
 // Format formats the node.
 func (node *Union) Format(buf *TrackedBuffer) {
+	if node.With != nil {
+		buf.astPrintf(node, "%v", node.With)
+	}
 	if requiresParen(node.Left) {
 		buf.astPrintf(node, "(%v)", node.Left)
 	} else {
@@ -127,11 +130,11 @@ func (node *With) Format(buf *TrackedBuffer) {
 	if node.Recursive {
 		buf.astPrintf(node, "recursive ")
 	}
-	ctesLength := len(node.ctes)
+	ctesLength := len(node.CTEs)
 	for i := 0; i < ctesLength-1; i++ {
-		buf.astPrintf(node, "%v, ", node.ctes[i])
+		buf.astPrintf(node, "%v, ", node.CTEs[i])
 	}
-	buf.astPrintf(node, "%v", node.ctes[ctesLength-1])
+	buf.astPrintf(node, "%v", node.CTEs[ctesLength-1])
 }
 
 // Format formats the node.
@@ -1302,6 +1305,79 @@ func (node *FuncExpr) Format(buf *TrackedBuffer) {
 		buf.WriteString(funcName)
 	}
 	buf.astPrintf(node, "(%s%v)", distinct, node.Exprs)
+	if node.Over != nil {
+		buf.astPrintf(node, " %v", node.Over)
+	}
+}
+
+// Format formats the node.
+func (node *WindowFuncExpr) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%s(%v) %v", node.Type.ToString(), node.Exprs, node.Over)
+}
+
+// Format formats the node.
+func (node *OverClause) Format(buf *TrackedBuffer) {
+	if node.WindowSpec == nil {
+		buf.astPrintf(node, "over %v", node.WindowName)
+		return
+	}
+	buf.astPrintf(node, "over (%v)", node.WindowSpec)
+}
+
+// Format formats the node.
+func (node *WindowSpecification) Format(buf *TrackedBuffer) {
+	prefix := ""
+	if !node.Name.IsEmpty() {
+		buf.astPrintf(node, "%v", node.Name)
+		prefix = " "
+	}
+	if len(node.PartitionClause) > 0 {
+		buf.astPrintf(node, "%spartition by %v", prefix, node.PartitionClause)
+		prefix = " "
+	}
+	if len(node.OrderClause) > 0 {
+		buf.astPrintf(node, "%sorder by ", prefix)
+		orderPrefix := ""
+		for _, order := range node.OrderClause {
+			buf.astPrintf(node, "%s%v", orderPrefix, order)
+			orderPrefix = ", "
+		}
+		prefix = " "
+	}
+	if node.FrameClause != nil {
+		buf.astPrintf(node, "%s%v", prefix, node.FrameClause)
+	}
+}
+
+// Format formats the node.
+func (node *FrameClause) Format(buf *TrackedBuffer) {
+	if node.End == nil {
+		buf.astPrintf(node, "%s %v", node.Unit.ToString(), node.Start)
+		return
+	}
+	buf.astPrintf(node, "%s between %v and %v", node.Unit.ToString(), node.Start, node.End)
+}
+
+// Format formats the node.
+func (node *FramePoint) Format(buf *TrackedBuffer) {
+	if node.Expr != nil {
+		buf.astPrintf(node, "%v ", node.Expr)
+	}
+	buf.WriteString(node.Type.ToString())
+}
+
+// Format formats the node.
+func (node *WindowDefinition) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%v as (%v)", node.Name, node.WindowSpec)
+}
+
+// Format formats the node.
+func (node WindowDefinitions) Format(buf *TrackedBuffer) {
+	prefix := " window "
+	for _, n := range node {
+		buf.astPrintf(node, "%s%v", prefix, n)
+		prefix = ", "
+	}
 }
 
 // Format formats the node
@@ -1532,7 +1608,60 @@ func (node AccessMode) Format(buf *TrackedBuffer) {
 
 // Format formats the node.
 func (node *Load) Format(buf *TrackedBuffer) {
-	buf.WriteString("AST node missing for Load type")
+	buf.WriteString("load data ")
+	if node.LowPriority {
+		buf.WriteString("low_priority ")
+	}
+	if node.Concurrent {
+		buf.WriteString("concurrent ")
+	}
+	if node.Local {
+		buf.WriteString("local ")
+	}
+	buf.astPrintf(node, "infile %s", encodeSQLString(node.FileName))
+	if node.Action == ReplaceAct {
+		buf.WriteString(" replace")
+	}
+	if node.Ignore {
+		buf.WriteString(" ignore")
+	}
+	buf.astPrintf(node, " into table %v%v", node.Table, node.Partitions)
+	if node.Charset != "" {
+		buf.astPrintf(node, " character set %s", node.Charset)
+	}
+	if node.FieldsTerminatedBy != nil || node.FieldsEnclosedBy != nil || node.FieldsEscapedBy != nil {
+		buf.WriteString(" fields")
+		if node.FieldsTerminatedBy != nil {
+			buf.astPrintf(node, " terminated by %v", node.FieldsTerminatedBy)
+		}
+		if node.FieldsEnclosedBy != nil {
+			if node.OptionallyEnclosed {
+				buf.WriteString(" optionally")
+			}
+			buf.astPrintf(node, " enclosed by %v", node.FieldsEnclosedBy)
+		}
+		if node.FieldsEscapedBy != nil {
+			buf.astPrintf(node, " escaped by %v", node.FieldsEscapedBy)
+		}
+	}
+	if node.LinesStartingBy != nil || node.LinesTerminatedBy != nil {
+		buf.WriteString(" lines")
+		if node.LinesStartingBy != nil {
+			buf.astPrintf(node, " starting by %v", node.LinesStartingBy)
+		}
+		if node.LinesTerminatedBy != nil {
+			buf.astPrintf(node, " terminated by %v", node.LinesTerminatedBy)
+		}
+	}
+	if node.IgnoreLines != nil {
+		buf.astPrintf(node, " ignore %v lines", node.IgnoreLines)
+	}
+	if node.Columns != nil {
+		buf.astPrintf(node, " %v", node.Columns)
+	}
+	if len(node.SetExprs) > 0 {
+		buf.astPrintf(node, " set %v", node.SetExprs)
+	}
 }
 
 // Format formats the node.
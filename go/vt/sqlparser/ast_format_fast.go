@@ -66,6 +66,8 @@ func (node *Select) formatFast(buf *TrackedBuffer) {
 
 	node.Having.formatFast(buf)
 
+	node.Windows.formatFast(buf)
+
 	node.OrderBy.formatFast(buf)
 
 	node.Limit.formatFast(buf)
@@ -76,6 +78,9 @@ func (node *Select) formatFast(buf *TrackedBuffer) {
 
 // formatFast formats the node.
 func (node *Union) formatFast(buf *TrackedBuffer) {
+	if node.With != nil {
+		node.With.formatFast(buf)
+	}
 	if requiresParen(node.Left) {
 		buf.WriteByte('(')
 		node.Left.formatFast(buf)
@@ -196,12 +201,12 @@ func (node *With) formatFast(buf *TrackedBuffer) {
 	if node.Recursive {
 		buf.WriteString("recursive ")
 	}
-	ctesLength := len(node.ctes)
+	ctesLength := len(node.CTEs)
 	for i := 0; i < ctesLength-1; i++ {
-		node.ctes[i].formatFast(buf)
+		node.CTEs[i].formatFast(buf)
 		buf.WriteString(", ")
 	}
-	node.ctes[ctesLength-1].formatFast(buf)
+	node.CTEs[ctesLength-1].formatFast(buf)
 }
 
 // formatFast formats the node.
@@ -1729,6 +1734,103 @@ func (node *FuncExpr) formatFast(buf *TrackedBuffer) {
 	buf.WriteString(distinct)
 	node.Exprs.formatFast(buf)
 	buf.WriteByte(')')
+	if node.Over != nil {
+		buf.WriteByte(' ')
+		node.Over.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *WindowFuncExpr) formatFast(buf *TrackedBuffer) {
+	buf.WriteString(node.Type.ToString())
+	buf.WriteByte('(')
+	node.Exprs.formatFast(buf)
+	buf.WriteString(") ")
+	node.Over.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *OverClause) formatFast(buf *TrackedBuffer) {
+	if node.WindowSpec == nil {
+		buf.WriteString("over ")
+		node.WindowName.formatFast(buf)
+		return
+	}
+	buf.WriteString("over (")
+	node.WindowSpec.formatFast(buf)
+	buf.WriteByte(')')
+}
+
+// formatFast formats the node.
+func (node *WindowSpecification) formatFast(buf *TrackedBuffer) {
+	prefix := ""
+	if !node.Name.IsEmpty() {
+		node.Name.formatFast(buf)
+		prefix = " "
+	}
+	if len(node.PartitionClause) > 0 {
+		buf.WriteString(prefix)
+		buf.WriteString("partition by ")
+		node.PartitionClause.formatFast(buf)
+		prefix = " "
+	}
+	if len(node.OrderClause) > 0 {
+		buf.WriteString(prefix)
+		buf.WriteString("order by ")
+		orderPrefix := ""
+		for _, order := range node.OrderClause {
+			buf.WriteString(orderPrefix)
+			order.formatFast(buf)
+			orderPrefix = ", "
+		}
+		prefix = " "
+	}
+	if node.FrameClause != nil {
+		buf.WriteString(prefix)
+		node.FrameClause.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *FrameClause) formatFast(buf *TrackedBuffer) {
+	if node.End == nil {
+		buf.WriteString(node.Unit.ToString())
+		buf.WriteByte(' ')
+		node.Start.formatFast(buf)
+		return
+	}
+	buf.WriteString(node.Unit.ToString())
+	buf.WriteString(" between ")
+	node.Start.formatFast(buf)
+	buf.WriteString(" and ")
+	node.End.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *FramePoint) formatFast(buf *TrackedBuffer) {
+	if node.Expr != nil {
+		node.Expr.formatFast(buf)
+		buf.WriteByte(' ')
+	}
+	buf.WriteString(node.Type.ToString())
+}
+
+// formatFast formats the node.
+func (node *WindowDefinition) formatFast(buf *TrackedBuffer) {
+	node.Name.formatFast(buf)
+	buf.WriteString(" as (")
+	node.WindowSpec.formatFast(buf)
+	buf.WriteByte(')')
+}
+
+// formatFast formats the node.
+func (node WindowDefinitions) formatFast(buf *TrackedBuffer) {
+	prefix := " window "
+	for _, n := range node {
+		buf.WriteString(prefix)
+		n.formatFast(buf)
+		prefix = ", "
+	}
 }
 
 // formatFast formats the node
@@ -2022,7 +2124,73 @@ func (node AccessMode) formatFast(buf *TrackedBuffer) {
 
 // formatFast formats the node.
 func (node *Load) formatFast(buf *TrackedBuffer) {
-	buf.WriteString("AST node missing for Load type")
+	buf.WriteString("load data ")
+	if node.LowPriority {
+		buf.WriteString("low_priority ")
+	}
+	if node.Concurrent {
+		buf.WriteString("concurrent ")
+	}
+	if node.Local {
+		buf.WriteString("local ")
+	}
+	buf.WriteString("infile ")
+	buf.WriteString(encodeSQLString(node.FileName))
+	if node.Action == ReplaceAct {
+		buf.WriteString(" replace")
+	}
+	if node.Ignore {
+		buf.WriteString(" ignore")
+	}
+	buf.WriteString(" into table ")
+	node.Table.formatFast(buf)
+	node.Partitions.formatFast(buf)
+	if node.Charset != "" {
+		buf.WriteString(" character set ")
+		buf.WriteString(node.Charset)
+	}
+	if node.FieldsTerminatedBy != nil || node.FieldsEnclosedBy != nil || node.FieldsEscapedBy != nil {
+		buf.WriteString(" fields")
+		if node.FieldsTerminatedBy != nil {
+			buf.WriteString(" terminated by ")
+			node.FieldsTerminatedBy.formatFast(buf)
+		}
+		if node.FieldsEnclosedBy != nil {
+			if node.OptionallyEnclosed {
+				buf.WriteString(" optionally")
+			}
+			buf.WriteString(" enclosed by ")
+			node.FieldsEnclosedBy.formatFast(buf)
+		}
+		if node.FieldsEscapedBy != nil {
+			buf.WriteString(" escaped by ")
+			node.FieldsEscapedBy.formatFast(buf)
+		}
+	}
+	if node.LinesStartingBy != nil || node.LinesTerminatedBy != nil {
+		buf.WriteString(" lines")
+		if node.LinesStartingBy != nil {
+			buf.WriteString(" starting by ")
+			node.LinesStartingBy.formatFast(buf)
+		}
+		if node.LinesTerminatedBy != nil {
+			buf.WriteString(" terminated by ")
+			node.LinesTerminatedBy.formatFast(buf)
+		}
+	}
+	if node.IgnoreLines != nil {
+		buf.WriteString(" ignore ")
+		node.IgnoreLines.formatFast(buf)
+		buf.WriteString(" lines")
+	}
+	if node.Columns != nil {
+		buf.WriteByte(' ')
+		node.Columns.formatFast(buf)
+	}
+	if len(node.SetExprs) > 0 {
+		buf.WriteString(" set ")
+		node.SetExprs.formatFast(buf)
+	}
 }
 
 // formatFast formats the node.
@@ -589,7 +589,9 @@ var Aggregates = map[string]bool{
 
 // IsAggregate returns true if the function is an aggregate.
 func (node *FuncExpr) IsAggregate() bool {
-	return Aggregates[node.Name.Lowered()]
+	// an aggregate function with an OVER clause is a window function
+	// and does not group the rows of the query
+	return node.Over == nil && Aggregates[node.Name.Lowered()]
 }
 
 // NewColIdent makes a new ColIdent.
@@ -1336,6 +1338,66 @@ func (ty TrimType) ToString() string {
 	}
 }
 
+// ToString returns the type as a string
+func (ty WindowFuncType) ToString() string {
+	switch ty {
+	case RowNumberType:
+		return RowNumberStr
+	case RankType:
+		return RankStr
+	case DenseRankType:
+		return DenseRankStr
+	case PercentRankType:
+		return PercentRankStr
+	case CumeDistType:
+		return CumeDistStr
+	case NtileType:
+		return NtileStr
+	case LagType:
+		return LagStr
+	case LeadType:
+		return LeadStr
+	case FirstValueType:
+		return FirstValueStr
+	case LastValueType:
+		return LastValueStr
+	case NthValueType:
+		return NthValueStr
+	default:
+		return "Unknown WindowFuncType"
+	}
+}
+
+// ToString returns the type as a string
+func (ty FrameUnitType) ToString() string {
+	switch ty {
+	case RowsFrameUnit:
+		return RowsFrameUnitStr
+	case RangeFrameUnit:
+		return RangeFrameUnitStr
+	default:
+		return "Unknown FrameUnitType"
+	}
+}
+
+// ToString returns the type as a string
+func (ty FramePointType) ToString() string {
+	switch ty {
+	case CurrentRowType:
+		return CurrentRowStr
+	case UnboundedPrecedingType:
+		return UnboundedPrecedingStr
+	case UnboundedFollowingType:
+		return UnboundedFollowingStr
+	case ExprPrecedingType:
+		return ExprPrecedingStr
+	case ExprFollowingType:
+		return ExprFollowingStr
+	default:
+		return "Unknown FramePointType"
+	}
+}
+
 // ToString returns the type as a string
 func (ty ExplainType) ToString() string {
 	switch ty {
@@ -1632,6 +1694,31 @@ func IsAggregation(node SQLNode) bool {
 	return false
 }
 
+// ContainsWindowFunction returns true if the expression contains a window function
+func ContainsWindowFunction(e SQLNode) bool {
+	hasWindowFunctions := false
+	_ = Walk(func(node SQLNode) (kontinue bool, err error) {
+		if IsWindowFunction(node) {
+			hasWindowFunctions = true
+			return false, nil
+		}
+		return true, nil
+	}, e)
+	return hasWindowFunctions
+}
+
+// IsWindowFunction returns true if the node is a window function,
+// or an aggregate function used as a window function
+func IsWindowFunction(node SQLNode) bool {
+	switch node := node.(type) {
+	case *WindowFuncExpr:
+		return true
+	case *FuncExpr:
+		return node.Over != nil
+	}
+	return false
+}
+
 // GetFirstSelect gets the first select statement
 func GetFirstSelect(selStmt SelectStatement) *Select {
 	if selStmt == nil {
@@ -1680,6 +1767,13 @@ func (es *ExtractedSubquery) GetHasValuesArg() string {
 
 }
 
+// GetAlternative returns the expression that replaces the original expression
+// when the subquery is not merged, which uses the argument and has_values
+// arguments instead of the subquery.
+func (es *ExtractedSubquery) GetAlternative() Expr {
+	return es.alternative
+}
+
 func (es *ExtractedSubquery) updateAlternative() {
 	switch original := es.Original.(type) {
 	case *ExistsExpr:
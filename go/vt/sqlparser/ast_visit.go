@@ -148,6 +148,10 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfForce(in, f)
 	case *ForeignKeyDefinition:
 		return VisitRefOfForeignKeyDefinition(in, f)
+	case *FrameClause:
+		return VisitRefOfFrameClause(in, f)
+	case *FramePoint:
+		return VisitRefOfFramePoint(in, f)
 	case *FuncExpr:
 		return VisitRefOfFuncExpr(in, f)
 	case GroupBy:
@@ -218,6 +222,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfOtherAdmin(in, f)
 	case *OtherRead:
 		return VisitRefOfOtherRead(in, f)
+	case *OverClause:
+		return VisitRefOfOverClause(in, f)
 	case *ParenTableExpr:
 		return VisitRefOfParenTableExpr(in, f)
 	case *PartitionDefinition:
@@ -344,6 +350,14 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfWhen(in, f)
 	case *Where:
 		return VisitRefOfWhere(in, f)
+	case *WindowDefinition:
+		return VisitRefOfWindowDefinition(in, f)
+	case WindowDefinitions:
+		return VisitWindowDefinitions(in, f)
+	case *WindowFuncExpr:
+		return VisitRefOfWindowFuncExpr(in, f)
+	case *WindowSpecification:
+		return VisitRefOfWindowSpecification(in, f)
 	case *With:
 		return VisitRefOfWith(in, f)
 	case *XorExpr:
@@ -1230,6 +1244,33 @@ func VisitRefOfForeignKeyDefinition(in *ForeignKeyDefinition, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfFrameClause(in *FrameClause, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfFramePoint(in.Start, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfFramePoint(in.End, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfFramePoint(in *FramePoint, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Expr, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfFuncExpr(in *FuncExpr, f Visit) error {
 	if in == nil {
 		return nil
@@ -1246,6 +1287,9 @@ func VisitRefOfFuncExpr(in *FuncExpr, f Visit) error {
 	if err := VisitSelectExprs(in.Exprs, f); err != nil {
 		return err
 	}
+	if err := VisitRefOfOverClause(in.Over, f); err != nil {
+		return err
+	}
 	return nil
 }
 func VisitGroupBy(in GroupBy, f Visit) error {
@@ -1471,6 +1515,36 @@ func VisitRefOfLoad(in *Load, f Visit) error {
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
+	if err := VisitTableName(in.Table, f); err != nil {
+		return err
+	}
+	if err := VisitPartitions(in.Partitions, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.FieldsTerminatedBy, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.FieldsEnclosedBy, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.FieldsEscapedBy, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.LinesStartingBy, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.LinesTerminatedBy, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfLiteral(in.IgnoreLines, f); err != nil {
+		return err
+	}
+	if err := VisitColumns(in.Columns, f); err != nil {
+		return err
+	}
+	if err := VisitUpdateExprs(in.SetExprs, f); err != nil {
+		return err
+	}
 	return nil
 }
 func VisitRefOfLockOption(in *LockOption, f Visit) error {
@@ -1651,6 +1725,21 @@ func VisitRefOfOtherRead(in *OtherRead, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfOverClause(in *OverClause, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.WindowName, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfWindowSpecification(in.WindowSpec, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfParenTableExpr(in *ParenTableExpr, f Visit) error {
 	if in == nil {
 		return nil
@@ -1921,6 +2010,9 @@ func VisitRefOfSelect(in *Select, f Visit) error {
 	if err := VisitRefOfWhere(in.Having, f); err != nil {
 		return err
 	}
+	if err := VisitWindowDefinitions(in.Windows, f); err != nil {
+		return err
+	}
 	if err := VisitOrderBy(in.OrderBy, f); err != nil {
 		return err
 	}
@@ -2572,6 +2664,71 @@ func VisitRefOfWhere(in *Where, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfWindowDefinition(in *WindowDefinition, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.Name, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfWindowSpecification(in.WindowSpec, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitWindowDefinitions(in WindowDefinitions, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	for _, el := range in {
+		if err := VisitRefOfWindowDefinition(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func VisitRefOfWindowFuncExpr(in *WindowFuncExpr, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExprs(in.Exprs, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfOverClause(in.Over, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfWindowSpecification(in *WindowSpecification, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.Name, f); err != nil {
+		return err
+	}
+	if err := VisitExprs(in.PartitionClause, f); err != nil {
+		return err
+	}
+	if err := VisitOrderBy(in.OrderClause, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfFrameClause(in.FrameClause, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfWith(in *With, f Visit) error {
 	if in == nil {
 		return nil
@@ -2579,7 +2736,7 @@ func VisitRefOfWith(in *With, f Visit) error {
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	for _, el := range in.ctes {
+	for _, el := range in.CTEs {
 		if err := VisitRefOfCommonTableExpr(el, f); err != nil {
 			return err
 		}
@@ -2678,6 +2835,8 @@ func VisitCallable(in Callable, f Visit) error {
 		return VisitRefOfValuesFuncExpr(in, f)
 	case *WeightStringFuncExpr:
 		return VisitRefOfWeightStringFuncExpr(in, f)
+	case *WindowFuncExpr:
+		return VisitRefOfWindowFuncExpr(in, f)
 	default:
 		// this should never happen
 		return nil
@@ -2860,6 +3019,8 @@ func VisitExpr(in Expr, f Visit) error {
 		return VisitRefOfValuesFuncExpr(in, f)
 	case *WeightStringFuncExpr:
 		return VisitRefOfWeightStringFuncExpr(in, f)
+	case *WindowFuncExpr:
+		return VisitRefOfWindowFuncExpr(in, f)
 	case *XorExpr:
 		return VisitRefOfXorExpr(in, f)
 	default:
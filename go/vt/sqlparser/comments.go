@@ -43,6 +43,8 @@ const (
 	DirectiveAllowHashJoin = "ALLOW_HASH_JOIN"
 	// DirectiveQueryPlanner lets the user specify per query which planner should be used
 	DirectiveQueryPlanner = "PLANNER"
+	// DirectiveResultCacheTTL lets vtgate cache the results of a select for the given number of milliseconds
+	DirectiveResultCacheTTL = "RESULT_CACHE_TTL_MS"
 )
 
 func isNonSpace(r rune) bool {
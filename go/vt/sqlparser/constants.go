@@ -305,6 +305,30 @@ const (
 	LTrimStr      = "ltrim"
 	RTrimStr      = "rtrim"
 
+	// WindowFuncType strings
+	RowNumberStr   = "row_number"
+	RankStr        = "rank"
+	DenseRankStr   = "dense_rank"
+	PercentRankStr = "percent_rank"
+	CumeDistStr    = "cume_dist"
+	NtileStr       = "ntile"
+	LagStr         = "lag"
+	LeadStr        = "lead"
+	FirstValueStr  = "first_value"
+	LastValueStr   = "last_value"
+	NthValueStr    = "nth_value"
+
+	// FrameUnitType strings
+	RowsFrameUnitStr  = "rows"
+	RangeFrameUnitStr = "range"
+
+	// FramePointType strings
+	CurrentRowStr         = "current row"
+	UnboundedPrecedingStr = "unbounded preceding"
+	UnboundedFollowingStr = "unbounded following"
+	ExprPrecedingStr      = "preceding"
+	ExprFollowingStr      = "following"
+
 	// LockOptionType strings
 	NoneTypeStr      = "none"
 	SharedTypeStr    = "shared"
@@ -404,6 +428,36 @@ const (
 	RTrimType
 )
 
+// Constants for Enum Type - WindowFuncType
+const (
+	RowNumberType WindowFuncType = iota
+	RankType
+	DenseRankType
+	PercentRankType
+	CumeDistType
+	NtileType
+	LagType
+	LeadType
+	FirstValueType
+	LastValueType
+	NthValueType
+)
+
+// Constants for Enum Type - FrameUnitType
+const (
+	RowsFrameUnit FrameUnitType = iota
+	RangeFrameUnit
+)
+
+// Constants for Enum Type - FramePointType
+const (
+	CurrentRowType FramePointType = iota
+	UnboundedPrecedingType
+	UnboundedFollowingType
+	ExprPrecedingType
+	ExprFollowingType
+)
+
 // Constants for Enum Type - WhereType
 const (
 	WhereClause WhereType = iota
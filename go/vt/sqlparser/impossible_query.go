@@ -37,6 +37,9 @@ func FormatImpossibleQuery(buf *TrackedBuffer, node SQLNode) {
 		if node.GroupBy != nil {
 			node.GroupBy.Format(buf)
 		}
+		if node.Windows != nil {
+			node.Windows.Format(buf)
+		}
 	case *Union:
 		if requiresParen(node.Left) {
 			buf.astPrintf(node, "(%v)", node.Left)
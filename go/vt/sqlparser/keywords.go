@@ -168,13 +168,14 @@ var keywords = []keyword{
 	{"complete", COMPLETE},
 	{"compressed", COMPRESSED},
 	{"compression", COMPRESSION},
+	{"concurrent", CONCURRENT},
 	{"condition", UNUSED},
 	{"connection", CONNECTION},
 	{"constraint", CONSTRAINT},
 	{"continue", UNUSED},
 	{"convert", CONVERT},
 	{"copy", COPY},
-	{"cume_dist", UNUSED},
+	{"cume_dist", CUME_DIST},
 	{"substr", SUBSTRING},
 	{"subpartition", SUBPARTITION},
 	{"subpartitions", SUBPARTITIONS},
@@ -182,6 +183,7 @@ var keywords = []keyword{
 	{"create", CREATE},
 	{"cross", CROSS},
 	{"csv", CSV},
+	{"current", CURRENT},
 	{"current_date", CURRENT_DATE},
 	{"current_time", CURRENT_TIME},
 	{"current_timestamp", CURRENT_TIMESTAMP},
@@ -206,7 +208,7 @@ var keywords = []keyword{
 	{"delay_key_write", DELAY_KEY_WRITE},
 	{"delayed", UNUSED},
 	{"delete", DELETE},
-	{"dense_rank", UNUSED},
+	{"dense_rank", DENSE_RANK},
 	{"desc", DESC},
 	{"describe", DESCRIBE},
 	{"deterministic", UNUSED},
@@ -253,12 +255,13 @@ var keywords = []keyword{
 	{"fetch", UNUSED},
 	{"fields", FIELDS},
 	{"first", FIRST},
-	{"first_value", UNUSED},
+	{"first_value", FIRST_VALUE},
 	{"fixed", FIXED},
 	{"float", FLOAT_TYPE},
 	{"float4", UNUSED},
 	{"float8", UNUSED},
 	{"flush", FLUSH},
+	{"following", FOLLOWING},
 	{"for", FOR},
 	{"force", FORCE},
 	{"foreign", FOREIGN},
@@ -294,7 +297,7 @@ var keywords = []keyword{
 	{"in", IN},
 	{"index", INDEX},
 	{"indexes", INDEXES},
-	{"infile", UNUSED},
+	{"infile", INFILE},
 	{"inout", UNUSED},
 	{"inner", INNER},
 	{"inplace", INPLACE},
@@ -323,13 +326,13 @@ var keywords = []keyword{
 	{"keyspaces", KEYSPACES},
 	{"key_block_size", KEY_BLOCK_SIZE},
 	{"kill", UNUSED},
-	{"lag", UNUSED},
+	{"lag", LAG},
 	{"language", LANGUAGE},
 	{"last", LAST},
-	{"last_value", UNUSED},
+	{"last_value", LAST_VALUE},
 	{"last_insert_id", LAST_INSERT_ID},
 	{"lateral", UNUSED},
-	{"lead", UNUSED},
+	{"lead", LEAD},
 	{"leading", LEADING},
 	{"leave", UNUSED},
 	{"left", LEFT},
@@ -387,8 +390,8 @@ var keywords = []keyword{
 	{"none", NONE},
 	{"not", NOT},
 	{"no_write_to_binlog", NO_WRITE_TO_BINLOG},
-	{"nth_value", UNUSED},
-	{"ntile", UNUSED},
+	{"nth_value", NTH_VALUE},
+	{"ntile", NTILE},
 	{"null", NULL},
 	{"numeric", NUMERIC},
 	{"of", UNUSED},
@@ -406,7 +409,7 @@ var keywords = []keyword{
 	{"out", UNUSED},
 	{"outer", OUTER},
 	{"outfile", OUTFILE},
-	{"over", UNUSED},
+	{"over", OVER},
 	{"overwrite", OVERWRITE},
 	{"pack_keys", PACK_KEYS},
 	{"parser", PARSER},
@@ -414,11 +417,12 @@ var keywords = []keyword{
 	{"partitions", PARTITIONS},
 	{"partitioning", PARTITIONING},
 	{"password", PASSWORD},
-	{"percent_rank", UNUSED},
+	{"percent_rank", PERCENT_RANK},
 	{"plugins", PLUGINS},
 	{"point", POINT},
 	{"polygon", POLYGON},
 	{"precision", UNUSED},
+	{"preceding", PRECEDING},
 	{"prepare", PREPARE},
 	{"primary", PRIMARY},
 	{"privileges", PRIVILEGES},
@@ -427,7 +431,7 @@ var keywords = []keyword{
 	{"query", QUERY},
 	{"range", RANGE},
 	{"quarter", QUARTER},
-	{"rank", UNUSED},
+	{"rank", RANK},
 	{"read", READ},
 	{"reads", UNUSED},
 	{"read_write", UNUSED},
@@ -456,10 +460,10 @@ var keywords = []keyword{
 	{"right", RIGHT},
 	{"rlike", REGEXP},
 	{"rollback", ROLLBACK},
-	{"row", UNUSED},
+	{"row", ROW},
 	{"row_format", ROW_FORMAT},
-	{"row_number", UNUSED},
-	{"rows", UNUSED},
+	{"row_number", ROW_NUMBER},
+	{"rows", ROWS},
 	{"rtrim", RTRIM},
 	{"s3", S3},
 	{"savepoint", SAVEPOINT},
@@ -531,6 +535,7 @@ var keywords = []keyword{
 	{"true", TRUE},
 	{"truncate", TRUNCATE},
 	{"trim", TRIM},
+	{"unbounded", UNBOUNDED},
 	{"uncommitted", UNCOMMITTED},
 	{"undefined", UNDEFINED},
 	{"undo", UNUSED},
@@ -576,7 +581,7 @@ var keywords = []keyword{
 	{"when", WHEN},
 	{"where", WHERE},
 	{"while", UNUSED},
-	{"window", UNUSED},
+	{"window", WINDOW},
 	{"with", WITH},
 	{"without", WITHOUT},
 	{"work", WORK},
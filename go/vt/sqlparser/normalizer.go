@@ -93,6 +93,10 @@ func (nz *normalizer) WalkSelect(cursor *Cursor) bool {
 	case OrderBy, GroupBy:
 		// do not make a bind var for order by column_position
 		return false
+	case *WindowFuncExpr, *FramePoint:
+		// do not make bind vars for the counts and offsets of window functions and frames,
+		// since the planner needs to know them to compute the windows at vtgate
+		return false
 	case *ConvertType:
 		// we should not rewrite the type description
 		return false
@@ -173,6 +173,11 @@ func TestNormalize(t *testing.T) {
 		in:      "select a, b from t order by 1 asc",
 		outstmt: "select a, b from t order by 1 asc",
 		outbv:   map[string]*querypb.BindVariable{},
+	}, {
+		// window function and frame offsets
+		in:      "select lag(a, 2, 0) over (order by b asc rows 1 preceding) from t",
+		outstmt: "select lag(a, 2, 0) over (order by b asc rows 1 preceding) from t",
+		outbv:   map[string]*querypb.BindVariable{},
 	}, {
 		// ORDER BY variable
 		in:      "select a, b from t order by c asc",
@@ -173,6 +173,8 @@ var (
 	}, {
 		input:  "WITH topsales2003 AS (SELECT salesRepEmployeeNumber employeeNumber, SUM(quantityOrdered * priceEach) sales FROM orders INNER JOIN orderdetails USING (orderNumber) INNER JOIN customers USING (customerNumber) WHERE YEAR(shippedDate) = 2003 AND status = 'Shipped' GROUP BY salesRepEmployeeNumber ORDER BY sales DESC LIMIT 5)SELECT employeeNumber, firstName, lastName, sales FROM employees JOIN topsales2003 USING (employeeNumber)",
 		output: "with topsales2003 as (select salesRepEmployeeNumber as employeeNumber, SUM(quantityOrdered * priceEach) as sales from orders join orderdetails using (orderNumber) join customers using (customerNumber) where YEAR(shippedDate) = 2003 and `status` = 'Shipped' group by salesRepEmployeeNumber order by sales desc limit 5) select employeeNumber, firstName, lastName, sales from employees join topsales2003 using (employeeNumber)",
+	}, {
+		input: "with x as (select a from t) select a from x union select b from y",
 	}, {
 		input: "select 1 from t",
 	}, {
@@ -2294,8 +2296,8 @@ var (
 		input:  "DROP /* comment */ PREPARE stmt1",
 		output: "drop /* comment */ prepare stmt1",
 	}, {
-		input:  "create table unused_reserved_keywords (dense_rank bigint, lead VARCHAR(255), percent_rank decimal(3, 0), row TINYINT, rows CHAR(10), constraint PK_project PRIMARY KEY (dense_rank))",
-		output: "create table unused_reserved_keywords (\n\t`dense_rank` bigint,\n\t`lead` VARCHAR(255),\n\t`percent_rank` decimal(3,0),\n\t`row` TINYINT,\n\t`rows` CHAR(10),\n\tconstraint PK_project PRIMARY KEY (`dense_rank`)\n)",
+		input:  "create table reserved_keywords (`dense_rank` bigint, `lead` VARCHAR(255), `percent_rank` decimal(3, 0), `row` TINYINT, `rows` CHAR(10), constraint PK_project PRIMARY KEY (`dense_rank`))",
+		output: "create table reserved_keywords (\n\t`dense_rank` bigint,\n\t`lead` VARCHAR(255),\n\t`percent_rank` decimal(3,0),\n\t`row` TINYINT,\n\t`rows` CHAR(10),\n\tconstraint PK_project PRIMARY KEY (`dense_rank`)\n)",
 	}, {
 		input:  "SELECT LTRIM('abc')",
 		output: "select ltrim('abc') from dual",
@@ -2326,6 +2328,31 @@ var (
 	}, {
 		input:  "SELECT TRIM(BOTH 'a' FROM 'abc')",
 		output: "select trim(both 'a' from 'abc') from dual",
+	}, {
+		input:  "select row_number() over (partition by a order by b desc) from t",
+		output: "select row_number() over (partition by a order by b desc) from t",
+	}, {
+		input:  "select rank() over w, dense_rank() over w, percent_rank() over w, cume_dist() over w from t window w as (order by a)",
+		output: "select rank() over w, dense_rank() over w, percent_rank() over w, cume_dist() over w from t window w as (order by a asc)",
+	}, {
+		input: "select ntile(4) over (order by a asc), nth_value(b, 2) over (order by a asc) from t",
+	}, {
+		input: "select lag(a) over (), lag(a, 2) over (), lead(a, 1, 0) over () from t",
+	}, {
+		input:  "select first_value(a) over (w rows unbounded preceding), last_value(a) over (w rows between 1 preceding and 2 following) from t window w as (partition by b, c order by d)",
+		output: "select first_value(a) over (w rows unbounded preceding), last_value(a) over (w rows between 1 preceding and 2 following) from t window w as (partition by b, c order by d asc)",
+	}, {
+		input: "select sum(a) over (order by b asc range between interval 1 day preceding and current row) from t",
+	}, {
+		input: "select count(*) over (partition by a), avg(b) over w1 from t window w1 as (), w2 as (w1 rows between current row and unbounded following)",
+	}, {
+		input: "select a from t group by a having count(*) > 1 window w as (order by a asc) order by a asc limit 10",
+	}, {
+		input:  "select row('a', 'b') = row('a', 'b') from dual",
+		output: "select row('a', 'b') = row('a', 'b') from dual",
+	}, {
+		input:  "select rows, current from t where rows > 70",
+		output: "select `rows`, `current` from t where `rows` > 70",
 	}}
 )
 
@@ -3071,6 +3098,36 @@ func TestLoadData(t *testing.T) {
 		_, err := Parse(tcase)
 		require.NoError(t, err)
 	}
+
+	tcases := []struct {
+		input, output string
+	}{{
+		input: "load data infile 'x.txt' into table t",
+	}, {
+		input: "load data local infile '/tmp/x.csv' into table ks.t",
+	}, {
+		input:  "LOAD DATA LOW_PRIORITY LOCAL INFILE 'x.txt' REPLACE INTO TABLE t PARTITION (p0, p1) CHARACTER SET utf8mb4",
+		output: "load data low_priority local infile 'x.txt' replace into table t partition (p0, p1) character set utf8mb4",
+	}, {
+		input: "load data concurrent infile 'x.txt' ignore into table t",
+	}, {
+		input:  "load data local infile 'x.csv' into table t columns terminated by ',' optionally enclosed by '\"' escaped by '\\\\' lines starting by 'x' terminated by '\\r\\n' ignore 1 rows",
+		output: "load data local infile 'x.csv' into table t fields terminated by ',' optionally enclosed by '\\\"' escaped by '\\\\' lines starting by 'x' terminated by '\\r\\n' ignore 1 lines",
+	}, {
+		input: "load data local infile 'x.csv' into table t fields enclosed by '\\'' lines terminated by '\\n'",
+	}, {
+		input: "load data local infile 'x.csv' into table t (a, @b, c) set d = @b * 2, e = now()",
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.input, func(t *testing.T) {
+			if tcase.output == "" {
+				tcase.output = tcase.input
+			}
+			tree, err := Parse(tcase.input)
+			require.NoError(t, err)
+			require.Equal(t, tcase.output, String(tree))
+		})
+	}
 }
 
 func TestCreateTable(t *testing.T) {
@@ -3847,6 +3904,15 @@ var (
 	}{{
 		input:  "select : from t",
 		output: "syntax error at position 9 near ':'",
+	}, {
+		input:  "select lag(a, 1, 0, 2) over () from t",
+		output: "incorrect parameter count in the call to native function 'lag' at position 31",
+	}, {
+		input:  "select row_number() from t",
+		output: "syntax error at position 25 near 'from'",
+	}, {
+		input:  "select a from t window w as (rows a preceding)",
+		output: "syntax error at position 36 near 'a'",
 	}, {
 		input:  "execute stmt using 1;",
 		output: "syntax error at position 21 near '1'",
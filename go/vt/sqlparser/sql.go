@@ -89,505 +89,511 @@ const LINES = 57386
 const TERMINATED = 57387
 const ESCAPED = 57388
 const ENCLOSED = 57389
-const DUMPFILE = 57390
-const CSV = 57391
-const HEADER = 57392
-const MANIFEST = 57393
-const OVERWRITE = 57394
-const STARTING = 57395
-const OPTIONALLY = 57396
-const VALUES = 57397
-const LAST_INSERT_ID = 57398
-const NEXT = 57399
-const VALUE = 57400
-const SHARE = 57401
-const MODE = 57402
-const SQL_NO_CACHE = 57403
-const SQL_CACHE = 57404
-const SQL_CALC_FOUND_ROWS = 57405
-const JOIN = 57406
-const STRAIGHT_JOIN = 57407
-const LEFT = 57408
-const RIGHT = 57409
-const INNER = 57410
-const OUTER = 57411
-const CROSS = 57412
-const NATURAL = 57413
-const USE = 57414
-const FORCE = 57415
-const ON = 57416
-const USING = 57417
-const INPLACE = 57418
-const COPY = 57419
-const ALGORITHM = 57420
-const NONE = 57421
-const SHARED = 57422
-const EXCLUSIVE = 57423
-const SUBQUERY_AS_EXPR = 57424
-const ID = 57425
-const AT_ID = 57426
-const AT_AT_ID = 57427
-const HEX = 57428
-const STRING = 57429
-const NCHAR_STRING = 57430
-const INTEGRAL = 57431
-const FLOAT = 57432
-const DECIMAL = 57433
-const HEXNUM = 57434
-const VALUE_ARG = 57435
-const LIST_ARG = 57436
-const COMMENT = 57437
-const COMMENT_KEYWORD = 57438
-const BIT_LITERAL = 57439
-const COMPRESSION = 57440
-const EXTRACT = 57441
-const NULL = 57442
-const TRUE = 57443
-const FALSE = 57444
-const OFF = 57445
-const DISCARD = 57446
-const IMPORT = 57447
-const ENABLE = 57448
-const DISABLE = 57449
-const TABLESPACE = 57450
-const VIRTUAL = 57451
-const STORED = 57452
-const BOTH = 57453
-const LEADING = 57454
-const TRAILING = 57455
-const EMPTY_FROM_CLAUSE = 57456
-const LOWER_THAN_CHARSET = 57457
-const CHARSET = 57458
-const UNIQUE = 57459
-const KEY = 57460
-const EXPRESSION_PREC_SETTER = 57461
-const OR = 57462
-const XOR = 57463
-const AND = 57464
-const NOT = 57465
-const BETWEEN = 57466
-const CASE = 57467
-const WHEN = 57468
-const THEN = 57469
-const ELSE = 57470
-const END = 57471
-const LE = 57472
-const GE = 57473
-const NE = 57474
-const NULL_SAFE_EQUAL = 57475
-const IS = 57476
-const LIKE = 57477
-const REGEXP = 57478
-const IN = 57479
-const SHIFT_LEFT = 57480
-const SHIFT_RIGHT = 57481
-const DIV = 57482
-const MOD = 57483
-const UNARY = 57484
-const COLLATE = 57485
-const BINARY = 57486
-const UNDERSCORE_ARMSCII8 = 57487
-const UNDERSCORE_ASCII = 57488
-const UNDERSCORE_BIG5 = 57489
-const UNDERSCORE_BINARY = 57490
-const UNDERSCORE_CP1250 = 57491
-const UNDERSCORE_CP1251 = 57492
-const UNDERSCORE_CP1256 = 57493
-const UNDERSCORE_CP1257 = 57494
-const UNDERSCORE_CP850 = 57495
-const UNDERSCORE_CP852 = 57496
-const UNDERSCORE_CP866 = 57497
-const UNDERSCORE_CP932 = 57498
-const UNDERSCORE_DEC8 = 57499
-const UNDERSCORE_EUCJPMS = 57500
-const UNDERSCORE_EUCKR = 57501
-const UNDERSCORE_GB18030 = 57502
-const UNDERSCORE_GB2312 = 57503
-const UNDERSCORE_GBK = 57504
-const UNDERSCORE_GEOSTD8 = 57505
-const UNDERSCORE_GREEK = 57506
-const UNDERSCORE_HEBREW = 57507
-const UNDERSCORE_HP8 = 57508
-const UNDERSCORE_KEYBCS2 = 57509
-const UNDERSCORE_KOI8R = 57510
-const UNDERSCORE_KOI8U = 57511
-const UNDERSCORE_LATIN1 = 57512
-const UNDERSCORE_LATIN2 = 57513
-const UNDERSCORE_LATIN5 = 57514
-const UNDERSCORE_LATIN7 = 57515
-const UNDERSCORE_MACCE = 57516
-const UNDERSCORE_MACROMAN = 57517
-const UNDERSCORE_SJIS = 57518
-const UNDERSCORE_SWE7 = 57519
-const UNDERSCORE_TIS620 = 57520
-const UNDERSCORE_UCS2 = 57521
-const UNDERSCORE_UJIS = 57522
-const UNDERSCORE_UTF16 = 57523
-const UNDERSCORE_UTF16LE = 57524
-const UNDERSCORE_UTF32 = 57525
-const UNDERSCORE_UTF8 = 57526
-const UNDERSCORE_UTF8MB4 = 57527
-const INTERVAL = 57528
-const JSON_EXTRACT_OP = 57529
-const JSON_UNQUOTE_EXTRACT_OP = 57530
-const CREATE = 57531
-const ALTER = 57532
-const DROP = 57533
-const RENAME = 57534
-const ANALYZE = 57535
-const ADD = 57536
-const FLUSH = 57537
-const CHANGE = 57538
-const MODIFY = 57539
-const DEALLOCATE = 57540
-const REVERT = 57541
-const SCHEMA = 57542
-const TABLE = 57543
-const INDEX = 57544
-const VIEW = 57545
-const TO = 57546
-const IGNORE = 57547
-const IF = 57548
-const PRIMARY = 57549
-const COLUMN = 57550
-const SPATIAL = 57551
-const FULLTEXT = 57552
-const KEY_BLOCK_SIZE = 57553
-const CHECK = 57554
-const INDEXES = 57555
-const ACTION = 57556
-const CASCADE = 57557
-const CONSTRAINT = 57558
-const FOREIGN = 57559
-const NO = 57560
-const REFERENCES = 57561
-const RESTRICT = 57562
-const SHOW = 57563
-const DESCRIBE = 57564
-const EXPLAIN = 57565
-const DATE = 57566
-const ESCAPE = 57567
-const REPAIR = 57568
-const OPTIMIZE = 57569
-const TRUNCATE = 57570
-const COALESCE = 57571
-const EXCHANGE = 57572
-const REBUILD = 57573
-const PARTITIONING = 57574
-const REMOVE = 57575
-const PREPARE = 57576
-const EXECUTE = 57577
-const MAXVALUE = 57578
-const PARTITION = 57579
-const REORGANIZE = 57580
-const LESS = 57581
-const THAN = 57582
-const PROCEDURE = 57583
-const TRIGGER = 57584
-const VINDEX = 57585
-const VINDEXES = 57586
-const DIRECTORY = 57587
-const NAME = 57588
-const UPGRADE = 57589
-const STATUS = 57590
-const VARIABLES = 57591
-const WARNINGS = 57592
-const CASCADED = 57593
-const DEFINER = 57594
-const OPTION = 57595
-const SQL = 57596
-const UNDEFINED = 57597
-const SEQUENCE = 57598
-const MERGE = 57599
-const TEMPORARY = 57600
-const TEMPTABLE = 57601
-const INVOKER = 57602
-const SECURITY = 57603
-const FIRST = 57604
-const AFTER = 57605
-const LAST = 57606
-const VITESS_MIGRATION = 57607
-const CANCEL = 57608
-const RETRY = 57609
-const COMPLETE = 57610
-const CLEANUP = 57611
-const BEGIN = 57612
-const START = 57613
-const TRANSACTION = 57614
-const COMMIT = 57615
-const ROLLBACK = 57616
-const SAVEPOINT = 57617
-const RELEASE = 57618
-const WORK = 57619
-const BIT = 57620
-const TINYINT = 57621
-const SMALLINT = 57622
-const MEDIUMINT = 57623
-const INT = 57624
-const INTEGER = 57625
-const BIGINT = 57626
-const INTNUM = 57627
-const REAL = 57628
-const DOUBLE = 57629
-const FLOAT_TYPE = 57630
-const DECIMAL_TYPE = 57631
-const NUMERIC = 57632
-const TIME = 57633
-const TIMESTAMP = 57634
-const DATETIME = 57635
-const YEAR = 57636
-const CHAR = 57637
-const VARCHAR = 57638
-const BOOL = 57639
-const CHARACTER = 57640
-const VARBINARY = 57641
-const NCHAR = 57642
-const TEXT = 57643
-const TINYTEXT = 57644
-const MEDIUMTEXT = 57645
-const LONGTEXT = 57646
-const BLOB = 57647
-const TINYBLOB = 57648
-const MEDIUMBLOB = 57649
-const LONGBLOB = 57650
-const JSON = 57651
-const ENUM = 57652
-const GEOMETRY = 57653
-const POINT = 57654
-const LINESTRING = 57655
-const POLYGON = 57656
-const GEOMETRYCOLLECTION = 57657
-const MULTIPOINT = 57658
-const MULTILINESTRING = 57659
-const MULTIPOLYGON = 57660
-const ASCII = 57661
-const UNICODE = 57662
-const NULLX = 57663
-const AUTO_INCREMENT = 57664
-const APPROXNUM = 57665
-const SIGNED = 57666
-const UNSIGNED = 57667
-const ZEROFILL = 57668
-const CODE = 57669
-const COLLATION = 57670
-const COLUMNS = 57671
-const DATABASES = 57672
-const ENGINES = 57673
-const EVENT = 57674
-const EXTENDED = 57675
-const FIELDS = 57676
-const FULL = 57677
-const FUNCTION = 57678
-const GTID_EXECUTED = 57679
-const KEYSPACES = 57680
-const OPEN = 57681
-const PLUGINS = 57682
-const PRIVILEGES = 57683
-const PROCESSLIST = 57684
-const SCHEMAS = 57685
-const TABLES = 57686
-const TRIGGERS = 57687
-const USER = 57688
-const VGTID_EXECUTED = 57689
-const VITESS_KEYSPACES = 57690
-const VITESS_METADATA = 57691
-const VITESS_MIGRATIONS = 57692
-const VITESS_REPLICATION_STATUS = 57693
-const VITESS_SHARDS = 57694
-const VITESS_TABLETS = 57695
-const VSCHEMA = 57696
-const NAMES = 57697
-const GLOBAL = 57698
-const SESSION = 57699
-const ISOLATION = 57700
-const LEVEL = 57701
-const READ = 57702
-const WRITE = 57703
-const ONLY = 57704
-const REPEATABLE = 57705
-const COMMITTED = 57706
-const UNCOMMITTED = 57707
-const SERIALIZABLE = 57708
-const CURRENT_TIMESTAMP = 57709
-const DATABASE = 57710
-const CURRENT_DATE = 57711
-const CURRENT_TIME = 57712
-const LOCALTIME = 57713
-const LOCALTIMESTAMP = 57714
-const CURRENT_USER = 57715
-const UTC_DATE = 57716
-const UTC_TIME = 57717
-const UTC_TIMESTAMP = 57718
-const DAY = 57719
-const DAY_HOUR = 57720
-const DAY_MICROSECOND = 57721
-const DAY_MINUTE = 57722
-const DAY_SECOND = 57723
-const HOUR = 57724
-const HOUR_MICROSECOND = 57725
-const HOUR_MINUTE = 57726
-const HOUR_SECOND = 57727
-const MICROSECOND = 57728
-const MINUTE = 57729
-const MINUTE_MICROSECOND = 57730
-const MINUTE_SECOND = 57731
-const MONTH = 57732
-const QUARTER = 57733
-const SECOND = 57734
-const SECOND_MICROSECOND = 57735
-const YEAR_MONTH = 57736
-const WEEK = 57737
-const REPLACE = 57738
-const CONVERT = 57739
-const CAST = 57740
-const SUBSTR = 57741
-const SUBSTRING = 57742
-const GROUP_CONCAT = 57743
-const SEPARATOR = 57744
-const TIMESTAMPADD = 57745
-const TIMESTAMPDIFF = 57746
-const WEIGHT_STRING = 57747
-const LTRIM = 57748
-const RTRIM = 57749
-const TRIM = 57750
-const MATCH = 57751
-const AGAINST = 57752
-const BOOLEAN = 57753
-const LANGUAGE = 57754
-const WITH = 57755
-const QUERY = 57756
-const EXPANSION = 57757
-const WITHOUT = 57758
-const VALIDATION = 57759
-const UNUSED = 57760
-const ARRAY = 57761
-const CUME_DIST = 57762
-const DESCRIPTION = 57763
-const DENSE_RANK = 57764
-const EMPTY = 57765
-const EXCEPT = 57766
-const FIRST_VALUE = 57767
-const GROUPING = 57768
-const GROUPS = 57769
-const JSON_TABLE = 57770
+const INFILE = 57390
+const CONCURRENT = 57391
+const DUMPFILE = 57392
+const CSV = 57393
+const HEADER = 57394
+const MANIFEST = 57395
+const OVERWRITE = 57396
+const STARTING = 57397
+const OPTIONALLY = 57398
+const VALUES = 57399
+const LAST_INSERT_ID = 57400
+const NEXT = 57401
+const VALUE = 57402
+const SHARE = 57403
+const MODE = 57404
+const SQL_NO_CACHE = 57405
+const SQL_CACHE = 57406
+const SQL_CALC_FOUND_ROWS = 57407
+const JOIN = 57408
+const STRAIGHT_JOIN = 57409
+const LEFT = 57410
+const RIGHT = 57411
+const INNER = 57412
+const OUTER = 57413
+const CROSS = 57414
+const NATURAL = 57415
+const USE = 57416
+const FORCE = 57417
+const ON = 57418
+const USING = 57419
+const INPLACE = 57420
+const COPY = 57421
+const ALGORITHM = 57422
+const NONE = 57423
+const SHARED = 57424
+const EXCLUSIVE = 57425
+const SUBQUERY_AS_EXPR = 57426
+const ID = 57427
+const AT_ID = 57428
+const AT_AT_ID = 57429
+const HEX = 57430
+const STRING = 57431
+const NCHAR_STRING = 57432
+const INTEGRAL = 57433
+const FLOAT = 57434
+const DECIMAL = 57435
+const HEXNUM = 57436
+const VALUE_ARG = 57437
+const LIST_ARG = 57438
+const COMMENT = 57439
+const COMMENT_KEYWORD = 57440
+const BIT_LITERAL = 57441
+const COMPRESSION = 57442
+const EXTRACT = 57443
+const NULL = 57444
+const TRUE = 57445
+const FALSE = 57446
+const OFF = 57447
+const DISCARD = 57448
+const IMPORT = 57449
+const ENABLE = 57450
+const DISABLE = 57451
+const TABLESPACE = 57452
+const VIRTUAL = 57453
+const STORED = 57454
+const BOTH = 57455
+const LEADING = 57456
+const TRAILING = 57457
+const EMPTY_FROM_CLAUSE = 57458
+const ROWS = 57459
+const EMPTY_WINDOW_NAME = 57460
+const LOWER_THAN_CHARSET = 57461
+const CHARSET = 57462
+const UNIQUE = 57463
+const KEY = 57464
+const EXPRESSION_PREC_SETTER = 57465
+const OR = 57466
+const XOR = 57467
+const AND = 57468
+const NOT = 57469
+const BETWEEN = 57470
+const CASE = 57471
+const WHEN = 57472
+const THEN = 57473
+const ELSE = 57474
+const END = 57475
+const LE = 57476
+const GE = 57477
+const NE = 57478
+const NULL_SAFE_EQUAL = 57479
+const IS = 57480
+const LIKE = 57481
+const REGEXP = 57482
+const IN = 57483
+const SHIFT_LEFT = 57484
+const SHIFT_RIGHT = 57485
+const DIV = 57486
+const MOD = 57487
+const UNARY = 57488
+const COLLATE = 57489
+const BINARY = 57490
+const UNDERSCORE_ARMSCII8 = 57491
+const UNDERSCORE_ASCII = 57492
+const UNDERSCORE_BIG5 = 57493
+const UNDERSCORE_BINARY = 57494
+const UNDERSCORE_CP1250 = 57495
+const UNDERSCORE_CP1251 = 57496
+const UNDERSCORE_CP1256 = 57497
+const UNDERSCORE_CP1257 = 57498
+const UNDERSCORE_CP850 = 57499
+const UNDERSCORE_CP852 = 57500
+const UNDERSCORE_CP866 = 57501
+const UNDERSCORE_CP932 = 57502
+const UNDERSCORE_DEC8 = 57503
+const UNDERSCORE_EUCJPMS = 57504
+const UNDERSCORE_EUCKR = 57505
+const UNDERSCORE_GB18030 = 57506
+const UNDERSCORE_GB2312 = 57507
+const UNDERSCORE_GBK = 57508
+const UNDERSCORE_GEOSTD8 = 57509
+const UNDERSCORE_GREEK = 57510
+const UNDERSCORE_HEBREW = 57511
+const UNDERSCORE_HP8 = 57512
+const UNDERSCORE_KEYBCS2 = 57513
+const UNDERSCORE_KOI8R = 57514
+const UNDERSCORE_KOI8U = 57515
+const UNDERSCORE_LATIN1 = 57516
+const UNDERSCORE_LATIN2 = 57517
+const UNDERSCORE_LATIN5 = 57518
+const UNDERSCORE_LATIN7 = 57519
+const UNDERSCORE_MACCE = 57520
+const UNDERSCORE_MACROMAN = 57521
+const UNDERSCORE_SJIS = 57522
+const UNDERSCORE_SWE7 = 57523
+const UNDERSCORE_TIS620 = 57524
+const UNDERSCORE_UCS2 = 57525
+const UNDERSCORE_UJIS = 57526
+const UNDERSCORE_UTF16 = 57527
+const UNDERSCORE_UTF16LE = 57528
+const UNDERSCORE_UTF32 = 57529
+const UNDERSCORE_UTF8 = 57530
+const UNDERSCORE_UTF8MB4 = 57531
+const INTERVAL = 57532
+const JSON_EXTRACT_OP = 57533
+const JSON_UNQUOTE_EXTRACT_OP = 57534
+const CREATE = 57535
+const ALTER = 57536
+const DROP = 57537
+const RENAME = 57538
+const ANALYZE = 57539
+const ADD = 57540
+const FLUSH = 57541
+const CHANGE = 57542
+const MODIFY = 57543
+const DEALLOCATE = 57544
+const REVERT = 57545
+const SCHEMA = 57546
+const TABLE = 57547
+const INDEX = 57548
+const VIEW = 57549
+const TO = 57550
+const IGNORE = 57551
+const IF = 57552
+const PRIMARY = 57553
+const COLUMN = 57554
+const SPATIAL = 57555
+const FULLTEXT = 57556
+const KEY_BLOCK_SIZE = 57557
+const CHECK = 57558
+const INDEXES = 57559
+const ACTION = 57560
+const CASCADE = 57561
+const CONSTRAINT = 57562
+const FOREIGN = 57563
+const NO = 57564
+const REFERENCES = 57565
+const RESTRICT = 57566
+const SHOW = 57567
+const DESCRIBE = 57568
+const EXPLAIN = 57569
+const DATE = 57570
+const ESCAPE = 57571
+const REPAIR = 57572
+const OPTIMIZE = 57573
+const TRUNCATE = 57574
+const COALESCE = 57575
+const EXCHANGE = 57576
+const REBUILD = 57577
+const PARTITIONING = 57578
+const REMOVE = 57579
+const PREPARE = 57580
+const EXECUTE = 57581
+const MAXVALUE = 57582
+const PARTITION = 57583
+const REORGANIZE = 57584
+const LESS = 57585
+const THAN = 57586
+const PROCEDURE = 57587
+const TRIGGER = 57588
+const VINDEX = 57589
+const VINDEXES = 57590
+const DIRECTORY = 57591
+const NAME = 57592
+const UPGRADE = 57593
+const STATUS = 57594
+const VARIABLES = 57595
+const WARNINGS = 57596
+const CASCADED = 57597
+const DEFINER = 57598
+const OPTION = 57599
+const SQL = 57600
+const UNDEFINED = 57601
+const SEQUENCE = 57602
+const MERGE = 57603
+const TEMPORARY = 57604
+const TEMPTABLE = 57605
+const INVOKER = 57606
+const SECURITY = 57607
+const FIRST = 57608
+const AFTER = 57609
+const LAST = 57610
+const VITESS_MIGRATION = 57611
+const CANCEL = 57612
+const RETRY = 57613
+const COMPLETE = 57614
+const CLEANUP = 57615
+const BEGIN = 57616
+const START = 57617
+const TRANSACTION = 57618
+const COMMIT = 57619
+const ROLLBACK = 57620
+const SAVEPOINT = 57621
+const RELEASE = 57622
+const WORK = 57623
+const BIT = 57624
+const TINYINT = 57625
+const SMALLINT = 57626
+const MEDIUMINT = 57627
+const INT = 57628
+const INTEGER = 57629
+const BIGINT = 57630
+const INTNUM = 57631
+const REAL = 57632
+const DOUBLE = 57633
+const FLOAT_TYPE = 57634
+const DECIMAL_TYPE = 57635
+const NUMERIC = 57636
+const TIME = 57637
+const TIMESTAMP = 57638
+const DATETIME = 57639
+const YEAR = 57640
+const CHAR = 57641
+const VARCHAR = 57642
+const BOOL = 57643
+const CHARACTER = 57644
+const VARBINARY = 57645
+const NCHAR = 57646
+const TEXT = 57647
+const TINYTEXT = 57648
+const MEDIUMTEXT = 57649
+const LONGTEXT = 57650
+const BLOB = 57651
+const TINYBLOB = 57652
+const MEDIUMBLOB = 57653
+const LONGBLOB = 57654
+const JSON = 57655
+const ENUM = 57656
+const GEOMETRY = 57657
+const POINT = 57658
+const LINESTRING = 57659
+const POLYGON = 57660
+const GEOMETRYCOLLECTION = 57661
+const MULTIPOINT = 57662
+const MULTILINESTRING = 57663
+const MULTIPOLYGON = 57664
+const ASCII = 57665
+const UNICODE = 57666
+const NULLX = 57667
+const AUTO_INCREMENT = 57668
+const APPROXNUM = 57669
+const SIGNED = 57670
+const UNSIGNED = 57671
+const ZEROFILL = 57672
+const CODE = 57673
+const COLLATION = 57674
+const COLUMNS = 57675
+const DATABASES = 57676
+const ENGINES = 57677
+const EVENT = 57678
+const EXTENDED = 57679
+const FIELDS = 57680
+const FULL = 57681
+const FUNCTION = 57682
+const GTID_EXECUTED = 57683
+const KEYSPACES = 57684
+const OPEN = 57685
+const PLUGINS = 57686
+const PRIVILEGES = 57687
+const PROCESSLIST = 57688
+const SCHEMAS = 57689
+const TABLES = 57690
+const TRIGGERS = 57691
+const USER = 57692
+const VGTID_EXECUTED = 57693
+const VITESS_KEYSPACES = 57694
+const VITESS_METADATA = 57695
+const VITESS_MIGRATIONS = 57696
+const VITESS_REPLICATION_STATUS = 57697
+const VITESS_SHARDS = 57698
+const VITESS_TABLETS = 57699
+const VSCHEMA = 57700
+const NAMES = 57701
+const GLOBAL = 57702
+const SESSION = 57703
+const ISOLATION = 57704
+const LEVEL = 57705
+const READ = 57706
+const WRITE = 57707
+const ONLY = 57708
+const REPEATABLE = 57709
+const COMMITTED = 57710
+const UNCOMMITTED = 57711
+const SERIALIZABLE = 57712
+const CURRENT_TIMESTAMP = 57713
+const DATABASE = 57714
+const CURRENT_DATE = 57715
+const CURRENT_TIME = 57716
+const LOCALTIME = 57717
+const LOCALTIMESTAMP = 57718
+const CURRENT_USER = 57719
+const UTC_DATE = 57720
+const UTC_TIME = 57721
+const UTC_TIMESTAMP = 57722
+const DAY = 57723
+const DAY_HOUR = 57724
+const DAY_MICROSECOND = 57725
+const DAY_MINUTE = 57726
+const DAY_SECOND = 57727
+const HOUR = 57728
+const HOUR_MICROSECOND = 57729
+const HOUR_MINUTE = 57730
+const HOUR_SECOND = 57731
+const MICROSECOND = 57732
+const MINUTE = 57733
+const MINUTE_MICROSECOND = 57734
+const MINUTE_SECOND = 57735
+const MONTH = 57736
+const QUARTER = 57737
+const SECOND = 57738
+const SECOND_MICROSECOND = 57739
+const YEAR_MONTH = 57740
+const WEEK = 57741
+const REPLACE = 57742
+const CONVERT = 57743
+const CAST = 57744
+const SUBSTR = 57745
+const SUBSTRING = 57746
+const GROUP_CONCAT = 57747
+const SEPARATOR = 57748
+const TIMESTAMPADD = 57749
+const TIMESTAMPDIFF = 57750
+const WEIGHT_STRING = 57751
+const LTRIM = 57752
+const RTRIM = 57753
+const TRIM = 57754
+const MATCH = 57755
+const AGAINST = 57756
+const BOOLEAN = 57757
+const LANGUAGE = 57758
+const WITH = 57759
+const QUERY = 57760
+const EXPANSION = 57761
+const WITHOUT = 57762
+const VALIDATION = 57763
+const OVER = 57764
+const WINDOW = 57765
+const ROW = 57766
+const CURRENT = 57767
+const CUME_DIST = 57768
+const DENSE_RANK = 57769
+const FIRST_VALUE = 57770
 const LAG = 57771
 const LAST_VALUE = 57772
-const LATERAL = 57773
-const LEAD = 57774
-const MEMBER = 57775
-const NTH_VALUE = 57776
-const NTILE = 57777
-const OF = 57778
-const OVER = 57779
-const PERCENT_RANK = 57780
-const RANK = 57781
-const RECURSIVE = 57782
-const ROW_NUMBER = 57783
-const SYSTEM = 57784
-const WINDOW = 57785
-const ACTIVE = 57786
-const ADMIN = 57787
-const BUCKETS = 57788
-const CLONE = 57789
-const COMPONENT = 57790
-const DEFINITION = 57791
-const ENFORCED = 57792
-const EXCLUDE = 57793
-const FOLLOWING = 57794
-const GEOMCOLLECTION = 57795
-const GET_MASTER_PUBLIC_KEY = 57796
-const HISTOGRAM = 57797
-const HISTORY = 57798
-const INACTIVE = 57799
-const INVISIBLE = 57800
-const LOCKED = 57801
-const MASTER_COMPRESSION_ALGORITHMS = 57802
-const MASTER_PUBLIC_KEY_PATH = 57803
-const MASTER_TLS_CIPHERSUITES = 57804
-const MASTER_ZSTD_COMPRESSION_LEVEL = 57805
-const NESTED = 57806
-const NETWORK_NAMESPACE = 57807
-const NOWAIT = 57808
-const NULLS = 57809
-const OJ = 57810
-const OLD = 57811
-const OPTIONAL = 57812
-const ORDINALITY = 57813
-const ORGANIZATION = 57814
-const OTHERS = 57815
-const PATH = 57816
-const PERSIST = 57817
-const PERSIST_ONLY = 57818
-const PRECEDING = 57819
-const PRIVILEGE_CHECKS_USER = 57820
-const PROCESS = 57821
-const RANDOM = 57822
-const REFERENCE = 57823
-const REQUIRE_ROW_FORMAT = 57824
-const RESOURCE = 57825
-const RESPECT = 57826
-const RESTART = 57827
-const RETAIN = 57828
-const REUSE = 57829
-const ROLE = 57830
-const SECONDARY = 57831
-const SECONDARY_ENGINE = 57832
-const SECONDARY_LOAD = 57833
-const SECONDARY_UNLOAD = 57834
-const SKIP = 57835
-const SRID = 57836
-const THREAD_PRIORITY = 57837
-const TIES = 57838
-const UNBOUNDED = 57839
-const VCPU = 57840
-const VISIBLE = 57841
-const FORMAT = 57842
-const TREE = 57843
-const VITESS = 57844
-const TRADITIONAL = 57845
-const LOCAL = 57846
-const LOW_PRIORITY = 57847
-const NO_WRITE_TO_BINLOG = 57848
-const LOGS = 57849
-const ERROR = 57850
-const GENERAL = 57851
-const HOSTS = 57852
-const OPTIMIZER_COSTS = 57853
-const USER_RESOURCES = 57854
-const SLOW = 57855
-const CHANNEL = 57856
-const RELAY = 57857
-const EXPORT = 57858
-const AVG_ROW_LENGTH = 57859
-const CONNECTION = 57860
-const CHECKSUM = 57861
-const DELAY_KEY_WRITE = 57862
-const ENCRYPTION = 57863
-const ENGINE = 57864
-const INSERT_METHOD = 57865
-const MAX_ROWS = 57866
-const MIN_ROWS = 57867
-const PACK_KEYS = 57868
-const PASSWORD = 57869
-const FIXED = 57870
-const DYNAMIC = 57871
-const COMPRESSED = 57872
-const REDUNDANT = 57873
-const COMPACT = 57874
-const ROW_FORMAT = 57875
-const STATS_AUTO_RECALC = 57876
-const STATS_PERSISTENT = 57877
-const STATS_SAMPLE_PAGES = 57878
-const STORAGE = 57879
-const MEMORY = 57880
-const DISK = 57881
-const PARTITIONS = 57882
-const LINEAR = 57883
-const RANGE = 57884
-const LIST = 57885
-const SUBPARTITION = 57886
-const SUBPARTITIONS = 57887
-const HASH = 57888
+const LEAD = 57773
+const NTH_VALUE = 57774
+const NTILE = 57775
+const PERCENT_RANK = 57776
+const RANK = 57777
+const ROW_NUMBER = 57778
+const UNUSED = 57779
+const ARRAY = 57780
+const DESCRIPTION = 57781
+const EMPTY = 57782
+const EXCEPT = 57783
+const GROUPING = 57784
+const GROUPS = 57785
+const JSON_TABLE = 57786
+const LATERAL = 57787
+const MEMBER = 57788
+const OF = 57789
+const RECURSIVE = 57790
+const SYSTEM = 57791
+const ACTIVE = 57792
+const ADMIN = 57793
+const BUCKETS = 57794
+const CLONE = 57795
+const COMPONENT = 57796
+const DEFINITION = 57797
+const ENFORCED = 57798
+const EXCLUDE = 57799
+const FOLLOWING = 57800
+const GEOMCOLLECTION = 57801
+const GET_MASTER_PUBLIC_KEY = 57802
+const HISTOGRAM = 57803
+const HISTORY = 57804
+const INACTIVE = 57805
+const INVISIBLE = 57806
+const LOCKED = 57807
+const MASTER_COMPRESSION_ALGORITHMS = 57808
+const MASTER_PUBLIC_KEY_PATH = 57809
+const MASTER_TLS_CIPHERSUITES = 57810
+const MASTER_ZSTD_COMPRESSION_LEVEL = 57811
+const NESTED = 57812
+const NETWORK_NAMESPACE = 57813
+const NOWAIT = 57814
+const NULLS = 57815
+const OJ = 57816
+const OLD = 57817
+const OPTIONAL = 57818
+const ORDINALITY = 57819
+const ORGANIZATION = 57820
+const OTHERS = 57821
+const PATH = 57822
+const PERSIST = 57823
+const PERSIST_ONLY = 57824
+const PRECEDING = 57825
+const PRIVILEGE_CHECKS_USER = 57826
+const PROCESS = 57827
+const RANDOM = 57828
+const REFERENCE = 57829
+const REQUIRE_ROW_FORMAT = 57830
+const RESOURCE = 57831
+const RESPECT = 57832
+const RESTART = 57833
+const RETAIN = 57834
+const REUSE = 57835
+const ROLE = 57836
+const SECONDARY = 57837
+const SECONDARY_ENGINE = 57838
+const SECONDARY_LOAD = 57839
+const SECONDARY_UNLOAD = 57840
+const SKIP = 57841
+const SRID = 57842
+const THREAD_PRIORITY = 57843
+const TIES = 57844
+const UNBOUNDED = 57845
+const VCPU = 57846
+const VISIBLE = 57847
+const FORMAT = 57848
+const TREE = 57849
+const VITESS = 57850
+const TRADITIONAL = 57851
+const LOCAL = 57852
+const LOW_PRIORITY = 57853
+const NO_WRITE_TO_BINLOG = 57854
+const LOGS = 57855
+const ERROR = 57856
+const GENERAL = 57857
+const HOSTS = 57858
+const OPTIMIZER_COSTS = 57859
+const USER_RESOURCES = 57860
+const SLOW = 57861
+const CHANNEL = 57862
+const RELAY = 57863
+const EXPORT = 57864
+const AVG_ROW_LENGTH = 57865
+const CONNECTION = 57866
+const CHECKSUM = 57867
+const DELAY_KEY_WRITE = 57868
+const ENCRYPTION = 57869
+const ENGINE = 57870
+const INSERT_METHOD = 57871
+const MAX_ROWS = 57872
+const MIN_ROWS = 57873
+const PACK_KEYS = 57874
+const PASSWORD = 57875
+const FIXED = 57876
+const DYNAMIC = 57877
+const COMPRESSED = 57878
+const REDUNDANT = 57879
+const COMPACT = 57880
+const ROW_FORMAT = 57881
+const STATS_AUTO_RECALC = 57882
+const STATS_PERSISTENT = 57883
+const STATS_SAMPLE_PAGES = 57884
+const STORAGE = 57885
+const MEMORY = 57886
+const DISK = 57887
+const PARTITIONS = 57888
+const LINEAR = 57889
+const RANGE = 57890
+const LIST = 57891
+const SUBPARTITION = 57892
+const SUBPARTITIONS = 57893
+const HASH = 57894
 
 var yyToknames = [...]string{
 	"$end",
@@ -637,6 +643,8 @@ var yyToknames = [...]string{
 	"TERMINATED",
 	"ESCAPED",
 	"ENCLOSED",
+	"INFILE",
+	"CONCURRENT",
 	"DUMPFILE",
 	"CSV",
 	"HEADER",
@@ -707,6 +715,8 @@ var yyToknames = [...]string{
 	"LEADING",
 	"TRAILING",
 	"EMPTY_FROM_CLAUSE",
+	"ROWS",
+	"EMPTY_WINDOW_NAME",
 	"LOWER_THAN_CHARSET",
 	"CHARSET",
 	"UNIQUE",
@@ -1024,32 +1034,34 @@ var yyToknames = [...]string{
 	"EXPANSION",
 	"WITHOUT",
 	"VALIDATION",
+	"OVER",
+	"WINDOW",
+	"ROW",
+	"CURRENT",
+	"CUME_DIST",
+	"DENSE_RANK",
+	"FIRST_VALUE",
+	"LAG",
+	"LAST_VALUE",
+	"LEAD",
+	"NTH_VALUE",
+	"NTILE",
+	"PERCENT_RANK",
+	"RANK",
+	"ROW_NUMBER",
 	"UNUSED",
 	"ARRAY",
-	"CUME_DIST",
 	"DESCRIPTION",
-	"DENSE_RANK",
 	"EMPTY",
 	"EXCEPT",
-	"FIRST_VALUE",
 	"GROUPING",
 	"GROUPS",
 	"JSON_TABLE",
-	"LAG",
-	"LAST_VALUE",
 	"LATERAL",
-	"LEAD",
 	"MEMBER",
-	"NTH_VALUE",
-	"NTILE",
 	"OF",
-	"OVER",
-	"PERCENT_RANK",
-	"RANK",
 	"RECURSIVE",
-	"ROW_NUMBER",
 	"SYSTEM",
-	"WINDOW",
 	"ACTIVE",
 	"ADMIN",
 	"BUCKETS",
@@ -1165,2602 +1177,2103 @@ const yyInitialStackSize = 16
 //line yacctab:1
 var yyExca = [...]int{
 	-1, 0,
-	10, 47,
-	11, 47,
+	10, 78,
+	11, 78,
 	-2, 37,
 	-1, 1,
 	1, -1,
 	-2, 0,
 	-1, 47,
-	1, 140,
-	564, 140,
-	-2, 146,
+	1, 171,
+	570, 171,
+	-2, 177,
 	-1, 48,
-	119, 146,
-	159, 146,
-	315, 146,
-	-2, 447,
+	123, 177,
+	163, 177,
+	319, 177,
+	-2, 478,
 	-1, 55,
-	33, 626,
-	219, 626,
-	230, 626,
-	265, 640,
-	266, 640,
-	-2, 628,
+	33, 657,
+	223, 657,
+	234, 657,
+	269, 671,
+	270, 671,
+	-2, 659,
 	-1, 60,
-	221, 651,
-	-2, 649,
+	225, 682,
+	-2, 680,
 	-1, 114,
-	218, 1129,
-	-2, 119,
+	222, 1199,
+	-2, 150,
 	-1, 116,
-	1, 141,
-	564, 141,
-	-2, 146,
+	1, 172,
+	570, 172,
+	-2, 177,
 	-1, 126,
-	120, 350,
-	224, 350,
-	-2, 441,
+	124, 381,
+	228, 381,
+	-2, 472,
 	-1, 145,
-	119, 146,
-	159, 146,
-	315, 146,
-	-2, 456,
-	-1, 617,
-	203, 1150,
-	-2, 1146,
-	-1, 618,
-	203, 1151,
-	-2, 1147,
-	-1, 692,
-	57, 719,
-	-2, 734,
-	-1, 729,
-	135, 1517,
-	-2, 112,
-	-1, 730,
-	135, 1391,
-	-2, 113,
-	-1, 736,
-	135, 1446,
-	-2, 1123,
-	-1, 881,
-	135, 1322,
-	-2, 1120,
-	-1, 919,
-	229, 41,
-	234, 41,
-	-2, 361,
-	-1, 996,
-	1, 495,
-	564, 495,
-	-2, 146,
-	-1, 1201,
-	57, 720,
-	-2, 739,
-	-1, 1202,
-	57, 721,
-	-2, 740,
-	-1, 1254,
-	119, 146,
-	159, 146,
-	315, 146,
-	-2, 391,
-	-1, 1331,
-	120, 350,
-	224, 350,
-	-2, 441,
-	-1, 1340,
-	229, 42,
-	234, 42,
-	-2, 362,
-	-1, 1599,
-	203, 1155,
-	-2, 1149,
-	-1, 1680,
-	119, 146,
-	159, 146,
-	315, 146,
+	123, 177,
+	163, 177,
+	319, 177,
+	-2, 487,
+	-1, 633,
+	207, 1220,
+	-2, 1216,
+	-1, 634,
+	207, 1221,
+	-2, 1217,
+	-1, 708,
+	59, 750,
+	-2, 765,
+	-1, 745,
+	139, 1592,
+	-2, 143,
+	-1, 746,
+	139, 1465,
+	-2, 144,
+	-1, 752,
+	139, 1520,
+	-2, 1193,
+	-1, 899,
+	139, 1394,
+	-2, 1190,
+	-1, 937,
+	233, 41,
+	238, 41,
 	-2, 392,
-	-1, 1687,
-	23, 165,
-	-2, 167,
-	-1, 1885,
-	84, 39,
-	-2, 774,
-	-1, 1935,
-	75, 94,
-	84, 94,
-	-2, 794,
-	-1, 2107,
-	47, 1091,
-	-2, 1085,
-	-1, 2276,
-	84, 39,
-	-2, 775,
-	-1, 2314,
-	5, 53,
-	16, 53,
-	18, 53,
-	85, 53,
-	-2, 767,
+	-1, 1014,
+	1, 526,
+	570, 526,
+	-2, 177,
+	-1, 1234,
+	59, 751,
+	-2, 770,
+	-1, 1235,
+	59, 752,
+	-2, 771,
+	-1, 1287,
+	123, 177,
+	163, 177,
+	319, 177,
+	-2, 422,
+	-1, 1364,
+	124, 381,
+	228, 381,
+	-2, 472,
+	-1, 1373,
+	233, 42,
+	238, 42,
+	-2, 393,
+	-1, 1644,
+	207, 1225,
+	-2, 1219,
+	-1, 1728,
+	123, 177,
+	163, 177,
+	319, 177,
+	-2, 423,
+	-1, 1735,
+	23, 196,
+	-2, 198,
+	-1, 1947,
+	86, 39,
+	-2, 805,
+	-1, 1997,
+	77, 125,
+	86, 125,
+	-2, 825,
+	-1, 2179,
+	47, 1161,
+	-2, 1155,
+	-1, 2355,
+	86, 39,
+	-2, 806,
+	-1, 2393,
+	5, 84,
+	16, 84,
+	18, 84,
+	87, 84,
+	-2, 798,
+	-1, 2713,
+	47, 1161,
+	-2, 57,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 36451
+const yyLast = 39608
 
 var yyAct = [...]int{
-	617, 2572, 1634, 2373, 2543, 2228, 2529, 2456, 2158, 2578,
-	2165, 3, 2118, 611, 37, 1180, 2199, 96, 1060, 685,
-	2399, 2121, 2167, 1740, 2470, 707, 1873, 2210, 565, 2122,
-	2211, 1216, 2119, 2285, 2279, 2404, 1638, 569, 1613, 620,
-	612, 1653, 2116, 2305, 2108, 591, 1908, 562, 609, 1931,
-	610, 182, 2213, 1900, 182, 1710, 529, 182, 1008, 1967,
-	2271, 2037, 545, 1996, 182, 1730, 563, 708, 1666, 1715,
-	884, 1969, 182, 561, 1968, 154, 36, 38, 734, 1920,
-	1658, 1203, 1875, 557, 927, 1749, 1892, 182, 1536, 1677,
-	1543, 689, 949, 693, 687, 1593, 1657, 2053, 1495, 140,
-	1729, 1782, 914, 1717, 1961, 95, 1246, 1338, 1937, 545,
-	1225, 710, 545, 182, 545, 909, 574, 1615, 1660, 1446,
-	1142, 1513, 91, 1183, 1079, 1555, 1442, 731, 1345, 891,
-	892, 1727, 920, 1706, 915, 888, 1245, 916, 1243, 1229,
-	699, 1330, 1428, 1306, 1058, 1645, 917, 1053, 721, 1451,
-	697, 123, 124, 76, 552, 97, 694, 695, 157, 1149,
-	98, 992, 1145, 1639, 2501, 1989, 89, 2579, 1354, 1742,
-	1743, 1744, 1742, 2196, 117, 2016, 2015, 1780, 85, 1987,
-	118, 1311, 696, 77, 8, 1606, 7, 6, 2045, 2046,
-	125, 1610, 1611, 715, 1502, 720, 1414, 184, 185, 186,
-	1501, 1596, 1500, 1499, 1498, 1497, 119, 1484, 555, 1489,
-	556, 2557, 931, 701, 885, 1871, 2104, 2182, 1902, 502,
-	553, 954, 90, 895, 2338, 2452, 900, 2451, 953, 952,
-	2366, 688, 686, 2367, 1037, 951, 78, 2588, 962, 80,
-	2539, 2582, 78, 2512, 2538, 1080, 2571, 728, 965, 966,
-	78, 969, 970, 971, 972, 2374, 78, 975, 976, 977,
-	978, 979, 980, 981, 982, 983, 984, 985, 986, 987,
-	988, 989, 735, 102, 930, 119, 709, 702, 1722, 1820,
-	1911, 2530, 1768, 907, 2511, 2052, 1080, 2261, 1320, 2155,
-	2156, 906, 178, 955, 956, 957, 2024, 905, 1872, 2417,
-	2023, 2154, 1720, 1992, 1044, 1912, 1046, 1672, 1673, 184,
-	185, 186, 2044, 87, 104, 105, 120, 108, 142, 87,
-	114, 967, 1946, 179, 1817, 1945, 497, 87, 1947, 162,
-	1247, 1027, 1248, 87, 1671, 904, 1612, 1001, 1002, 119,
-	1056, 679, 532, 1818, 1043, 1045, 681, 682, 683, 684,
-	1090, 678, 692, 899, 1028, 1958, 901, 1015, 1032, 1033,
-	152, 1015, 1016, 1021, 1990, 141, 1016, 1690, 1689, 1004,
-	995, 2079, 2230, 532, 1014, 2282, 1013, 2252, 2250, 1488,
-	723, 724, 543, 519, 547, 541, 159, 1187, 160, 1111,
-	532, 1090, 1404, 902, 1332, 1333, 151, 150, 177, 1997,
-	1750, 1490, 1491, 1492, 1783, 532, 991, 2019, 532, 1434,
-	2461, 1112, 1113, 1114, 1115, 1116, 1117, 1118, 1120, 1119,
-	1121, 1122, 1794, 1791, 1793, 1792, 2581, 1788, 1429, 518,
-	968, 1719, 1050, 1029, 1036, 1041, 1405, 2223, 1406, 1042,
-	1055, 516, 1022, 1086, 997, 2224, 1078, 1034, 908, 1047,
-	904, 2231, 896, 2032, 1796, 1799, 1797, 1035, 1798, 898,
-	897, 1030, 1031, 974, 2558, 1787, 973, 2232, 1785, 2359,
-	910, 1040, 1753, 2207, 911, 87, 938, 936, 1654, 911,
-	947, 513, 1789, 946, 1086, 945, 944, 2171, 943, 942,
-	527, 941, 940, 935, 146, 1334, 153, 182, 1331, 182,
-	147, 148, 182, 903, 1190, 524, 163, 1786, 902, 904,
-	990, 1323, 948, 889, 1818, 168, 1123, 889, 923, 2569,
-	1048, 887, 1123, 2080, 2576, 2036, 2583, 889, 1344, 922,
-	545, 545, 545, 1025, 1443, 1876, 1878, 1728, 533, 722,
-	1123, 2033, 1774, 1439, 1066, 958, 2491, 2189, 545, 545,
-	964, 2018, 1318, 1317, 1316, 2008, 1440, 2181, 1314, 929,
-	501, 1072, 1011, 37, 1017, 1018, 1019, 1020, 503, 533,
-	505, 520, 496, 535, 994, 534, 509, 2319, 507, 511,
-	521, 512, 1770, 506, 2206, 517, 533, 1057, 508, 522,
-	523, 525, 539, 538, 526, 2031, 515, 536, 2030, 2048,
-	2021, 533, 1435, 2301, 533, 1831, 2500, 1988, 1942, 1085,
-	1082, 1083, 1084, 1089, 1091, 1088, 1907, 1087, 903, 939,
-	937, 1863, 1640, 1641, 1081, 1605, 155, 1991, 1124, 1125,
-	1233, 1433, 1343, 1721, 1160, 1049, 1006, 1126, 1127, 1128,
-	1129, 2510, 1178, 81, 2283, 2448, 1678, 1134, 929, 1137,
-	1085, 1082, 1083, 1084, 1089, 1091, 1088, 928, 1087, 1122,
-	86, 2153, 2039, 993, 1173, 1081, 86, 2038, 929, 704,
-	116, 1054, 1518, 2039, 86, 1062, 1063, 903, 2038, 182,
-	86, 929, 1010, 545, 545, 1877, 1519, 1520, 1517, 1819,
-	184, 185, 186, 1130, 1538, 2462, 111, 2506, 929, 182,
-	1193, 149, 1038, 1452, 1197, 1179, 687, 1196, 1194, 2355,
-	689, 1191, 1416, 1415, 1417, 1418, 1419, 143, 545, 1024,
-	144, 1000, 182, 1012, 1003, 2295, 2574, 545, 950, 2575,
-	1026, 2573, 77, 545, 1075, 1784, 1073, 1074, 1117, 1118,
-	1120, 1119, 1121, 1122, 537, 731, 928, 1436, 963, 929,
-	1249, 1076, 2072, 1147, 1151, 1148, 112, 1195, 1051, 1980,
-	1769, 95, 530, 1430, 1539, 1431, 928, 1179, 1432, 1556,
-	1095, 932, 922, 1166, 1167, 1168, 1169, 531, 1556, 928,
-	1845, 933, 1094, 1095, 2413, 922, 925, 926, 1093, 889,
-	1094, 1095, 2330, 919, 923, 2329, 928, 1184, 1757, 1353,
-	1209, 934, 922, 925, 926, 1352, 889, 1342, 1858, 1767,
-	919, 923, 1009, 1765, 918, 1584, 98, 1508, 1510, 1511,
-	2258, 156, 161, 158, 164, 165, 166, 167, 169, 170,
-	171, 172, 1181, 938, 1762, 1039, 1453, 173, 174, 175,
-	176, 1509, 2549, 936, 686, 1244, 2547, 928, 2565, 1215,
-	688, 996, 932, 922, 2517, 2551, 2552, 1093, 2320, 1094,
-	1095, 1766, 933, 2169, 2170, 1192, 2074, 1762, 2584, 87,
-	2548, 2586, 1239, 1240, 1646, 1647, 1823, 1824, 1825, 2161,
-	1212, 1210, 1234, 1516, 182, 1210, 2518, 2443, 1307, 2567,
-	735, 1223, 2256, 1210, 1764, 2389, 2566, 1315, 1576, 1565,
-	1566, 1567, 1568, 1578, 1569, 1570, 1571, 1583, 1579, 1572,
-	1573, 1580, 1581, 1582, 1574, 1575, 1577, 2388, 545, 1093,
-	1340, 1094, 1095, 1093, 2162, 1094, 1095, 1093, 1349, 1094,
-	1095, 1093, 1351, 1094, 1095, 545, 545, 2484, 545, 2337,
-	545, 545, 2585, 545, 545, 545, 545, 545, 545, 2164,
-	631, 632, 633, 2159, 726, 2488, 1222, 1841, 545, 1210,
-	2336, 1423, 182, 1387, 1421, 1198, 1560, 2168, 1350, 2485,
-	2169, 2170, 1093, 1411, 1094, 1095, 2197, 2160, 182, 2171,
-	1115, 1116, 1117, 1118, 1120, 1119, 1121, 1122, 1336, 545,
-	2187, 182, 1965, 1382, 1383, 1093, 1964, 1094, 1095, 1725,
-	1321, 1322, 1441, 1424, 1409, 545, 1408, 182, 1407, 2166,
-	1346, 1346, 1398, 1329, 1099, 1100, 1101, 1102, 1103, 1104,
-	1105, 1097, 1392, 182, 1422, 1836, 1389, 1420, 1384, 1840,
-	182, 1388, 1363, 1219, 1835, 1348, 1410, 2487, 2486, 182,
-	182, 182, 182, 182, 182, 182, 182, 182, 545, 545,
-	545, 2412, 2410, 2385, 1326, 1347, 1313, 2334, 2326, 2497,
-	1390, 1391, 1974, 1339, 1327, 1325, 1396, 1397, 1093, 1883,
-	1094, 1095, 1882, 1093, 2168, 1094, 1095, 1962, 2264, 182,
-	1456, 1778, 1220, 1777, 2227, 1448, 2171, 1460, 1210, 1462,
-	1463, 1464, 1465, 2441, 1637, 1400, 1469, 1093, 1065, 1094,
-	1095, 2356, 1619, 1541, 1356, 1540, 1357, 2263, 1359, 1361,
-	1483, 1485, 1365, 1367, 1369, 1371, 1373, 1093, 1449, 1094,
-	1095, 1412, 1385, 1399, 1537, 1395, 1093, 1853, 1094, 1095,
-	1444, 1093, 1834, 1094, 1095, 1394, 1393, 1546, 545, 1093,
-	1221, 1094, 1095, 1052, 1898, 2580, 1093, 1514, 1094, 1095,
-	184, 185, 186, 545, 545, 119, 1521, 1210, 1523, 1524,
-	1525, 1526, 1527, 1528, 1529, 1530, 1531, 1532, 1533, 1534,
-	1535, 906, 1512, 1458, 1319, 1894, 1522, 905, 2469, 1597,
-	2468, 1111, 1557, 2047, 2437, 92, 182, 2436, 101, 2163,
-	94, 545, 1479, 1480, 1481, 2372, 93, 1998, 1482, 100,
-	1977, 99, 1618, 1112, 1113, 1114, 1115, 1116, 1117, 1118,
-	1120, 1119, 1121, 1122, 92, 182, 1898, 2536, 545, 1909,
-	1624, 94, 1625, 1515, 1763, 93, 1966, 1093, 182, 1094,
-	1095, 545, 1093, 2555, 1094, 1095, 182, 1686, 182, 1599,
-	182, 182, 545, 1898, 2523, 545, 95, 1832, 1454, 1455,
-	1210, 597, 1597, 184, 185, 186, 545, 2327, 1601, 1602,
-	731, 2294, 1459, 731, 1093, 95, 1094, 1095, 1210, 1466,
-	1467, 1468, 1111, 1898, 2521, 1107, 94, 1108, 2526, 1210,
-	1598, 1762, 184, 185, 186, 1630, 1949, 2513, 1210, 1656,
-	1917, 1109, 1110, 1106, 1112, 1113, 1114, 1115, 1116, 1117,
-	1118, 1120, 1119, 1121, 1122, 1696, 1697, 1698, 1699, 1898,
-	2502, 545, 1599, 2364, 2499, 1898, 2444, 1731, 1732, 1733,
-	2364, 1210, 1735, 1737, 1898, 2362, 1682, 184, 185, 186,
-	1681, 1738, 1938, 1542, 1210, 701, 545, 1762, 1210, 1664,
-	1548, 1549, 545, 1349, 2299, 1210, 1349, 1687, 1349, 2179,
-	2178, 2175, 2176, 1651, 1761, 1632, 2175, 2174, 1685, 1917,
-	1210, 1712, 1909, 1600, 1832, 1210, 1603, 1604, 1751, 2296,
-	1649, 1092, 184, 185, 186, 1718, 1736, 1818, 2017, 1669,
-	1668, 1310, 2002, 2505, 545, 2148, 1537, 1994, 1995, 1898,
-	1684, 1537, 1537, 1683, 1818, 1939, 1917, 1938, 1898, 1897,
-	1092, 1210, 1629, 2177, 1941, 735, 1748, 1916, 735, 1113,
-	1114, 1115, 1116, 1117, 1118, 1120, 1119, 1121, 1122, 931,
-	1310, 1309, 1255, 1254, 100, 2117, 2092, 182, 1670, 1346,
-	1832, 1850, 2339, 2294, 182, 2294, 1708, 1709, 1849, 182,
-	182, 1723, 1713, 182, 1691, 182, 1692, 1693, 1694, 1695,
-	1756, 1734, 182, 1759, 1771, 1760, 1726, 1724, 1762, 182,
-	1939, 1917, 1702, 1703, 1704, 1705, 1745, 1644, 1214, 1818,
-	1772, 1755, 1754, 1758, 1773, 1713, 1111, 1608, 1830, 1775,
-	1776, 930, 2340, 2341, 2342, 1493, 1438, 182, 545, 1241,
-	913, 912, 1832, 87, 2458, 1211, 1213, 1217, 1112, 1113,
-	1114, 1115, 1116, 1117, 1118, 1120, 1119, 1121, 1122, 101,
-	1378, 691, 2229, 1809, 1810, 2434, 2424, 2354, 1812, 2351,
-	100, 1111, 99, 2332, 2267, 1781, 2266, 1813, 1312, 1711,
-	2225, 94, 2202, 613, 2198, 592, 594, 614, 615, 618,
-	590, 593, 616, 1112, 1113, 1114, 1115, 1116, 1117, 1118,
-	1120, 1119, 1121, 1122, 2003, 1707, 2054, 1701, 1514, 1700,
-	1379, 1380, 1381, 1426, 2200, 1341, 1827, 1802, 1829, 595,
-	596, 2430, 2431, 2432, 2433, 1112, 1113, 1114, 1115, 1116,
-	1117, 1118, 1120, 1119, 1121, 1122, 1828, 2459, 87, 1210,
-	183, 1337, 1308, 183, 113, 2343, 183, 1971, 1970, 1375,
-	995, 546, 1722, 183, 2306, 2307, 1622, 2562, 2544, 182,
-	2309, 183, 2194, 2193, 2192, 2117, 1981, 182, 1816, 1803,
-	1486, 2312, 2139, 545, 1869, 1886, 183, 2140, 2137, 545,
-	1628, 2300, 2311, 2138, 1515, 2136, 2135, 2056, 2560, 1826,
-	545, 2537, 2344, 2345, 1636, 1971, 1376, 1377, 546, 2097,
-	1218, 546, 183, 546, 1922, 1925, 1926, 1927, 1923, 2096,
-	1924, 1928, 2000, 182, 182, 1913, 2141, 37, 1926, 1927,
-	2394, 2483, 2393, 2403, 1899, 2287, 1933, 2405, 2290, 1948,
-	1844, 2109, 2111, 2286, 1599, 1922, 1925, 1926, 1927, 1923,
-	2112, 1924, 1928, 2106, 1437, 2306, 2307, 705, 2173, 2066,
-	2065, 2064, 2058, 677, 2062, 706, 2057, 1956, 2055, 1856,
-	1975, 960, 1895, 2060, 959, 2239, 1970, 1552, 2042, 1064,
-	2392, 2010, 2059, 545, 2292, 1598, 2009, 1184, 182, 1959,
-	1960, 1553, 1870, 92, 1880, 182, 120, 2061, 2063, 1932,
-	94, 1646, 1647, 2190, 93, 94, 92, 545, 1806, 1993,
-	1896, 2498, 1891, 1842, 545, 1906, 1952, 93, 1349, 1349,
-	2454, 2172, 1936, 545, 1930, 1633, 713, 714, 2095, 1208,
-	1204, 1795, 2272, 1822, 1940, 2014, 2094, 1943, 99, 2475,
-	101, 101, 1718, 2474, 1205, 1953, 182, 182, 182, 182,
-	182, 100, 100, 99, 99, 1950, 1860, 1861, 2411, 1208,
-	1204, 2409, 94, 182, 182, 2408, 1963, 2401, 2352, 1626,
-	1627, 1207, 1973, 1206, 1205, 2291, 2289, 2203, 1746, 182,
-	1972, 1324, 712, 100, 101, 2400, 2280, 1909, 1978, 1894,
-	2564, 2563, 1982, 1983, 1984, 100, 2012, 1537, 2081, 1201,
-	1202, 1207, 1851, 1206, 1620, 1329, 1235, 1227, 106, 107,
-	2564, 2489, 2325, 703, 103, 88, 1, 2011, 626, 2546,
-	687, 514, 2071, 2013, 545, 2004, 2005, 1609, 1182, 528,
-	2542, 1413, 1403, 2375, 2455, 1999, 1752, 2350, 1716, 921,
-	545, 145, 2086, 1679, 602, 1680, 2532, 110, 882, 109,
-	924, 1023, 1747, 2365, 1957, 182, 1688, 1261, 2050, 545,
-	1259, 2034, 1260, 1258, 2049, 1263, 1262, 1257, 545, 1852,
-	1487, 542, 1929, 180, 1250, 545, 545, 1228, 182, 182,
-	182, 182, 182, 2051, 961, 2068, 2086, 504, 693, 2129,
-	182, 2180, 1779, 2067, 2114, 182, 182, 510, 182, 2099,
-	1135, 182, 182, 182, 2100, 2093, 544, 1558, 1197, 2120,
-	1944, 1559, 732, 2123, 2120, 725, 1621, 2087, 2085, 1884,
-	2125, 2088, 2089, 2090, 2284, 2105, 2107, 1901, 2110, 2103,
-	2482, 2402, 2524, 1954, 2147, 1224, 2188, 2101, 2098, 1843,
-	1211, 1607, 182, 1554, 1661, 1617, 1507, 2149, 567, 566,
-	2150, 694, 695, 733, 564, 545, 886, 1887, 893, 2131,
-	2132, 95, 2134, 1910, 545, 1098, 2209, 621, 2142, 182,
-	2151, 2146, 1874, 1631, 2130, 1236, 1921, 2133, 1919, 182,
-	1918, 1448, 1804, 1665, 2308, 2304, 1659, 2157, 1893, 575,
-	568, 560, 619, 2322, 182, 2205, 183, 182, 183, 2215,
-	2183, 183, 2020, 2040, 2184, 2226, 2041, 2240, 2091, 2022,
-	1955, 2222, 1077, 1200, 554, 894, 717, 1551, 2460, 2217,
-	2446, 2216, 1821, 2185, 2186, 2204, 2260, 1199, 1718, 546,
-	546, 546, 2208, 1563, 1564, 2220, 2195, 1739, 63, 41,
-	1588, 549, 2556, 1068, 2128, 719, 32, 546, 546, 31,
-	30, 29, 28, 545, 182, 2235, 2234, 23, 22, 2237,
-	2238, 21, 20, 19, 25, 18, 17, 16, 115, 50,
-	47, 45, 122, 121, 2248, 2242, 48, 44, 998, 42,
-	27, 2241, 26, 15, 558, 14, 13, 12, 11, 10,
-	9, 5, 4, 35, 34, 33, 1071, 24, 2, 1986,
-	1741, 0, 0, 0, 0, 2278, 0, 0, 0, 182,
-	2273, 2274, 2288, 0, 0, 0, 711, 2281, 0, 0,
-	0, 2293, 0, 0, 0, 0, 0, 2328, 0, 0,
-	0, 0, 182, 0, 0, 2313, 2303, 2310, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2315,
-	182, 0, 0, 182, 182, 182, 2317, 2318, 2316, 0,
-	0, 0, 0, 545, 545, 2357, 2358, 2323, 0, 0,
-	2217, 2333, 2216, 2335, 2324, 0, 0, 0, 183, 0,
-	0, 0, 546, 546, 0, 0, 0, 0, 0, 0,
-	545, 545, 545, 545, 0, 0, 2245, 2246, 183, 2247,
-	0, 0, 2249, 0, 2251, 0, 2253, 0, 2360, 0,
-	0, 0, 0, 2371, 0, 0, 0, 546, 2369, 2370,
-	0, 183, 0, 0, 0, 0, 546, 0, 0, 0,
-	0, 0, 546, 0, 0, 0, 182, 0, 0, 0,
-	0, 0, 0, 2381, 0, 0, 2384, 0, 0, 0,
-	0, 2380, 0, 0, 0, 0, 0, 0, 0, 0,
-	545, 0, 545, 0, 0, 0, 0, 0, 0, 0,
-	2418, 0, 37, 2398, 2397, 0, 0, 0, 2407, 2406,
-	0, 687, 2420, 2422, 0, 0, 2416, 2414, 0, 0,
-	0, 0, 2120, 0, 0, 0, 0, 2123, 0, 0,
-	0, 2123, 0, 1833, 0, 0, 0, 1837, 0, 1838,
-	1839, 0, 0, 0, 2429, 545, 0, 2442, 1847, 0,
-	0, 1848, 2426, 2427, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 545, 2440, 0, 2438, 2439,
-	0, 0, 0, 0, 0, 2449, 0, 1854, 1855, 0,
-	1857, 0, 545, 2457, 1859, 2450, 545, 545, 0, 0,
-	2445, 1864, 1865, 1866, 1867, 1868, 0, 1631, 0, 0,
-	0, 0, 0, 0, 733, 733, 733, 2481, 1881, 2479,
-	2476, 2477, 0, 183, 2478, 545, 0, 2493, 0, 0,
-	0, 0, 1067, 1069, 0, 545, 0, 2496, 0, 2490,
-	0, 0, 0, 0, 687, 0, 2494, 0, 2492, 0,
-	0, 0, 0, 0, 0, 0, 0, 546, 2123, 545,
-	182, 2507, 0, 0, 0, 0, 2504, 0, 37, 0,
-	0, 0, 0, 0, 546, 546, 0, 546, 0, 546,
-	546, 0, 546, 546, 546, 546, 546, 546, 0, 0,
-	0, 0, 545, 0, 0, 0, 0, 546, 0, 0,
-	0, 183, 2519, 0, 0, 0, 545, 545, 0, 0,
-	2525, 1176, 37, 2531, 545, 0, 0, 183, 2527, 0,
-	2522, 0, 0, 0, 2457, 2533, 0, 2120, 546, 0,
-	183, 0, 2553, 2545, 0, 2550, 0, 0, 0, 0,
-	0, 0, 2559, 0, 546, 0, 183, 2561, 0, 0,
-	0, 0, 0, 0, 0, 0, 545, 0, 2568, 0,
-	0, 0, 183, 2570, 0, 0, 2577, 1188, 1189, 183,
-	0, 0, 0, 0, 0, 0, 0, 0, 183, 183,
-	183, 183, 183, 183, 183, 183, 183, 546, 546, 546,
-	2587, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1231, 0, 0, 0, 0, 0, 0, 0,
-	0, 733, 0, 0, 0, 0, 1096, 1251, 183, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1143, 0, 0, 0, 0, 0,
-	0, 0, 0, 2078, 0, 0, 0, 0, 0, 0,
-	0, 2083, 2084, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 546, 0, 0,
-	0, 0, 0, 0, 0, 558, 0, 0, 0, 0,
-	0, 0, 546, 546, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 2126, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 183, 0, 0, 0, 0,
-	546, 0, 2144, 2145, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 183, 0, 0, 546, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 183, 0, 1226,
-	546, 0, 0, 0, 0, 183, 0, 183, 0, 183,
-	183, 546, 0, 0, 546, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 546, 0, 0, 0, 0,
-	0, 0, 886, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1176, 0, 0, 0, 1355,
-	1355, 0, 1355, 0, 1355, 1355, 0, 1364, 1355, 1355,
-	1355, 1355, 1355, 0, 0, 0, 0, 0, 0, 0,
-	1176, 1176, 886, 0, 0, 0, 0, 0, 178, 0,
-	546, 0, 0, 0, 2244, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2254, 2255,
-	2257, 2259, 120, 1425, 142, 546, 0, 0, 2265, 0,
-	0, 546, 0, 2269, 0, 162, 2270, 0, 0, 1445,
-	0, 0, 2275, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 152, 0, 0, 0,
-	0, 141, 0, 546, 0, 2297, 2298, 0, 0, 2302,
-	0, 0, 733, 733, 733, 0, 0, 0, 0, 0,
-	0, 0, 159, 0, 160, 0, 0, 2314, 0, 0,
-	129, 130, 151, 150, 177, 0, 0, 0, 0, 0,
+	633, 2641, 636, 2699, 2335, 1964, 2190, 2182, 2655, 1682,
+	2452, 1686, 2705, 2616, 2300, 2630, 1701, 2230, 1210, 723,
+	2237, 2567, 701, 2540, 2483, 1078, 1935, 628, 2193, 2283,
+	2557, 1788, 565, 2239, 3, 2271, 2282, 569, 1249, 2194,
+	573, 1970, 2191, 2488, 627, 37, 2364, 1661, 1897, 625,
+	1993, 182, 626, 2358, 182, 607, 532, 182, 2285, 2188,
+	2180, 1962, 548, 2384, 182, 2350, 1026, 566, 2058, 1758,
+	564, 2099, 182, 2029, 1763, 1778, 2030, 902, 1714, 2031,
+	154, 36, 1982, 38, 945, 967, 560, 182, 724, 703,
+	1344, 1236, 1954, 1937, 1569, 1706, 750, 1797, 1371, 140,
+	1705, 1725, 1638, 2115, 1830, 1576, 1777, 1765, 2023, 548,
+	932, 927, 548, 182, 548, 1999, 578, 1258, 91, 1546,
+	1528, 1708, 705, 1279, 709, 1387, 95, 96, 1663, 1216,
+	1479, 1589, 1161, 1097, 747, 1475, 909, 938, 935, 1461,
+	1775, 906, 726, 1693, 933, 910, 1754, 1378, 934, 1262,
+	1278, 1071, 555, 1055, 715, 1484, 98, 1339, 737, 1276,
+	1687, 710, 117, 711, 118, 157, 713, 1076, 1363, 123,
+	124, 76, 1010, 85, 1179, 1641, 2590, 1164, 1651, 2051,
+	89, 2706, 1790, 1791, 1792, 2604, 2268, 1790, 97, 2078,
+	2077, 1828, 2049, 1098, 1447, 712, 77, 8, 184, 185,
+	186, 7, 6, 2107, 2108, 184, 185, 186, 731, 717,
+	736, 1535, 949, 119, 1534, 90, 505, 125, 556, 1658,
+	1659, 1533, 903, 1532, 1531, 1530, 972, 1517, 969, 558,
+	1212, 559, 1522, 535, 1098, 2669, 1933, 1654, 980, 2176,
+	918, 983, 984, 913, 987, 988, 989, 990, 2678, 704,
+	993, 994, 995, 996, 997, 998, 999, 1000, 1001, 1002,
+	1003, 1004, 1005, 1006, 1007, 702, 948, 1214, 744, 751,
+	718, 725, 2254, 2677, 522, 102, 2676, 2417, 2674, 924,
+	925, 923, 119, 2568, 1898, 973, 974, 975, 647, 648,
+	649, 2536, 2647, 2535, 971, 970, 2445, 178, 1108, 2446,
+	1770, 2675, 647, 648, 649, 78, 2647, 78, 2720, 2640,
+	1868, 78, 2709, 2601, 2693, 2453, 104, 105, 985, 108,
+	521, 120, 114, 2631, 1768, 179, 1816, 2642, 500, 1973,
+	2639, 2600, 519, 2333, 162, 2114, 1353, 2349, 2008, 1108,
+	613, 2007, 78, 2086, 2009, 80, 119, 2085, 697, 698,
+	699, 700, 2227, 2228, 708, 1280, 1974, 1281, 2501, 1720,
+	1721, 1934, 1062, 2226, 1064, 2106, 1660, 1865, 1719, 1050,
+	1051, 1045, 516, 1074, 695, 694, 1866, 922, 2013, 1019,
+	1020, 530, 739, 740, 87, 2020, 87, 1033, 2052, 535,
+	87, 1104, 1034, 159, 1096, 160, 527, 2149, 1046, 1039,
+	2648, 1013, 1061, 1063, 2361, 177, 1738, 1737, 2324, 2322,
+	1033, 1022, 2302, 546, 2648, 1034, 1129, 535, 1521, 550,
+	1009, 87, 1467, 1032, 544, 1031, 1523, 1524, 1525, 536,
+	1220, 2059, 1104, 917, 1798, 920, 919, 2545, 1130, 1131,
+	1132, 1133, 1134, 1135, 1136, 1138, 1137, 1139, 1140, 2081,
+	535, 1836, 535, 1831, 2708, 1767, 1462, 986, 1052, 506,
+	1068, 508, 523, 1015, 538, 1054, 537, 512, 1053, 510,
+	514, 524, 515, 1073, 509, 1847, 520, 1047, 1040, 511,
+	525, 526, 528, 542, 541, 529, 2295, 518, 539, 2094,
+	2670, 2303, 926, 1059, 2296, 1048, 1049, 1060, 992, 1835,
+	182, 991, 182, 2304, 1437, 182, 1837, 1065, 1842, 1839,
+	1841, 1840, 1833, 1030, 1844, 163, 1845, 2438, 1846, 1702,
+	1801, 956, 87, 1066, 168, 2467, 954, 2279, 2348, 1058,
+	922, 929, 914, 548, 548, 548, 965, 964, 963, 916,
+	915, 1834, 922, 1008, 962, 921, 1223, 961, 1438, 2150,
+	1439, 548, 548, 960, 928, 959, 958, 1356, 929, 1103,
+	1100, 1101, 1102, 1107, 1109, 1106, 953, 1105, 966, 2703,
+	2715, 2243, 2691, 1029, 1099, 1035, 1036, 1037, 1038, 907,
+	947, 1866, 2098, 907, 941, 536, 1141, 1090, 920, 1141,
+	907, 940, 1476, 1776, 905, 2722, 738, 37, 1075, 1043,
+	1103, 1100, 1101, 1102, 1107, 1109, 1106, 1012, 1105, 2095,
+	1822, 1938, 1940, 536, 2253, 1099, 1472, 1468, 1084, 976,
+	2589, 1377, 2261, 2050, 629, 947, 608, 610, 630, 631,
+	2605, 606, 609, 632, 2080, 155, 2643, 540, 2278, 1351,
+	1350, 1349, 1144, 1145, 1146, 1147, 536, 1067, 536, 2083,
+	2643, 2556, 1152, 2053, 1155, 533, 2070, 1769, 947, 1056,
+	611, 612, 2514, 2515, 2516, 2517, 957, 1688, 1689, 2110,
+	534, 955, 1879, 1473, 1347, 2362, 2580, 982, 946, 947,
+	1069, 504, 499, 1203, 940, 943, 944, 1466, 907, 1208,
+	2599, 1818, 937, 941, 2532, 182, 1011, 1148, 921, 548,
+	548, 1080, 1081, 2093, 116, 947, 2092, 1142, 1143, 2398,
+	921, 2380, 2004, 936, 2644, 182, 1449, 1448, 1450, 1451,
+	1452, 1224, 2546, 946, 1867, 1376, 1209, 1227, 2644, 1969,
+	1924, 2101, 2101, 86, 548, 86, 2100, 2100, 182, 86,
+	1141, 1021, 1650, 548, 1018, 1266, 1213, 1226, 77, 548,
+	1093, 1230, 1190, 81, 1091, 1092, 946, 705, 1024, 1726,
+	1140, 950, 940, 1939, 2225, 720, 2116, 1485, 747, 1629,
+	86, 951, 1072, 1028, 2595, 2701, 2434, 946, 2702, 947,
+	2700, 111, 950, 940, 1177, 1181, 1178, 2374, 1209, 1042,
+	968, 952, 951, 2134, 1228, 1229, 1057, 1832, 95, 96,
+	1044, 1469, 1282, 946, 1196, 1197, 1198, 1199, 1094, 940,
+	943, 944, 1590, 907, 1894, 1551, 1217, 937, 941, 1590,
+	2330, 1463, 1111, 1464, 1112, 1113, 1465, 1914, 98, 1552,
+	1553, 1550, 156, 161, 158, 164, 165, 166, 167, 169,
+	170, 171, 172, 112, 2042, 1112, 1113, 2118, 173, 174,
+	175, 176, 1621, 1610, 1611, 1612, 1613, 1623, 1614, 1615,
+	1616, 1628, 1624, 1617, 1618, 1625, 1626, 1627, 1619, 1620,
+	1622, 2694, 1113, 1817, 2497, 1890, 1225, 946, 2340, 981,
+	2687, 1248, 2409, 2408, 704, 1014, 1815, 1243, 1111, 1245,
+	1112, 1113, 1805, 702, 1541, 1543, 1544, 2136, 1272, 1273,
+	1694, 1695, 182, 751, 1486, 1386, 1340, 1027, 1385, 2128,
+	2127, 2126, 2120, 1375, 2124, 1348, 2119, 1277, 2117, 1111,
+	1542, 1112, 1113, 2122, 184, 185, 186, 1111, 1571, 1112,
+	1113, 1111, 2121, 1112, 1113, 1813, 548, 956, 1373, 1135,
+	1136, 1138, 1137, 1139, 1140, 1810, 1382, 2123, 2125, 1889,
+	1384, 2739, 954, 548, 548, 1810, 548, 2399, 548, 548,
+	1267, 548, 548, 548, 548, 548, 548, 2618, 2729, 1882,
+	1243, 2661, 1814, 87, 2718, 2659, 548, 1605, 2328, 1243,
+	182, 1420, 1812, 1231, 2663, 2664, 2573, 1549, 2527, 1383,
+	1111, 2472, 1112, 1113, 2241, 2242, 182, 1884, 2716, 2619,
+	1572, 2660, 1111, 2689, 1112, 1113, 1883, 548, 2471, 182,
+	2751, 1354, 1355, 1369, 1415, 1416, 2416, 1456, 2574, 1111,
+	1474, 1112, 1113, 548, 2415, 182, 742, 2740, 1379, 1379,
+	2269, 1362, 1133, 1134, 1135, 1136, 1138, 1137, 1139, 1140,
+	2299, 182, 2259, 1111, 1381, 1112, 1113, 1111, 182, 1112,
+	1113, 2027, 2026, 1417, 1871, 1872, 1873, 182, 182, 182,
+	182, 182, 182, 182, 182, 182, 548, 548, 548, 2586,
+	1773, 1423, 1424, 1111, 2717, 1112, 1113, 1429, 1430, 1389,
+	1457, 1390, 1455, 1392, 1394, 1346, 1454, 1398, 1400, 1402,
+	1404, 1406, 1380, 1442, 1441, 1372, 1440, 182, 2240, 1359,
+	1360, 1358, 1431, 1129, 1425, 2109, 1489, 1256, 1422, 1111,
+	2243, 1112, 1113, 1493, 1919, 1495, 1496, 1497, 1498, 1421,
+	1396, 1481, 1502, 1444, 1433, 1130, 1131, 1132, 1133, 1134,
+	1135, 1136, 1138, 1137, 1139, 1140, 1516, 1418, 647, 648,
+	649, 2750, 1570, 1117, 1118, 1119, 1120, 1121, 1122, 1123,
+	1115, 1453, 1547, 2749, 1252, 1579, 548, 1477, 1243, 1554,
+	2745, 1556, 1557, 1558, 1559, 1560, 1561, 1562, 1563, 1564,
+	1565, 1566, 1567, 1568, 1255, 1945, 2743, 924, 1944, 923,
+	119, 2688, 2339, 548, 548, 1487, 1488, 1545, 1443, 1243,
+	2577, 1599, 1600, 2576, 1352, 1555, 1908, 1491, 1111, 1492,
+	1112, 1113, 2525, 2575, 1591, 1253, 1499, 1500, 1501, 2496,
+	2435, 2494, 2464, 2413, 2028, 1111, 1642, 1112, 1113, 182,
+	1515, 2405, 2158, 1111, 548, 1112, 1113, 2036, 2024, 1111,
+	1826, 1112, 1113, 1512, 1513, 1514, 1825, 1111, 1666, 1112,
+	1113, 1548, 1111, 1685, 1112, 1113, 2233, 1672, 182, 1673,
+	1111, 548, 1112, 1113, 1111, 1667, 1112, 1113, 184, 185,
+	186, 182, 2406, 1574, 548, 1573, 184, 185, 186, 182,
+	2011, 182, 1518, 182, 182, 548, 1482, 1445, 548, 184,
+	185, 186, 1432, 1786, 184, 185, 186, 1644, 1784, 548,
+	1428, 1427, 1642, 2234, 1426, 1646, 1647, 95, 96, 1254,
+	747, 1070, 1083, 747, 184, 185, 186, 2748, 1243, 1960,
+	2707, 1960, 2637, 1744, 1745, 1746, 1747, 1243, 2236, 95,
+	96, 1643, 2231, 1704, 2555, 1575, 92, 2554, 1678, 92,
+	1960, 2624, 1581, 1582, 1583, 94, 94, 93, 1960, 2622,
+	93, 2241, 2242, 2521, 548, 2520, 101, 2451, 2232, 2220,
+	1779, 1780, 1781, 2610, 1243, 1783, 1785, 100, 1866, 99,
+	2060, 101, 2000, 1644, 717, 1655, 1730, 1645, 1729, 548,
+	1648, 1649, 100, 2039, 99, 548, 1382, 1960, 2591, 1382,
+	2238, 1382, 1734, 94, 2443, 2588, 1739, 1809, 1740, 1741,
+	1742, 1743, 1735, 1243, 1712, 1243, 1243, 1699, 2373, 1733,
+	1799, 2000, 1680, 2652, 1750, 1751, 1752, 1753, 1760, 1677,
+	1960, 2528, 1110, 1697, 2443, 1243, 2375, 548, 1766, 1570,
+	1960, 2441, 1810, 1243, 1570, 1570, 1717, 2001, 1243, 2378,
+	1243, 2251, 2250, 1732, 1716, 751, 2003, 1731, 751, 2247,
+	2248, 2247, 2246, 1243, 1971, 2240, 1796, 1979, 1243, 1880,
+	1243, 1971, 949, 1866, 2079, 1343, 2064, 2243, 2056, 2057,
+	182, 2594, 1379, 2272, 1960, 1959, 2001, 182, 1110, 1243,
+	1343, 1342, 182, 182, 1804, 1866, 182, 1807, 182, 1808,
+	100, 1761, 1756, 1757, 1960, 182, 1774, 1979, 1772, 1771,
+	1288, 1287, 182, 1782, 1819, 1131, 1132, 1133, 1134, 1135,
+	1136, 1138, 1137, 1139, 1140, 1129, 948, 1878, 1956, 1978,
+	1802, 1803, 2189, 1821, 1761, 1820, 1129, 1979, 1823, 1824,
+	182, 548, 2373, 94, 2373, 1806, 1811, 1130, 1131, 1132,
+	1133, 1134, 1135, 1136, 1138, 1137, 1139, 1140, 1130, 1131,
+	1132, 1133, 1134, 1135, 1136, 1138, 1137, 1139, 1140, 2249,
+	1880, 2418, 2164, 1718, 1857, 1858, 1880, 707, 1911, 1860,
+	2235, 1910, 1810, 1793, 1692, 1979, 1247, 1656, 1861, 1129,
+	1526, 1471, 1125, 1274, 1126, 931, 930, 87, 1547, 2667,
+	1829, 1411, 1880, 2627, 1875, 1810, 1877, 2542, 1127, 1128,
+	1124, 1130, 1131, 1132, 1133, 1134, 1135, 1136, 1138, 1137,
+	1139, 1140, 1250, 2419, 2420, 2421, 2518, 2391, 2508, 1850,
+	2433, 2430, 2411, 2343, 2342, 1345, 1759, 2297, 2274, 2270,
+	1876, 1130, 1131, 1132, 1133, 1134, 1135, 1136, 1138, 1137,
+	1139, 1140, 2065, 1412, 1413, 1414, 87, 2422, 1755, 1749,
+	1748, 1459, 1374, 1899, 1900, 1901, 1902, 1370, 1341, 113,
+	2032, 634, 1984, 1987, 1988, 1989, 1985, 182, 1986, 1990,
+	1864, 2033, 2385, 2386, 1013, 182, 2301, 1548, 1408, 2543,
+	1874, 548, 2385, 2386, 1770, 1670, 1930, 548, 2684, 2656,
+	1948, 2388, 2266, 2265, 2379, 2264, 2423, 2424, 548, 1984,
+	1987, 1988, 1989, 1985, 2189, 1986, 1990, 1961, 2043, 2033,
+	1851, 1519, 183, 2390, 2211, 183, 2208, 2207, 183, 2212,
+	1251, 182, 182, 549, 2209, 183, 2672, 1409, 1410, 2210,
+	1893, 2638, 2213, 183, 1988, 1989, 1684, 2010, 2478, 1676,
+	2477, 2724, 2726, 2181, 2183, 2169, 1975, 2168, 183, 2062,
+	2731, 2366, 2184, 2572, 2184, 2487, 37, 1644, 1931, 2489,
+	2730, 2365, 2021, 2022, 2733, 1995, 2369, 1241, 1237, 721,
+	549, 1891, 2712, 549, 183, 549, 1917, 2178, 1470, 722,
+	1957, 548, 1238, 693, 2245, 2018, 182, 2037, 1217, 978,
+	2476, 1643, 977, 182, 2311, 2032, 1932, 2104, 2736, 1082,
+	2072, 1586, 1994, 1942, 2071, 548, 1953, 92, 1958, 1674,
+	1675, 1240, 548, 1239, 2055, 1587, 1382, 1382, 93, 2014,
+	1968, 548, 120, 1241, 1237, 1921, 1922, 2371, 2474, 92,
+	1694, 1695, 2262, 2076, 94, 1854, 94, 2653, 1238, 2002,
+	93, 2587, 1998, 2538, 182, 182, 182, 182, 182, 2012,
+	1766, 2015, 2005, 2244, 1992, 1681, 729, 730, 1843, 101,
+	2351, 182, 182, 2035, 1870, 1234, 1235, 1240, 2025, 1239,
+	100, 2167, 99, 101, 99, 2744, 100, 182, 2742, 2166,
+	2741, 94, 2034, 2734, 100, 1971, 99, 2732, 2562, 2040,
+	2561, 2551, 2495, 2493, 2492, 1570, 2044, 2045, 2046, 2074,
+	2485, 2431, 2370, 1362, 2368, 2275, 1794, 1357, 101, 548,
+	728, 2484, 2359, 1956, 2066, 2067, 2686, 2685, 103, 100,
+	2151, 1912, 1668, 1268, 2133, 1260, 2686, 2073, 106, 107,
+	2578, 2404, 719, 2075, 88, 548, 1, 642, 2658, 517,
+	1657, 1215, 531, 2654, 1446, 1436, 2454, 2539, 2061, 1800,
+	2429, 1764, 548, 939, 145, 1727, 1728, 2112, 2633, 2111,
+	2157, 110, 2139, 2140, 2141, 2142, 2143, 182, 900, 109,
+	942, 548, 1041, 1795, 2444, 2019, 1736, 1294, 1292, 2153,
+	548, 2096, 1293, 1291, 1296, 1295, 1290, 548, 548, 2130,
+	182, 182, 182, 182, 182, 2201, 2113, 2129, 1913, 1520,
+	2186, 545, 182, 1991, 180, 2157, 2171, 182, 182, 1283,
+	182, 1261, 2195, 182, 182, 182, 979, 2172, 507, 2252,
+	2102, 1827, 513, 2103, 2192, 1153, 2165, 2006, 748, 2192,
+	741, 709, 1669, 2160, 2161, 2162, 2156, 1946, 2197, 2363,
+	2159, 2177, 2179, 1963, 2175, 2571, 2486, 2625, 2260, 2016,
+	1257, 1230, 1892, 1588, 182, 2219, 2170, 1709, 1665, 2173,
+	2221, 1540, 2336, 2222, 2615, 2603, 2602, 548, 710, 2466,
+	711, 2645, 568, 571, 570, 567, 548, 2163, 1949, 2735,
+	2727, 182, 2737, 2214, 2721, 2281, 2203, 2204, 2218, 2206,
+	2202, 182, 2223, 2205, 95, 96, 1653, 2607, 2347, 1211,
+	2723, 2711, 2713, 2696, 2229, 1972, 182, 1116, 637, 182,
+	2277, 1481, 1936, 2200, 1269, 1983, 1981, 1980, 2256, 2312,
+	2255, 1852, 1713, 2387, 2383, 1707, 1955, 579, 2257, 2258,
+	572, 563, 2289, 635, 2401, 2288, 2287, 2082, 2298, 2084,
+	2017, 2294, 1095, 1233, 2276, 557, 912, 1585, 548, 2280,
+	1766, 2544, 2292, 2530, 1869, 2332, 1232, 1608, 1609, 2267,
+	1787, 183, 63, 183, 41, 1633, 183, 552, 2668, 1086,
+	735, 32, 31, 30, 29, 548, 182, 28, 2306, 2309,
+	2310, 2307, 23, 22, 21, 20, 19, 25, 2314, 18,
+	2313, 17, 16, 115, 549, 549, 549, 50, 47, 45,
+	2320, 122, 121, 48, 44, 1016, 42, 27, 26, 15,
+	14, 13, 549, 549, 12, 11, 10, 2317, 2318, 9,
+	2319, 5, 2357, 2321, 4, 2323, 35, 2325, 34, 33,
+	1089, 182, 24, 2, 2048, 1789, 0, 2352, 2353, 0,
+	0, 0, 0, 0, 0, 0, 2394, 2360, 0, 2407,
+	2367, 0, 0, 2382, 182, 0, 0, 0, 0, 0,
+	2372, 0, 0, 0, 2392, 0, 0, 0, 2412, 0,
+	2414, 0, 182, 2396, 2397, 182, 182, 182, 0, 2389,
+	0, 2395, 0, 0, 0, 548, 548, 0, 0, 0,
+	0, 0, 0, 2289, 2436, 2437, 2288, 0, 0, 2402,
+	0, 0, 2403, 2448, 2449, 0, 0, 0, 0, 0,
+	0, 0, 548, 548, 548, 548, 0, 2439, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2460, 0, 2450, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 183, 0, 2459, 0,
+	549, 549, 2463, 0, 0, 182, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 183, 0, 0, 0,
-	0, 0, 0, 183, 0, 0, 0, 0, 183, 183,
-	0, 0, 183, 0, 183, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 548,
+	0, 548, 0, 0, 0, 549, 0, 2468, 0, 183,
+	0, 0, 0, 0, 549, 0, 0, 0, 0, 0,
+	549, 0, 2481, 2195, 0, 2506, 2490, 2195, 2504, 0,
+	2491, 2482, 2502, 0, 2192, 2500, 2498, 0, 0, 0,
+	0, 0, 37, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 548, 0, 2510, 2511, 0, 0,
+	0, 0, 2513, 2526, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 548, 0, 0, 0, 0, 0,
+	0, 0, 0, 2524, 2522, 0, 2529, 2523, 0, 0,
+	0, 0, 0, 2534, 0, 2533, 548, 0, 0, 2541,
+	548, 548, 0, 0, 0, 0, 0, 0, 0, 2550,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2570, 0, 0, 2566, 2565, 548,
+	2563, 2564, 0, 0, 0, 0, 0, 0, 2582, 548,
+	0, 0, 0, 0, 0, 0, 2581, 0, 2585, 2195,
+	2579, 0, 0, 2583, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 548, 182, 0, 0, 0, 0, 0,
+	0, 0, 2596, 183, 0, 0, 0, 182, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 548,
+	2606, 0, 0, 2593, 0, 0, 0, 0, 0, 0,
+	0, 548, 0, 37, 0, 2620, 0, 549, 2614, 0,
+	0, 0, 0, 0, 0, 548, 548, 0, 0, 0,
+	0, 0, 0, 2623, 549, 549, 2646, 549, 0, 549,
+	549, 548, 549, 549, 549, 549, 549, 549, 2632, 2192,
+	2541, 2634, 2626, 0, 2649, 0, 2628, 549, 0, 0,
+	0, 183, 37, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2665, 2657, 2673, 2646, 2662, 183, 0, 0,
+	0, 0, 2671, 548, 0, 0, 0, 0, 549, 0,
+	183, 2680, 0, 2683, 0, 0, 2681, 0, 548, 0,
+	0, 0, 0, 0, 549, 0, 183, 2690, 0, 0,
+	548, 2679, 2692, 548, 0, 0, 2697, 2698, 0, 0,
+	2704, 0, 183, 0, 0, 0, 2710, 2646, 0, 183,
+	0, 0, 0, 0, 0, 0, 0, 0, 183, 183,
+	183, 183, 183, 183, 183, 183, 183, 549, 549, 549,
+	2719, 2725, 0, 0, 0, 0, 0, 0, 0, 548,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2746, 0, 0, 0, 0, 183, 548,
+	78, 39, 40, 80, 0, 0, 0, 0, 178, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	84, 0, 0, 0, 43, 69, 70, 2192, 67, 71,
+	0, 0, 120, 0, 0, 619, 0, 68, 0, 0,
+	0, 0, 0, 0, 0, 162, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 549, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 56, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 87,
+	0, 0, 0, 0, 549, 549, 181, 0, 0, 503,
+	0, 0, 543, 178, 0, 0, 0, 0, 0, 503,
+	0, 0, 0, 0, 159, 0, 160, 503, 0, 0,
+	0, 0, 0, 0, 0, 0, 177, 120, 0, 142,
+	183, 0, 716, 0, 0, 549, 0, 0, 0, 0,
+	162, 0, 0, 0, 0, 0, 0, 0, 734, 0,
+	734, 0, 0, 0, 0, 0, 0, 0, 503, 183,
+	0, 0, 549, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 183, 152, 0, 549, 0, 0, 141, 0,
+	183, 0, 183, 0, 183, 183, 549, 0, 0, 549,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 159,
+	549, 160, 0, 0, 0, 0, 0, 129, 130, 151,
+	150, 177, 0, 0, 46, 49, 52, 51, 54, 0,
+	66, 0, 0, 75, 72, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 163, 0, 0, 0,
+	0, 0, 0, 0, 0, 168, 55, 83, 82, 0,
+	0, 64, 65, 53, 0, 549, 0, 0, 0, 73,
+	74, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	549, 0, 0, 0, 0, 0, 549, 0, 0, 0,
+	0, 0, 0, 0, 0, 57, 58, 0, 59, 60,
+	61, 62, 0, 0, 0, 0, 0, 0, 0, 146,
+	127, 153, 134, 126, 0, 147, 148, 0, 0, 0,
+	0, 163, 0, 0, 0, 0, 0, 733, 549, 0,
+	168, 135, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 138, 136, 131, 132, 133,
+	137, 0, 0, 0, 0, 0, 155, 128, 0, 0,
+	0, 0, 0, 0, 0, 0, 139, 0, 0, 0,
 	0, 183, 0, 0, 0, 0, 0, 0, 183, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2363, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1547, 0, 0, 0, 183, 546, 0, 1176,
-	0, 0, 0, 0, 0, 0, 0, 1561, 1562, 0,
-	0, 0, 0, 0, 0, 733, 0, 0, 0, 0,
-	146, 127, 153, 134, 126, 0, 147, 148, 0, 0,
-	603, 0, 163, 0, 0, 0, 0, 1450, 0, 2390,
-	0, 168, 135, 0, 0, 1623, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 138, 136, 131, 132,
-	133, 137, 0, 0, 0, 0, 0, 0, 128, 0,
-	0, 0, 1635, 0, 0, 0, 0, 139, 0, 0,
-	0, 181, 0, 0, 500, 1231, 0, 540, 733, 0,
-	0, 0, 0, 0, 500, 0, 733, 0, 0, 733,
-	0, 0, 500, 0, 178, 0, 0, 0, 0, 0,
-	886, 0, 0, 0, 0, 0, 0, 700, 183, 1503,
-	1504, 1505, 1506, 0, 0, 0, 183, 0, 120, 0,
-	0, 0, 546, 718, 0, 718, 0, 0, 546, 2453,
-	0, 162, 0, 500, 0, 0, 0, 0, 0, 546,
-	0, 0, 155, 2463, 2464, 2465, 0, 2466, 2467, 1544,
-	1545, 0, 2471, 0, 0, 893, 0, 1550, 0, 0,
-	0, 0, 183, 183, 0, 0, 0, 0, 0, 0,
-	0, 0, 1585, 1586, 1587, 1589, 0, 0, 0, 0,
-	886, 0, 0, 0, 0, 0, 893, 0, 159, 2495,
-	160, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	177, 0, 0, 558, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 149, 628, 79,
-	0, 2509, 546, 0, 0, 0, 0, 183, 886, 0,
-	0, 2514, 0, 143, 183, 0, 144, 2515, 2516, 1642,
-	1643, 0, 0, 0, 0, 0, 546, 0, 0, 0,
-	0, 0, 0, 546, 0, 0, 0, 0, 0, 0,
-	0, 0, 546, 0, 2528, 0, 1676, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 183, 183, 183, 183, 183,
-	0, 0, 0, 0, 0, 0, 690, 0, 79, 0,
-	0, 0, 183, 183, 0, 0, 0, 0, 163, 0,
-	0, 0, 0, 0, 0, 0, 690, 168, 183, 0,
-	0, 0, 0, 0, 0, 1714, 0, 0, 0, 0,
-	0, 0, 1815, 0, 0, 0, 0, 156, 161, 158,
-	164, 165, 166, 167, 169, 170, 171, 172, 0, 0,
-	0, 0, 0, 173, 174, 175, 176, 0, 0, 0,
-	0, 0, 0, 546, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 546,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 183, 0, 0, 0, 546, 0,
-	0, 0, 0, 0, 0, 0, 0, 546, 0, 0,
-	0, 0, 0, 0, 546, 546, 0, 183, 183, 183,
-	183, 183, 0, 0, 0, 0, 0, 0, 155, 183,
-	0, 0, 0, 0, 183, 183, 0, 183, 0, 0,
-	183, 183, 183, 0, 0, 0, 0, 0, 0, 0,
-	733, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1635, 0, 0,
-	1185, 0, 0, 1888, 0, 0, 0, 0, 0, 0,
-	0, 183, 0, 0, 1903, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 546, 0, 0, 0, 0, 0,
-	0, 0, 0, 546, 0, 0, 0, 0, 183, 0,
-	0, 0, 0, 0, 0, 0, 0, 500, 183, 500,
-	0, 0, 500, 0, 499, 0, 0, 0, 0, 0,
-	0, 0, 0, 183, 548, 0, 183, 0, 0, 0,
-	0, 0, 680, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1976, 0, 0,
-	0, 0, 0, 1846, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 890, 0, 0, 0, 0, 0, 0,
-	0, 1635, 546, 183, 0, 0, 0, 0, 2001, 0,
-	0, 0, 0, 0, 0, 1143, 0, 2006, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 183, 183, 0, 0, 183, 0, 183,
+	0, 0, 0, 0, 0, 561, 183, 0, 178, 0,
+	0, 0, 0, 183, 0, 0, 0, 0, 0, 2054,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 81, 120, 0, 142, 0, 0, 727, 0, 0,
+	0, 183, 549, 0, 0, 162, 0, 0, 86, 0,
+	0, 155, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 152, 0,
+	0, 0, 0, 141, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 159, 0, 160, 0, 0, 0,
+	0, 0, 1365, 1366, 151, 150, 177, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 149, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 143, 0, 0, 144, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 503, 0, 503, 0, 0,
+	503, 0, 0, 156, 161, 158, 164, 165, 166, 167,
+	169, 170, 171, 172, 0, 0, 0, 0, 183, 173,
+	174, 175, 176, 0, 0, 0, 183, 0, 0, 0,
+	0, 0, 549, 0, 0, 0, 0, 0, 549, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 549,
+	0, 0, 0, 0, 146, 1367, 153, 0, 1364, 0,
+	147, 148, 0, 0, 0, 0, 163, 0, 0, 0,
+	0, 0, 183, 183, 0, 168, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 156, 161,
+	158, 164, 165, 166, 167, 169, 170, 171, 172, 0,
+	0, 0, 0, 0, 173, 174, 175, 176, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 549, 0, 0, 0, 0, 183, 1207, 0,
+	0, 0, 0, 0, 183, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 549, 0, 0, 0,
+	0, 0, 0, 549, 0, 0, 0, 0, 0, 0,
+	0, 0, 549, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 155, 0, 0, 0,
+	503, 0, 0, 0, 0, 183, 183, 183, 183, 183,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	716, 0, 183, 183, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 183, 0,
+	0, 0, 0, 503, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 618,
+	549, 149, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 143, 0, 0,
+	144, 0, 0, 0, 0, 0, 549, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 549, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 183, 0,
+	0, 547, 549, 0, 0, 0, 0, 0, 0, 0,
+	0, 549, 0, 0, 0, 0, 0, 0, 549, 549,
+	1114, 183, 183, 183, 183, 183, 0, 0, 0, 0,
+	0, 1242, 0, 183, 0, 0, 0, 0, 183, 183,
+	0, 183, 0, 0, 183, 183, 183, 0, 749, 0,
+	1162, 904, 0, 911, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 156, 161, 158, 164, 165, 166, 167,
-	169, 170, 171, 172, 0, 0, 0, 0, 0, 173,
-	174, 175, 176, 0, 0, 0, 0, 1177, 183, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1226, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 183, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 183,
-	0, 0, 183, 183, 183, 0, 0, 0, 0, 500,
-	0, 0, 546, 546, 0, 0, 0, 0, 733, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 700,
-	0, 0, 0, 0, 1355, 0, 0, 0, 0, 546,
-	546, 546, 546, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 500, 2102, 0, 0, 0, 0, 1059, 1059,
-	1059, 0, 733, 0, 0, 0, 1176, 0, 0, 2127,
-	1355, 1176, 0, 0, 0, 0, 0, 0, 79, 0,
+	169, 170, 171, 172, 0, 0, 0, 503, 0, 173,
+	174, 175, 176, 0, 0, 183, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 549, 0,
+	0, 0, 561, 0, 0, 0, 0, 549, 0, 0,
+	0, 0, 183, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 183, 0, 1207, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 183, 0, 0,
+	183, 0, 0, 0, 0, 0, 0, 0, 0, 1207,
+	1207, 0, 0, 0, 0, 503, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1434, 0, 0, 0, 0, 0, 0, 0, 549,
+	0, 0, 0, 0, 503, 0, 1259, 0, 0, 0,
+	0, 0, 0, 0, 178, 0, 0, 0, 0, 0,
+	1480, 0, 0, 0, 0, 1361, 549, 183, 0, 0,
+	0, 0, 0, 0, 0, 0, 503, 0, 120, 0,
+	142, 0, 0, 503, 0, 0, 0, 0, 0, 0,
+	0, 162, 1503, 1504, 503, 503, 503, 503, 503, 503,
+	503, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 183, 0, 152, 0, 0, 0, 0, 141,
+	0, 0, 503, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 183, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 690, 1131, 1132, 1133, 546,
-	1136, 546, 1138, 1139, 1140, 1141, 0, 1144, 1146, 1146,
-	0, 1146, 1150, 1150, 1152, 1153, 1154, 1155, 1156, 1157,
-	1158, 1159, 0, 1161, 1162, 1163, 1164, 1165, 0, 0,
-	0, 0, 1150, 1150, 1150, 1150, 0, 0, 0, 886,
-	0, 0, 1176, 0, 0, 0, 0, 0, 1635, 0,
-	0, 0, 0, 0, 546, 0, 0, 2069, 2070, 0,
-	0, 0, 2073, 0, 0, 0, 2075, 2076, 2077, 0,
-	0, 0, 0, 0, 546, 2082, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 546, 0, 0, 0, 546, 546, 0, 0, 1186,
-	0, 0, 0, 0, 500, 690, 0, 0, 0, 690,
-	0, 0, 0, 0, 0, 690, 0, 0, 0, 0,
-	0, 0, 0, 0, 546, 0, 2115, 0, 0, 0,
-	0, 0, 0, 0, 546, 0, 0, 2102, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1177, 0, 0, 0, 0, 0, 0, 546, 183,
-	0, 0, 0, 0, 0, 0, 0, 999, 0, 1005,
-	0, 0, 1007, 0, 0, 0, 1177, 1177, 0, 0,
-	0, 0, 500, 0, 0, 0, 0, 0, 0, 0,
-	0, 546, 0, 0, 0, 0, 0, 0, 1401, 0,
-	0, 0, 0, 0, 0, 546, 546, 2201, 0, 0,
-	0, 500, 0, 546, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1447, 0, 0,
-	0, 0, 178, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 500, 0, 0, 0, 1635, 1635, 0,
-	500, 0, 0, 0, 0, 546, 120, 0, 0, 1470,
-	1471, 500, 500, 500, 500, 500, 500, 500, 0, 162,
-	0, 0, 0, 0, 2376, 2377, 2378, 2379, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2262, 0, 0, 0, 0, 0, 0, 2268, 500,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1951, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 159, 0, 160, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 177, 1176,
-	0, 558, 0, 0, 2419, 0, 2421, 0, 0, 0,
-	0, 0, 718, 0, 0, 0, 0, 0, 0, 718,
-	718, 0, 0, 0, 0, 1177, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	718, 1447, 718, 718, 718, 718, 718, 0, 0, 0,
-	0, 0, 1238, 0, 0, 0, 0, 0, 0, 1635,
-	0, 0, 0, 0, 0, 0, 1401, 0, 0, 0,
-	0, 0, 0, 0, 0, 2353, 0, 0, 0, 733,
-	0, 718, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2368, 0, 0, 0, 700, 2472, 0, 0, 0,
-	2472, 2472, 0, 0, 0, 0, 163, 0, 500, 0,
-	0, 0, 0, 0, 1447, 168, 500, 0, 500, 0,
-	500, 1667, 0, 0, 0, 0, 1059, 1059, 1059, 1635,
-	0, 0, 0, 2382, 0, 2383, 0, 0, 0, 1635,
-	2386, 2387, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1635, 0, 0, 0, 0, 0, 0,
-	0, 0, 2415, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2423, 0, 0, 2425, 0, 0, 0,
-	0, 178, 0, 0, 1176, 0, 2520, 0, 2428, 0,
-	0, 0, 1328, 0, 0, 0, 0, 0, 0, 2435,
-	733, 733, 0, 0, 1256, 120, 0, 142, 2540, 0,
-	0, 0, 0, 0, 0, 0, 155, 0, 162, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 558, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 152,
-	1635, 0, 0, 0, 141, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2480, 558, 0,
-	0, 0, 0, 0, 0, 159, 0, 160, 0, 0,
-	0, 0, 1386, 1332, 1333, 151, 150, 177, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 500, 0, 0,
-	0, 0, 0, 0, 500, 0, 0, 0, 1662, 500,
-	500, 1427, 0, 500, 558, 1807, 0, 0, 0, 0,
-	0, 0, 500, 0, 0, 0, 0, 0, 0, 500,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1457, 0, 0, 0, 0, 1278, 0,
-	1461, 0, 0, 0, 0, 0, 0, 500, 0, 0,
-	0, 1472, 1473, 1474, 1475, 1476, 1477, 1478, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 146, 1334, 153, 0, 1331, 0, 147,
-	148, 0, 0, 2554, 0, 163, 0, 0, 0, 1496,
-	0, 0, 0, 0, 168, 0, 0, 0, 0, 0,
-	0, 156, 161, 158, 164, 165, 166, 167, 169, 170,
-	171, 172, 718, 0, 0, 0, 0, 173, 174, 175,
-	176, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 718, 718, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1447, 0, 1266, 500,
-	0, 0, 0, 0, 0, 0, 0, 1401, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 155, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 500, 500, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1648, 0,
-	0, 0, 0, 0, 0, 0, 1652, 0, 1655, 1279,
-	0, 1496, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	149, 0, 0, 0, 0, 0, 0, 0, 500, 0,
-	0, 0, 0, 0, 0, 1985, 143, 0, 0, 144,
-	0, 0, 0, 1292, 1295, 1296, 1297, 1298, 1299, 1300,
-	0, 1301, 1302, 1303, 1304, 1305, 1280, 1281, 1282, 1283,
-	1264, 1265, 1293, 0, 1267, 0, 1268, 1269, 1270, 1271,
-	1272, 1273, 1274, 1275, 1276, 1277, 1284, 1285, 1286, 1287,
-	1288, 1289, 1290, 1291, 0, 0, 500, 500, 500, 500,
-	500, 0, 0, 0, 0, 0, 1862, 0, 0, 0,
-	0, 0, 0, 500, 500, 0, 0, 0, 0, 0,
-	0, 0, 0, 1879, 0, 0, 0, 0, 0, 500,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 690, 718, 0, 0, 0, 0, 0, 0,
-	156, 161, 158, 164, 165, 166, 167, 169, 170, 171,
-	172, 0, 1914, 1915, 0, 0, 173, 174, 175, 176,
-	0, 1934, 0, 718, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1294, 1496, 0, 0,
-	0, 0, 0, 0, 1790, 0, 0, 0, 0, 1800,
-	1801, 0, 0, 1805, 0, 500, 0, 0, 0, 0,
-	0, 0, 1808, 0, 0, 0, 0, 0, 0, 1811,
-	0, 0, 1177, 0, 0, 0, 0, 1177, 500, 500,
-	500, 500, 500, 0, 0, 0, 0, 0, 0, 0,
-	2143, 0, 0, 0, 0, 500, 1401, 1814, 500, 0,
-	0, 500, 2152, 1447, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 2007, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 500, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1177, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 500,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 500,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 500, 0, 0, 500, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 78, 39, 40, 80, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 84, 0, 0, 0, 43,
-	69, 70, 0, 67, 71, 0, 0, 0, 0, 0,
-	0, 0, 68, 1662, 500, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2124, 0, 79, 1935, 0, 1662, 1662, 1662, 1662,
-	1662, 56, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 87, 1934, 0, 0, 1662, 0, 0, 1662,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 500,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 500, 0, 0, 0, 0, 0, 1979, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	500, 0, 0, 500, 500, 500, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2214,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2025, 2026, 2027, 2028,
-	2029, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2243, 0, 1496, 2035, 46, 49, 52, 51, 54,
-	0, 66, 0, 0, 75, 72, 0, 0, 0, 2043,
-	0, 0, 0, 0, 0, 0, 1401, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 55, 83, 82,
-	0, 0, 64, 65, 53, 1177, 0, 0, 0, 0,
-	73, 74, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 57, 58, 0, 59,
-	60, 61, 62, 0, 0, 0, 0, 1662, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2321, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2361, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 2191, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2212,
-	500, 0, 81, 0, 0, 0, 0, 0, 0, 2221,
-	2391, 0, 2395, 2396, 0, 0, 0, 0, 0, 86,
-	0, 0, 0, 0, 2233, 0, 0, 2236, 0, 0,
-	1177, 0, 0, 0, 87, 2124, 0, 79, 0, 2124,
-	622, 629, 630, 631, 632, 633, 623, 625, 0, 0,
-	0, 624, 0, 0, 627, 634, 635, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 2277, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2447, 0,
-	2218, 2219, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 636, 637, 638, 639, 640, 641, 642, 643,
-	644, 645, 646, 647, 648, 649, 650, 651, 652, 653,
-	654, 655, 656, 657, 658, 659, 660, 661, 662, 663,
-	664, 665, 666, 667, 668, 669, 670, 671, 672, 673,
-	674, 675, 676, 0, 0, 0, 2124, 0, 0, 0,
-	0, 0, 2331, 0, 0, 0, 0, 0, 0, 1155,
-	1156, 1157, 1158, 0, 0, 0, 0, 0, 0, 0,
-	2346, 0, 0, 2347, 2348, 2349, 0, 0, 0, 0,
-	0, 0, 0, 79, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 79, 0, 864,
-	849, 421, 0, 796, 867, 766, 784, 877, 787, 790,
-	831, 745, 810, 342, 781, 0, 770, 740, 776, 741,
-	768, 798, 244, 765, 851, 814, 866, 297, 241, 747,
-	771, 356, 786, 193, 833, 397, 228, 307, 304, 428,
-	255, 247, 243, 227, 281, 314, 354, 415, 348, 873,
-	301, 820, 0, 406, 327, 0, 0, 0, 800, 855,
-	808, 845, 795, 832, 755, 819, 868, 782, 828, 869,
-	287, 226, 192, 339, 407, 259, 0, 0, 0, 0,
-	184, 185, 186, 0, 2534, 0, 2535, 0, 0, 0,
-	0, 0, 0, 217, 0, 224, 778, 825, 863, 779,
-	827, 239, 285, 246, 238, 425, 874, 854, 744, 807,
-	862, 0, 0, 209, 865, 802, 0, 830, 0, 880,
-	739, 822, 0, 742, 746, 876, 858, 774, 249, 0,
-	0, 0, 0, 0, 0, 0, 799, 809, 842, 793,
-	0, 0, 0, 0, 0, 0, 0, 772, 0, 818,
-	0, 0, 0, 751, 743, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 797, 0, 0, 0,
-	754, 0, 773, 843, 0, 737, 268, 748, 328, 231,
-	2508, 847, 857, 794, 459, 861, 792, 791, 837, 752,
-	853, 785, 296, 750, 293, 188, 205, 0, 783, 338,
-	379, 385, 852, 769, 777, 229, 775, 383, 352, 443,
-	213, 257, 376, 357, 381, 364, 260, 817, 835, 382,
-	302, 430, 371, 440, 460, 461, 237, 332, 450, 419,
-	456, 472, 206, 234, 346, 412, 446, 403, 325, 426,
-	427, 292, 402, 266, 191, 300, 466, 204, 391, 221,
-	211, 197, 414, 438, 218, 394, 0, 0, 474, 199,
-	436, 411, 321, 289, 290, 198, 0, 375, 242, 264,
-	232, 341, 433, 434, 230, 475, 208, 455, 201, 1061,
-	454, 334, 429, 437, 322, 312, 200, 435, 320, 311,
-	295, 253, 275, 369, 305, 370, 276, 330, 329, 331,
-	194, 447, 0, 195, 0, 408, 448, 476, 214, 215,
-	216, 764, 252, 256, 263, 265, 271, 272, 279, 298,
-	345, 368, 366, 372, 848, 424, 441, 451, 458, 464,
-	465, 467, 468, 469, 470, 471, 333, 278, 404, 294,
-	303, 840, 879, 351, 384, 219, 445, 405, 759, 763,
-	757, 758, 812, 813, 760, 870, 871, 872, 477, 478,
-	479, 480, 481, 482, 483, 484, 485, 486, 487, 488,
-	489, 490, 491, 492, 493, 494, 0, 844, 753, 0,
-	761, 762, 0, 850, 859, 860, 495, 313, 396, 442,
-	816, 187, 202, 299, 875, 373, 261, 473, 453, 449,
-	738, 756, 236, 767, 0, 0, 780, 788, 789, 801,
-	803, 804, 805, 806, 324, 823, 824, 826, 834, 836,
-	839, 841, 846, 856, 878, 189, 190, 203, 212, 222,
-	235, 250, 258, 269, 274, 277, 282, 283, 286, 291,
-	309, 315, 316, 317, 318, 335, 336, 337, 340, 343,
-	344, 347, 349, 350, 353, 360, 361, 362, 363, 365,
-	367, 374, 378, 386, 387, 388, 389, 390, 392, 393,
-	398, 399, 400, 401, 409, 413, 431, 432, 444, 457,
-	462, 270, 439, 463, 0, 308, 815, 821, 310, 254,
-	273, 284, 829, 452, 410, 207, 380, 262, 196, 225,
-	210, 233, 248, 251, 288, 319, 326, 355, 359, 267,
-	245, 223, 377, 220, 395, 416, 417, 418, 420, 323,
-	240, 358, 811, 838, 306, 422, 423, 280, 864, 849,
-	421, 0, 796, 867, 766, 784, 877, 787, 790, 831,
-	745, 810, 342, 781, 0, 770, 740, 776, 741, 768,
-	798, 244, 765, 851, 814, 866, 297, 241, 747, 771,
-	356, 786, 193, 833, 397, 228, 307, 304, 428, 255,
-	247, 243, 227, 281, 314, 354, 415, 348, 873, 301,
-	820, 0, 406, 327, 0, 0, 0, 800, 855, 808,
-	845, 795, 832, 755, 819, 868, 782, 828, 869, 287,
-	226, 192, 339, 407, 259, 0, 0, 0, 0, 184,
-	185, 186, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 217, 0, 224, 778, 825, 863, 779, 827,
-	239, 285, 246, 238, 425, 874, 854, 744, 807, 862,
-	0, 0, 209, 865, 802, 0, 830, 0, 880, 739,
-	822, 0, 742, 746, 876, 858, 774, 249, 0, 0,
-	0, 0, 0, 0, 0, 799, 809, 842, 793, 0,
-	0, 0, 0, 0, 2153, 0, 772, 0, 818, 0,
-	0, 0, 751, 743, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 797, 0, 0, 0, 754,
-	0, 773, 843, 0, 737, 268, 748, 328, 231, 0,
-	847, 857, 794, 459, 861, 792, 791, 837, 752, 853,
-	785, 296, 750, 293, 188, 205, 0, 783, 338, 379,
-	385, 852, 769, 777, 229, 775, 383, 352, 443, 213,
-	257, 376, 357, 381, 364, 260, 817, 835, 382, 302,
-	430, 371, 440, 460, 461, 237, 332, 450, 419, 456,
-	472, 206, 234, 346, 412, 446, 403, 325, 426, 427,
-	292, 402, 266, 191, 300, 466, 204, 391, 221, 211,
-	197, 414, 438, 218, 394, 0, 0, 474, 199, 436,
-	411, 321, 289, 290, 198, 0, 375, 242, 264, 232,
-	341, 433, 434, 230, 475, 208, 455, 201, 1061, 454,
-	334, 429, 437, 322, 312, 200, 435, 320, 311, 295,
-	253, 275, 369, 305, 370, 276, 330, 329, 331, 194,
-	447, 0, 195, 0, 408, 448, 476, 214, 215, 216,
-	764, 252, 256, 263, 265, 271, 272, 279, 298, 345,
-	368, 366, 372, 848, 424, 441, 451, 458, 464, 465,
-	467, 468, 469, 470, 471, 333, 278, 404, 294, 303,
-	840, 879, 351, 384, 219, 445, 405, 759, 763, 757,
-	758, 812, 813, 760, 870, 871, 872, 477, 478, 479,
-	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
-	490, 491, 492, 493, 494, 0, 844, 753, 0, 761,
-	762, 0, 850, 859, 860, 495, 313, 396, 442, 816,
-	187, 202, 299, 875, 373, 261, 473, 453, 449, 738,
-	756, 236, 767, 0, 0, 780, 788, 789, 801, 803,
-	804, 805, 806, 324, 823, 824, 826, 834, 836, 839,
-	841, 846, 856, 878, 189, 190, 203, 212, 222, 235,
-	250, 258, 269, 274, 277, 282, 283, 286, 291, 309,
-	315, 316, 317, 318, 335, 336, 337, 340, 343, 344,
-	347, 349, 350, 353, 360, 361, 362, 363, 365, 367,
-	374, 378, 386, 387, 388, 389, 390, 392, 393, 398,
-	399, 400, 401, 409, 413, 431, 432, 444, 457, 462,
-	270, 439, 463, 0, 308, 815, 821, 310, 254, 273,
-	284, 829, 452, 410, 207, 380, 262, 196, 225, 210,
-	233, 248, 251, 288, 319, 326, 355, 359, 267, 245,
-	223, 377, 220, 395, 416, 417, 418, 420, 323, 240,
-	358, 811, 838, 306, 422, 423, 280, 864, 849, 421,
-	0, 796, 867, 766, 784, 877, 787, 790, 831, 745,
-	810, 342, 781, 0, 770, 740, 776, 741, 768, 798,
-	244, 765, 851, 814, 866, 297, 241, 747, 771, 356,
-	786, 193, 833, 397, 228, 307, 304, 428, 255, 247,
-	243, 227, 281, 314, 354, 415, 348, 873, 301, 820,
-	0, 406, 327, 0, 0, 0, 800, 855, 808, 845,
-	795, 832, 755, 819, 868, 782, 828, 869, 287, 226,
-	192, 339, 407, 259, 0, 0, 0, 0, 184, 185,
-	186, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 217, 0, 224, 778, 825, 863, 779, 827, 239,
-	285, 246, 238, 425, 874, 854, 744, 807, 862, 0,
-	0, 209, 865, 802, 0, 830, 0, 880, 739, 822,
-	0, 742, 746, 876, 858, 774, 249, 0, 0, 0,
-	0, 0, 0, 0, 799, 809, 842, 793, 0, 0,
-	0, 0, 0, 2113, 0, 772, 0, 818, 0, 0,
-	0, 751, 743, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 797, 0, 0, 0, 754, 0,
-	773, 843, 0, 737, 268, 748, 328, 231, 0, 847,
-	857, 794, 459, 861, 792, 791, 837, 752, 853, 785,
-	296, 750, 293, 188, 205, 0, 783, 338, 379, 385,
-	852, 769, 777, 229, 775, 383, 352, 443, 213, 257,
-	376, 357, 381, 364, 260, 817, 835, 382, 302, 430,
-	371, 440, 460, 461, 237, 332, 450, 419, 456, 472,
-	206, 234, 346, 412, 446, 403, 325, 426, 427, 292,
-	402, 266, 191, 300, 466, 204, 391, 221, 211, 197,
-	414, 438, 218, 394, 0, 0, 474, 199, 436, 411,
-	321, 289, 290, 198, 0, 375, 242, 264, 232, 341,
-	433, 434, 230, 475, 208, 455, 201, 1061, 454, 334,
-	429, 437, 322, 312, 200, 435, 320, 311, 295, 253,
-	275, 369, 305, 370, 276, 330, 329, 331, 194, 447,
-	0, 195, 0, 408, 448, 476, 214, 215, 216, 764,
-	252, 256, 263, 265, 271, 272, 279, 298, 345, 368,
-	366, 372, 848, 424, 441, 451, 458, 464, 465, 467,
-	468, 469, 470, 471, 333, 278, 404, 294, 303, 840,
-	879, 351, 384, 219, 445, 405, 759, 763, 757, 758,
-	812, 813, 760, 870, 871, 872, 477, 478, 479, 480,
-	481, 482, 483, 484, 485, 486, 487, 488, 489, 490,
-	491, 492, 493, 494, 0, 844, 753, 0, 761, 762,
-	0, 850, 859, 860, 495, 313, 396, 442, 816, 187,
-	202, 299, 875, 373, 261, 473, 453, 449, 738, 756,
-	236, 767, 0, 0, 780, 788, 789, 801, 803, 804,
-	805, 806, 324, 823, 824, 826, 834, 836, 839, 841,
-	846, 856, 878, 189, 190, 203, 212, 222, 235, 250,
-	258, 269, 274, 277, 282, 283, 286, 291, 309, 315,
-	316, 317, 318, 335, 336, 337, 340, 343, 344, 347,
-	349, 350, 353, 360, 361, 362, 363, 365, 367, 374,
-	378, 386, 387, 388, 389, 390, 392, 393, 398, 399,
-	400, 401, 409, 413, 431, 432, 444, 457, 462, 270,
-	439, 463, 0, 308, 815, 821, 310, 254, 273, 284,
-	829, 452, 410, 207, 380, 262, 196, 225, 210, 233,
-	248, 251, 288, 319, 326, 355, 359, 267, 245, 223,
-	377, 220, 395, 416, 417, 418, 420, 323, 240, 358,
-	811, 838, 306, 422, 423, 280, 864, 849, 421, 0,
-	796, 867, 766, 784, 877, 787, 790, 831, 745, 810,
-	342, 781, 0, 770, 740, 776, 741, 768, 798, 244,
-	765, 851, 814, 866, 297, 241, 747, 771, 356, 786,
-	193, 833, 397, 228, 307, 304, 428, 255, 247, 243,
-	227, 281, 314, 354, 415, 348, 873, 301, 820, 0,
-	406, 327, 0, 0, 0, 800, 855, 808, 845, 795,
-	832, 755, 819, 868, 782, 828, 869, 287, 226, 192,
-	339, 407, 259, 0, 0, 0, 0, 184, 185, 186,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	217, 0, 224, 778, 825, 863, 779, 827, 239, 285,
-	246, 238, 425, 874, 854, 744, 807, 862, 0, 0,
-	209, 865, 802, 0, 830, 0, 880, 739, 822, 0,
-	742, 746, 876, 858, 774, 249, 0, 0, 0, 0,
-	0, 0, 0, 799, 809, 842, 793, 0, 0, 0,
-	0, 0, 1650, 0, 772, 0, 818, 0, 0, 0,
-	751, 743, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 797, 0, 0, 0, 754, 0, 773,
-	843, 0, 737, 268, 748, 328, 231, 0, 847, 857,
-	794, 459, 861, 792, 791, 837, 752, 853, 785, 296,
-	750, 293, 188, 205, 0, 783, 338, 379, 385, 852,
-	769, 777, 229, 775, 383, 352, 443, 213, 257, 376,
-	357, 381, 364, 260, 817, 835, 382, 302, 430, 371,
-	440, 460, 461, 237, 332, 450, 419, 456, 472, 206,
-	234, 346, 412, 446, 403, 325, 426, 427, 292, 402,
-	266, 191, 300, 466, 204, 391, 221, 211, 197, 414,
-	438, 218, 394, 0, 0, 474, 199, 436, 411, 321,
-	289, 290, 198, 0, 375, 242, 264, 232, 341, 433,
-	434, 230, 475, 208, 455, 201, 1061, 454, 334, 429,
-	437, 322, 312, 200, 435, 320, 311, 295, 253, 275,
-	369, 305, 370, 276, 330, 329, 331, 194, 447, 0,
-	195, 0, 408, 448, 476, 214, 215, 216, 764, 252,
-	256, 263, 265, 271, 272, 279, 298, 345, 368, 366,
-	372, 848, 424, 441, 451, 458, 464, 465, 467, 468,
-	469, 470, 471, 333, 278, 404, 294, 303, 840, 879,
-	351, 384, 219, 445, 405, 759, 763, 757, 758, 812,
-	813, 760, 870, 871, 872, 477, 478, 479, 480, 481,
-	482, 483, 484, 485, 486, 487, 488, 489, 490, 491,
-	492, 493, 494, 0, 844, 753, 0, 761, 762, 0,
-	850, 859, 860, 495, 313, 396, 442, 816, 187, 202,
-	299, 875, 373, 261, 473, 453, 449, 738, 756, 236,
-	767, 0, 0, 780, 788, 789, 801, 803, 804, 805,
-	806, 324, 823, 824, 826, 834, 836, 839, 841, 846,
-	856, 878, 189, 190, 203, 212, 222, 235, 250, 258,
-	269, 274, 277, 282, 283, 286, 291, 309, 315, 316,
-	317, 318, 335, 336, 337, 340, 343, 344, 347, 349,
-	350, 353, 360, 361, 362, 363, 365, 367, 374, 378,
-	386, 387, 388, 389, 390, 392, 393, 398, 399, 400,
-	401, 409, 413, 431, 432, 444, 457, 462, 270, 439,
-	463, 0, 308, 815, 821, 310, 254, 273, 284, 829,
-	452, 410, 207, 380, 262, 196, 225, 210, 233, 248,
-	251, 288, 319, 326, 355, 359, 267, 245, 223, 377,
-	220, 395, 416, 417, 418, 420, 323, 240, 358, 811,
-	838, 306, 422, 423, 280, 864, 849, 421, 0, 796,
-	867, 766, 784, 877, 787, 790, 831, 745, 810, 342,
-	781, 0, 770, 740, 776, 741, 768, 798, 244, 765,
-	851, 814, 866, 297, 241, 747, 771, 356, 786, 193,
-	833, 397, 228, 307, 304, 428, 255, 247, 243, 227,
-	281, 314, 354, 415, 348, 873, 301, 820, 0, 406,
-	327, 0, 0, 0, 800, 855, 808, 845, 795, 832,
-	755, 819, 868, 782, 828, 869, 287, 226, 192, 339,
-	407, 259, 0, 87, 0, 0, 184, 185, 186, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 217,
-	0, 224, 778, 825, 863, 779, 827, 239, 285, 246,
-	238, 425, 874, 854, 744, 807, 862, 0, 0, 209,
-	865, 802, 0, 830, 0, 880, 739, 822, 0, 742,
-	746, 876, 858, 774, 249, 0, 0, 0, 0, 0,
-	0, 0, 799, 809, 842, 793, 0, 0, 0, 0,
-	0, 0, 0, 772, 0, 818, 0, 0, 0, 751,
-	743, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 797, 0, 0, 0, 754, 0, 773, 843,
-	0, 737, 268, 748, 328, 231, 0, 847, 857, 794,
-	459, 861, 792, 791, 837, 752, 853, 785, 296, 750,
-	293, 188, 205, 0, 783, 338, 379, 385, 852, 769,
-	777, 229, 775, 383, 352, 443, 213, 257, 376, 357,
-	381, 364, 260, 817, 835, 382, 302, 430, 371, 440,
-	460, 461, 237, 332, 450, 419, 456, 472, 206, 234,
-	346, 412, 446, 403, 325, 426, 427, 292, 402, 266,
-	191, 300, 466, 204, 391, 221, 211, 197, 414, 438,
-	218, 394, 0, 0, 474, 199, 436, 411, 321, 289,
-	290, 198, 0, 375, 242, 264, 232, 341, 433, 434,
-	230, 475, 208, 455, 201, 1061, 454, 334, 429, 437,
-	322, 312, 200, 435, 320, 311, 295, 253, 275, 369,
-	305, 370, 276, 330, 329, 331, 194, 447, 0, 195,
-	0, 408, 448, 476, 214, 215, 216, 764, 252, 256,
-	263, 265, 271, 272, 279, 298, 345, 368, 366, 372,
-	848, 424, 441, 451, 458, 464, 465, 467, 468, 469,
-	470, 471, 333, 278, 404, 294, 303, 840, 879, 351,
-	384, 219, 445, 405, 759, 763, 757, 758, 812, 813,
-	760, 870, 871, 872, 477, 478, 479, 480, 481, 482,
-	483, 484, 485, 486, 487, 488, 489, 490, 491, 492,
-	493, 494, 0, 844, 753, 0, 761, 762, 0, 850,
-	859, 860, 495, 313, 396, 442, 816, 187, 202, 299,
-	875, 373, 261, 473, 453, 449, 738, 756, 236, 767,
-	0, 0, 780, 788, 789, 801, 803, 804, 805, 806,
-	324, 823, 824, 826, 834, 836, 839, 841, 846, 856,
-	878, 189, 190, 203, 212, 222, 235, 250, 258, 269,
-	274, 277, 282, 283, 286, 291, 309, 315, 316, 317,
-	318, 335, 336, 337, 340, 343, 344, 347, 349, 350,
-	353, 360, 361, 362, 363, 365, 367, 374, 378, 386,
-	387, 388, 389, 390, 392, 393, 398, 399, 400, 401,
-	409, 413, 431, 432, 444, 457, 462, 270, 439, 463,
-	0, 308, 815, 821, 310, 254, 273, 284, 829, 452,
-	410, 207, 380, 262, 196, 225, 210, 233, 248, 251,
-	288, 319, 326, 355, 359, 267, 245, 223, 377, 220,
-	395, 416, 417, 418, 420, 323, 240, 358, 811, 838,
-	306, 422, 423, 280, 864, 849, 421, 0, 796, 867,
-	766, 784, 877, 787, 790, 831, 745, 810, 342, 781,
-	0, 770, 740, 776, 741, 768, 798, 244, 765, 851,
-	814, 866, 297, 241, 747, 771, 356, 786, 193, 833,
-	397, 228, 307, 304, 428, 255, 247, 243, 227, 281,
-	314, 354, 415, 348, 873, 301, 820, 0, 406, 327,
-	0, 0, 0, 800, 855, 808, 845, 795, 832, 755,
-	819, 868, 782, 828, 869, 287, 226, 192, 339, 407,
-	259, 0, 0, 0, 0, 184, 185, 186, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
-	224, 778, 825, 863, 779, 827, 239, 285, 246, 238,
-	425, 874, 854, 744, 807, 862, 0, 0, 209, 865,
-	802, 0, 830, 0, 880, 739, 822, 0, 742, 746,
-	876, 858, 774, 249, 0, 0, 0, 0, 0, 0,
-	0, 799, 809, 842, 793, 0, 0, 0, 0, 0,
-	0, 0, 772, 0, 818, 0, 0, 0, 751, 743,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 797, 0, 0, 0, 754, 0, 773, 843, 0,
-	737, 268, 748, 328, 231, 0, 847, 857, 794, 459,
-	861, 792, 791, 837, 752, 853, 785, 296, 750, 293,
-	188, 205, 0, 783, 338, 379, 385, 852, 769, 777,
-	229, 775, 383, 352, 443, 213, 257, 376, 357, 381,
-	364, 260, 817, 835, 382, 302, 430, 371, 440, 460,
-	461, 237, 332, 450, 419, 456, 472, 206, 234, 346,
-	412, 446, 403, 325, 426, 427, 292, 402, 266, 191,
-	300, 466, 204, 391, 221, 211, 197, 414, 438, 218,
-	394, 0, 0, 474, 199, 436, 411, 321, 289, 290,
-	198, 0, 375, 242, 264, 232, 341, 433, 434, 230,
-	475, 208, 455, 201, 1061, 454, 334, 429, 437, 322,
-	312, 200, 435, 320, 311, 295, 253, 275, 369, 305,
-	370, 276, 330, 329, 331, 194, 447, 0, 195, 0,
-	408, 448, 476, 214, 215, 216, 764, 252, 256, 263,
-	265, 271, 272, 279, 298, 345, 368, 366, 372, 848,
-	424, 441, 451, 458, 464, 465, 467, 468, 469, 470,
-	471, 333, 278, 404, 294, 303, 840, 879, 351, 384,
-	219, 445, 405, 759, 763, 757, 758, 812, 813, 760,
-	870, 871, 872, 477, 478, 479, 480, 481, 482, 483,
-	484, 485, 486, 487, 488, 489, 490, 491, 492, 493,
-	494, 0, 844, 753, 0, 761, 762, 0, 850, 859,
-	860, 495, 313, 396, 442, 816, 187, 202, 299, 875,
-	373, 261, 473, 453, 449, 738, 756, 236, 767, 0,
-	0, 780, 788, 789, 801, 803, 804, 805, 806, 324,
-	823, 824, 826, 834, 836, 839, 841, 846, 856, 878,
-	189, 190, 203, 212, 222, 235, 250, 258, 269, 274,
-	277, 282, 283, 286, 291, 309, 315, 316, 317, 318,
-	335, 336, 337, 340, 343, 344, 347, 349, 350, 353,
-	360, 361, 362, 363, 365, 367, 374, 378, 386, 387,
-	388, 389, 390, 392, 393, 398, 399, 400, 401, 409,
-	413, 431, 432, 444, 457, 462, 270, 439, 463, 0,
-	308, 815, 821, 310, 254, 273, 284, 829, 452, 410,
-	207, 380, 262, 196, 225, 210, 233, 248, 251, 288,
-	319, 326, 355, 359, 267, 245, 223, 377, 220, 395,
-	416, 417, 418, 420, 323, 240, 358, 811, 838, 306,
-	422, 423, 280, 864, 849, 421, 0, 796, 867, 766,
-	784, 877, 787, 790, 831, 745, 810, 342, 781, 0,
-	770, 740, 776, 741, 768, 798, 244, 765, 851, 814,
-	866, 297, 241, 747, 771, 356, 786, 193, 833, 397,
-	228, 307, 304, 428, 255, 247, 243, 227, 281, 314,
-	354, 415, 348, 873, 301, 820, 0, 406, 327, 0,
-	0, 0, 800, 855, 808, 845, 795, 832, 755, 819,
-	868, 782, 828, 869, 287, 226, 192, 339, 407, 259,
-	0, 0, 0, 0, 184, 185, 186, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 217, 0, 224,
-	778, 825, 863, 779, 827, 239, 285, 246, 238, 425,
-	874, 854, 744, 807, 862, 0, 0, 881, 865, 802,
-	0, 830, 0, 880, 739, 822, 0, 742, 746, 876,
-	858, 774, 249, 0, 0, 0, 0, 0, 0, 0,
-	799, 809, 842, 793, 0, 0, 0, 0, 0, 0,
-	0, 772, 0, 818, 0, 0, 0, 751, 743, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	797, 0, 0, 0, 754, 0, 773, 843, 0, 737,
-	268, 748, 328, 231, 0, 847, 857, 794, 459, 861,
-	792, 791, 837, 752, 853, 785, 296, 750, 293, 188,
-	205, 0, 783, 338, 379, 385, 852, 769, 777, 229,
-	775, 383, 352, 443, 213, 257, 376, 357, 381, 364,
-	260, 817, 835, 382, 302, 430, 371, 440, 460, 461,
-	237, 332, 450, 419, 456, 472, 206, 234, 346, 412,
-	446, 403, 325, 426, 427, 292, 402, 266, 191, 300,
-	466, 204, 391, 221, 211, 197, 414, 438, 218, 394,
-	0, 0, 474, 199, 436, 411, 321, 289, 290, 198,
-	0, 375, 242, 264, 232, 341, 433, 434, 230, 475,
-	208, 455, 201, 749, 454, 334, 429, 437, 322, 312,
-	200, 435, 320, 311, 295, 253, 275, 369, 305, 370,
-	276, 330, 329, 331, 194, 447, 0, 195, 0, 408,
-	448, 476, 214, 215, 216, 764, 252, 256, 263, 265,
-	271, 272, 279, 298, 345, 368, 366, 372, 848, 424,
-	441, 451, 458, 464, 465, 467, 468, 469, 470, 471,
-	736, 730, 729, 294, 303, 840, 879, 351, 384, 219,
-	445, 405, 759, 763, 757, 758, 812, 813, 760, 870,
-	871, 872, 477, 478, 479, 480, 481, 482, 483, 484,
-	485, 486, 487, 488, 489, 490, 491, 492, 493, 494,
-	0, 844, 753, 0, 761, 762, 0, 850, 859, 860,
-	495, 313, 396, 442, 816, 187, 202, 299, 875, 373,
-	261, 473, 453, 449, 738, 756, 236, 767, 0, 0,
-	780, 788, 789, 801, 803, 804, 805, 806, 324, 823,
-	824, 826, 834, 836, 839, 841, 846, 856, 878, 189,
-	190, 203, 212, 222, 235, 250, 258, 269, 274, 277,
-	282, 283, 286, 291, 309, 315, 316, 317, 318, 335,
-	336, 337, 340, 343, 344, 347, 349, 350, 353, 360,
-	361, 362, 363, 365, 367, 374, 378, 386, 387, 388,
-	389, 390, 392, 393, 398, 399, 400, 401, 409, 413,
-	431, 432, 444, 457, 462, 270, 439, 463, 0, 308,
-	815, 821, 310, 254, 273, 284, 829, 452, 410, 207,
-	380, 262, 196, 225, 210, 233, 248, 251, 288, 319,
-	326, 355, 359, 267, 245, 223, 377, 220, 395, 416,
-	417, 418, 420, 323, 240, 358, 811, 838, 306, 422,
-	423, 280, 864, 849, 421, 0, 796, 867, 766, 784,
-	877, 787, 790, 831, 745, 810, 342, 781, 0, 770,
-	740, 776, 741, 768, 798, 244, 765, 851, 814, 866,
-	297, 241, 747, 771, 356, 786, 193, 833, 397, 228,
-	307, 304, 428, 255, 247, 243, 227, 281, 314, 354,
-	415, 348, 873, 301, 820, 0, 406, 327, 0, 0,
-	0, 800, 855, 808, 845, 795, 832, 755, 819, 868,
-	782, 828, 869, 287, 226, 192, 339, 407, 259, 0,
-	0, 0, 0, 184, 185, 186, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 217, 0, 224, 778,
-	825, 863, 779, 827, 239, 285, 246, 238, 425, 874,
-	854, 744, 807, 862, 0, 0, 881, 865, 802, 0,
-	830, 0, 880, 739, 822, 0, 742, 746, 876, 858,
-	774, 249, 0, 0, 0, 0, 0, 0, 0, 799,
-	809, 842, 793, 0, 0, 0, 0, 0, 0, 0,
-	772, 0, 818, 0, 0, 0, 751, 743, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 797,
-	0, 0, 0, 754, 0, 773, 843, 0, 737, 268,
-	748, 328, 231, 0, 847, 857, 794, 459, 861, 792,
-	791, 837, 752, 853, 785, 296, 750, 293, 188, 205,
-	0, 783, 338, 379, 385, 852, 769, 777, 229, 775,
-	383, 352, 443, 213, 257, 376, 357, 381, 364, 260,
-	817, 835, 382, 302, 430, 371, 440, 460, 461, 237,
-	332, 450, 419, 456, 472, 206, 234, 346, 412, 446,
-	403, 325, 426, 427, 292, 402, 266, 191, 300, 466,
-	204, 391, 221, 211, 197, 414, 1242, 218, 394, 0,
-	0, 474, 199, 436, 411, 321, 289, 290, 198, 0,
-	375, 242, 264, 232, 341, 433, 434, 230, 475, 208,
-	455, 201, 749, 454, 334, 429, 437, 322, 312, 200,
-	435, 320, 311, 295, 253, 275, 369, 305, 370, 276,
-	330, 329, 331, 194, 447, 0, 195, 0, 408, 448,
-	476, 214, 215, 216, 764, 252, 256, 263, 265, 271,
-	272, 279, 298, 345, 368, 366, 372, 848, 424, 441,
-	451, 458, 464, 465, 467, 468, 469, 470, 471, 736,
-	730, 729, 294, 303, 840, 879, 351, 384, 219, 445,
-	405, 759, 763, 757, 758, 812, 813, 760, 870, 871,
-	872, 477, 478, 479, 480, 481, 482, 483, 484, 485,
-	486, 487, 488, 489, 490, 491, 492, 493, 494, 0,
-	844, 753, 0, 761, 762, 0, 850, 859, 860, 495,
-	313, 396, 442, 816, 187, 202, 299, 875, 373, 261,
-	473, 453, 449, 738, 756, 236, 767, 0, 0, 780,
-	788, 789, 801, 803, 804, 805, 806, 324, 823, 824,
-	826, 834, 836, 839, 841, 846, 856, 878, 189, 190,
-	203, 212, 222, 235, 250, 258, 269, 274, 277, 282,
-	283, 286, 291, 309, 315, 316, 317, 318, 335, 336,
-	337, 340, 343, 344, 347, 349, 350, 353, 360, 361,
-	362, 363, 365, 367, 374, 378, 386, 387, 388, 389,
-	390, 392, 393, 398, 399, 400, 401, 409, 413, 431,
-	432, 444, 457, 462, 270, 439, 463, 0, 308, 815,
-	821, 310, 254, 273, 284, 829, 452, 410, 207, 380,
-	262, 196, 225, 210, 233, 248, 251, 288, 319, 326,
-	355, 359, 267, 245, 223, 377, 220, 395, 416, 417,
-	418, 420, 323, 240, 358, 811, 838, 306, 422, 423,
-	280, 864, 849, 421, 0, 796, 867, 766, 784, 877,
-	787, 790, 831, 745, 810, 342, 781, 0, 770, 740,
-	776, 741, 768, 798, 244, 765, 851, 814, 866, 297,
-	241, 747, 771, 356, 786, 193, 833, 397, 228, 307,
-	304, 428, 255, 247, 243, 227, 281, 314, 354, 415,
-	348, 873, 301, 820, 0, 406, 327, 0, 0, 0,
-	800, 855, 808, 845, 795, 832, 755, 819, 868, 782,
-	828, 869, 287, 226, 192, 339, 407, 259, 0, 0,
-	0, 0, 184, 185, 186, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 217, 0, 224, 778, 825,
-	863, 779, 827, 239, 285, 246, 238, 425, 874, 854,
-	744, 807, 862, 0, 0, 881, 865, 802, 0, 830,
-	0, 880, 739, 822, 0, 742, 746, 876, 858, 774,
-	249, 0, 0, 0, 0, 0, 0, 0, 799, 809,
-	842, 793, 0, 0, 0, 0, 0, 0, 0, 772,
-	0, 818, 0, 0, 0, 751, 743, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 797, 0,
-	0, 0, 754, 0, 773, 843, 0, 737, 268, 748,
-	328, 231, 0, 847, 857, 794, 459, 861, 792, 791,
-	837, 752, 853, 785, 296, 750, 293, 188, 205, 0,
-	783, 338, 379, 385, 852, 769, 777, 229, 775, 383,
-	352, 443, 213, 257, 376, 357, 381, 364, 260, 817,
-	835, 382, 302, 430, 371, 440, 460, 461, 237, 332,
-	450, 419, 456, 472, 206, 234, 346, 412, 446, 403,
-	325, 426, 427, 292, 402, 266, 191, 300, 466, 204,
-	391, 221, 211, 197, 414, 727, 218, 394, 0, 0,
-	474, 199, 436, 411, 321, 289, 290, 198, 0, 375,
-	242, 264, 232, 341, 433, 434, 230, 475, 208, 455,
-	201, 749, 454, 334, 429, 437, 322, 312, 200, 435,
-	320, 311, 295, 253, 275, 369, 305, 370, 276, 330,
-	329, 331, 194, 447, 0, 195, 0, 408, 448, 476,
-	214, 215, 216, 764, 252, 256, 263, 265, 271, 272,
-	279, 298, 345, 368, 366, 372, 848, 424, 441, 451,
-	458, 464, 465, 467, 468, 469, 470, 471, 736, 730,
-	729, 294, 303, 840, 879, 351, 384, 219, 445, 405,
-	759, 763, 757, 758, 812, 813, 760, 870, 871, 872,
-	477, 478, 479, 480, 481, 482, 483, 484, 485, 486,
-	487, 488, 489, 490, 491, 492, 493, 494, 0, 844,
-	753, 0, 761, 762, 0, 850, 859, 860, 495, 313,
-	396, 442, 816, 187, 202, 299, 875, 373, 261, 473,
-	453, 449, 738, 756, 236, 767, 0, 0, 780, 788,
-	789, 801, 803, 804, 805, 806, 324, 823, 824, 826,
-	834, 836, 839, 841, 846, 856, 878, 189, 190, 203,
-	212, 222, 235, 250, 258, 269, 274, 277, 282, 283,
-	286, 291, 309, 315, 316, 317, 318, 335, 336, 337,
-	340, 343, 344, 347, 349, 350, 353, 360, 361, 362,
-	363, 365, 367, 374, 378, 386, 387, 388, 389, 390,
-	392, 393, 398, 399, 400, 401, 409, 413, 431, 432,
-	444, 457, 462, 270, 439, 463, 0, 308, 815, 821,
-	310, 254, 273, 284, 829, 452, 410, 207, 380, 262,
-	196, 225, 210, 233, 248, 251, 288, 319, 326, 355,
-	359, 267, 245, 223, 377, 220, 395, 416, 417, 418,
-	420, 323, 240, 358, 811, 838, 306, 422, 423, 280,
-	421, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 342, 0, 0, 1594, 0, 576, 0, 0,
-	0, 244, 581, 0, 0, 0, 297, 241, 0, 1595,
-	356, 0, 193, 0, 397, 228, 307, 304, 428, 255,
-	247, 243, 227, 281, 314, 354, 415, 348, 588, 301,
-	0, 0, 406, 327, 0, 0, 0, 0, 0, 583,
-	584, 0, 0, 0, 0, 0, 0, 0, 0, 287,
-	226, 192, 339, 407, 259, 0, 87, 0, 0, 184,
-	185, 186, 622, 629, 630, 631, 632, 633, 623, 625,
-	0, 0, 217, 624, 224, 597, 627, 634, 635, 0,
-	239, 285, 246, 238, 425, 0, 0, 0, 0, 0,
-	0, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	559, 573, 0, 587, 0, 0, 0, 249, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 570, 571, 716, 0, 0, 0, 607, 0,
-	572, 0, 0, 580, 636, 637, 638, 639, 640, 641,
-	642, 643, 644, 645, 646, 647, 648, 649, 650, 651,
-	652, 653, 654, 655, 656, 657, 658, 659, 660, 661,
-	662, 663, 664, 665, 666, 667, 668, 669, 670, 671,
-	672, 673, 674, 675, 676, 582, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 268, 0, 328, 231, 0,
-	606, 0, 0, 459, 0, 0, 604, 0, 0, 0,
-	0, 296, 0, 293, 188, 205, 0, 0, 338, 379,
-	385, 0, 0, 0, 229, 0, 383, 352, 443, 213,
-	257, 376, 357, 381, 364, 260, 0, 0, 382, 302,
-	430, 371, 440, 460, 461, 237, 332, 450, 419, 456,
-	472, 206, 234, 346, 412, 446, 403, 325, 426, 427,
-	292, 402, 266, 191, 300, 466, 204, 391, 221, 211,
-	197, 414, 438, 218, 394, 0, 0, 474, 199, 436,
-	411, 321, 289, 290, 198, 0, 375, 242, 264, 232,
-	341, 433, 434, 230, 475, 208, 455, 201, 0, 454,
-	334, 429, 437, 322, 312, 200, 435, 320, 311, 295,
-	253, 275, 369, 305, 370, 276, 330, 329, 331, 194,
-	447, 0, 195, 0, 408, 448, 476, 214, 215, 216,
-	0, 252, 256, 263, 265, 271, 272, 279, 298, 345,
-	368, 366, 372, 0, 424, 441, 451, 458, 464, 465,
-	467, 468, 469, 470, 471, 333, 278, 404, 294, 303,
-	0, 0, 351, 384, 219, 445, 405, 613, 605, 592,
-	594, 614, 615, 589, 590, 593, 616, 477, 478, 479,
-	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
-	490, 491, 492, 493, 494, 0, 608, 579, 578, 0,
-	585, 586, 0, 595, 596, 598, 599, 600, 601, 577,
-	187, 202, 299, 0, 373, 261, 473, 453, 449, 0,
-	0, 236, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 324, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 189, 190, 203, 212, 222, 235,
-	250, 258, 269, 274, 277, 282, 283, 286, 291, 309,
-	315, 316, 317, 318, 335, 336, 337, 340, 343, 344,
-	347, 349, 350, 353, 360, 361, 362, 363, 365, 367,
-	374, 378, 386, 387, 388, 389, 390, 392, 393, 398,
-	399, 400, 401, 409, 413, 431, 432, 444, 457, 462,
-	270, 439, 463, 0, 308, 0, 0, 310, 254, 273,
-	284, 0, 452, 410, 207, 380, 262, 196, 225, 210,
-	233, 248, 251, 288, 319, 326, 355, 359, 267, 245,
-	223, 377, 220, 395, 416, 417, 418, 420, 323, 240,
-	358, 421, 0, 306, 422, 423, 280, 0, 0, 0,
-	0, 0, 0, 342, 0, 0, 0, 0, 576, 0,
-	0, 0, 244, 581, 0, 0, 0, 297, 241, 0,
-	0, 356, 0, 193, 0, 397, 228, 307, 304, 428,
-	255, 247, 243, 227, 281, 314, 354, 415, 348, 588,
-	301, 0, 0, 406, 327, 0, 0, 0, 0, 0,
-	583, 584, 0, 0, 0, 0, 0, 0, 0, 0,
-	287, 226, 192, 339, 407, 259, 0, 87, 0, 0,
-	184, 185, 186, 622, 629, 630, 631, 632, 633, 623,
-	625, 0, 0, 217, 624, 224, 597, 627, 634, 635,
-	0, 239, 285, 246, 238, 425, 0, 0, 1590, 1591,
-	1592, 0, 0, 209, 0, 0, 0, 0, 0, 0,
-	0, 559, 573, 0, 587, 0, 0, 0, 249, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 570, 571, 0, 0, 0, 0, 607,
-	0, 572, 0, 0, 580, 636, 637, 638, 639, 640,
-	641, 642, 643, 644, 645, 646, 647, 648, 649, 650,
-	651, 652, 653, 654, 655, 656, 657, 658, 659, 660,
-	661, 662, 663, 664, 665, 666, 667, 668, 669, 670,
-	671, 672, 673, 674, 675, 676, 582, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 268, 0, 328, 231,
-	0, 606, 0, 0, 459, 0, 0, 604, 0, 0,
-	0, 0, 296, 0, 293, 188, 205, 0, 0, 338,
-	379, 385, 0, 0, 0, 229, 0, 383, 352, 443,
-	213, 257, 376, 357, 381, 364, 260, 0, 0, 382,
-	302, 430, 371, 440, 460, 461, 237, 332, 450, 419,
-	456, 472, 206, 234, 346, 412, 446, 403, 325, 426,
-	427, 292, 402, 266, 191, 300, 466, 204, 391, 221,
-	211, 197, 414, 438, 218, 394, 0, 0, 474, 199,
-	436, 411, 321, 289, 290, 198, 0, 375, 242, 264,
-	232, 341, 433, 434, 230, 475, 208, 455, 201, 0,
-	454, 334, 429, 437, 322, 312, 200, 435, 320, 311,
-	295, 253, 275, 369, 305, 370, 276, 330, 329, 331,
-	194, 447, 0, 195, 0, 408, 448, 476, 214, 215,
-	216, 0, 252, 256, 263, 265, 271, 272, 279, 298,
-	345, 368, 366, 372, 0, 424, 441, 451, 458, 464,
-	465, 467, 468, 469, 470, 471, 333, 278, 404, 294,
-	303, 0, 0, 351, 384, 219, 445, 405, 613, 605,
-	592, 594, 614, 615, 589, 590, 593, 616, 477, 478,
-	479, 480, 481, 482, 483, 484, 485, 486, 487, 488,
-	489, 490, 491, 492, 493, 494, 0, 608, 579, 578,
-	0, 585, 586, 0, 595, 596, 598, 599, 600, 601,
-	577, 187, 202, 299, 0, 373, 261, 473, 453, 449,
-	0, 0, 236, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 324, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 189, 190, 203, 212, 222,
-	235, 250, 258, 269, 274, 277, 282, 283, 286, 291,
-	309, 315, 316, 317, 318, 335, 336, 337, 340, 343,
-	344, 347, 349, 350, 353, 360, 361, 362, 363, 365,
-	367, 374, 378, 386, 387, 388, 389, 390, 392, 393,
-	398, 399, 400, 401, 409, 413, 431, 432, 444, 457,
-	462, 270, 439, 463, 0, 308, 0, 0, 310, 254,
-	273, 284, 0, 452, 410, 207, 380, 262, 196, 225,
-	210, 233, 248, 251, 288, 319, 326, 355, 359, 267,
-	245, 223, 377, 220, 395, 416, 417, 418, 420, 323,
-	240, 358, 421, 0, 306, 422, 423, 280, 0, 0,
-	0, 0, 0, 0, 342, 0, 0, 0, 0, 576,
-	0, 0, 0, 244, 581, 0, 0, 0, 297, 241,
-	0, 0, 356, 0, 193, 0, 397, 228, 307, 304,
-	428, 255, 247, 243, 227, 281, 314, 354, 415, 348,
-	588, 301, 0, 0, 406, 327, 0, 0, 0, 0,
-	0, 583, 584, 0, 0, 0, 0, 0, 0, 1674,
-	0, 287, 226, 192, 339, 407, 259, 0, 87, 0,
-	0, 184, 185, 186, 622, 629, 630, 631, 632, 633,
-	623, 625, 0, 0, 217, 624, 224, 597, 627, 634,
-	635, 1675, 239, 285, 246, 238, 425, 0, 0, 0,
-	0, 0, 0, 0, 209, 0, 0, 0, 0, 0,
-	0, 0, 559, 573, 0, 587, 0, 0, 0, 249,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 570, 571, 0, 0, 0, 0,
-	607, 0, 572, 0, 0, 580, 636, 637, 638, 639,
-	640, 641, 642, 643, 644, 645, 646, 647, 648, 649,
-	650, 651, 652, 653, 654, 655, 656, 657, 658, 659,
-	660, 661, 662, 663, 664, 665, 666, 667, 668, 669,
-	670, 671, 672, 673, 674, 675, 676, 582, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 268, 0, 328,
-	231, 0, 606, 0, 0, 459, 0, 0, 604, 0,
-	0, 0, 0, 296, 0, 293, 188, 205, 0, 0,
-	338, 379, 385, 0, 0, 0, 229, 0, 383, 352,
-	443, 213, 257, 376, 357, 381, 364, 260, 0, 0,
-	382, 302, 430, 371, 440, 460, 461, 237, 332, 450,
-	419, 456, 472, 206, 234, 346, 412, 446, 403, 325,
-	426, 427, 292, 402, 266, 191, 300, 466, 204, 391,
-	221, 211, 197, 414, 438, 218, 394, 0, 0, 474,
-	199, 436, 411, 321, 289, 290, 198, 0, 375, 242,
-	264, 232, 341, 433, 434, 230, 475, 208, 455, 201,
-	0, 454, 334, 429, 437, 322, 312, 200, 435, 320,
-	311, 295, 253, 275, 369, 305, 370, 276, 330, 329,
-	331, 194, 447, 0, 195, 0, 408, 448, 476, 214,
-	215, 216, 0, 252, 256, 263, 265, 271, 272, 279,
-	298, 345, 368, 366, 372, 0, 424, 441, 451, 458,
-	464, 465, 467, 468, 469, 470, 471, 333, 278, 404,
-	294, 303, 0, 0, 351, 384, 219, 445, 405, 613,
-	605, 592, 594, 614, 615, 589, 590, 593, 616, 477,
-	478, 479, 480, 481, 482, 483, 484, 485, 486, 487,
-	488, 489, 490, 491, 492, 493, 494, 0, 608, 579,
-	578, 0, 585, 586, 0, 595, 596, 598, 599, 600,
-	601, 577, 187, 202, 299, 0, 373, 261, 473, 453,
-	449, 0, 0, 236, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 324, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 189, 190, 203, 212,
-	222, 235, 250, 258, 269, 274, 277, 282, 283, 286,
-	291, 309, 315, 316, 317, 318, 335, 336, 337, 340,
-	343, 344, 347, 349, 350, 353, 360, 361, 362, 363,
-	365, 367, 374, 378, 386, 387, 388, 389, 390, 392,
-	393, 398, 399, 400, 401, 409, 413, 431, 432, 444,
-	457, 462, 270, 439, 463, 0, 308, 0, 0, 310,
-	254, 273, 284, 0, 452, 410, 207, 380, 262, 196,
-	225, 210, 233, 248, 251, 288, 319, 326, 355, 359,
-	267, 245, 223, 377, 220, 395, 416, 417, 418, 420,
-	323, 240, 358, 78, 421, 306, 422, 423, 280, 0,
-	0, 0, 0, 0, 0, 0, 342, 0, 0, 0,
-	0, 576, 0, 0, 0, 244, 581, 0, 0, 0,
-	297, 241, 0, 0, 356, 0, 193, 0, 397, 228,
-	307, 304, 428, 255, 247, 243, 227, 281, 314, 354,
-	415, 348, 588, 301, 0, 0, 406, 327, 0, 0,
-	0, 0, 0, 583, 584, 0, 0, 0, 0, 0,
-	0, 0, 0, 287, 226, 192, 339, 407, 259, 0,
-	87, 0, 0, 184, 185, 186, 622, 629, 630, 631,
-	632, 633, 623, 625, 0, 0, 217, 624, 224, 597,
-	627, 634, 635, 0, 239, 285, 246, 238, 425, 0,
-	0, 0, 0, 0, 0, 0, 209, 0, 0, 0,
-	0, 0, 0, 0, 559, 573, 0, 587, 0, 0,
-	0, 249, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 570, 571, 0, 0,
-	0, 0, 607, 0, 572, 0, 0, 580, 636, 637,
-	638, 639, 640, 641, 642, 643, 644, 645, 646, 647,
-	648, 649, 650, 651, 652, 653, 654, 655, 656, 657,
-	658, 659, 660, 661, 662, 663, 664, 665, 666, 667,
-	668, 669, 670, 671, 672, 673, 674, 675, 676, 582,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 268,
-	0, 328, 231, 0, 606, 0, 0, 459, 0, 0,
-	604, 0, 0, 0, 0, 296, 0, 293, 188, 205,
-	0, 0, 338, 379, 385, 0, 0, 0, 229, 0,
-	383, 352, 443, 213, 257, 376, 357, 381, 364, 260,
-	0, 0, 382, 302, 430, 371, 440, 460, 461, 237,
-	332, 450, 419, 456, 472, 206, 234, 346, 412, 446,
-	403, 325, 426, 427, 292, 402, 266, 191, 300, 466,
-	204, 391, 221, 211, 197, 414, 438, 218, 394, 0,
-	0, 474, 199, 436, 411, 321, 289, 290, 198, 0,
-	375, 242, 264, 232, 341, 433, 434, 230, 475, 208,
-	455, 201, 0, 454, 334, 429, 437, 322, 312, 200,
-	435, 320, 311, 295, 253, 275, 369, 305, 370, 276,
-	330, 329, 331, 194, 447, 0, 195, 0, 408, 448,
-	476, 214, 215, 216, 0, 252, 256, 263, 265, 271,
-	272, 279, 298, 345, 368, 366, 372, 0, 424, 441,
-	451, 458, 464, 465, 467, 468, 469, 470, 471, 333,
-	278, 404, 294, 303, 0, 0, 351, 384, 219, 445,
-	405, 613, 605, 592, 594, 614, 615, 589, 590, 593,
-	616, 477, 478, 479, 480, 481, 482, 483, 484, 485,
-	486, 487, 488, 489, 490, 491, 492, 493, 494, 0,
-	608, 579, 578, 0, 585, 586, 0, 595, 596, 598,
-	599, 600, 601, 577, 187, 202, 299, 86, 373, 261,
-	473, 453, 449, 0, 0, 236, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 324, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 189, 190,
-	203, 212, 222, 235, 250, 258, 269, 274, 277, 282,
-	283, 286, 291, 309, 315, 316, 317, 318, 335, 336,
-	337, 340, 343, 344, 347, 349, 350, 353, 360, 361,
-	362, 363, 365, 367, 374, 378, 386, 387, 388, 389,
-	390, 392, 393, 398, 399, 400, 401, 409, 413, 431,
-	432, 444, 457, 462, 270, 439, 463, 0, 308, 0,
-	0, 310, 254, 273, 284, 0, 452, 410, 207, 380,
-	262, 196, 225, 210, 233, 248, 251, 288, 319, 326,
-	355, 359, 267, 245, 223, 377, 220, 395, 416, 417,
-	418, 420, 323, 240, 358, 421, 0, 306, 422, 423,
-	280, 0, 0, 0, 0, 0, 0, 342, 0, 0,
-	0, 0, 576, 0, 0, 0, 244, 581, 0, 0,
-	0, 297, 241, 0, 0, 356, 0, 193, 0, 397,
-	228, 307, 304, 428, 255, 247, 243, 227, 281, 314,
-	354, 415, 348, 588, 301, 0, 0, 406, 327, 0,
-	0, 0, 0, 0, 583, 584, 0, 0, 0, 0,
-	0, 0, 0, 0, 287, 226, 192, 339, 407, 259,
-	0, 87, 0, 0, 184, 185, 186, 622, 629, 630,
-	631, 632, 633, 623, 625, 0, 0, 217, 624, 224,
-	597, 627, 634, 635, 0, 239, 285, 246, 238, 425,
-	0, 0, 0, 0, 0, 0, 0, 209, 0, 0,
-	0, 0, 0, 0, 0, 559, 573, 0, 587, 0,
-	0, 0, 249, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 570, 571, 0,
-	0, 0, 0, 607, 0, 572, 0, 0, 580, 636,
-	637, 638, 639, 640, 641, 642, 643, 644, 645, 646,
-	647, 648, 649, 650, 651, 652, 653, 654, 655, 656,
+	159, 0, 160, 0, 0, 0, 0, 0, 1365, 1366,
+	151, 150, 177, 183, 0, 0, 183, 183, 183, 0,
+	0, 0, 0, 0, 0, 0, 549, 549, 0, 0,
+	0, 0, 0, 0, 0, 734, 0, 0, 0, 0,
+	0, 0, 734, 734, 734, 0, 0, 0, 0, 1207,
+	0, 0, 0, 549, 549, 549, 549, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 734, 1480, 734, 734, 734,
+	734, 734, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1434, 0, 183, 0, 0, 0,
+	146, 1367, 153, 0, 1364, 0, 147, 148, 0, 734,
+	0, 0, 163, 0, 0, 0, 0, 0, 0, 0,
+	549, 168, 549, 716, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 503, 0, 0, 0,
+	0, 0, 1480, 0, 503, 0, 503, 0, 503, 1715,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 749, 749, 749, 549, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1483, 0, 0, 0,
+	1085, 1087, 0, 0, 0, 549, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 549, 0, 0,
+	0, 549, 549, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 155, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	549, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	549, 0, 0, 0, 0, 0, 0, 0, 1536, 1537,
+	1538, 1539, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1206, 0, 549, 183, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 183, 0,
+	0, 0, 0, 0, 0, 0, 0, 149, 1577, 1578,
+	549, 0, 0, 0, 0, 0, 0, 1584, 0, 0,
+	0, 0, 549, 143, 0, 0, 144, 0, 0, 1598,
+	561, 561, 1601, 1602, 1603, 503, 549, 549, 1221, 1222,
+	0, 0, 503, 1630, 1631, 1632, 1634, 503, 503, 0,
+	0, 503, 549, 1855, 0, 0, 0, 0, 0, 0,
+	503, 0, 0, 0, 0, 0, 0, 503, 0, 0,
+	0, 0, 0, 1264, 0, 0, 0, 561, 0, 0,
+	0, 0, 749, 0, 0, 0, 0, 0, 1284, 0,
+	0, 0, 0, 0, 549, 503, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 549,
+	0, 0, 0, 1690, 1691, 0, 0, 0, 0, 0,
+	0, 549, 0, 0, 549, 0, 0, 0, 0, 156,
+	161, 158, 164, 165, 166, 167, 169, 170, 171, 172,
+	1724, 0, 1244, 1246, 0, 173, 174, 175, 176, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 734, 0, 0, 0, 0, 0, 0, 0, 0,
+	549, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	549, 0, 0, 0, 0, 0, 0, 0, 0, 1762,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 734, 734, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1480, 0, 0, 0,
+	0, 0, 503, 0, 0, 0, 0, 0, 0, 0,
+	1434, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 644, 79, 0,
+	0, 0, 0, 0, 0, 904, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 503, 503, 1206, 0,
+	0, 0, 1388, 1388, 0, 1388, 0, 1388, 1388, 0,
+	1397, 1388, 1388, 1388, 1388, 1388, 0, 0, 0, 0,
+	0, 0, 0, 1206, 1206, 904, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 706, 1458, 79, 0, 0,
+	0, 503, 0, 0, 0, 0, 0, 0, 2047, 0,
+	0, 0, 1478, 0, 0, 706, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 749, 749, 749, 0, 503,
+	503, 503, 503, 503, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 503, 503, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 503, 0, 0, 0, 0, 0, 1895, 0,
+	0, 0, 0, 0, 0, 0, 734, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1162, 0, 0, 0, 1580, 0, 0, 0, 734,
+	0, 0, 0, 1206, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1606, 1607, 0, 0, 0, 0, 0, 0,
+	749, 0, 503, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1207,
+	1259, 0, 0, 0, 1207, 503, 503, 503, 503, 503,
+	0, 0, 0, 1671, 0, 0, 0, 2215, 0, 0,
+	0, 0, 503, 1434, 0, 503, 0, 0, 503, 2224,
+	1480, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1683, 0, 0, 0, 0, 0, 0, 1592, 1593, 1594,
+	1595, 1596, 1597, 1264, 0, 0, 749, 0, 0, 0,
+	0, 0, 1604, 0, 749, 0, 0, 749, 0, 503,
+	0, 0, 0, 0, 0, 0, 0, 0, 904, 0,
+	0, 0, 0, 0, 0, 1207, 0, 0, 0, 0,
+	0, 1244, 1652, 0, 0, 0, 503, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 503, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1218, 0,
+	0, 503, 0, 0, 503, 0, 0, 1679, 0, 0,
+	0, 0, 0, 911, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 904, 0,
+	0, 0, 0, 0, 911, 0, 0, 0, 0, 0,
+	0, 0, 502, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 551, 0, 0, 0, 0, 0, 0, 0,
+	696, 503, 0, 0, 0, 0, 0, 2131, 2132, 0,
+	0, 0, 2135, 0, 0, 0, 904, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2144, 0, 2145, 2146,
+	2147, 0, 0, 0, 0, 0, 0, 2152, 0, 0,
+	0, 908, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 503, 0, 0, 0,
+	1077, 1077, 1077, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 503,
+	79, 0, 0, 0, 0, 0, 0, 0, 0, 2187,
+	0, 0, 0, 0, 0, 0, 0, 503, 0, 0,
+	503, 503, 503, 0, 0, 0, 0, 0, 706, 1149,
+	1150, 1151, 0, 1154, 0, 1156, 1157, 1158, 1159, 1160,
+	1863, 1163, 1165, 1166, 1167, 1168, 1169, 1170, 1171, 1172,
+	1173, 1174, 1175, 1176, 1165, 0, 1165, 1180, 1180, 1182,
+	1183, 1184, 1185, 1186, 1187, 1188, 1189, 0, 1191, 1192,
+	1193, 1194, 1195, 0, 0, 0, 0, 1180, 1180, 1180,
+	1180, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2273, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1434, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1207,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1219, 0, 0, 0, 0, 0,
+	706, 0, 0, 0, 706, 0, 0, 0, 0, 0,
+	706, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	749, 0, 0, 0, 2334, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1881, 0, 2344,
+	1683, 1885, 0, 1886, 1887, 1888, 1950, 0, 0, 0,
+	0, 0, 0, 1896, 0, 0, 0, 1965, 0, 0,
+	1903, 1904, 1905, 1906, 1907, 0, 0, 1909, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 561, 0, 0, 0, 0, 0,
+	0, 0, 0, 1915, 1916, 0, 1918, 0, 0, 0,
+	1920, 0, 0, 0, 0, 0, 0, 1925, 1926, 1927,
+	1928, 1929, 0, 1679, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1943, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2038, 0, 0, 0, 0, 0, 0, 0, 0, 503,
+	0, 0, 0, 0, 0, 0, 0, 0, 2432, 0,
+	0, 0, 503, 0, 1683, 0, 0, 0, 0, 0,
+	0, 2063, 0, 0, 2447, 0, 0, 0, 0, 0,
+	2068, 0, 0, 0, 1207, 0, 0, 0, 1311, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1017, 0,
+	1023, 0, 0, 1025, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2461, 0, 2462, 0,
+	0, 0, 0, 0, 0, 0, 0, 2469, 2470, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2499, 0, 0, 0, 0, 0, 2138, 0,
+	0, 0, 0, 2507, 0, 0, 2509, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2512, 0,
+	0, 0, 0, 0, 749, 0, 0, 0, 0, 2519,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1388, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1299, 0, 0, 0, 0, 0, 561, 0,
+	2174, 0, 0, 0, 0, 0, 0, 0, 0, 749,
+	0, 0, 1207, 1206, 0, 0, 2199, 1388, 1206, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1077, 1077, 1077, 0, 0, 0, 0,
+	0, 0, 2569, 561, 0, 2148, 0, 0, 0, 0,
+	0, 0, 0, 2154, 2155, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1312, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 561,
+	0, 0, 0, 0, 0, 0, 904, 0, 0, 1206,
+	0, 0, 0, 0, 0, 1683, 1271, 0, 2198, 561,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2216, 2217, 1325, 1328, 1329,
+	1330, 1331, 1332, 1333, 0, 1334, 1335, 1336, 1337, 1338,
+	1313, 1314, 1315, 1316, 1297, 1298, 1326, 0, 1300, 0,
+	1301, 1302, 1303, 1304, 1305, 1306, 1307, 1308, 1309, 1310,
+	1317, 1318, 1319, 1320, 1321, 1322, 1323, 1324, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2337, 0, 0,
+	0, 0, 0, 0, 0, 2666, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2174, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1710, 0, 0, 0, 0, 0, 0, 2316, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1327, 2326, 2327, 2329, 2331, 0, 0, 0, 0, 0,
+	1289, 0, 0, 0, 0, 0, 2338, 0, 0, 2341,
+	0, 0, 0, 0, 2345, 0, 0, 0, 2346, 0,
+	0, 0, 0, 0, 0, 2354, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1683, 1683, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2376, 2377,
+	0, 0, 2381, 0, 0, 0, 0, 0, 0, 0,
+	0, 2455, 2456, 2457, 2458, 0, 0, 0, 1419, 0,
+	2393, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1460, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1490,
+	0, 0, 0, 1206, 2442, 0, 1494, 0, 2503, 0,
+	2505, 0, 0, 0, 0, 0, 0, 1505, 1506, 1507,
+	1508, 1509, 1510, 1511, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1529, 0, 2465, 0, 0,
+	0, 0, 0, 1683, 0, 0, 2473, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 749, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2559, 0, 0, 0, 2559,
+	2559, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1683, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1683, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2537,
+	0, 0, 1683, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2547, 2548, 2549, 0, 0, 0, 0,
+	0, 2552, 2553, 0, 0, 0, 0, 2558, 2617, 0,
+	1923, 0, 0, 0, 0, 0, 0, 0, 1206, 0,
+	2621, 0, 0, 0, 0, 0, 0, 0, 0, 1696,
+	1941, 0, 0, 0, 749, 749, 0, 1700, 0, 1703,
+	0, 0, 1529, 0, 2584, 87, 0, 0, 0, 706,
+	2650, 638, 645, 646, 647, 648, 649, 639, 641, 0,
+	0, 0, 640, 0, 0, 643, 650, 651, 0, 1976,
+	1977, 0, 0, 0, 0, 0, 2598, 0, 1996, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2611,
+	0, 0, 2617, 0, 0, 2612, 2613, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1683, 0, 0,
+	0, 0, 0, 2290, 2291, 0, 0, 0, 0, 2695,
+	0, 0, 2337, 0, 2629, 652, 653, 654, 655, 656,
 	657, 658, 659, 660, 661, 662, 663, 664, 665, 666,
 	667, 668, 669, 670, 671, 672, 673, 674, 675, 676,
-	582, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	268, 0, 328, 231, 0, 606, 0, 0, 459, 0,
-	0, 604, 0, 0, 0, 0, 296, 0, 293, 188,
-	205, 0, 0, 338, 379, 385, 0, 0, 0, 229,
-	0, 383, 352, 443, 213, 257, 376, 357, 381, 364,
-	260, 2503, 0, 382, 302, 430, 371, 440, 460, 461,
-	237, 332, 450, 419, 456, 472, 206, 234, 346, 412,
-	446, 403, 325, 426, 427, 292, 402, 266, 191, 300,
-	466, 204, 391, 221, 211, 197, 414, 438, 218, 394,
-	0, 0, 474, 199, 436, 411, 321, 289, 290, 198,
-	0, 375, 242, 264, 232, 341, 433, 434, 230, 475,
-	208, 455, 201, 0, 454, 334, 429, 437, 322, 312,
-	200, 435, 320, 311, 295, 253, 275, 369, 305, 370,
-	276, 330, 329, 331, 194, 447, 0, 195, 0, 408,
-	448, 476, 214, 215, 216, 0, 252, 256, 263, 265,
-	271, 272, 279, 298, 345, 368, 366, 372, 0, 424,
-	441, 451, 458, 464, 465, 467, 468, 469, 470, 471,
-	333, 278, 404, 294, 303, 0, 0, 351, 384, 219,
-	445, 405, 613, 605, 592, 594, 614, 615, 589, 590,
-	593, 616, 477, 478, 479, 480, 481, 482, 483, 484,
-	485, 486, 487, 488, 489, 490, 491, 492, 493, 494,
-	0, 608, 579, 578, 0, 585, 586, 0, 595, 596,
-	598, 599, 600, 601, 577, 187, 202, 299, 0, 373,
-	261, 473, 453, 449, 0, 0, 236, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 324, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	190, 203, 212, 222, 235, 250, 258, 269, 274, 277,
-	282, 283, 286, 291, 309, 315, 316, 317, 318, 335,
-	336, 337, 340, 343, 344, 347, 349, 350, 353, 360,
-	361, 362, 363, 365, 367, 374, 378, 386, 387, 388,
-	389, 390, 392, 393, 398, 399, 400, 401, 409, 413,
-	431, 432, 444, 457, 462, 270, 439, 463, 0, 308,
-	0, 0, 310, 254, 273, 284, 0, 452, 410, 207,
-	380, 262, 196, 225, 210, 233, 248, 251, 288, 319,
-	326, 355, 359, 267, 245, 223, 377, 220, 395, 416,
-	417, 418, 420, 323, 240, 358, 421, 0, 306, 422,
-	423, 280, 0, 0, 0, 0, 0, 0, 342, 0,
-	0, 0, 0, 576, 0, 0, 0, 244, 581, 0,
-	0, 0, 297, 241, 0, 0, 356, 0, 193, 0,
-	397, 228, 307, 304, 428, 255, 247, 243, 227, 281,
-	314, 354, 415, 348, 588, 301, 0, 0, 406, 327,
-	0, 0, 0, 0, 0, 583, 584, 0, 0, 0,
-	0, 0, 0, 0, 0, 287, 226, 192, 339, 407,
-	259, 0, 87, 0, 1210, 184, 185, 186, 622, 629,
-	630, 631, 632, 633, 623, 625, 0, 0, 217, 624,
-	224, 597, 627, 634, 635, 0, 239, 285, 246, 238,
-	425, 0, 0, 0, 0, 0, 0, 0, 209, 0,
-	0, 0, 0, 0, 0, 0, 559, 573, 0, 587,
-	0, 0, 0, 249, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 570, 571,
-	0, 0, 0, 0, 607, 0, 572, 0, 0, 580,
-	636, 637, 638, 639, 640, 641, 642, 643, 644, 645,
-	646, 647, 648, 649, 650, 651, 652, 653, 654, 655,
-	656, 657, 658, 659, 660, 661, 662, 663, 664, 665,
-	666, 667, 668, 669, 670, 671, 672, 673, 674, 675,
-	676, 582, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 268, 0, 328, 231, 0, 606, 0, 0, 459,
-	0, 0, 604, 0, 0, 0, 0, 296, 0, 293,
-	188, 205, 0, 0, 338, 379, 385, 0, 0, 0,
-	229, 0, 383, 352, 443, 213, 257, 376, 357, 381,
-	364, 260, 0, 0, 382, 302, 430, 371, 440, 460,
-	461, 237, 332, 450, 419, 456, 472, 206, 234, 346,
-	412, 446, 403, 325, 426, 427, 292, 402, 266, 191,
-	300, 466, 204, 391, 221, 211, 197, 414, 438, 218,
-	394, 0, 0, 474, 199, 436, 411, 321, 289, 290,
-	198, 0, 375, 242, 264, 232, 341, 433, 434, 230,
-	475, 208, 455, 201, 0, 454, 334, 429, 437, 322,
-	312, 200, 435, 320, 311, 295, 253, 275, 369, 305,
-	370, 276, 330, 329, 331, 194, 447, 0, 195, 0,
-	408, 448, 476, 214, 215, 216, 0, 252, 256, 263,
-	265, 271, 272, 279, 298, 345, 368, 366, 372, 0,
-	424, 441, 451, 458, 464, 465, 467, 468, 469, 470,
-	471, 333, 278, 404, 294, 303, 0, 0, 351, 384,
-	219, 445, 405, 613, 605, 592, 594, 614, 615, 589,
-	590, 593, 616, 477, 478, 479, 480, 481, 482, 483,
-	484, 485, 486, 487, 488, 489, 490, 491, 492, 493,
-	494, 0, 608, 579, 578, 0, 585, 586, 0, 595,
-	596, 598, 599, 600, 601, 577, 187, 202, 299, 0,
-	373, 261, 473, 453, 449, 0, 0, 236, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 324,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	189, 190, 203, 212, 222, 235, 250, 258, 269, 274,
-	277, 282, 283, 286, 291, 309, 315, 316, 317, 318,
-	335, 336, 337, 340, 343, 344, 347, 349, 350, 353,
-	360, 361, 362, 363, 365, 367, 374, 378, 386, 387,
-	388, 389, 390, 392, 393, 398, 399, 400, 401, 409,
-	413, 431, 432, 444, 457, 462, 270, 439, 463, 0,
-	308, 0, 0, 310, 254, 273, 284, 0, 452, 410,
-	207, 380, 262, 196, 225, 210, 233, 248, 251, 288,
-	319, 326, 355, 359, 267, 245, 223, 377, 220, 395,
-	416, 417, 418, 420, 323, 240, 358, 421, 0, 306,
-	422, 423, 280, 0, 0, 0, 0, 0, 0, 342,
-	0, 0, 0, 0, 576, 0, 0, 0, 244, 581,
-	0, 0, 0, 297, 241, 0, 0, 356, 0, 193,
-	0, 397, 228, 307, 304, 428, 255, 247, 243, 227,
-	281, 314, 354, 415, 348, 588, 301, 0, 0, 406,
-	327, 0, 0, 0, 0, 0, 583, 584, 0, 0,
-	0, 0, 0, 0, 0, 0, 287, 226, 192, 339,
-	407, 259, 0, 87, 0, 0, 184, 185, 186, 622,
-	629, 630, 631, 632, 633, 623, 625, 0, 0, 217,
-	624, 224, 597, 627, 634, 635, 0, 239, 285, 246,
-	238, 425, 0, 0, 0, 0, 0, 0, 0, 209,
-	0, 0, 0, 0, 0, 0, 0, 559, 573, 0,
-	587, 0, 0, 0, 249, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 570,
-	571, 716, 0, 0, 0, 607, 0, 572, 0, 0,
-	580, 636, 637, 638, 639, 640, 641, 642, 643, 644,
-	645, 646, 647, 648, 649, 650, 651, 652, 653, 654,
-	655, 656, 657, 658, 659, 660, 661, 662, 663, 664,
-	665, 666, 667, 668, 669, 670, 671, 672, 673, 674,
-	675, 676, 582, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 268, 0, 328, 231, 0, 606, 0, 0,
-	459, 0, 0, 604, 0, 0, 0, 0, 296, 0,
-	293, 188, 205, 0, 0, 338, 379, 385, 0, 0,
-	0, 229, 0, 383, 352, 443, 213, 257, 376, 357,
-	381, 364, 260, 0, 0, 382, 302, 430, 371, 440,
-	460, 461, 237, 332, 450, 419, 456, 472, 206, 234,
-	346, 412, 446, 403, 325, 426, 427, 292, 402, 266,
-	191, 300, 466, 204, 391, 221, 211, 197, 414, 438,
-	218, 394, 0, 0, 474, 199, 436, 411, 321, 289,
-	290, 198, 0, 375, 242, 264, 232, 341, 433, 434,
-	230, 475, 208, 455, 201, 0, 454, 334, 429, 437,
-	322, 312, 200, 435, 320, 311, 295, 253, 275, 369,
-	305, 370, 276, 330, 329, 331, 194, 447, 0, 195,
-	0, 408, 448, 476, 214, 215, 216, 0, 252, 256,
-	263, 265, 271, 272, 279, 298, 345, 368, 366, 372,
-	0, 424, 441, 451, 458, 464, 465, 467, 468, 469,
-	470, 471, 333, 278, 404, 294, 303, 0, 0, 351,
-	384, 219, 445, 405, 613, 605, 592, 594, 614, 615,
-	589, 590, 593, 616, 477, 478, 479, 480, 481, 482,
-	483, 484, 485, 486, 487, 488, 489, 490, 491, 492,
-	493, 494, 0, 608, 579, 578, 0, 585, 586, 0,
-	595, 596, 598, 599, 600, 601, 577, 187, 202, 299,
-	0, 373, 261, 473, 453, 449, 0, 0, 236, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	324, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 189, 190, 203, 212, 222, 235, 250, 258, 269,
-	274, 277, 282, 283, 286, 291, 309, 315, 316, 317,
-	318, 335, 336, 337, 340, 343, 344, 347, 349, 350,
-	353, 360, 361, 362, 363, 365, 367, 374, 378, 386,
-	387, 388, 389, 390, 392, 393, 398, 399, 400, 401,
-	409, 413, 431, 432, 444, 457, 462, 270, 439, 463,
-	0, 308, 0, 0, 310, 254, 273, 284, 0, 452,
-	410, 207, 380, 262, 196, 225, 210, 233, 248, 251,
-	288, 319, 326, 355, 359, 267, 245, 223, 377, 220,
-	395, 416, 417, 418, 420, 323, 240, 358, 421, 0,
-	306, 422, 423, 280, 0, 0, 0, 0, 0, 0,
-	342, 0, 0, 0, 0, 576, 0, 0, 0, 244,
-	581, 0, 0, 0, 297, 241, 0, 0, 356, 0,
-	193, 0, 397, 228, 307, 304, 428, 255, 247, 243,
-	227, 281, 314, 354, 415, 348, 588, 301, 0, 0,
-	406, 327, 0, 0, 0, 0, 0, 583, 584, 0,
-	0, 0, 0, 0, 0, 0, 0, 287, 226, 192,
-	339, 407, 259, 0, 87, 0, 0, 184, 185, 186,
-	622, 629, 630, 631, 632, 633, 623, 625, 0, 0,
-	217, 624, 224, 597, 627, 634, 635, 0, 239, 285,
-	246, 238, 425, 0, 0, 0, 0, 0, 0, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 559, 573,
-	0, 587, 0, 0, 0, 249, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	570, 571, 0, 0, 0, 0, 607, 0, 572, 0,
-	0, 580, 636, 637, 638, 639, 640, 641, 642, 643,
-	644, 645, 646, 647, 648, 649, 650, 651, 652, 653,
-	654, 655, 656, 657, 658, 659, 660, 661, 662, 663,
-	664, 665, 666, 667, 668, 669, 670, 671, 672, 673,
-	674, 675, 676, 582, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 268, 0, 328, 231, 0, 606, 0,
-	0, 459, 0, 0, 604, 0, 0, 0, 0, 296,
-	0, 293, 188, 205, 0, 0, 338, 379, 385, 0,
-	0, 0, 229, 0, 383, 352, 443, 213, 257, 376,
-	357, 381, 364, 260, 0, 0, 382, 302, 430, 371,
-	440, 460, 461, 237, 332, 450, 419, 456, 472, 206,
-	234, 346, 412, 446, 403, 325, 426, 427, 292, 402,
-	266, 191, 300, 466, 204, 391, 221, 211, 197, 414,
-	438, 218, 394, 0, 0, 474, 199, 436, 411, 321,
-	289, 290, 198, 0, 375, 242, 264, 232, 341, 433,
-	434, 230, 475, 208, 455, 201, 0, 454, 334, 429,
-	437, 322, 312, 200, 435, 320, 311, 295, 253, 275,
-	369, 305, 370, 276, 330, 329, 331, 194, 447, 0,
-	195, 0, 408, 448, 476, 214, 215, 216, 0, 252,
-	256, 263, 265, 271, 272, 279, 298, 345, 368, 366,
-	372, 0, 424, 441, 451, 458, 464, 465, 467, 468,
-	469, 470, 471, 333, 278, 404, 294, 303, 0, 0,
-	351, 384, 219, 445, 405, 613, 605, 592, 594, 614,
-	615, 589, 590, 593, 616, 477, 478, 479, 480, 481,
-	482, 483, 484, 485, 486, 487, 488, 489, 490, 491,
-	492, 493, 494, 0, 608, 579, 578, 0, 585, 586,
-	0, 595, 596, 598, 599, 600, 601, 577, 187, 202,
-	299, 0, 373, 261, 473, 453, 449, 0, 0, 236,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 324, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 189, 190, 203, 212, 222, 235, 250, 258,
-	269, 274, 277, 282, 283, 286, 291, 309, 315, 316,
-	317, 318, 335, 336, 337, 340, 343, 344, 347, 349,
-	350, 353, 360, 361, 362, 363, 365, 367, 374, 378,
-	386, 387, 388, 389, 390, 392, 393, 398, 399, 400,
-	401, 409, 413, 431, 432, 444, 457, 462, 270, 439,
-	463, 0, 308, 0, 0, 310, 254, 273, 284, 0,
-	452, 410, 207, 380, 262, 196, 225, 210, 233, 248,
-	251, 288, 319, 326, 355, 359, 267, 245, 223, 377,
-	220, 395, 416, 417, 418, 420, 323, 240, 358, 421,
-	0, 306, 422, 423, 280, 0, 0, 0, 0, 0,
-	0, 342, 0, 0, 0, 0, 576, 0, 0, 0,
-	244, 581, 0, 0, 0, 297, 241, 0, 0, 356,
-	0, 193, 0, 397, 228, 307, 304, 428, 255, 247,
-	243, 227, 281, 314, 354, 415, 348, 588, 301, 0,
-	0, 406, 327, 0, 0, 0, 0, 0, 583, 584,
-	0, 0, 0, 0, 0, 0, 0, 0, 287, 226,
-	192, 339, 407, 259, 0, 87, 0, 0, 184, 185,
-	186, 622, 629, 630, 631, 632, 633, 623, 625, 0,
-	0, 217, 624, 224, 597, 627, 634, 635, 0, 239,
-	285, 246, 238, 425, 0, 0, 0, 0, 0, 0,
-	0, 209, 0, 0, 0, 0, 0, 0, 0, 0,
-	573, 0, 587, 0, 0, 0, 249, 0, 0, 0,
+	677, 678, 679, 680, 681, 682, 683, 684, 685, 686,
+	687, 688, 689, 690, 691, 692, 0, 0, 2738, 0,
+	0, 0, 0, 0, 0, 0, 1206, 0, 0, 2069,
+	0, 0, 0, 0, 0, 0, 0, 0, 2752, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 570, 571, 0, 0, 0, 0, 607, 0, 572,
-	0, 0, 580, 636, 637, 638, 639, 640, 641, 642,
-	643, 644, 645, 646, 647, 648, 649, 650, 651, 652,
-	653, 654, 655, 656, 657, 658, 659, 660, 661, 662,
-	663, 664, 665, 666, 667, 668, 669, 670, 671, 672,
-	673, 674, 675, 676, 582, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 268, 0, 328, 231, 0, 606,
-	0, 0, 459, 0, 0, 604, 0, 0, 0, 0,
-	296, 0, 293, 188, 205, 0, 0, 338, 379, 385,
-	0, 0, 0, 229, 0, 383, 352, 443, 213, 257,
-	376, 357, 381, 364, 260, 0, 0, 382, 302, 430,
-	371, 440, 460, 461, 237, 332, 450, 419, 456, 472,
-	206, 234, 346, 412, 446, 403, 325, 426, 427, 292,
-	402, 266, 191, 300, 466, 204, 391, 221, 211, 197,
-	414, 438, 218, 394, 0, 0, 474, 199, 436, 411,
-	321, 289, 290, 198, 0, 375, 242, 264, 232, 341,
-	433, 434, 230, 475, 208, 455, 201, 0, 454, 334,
-	429, 437, 322, 312, 200, 435, 320, 311, 295, 253,
-	275, 369, 305, 370, 276, 330, 329, 331, 194, 447,
-	0, 195, 0, 408, 448, 476, 214, 215, 216, 0,
-	252, 256, 263, 265, 271, 272, 279, 298, 345, 368,
-	366, 372, 0, 424, 441, 451, 458, 464, 465, 467,
-	468, 469, 470, 471, 333, 278, 404, 294, 303, 0,
-	0, 351, 384, 219, 445, 405, 613, 605, 592, 594,
-	614, 615, 589, 590, 593, 616, 477, 478, 479, 480,
-	481, 482, 483, 484, 485, 486, 487, 488, 489, 490,
-	491, 492, 493, 494, 0, 608, 579, 578, 0, 585,
-	586, 0, 595, 596, 598, 599, 600, 601, 577, 187,
-	202, 299, 0, 373, 261, 473, 453, 449, 0, 0,
-	236, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 324, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 189, 190, 203, 212, 222, 235, 250,
-	258, 269, 274, 277, 282, 283, 286, 291, 309, 315,
-	316, 317, 318, 335, 336, 337, 340, 343, 344, 347,
-	349, 350, 353, 360, 361, 362, 363, 365, 367, 374,
-	378, 386, 387, 388, 389, 390, 392, 393, 398, 399,
-	400, 401, 409, 413, 431, 432, 444, 457, 462, 270,
-	439, 463, 0, 308, 0, 0, 310, 254, 273, 284,
-	0, 452, 410, 207, 380, 262, 196, 225, 210, 233,
-	248, 251, 288, 319, 326, 355, 359, 267, 245, 223,
-	377, 220, 395, 416, 417, 418, 420, 323, 240, 358,
-	421, 0, 306, 422, 423, 280, 0, 0, 0, 0,
-	0, 0, 342, 0, 0, 0, 0, 0, 0, 0,
-	0, 244, 0, 0, 0, 0, 297, 241, 0, 0,
-	356, 0, 193, 0, 397, 228, 307, 304, 428, 255,
-	247, 243, 227, 281, 314, 354, 415, 348, 0, 301,
-	0, 0, 406, 327, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 287,
-	226, 192, 339, 407, 259, 0, 0, 0, 0, 184,
-	185, 186, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 217, 0, 224, 0, 0, 0, 0, 0,
-	239, 285, 246, 238, 425, 0, 0, 0, 0, 0,
-	0, 0, 209, 0, 929, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 249, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1529, 0,
+	2714, 0, 0, 0, 0, 1838, 0, 0, 0, 0,
+	1848, 1849, 0, 0, 1853, 0, 0, 0, 0, 0,
+	0, 0, 0, 1856, 0, 0, 0, 0, 0, 0,
+	1859, 0, 0, 0, 0, 0, 2137, 0, 0, 2747,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1862, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 87, 0, 0, 0, 0,
+	0, 638, 645, 646, 647, 648, 649, 639, 641, 0,
+	0, 0, 640, 0, 1710, 643, 650, 651, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2196, 0, 79, 0, 0, 1710, 1710, 1710,
+	1710, 1710, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1996, 0, 0, 1710, 0, 0,
+	1710, 0, 0, 2290, 2291, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 652, 653, 654, 655, 656,
+	657, 658, 659, 660, 661, 662, 663, 664, 665, 666,
+	667, 668, 669, 670, 671, 672, 673, 674, 675, 676,
+	677, 678, 679, 680, 681, 682, 683, 684, 685, 686,
+	687, 688, 689, 690, 691, 692, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2286, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 268, 0, 328, 231, 0,
-	0, 0, 928, 459, 0, 0, 0, 0, 0, 925,
-	926, 296, 889, 293, 188, 205, 919, 923, 338, 379,
-	385, 0, 0, 0, 229, 0, 383, 352, 443, 213,
-	257, 376, 357, 381, 364, 260, 0, 0, 382, 302,
-	430, 371, 440, 460, 461, 237, 332, 450, 419, 456,
-	472, 206, 234, 346, 412, 446, 403, 325, 426, 427,
-	292, 402, 266, 191, 300, 466, 204, 391, 221, 211,
-	197, 414, 438, 218, 394, 0, 0, 474, 199, 436,
-	411, 321, 289, 290, 198, 0, 375, 242, 264, 232,
-	341, 433, 434, 230, 475, 208, 455, 201, 0, 454,
-	334, 429, 437, 322, 312, 200, 435, 320, 311, 295,
-	253, 275, 369, 305, 370, 276, 330, 329, 331, 194,
-	447, 0, 195, 0, 408, 448, 476, 214, 215, 216,
-	0, 252, 256, 263, 265, 271, 272, 279, 298, 345,
-	368, 366, 372, 0, 424, 441, 451, 458, 464, 465,
-	467, 468, 469, 470, 471, 333, 278, 404, 294, 303,
-	0, 0, 351, 384, 219, 445, 405, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 477, 478, 479,
-	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
-	490, 491, 492, 493, 494, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 495, 313, 396, 442, 0,
-	187, 202, 299, 0, 373, 261, 473, 453, 449, 0,
-	0, 236, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 324, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 189, 190, 203, 212, 222, 235,
-	250, 258, 269, 274, 277, 282, 283, 286, 291, 309,
-	315, 316, 317, 318, 335, 336, 337, 340, 343, 344,
-	347, 349, 350, 353, 360, 361, 362, 363, 365, 367,
-	374, 378, 386, 387, 388, 389, 390, 392, 393, 398,
-	399, 400, 401, 409, 413, 431, 432, 444, 457, 462,
-	270, 439, 463, 0, 308, 0, 0, 310, 254, 273,
-	284, 0, 452, 410, 207, 380, 262, 196, 225, 210,
-	233, 248, 251, 288, 319, 326, 355, 359, 267, 245,
-	223, 377, 220, 395, 416, 417, 418, 420, 323, 240,
-	358, 421, 0, 306, 422, 423, 280, 0, 0, 0,
-	0, 0, 0, 342, 0, 0, 0, 1230, 0, 0,
-	0, 0, 244, 0, 0, 0, 0, 297, 241, 0,
-	0, 356, 0, 193, 0, 397, 228, 307, 304, 428,
-	255, 247, 243, 227, 281, 314, 354, 415, 348, 0,
-	301, 0, 0, 406, 327, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	287, 226, 192, 339, 407, 259, 0, 0, 0, 0,
-	184, 185, 186, 0, 1232, 0, 0, 0, 0, 0,
-	0, 0, 0, 217, 0, 224, 0, 0, 0, 0,
-	0, 239, 285, 246, 238, 425, 0, 0, 0, 0,
-	0, 0, 0, 209, 0, 0, 0, 1093, 0, 1094,
-	1095, 0, 0, 0, 0, 0, 0, 0, 249, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 268, 0, 328, 231,
-	0, 0, 0, 0, 459, 0, 0, 0, 0, 0,
-	0, 0, 296, 0, 293, 188, 205, 0, 0, 338,
-	379, 385, 0, 0, 0, 229, 0, 383, 352, 443,
-	213, 257, 376, 357, 381, 364, 260, 0, 0, 382,
-	302, 430, 371, 440, 460, 461, 237, 332, 450, 419,
-	456, 472, 206, 234, 346, 412, 446, 403, 325, 426,
-	427, 292, 402, 266, 191, 300, 466, 204, 391, 221,
-	211, 197, 414, 438, 218, 394, 0, 0, 474, 199,
-	436, 411, 321, 289, 290, 198, 0, 375, 242, 264,
-	232, 341, 433, 434, 230, 475, 208, 455, 201, 0,
-	454, 334, 429, 437, 322, 312, 200, 435, 320, 311,
-	295, 253, 275, 369, 305, 370, 276, 330, 329, 331,
-	194, 447, 0, 195, 0, 408, 448, 476, 214, 215,
-	216, 0, 252, 256, 263, 265, 271, 272, 279, 298,
-	345, 368, 366, 372, 0, 424, 441, 451, 458, 464,
-	465, 467, 468, 469, 470, 471, 333, 278, 404, 294,
-	303, 0, 0, 351, 384, 219, 445, 405, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 477, 478,
-	479, 480, 481, 482, 483, 484, 485, 486, 487, 488,
-	489, 490, 491, 492, 493, 494, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 495, 313, 396, 442,
-	0, 187, 202, 299, 0, 373, 261, 473, 453, 449,
-	0, 0, 236, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 324, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 189, 190, 203, 212, 222,
-	235, 250, 258, 269, 274, 277, 282, 283, 286, 291,
-	309, 315, 316, 317, 318, 335, 336, 337, 340, 343,
-	344, 347, 349, 350, 353, 360, 361, 362, 363, 365,
-	367, 374, 378, 386, 387, 388, 389, 390, 392, 393,
-	398, 399, 400, 401, 409, 413, 431, 432, 444, 457,
-	462, 270, 439, 463, 0, 308, 0, 0, 310, 254,
-	273, 284, 0, 452, 410, 207, 380, 262, 196, 225,
-	210, 233, 248, 251, 288, 319, 326, 355, 359, 267,
-	245, 223, 377, 220, 395, 416, 417, 418, 420, 323,
-	240, 358, 421, 0, 306, 422, 423, 280, 0, 0,
-	0, 0, 0, 0, 342, 0, 0, 0, 0, 0,
-	0, 0, 0, 244, 0, 0, 0, 0, 297, 241,
-	0, 0, 356, 0, 193, 0, 397, 228, 307, 304,
-	428, 255, 247, 243, 227, 281, 314, 354, 415, 348,
-	0, 301, 0, 0, 406, 327, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 287, 226, 192, 339, 407, 259, 0, 0, 0,
-	0, 184, 185, 186, 1172, 1175, 0, 0, 0, 0,
-	1171, 1174, 0, 0, 217, 1170, 224, 0, 0, 0,
-	0, 0, 239, 285, 246, 238, 425, 0, 0, 0,
-	0, 0, 0, 0, 209, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 249,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 268, 0, 328,
-	231, 0, 0, 0, 0, 459, 0, 0, 0, 0,
-	0, 0, 0, 296, 0, 293, 188, 205, 0, 0,
-	338, 379, 385, 0, 0, 0, 229, 0, 383, 352,
-	443, 213, 257, 376, 357, 381, 364, 260, 0, 0,
-	382, 302, 430, 371, 440, 460, 461, 237, 332, 450,
-	419, 456, 472, 206, 234, 346, 412, 446, 403, 325,
-	426, 427, 292, 402, 266, 191, 300, 466, 204, 391,
-	221, 211, 197, 414, 438, 218, 394, 0, 0, 474,
-	199, 436, 411, 321, 289, 290, 198, 0, 375, 242,
-	264, 232, 341, 433, 434, 230, 475, 208, 455, 201,
-	0, 454, 334, 429, 437, 322, 312, 200, 435, 320,
-	311, 295, 253, 275, 369, 305, 370, 276, 330, 329,
-	331, 194, 447, 0, 195, 0, 408, 448, 476, 214,
-	215, 216, 0, 252, 256, 263, 265, 271, 272, 279,
-	298, 345, 368, 366, 372, 0, 424, 441, 451, 458,
-	464, 465, 467, 468, 469, 470, 471, 333, 278, 404,
-	294, 303, 0, 0, 351, 384, 219, 445, 405, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 477,
-	478, 479, 480, 481, 482, 483, 484, 485, 486, 487,
-	488, 489, 490, 491, 492, 493, 494, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 495, 313, 396,
-	442, 0, 187, 202, 299, 0, 373, 261, 473, 453,
-	449, 0, 0, 236, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 324, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 189, 190, 203, 212,
-	222, 235, 250, 258, 269, 274, 277, 282, 283, 286,
-	291, 309, 315, 316, 317, 318, 335, 336, 337, 340,
-	343, 344, 347, 349, 350, 353, 360, 361, 362, 363,
-	365, 367, 374, 378, 386, 387, 388, 389, 390, 392,
-	393, 398, 399, 400, 401, 409, 413, 431, 432, 444,
-	457, 462, 270, 439, 463, 0, 308, 0, 0, 310,
-	254, 273, 284, 0, 452, 410, 207, 380, 262, 196,
-	225, 210, 233, 248, 251, 288, 319, 326, 355, 359,
-	267, 245, 223, 377, 220, 395, 416, 417, 418, 420,
-	323, 240, 358, 78, 421, 306, 422, 423, 280, 0,
-	0, 0, 0, 0, 0, 0, 342, 0, 0, 0,
-	0, 0, 0, 0, 0, 244, 0, 0, 0, 0,
-	297, 241, 0, 0, 356, 0, 193, 0, 397, 228,
-	307, 304, 428, 255, 247, 243, 227, 281, 314, 354,
-	415, 348, 0, 301, 0, 0, 406, 327, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 287, 226, 192, 339, 407, 259, 0,
-	87, 0, 1210, 184, 185, 186, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 217, 0, 224, 0,
-	0, 0, 0, 0, 239, 285, 246, 238, 425, 0,
-	0, 0, 0, 0, 0, 0, 209, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 249, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 268,
-	0, 328, 231, 0, 0, 0, 0, 459, 0, 0,
-	0, 0, 0, 0, 0, 296, 0, 293, 188, 205,
-	0, 0, 338, 379, 385, 0, 0, 0, 229, 0,
-	383, 352, 443, 213, 257, 376, 357, 381, 364, 260,
-	0, 0, 382, 302, 430, 371, 440, 460, 461, 237,
-	332, 450, 419, 456, 472, 206, 234, 346, 412, 446,
-	403, 325, 426, 427, 292, 402, 266, 191, 300, 466,
-	204, 391, 221, 211, 197, 414, 438, 218, 394, 0,
-	0, 474, 199, 436, 411, 321, 289, 290, 198, 0,
-	375, 242, 264, 232, 341, 433, 434, 230, 475, 208,
-	455, 201, 0, 454, 334, 429, 437, 322, 312, 200,
-	435, 320, 311, 295, 253, 275, 369, 305, 370, 276,
-	330, 329, 331, 194, 447, 0, 195, 0, 408, 448,
-	476, 214, 215, 216, 0, 252, 256, 263, 265, 271,
-	272, 279, 298, 345, 368, 366, 372, 0, 424, 441,
-	451, 458, 464, 465, 467, 468, 469, 470, 471, 333,
-	278, 404, 294, 303, 0, 0, 351, 384, 219, 445,
-	405, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 477, 478, 479, 480, 481, 482, 483, 484, 485,
-	486, 487, 488, 489, 490, 491, 492, 493, 494, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 495,
-	313, 396, 442, 0, 187, 202, 299, 86, 373, 261,
-	473, 453, 449, 0, 0, 236, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 324, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 189, 190,
-	203, 212, 222, 235, 250, 258, 269, 274, 277, 282,
-	283, 286, 291, 309, 315, 316, 317, 318, 335, 336,
-	337, 340, 343, 344, 347, 349, 350, 353, 360, 361,
-	362, 363, 365, 367, 374, 378, 386, 387, 388, 389,
-	390, 392, 393, 398, 399, 400, 401, 409, 413, 431,
-	432, 444, 457, 462, 270, 439, 463, 0, 308, 0,
-	0, 310, 254, 273, 284, 0, 452, 410, 207, 380,
-	262, 196, 225, 210, 233, 248, 251, 288, 319, 326,
-	355, 359, 267, 245, 223, 377, 220, 395, 416, 417,
-	418, 420, 323, 240, 358, 78, 421, 306, 422, 423,
-	280, 0, 0, 0, 0, 0, 0, 0, 342, 0,
-	0, 0, 0, 0, 0, 0, 0, 244, 0, 0,
-	0, 0, 297, 241, 0, 0, 356, 0, 193, 0,
-	397, 228, 307, 304, 428, 255, 247, 243, 227, 281,
-	314, 354, 415, 348, 0, 301, 0, 0, 406, 327,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 287, 226, 192, 339, 407,
-	259, 0, 87, 0, 0, 184, 185, 186, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
-	224, 0, 0, 0, 0, 0, 239, 285, 246, 238,
-	425, 0, 0, 0, 0, 0, 0, 0, 209, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 249, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1997, 0, 2315, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2041, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 268, 0, 328, 231, 0, 0, 0, 0, 459,
-	0, 0, 0, 0, 0, 0, 0, 296, 0, 293,
-	188, 205, 0, 0, 338, 379, 385, 0, 0, 0,
-	229, 0, 383, 352, 443, 213, 257, 376, 357, 381,
-	364, 260, 0, 0, 382, 302, 430, 371, 440, 460,
-	461, 237, 332, 450, 419, 456, 472, 206, 234, 346,
-	412, 446, 403, 325, 426, 427, 292, 402, 266, 191,
-	300, 466, 204, 391, 221, 211, 197, 414, 438, 218,
-	394, 0, 0, 474, 199, 436, 411, 321, 289, 290,
-	198, 0, 375, 242, 264, 232, 341, 433, 434, 230,
-	475, 208, 455, 201, 0, 454, 334, 429, 437, 322,
-	312, 200, 435, 320, 311, 295, 253, 275, 369, 305,
-	370, 276, 330, 329, 331, 194, 447, 0, 195, 0,
-	408, 448, 476, 214, 215, 216, 0, 252, 256, 263,
-	265, 271, 272, 279, 298, 345, 368, 366, 372, 0,
-	424, 441, 451, 458, 464, 465, 467, 468, 469, 470,
-	471, 333, 278, 404, 294, 303, 0, 0, 351, 384,
-	219, 445, 405, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 477, 478, 479, 480, 481, 482, 483,
-	484, 485, 486, 487, 488, 489, 490, 491, 492, 493,
-	494, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 495, 313, 396, 442, 0, 187, 202, 299, 86,
-	373, 261, 473, 453, 449, 0, 0, 236, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 324,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	189, 190, 203, 212, 222, 235, 250, 258, 269, 274,
-	277, 282, 283, 286, 291, 309, 315, 316, 317, 318,
-	335, 336, 337, 340, 343, 344, 347, 349, 350, 353,
-	360, 361, 362, 363, 365, 367, 374, 378, 386, 387,
-	388, 389, 390, 392, 393, 398, 399, 400, 401, 409,
-	413, 431, 432, 444, 457, 462, 270, 439, 463, 0,
-	308, 0, 0, 310, 254, 273, 284, 0, 452, 410,
-	207, 380, 262, 196, 225, 210, 233, 248, 251, 288,
-	319, 326, 355, 359, 267, 245, 223, 377, 220, 395,
-	416, 417, 418, 420, 323, 240, 358, 421, 0, 306,
-	422, 423, 280, 0, 0, 0, 0, 0, 0, 342,
-	0, 0, 0, 1616, 0, 0, 0, 0, 244, 0,
-	0, 0, 0, 297, 241, 0, 0, 356, 0, 193,
-	0, 397, 228, 307, 304, 428, 255, 247, 243, 227,
-	281, 314, 354, 415, 348, 0, 301, 0, 0, 406,
-	327, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 287, 226, 192, 339,
-	407, 259, 0, 0, 0, 0, 184, 185, 186, 0,
-	1402, 0, 0, 0, 0, 0, 0, 0, 0, 217,
-	0, 224, 0, 0, 0, 0, 0, 239, 285, 246,
-	238, 425, 0, 0, 0, 0, 0, 0, 0, 209,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 249, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 268, 0, 328, 231, 0, 0, 0, 0,
-	459, 0, 0, 0, 0, 0, 0, 0, 296, 0,
-	293, 188, 205, 0, 0, 338, 379, 385, 0, 0,
-	0, 229, 0, 383, 352, 443, 213, 257, 376, 357,
-	381, 364, 260, 0, 1614, 382, 302, 430, 371, 440,
-	460, 461, 237, 332, 450, 419, 456, 472, 206, 234,
-	346, 412, 446, 403, 325, 426, 427, 292, 402, 266,
-	191, 300, 466, 204, 391, 221, 211, 197, 414, 438,
-	218, 394, 0, 0, 474, 199, 436, 411, 321, 289,
-	290, 198, 0, 375, 242, 264, 232, 341, 433, 434,
-	230, 475, 208, 455, 201, 0, 454, 334, 429, 437,
-	322, 312, 200, 435, 320, 311, 295, 253, 275, 369,
-	305, 370, 276, 330, 329, 331, 194, 447, 0, 195,
-	0, 408, 448, 476, 214, 215, 216, 0, 252, 256,
-	263, 265, 271, 272, 279, 298, 345, 368, 366, 372,
-	0, 424, 441, 451, 458, 464, 465, 467, 468, 469,
-	470, 471, 333, 278, 404, 294, 303, 0, 0, 351,
-	384, 219, 445, 405, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 477, 478, 479, 480, 481, 482,
-	483, 484, 485, 486, 487, 488, 489, 490, 491, 492,
-	493, 494, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 495, 313, 396, 442, 0, 187, 202, 299,
-	0, 373, 261, 473, 453, 449, 0, 0, 236, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	324, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 189, 190, 203, 212, 222, 235, 250, 258, 269,
-	274, 277, 282, 283, 286, 291, 309, 315, 316, 317,
-	318, 335, 336, 337, 340, 343, 344, 347, 349, 350,
-	353, 360, 361, 362, 363, 365, 367, 374, 378, 386,
-	387, 388, 389, 390, 392, 393, 398, 399, 400, 401,
-	409, 413, 431, 432, 444, 457, 462, 270, 439, 463,
-	0, 308, 0, 0, 310, 254, 273, 284, 0, 452,
-	410, 207, 380, 262, 196, 225, 210, 233, 248, 251,
-	288, 319, 326, 355, 359, 267, 245, 223, 377, 220,
-	395, 416, 417, 418, 420, 323, 240, 358, 421, 0,
-	306, 422, 423, 280, 0, 0, 0, 0, 0, 0,
-	342, 0, 0, 0, 0, 0, 0, 0, 0, 244,
-	0, 0, 0, 0, 297, 241, 0, 0, 356, 0,
-	193, 0, 397, 228, 307, 304, 428, 255, 247, 243,
-	227, 281, 314, 354, 415, 348, 0, 301, 0, 0,
-	406, 327, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 287, 226, 192,
-	339, 407, 259, 0, 0, 0, 0, 184, 185, 186,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	217, 0, 224, 0, 0, 0, 0, 0, 239, 285,
-	246, 238, 425, 0, 0, 0, 0, 0, 0, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 249, 0, 0, 0, 0,
-	0, 0, 0, 0, 883, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1710, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2400, 2087, 2088, 2089, 2090, 2091, 0, 629, 0,
+	0, 0, 630, 631, 0, 0, 0, 632, 0, 1529,
+	2097, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2105, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2440, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 268, 0, 328, 231, 0, 0, 0,
-	0, 459, 0, 0, 0, 0, 0, 0, 0, 296,
-	889, 293, 188, 205, 887, 0, 338, 379, 385, 0,
-	0, 0, 229, 0, 383, 352, 443, 213, 257, 376,
-	357, 381, 364, 260, 0, 0, 382, 302, 430, 371,
-	440, 460, 461, 237, 332, 450, 419, 456, 472, 206,
-	234, 346, 412, 446, 403, 325, 426, 427, 292, 402,
-	266, 191, 300, 466, 204, 391, 221, 211, 197, 414,
-	438, 218, 394, 0, 0, 474, 199, 436, 411, 321,
-	289, 290, 198, 0, 375, 242, 264, 232, 341, 433,
-	434, 230, 475, 208, 455, 201, 0, 454, 334, 429,
-	437, 322, 312, 200, 435, 320, 311, 295, 253, 275,
-	369, 305, 370, 276, 330, 329, 331, 194, 447, 0,
-	195, 0, 408, 448, 476, 214, 215, 216, 0, 252,
-	256, 263, 265, 271, 272, 279, 298, 345, 368, 366,
-	372, 0, 424, 441, 451, 458, 464, 465, 467, 468,
-	469, 470, 471, 333, 278, 404, 294, 303, 0, 0,
-	351, 384, 219, 445, 405, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 477, 478, 479, 480, 481,
-	482, 483, 484, 485, 486, 487, 488, 489, 490, 491,
-	492, 493, 494, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 495, 313, 396, 442, 0, 187, 202,
-	299, 0, 373, 261, 473, 453, 449, 0, 0, 236,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 324, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 189, 190, 203, 212, 222, 235, 250, 258,
-	269, 274, 277, 282, 283, 286, 291, 309, 315, 316,
-	317, 318, 335, 336, 337, 340, 343, 344, 347, 349,
-	350, 353, 360, 361, 362, 363, 365, 367, 374, 378,
-	386, 387, 388, 389, 390, 392, 393, 398, 399, 400,
-	401, 409, 413, 431, 432, 444, 457, 462, 270, 439,
-	463, 0, 308, 0, 0, 310, 254, 273, 284, 0,
-	452, 410, 207, 380, 262, 196, 225, 210, 233, 248,
-	251, 288, 319, 326, 355, 359, 267, 245, 223, 377,
-	220, 395, 416, 417, 418, 420, 323, 240, 358, 421,
-	0, 306, 422, 423, 280, 0, 0, 0, 0, 0,
-	0, 342, 0, 0, 0, 0, 0, 0, 0, 0,
-	244, 0, 0, 0, 0, 297, 241, 0, 0, 356,
-	0, 193, 0, 397, 228, 307, 304, 428, 255, 247,
-	243, 227, 281, 314, 354, 415, 348, 0, 301, 0,
-	0, 406, 327, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 287, 226,
-	192, 339, 407, 259, 0, 0, 0, 1210, 184, 185,
-	186, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 217, 0, 224, 0, 0, 0, 0, 0, 239,
-	285, 246, 238, 425, 0, 0, 0, 0, 0, 0,
-	0, 209, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 249, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2475, 0,
+	2479, 2480, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2196, 0, 79, 0, 2196, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 268, 0, 328, 231, 0, 0,
-	0, 0, 459, 0, 0, 0, 2473, 0, 0, 0,
-	296, 0, 293, 188, 205, 0, 0, 338, 379, 385,
-	0, 0, 0, 229, 0, 383, 352, 443, 213, 257,
-	376, 357, 381, 364, 260, 0, 0, 382, 302, 430,
-	371, 440, 460, 461, 237, 332, 450, 419, 456, 472,
-	206, 234, 346, 412, 446, 403, 325, 426, 427, 292,
-	402, 266, 191, 300, 466, 204, 391, 221, 211, 197,
-	414, 438, 218, 394, 0, 0, 474, 199, 436, 411,
-	321, 289, 290, 198, 0, 375, 242, 264, 232, 341,
-	433, 434, 230, 475, 208, 455, 201, 0, 454, 334,
-	429, 437, 322, 312, 200, 435, 320, 311, 295, 253,
-	275, 369, 305, 370, 276, 330, 329, 331, 194, 447,
-	0, 195, 0, 408, 448, 476, 214, 215, 216, 0,
-	252, 256, 263, 265, 271, 272, 279, 298, 345, 368,
-	366, 372, 0, 424, 441, 451, 458, 464, 465, 467,
-	468, 469, 470, 471, 333, 278, 404, 294, 303, 0,
-	0, 351, 384, 219, 445, 405, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 477, 478, 479, 480,
-	481, 482, 483, 484, 485, 486, 487, 488, 489, 490,
-	491, 492, 493, 494, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 495, 313, 396, 442, 0, 187,
-	202, 299, 0, 373, 261, 473, 453, 449, 0, 0,
-	236, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 324, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 189, 190, 203, 212, 222, 235, 250,
-	258, 269, 274, 277, 282, 283, 286, 291, 309, 315,
-	316, 317, 318, 335, 336, 337, 340, 343, 344, 347,
-	349, 350, 353, 360, 361, 362, 363, 365, 367, 374,
-	378, 386, 387, 388, 389, 390, 392, 393, 398, 399,
-	400, 401, 409, 413, 431, 432, 444, 457, 462, 270,
-	439, 463, 0, 308, 0, 0, 310, 254, 273, 284,
-	0, 452, 410, 207, 380, 262, 196, 225, 210, 233,
-	248, 251, 288, 319, 326, 355, 359, 267, 245, 223,
-	377, 220, 395, 416, 417, 418, 420, 323, 240, 358,
-	421, 0, 306, 422, 423, 280, 0, 0, 0, 0,
-	0, 0, 342, 0, 0, 0, 1616, 0, 0, 0,
-	0, 244, 0, 0, 0, 0, 297, 241, 0, 0,
-	356, 0, 193, 0, 397, 228, 307, 304, 428, 255,
-	247, 243, 227, 281, 314, 354, 415, 348, 0, 301,
-	0, 0, 406, 327, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 287,
-	226, 192, 339, 407, 259, 0, 0, 0, 0, 184,
-	185, 186, 0, 1402, 0, 0, 0, 0, 0, 0,
-	0, 0, 217, 0, 224, 0, 0, 0, 0, 0,
-	239, 285, 246, 238, 425, 0, 0, 0, 0, 0,
-	0, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 249, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2531, 0, 0, 0,
+	0, 0, 2263, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2284,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2293,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2305, 0, 0, 2308, 0, 2196,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1185, 1186, 1187, 1188, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 79, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 268, 0, 328, 231, 0,
-	0, 0, 0, 459, 0, 0, 0, 0, 0, 0,
-	0, 296, 0, 293, 188, 205, 0, 0, 338, 379,
-	385, 0, 0, 0, 229, 0, 383, 352, 443, 213,
-	257, 376, 357, 381, 364, 260, 0, 0, 382, 302,
-	430, 371, 440, 460, 461, 237, 332, 450, 419, 456,
-	472, 206, 234, 346, 412, 446, 403, 325, 426, 427,
-	292, 402, 266, 191, 300, 466, 204, 391, 221, 211,
-	197, 414, 438, 218, 394, 0, 0, 474, 199, 436,
-	411, 321, 289, 290, 198, 0, 375, 242, 264, 232,
-	341, 433, 434, 230, 475, 208, 455, 201, 0, 454,
-	334, 429, 437, 322, 312, 200, 435, 320, 311, 295,
-	253, 275, 369, 305, 370, 276, 330, 329, 331, 194,
-	447, 0, 195, 0, 408, 448, 476, 214, 215, 216,
-	0, 252, 256, 263, 265, 271, 272, 279, 298, 345,
-	368, 366, 372, 0, 424, 441, 451, 458, 464, 465,
-	467, 468, 469, 470, 471, 333, 278, 404, 294, 303,
-	0, 0, 351, 384, 219, 445, 405, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 477, 478, 479,
-	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
-	490, 491, 492, 493, 494, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 495, 313, 396, 442, 0,
-	187, 202, 299, 0, 373, 261, 473, 453, 449, 0,
-	0, 236, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 324, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 189, 190, 203, 212, 222, 235,
-	250, 258, 269, 274, 277, 282, 283, 286, 291, 309,
-	315, 316, 317, 318, 335, 336, 337, 340, 343, 344,
-	347, 349, 350, 353, 360, 361, 362, 363, 365, 367,
-	374, 378, 386, 387, 388, 389, 390, 392, 393, 398,
-	399, 400, 401, 409, 413, 431, 432, 444, 457, 462,
-	270, 439, 463, 0, 308, 0, 0, 310, 254, 273,
-	284, 0, 452, 410, 207, 380, 262, 196, 225, 210,
-	233, 248, 251, 288, 319, 326, 355, 359, 267, 245,
-	223, 377, 220, 395, 416, 417, 418, 420, 323, 240,
-	358, 421, 0, 306, 422, 423, 280, 0, 0, 0,
-	0, 0, 0, 342, 0, 0, 0, 0, 0, 0,
-	0, 0, 244, 0, 0, 0, 0, 297, 241, 0,
-	0, 356, 0, 193, 0, 397, 228, 307, 304, 428,
-	255, 247, 243, 227, 281, 314, 354, 415, 348, 0,
-	301, 0, 0, 406, 327, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	287, 226, 192, 339, 407, 259, 0, 0, 0, 0,
-	184, 185, 186, 0, 1904, 0, 0, 0, 0, 0,
-	0, 0, 0, 217, 0, 224, 0, 0, 0, 0,
-	0, 239, 285, 246, 238, 425, 0, 0, 0, 0,
-	0, 0, 0, 209, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 249, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1905, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 268, 0, 328, 231,
-	0, 0, 0, 0, 459, 0, 0, 0, 0, 0,
-	0, 0, 296, 0, 293, 188, 205, 0, 0, 338,
-	379, 385, 0, 0, 0, 229, 0, 383, 352, 443,
-	213, 257, 376, 357, 381, 364, 260, 0, 0, 382,
-	302, 430, 371, 440, 460, 461, 237, 332, 450, 419,
-	456, 472, 206, 234, 346, 412, 446, 403, 325, 426,
-	427, 292, 402, 266, 191, 300, 466, 204, 391, 221,
-	211, 197, 414, 438, 218, 394, 0, 0, 474, 199,
-	436, 411, 321, 289, 290, 198, 0, 375, 242, 264,
-	232, 341, 433, 434, 230, 475, 208, 455, 201, 0,
-	454, 334, 429, 437, 322, 312, 200, 435, 320, 311,
-	295, 253, 275, 369, 305, 370, 276, 330, 329, 331,
-	194, 447, 0, 195, 0, 408, 448, 476, 214, 215,
-	216, 0, 252, 256, 263, 265, 271, 272, 279, 298,
-	345, 368, 366, 372, 0, 424, 441, 451, 458, 464,
-	465, 467, 468, 469, 470, 471, 333, 278, 404, 294,
-	303, 0, 0, 351, 384, 219, 445, 405, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 477, 478,
-	479, 480, 481, 482, 483, 484, 485, 486, 487, 488,
-	489, 490, 491, 492, 493, 494, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 495, 313, 396, 442,
-	0, 187, 202, 299, 0, 373, 261, 473, 453, 449,
-	0, 0, 236, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 324, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 189, 190, 203, 212, 222,
-	235, 250, 258, 269, 274, 277, 282, 283, 286, 291,
-	309, 315, 316, 317, 318, 335, 336, 337, 340, 343,
-	344, 347, 349, 350, 353, 360, 361, 362, 363, 365,
-	367, 374, 378, 386, 387, 388, 389, 390, 392, 393,
-	398, 399, 400, 401, 409, 413, 431, 432, 444, 457,
-	462, 270, 439, 463, 0, 308, 0, 0, 310, 254,
-	273, 284, 0, 452, 410, 207, 380, 262, 196, 225,
-	210, 233, 248, 251, 288, 319, 326, 355, 359, 267,
-	245, 223, 377, 220, 395, 416, 417, 418, 420, 323,
-	240, 358, 421, 0, 306, 422, 423, 280, 0, 0,
-	0, 0, 0, 0, 342, 0, 0, 0, 0, 0,
-	0, 0, 0, 244, 0, 0, 0, 0, 297, 241,
-	0, 0, 356, 0, 193, 0, 397, 228, 307, 304,
-	428, 255, 247, 243, 227, 281, 314, 354, 415, 348,
-	0, 301, 0, 0, 406, 327, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 287, 226, 192, 339, 407, 259, 0, 0, 0,
-	0, 184, 185, 186, 0, 0, 0, 1889, 0, 0,
-	0, 1890, 0, 0, 217, 0, 224, 0, 0, 0,
-	0, 0, 239, 285, 246, 238, 425, 0, 0, 0,
-	0, 0, 0, 0, 209, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 249,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 268, 0, 328,
-	231, 0, 0, 0, 0, 459, 0, 0, 0, 0,
-	0, 0, 0, 296, 0, 293, 188, 205, 0, 0,
-	338, 379, 385, 0, 0, 0, 229, 0, 383, 352,
-	443, 213, 257, 376, 357, 381, 364, 260, 0, 0,
-	382, 302, 430, 371, 440, 460, 461, 237, 332, 450,
-	419, 456, 472, 206, 234, 346, 412, 446, 403, 325,
-	426, 427, 292, 402, 266, 191, 300, 466, 204, 391,
-	221, 211, 197, 414, 438, 218, 394, 0, 0, 474,
-	199, 436, 411, 321, 289, 290, 198, 0, 375, 242,
-	264, 232, 341, 433, 434, 230, 475, 208, 455, 201,
-	0, 454, 334, 429, 437, 322, 312, 200, 435, 320,
-	311, 295, 253, 275, 369, 305, 370, 276, 330, 329,
-	331, 194, 447, 0, 195, 0, 408, 448, 476, 214,
-	215, 216, 0, 252, 256, 263, 265, 271, 272, 279,
-	298, 345, 368, 366, 372, 0, 424, 441, 451, 458,
-	464, 465, 467, 468, 469, 470, 471, 333, 278, 404,
-	294, 303, 0, 0, 351, 384, 219, 445, 405, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 477,
-	478, 479, 480, 481, 482, 483, 484, 485, 486, 487,
-	488, 489, 490, 491, 492, 493, 494, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 495, 313, 396,
-	442, 0, 187, 202, 299, 0, 373, 261, 473, 453,
-	449, 0, 0, 236, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 324, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 189, 190, 203, 212,
-	222, 235, 250, 258, 269, 274, 277, 282, 283, 286,
-	291, 309, 315, 316, 317, 318, 335, 336, 337, 340,
-	343, 344, 347, 349, 350, 353, 360, 361, 362, 363,
-	365, 367, 374, 378, 386, 387, 388, 389, 390, 392,
-	393, 398, 399, 400, 401, 409, 413, 431, 432, 444,
-	457, 462, 270, 439, 463, 0, 308, 0, 0, 310,
-	254, 273, 284, 0, 452, 410, 207, 380, 262, 196,
-	225, 210, 233, 248, 251, 288, 319, 326, 355, 359,
-	267, 245, 223, 377, 220, 395, 416, 417, 418, 420,
-	323, 240, 358, 421, 0, 306, 422, 423, 280, 0,
-	0, 0, 0, 0, 0, 342, 0, 0, 0, 0,
-	0, 0, 0, 0, 244, 1253, 0, 0, 0, 297,
-	241, 0, 0, 356, 0, 193, 0, 397, 228, 307,
-	304, 428, 255, 247, 243, 227, 281, 314, 354, 415,
-	348, 0, 301, 0, 0, 406, 327, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 287, 226, 192, 339, 407, 259, 0, 0,
-	0, 0, 184, 185, 186, 0, 1252, 0, 0, 0,
-	0, 0, 0, 0, 0, 217, 0, 224, 0, 0,
-	0, 0, 0, 239, 285, 246, 238, 425, 0, 0,
-	0, 0, 0, 0, 0, 209, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	249, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 268, 0,
-	328, 231, 0, 0, 0, 0, 459, 0, 0, 0,
-	0, 0, 0, 0, 296, 0, 293, 188, 205, 0,
-	0, 338, 379, 385, 0, 0, 0, 229, 0, 383,
-	352, 443, 213, 257, 376, 357, 381, 364, 260, 0,
-	0, 382, 302, 430, 371, 440, 460, 461, 237, 332,
-	450, 419, 456, 472, 206, 234, 346, 412, 446, 403,
-	325, 426, 427, 292, 402, 266, 191, 300, 466, 204,
-	391, 221, 211, 197, 414, 438, 218, 394, 0, 0,
-	474, 199, 436, 411, 321, 289, 290, 198, 0, 375,
-	242, 264, 232, 341, 433, 434, 230, 475, 208, 455,
-	201, 0, 454, 334, 429, 437, 322, 312, 200, 435,
-	320, 311, 295, 253, 275, 369, 305, 370, 276, 330,
-	329, 331, 194, 447, 0, 195, 0, 408, 448, 476,
-	214, 215, 216, 0, 252, 256, 263, 265, 271, 272,
-	279, 298, 345, 368, 366, 372, 0, 424, 441, 451,
-	458, 464, 465, 467, 468, 469, 470, 471, 333, 278,
-	404, 294, 303, 0, 0, 351, 384, 219, 445, 405,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	477, 478, 479, 480, 481, 482, 483, 484, 485, 486,
-	487, 488, 489, 490, 491, 492, 493, 494, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 495, 313,
-	396, 442, 0, 187, 202, 299, 0, 373, 261, 473,
-	453, 449, 0, 0, 236, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 324, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 189, 190, 203,
-	212, 222, 235, 250, 258, 269, 274, 277, 282, 283,
-	286, 291, 309, 315, 316, 317, 318, 335, 336, 337,
-	340, 343, 344, 347, 349, 350, 353, 360, 361, 362,
-	363, 365, 367, 374, 378, 386, 387, 388, 389, 390,
-	392, 393, 398, 399, 400, 401, 409, 413, 431, 432,
-	444, 457, 462, 270, 439, 463, 0, 308, 0, 0,
-	310, 254, 273, 284, 0, 452, 410, 207, 380, 262,
-	196, 225, 210, 233, 248, 251, 288, 319, 326, 355,
-	359, 267, 245, 223, 377, 220, 395, 416, 417, 418,
-	420, 323, 240, 358, 421, 0, 306, 422, 423, 280,
-	0, 0, 0, 0, 0, 0, 342, 0, 0, 0,
-	0, 0, 0, 0, 0, 244, 0, 0, 0, 0,
-	297, 241, 0, 0, 356, 0, 193, 0, 397, 228,
-	307, 304, 428, 255, 247, 243, 227, 281, 314, 354,
-	415, 348, 0, 301, 0, 0, 406, 327, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 287, 226, 192, 339, 407, 259, 0,
-	0, 0, 0, 184, 185, 186, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 217, 0, 224, 0,
-	0, 0, 0, 0, 239, 285, 246, 238, 425, 0,
-	0, 0, 0, 0, 0, 0, 209, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 249, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 268,
-	0, 328, 231, 0, 0, 0, 0, 459, 0, 0,
-	0, 2541, 0, 0, 0, 296, 0, 293, 188, 205,
-	0, 0, 338, 379, 385, 0, 0, 0, 229, 0,
-	383, 352, 443, 213, 257, 376, 357, 381, 364, 260,
-	0, 0, 382, 302, 430, 371, 440, 460, 461, 237,
-	332, 450, 419, 456, 472, 206, 234, 346, 412, 446,
-	403, 325, 426, 427, 292, 402, 266, 191, 300, 466,
-	204, 391, 221, 211, 197, 414, 438, 218, 394, 0,
-	0, 474, 199, 436, 411, 321, 289, 290, 198, 0,
-	375, 242, 264, 232, 341, 433, 434, 230, 475, 208,
-	455, 201, 0, 454, 334, 429, 437, 322, 312, 200,
-	435, 320, 311, 295, 253, 275, 369, 305, 370, 276,
-	330, 329, 331, 194, 447, 0, 195, 0, 408, 448,
-	476, 214, 215, 216, 0, 252, 256, 263, 265, 271,
-	272, 279, 298, 345, 368, 366, 372, 0, 424, 441,
-	451, 458, 464, 465, 467, 468, 469, 470, 471, 333,
-	278, 404, 294, 303, 0, 0, 351, 384, 219, 445,
-	405, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 477, 478, 479, 480, 481, 482, 483, 484, 485,
-	486, 487, 488, 489, 490, 491, 492, 493, 494, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 495,
-	313, 396, 442, 0, 187, 202, 299, 0, 373, 261,
-	473, 453, 449, 0, 0, 236, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 324, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 189, 190,
-	203, 212, 222, 235, 250, 258, 269, 274, 277, 282,
-	283, 286, 291, 309, 315, 316, 317, 318, 335, 336,
-	337, 340, 343, 344, 347, 349, 350, 353, 360, 361,
-	362, 363, 365, 367, 374, 378, 386, 387, 388, 389,
-	390, 392, 393, 398, 399, 400, 401, 409, 413, 431,
-	432, 444, 457, 462, 270, 439, 463, 0, 308, 0,
-	0, 310, 254, 273, 284, 0, 452, 410, 207, 380,
-	262, 196, 225, 210, 233, 248, 251, 288, 319, 326,
-	355, 359, 267, 245, 223, 377, 220, 395, 416, 417,
-	418, 420, 323, 240, 358, 421, 0, 306, 422, 423,
-	280, 0, 0, 0, 0, 0, 0, 342, 0, 0,
-	0, 0, 0, 0, 0, 0, 244, 0, 0, 0,
-	0, 297, 241, 0, 0, 356, 0, 193, 0, 397,
-	228, 307, 304, 428, 255, 247, 243, 227, 281, 314,
-	354, 415, 348, 0, 301, 0, 0, 406, 327, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 287, 226, 192, 339, 407, 259,
-	0, 0, 0, 0, 184, 185, 186, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 217, 0, 224,
-	0, 0, 0, 0, 0, 239, 285, 246, 238, 425,
-	0, 0, 0, 0, 0, 0, 0, 209, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 249, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2356, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 79, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2410, 0, 0, 0, 0, 0, 0, 0,
+	0, 2682, 0, 0, 0, 0, 0, 0, 0, 0,
+	2425, 0, 0, 2426, 2427, 2428, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	268, 0, 328, 231, 0, 0, 0, 0, 459, 0,
-	0, 0, 2473, 0, 0, 0, 296, 0, 293, 188,
-	205, 0, 0, 338, 379, 385, 0, 0, 0, 229,
-	0, 383, 352, 443, 213, 257, 376, 357, 381, 364,
-	260, 0, 0, 382, 302, 430, 371, 440, 460, 461,
-	237, 332, 450, 419, 456, 472, 206, 234, 346, 412,
-	446, 403, 325, 426, 427, 292, 402, 266, 191, 300,
-	466, 204, 391, 221, 211, 197, 414, 438, 218, 394,
-	0, 0, 474, 199, 436, 411, 321, 289, 290, 198,
-	0, 375, 242, 264, 232, 341, 433, 434, 230, 475,
-	208, 455, 201, 0, 454, 334, 429, 437, 322, 312,
-	200, 435, 320, 311, 295, 253, 275, 369, 305, 370,
-	276, 330, 329, 331, 194, 447, 0, 195, 0, 408,
-	448, 476, 214, 215, 216, 0, 252, 256, 263, 265,
-	271, 272, 279, 298, 345, 368, 366, 372, 0, 424,
-	441, 451, 458, 464, 465, 467, 468, 469, 470, 471,
-	333, 278, 404, 294, 303, 0, 0, 351, 384, 219,
-	445, 405, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 477, 478, 479, 480, 481, 482, 483, 484,
-	485, 486, 487, 488, 489, 490, 491, 492, 493, 494,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	495, 313, 396, 442, 0, 187, 202, 299, 0, 373,
-	261, 473, 453, 449, 0, 0, 236, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 324, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	190, 203, 212, 222, 235, 250, 258, 269, 274, 277,
-	282, 283, 286, 291, 309, 315, 316, 317, 318, 335,
-	336, 337, 340, 343, 344, 347, 349, 350, 353, 360,
-	361, 362, 363, 365, 367, 374, 378, 386, 387, 388,
-	389, 390, 392, 393, 398, 399, 400, 401, 409, 413,
-	431, 432, 444, 457, 462, 270, 439, 463, 0, 308,
-	0, 0, 310, 254, 273, 284, 0, 452, 410, 207,
-	380, 262, 196, 225, 210, 233, 248, 251, 288, 319,
-	326, 355, 359, 267, 245, 223, 377, 220, 395, 416,
-	417, 418, 420, 323, 240, 358, 421, 0, 306, 422,
-	423, 280, 0, 0, 0, 0, 0, 0, 342, 0,
-	0, 0, 0, 0, 0, 0, 0, 244, 0, 0,
-	0, 0, 297, 241, 0, 0, 356, 0, 193, 0,
-	397, 228, 307, 304, 428, 255, 247, 243, 227, 281,
-	314, 354, 415, 348, 0, 301, 0, 0, 406, 327,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 287, 226, 192, 339, 407,
-	259, 0, 87, 0, 0, 184, 185, 186, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
-	224, 0, 0, 0, 0, 0, 239, 285, 246, 238,
-	425, 0, 0, 0, 0, 0, 0, 0, 209, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 249, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2728,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	882, 867, 424, 0, 813, 885, 782, 800, 895, 803,
+	806, 848, 761, 827, 344, 797, 0, 786, 756, 792,
+	757, 784, 815, 246, 781, 869, 831, 884, 299, 243,
+	763, 787, 358, 802, 193, 850, 400, 230, 309, 306,
+	431, 257, 249, 811, 225, 245, 228, 283, 316, 356,
+	418, 350, 891, 303, 837, 0, 409, 329, 0, 0,
+	0, 817, 873, 825, 862, 812, 849, 771, 836, 886,
+	798, 845, 887, 289, 227, 192, 341, 410, 261, 0,
+	0, 0, 0, 184, 185, 186, 0, 2635, 0, 2636,
+	0, 0, 0, 0, 0, 0, 217, 0, 224, 794,
+	842, 881, 795, 844, 241, 287, 248, 240, 428, 892,
+	872, 760, 824, 880, 0, 397, 0, 0, 209, 883,
+	819, 0, 847, 0, 898, 755, 839, 0, 758, 762,
+	894, 876, 790, 251, 0, 0, 0, 0, 0, 0,
+	0, 816, 826, 859, 809, 0, 0, 0, 0, 0,
+	0, 0, 788, 0, 835, 0, 0, 0, 767, 759,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 268, 0, 328, 231, 0, 0, 0, 0, 459,
-	0, 0, 0, 0, 0, 0, 0, 296, 0, 293,
-	188, 205, 0, 0, 338, 379, 385, 0, 0, 0,
-	229, 0, 383, 352, 443, 213, 257, 376, 357, 381,
-	364, 260, 0, 0, 382, 302, 430, 371, 440, 460,
-	461, 237, 332, 450, 419, 456, 472, 206, 234, 346,
-	412, 446, 403, 325, 426, 427, 292, 402, 266, 191,
-	300, 466, 204, 391, 221, 211, 197, 414, 438, 218,
-	394, 0, 0, 474, 199, 436, 411, 321, 289, 290,
-	198, 0, 375, 242, 264, 232, 341, 433, 434, 230,
-	475, 208, 455, 201, 0, 454, 334, 429, 437, 322,
-	312, 200, 435, 320, 311, 295, 253, 275, 369, 305,
-	370, 276, 330, 329, 331, 194, 447, 0, 195, 0,
-	408, 448, 476, 214, 215, 216, 0, 252, 256, 263,
-	265, 271, 272, 279, 298, 345, 368, 366, 372, 0,
-	424, 441, 451, 458, 464, 465, 467, 468, 469, 470,
-	471, 333, 278, 404, 294, 303, 0, 0, 351, 384,
-	219, 445, 405, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 477, 478, 479, 480, 481, 482, 483,
+	0, 814, 2597, 0, 0, 770, 0, 789, 860, 0,
+	753, 270, 764, 330, 233, 2608, 865, 875, 810, 462,
+	879, 808, 807, 854, 768, 871, 801, 298, 766, 295,
+	188, 205, 0, 799, 340, 381, 387, 870, 785, 793,
+	231, 791, 385, 354, 446, 213, 259, 378, 359, 383,
+	366, 262, 834, 852, 384, 304, 433, 373, 443, 463,
+	464, 239, 334, 453, 422, 459, 475, 206, 236, 348,
+	415, 449, 406, 327, 429, 430, 294, 405, 268, 191,
+	302, 469, 204, 393, 221, 211, 197, 417, 441, 218,
+	396, 0, 0, 477, 199, 439, 414, 323, 291, 292,
+	198, 0, 377, 244, 266, 234, 343, 436, 437, 232,
+	478, 208, 458, 201, 1079, 457, 336, 432, 440, 324,
+	314, 200, 438, 322, 313, 297, 255, 277, 371, 307,
+	372, 278, 332, 331, 333, 194, 450, 0, 195, 0,
+	411, 451, 479, 214, 215, 216, 780, 254, 258, 265,
+	267, 273, 274, 281, 300, 347, 370, 368, 374, 866,
+	427, 444, 454, 461, 467, 468, 470, 471, 472, 473,
+	474, 335, 280, 407, 296, 305, 857, 897, 353, 386,
+	219, 448, 408, 775, 779, 773, 774, 829, 830, 776,
+	888, 889, 890, 480, 481, 482, 483, 484, 485, 486,
+	487, 488, 489, 490, 491, 492, 493, 494, 495, 496,
+	497, 0, 861, 769, 0, 777, 778, 0, 868, 877,
+	878, 498, 315, 399, 445, 833, 187, 202, 301, 893,
+	375, 263, 476, 456, 851, 896, 863, 229, 772, 783,
+	796, 820, 821, 823, 840, 841, 853, 856, 864, 452,
+	754, 238, 0, 0, 804, 805, 818, 822, 326, 843,
+	858, 874, 189, 190, 203, 212, 222, 237, 252, 260,
+	271, 276, 279, 284, 285, 288, 293, 311, 317, 318,
+	319, 320, 337, 338, 339, 342, 345, 346, 349, 351,
+	352, 355, 362, 363, 364, 365, 367, 369, 376, 380,
+	388, 389, 390, 391, 392, 394, 395, 401, 402, 403,
+	404, 412, 416, 434, 435, 447, 460, 465, 272, 442,
+	466, 0, 310, 832, 838, 312, 256, 275, 286, 846,
+	455, 413, 207, 382, 264, 196, 226, 210, 235, 250,
+	253, 290, 321, 328, 357, 361, 269, 247, 223, 379,
+	220, 398, 419, 420, 421, 423, 325, 242, 360, 828,
+	855, 308, 425, 426, 282, 882, 867, 424, 0, 813,
+	885, 782, 800, 895, 803, 806, 848, 761, 827, 344,
+	797, 0, 786, 756, 792, 757, 784, 815, 246, 781,
+	869, 831, 884, 299, 243, 763, 787, 358, 802, 193,
+	850, 400, 230, 309, 306, 431, 257, 249, 811, 225,
+	245, 228, 283, 316, 356, 418, 350, 891, 303, 837,
+	0, 409, 329, 0, 0, 0, 817, 873, 825, 862,
+	812, 849, 771, 836, 886, 798, 845, 887, 289, 227,
+	192, 341, 410, 261, 0, 0, 0, 0, 184, 185,
+	186, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 217, 0, 224, 794, 842, 881, 795, 844, 241,
+	287, 248, 240, 428, 892, 872, 760, 824, 880, 0,
+	397, 0, 0, 209, 883, 819, 0, 847, 0, 898,
+	755, 839, 0, 758, 762, 894, 876, 790, 251, 0,
+	0, 0, 0, 0, 0, 0, 816, 826, 859, 809,
+	0, 0, 0, 0, 0, 2225, 0, 788, 0, 835,
+	0, 0, 0, 767, 759, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 814, 0, 0, 0,
+	770, 0, 789, 860, 0, 753, 270, 764, 330, 233,
+	0, 865, 875, 810, 462, 879, 808, 807, 854, 768,
+	871, 801, 298, 766, 295, 188, 205, 0, 799, 340,
+	381, 387, 870, 785, 793, 231, 791, 385, 354, 446,
+	213, 259, 378, 359, 383, 366, 262, 834, 852, 384,
+	304, 433, 373, 443, 463, 464, 239, 334, 453, 422,
+	459, 475, 206, 236, 348, 415, 449, 406, 327, 429,
+	430, 294, 405, 268, 191, 302, 469, 204, 393, 221,
+	211, 197, 417, 441, 218, 396, 0, 0, 477, 199,
+	439, 414, 323, 291, 292, 198, 0, 377, 244, 266,
+	234, 343, 436, 437, 232, 478, 208, 458, 201, 1079,
+	457, 336, 432, 440, 324, 314, 200, 438, 322, 313,
+	297, 255, 277, 371, 307, 372, 278, 332, 331, 333,
+	194, 450, 0, 195, 0, 411, 451, 479, 214, 215,
+	216, 780, 254, 258, 265, 267, 273, 274, 281, 300,
+	347, 370, 368, 374, 866, 427, 444, 454, 461, 467,
+	468, 470, 471, 472, 473, 474, 335, 280, 407, 296,
+	305, 857, 897, 353, 386, 219, 448, 408, 775, 779,
+	773, 774, 829, 830, 776, 888, 889, 890, 480, 481,
+	482, 483, 484, 485, 486, 487, 488, 489, 490, 491,
+	492, 493, 494, 495, 496, 497, 0, 861, 769, 0,
+	777, 778, 0, 868, 877, 878, 498, 315, 399, 445,
+	833, 187, 202, 301, 893, 375, 263, 476, 456, 851,
+	896, 863, 229, 772, 783, 796, 820, 821, 823, 840,
+	841, 853, 856, 864, 452, 754, 238, 0, 0, 804,
+	805, 818, 822, 326, 843, 858, 874, 189, 190, 203,
+	212, 222, 237, 252, 260, 271, 276, 279, 284, 285,
+	288, 293, 311, 317, 318, 319, 320, 337, 338, 339,
+	342, 345, 346, 349, 351, 352, 355, 362, 363, 364,
+	365, 367, 369, 376, 380, 388, 389, 390, 391, 392,
+	394, 395, 401, 402, 403, 404, 412, 416, 434, 435,
+	447, 460, 465, 272, 442, 466, 0, 310, 832, 838,
+	312, 256, 275, 286, 846, 455, 413, 207, 382, 264,
+	196, 226, 210, 235, 250, 253, 290, 321, 328, 357,
+	361, 269, 247, 223, 379, 220, 398, 419, 420, 421,
+	423, 325, 242, 360, 828, 855, 308, 425, 426, 282,
+	882, 867, 424, 0, 813, 885, 782, 800, 895, 803,
+	806, 848, 761, 827, 344, 797, 0, 786, 756, 792,
+	757, 784, 815, 246, 781, 869, 831, 884, 299, 243,
+	763, 787, 358, 802, 193, 850, 400, 230, 309, 306,
+	431, 257, 249, 811, 225, 245, 228, 283, 316, 356,
+	418, 350, 891, 303, 837, 0, 409, 329, 0, 0,
+	0, 817, 873, 825, 862, 812, 849, 771, 836, 886,
+	798, 845, 887, 289, 227, 192, 341, 410, 261, 0,
+	0, 0, 0, 184, 185, 186, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 217, 0, 224, 794,
+	842, 881, 795, 844, 241, 287, 248, 240, 428, 892,
+	872, 760, 824, 880, 0, 397, 0, 0, 209, 883,
+	819, 0, 847, 0, 898, 755, 839, 0, 758, 762,
+	894, 876, 790, 251, 0, 0, 0, 0, 0, 0,
+	0, 816, 826, 859, 809, 0, 0, 0, 0, 0,
+	2185, 0, 788, 0, 835, 0, 0, 0, 767, 759,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 814, 0, 0, 0, 770, 0, 789, 860, 0,
+	753, 270, 764, 330, 233, 0, 865, 875, 810, 462,
+	879, 808, 807, 854, 768, 871, 801, 298, 766, 295,
+	188, 205, 0, 799, 340, 381, 387, 870, 785, 793,
+	231, 791, 385, 354, 446, 213, 259, 378, 359, 383,
+	366, 262, 834, 852, 384, 304, 433, 373, 443, 463,
+	464, 239, 334, 453, 422, 459, 475, 206, 236, 348,
+	415, 449, 406, 327, 429, 430, 294, 405, 268, 191,
+	302, 469, 204, 393, 221, 211, 197, 417, 441, 218,
+	396, 0, 0, 477, 199, 439, 414, 323, 291, 292,
+	198, 0, 377, 244, 266, 234, 343, 436, 437, 232,
+	478, 208, 458, 201, 1079, 457, 336, 432, 440, 324,
+	314, 200, 438, 322, 313, 297, 255, 277, 371, 307,
+	372, 278, 332, 331, 333, 194, 450, 0, 195, 0,
+	411, 451, 479, 214, 215, 216, 780, 254, 258, 265,
+	267, 273, 274, 281, 300, 347, 370, 368, 374, 866,
+	427, 444, 454, 461, 467, 468, 470, 471, 472, 473,
+	474, 335, 280, 407, 296, 305, 857, 897, 353, 386,
+	219, 448, 408, 775, 779, 773, 774, 829, 830, 776,
+	888, 889, 890, 480, 481, 482, 483, 484, 485, 486,
+	487, 488, 489, 490, 491, 492, 493, 494, 495, 496,
+	497, 0, 861, 769, 0, 777, 778, 0, 868, 877,
+	878, 498, 315, 399, 445, 833, 187, 202, 301, 893,
+	375, 263, 476, 456, 851, 896, 863, 229, 772, 783,
+	796, 820, 821, 823, 840, 841, 853, 856, 864, 452,
+	754, 238, 0, 0, 804, 805, 818, 822, 326, 843,
+	858, 874, 189, 190, 203, 212, 222, 237, 252, 260,
+	271, 276, 279, 284, 285, 288, 293, 311, 317, 318,
+	319, 320, 337, 338, 339, 342, 345, 346, 349, 351,
+	352, 355, 362, 363, 364, 365, 367, 369, 376, 380,
+	388, 389, 390, 391, 392, 394, 395, 401, 402, 403,
+	404, 412, 416, 434, 435, 447, 460, 465, 272, 442,
+	466, 0, 310, 832, 838, 312, 256, 275, 286, 846,
+	455, 413, 207, 382, 264, 196, 226, 210, 235, 250,
+	253, 290, 321, 328, 357, 361, 269, 247, 223, 379,
+	220, 398, 419, 420, 421, 423, 325, 242, 360, 828,
+	855, 308, 425, 426, 282, 882, 867, 424, 0, 813,
+	885, 782, 800, 895, 803, 806, 848, 761, 827, 344,
+	797, 0, 786, 756, 792, 757, 784, 815, 246, 781,
+	869, 831, 884, 299, 243, 763, 787, 358, 802, 193,
+	850, 400, 230, 309, 306, 431, 257, 249, 811, 225,
+	245, 228, 283, 316, 356, 418, 350, 891, 303, 837,
+	0, 409, 329, 0, 0, 0, 817, 873, 825, 862,
+	812, 849, 771, 836, 886, 798, 845, 887, 289, 227,
+	192, 341, 410, 261, 0, 0, 0, 0, 184, 185,
+	186, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 217, 0, 224, 794, 842, 881, 795, 844, 241,
+	287, 248, 240, 428, 892, 872, 760, 824, 880, 0,
+	397, 0, 0, 209, 883, 819, 0, 847, 0, 898,
+	755, 839, 0, 758, 762, 894, 876, 790, 251, 0,
+	0, 0, 0, 0, 0, 0, 816, 826, 859, 809,
+	0, 0, 0, 0, 0, 1698, 0, 788, 0, 835,
+	0, 0, 0, 767, 759, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 814, 0, 0, 0,
+	770, 0, 789, 860, 0, 753, 270, 764, 330, 233,
+	0, 865, 875, 810, 462, 879, 808, 807, 854, 768,
+	871, 801, 298, 766, 295, 188, 205, 0, 799, 340,
+	381, 387, 870, 785, 793, 231, 791, 385, 354, 446,
+	213, 259, 378, 359, 383, 366, 262, 834, 852, 384,
+	304, 433, 373, 443, 463, 464, 239, 334, 453, 422,
+	459, 475, 206, 236, 348, 415, 449, 406, 327, 429,
+	430, 294, 405, 268, 191, 302, 469, 204, 393, 221,
+	211, 197, 417, 441, 218, 396, 0, 0, 477, 199,
+	439, 414, 323, 291, 292, 198, 0, 377, 244, 266,
+	234, 343, 436, 437, 232, 478, 208, 458, 201, 1079,
+	457, 336, 432, 440, 324, 314, 200, 438, 322, 313,
+	297, 255, 277, 371, 307, 372, 278, 332, 331, 333,
+	194, 450, 0, 195, 0, 411, 451, 479, 214, 215,
+	216, 780, 254, 258, 265, 267, 273, 274, 281, 300,
+	347, 370, 368, 374, 866, 427, 444, 454, 461, 467,
+	468, 470, 471, 472, 473, 474, 335, 280, 407, 296,
+	305, 857, 897, 353, 386, 219, 448, 408, 775, 779,
+	773, 774, 829, 830, 776, 888, 889, 890, 480, 481,
+	482, 483, 484, 485, 486, 487, 488, 489, 490, 491,
+	492, 493, 494, 495, 496, 497, 0, 861, 769, 0,
+	777, 778, 0, 868, 877, 878, 498, 315, 399, 445,
+	833, 187, 202, 301, 893, 375, 263, 476, 456, 851,
+	896, 863, 229, 772, 783, 796, 820, 821, 823, 840,
+	841, 853, 856, 864, 452, 754, 238, 0, 0, 804,
+	805, 818, 822, 326, 843, 858, 874, 189, 190, 203,
+	212, 222, 237, 252, 260, 271, 276, 279, 284, 285,
+	288, 293, 311, 317, 318, 319, 320, 337, 338, 339,
+	342, 345, 346, 349, 351, 352, 355, 362, 363, 364,
+	365, 367, 369, 376, 380, 388, 389, 390, 391, 392,
+	394, 395, 401, 402, 403, 404, 412, 416, 434, 435,
+	447, 460, 465, 272, 442, 466, 0, 310, 832, 838,
+	312, 256, 275, 286, 846, 455, 413, 207, 382, 264,
+	196, 226, 210, 235, 250, 253, 290, 321, 328, 357,
+	361, 269, 247, 223, 379, 220, 398, 419, 420, 421,
+	423, 325, 242, 360, 828, 855, 308, 425, 426, 282,
+	882, 867, 424, 0, 813, 885, 782, 800, 895, 803,
+	806, 848, 761, 827, 344, 797, 0, 786, 756, 792,
+	757, 784, 815, 246, 781, 869, 831, 884, 299, 243,
+	763, 787, 358, 802, 193, 850, 400, 230, 309, 306,
+	431, 257, 249, 811, 225, 245, 228, 283, 316, 356,
+	418, 350, 891, 303, 837, 0, 409, 329, 0, 0,
+	0, 817, 873, 825, 862, 812, 849, 771, 836, 886,
+	798, 845, 887, 289, 227, 192, 341, 410, 261, 0,
+	87, 0, 0, 184, 185, 186, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 217, 0, 224, 794,
+	842, 881, 795, 844, 241, 287, 248, 240, 428, 892,
+	872, 760, 824, 880, 0, 397, 0, 0, 209, 883,
+	819, 0, 847, 0, 898, 755, 839, 0, 758, 762,
+	894, 876, 790, 251, 0, 0, 0, 0, 0, 0,
+	0, 816, 826, 859, 809, 0, 0, 0, 0, 0,
+	0, 0, 788, 0, 835, 0, 0, 0, 767, 759,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 814, 0, 0, 0, 770, 0, 789, 860, 0,
+	753, 270, 764, 330, 233, 0, 865, 875, 810, 462,
+	879, 808, 807, 854, 768, 871, 801, 298, 766, 295,
+	188, 205, 0, 799, 340, 381, 387, 870, 785, 793,
+	231, 791, 385, 354, 446, 213, 259, 378, 359, 383,
+	366, 262, 834, 852, 384, 304, 433, 373, 443, 463,
+	464, 239, 334, 453, 422, 459, 475, 206, 236, 348,
+	415, 449, 406, 327, 429, 430, 294, 405, 268, 191,
+	302, 469, 204, 393, 221, 211, 197, 417, 441, 218,
+	396, 0, 0, 477, 199, 439, 414, 323, 291, 292,
+	198, 0, 377, 244, 266, 234, 343, 436, 437, 232,
+	478, 208, 458, 201, 1079, 457, 336, 432, 440, 324,
+	314, 200, 438, 322, 313, 297, 255, 277, 371, 307,
+	372, 278, 332, 331, 333, 194, 450, 0, 195, 0,
+	411, 451, 479, 214, 215, 216, 780, 254, 258, 265,
+	267, 273, 274, 281, 300, 347, 370, 368, 374, 866,
+	427, 444, 454, 461, 467, 468, 470, 471, 472, 473,
+	474, 335, 280, 407, 296, 305, 857, 897, 353, 386,
+	219, 448, 408, 775, 779, 773, 774, 829, 830, 776,
+	888, 889, 890, 480, 481, 482, 483, 484, 485, 486,
+	487, 488, 489, 490, 491, 492, 493, 494, 495, 496,
+	497, 0, 861, 769, 0, 777, 778, 0, 868, 877,
+	878, 498, 315, 399, 445, 833, 187, 202, 301, 893,
+	375, 263, 476, 456, 851, 896, 863, 229, 772, 783,
+	796, 820, 821, 823, 840, 841, 853, 856, 864, 452,
+	754, 238, 0, 0, 804, 805, 818, 822, 326, 843,
+	858, 874, 189, 190, 203, 212, 222, 237, 252, 260,
+	271, 276, 279, 284, 285, 288, 293, 311, 317, 318,
+	319, 320, 337, 338, 339, 342, 345, 346, 349, 351,
+	352, 355, 362, 363, 364, 365, 367, 369, 376, 380,
+	388, 389, 390, 391, 392, 394, 395, 401, 402, 403,
+	404, 412, 416, 434, 435, 447, 460, 465, 272, 442,
+	466, 0, 310, 832, 838, 312, 256, 275, 286, 846,
+	455, 413, 207, 382, 264, 196, 226, 210, 235, 250,
+	253, 290, 321, 328, 357, 361, 269, 247, 223, 379,
+	220, 398, 419, 420, 421, 423, 325, 242, 360, 828,
+	855, 308, 425, 426, 282, 882, 867, 424, 0, 813,
+	885, 782, 800, 895, 803, 806, 848, 761, 827, 344,
+	797, 0, 786, 756, 792, 757, 784, 815, 246, 781,
+	869, 831, 884, 299, 243, 763, 787, 358, 802, 193,
+	850, 400, 230, 309, 306, 431, 257, 249, 811, 225,
+	245, 228, 283, 316, 356, 418, 350, 891, 303, 837,
+	0, 409, 329, 0, 0, 0, 817, 873, 825, 862,
+	812, 849, 771, 836, 886, 798, 845, 887, 289, 227,
+	192, 341, 410, 261, 0, 0, 0, 0, 184, 185,
+	186, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 217, 0, 224, 794, 842, 881, 795, 844, 241,
+	287, 248, 240, 428, 892, 872, 760, 824, 880, 0,
+	397, 0, 0, 209, 883, 819, 0, 847, 0, 898,
+	755, 839, 0, 758, 762, 894, 876, 790, 251, 0,
+	0, 0, 0, 0, 0, 0, 816, 826, 859, 809,
+	0, 0, 0, 0, 0, 0, 0, 788, 0, 835,
+	0, 0, 0, 767, 759, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 814, 0, 0, 0,
+	770, 0, 789, 860, 0, 753, 270, 764, 330, 233,
+	0, 865, 875, 810, 462, 879, 808, 807, 854, 768,
+	871, 801, 298, 766, 295, 188, 205, 0, 799, 340,
+	381, 387, 870, 785, 793, 231, 791, 385, 354, 446,
+	213, 259, 378, 359, 383, 366, 262, 834, 852, 384,
+	304, 433, 373, 443, 463, 464, 239, 334, 453, 422,
+	459, 475, 206, 236, 348, 415, 449, 406, 327, 429,
+	430, 294, 405, 268, 191, 302, 469, 204, 393, 221,
+	211, 197, 417, 441, 218, 396, 0, 0, 477, 199,
+	439, 414, 323, 291, 292, 198, 0, 377, 244, 266,
+	234, 343, 436, 437, 232, 478, 208, 458, 201, 1079,
+	457, 336, 432, 440, 324, 314, 200, 438, 322, 313,
+	297, 255, 277, 371, 307, 372, 278, 332, 331, 333,
+	194, 450, 0, 195, 0, 411, 451, 479, 214, 215,
+	216, 780, 254, 258, 265, 267, 273, 274, 281, 300,
+	347, 370, 368, 374, 866, 427, 444, 454, 461, 467,
+	468, 470, 471, 472, 473, 474, 335, 280, 407, 296,
+	305, 857, 897, 353, 386, 219, 448, 408, 775, 779,
+	773, 774, 829, 830, 776, 888, 889, 890, 480, 481,
+	482, 483, 484, 485, 486, 487, 488, 489, 490, 491,
+	492, 493, 494, 495, 496, 497, 0, 861, 769, 0,
+	777, 778, 0, 868, 877, 878, 498, 315, 399, 445,
+	833, 187, 202, 301, 893, 375, 263, 476, 456, 851,
+	896, 863, 229, 772, 783, 796, 820, 821, 823, 840,
+	841, 853, 856, 864, 452, 754, 238, 0, 0, 804,
+	805, 818, 822, 326, 843, 858, 874, 189, 190, 203,
+	212, 222, 237, 252, 260, 271, 276, 279, 284, 285,
+	288, 293, 311, 317, 318, 319, 320, 337, 338, 339,
+	342, 345, 346, 349, 351, 352, 355, 362, 363, 364,
+	365, 367, 369, 376, 380, 388, 389, 390, 391, 392,
+	394, 395, 401, 402, 403, 404, 412, 416, 434, 435,
+	447, 460, 465, 272, 442, 466, 0, 310, 832, 838,
+	312, 256, 275, 286, 846, 455, 413, 207, 382, 264,
+	196, 226, 210, 235, 250, 253, 290, 321, 328, 357,
+	361, 269, 247, 223, 379, 220, 398, 419, 420, 421,
+	423, 325, 242, 360, 828, 855, 308, 425, 426, 282,
+	882, 867, 424, 0, 813, 885, 782, 800, 895, 803,
+	806, 848, 761, 827, 344, 797, 0, 786, 756, 792,
+	757, 784, 815, 246, 781, 869, 831, 884, 299, 243,
+	763, 787, 358, 802, 193, 850, 400, 230, 309, 306,
+	431, 257, 249, 811, 225, 245, 228, 283, 316, 356,
+	418, 350, 891, 303, 837, 0, 409, 329, 0, 0,
+	0, 817, 873, 825, 862, 812, 849, 771, 836, 886,
+	798, 845, 887, 289, 227, 192, 341, 410, 261, 0,
+	0, 0, 0, 184, 185, 186, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 217, 0, 224, 794,
+	842, 881, 795, 844, 241, 287, 248, 240, 428, 892,
+	872, 760, 824, 880, 0, 397, 0, 0, 899, 883,
+	819, 0, 847, 0, 898, 755, 839, 0, 758, 762,
+	894, 876, 790, 251, 0, 0, 0, 0, 0, 0,
+	0, 816, 826, 859, 809, 0, 0, 0, 0, 0,
+	0, 0, 788, 0, 835, 0, 0, 0, 767, 759,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 814, 0, 0, 0, 770, 0, 789, 860, 0,
+	753, 270, 764, 330, 233, 0, 865, 875, 810, 462,
+	879, 808, 807, 854, 768, 871, 801, 298, 766, 295,
+	188, 205, 0, 799, 340, 381, 387, 870, 785, 793,
+	231, 791, 385, 354, 446, 213, 259, 378, 359, 383,
+	366, 262, 834, 852, 384, 304, 433, 373, 443, 463,
+	464, 239, 334, 453, 422, 459, 475, 206, 236, 348,
+	415, 449, 406, 327, 429, 430, 294, 405, 268, 191,
+	302, 469, 204, 393, 221, 211, 197, 417, 441, 218,
+	396, 0, 0, 477, 199, 439, 414, 323, 291, 292,
+	198, 0, 377, 244, 266, 234, 343, 436, 437, 232,
+	478, 208, 458, 201, 765, 457, 336, 432, 440, 324,
+	314, 200, 438, 322, 313, 297, 255, 277, 371, 307,
+	372, 278, 332, 331, 333, 194, 450, 0, 195, 0,
+	411, 451, 479, 214, 215, 216, 780, 254, 258, 265,
+	267, 273, 274, 281, 300, 347, 370, 368, 374, 866,
+	427, 444, 454, 461, 467, 468, 470, 471, 472, 473,
+	474, 752, 746, 745, 296, 305, 857, 897, 353, 386,
+	219, 448, 408, 775, 779, 773, 774, 829, 830, 776,
+	888, 889, 890, 480, 481, 482, 483, 484, 485, 486,
+	487, 488, 489, 490, 491, 492, 493, 494, 495, 496,
+	497, 0, 861, 769, 0, 777, 778, 0, 868, 877,
+	878, 498, 315, 399, 445, 833, 187, 202, 301, 893,
+	375, 263, 476, 456, 851, 896, 863, 229, 772, 783,
+	796, 820, 821, 823, 840, 841, 853, 856, 864, 452,
+	754, 238, 0, 0, 804, 805, 818, 822, 326, 843,
+	858, 874, 189, 190, 203, 212, 222, 237, 252, 260,
+	271, 276, 279, 284, 285, 288, 293, 311, 317, 318,
+	319, 320, 337, 338, 339, 342, 345, 346, 349, 351,
+	352, 355, 362, 363, 364, 365, 367, 369, 376, 380,
+	388, 389, 390, 391, 392, 394, 395, 401, 402, 403,
+	404, 412, 416, 434, 435, 447, 460, 465, 272, 442,
+	466, 0, 310, 832, 838, 312, 256, 275, 286, 846,
+	455, 413, 207, 382, 264, 196, 226, 210, 235, 250,
+	253, 290, 321, 328, 357, 361, 269, 247, 223, 379,
+	220, 398, 419, 420, 421, 423, 325, 242, 360, 828,
+	855, 308, 425, 426, 282, 882, 867, 424, 0, 813,
+	885, 782, 800, 895, 803, 806, 848, 761, 827, 344,
+	797, 0, 786, 756, 792, 757, 784, 815, 246, 781,
+	869, 831, 884, 299, 243, 763, 787, 358, 802, 193,
+	850, 400, 230, 309, 306, 431, 257, 249, 811, 225,
+	245, 228, 283, 316, 356, 418, 350, 891, 303, 837,
+	0, 409, 329, 0, 0, 0, 817, 873, 825, 862,
+	812, 849, 771, 836, 886, 798, 845, 887, 289, 227,
+	192, 341, 410, 261, 0, 0, 0, 0, 184, 185,
+	186, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 217, 0, 224, 794, 842, 881, 795, 844, 241,
+	287, 248, 240, 428, 892, 872, 760, 824, 880, 0,
+	397, 0, 0, 899, 883, 819, 0, 847, 0, 898,
+	755, 839, 0, 758, 762, 894, 876, 790, 251, 0,
+	0, 0, 0, 0, 0, 0, 816, 826, 859, 809,
+	0, 0, 0, 0, 0, 0, 0, 788, 0, 835,
+	0, 0, 0, 767, 759, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 814, 0, 0, 0,
+	770, 0, 789, 860, 0, 753, 270, 764, 330, 233,
+	0, 865, 875, 810, 462, 879, 808, 807, 854, 768,
+	871, 801, 298, 766, 295, 188, 205, 0, 799, 340,
+	381, 387, 870, 785, 793, 231, 791, 385, 354, 446,
+	213, 259, 378, 359, 383, 366, 262, 834, 852, 384,
+	304, 433, 373, 443, 463, 464, 239, 334, 453, 422,
+	459, 475, 206, 236, 348, 415, 449, 406, 327, 429,
+	430, 294, 405, 268, 191, 302, 469, 204, 393, 221,
+	211, 197, 417, 1275, 218, 396, 0, 0, 477, 199,
+	439, 414, 323, 291, 292, 198, 0, 377, 244, 266,
+	234, 343, 436, 437, 232, 478, 208, 458, 201, 765,
+	457, 336, 432, 440, 324, 314, 200, 438, 322, 313,
+	297, 255, 277, 371, 307, 372, 278, 332, 331, 333,
+	194, 450, 0, 195, 0, 411, 451, 479, 214, 215,
+	216, 780, 254, 258, 265, 267, 273, 274, 281, 300,
+	347, 370, 368, 374, 866, 427, 444, 454, 461, 467,
+	468, 470, 471, 472, 473, 474, 752, 746, 745, 296,
+	305, 857, 897, 353, 386, 219, 448, 408, 775, 779,
+	773, 774, 829, 830, 776, 888, 889, 890, 480, 481,
+	482, 483, 484, 485, 486, 487, 488, 489, 490, 491,
+	492, 493, 494, 495, 496, 497, 0, 861, 769, 0,
+	777, 778, 0, 868, 877, 878, 498, 315, 399, 445,
+	833, 187, 202, 301, 893, 375, 263, 476, 456, 851,
+	896, 863, 229, 772, 783, 796, 820, 821, 823, 840,
+	841, 853, 856, 864, 452, 754, 238, 0, 0, 804,
+	805, 818, 822, 326, 843, 858, 874, 189, 190, 203,
+	212, 222, 237, 252, 260, 271, 276, 279, 284, 285,
+	288, 293, 311, 317, 318, 319, 320, 337, 338, 339,
+	342, 345, 346, 349, 351, 352, 355, 362, 363, 364,
+	365, 367, 369, 376, 380, 388, 389, 390, 391, 392,
+	394, 395, 401, 402, 403, 404, 412, 416, 434, 435,
+	447, 460, 465, 272, 442, 466, 0, 310, 832, 838,
+	312, 256, 275, 286, 846, 455, 413, 207, 382, 264,
+	196, 226, 210, 235, 250, 253, 290, 321, 328, 357,
+	361, 269, 247, 223, 379, 220, 398, 419, 420, 421,
+	423, 325, 242, 360, 828, 855, 308, 425, 426, 282,
+	882, 867, 424, 0, 813, 885, 782, 800, 895, 803,
+	806, 848, 761, 827, 344, 797, 0, 786, 756, 792,
+	757, 784, 815, 246, 781, 869, 831, 884, 299, 243,
+	763, 787, 358, 802, 193, 850, 400, 230, 309, 306,
+	431, 257, 249, 811, 225, 245, 228, 283, 316, 356,
+	418, 350, 891, 303, 837, 0, 409, 329, 0, 0,
+	0, 817, 873, 825, 862, 812, 849, 771, 836, 886,
+	798, 845, 887, 289, 227, 192, 341, 410, 261, 0,
+	0, 0, 0, 184, 185, 186, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 217, 0, 224, 794,
+	842, 881, 795, 844, 241, 287, 248, 240, 428, 892,
+	872, 760, 824, 880, 0, 397, 0, 0, 899, 883,
+	819, 0, 847, 0, 898, 755, 839, 0, 758, 762,
+	894, 876, 790, 251, 0, 0, 0, 0, 0, 0,
+	0, 816, 826, 859, 809, 0, 0, 0, 0, 0,
+	0, 0, 788, 0, 835, 0, 0, 0, 767, 759,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 814, 0, 0, 0, 770, 0, 789, 860, 0,
+	753, 270, 764, 330, 233, 0, 865, 875, 810, 462,
+	879, 808, 807, 854, 768, 871, 801, 298, 766, 295,
+	188, 205, 0, 799, 340, 381, 387, 870, 785, 793,
+	231, 791, 385, 354, 446, 213, 259, 378, 359, 383,
+	366, 262, 834, 852, 384, 304, 433, 373, 443, 463,
+	464, 239, 334, 453, 422, 459, 475, 206, 236, 348,
+	415, 449, 406, 327, 429, 430, 294, 405, 268, 191,
+	302, 469, 204, 393, 221, 211, 197, 417, 743, 218,
+	396, 0, 0, 477, 199, 439, 414, 323, 291, 292,
+	198, 0, 377, 244, 266, 234, 343, 436, 437, 232,
+	478, 208, 458, 201, 765, 457, 336, 432, 440, 324,
+	314, 200, 438, 322, 313, 297, 255, 277, 371, 307,
+	372, 278, 332, 331, 333, 194, 450, 0, 195, 0,
+	411, 451, 479, 214, 215, 216, 780, 254, 258, 265,
+	267, 273, 274, 281, 300, 347, 370, 368, 374, 866,
+	427, 444, 454, 461, 467, 468, 470, 471, 472, 473,
+	474, 752, 746, 745, 296, 305, 857, 897, 353, 386,
+	219, 448, 408, 775, 779, 773, 774, 829, 830, 776,
+	888, 889, 890, 480, 481, 482, 483, 484, 485, 486,
+	487, 488, 489, 490, 491, 492, 493, 494, 495, 496,
+	497, 0, 861, 769, 0, 777, 778, 0, 868, 877,
+	878, 498, 315, 399, 445, 833, 187, 202, 301, 893,
+	375, 263, 476, 456, 851, 896, 863, 229, 772, 783,
+	796, 820, 821, 823, 840, 841, 853, 856, 864, 452,
+	754, 238, 0, 0, 804, 805, 818, 822, 326, 843,
+	858, 874, 189, 190, 203, 212, 222, 237, 252, 260,
+	271, 276, 279, 284, 285, 288, 293, 311, 317, 318,
+	319, 320, 337, 338, 339, 342, 345, 346, 349, 351,
+	352, 355, 362, 363, 364, 365, 367, 369, 376, 380,
+	388, 389, 390, 391, 392, 394, 395, 401, 402, 403,
+	404, 412, 416, 434, 435, 447, 460, 465, 272, 442,
+	466, 0, 310, 832, 838, 312, 256, 275, 286, 846,
+	455, 413, 207, 382, 264, 196, 226, 210, 235, 250,
+	253, 290, 321, 328, 357, 361, 269, 247, 223, 379,
+	220, 398, 419, 420, 421, 423, 325, 242, 360, 828,
+	855, 308, 425, 426, 282, 424, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 344, 0, 0,
+	1639, 0, 580, 0, 0, 0, 246, 585, 0, 0,
+	0, 299, 243, 0, 1640, 358, 0, 193, 0, 400,
+	230, 309, 306, 431, 257, 249, 0, 225, 245, 228,
+	283, 316, 356, 418, 350, 593, 303, 0, 0, 409,
+	329, 0, 0, 0, 0, 0, 587, 588, 0, 0,
+	0, 0, 0, 0, 0, 0, 289, 227, 192, 341,
+	410, 261, 0, 87, 0, 0, 184, 185, 186, 638,
+	645, 646, 647, 648, 649, 639, 641, 0, 0, 217,
+	640, 224, 613, 643, 650, 651, 0, 241, 287, 248,
+	240, 428, 0, 0, 0, 0, 0, 0, 397, 0,
+	0, 209, 0, 0, 0, 0, 0, 0, 0, 562,
+	577, 0, 592, 0, 0, 0, 251, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 574, 575, 732, 0, 0, 0, 623, 0, 576,
+	0, 0, 584, 652, 653, 654, 655, 656, 657, 658,
+	659, 660, 661, 662, 663, 664, 665, 666, 667, 668,
+	669, 670, 671, 672, 673, 674, 675, 676, 677, 678,
+	679, 680, 681, 682, 683, 684, 685, 686, 687, 688,
+	689, 690, 691, 692, 586, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 270, 0, 330, 233, 0, 622,
+	0, 0, 462, 0, 0, 620, 0, 0, 0, 0,
+	298, 0, 295, 188, 205, 0, 0, 340, 381, 387,
+	0, 0, 0, 231, 0, 385, 354, 446, 213, 259,
+	378, 359, 383, 366, 262, 0, 0, 384, 304, 433,
+	373, 443, 463, 464, 239, 334, 453, 422, 459, 475,
+	206, 236, 348, 415, 449, 406, 327, 429, 430, 294,
+	405, 268, 191, 302, 469, 204, 393, 221, 211, 197,
+	417, 441, 218, 396, 0, 0, 477, 199, 439, 414,
+	323, 291, 292, 198, 0, 377, 244, 266, 234, 343,
+	436, 437, 232, 478, 208, 458, 201, 0, 457, 336,
+	432, 440, 324, 314, 200, 438, 322, 313, 297, 255,
+	277, 371, 307, 372, 278, 332, 331, 333, 194, 450,
+	0, 195, 0, 411, 451, 479, 214, 215, 216, 0,
+	254, 258, 265, 267, 273, 274, 281, 300, 347, 370,
+	368, 374, 0, 427, 444, 454, 461, 467, 468, 470,
+	471, 472, 473, 474, 335, 280, 407, 296, 305, 0,
+	0, 353, 386, 219, 448, 408, 629, 621, 608, 610,
+	630, 631, 594, 606, 609, 632, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 492, 493,
+	494, 495, 496, 497, 0, 624, 583, 582, 0, 590,
+	591, 0, 611, 612, 614, 615, 616, 617, 581, 187,
+	202, 301, 0, 375, 263, 476, 456, 0, 0, 589,
+	229, 599, 597, 603, 601, 604, 602, 605, 600, 598,
+	596, 595, 452, 0, 238, 0, 0, 0, 0, 0,
+	0, 326, 0, 0, 0, 189, 190, 203, 212, 222,
+	237, 252, 260, 271, 276, 279, 284, 285, 288, 293,
+	311, 317, 318, 319, 320, 337, 338, 339, 342, 345,
+	346, 349, 351, 352, 355, 362, 363, 364, 365, 367,
+	369, 376, 380, 388, 389, 390, 391, 392, 394, 395,
+	401, 402, 403, 404, 412, 416, 434, 435, 447, 460,
+	465, 272, 442, 466, 0, 310, 0, 0, 312, 256,
+	275, 286, 0, 455, 413, 207, 382, 264, 196, 226,
+	210, 235, 250, 253, 290, 321, 328, 357, 361, 269,
+	247, 223, 379, 220, 398, 419, 420, 421, 423, 325,
+	242, 360, 424, 0, 308, 425, 426, 282, 0, 0,
+	0, 0, 0, 0, 344, 0, 0, 0, 0, 580,
+	0, 0, 0, 246, 585, 0, 0, 0, 299, 243,
+	0, 0, 358, 0, 193, 0, 400, 230, 309, 306,
+	431, 257, 249, 0, 225, 245, 228, 283, 316, 356,
+	418, 350, 593, 303, 0, 0, 409, 329, 0, 0,
+	0, 0, 0, 587, 588, 0, 0, 0, 0, 0,
+	0, 0, 0, 289, 227, 192, 341, 410, 261, 0,
+	87, 0, 0, 184, 185, 186, 638, 645, 646, 647,
+	648, 649, 639, 641, 0, 0, 217, 640, 224, 613,
+	643, 650, 651, 0, 241, 287, 248, 240, 428, 0,
+	0, 1635, 1636, 1637, 0, 397, 0, 0, 209, 0,
+	0, 0, 0, 0, 0, 0, 562, 577, 0, 592,
+	0, 0, 0, 251, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 574, 575,
+	0, 0, 0, 0, 623, 0, 576, 0, 0, 584,
+	652, 653, 654, 655, 656, 657, 658, 659, 660, 661,
+	662, 663, 664, 665, 666, 667, 668, 669, 670, 671,
+	672, 673, 674, 675, 676, 677, 678, 679, 680, 681,
+	682, 683, 684, 685, 686, 687, 688, 689, 690, 691,
+	692, 586, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 270, 0, 330, 233, 0, 622, 0, 0, 462,
+	0, 0, 620, 0, 0, 0, 0, 298, 0, 295,
+	188, 205, 0, 0, 340, 381, 387, 0, 0, 0,
+	231, 0, 385, 354, 446, 213, 259, 378, 359, 383,
+	366, 262, 0, 0, 384, 304, 433, 373, 443, 463,
+	464, 239, 334, 453, 422, 459, 475, 206, 236, 348,
+	415, 449, 406, 327, 429, 430, 294, 405, 268, 191,
+	302, 469, 204, 393, 221, 211, 197, 417, 441, 218,
+	396, 0, 0, 477, 199, 439, 414, 323, 291, 292,
+	198, 0, 377, 244, 266, 234, 343, 436, 437, 232,
+	478, 208, 458, 201, 0, 457, 336, 432, 440, 324,
+	314, 200, 438, 322, 313, 297, 255, 277, 371, 307,
+	372, 278, 332, 331, 333, 194, 450, 0, 195, 0,
+	411, 451, 479, 214, 215, 216, 0, 254, 258, 265,
+	267, 273, 274, 281, 300, 347, 370, 368, 374, 0,
+	427, 444, 454, 461, 467, 468, 470, 471, 472, 473,
+	474, 335, 280, 407, 296, 305, 0, 0, 353, 386,
+	219, 448, 408, 629, 621, 608, 610, 630, 631, 594,
+	606, 609, 632, 480, 481, 482, 483, 484, 485, 486,
+	487, 488, 489, 490, 491, 492, 493, 494, 495, 496,
+	497, 0, 624, 583, 582, 0, 590, 591, 0, 611,
+	612, 614, 615, 616, 617, 581, 187, 202, 301, 0,
+	375, 263, 476, 456, 0, 0, 589, 229, 599, 597,
+	603, 601, 604, 602, 605, 600, 598, 596, 595, 452,
+	0, 238, 0, 0, 0, 0, 0, 0, 326, 0,
+	0, 0, 189, 190, 203, 212, 222, 237, 252, 260,
+	271, 276, 279, 284, 285, 288, 293, 311, 317, 318,
+	319, 320, 337, 338, 339, 342, 345, 346, 349, 351,
+	352, 355, 362, 363, 364, 365, 367, 369, 376, 380,
+	388, 389, 390, 391, 392, 394, 395, 401, 402, 403,
+	404, 412, 416, 434, 435, 447, 460, 465, 272, 442,
+	466, 0, 310, 0, 0, 312, 256, 275, 286, 0,
+	455, 413, 207, 382, 264, 196, 226, 210, 235, 250,
+	253, 290, 321, 328, 357, 361, 269, 247, 223, 379,
+	220, 398, 419, 420, 421, 423, 325, 242, 360, 424,
+	0, 308, 425, 426, 282, 0, 0, 0, 0, 0,
+	0, 344, 0, 0, 0, 0, 580, 0, 0, 0,
+	246, 585, 0, 0, 0, 299, 243, 0, 0, 358,
+	0, 193, 0, 400, 230, 309, 306, 431, 257, 249,
+	0, 225, 245, 228, 283, 316, 356, 418, 350, 593,
+	303, 0, 0, 409, 329, 0, 0, 0, 0, 0,
+	587, 588, 0, 0, 0, 0, 0, 0, 1722, 0,
+	289, 227, 192, 341, 410, 261, 0, 87, 0, 0,
+	184, 185, 186, 638, 645, 646, 647, 648, 649, 639,
+	641, 0, 0, 217, 640, 224, 613, 643, 650, 651,
+	1723, 241, 287, 248, 240, 428, 0, 0, 0, 0,
+	0, 0, 397, 0, 0, 209, 0, 0, 0, 0,
+	0, 0, 0, 562, 577, 0, 592, 0, 0, 0,
+	251, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 574, 575, 0, 0, 0,
+	0, 623, 0, 576, 0, 0, 584, 652, 653, 654,
+	655, 656, 657, 658, 659, 660, 661, 662, 663, 664,
+	665, 666, 667, 668, 669, 670, 671, 672, 673, 674,
+	675, 676, 677, 678, 679, 680, 681, 682, 683, 684,
+	685, 686, 687, 688, 689, 690, 691, 692, 586, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 270, 0,
+	330, 233, 0, 622, 0, 0, 462, 0, 0, 620,
+	0, 0, 0, 0, 298, 0, 295, 188, 205, 0,
+	0, 340, 381, 387, 0, 0, 0, 231, 0, 385,
+	354, 446, 213, 259, 378, 359, 383, 366, 262, 0,
+	0, 384, 304, 433, 373, 443, 463, 464, 239, 334,
+	453, 422, 459, 475, 206, 236, 348, 415, 449, 406,
+	327, 429, 430, 294, 405, 268, 191, 302, 469, 204,
+	393, 221, 211, 197, 417, 441, 218, 396, 0, 0,
+	477, 199, 439, 414, 323, 291, 292, 198, 0, 377,
+	244, 266, 234, 343, 436, 437, 232, 478, 208, 458,
+	201, 0, 457, 336, 432, 440, 324, 314, 200, 438,
+	322, 313, 297, 255, 277, 371, 307, 372, 278, 332,
+	331, 333, 194, 450, 0, 195, 0, 411, 451, 479,
+	214, 215, 216, 0, 254, 258, 265, 267, 273, 274,
+	281, 300, 347, 370, 368, 374, 0, 427, 444, 454,
+	461, 467, 468, 470, 471, 472, 473, 474, 335, 280,
+	407, 296, 305, 0, 0, 353, 386, 219, 448, 408,
+	629, 621, 608, 610, 630, 631, 594, 606, 609, 632,
+	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
+	490, 491, 492, 493, 494, 495, 496, 497, 0, 624,
+	583, 582, 0, 590, 591, 0, 611, 612, 614, 615,
+	616, 617, 581, 187, 202, 301, 0, 375, 263, 476,
+	456, 0, 0, 589, 229, 599, 597, 603, 601, 604,
+	602, 605, 600, 598, 596, 595, 452, 0, 238, 0,
+	0, 0, 0, 0, 0, 326, 0, 0, 0, 189,
+	190, 203, 212, 222, 237, 252, 260, 271, 276, 279,
+	284, 285, 288, 293, 311, 317, 318, 319, 320, 337,
+	338, 339, 342, 345, 346, 349, 351, 352, 355, 362,
+	363, 364, 365, 367, 369, 376, 380, 388, 389, 390,
+	391, 392, 394, 395, 401, 402, 403, 404, 412, 416,
+	434, 435, 447, 460, 465, 272, 442, 466, 0, 310,
+	0, 0, 312, 256, 275, 286, 0, 455, 413, 207,
+	382, 264, 196, 226, 210, 235, 250, 253, 290, 321,
+	328, 357, 361, 269, 247, 223, 379, 220, 398, 419,
+	420, 421, 423, 325, 242, 360, 78, 424, 308, 425,
+	426, 282, 0, 0, 0, 0, 0, 0, 0, 344,
+	0, 0, 0, 0, 580, 0, 0, 0, 246, 585,
+	0, 0, 0, 299, 243, 0, 0, 358, 0, 193,
+	0, 400, 230, 309, 306, 431, 257, 249, 0, 225,
+	245, 228, 283, 316, 356, 418, 350, 593, 303, 0,
+	0, 409, 329, 0, 0, 0, 0, 0, 587, 588,
+	0, 0, 0, 0, 0, 0, 0, 0, 289, 227,
+	192, 341, 410, 261, 0, 87, 0, 0, 184, 185,
+	186, 638, 645, 646, 647, 648, 649, 639, 641, 0,
+	0, 217, 640, 224, 613, 643, 650, 651, 0, 241,
+	287, 248, 240, 428, 0, 0, 0, 0, 0, 0,
+	397, 0, 0, 209, 0, 0, 0, 0, 0, 0,
+	0, 562, 577, 0, 592, 0, 0, 0, 251, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 574, 575, 0, 0, 0, 0, 623,
+	0, 576, 0, 0, 584, 652, 653, 654, 655, 656,
+	657, 658, 659, 660, 661, 662, 663, 664, 665, 666,
+	667, 668, 669, 670, 671, 672, 673, 674, 675, 676,
+	677, 678, 679, 680, 681, 682, 683, 684, 685, 686,
+	687, 688, 689, 690, 691, 692, 586, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 270, 0, 330, 233,
+	0, 622, 0, 0, 462, 0, 0, 620, 0, 0,
+	0, 0, 298, 0, 295, 188, 205, 0, 0, 340,
+	381, 387, 0, 0, 0, 231, 0, 385, 354, 446,
+	213, 259, 378, 359, 383, 366, 262, 0, 0, 384,
+	304, 433, 373, 443, 463, 464, 239, 334, 453, 422,
+	459, 475, 206, 236, 348, 415, 449, 406, 327, 429,
+	430, 294, 405, 268, 191, 302, 469, 204, 393, 221,
+	211, 197, 417, 441, 218, 396, 0, 0, 477, 199,
+	439, 414, 323, 291, 292, 198, 0, 377, 244, 266,
+	234, 343, 436, 437, 232, 478, 208, 458, 201, 0,
+	457, 336, 432, 440, 324, 314, 200, 438, 322, 313,
+	297, 255, 277, 371, 307, 372, 278, 332, 331, 333,
+	194, 450, 0, 195, 0, 411, 451, 479, 214, 215,
+	216, 0, 254, 258, 265, 267, 273, 274, 281, 300,
+	347, 370, 368, 374, 0, 427, 444, 454, 461, 467,
+	468, 470, 471, 472, 473, 474, 335, 280, 407, 296,
+	305, 0, 0, 353, 386, 219, 448, 408, 629, 621,
+	608, 610, 630, 631, 594, 606, 609, 632, 480, 481,
+	482, 483, 484, 485, 486, 487, 488, 489, 490, 491,
+	492, 493, 494, 495, 496, 497, 0, 624, 583, 582,
+	0, 590, 591, 0, 611, 612, 614, 615, 616, 617,
+	581, 187, 202, 301, 86, 375, 263, 476, 456, 0,
+	0, 589, 229, 599, 597, 603, 601, 604, 602, 605,
+	600, 598, 596, 595, 452, 0, 238, 0, 0, 0,
+	0, 0, 0, 326, 0, 0, 0, 189, 190, 203,
+	212, 222, 237, 252, 260, 271, 276, 279, 284, 285,
+	288, 293, 311, 317, 318, 319, 320, 337, 338, 339,
+	342, 345, 346, 349, 351, 352, 355, 362, 363, 364,
+	365, 367, 369, 376, 380, 388, 389, 390, 391, 392,
+	394, 395, 401, 402, 403, 404, 412, 416, 434, 435,
+	447, 460, 465, 272, 442, 466, 0, 310, 0, 0,
+	312, 256, 275, 286, 0, 455, 413, 207, 382, 264,
+	196, 226, 210, 235, 250, 253, 290, 321, 328, 357,
+	361, 269, 247, 223, 379, 220, 398, 419, 420, 421,
+	423, 325, 242, 360, 424, 0, 308, 425, 426, 282,
+	0, 0, 0, 0, 0, 0, 344, 0, 0, 0,
+	0, 580, 0, 0, 0, 246, 585, 0, 0, 0,
+	299, 243, 0, 0, 358, 0, 193, 0, 400, 230,
+	309, 306, 431, 257, 249, 0, 225, 245, 228, 283,
+	316, 356, 418, 350, 593, 303, 0, 0, 409, 329,
+	0, 0, 0, 0, 0, 587, 588, 0, 0, 0,
+	0, 0, 0, 0, 0, 289, 227, 192, 341, 410,
+	261, 0, 87, 0, 0, 184, 185, 186, 638, 645,
+	646, 647, 648, 649, 639, 641, 0, 0, 217, 640,
+	224, 613, 643, 650, 651, 0, 241, 287, 248, 240,
+	428, 0, 0, 0, 0, 0, 0, 397, 0, 0,
+	209, 0, 0, 0, 0, 0, 0, 0, 562, 577,
+	0, 592, 0, 0, 0, 251, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	574, 575, 0, 0, 0, 0, 623, 0, 576, 0,
+	0, 584, 652, 653, 654, 655, 656, 657, 658, 659,
+	660, 661, 662, 663, 664, 665, 666, 667, 668, 669,
+	670, 671, 672, 673, 674, 675, 676, 677, 678, 679,
+	680, 681, 682, 683, 684, 685, 686, 687, 688, 689,
+	690, 691, 692, 586, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 270, 0, 330, 233, 0, 622, 0,
+	0, 462, 0, 0, 620, 0, 0, 0, 0, 298,
+	0, 295, 188, 205, 0, 0, 340, 381, 387, 0,
+	0, 0, 231, 0, 385, 354, 446, 213, 259, 378,
+	359, 383, 366, 262, 2592, 0, 384, 304, 433, 373,
+	443, 463, 464, 239, 334, 453, 422, 459, 475, 206,
+	236, 348, 415, 449, 406, 327, 429, 430, 294, 405,
+	268, 191, 302, 469, 204, 393, 221, 211, 197, 417,
+	441, 218, 396, 0, 0, 477, 199, 439, 414, 323,
+	291, 292, 198, 0, 377, 244, 266, 234, 343, 436,
+	437, 232, 478, 208, 458, 201, 0, 457, 336, 432,
+	440, 324, 314, 200, 438, 322, 313, 297, 255, 277,
+	371, 307, 372, 278, 332, 331, 333, 194, 450, 0,
+	195, 0, 411, 451, 479, 214, 215, 216, 0, 254,
+	258, 265, 267, 273, 274, 281, 300, 347, 370, 368,
+	374, 0, 427, 444, 454, 461, 467, 468, 470, 471,
+	472, 473, 474, 335, 280, 407, 296, 305, 0, 0,
+	353, 386, 219, 448, 408, 629, 621, 608, 610, 630,
+	631, 594, 606, 609, 632, 480, 481, 482, 483, 484,
+	485, 486, 487, 488, 489, 490, 491, 492, 493, 494,
+	495, 496, 497, 0, 624, 583, 582, 0, 590, 591,
+	0, 611, 612, 614, 615, 616, 617, 581, 187, 202,
+	301, 0, 375, 263, 476, 456, 0, 0, 589, 229,
+	599, 597, 603, 601, 604, 602, 605, 600, 598, 596,
+	595, 452, 0, 238, 0, 0, 0, 0, 0, 0,
+	326, 0, 0, 0, 189, 190, 203, 212, 222, 237,
+	252, 260, 271, 276, 279, 284, 285, 288, 293, 311,
+	317, 318, 319, 320, 337, 338, 339, 342, 345, 346,
+	349, 351, 352, 355, 362, 363, 364, 365, 367, 369,
+	376, 380, 388, 389, 390, 391, 392, 394, 395, 401,
+	402, 403, 404, 412, 416, 434, 435, 447, 460, 465,
+	272, 442, 466, 0, 310, 0, 0, 312, 256, 275,
+	286, 0, 455, 413, 207, 382, 264, 196, 226, 210,
+	235, 250, 253, 290, 321, 328, 357, 361, 269, 247,
+	223, 379, 220, 398, 419, 420, 421, 423, 325, 242,
+	360, 424, 0, 308, 425, 426, 282, 0, 0, 0,
+	0, 0, 0, 344, 0, 0, 0, 0, 580, 0,
+	0, 0, 246, 585, 0, 0, 0, 299, 243, 0,
+	0, 358, 0, 193, 0, 400, 230, 309, 306, 431,
+	257, 249, 0, 225, 245, 228, 283, 316, 356, 418,
+	350, 593, 303, 0, 0, 409, 329, 0, 0, 0,
+	0, 0, 587, 588, 0, 0, 0, 0, 0, 0,
+	0, 0, 289, 227, 192, 341, 410, 261, 0, 87,
+	0, 1243, 184, 185, 186, 638, 645, 646, 647, 648,
+	649, 639, 641, 0, 0, 217, 640, 224, 613, 643,
+	650, 651, 0, 241, 287, 248, 240, 428, 0, 0,
+	0, 0, 0, 0, 397, 0, 0, 209, 0, 0,
+	0, 0, 0, 0, 0, 562, 577, 0, 592, 0,
+	0, 0, 251, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 574, 575, 0,
+	0, 0, 0, 623, 0, 576, 0, 0, 584, 652,
+	653, 654, 655, 656, 657, 658, 659, 660, 661, 662,
+	663, 664, 665, 666, 667, 668, 669, 670, 671, 672,
+	673, 674, 675, 676, 677, 678, 679, 680, 681, 682,
+	683, 684, 685, 686, 687, 688, 689, 690, 691, 692,
+	586, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	270, 0, 330, 233, 0, 622, 0, 0, 462, 0,
+	0, 620, 0, 0, 0, 0, 298, 0, 295, 188,
+	205, 0, 0, 340, 381, 387, 0, 0, 0, 231,
+	0, 385, 354, 446, 213, 259, 378, 359, 383, 366,
+	262, 0, 0, 384, 304, 433, 373, 443, 463, 464,
+	239, 334, 453, 422, 459, 475, 206, 236, 348, 415,
+	449, 406, 327, 429, 430, 294, 405, 268, 191, 302,
+	469, 204, 393, 221, 211, 197, 417, 441, 218, 396,
+	0, 0, 477, 199, 439, 414, 323, 291, 292, 198,
+	0, 377, 244, 266, 234, 343, 436, 437, 232, 478,
+	208, 458, 201, 0, 457, 336, 432, 440, 324, 314,
+	200, 438, 322, 313, 297, 255, 277, 371, 307, 372,
+	278, 332, 331, 333, 194, 450, 0, 195, 0, 411,
+	451, 479, 214, 215, 216, 0, 254, 258, 265, 267,
+	273, 274, 281, 300, 347, 370, 368, 374, 0, 427,
+	444, 454, 461, 467, 468, 470, 471, 472, 473, 474,
+	335, 280, 407, 296, 305, 0, 0, 353, 386, 219,
+	448, 408, 629, 621, 608, 610, 630, 631, 594, 606,
+	609, 632, 480, 481, 482, 483, 484, 485, 486, 487,
+	488, 489, 490, 491, 492, 493, 494, 495, 496, 497,
+	0, 624, 583, 582, 0, 590, 591, 0, 611, 612,
+	614, 615, 616, 617, 581, 187, 202, 301, 0, 375,
+	263, 476, 456, 0, 0, 589, 229, 599, 597, 603,
+	601, 604, 602, 605, 600, 598, 596, 595, 452, 0,
+	238, 0, 0, 0, 0, 0, 0, 326, 0, 0,
+	0, 189, 190, 203, 212, 222, 237, 252, 260, 271,
+	276, 279, 284, 285, 288, 293, 311, 317, 318, 319,
+	320, 337, 338, 339, 342, 345, 346, 349, 351, 352,
+	355, 362, 363, 364, 365, 367, 369, 376, 380, 388,
+	389, 390, 391, 392, 394, 395, 401, 402, 403, 404,
+	412, 416, 434, 435, 447, 460, 465, 272, 442, 466,
+	0, 310, 0, 0, 312, 256, 275, 286, 0, 455,
+	413, 207, 382, 264, 196, 226, 210, 235, 250, 253,
+	290, 321, 328, 357, 361, 269, 247, 223, 379, 220,
+	398, 419, 420, 421, 423, 325, 242, 360, 424, 0,
+	308, 425, 426, 282, 0, 0, 0, 0, 0, 0,
+	344, 0, 0, 0, 0, 580, 0, 0, 0, 246,
+	585, 0, 0, 0, 299, 243, 0, 0, 358, 0,
+	193, 0, 400, 230, 309, 306, 431, 257, 249, 0,
+	225, 245, 228, 283, 316, 356, 418, 350, 593, 303,
+	0, 0, 409, 329, 0, 0, 0, 0, 0, 587,
+	588, 0, 0, 0, 0, 0, 0, 0, 0, 289,
+	227, 192, 341, 410, 261, 0, 87, 0, 0, 184,
+	185, 186, 638, 645, 646, 647, 648, 649, 639, 641,
+	0, 0, 217, 640, 224, 613, 643, 650, 651, 0,
+	241, 287, 248, 240, 428, 0, 0, 0, 0, 0,
+	0, 397, 0, 0, 209, 0, 0, 0, 0, 0,
+	0, 0, 562, 577, 0, 592, 0, 0, 0, 251,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 574, 575, 732, 0, 0, 0,
+	623, 0, 576, 0, 0, 584, 652, 653, 654, 655,
+	656, 657, 658, 659, 660, 661, 662, 663, 664, 665,
+	666, 667, 668, 669, 670, 671, 672, 673, 674, 675,
+	676, 677, 678, 679, 680, 681, 682, 683, 684, 685,
+	686, 687, 688, 689, 690, 691, 692, 586, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 270, 0, 330,
+	233, 0, 622, 0, 0, 462, 0, 0, 620, 0,
+	0, 0, 0, 298, 0, 295, 188, 205, 0, 0,
+	340, 381, 387, 0, 0, 0, 231, 0, 385, 354,
+	446, 213, 259, 378, 359, 383, 366, 262, 0, 0,
+	384, 304, 433, 373, 443, 463, 464, 239, 334, 453,
+	422, 459, 475, 206, 236, 348, 415, 449, 406, 327,
+	429, 430, 294, 405, 268, 191, 302, 469, 204, 393,
+	221, 211, 197, 417, 441, 218, 396, 0, 0, 477,
+	199, 439, 414, 323, 291, 292, 198, 0, 377, 244,
+	266, 234, 343, 436, 437, 232, 478, 208, 458, 201,
+	0, 457, 336, 432, 440, 324, 314, 200, 438, 322,
+	313, 297, 255, 277, 371, 307, 372, 278, 332, 331,
+	333, 194, 450, 0, 195, 0, 411, 451, 479, 214,
+	215, 216, 0, 254, 258, 265, 267, 273, 274, 281,
+	300, 347, 370, 368, 374, 0, 427, 444, 454, 461,
+	467, 468, 470, 471, 472, 473, 474, 335, 280, 407,
+	296, 305, 0, 0, 353, 386, 219, 448, 408, 629,
+	621, 608, 610, 630, 631, 594, 606, 609, 632, 480,
+	481, 482, 483, 484, 485, 486, 487, 488, 489, 490,
+	491, 492, 493, 494, 495, 496, 497, 0, 624, 583,
+	582, 0, 590, 591, 0, 611, 612, 614, 615, 616,
+	617, 581, 187, 202, 301, 0, 375, 263, 476, 456,
+	0, 0, 589, 229, 599, 597, 603, 601, 604, 602,
+	605, 600, 598, 596, 595, 452, 0, 238, 0, 0,
+	0, 0, 0, 0, 326, 0, 0, 0, 189, 190,
+	203, 212, 222, 237, 252, 260, 271, 276, 279, 284,
+	285, 288, 293, 311, 317, 318, 319, 320, 337, 338,
+	339, 342, 345, 346, 349, 351, 352, 355, 362, 363,
+	364, 365, 367, 369, 376, 380, 388, 389, 390, 391,
+	392, 394, 395, 401, 402, 403, 404, 412, 416, 434,
+	435, 447, 460, 465, 272, 442, 466, 0, 310, 0,
+	0, 312, 256, 275, 286, 0, 455, 413, 207, 382,
+	264, 196, 226, 210, 235, 250, 253, 290, 321, 328,
+	357, 361, 269, 247, 223, 379, 220, 398, 419, 420,
+	421, 423, 325, 242, 360, 424, 0, 308, 425, 426,
+	282, 0, 0, 0, 0, 0, 0, 344, 0, 0,
+	0, 0, 580, 0, 0, 0, 246, 585, 0, 0,
+	0, 299, 243, 0, 0, 358, 0, 193, 0, 400,
+	230, 309, 306, 431, 257, 249, 0, 225, 245, 228,
+	283, 316, 356, 418, 350, 593, 303, 0, 0, 409,
+	329, 0, 0, 0, 0, 0, 587, 588, 0, 0,
+	0, 0, 0, 0, 0, 0, 289, 227, 192, 341,
+	410, 261, 0, 87, 0, 0, 184, 185, 186, 638,
+	645, 646, 647, 648, 649, 639, 641, 0, 0, 217,
+	640, 224, 613, 643, 650, 651, 0, 241, 287, 248,
+	240, 428, 0, 0, 0, 0, 0, 0, 397, 0,
+	0, 209, 0, 0, 0, 0, 0, 0, 0, 562,
+	577, 0, 592, 0, 0, 0, 251, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 574, 575, 0, 0, 0, 0, 623, 0, 576,
+	0, 0, 584, 652, 653, 654, 655, 656, 657, 658,
+	659, 660, 661, 662, 663, 664, 665, 666, 667, 668,
+	669, 670, 671, 672, 673, 674, 675, 676, 677, 678,
+	679, 680, 681, 682, 683, 684, 685, 686, 687, 688,
+	689, 690, 691, 692, 586, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 270, 0, 330, 233, 0, 622,
+	0, 0, 462, 0, 0, 620, 0, 0, 0, 0,
+	298, 0, 295, 188, 205, 0, 0, 340, 381, 387,
+	0, 0, 0, 231, 0, 385, 354, 446, 213, 259,
+	378, 359, 383, 366, 262, 0, 0, 384, 304, 433,
+	373, 443, 463, 464, 239, 334, 453, 422, 459, 475,
+	206, 236, 348, 415, 449, 406, 327, 429, 430, 294,
+	405, 268, 191, 302, 469, 204, 393, 221, 211, 197,
+	417, 441, 218, 396, 0, 0, 477, 199, 439, 414,
+	323, 291, 292, 198, 0, 377, 244, 266, 234, 343,
+	436, 437, 232, 478, 208, 458, 201, 0, 457, 336,
+	432, 440, 324, 314, 200, 438, 322, 313, 297, 255,
+	277, 371, 307, 372, 278, 332, 331, 333, 194, 450,
+	0, 195, 0, 411, 451, 479, 214, 215, 216, 0,
+	254, 258, 265, 267, 273, 274, 281, 300, 347, 370,
+	368, 374, 0, 427, 444, 454, 461, 467, 468, 470,
+	471, 472, 473, 474, 335, 280, 407, 296, 305, 0,
+	0, 353, 386, 219, 448, 408, 629, 621, 608, 610,
+	630, 631, 594, 606, 609, 632, 480, 481, 482, 483,
 	484, 485, 486, 487, 488, 489, 490, 491, 492, 493,
-	494, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 495, 313, 396, 442, 0, 187, 202, 299, 0,
-	373, 261, 473, 453, 449, 0, 0, 236, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 324,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	189, 190, 203, 212, 222, 235, 250, 258, 269, 274,
-	277, 282, 283, 286, 291, 309, 315, 316, 317, 318,
-	335, 336, 337, 340, 343, 344, 347, 349, 350, 353,
-	360, 361, 362, 363, 365, 367, 374, 378, 386, 387,
-	388, 389, 390, 392, 393, 398, 399, 400, 401, 409,
-	413, 431, 432, 444, 457, 462, 270, 439, 463, 0,
-	308, 0, 0, 310, 254, 273, 284, 0, 452, 410,
-	207, 380, 262, 196, 225, 210, 233, 248, 251, 288,
-	319, 326, 355, 359, 267, 245, 223, 377, 220, 395,
-	416, 417, 418, 420, 323, 240, 358, 421, 0, 306,
-	422, 423, 280, 0, 0, 0, 0, 0, 0, 342,
-	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
-	0, 0, 0, 297, 241, 0, 0, 356, 0, 193,
-	0, 397, 228, 307, 304, 428, 255, 247, 243, 227,
-	281, 314, 354, 415, 348, 0, 301, 0, 0, 406,
-	327, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 287, 226, 192, 339,
-	407, 259, 0, 0, 0, 0, 184, 185, 186, 0,
-	1402, 0, 0, 0, 0, 0, 0, 0, 0, 217,
-	0, 224, 0, 0, 0, 0, 0, 239, 285, 246,
-	238, 425, 0, 0, 0, 0, 0, 0, 0, 209,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 249, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 268, 0, 328, 231, 0, 0, 0, 0,
-	459, 0, 0, 0, 0, 0, 0, 0, 296, 0,
-	293, 188, 205, 0, 0, 338, 379, 385, 0, 0,
-	0, 229, 0, 383, 352, 443, 213, 257, 376, 357,
-	381, 364, 260, 0, 0, 382, 302, 430, 371, 440,
-	460, 461, 237, 332, 450, 419, 456, 472, 206, 234,
-	346, 412, 446, 403, 325, 426, 427, 292, 402, 266,
-	191, 300, 466, 204, 391, 221, 211, 197, 414, 438,
-	218, 394, 0, 0, 474, 199, 436, 411, 321, 289,
-	290, 198, 0, 375, 242, 264, 232, 341, 433, 434,
-	230, 475, 208, 455, 201, 0, 454, 334, 429, 437,
-	322, 312, 200, 435, 320, 311, 295, 253, 275, 369,
-	305, 370, 276, 330, 329, 331, 194, 447, 0, 195,
-	0, 408, 448, 476, 214, 215, 216, 0, 252, 256,
-	263, 265, 271, 272, 279, 298, 345, 368, 366, 372,
-	0, 424, 441, 451, 458, 464, 465, 467, 468, 469,
-	470, 471, 333, 278, 404, 294, 303, 0, 0, 351,
-	384, 219, 445, 405, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 477, 478, 479, 480, 481, 482,
+	494, 495, 496, 497, 0, 624, 583, 582, 0, 590,
+	591, 0, 611, 612, 614, 615, 616, 617, 581, 187,
+	202, 301, 0, 375, 263, 476, 456, 0, 0, 589,
+	229, 599, 597, 603, 601, 604, 602, 605, 600, 598,
+	596, 595, 452, 0, 238, 0, 0, 0, 0, 0,
+	0, 326, 0, 0, 0, 189, 190, 203, 212, 222,
+	237, 252, 260, 271, 276, 279, 284, 285, 288, 293,
+	311, 317, 318, 319, 320, 337, 338, 339, 342, 345,
+	346, 349, 351, 352, 355, 362, 363, 364, 365, 367,
+	369, 376, 380, 388, 389, 390, 391, 392, 394, 395,
+	401, 402, 403, 404, 412, 416, 434, 435, 447, 460,
+	465, 272, 442, 466, 0, 310, 0, 0, 312, 256,
+	275, 286, 0, 455, 413, 207, 382, 264, 196, 226,
+	210, 235, 250, 253, 290, 321, 328, 357, 361, 269,
+	247, 223, 379, 220, 398, 419, 420, 421, 423, 325,
+	242, 360, 424, 0, 308, 425, 426, 282, 0, 0,
+	0, 0, 0, 0, 344, 0, 0, 0, 0, 580,
+	0, 0, 0, 246, 585, 0, 0, 0, 299, 243,
+	0, 0, 358, 0, 193, 0, 400, 230, 309, 306,
+	431, 257, 249, 0, 225, 245, 228, 283, 316, 356,
+	418, 350, 593, 303, 0, 0, 409, 329, 0, 0,
+	0, 0, 0, 587, 588, 0, 0, 0, 0, 0,
+	0, 0, 0, 289, 227, 192, 341, 410, 261, 0,
+	87, 0, 0, 184, 185, 186, 638, 645, 646, 647,
+	648, 649, 639, 641, 0, 0, 217, 640, 224, 613,
+	643, 650, 651, 0, 241, 287, 248, 240, 428, 0,
+	0, 0, 0, 0, 0, 397, 0, 0, 209, 0,
+	0, 0, 0, 0, 0, 0, 0, 577, 0, 592,
+	0, 0, 0, 251, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 574, 575,
+	0, 0, 0, 0, 623, 0, 576, 0, 0, 584,
+	652, 653, 654, 655, 656, 657, 658, 659, 660, 661,
+	662, 663, 664, 665, 666, 667, 668, 669, 670, 671,
+	672, 673, 674, 675, 676, 677, 678, 679, 680, 681,
+	682, 683, 684, 685, 686, 687, 688, 689, 690, 691,
+	692, 586, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 270, 0, 330, 233, 0, 622, 0, 0, 462,
+	0, 0, 620, 0, 0, 0, 0, 298, 0, 295,
+	188, 205, 0, 0, 340, 381, 387, 0, 0, 0,
+	231, 0, 385, 354, 446, 213, 259, 378, 359, 383,
+	366, 262, 0, 0, 384, 304, 433, 373, 443, 463,
+	464, 239, 334, 453, 422, 459, 475, 206, 236, 348,
+	415, 449, 406, 327, 429, 430, 294, 405, 268, 191,
+	302, 469, 204, 393, 221, 211, 197, 417, 441, 218,
+	396, 0, 0, 477, 199, 439, 414, 323, 291, 292,
+	198, 0, 377, 244, 266, 234, 343, 436, 437, 232,
+	478, 208, 458, 201, 0, 457, 336, 432, 440, 324,
+	314, 200, 438, 322, 313, 297, 255, 277, 371, 307,
+	372, 278, 332, 331, 333, 194, 450, 0, 195, 0,
+	411, 451, 479, 214, 215, 216, 0, 254, 258, 265,
+	267, 273, 274, 281, 300, 347, 370, 368, 374, 0,
+	427, 444, 454, 461, 467, 468, 470, 471, 472, 473,
+	474, 335, 280, 407, 296, 305, 0, 0, 353, 386,
+	219, 448, 408, 629, 621, 608, 610, 630, 631, 594,
+	606, 609, 632, 480, 481, 482, 483, 484, 485, 486,
+	487, 488, 489, 490, 491, 492, 493, 494, 495, 496,
+	497, 0, 624, 583, 582, 0, 590, 591, 0, 611,
+	612, 614, 615, 616, 617, 581, 187, 202, 301, 0,
+	375, 263, 476, 456, 0, 0, 589, 229, 599, 597,
+	603, 601, 604, 602, 605, 600, 598, 596, 595, 452,
+	0, 238, 0, 0, 0, 0, 0, 0, 326, 0,
+	0, 0, 189, 190, 203, 212, 222, 237, 252, 260,
+	271, 276, 279, 284, 285, 288, 293, 311, 317, 318,
+	319, 320, 337, 338, 339, 342, 345, 346, 349, 351,
+	352, 355, 362, 363, 364, 365, 367, 369, 376, 380,
+	388, 389, 390, 391, 392, 394, 395, 401, 402, 403,
+	404, 412, 416, 434, 435, 447, 460, 465, 272, 442,
+	466, 0, 310, 0, 0, 312, 256, 275, 286, 0,
+	455, 413, 207, 382, 264, 196, 226, 210, 235, 250,
+	253, 290, 321, 328, 357, 361, 269, 247, 223, 379,
+	220, 398, 419, 420, 421, 423, 325, 242, 360, 424,
+	0, 308, 425, 426, 282, 0, 0, 0, 0, 0,
+	0, 344, 0, 0, 0, 0, 0, 0, 0, 0,
+	246, 0, 0, 0, 0, 299, 243, 0, 0, 358,
+	0, 193, 0, 400, 230, 309, 306, 431, 257, 249,
+	0, 225, 245, 228, 283, 316, 356, 418, 350, 0,
+	303, 0, 0, 409, 329, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	289, 227, 192, 341, 410, 261, 0, 0, 0, 0,
+	184, 185, 186, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 217, 0, 224, 0, 0, 0, 0,
+	0, 241, 287, 248, 240, 428, 0, 0, 0, 0,
+	0, 0, 397, 0, 0, 209, 0, 0, 0, 0,
+	1129, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	251, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1130, 1131, 1132, 1133, 1134, 1135, 1136, 1138,
+	1137, 1139, 1140, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 270, 0,
+	330, 233, 0, 0, 0, 0, 462, 0, 0, 0,
+	0, 0, 0, 0, 298, 0, 295, 188, 205, 0,
+	0, 340, 381, 387, 0, 0, 0, 231, 0, 385,
+	354, 446, 213, 259, 378, 359, 383, 366, 262, 0,
+	0, 384, 304, 433, 373, 443, 463, 464, 239, 334,
+	453, 422, 459, 475, 206, 236, 348, 415, 449, 406,
+	327, 429, 430, 294, 405, 268, 191, 302, 469, 204,
+	393, 221, 211, 197, 417, 441, 218, 396, 0, 0,
+	477, 199, 439, 414, 323, 291, 292, 198, 0, 377,
+	244, 266, 234, 343, 436, 437, 232, 478, 208, 458,
+	201, 0, 457, 336, 432, 440, 324, 314, 200, 438,
+	322, 313, 297, 255, 277, 371, 307, 372, 278, 332,
+	331, 333, 194, 450, 0, 195, 0, 411, 451, 479,
+	214, 215, 216, 0, 254, 258, 265, 267, 273, 274,
+	281, 300, 347, 370, 368, 374, 0, 427, 444, 454,
+	461, 467, 468, 470, 471, 472, 473, 474, 335, 280,
+	407, 296, 305, 0, 0, 353, 386, 219, 448, 408,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
+	490, 491, 492, 493, 494, 495, 496, 497, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 498, 315,
+	399, 445, 0, 187, 202, 301, 0, 375, 263, 476,
+	456, 0, 0, 0, 229, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 452, 0, 238, 0,
+	0, 0, 0, 0, 0, 326, 0, 0, 0, 189,
+	190, 203, 212, 222, 237, 252, 260, 271, 276, 279,
+	284, 285, 288, 293, 311, 317, 318, 319, 320, 337,
+	338, 339, 342, 345, 346, 349, 351, 352, 355, 362,
+	363, 364, 365, 367, 369, 376, 380, 388, 389, 390,
+	391, 392, 394, 395, 401, 402, 403, 404, 412, 416,
+	434, 435, 447, 460, 465, 272, 442, 466, 0, 310,
+	0, 0, 312, 256, 275, 286, 0, 455, 413, 207,
+	382, 264, 196, 226, 210, 235, 250, 253, 290, 321,
+	328, 357, 361, 269, 247, 223, 379, 220, 398, 419,
+	420, 421, 423, 325, 242, 360, 424, 0, 308, 425,
+	426, 282, 0, 0, 0, 0, 0, 0, 344, 0,
+	0, 0, 0, 0, 0, 0, 0, 246, 0, 0,
+	0, 0, 299, 243, 0, 0, 358, 0, 193, 0,
+	400, 230, 309, 306, 431, 257, 249, 0, 225, 245,
+	228, 283, 316, 356, 418, 350, 0, 303, 0, 0,
+	409, 329, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 289, 227, 192,
+	341, 410, 261, 0, 0, 0, 0, 184, 185, 186,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	217, 0, 224, 0, 0, 0, 0, 0, 241, 287,
+	248, 240, 428, 0, 0, 0, 0, 0, 0, 397,
+	0, 0, 209, 0, 947, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 251, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 270, 0, 330, 233, 0,
+	0, 0, 946, 462, 0, 0, 0, 0, 0, 943,
+	944, 298, 907, 295, 188, 205, 937, 941, 340, 381,
+	387, 0, 0, 0, 231, 0, 385, 354, 446, 213,
+	259, 378, 359, 383, 366, 262, 0, 0, 384, 304,
+	433, 373, 443, 463, 464, 239, 334, 453, 422, 459,
+	475, 206, 236, 348, 415, 449, 406, 327, 429, 430,
+	294, 405, 268, 191, 302, 469, 204, 393, 221, 211,
+	197, 417, 441, 218, 396, 0, 0, 477, 199, 439,
+	414, 323, 291, 292, 198, 0, 377, 244, 266, 234,
+	343, 436, 437, 232, 478, 208, 458, 201, 0, 457,
+	336, 432, 440, 324, 314, 200, 438, 322, 313, 297,
+	255, 277, 371, 307, 372, 278, 332, 331, 333, 194,
+	450, 0, 195, 0, 411, 451, 479, 214, 215, 216,
+	0, 254, 258, 265, 267, 273, 274, 281, 300, 347,
+	370, 368, 374, 0, 427, 444, 454, 461, 467, 468,
+	470, 471, 472, 473, 474, 335, 280, 407, 296, 305,
+	0, 0, 353, 386, 219, 448, 408, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 480, 481, 482,
 	483, 484, 485, 486, 487, 488, 489, 490, 491, 492,
-	493, 494, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 495, 313, 396, 442, 0, 187, 202, 299,
-	0, 373, 261, 473, 453, 449, 0, 0, 236, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	324, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 189, 190, 203, 212, 222, 235, 250, 258, 269,
-	274, 277, 282, 283, 286, 291, 309, 315, 316, 317,
-	318, 335, 336, 337, 340, 343, 344, 347, 349, 350,
-	353, 360, 361, 362, 363, 365, 367, 374, 378, 386,
-	387, 388, 389, 390, 392, 393, 398, 399, 400, 401,
-	409, 413, 431, 432, 444, 457, 462, 270, 439, 463,
-	0, 308, 0, 0, 310, 254, 273, 284, 0, 452,
-	410, 207, 380, 262, 196, 225, 210, 233, 248, 251,
-	288, 319, 326, 355, 359, 267, 245, 223, 377, 220,
-	395, 416, 417, 418, 420, 323, 240, 358, 0, 421,
-	306, 422, 423, 280, 1663, 0, 0, 0, 0, 0,
-	0, 342, 0, 0, 0, 0, 0, 0, 0, 0,
-	244, 0, 0, 0, 0, 297, 241, 0, 0, 356,
-	0, 193, 0, 397, 228, 307, 304, 428, 255, 247,
-	243, 227, 281, 314, 354, 415, 348, 0, 301, 0,
-	0, 406, 327, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 287, 226,
-	192, 339, 407, 259, 0, 0, 0, 0, 184, 185,
-	186, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 217, 0, 224, 0, 0, 0, 0, 0, 239,
-	285, 246, 238, 425, 0, 0, 0, 0, 0, 0,
-	0, 209, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 249, 0, 0, 0,
+	493, 494, 495, 496, 497, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 498, 315, 399, 445, 0,
+	187, 202, 301, 0, 375, 263, 476, 456, 0, 0,
+	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 452, 0, 238, 0, 0, 0, 0,
+	0, 0, 326, 0, 0, 0, 189, 190, 203, 212,
+	222, 237, 252, 260, 271, 276, 279, 284, 285, 288,
+	293, 311, 317, 318, 319, 320, 337, 338, 339, 342,
+	345, 346, 349, 351, 352, 355, 362, 363, 364, 365,
+	367, 369, 376, 380, 388, 389, 390, 391, 392, 394,
+	395, 401, 402, 403, 404, 412, 416, 434, 435, 447,
+	460, 465, 272, 442, 466, 0, 310, 0, 0, 312,
+	256, 275, 286, 0, 455, 413, 207, 382, 264, 196,
+	226, 210, 235, 250, 253, 290, 321, 328, 357, 361,
+	269, 247, 223, 379, 220, 398, 419, 420, 421, 423,
+	325, 242, 360, 424, 0, 308, 425, 426, 282, 0,
+	0, 0, 0, 0, 0, 344, 0, 0, 0, 1263,
+	0, 0, 0, 0, 246, 0, 0, 0, 0, 299,
+	243, 0, 0, 358, 0, 193, 0, 400, 230, 309,
+	306, 431, 257, 249, 0, 225, 245, 228, 283, 316,
+	356, 418, 350, 0, 303, 0, 0, 409, 329, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 289, 227, 192, 341, 410, 261,
+	0, 0, 0, 0, 184, 185, 186, 0, 1265, 0,
+	0, 0, 0, 0, 0, 0, 0, 217, 0, 224,
+	0, 0, 0, 0, 0, 241, 287, 248, 240, 428,
+	0, 0, 0, 0, 0, 0, 397, 0, 0, 209,
+	0, 0, 0, 1111, 0, 1112, 1113, 0, 0, 0,
+	0, 0, 0, 0, 251, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 270, 0, 330, 233, 0, 0, 0, 0,
+	462, 0, 0, 0, 0, 0, 0, 0, 298, 0,
+	295, 188, 205, 0, 0, 340, 381, 387, 0, 0,
+	0, 231, 0, 385, 354, 446, 213, 259, 378, 359,
+	383, 366, 262, 0, 0, 384, 304, 433, 373, 443,
+	463, 464, 239, 334, 453, 422, 459, 475, 206, 236,
+	348, 415, 449, 406, 327, 429, 430, 294, 405, 268,
+	191, 302, 469, 204, 393, 221, 211, 197, 417, 441,
+	218, 396, 0, 0, 477, 199, 439, 414, 323, 291,
+	292, 198, 0, 377, 244, 266, 234, 343, 436, 437,
+	232, 478, 208, 458, 201, 0, 457, 336, 432, 440,
+	324, 314, 200, 438, 322, 313, 297, 255, 277, 371,
+	307, 372, 278, 332, 331, 333, 194, 450, 0, 195,
+	0, 411, 451, 479, 214, 215, 216, 0, 254, 258,
+	265, 267, 273, 274, 281, 300, 347, 370, 368, 374,
+	0, 427, 444, 454, 461, 467, 468, 470, 471, 472,
+	473, 474, 335, 280, 407, 296, 305, 0, 0, 353,
+	386, 219, 448, 408, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 480, 481, 482, 483, 484, 485,
+	486, 487, 488, 489, 490, 491, 492, 493, 494, 495,
+	496, 497, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 498, 315, 399, 445, 0, 187, 202, 301,
+	0, 375, 263, 476, 456, 0, 0, 0, 229, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	452, 0, 238, 0, 0, 0, 0, 0, 0, 326,
+	0, 0, 0, 189, 190, 203, 212, 222, 237, 252,
+	260, 271, 276, 279, 284, 285, 288, 293, 311, 317,
+	318, 319, 320, 337, 338, 339, 342, 345, 346, 349,
+	351, 352, 355, 362, 363, 364, 365, 367, 369, 376,
+	380, 388, 389, 390, 391, 392, 394, 395, 401, 402,
+	403, 404, 412, 416, 434, 435, 447, 460, 465, 272,
+	442, 466, 0, 310, 0, 0, 312, 256, 275, 286,
+	0, 455, 413, 207, 382, 264, 196, 226, 210, 235,
+	250, 253, 290, 321, 328, 357, 361, 269, 247, 223,
+	379, 220, 398, 419, 420, 421, 423, 325, 242, 360,
+	424, 0, 308, 425, 426, 282, 0, 0, 0, 0,
+	0, 0, 344, 0, 0, 0, 0, 0, 0, 0,
+	0, 246, 0, 0, 0, 0, 299, 243, 0, 0,
+	358, 0, 193, 0, 400, 230, 309, 306, 431, 257,
+	249, 0, 225, 245, 228, 283, 316, 356, 418, 350,
+	0, 303, 0, 0, 409, 329, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 289, 227, 192, 341, 410, 261, 0, 0, 0,
+	0, 184, 185, 186, 1202, 1205, 0, 0, 0, 0,
+	1201, 1204, 0, 0, 217, 1200, 224, 0, 0, 0,
+	0, 0, 241, 287, 248, 240, 428, 0, 0, 0,
+	0, 0, 0, 397, 0, 0, 209, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 251, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 270,
+	0, 330, 233, 0, 0, 0, 0, 462, 0, 0,
+	0, 0, 0, 0, 0, 298, 0, 295, 188, 205,
+	0, 0, 340, 381, 387, 0, 0, 0, 231, 0,
+	385, 354, 446, 213, 259, 378, 359, 383, 366, 262,
+	0, 0, 384, 304, 433, 373, 443, 463, 464, 239,
+	334, 453, 422, 459, 475, 206, 236, 348, 415, 449,
+	406, 327, 429, 430, 294, 405, 268, 191, 302, 469,
+	204, 393, 221, 211, 197, 417, 441, 218, 396, 0,
+	0, 477, 199, 439, 414, 323, 291, 292, 198, 0,
+	377, 244, 266, 234, 343, 436, 437, 232, 478, 208,
+	458, 201, 0, 457, 336, 432, 440, 324, 314, 200,
+	438, 322, 313, 297, 255, 277, 371, 307, 372, 278,
+	332, 331, 333, 194, 450, 0, 195, 0, 411, 451,
+	479, 214, 215, 216, 0, 254, 258, 265, 267, 273,
+	274, 281, 300, 347, 370, 368, 374, 0, 427, 444,
+	454, 461, 467, 468, 470, 471, 472, 473, 474, 335,
+	280, 407, 296, 305, 0, 0, 353, 386, 219, 448,
+	408, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 480, 481, 482, 483, 484, 485, 486, 487, 488,
+	489, 490, 491, 492, 493, 494, 495, 496, 497, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 498,
+	315, 399, 445, 0, 187, 202, 301, 0, 375, 263,
+	476, 456, 0, 0, 0, 229, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 452, 0, 238,
+	0, 0, 0, 0, 0, 0, 326, 0, 0, 0,
+	189, 190, 203, 212, 222, 237, 252, 260, 271, 276,
+	279, 284, 285, 288, 293, 311, 317, 318, 319, 320,
+	337, 338, 339, 342, 345, 346, 349, 351, 352, 355,
+	362, 363, 364, 365, 367, 369, 376, 380, 388, 389,
+	390, 391, 392, 394, 395, 401, 402, 403, 404, 412,
+	416, 434, 435, 447, 460, 465, 272, 442, 466, 0,
+	310, 0, 0, 312, 256, 275, 286, 0, 455, 413,
+	207, 382, 264, 196, 226, 210, 235, 250, 253, 290,
+	321, 328, 357, 361, 269, 247, 223, 379, 220, 398,
+	419, 420, 421, 423, 325, 242, 360, 78, 424, 308,
+	425, 426, 282, 0, 0, 0, 0, 0, 0, 0,
+	344, 0, 0, 0, 0, 0, 0, 0, 0, 246,
+	0, 0, 0, 0, 299, 243, 0, 0, 358, 0,
+	193, 0, 400, 230, 309, 306, 431, 257, 249, 0,
+	225, 245, 228, 283, 316, 356, 418, 350, 0, 303,
+	0, 0, 409, 329, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 289,
+	227, 192, 341, 410, 261, 0, 87, 0, 1243, 184,
+	185, 186, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 217, 0, 224, 0, 0, 0, 0, 0,
+	241, 287, 248, 240, 428, 0, 0, 0, 0, 0,
+	0, 397, 0, 0, 209, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 251,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -3768,54 +3281,55 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 268, 0, 328, 231, 0, 0,
-	0, 0, 459, 0, 0, 0, 0, 0, 0, 0,
-	296, 0, 293, 188, 205, 0, 0, 338, 379, 385,
-	0, 0, 0, 229, 0, 383, 352, 443, 213, 257,
-	376, 357, 381, 364, 260, 0, 0, 382, 302, 430,
-	371, 440, 460, 461, 237, 332, 450, 419, 456, 472,
-	206, 234, 346, 412, 446, 403, 325, 426, 427, 292,
-	402, 266, 191, 300, 466, 204, 391, 221, 211, 197,
-	414, 438, 218, 394, 0, 0, 474, 199, 436, 411,
-	321, 289, 290, 198, 0, 375, 242, 264, 232, 341,
-	433, 434, 230, 475, 208, 455, 201, 0, 454, 334,
-	429, 437, 322, 312, 200, 435, 320, 311, 295, 253,
-	275, 369, 305, 370, 276, 330, 329, 331, 194, 447,
-	0, 195, 0, 408, 448, 476, 214, 215, 216, 0,
-	252, 256, 263, 265, 271, 272, 279, 298, 345, 368,
-	366, 372, 0, 424, 441, 451, 458, 464, 465, 467,
-	468, 469, 470, 471, 333, 278, 404, 294, 303, 0,
-	0, 351, 384, 219, 445, 405, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 477, 478, 479, 480,
+	0, 0, 0, 0, 0, 0, 0, 270, 0, 330,
+	233, 0, 0, 0, 0, 462, 0, 0, 0, 0,
+	0, 0, 0, 298, 0, 295, 188, 205, 0, 0,
+	340, 381, 387, 0, 0, 0, 231, 0, 385, 354,
+	446, 213, 259, 378, 359, 383, 366, 262, 0, 0,
+	384, 304, 433, 373, 443, 463, 464, 239, 334, 453,
+	422, 459, 475, 206, 236, 348, 415, 449, 406, 327,
+	429, 430, 294, 405, 268, 191, 302, 469, 204, 393,
+	221, 211, 197, 417, 441, 218, 396, 0, 0, 477,
+	199, 439, 414, 323, 291, 292, 198, 0, 377, 244,
+	266, 234, 343, 436, 437, 232, 478, 208, 458, 201,
+	0, 457, 336, 432, 440, 324, 314, 200, 438, 322,
+	313, 297, 255, 277, 371, 307, 372, 278, 332, 331,
+	333, 194, 450, 0, 195, 0, 411, 451, 479, 214,
+	215, 216, 0, 254, 258, 265, 267, 273, 274, 281,
+	300, 347, 370, 368, 374, 0, 427, 444, 454, 461,
+	467, 468, 470, 471, 472, 473, 474, 335, 280, 407,
+	296, 305, 0, 0, 353, 386, 219, 448, 408, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 480,
 	481, 482, 483, 484, 485, 486, 487, 488, 489, 490,
-	491, 492, 493, 494, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 495, 313, 396, 442, 0, 187,
-	202, 299, 0, 373, 261, 473, 453, 449, 0, 0,
-	236, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 324, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 189, 190, 203, 212, 222, 235, 250,
-	258, 269, 274, 277, 282, 283, 286, 291, 309, 315,
-	316, 317, 318, 335, 336, 337, 340, 343, 344, 347,
-	349, 350, 353, 360, 361, 362, 363, 365, 367, 374,
-	378, 386, 387, 388, 389, 390, 392, 393, 398, 399,
-	400, 401, 409, 413, 431, 432, 444, 457, 462, 270,
-	439, 463, 0, 308, 0, 0, 310, 254, 273, 284,
-	0, 452, 410, 207, 380, 262, 196, 225, 210, 233,
-	248, 251, 288, 319, 326, 355, 359, 267, 245, 223,
-	377, 220, 395, 416, 417, 418, 420, 323, 240, 358,
-	421, 0, 306, 422, 423, 280, 0, 0, 0, 0,
-	0, 0, 342, 0, 0, 0, 0, 0, 0, 0,
-	0, 244, 0, 0, 0, 0, 297, 241, 0, 0,
-	356, 0, 193, 0, 397, 228, 307, 304, 428, 255,
-	247, 243, 227, 281, 314, 354, 415, 348, 0, 301,
-	0, 0, 406, 327, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 287,
-	226, 192, 339, 407, 259, 0, 0, 0, 0, 184,
-	185, 186, 0, 1232, 0, 0, 0, 0, 0, 0,
-	0, 0, 217, 0, 224, 0, 0, 0, 0, 0,
-	239, 285, 246, 238, 425, 0, 0, 0, 0, 0,
+	491, 492, 493, 494, 495, 496, 497, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 498, 315, 399,
+	445, 0, 187, 202, 301, 86, 375, 263, 476, 456,
+	0, 0, 0, 229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 452, 0, 238, 0, 0,
+	0, 0, 0, 0, 326, 0, 0, 0, 189, 190,
+	203, 212, 222, 237, 252, 260, 271, 276, 279, 284,
+	285, 288, 293, 311, 317, 318, 319, 320, 337, 338,
+	339, 342, 345, 346, 349, 351, 352, 355, 362, 363,
+	364, 365, 367, 369, 376, 380, 388, 389, 390, 391,
+	392, 394, 395, 401, 402, 403, 404, 412, 416, 434,
+	435, 447, 460, 465, 272, 442, 466, 0, 310, 0,
+	0, 312, 256, 275, 286, 0, 455, 413, 207, 382,
+	264, 196, 226, 210, 235, 250, 253, 290, 321, 328,
+	357, 361, 269, 247, 223, 379, 220, 398, 419, 420,
+	421, 423, 325, 242, 360, 78, 424, 308, 425, 426,
+	282, 0, 0, 0, 0, 0, 0, 0, 344, 0,
+	0, 0, 0, 0, 0, 0, 0, 246, 0, 0,
+	0, 0, 299, 243, 0, 0, 358, 0, 193, 0,
+	400, 230, 309, 306, 431, 257, 249, 0, 225, 245,
+	228, 283, 316, 356, 418, 350, 0, 303, 0, 0,
+	409, 329, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 289, 227, 192,
+	341, 410, 261, 0, 87, 0, 0, 184, 185, 186,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	217, 0, 224, 0, 0, 0, 0, 0, 241, 287,
+	248, 240, 428, 0, 0, 0, 0, 0, 0, 397,
 	0, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 249, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 251, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -3823,275 +3337,222 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 268, 0, 328, 231, 0,
-	0, 0, 0, 459, 0, 0, 0, 0, 0, 0,
-	0, 296, 0, 293, 188, 205, 0, 0, 338, 379,
-	385, 0, 0, 0, 229, 0, 383, 352, 443, 213,
-	257, 376, 357, 381, 364, 260, 0, 0, 382, 302,
-	430, 371, 440, 460, 461, 237, 332, 450, 419, 456,
-	472, 206, 234, 346, 412, 446, 403, 325, 426, 427,
-	292, 402, 266, 191, 300, 466, 204, 391, 221, 211,
-	197, 414, 438, 218, 394, 0, 0, 474, 199, 436,
-	411, 321, 289, 290, 198, 0, 375, 242, 264, 232,
-	341, 433, 434, 230, 475, 208, 455, 201, 0, 454,
-	334, 429, 437, 322, 312, 200, 435, 320, 311, 295,
-	253, 275, 369, 305, 370, 276, 330, 329, 331, 194,
-	447, 0, 195, 0, 408, 448, 476, 214, 215, 216,
-	0, 252, 256, 263, 265, 271, 272, 279, 298, 345,
-	368, 366, 372, 0, 424, 441, 451, 458, 464, 465,
-	467, 468, 469, 470, 471, 333, 278, 404, 294, 303,
-	0, 0, 351, 384, 219, 445, 405, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 477, 478, 479,
-	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
-	490, 491, 492, 493, 494, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 495, 313, 396, 442, 0,
-	187, 202, 299, 0, 373, 261, 473, 453, 449, 0,
-	0, 236, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 324, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 189, 190, 203, 212, 222, 235,
-	250, 258, 269, 274, 277, 282, 283, 286, 291, 309,
-	315, 316, 317, 318, 335, 336, 337, 340, 343, 344,
-	347, 349, 350, 353, 360, 361, 362, 363, 365, 367,
-	374, 378, 386, 387, 388, 389, 390, 392, 393, 398,
-	399, 400, 401, 409, 413, 431, 432, 444, 457, 462,
-	270, 439, 463, 0, 308, 0, 0, 310, 254, 273,
-	284, 0, 452, 410, 207, 380, 262, 196, 225, 210,
-	233, 248, 251, 288, 319, 326, 355, 359, 267, 245,
-	223, 377, 220, 395, 416, 417, 418, 420, 323, 240,
-	358, 421, 0, 306, 422, 423, 280, 0, 0, 0,
-	0, 0, 0, 342, 0, 0, 0, 0, 0, 0,
-	0, 0, 244, 0, 0, 0, 0, 297, 241, 0,
-	0, 356, 0, 193, 0, 397, 228, 307, 304, 428,
-	255, 247, 243, 227, 281, 314, 354, 415, 348, 0,
-	301, 0, 0, 406, 327, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	287, 226, 192, 339, 407, 259, 0, 0, 0, 0,
-	184, 185, 186, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 217, 0, 224, 0, 0, 0, 0,
-	0, 239, 285, 246, 238, 425, 0, 0, 0, 0,
-	0, 0, 0, 209, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 249, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1123, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 268, 0, 328, 231,
-	0, 0, 0, 0, 459, 0, 0, 0, 0, 0,
-	0, 0, 296, 0, 293, 188, 205, 0, 0, 338,
-	379, 385, 0, 0, 0, 229, 0, 383, 352, 443,
-	213, 257, 376, 357, 381, 364, 260, 0, 0, 382,
-	302, 430, 371, 440, 460, 461, 237, 332, 450, 419,
-	456, 472, 206, 234, 346, 412, 446, 403, 325, 426,
-	427, 292, 402, 266, 191, 300, 466, 204, 391, 221,
-	211, 197, 414, 438, 218, 394, 0, 0, 474, 199,
-	436, 411, 321, 289, 290, 198, 0, 375, 242, 264,
-	232, 341, 433, 434, 230, 475, 208, 455, 201, 0,
-	454, 334, 429, 437, 322, 312, 200, 435, 320, 311,
-	295, 253, 275, 369, 305, 370, 276, 330, 329, 331,
-	194, 447, 0, 195, 0, 408, 448, 476, 214, 215,
-	216, 0, 252, 256, 263, 265, 271, 272, 279, 298,
-	345, 368, 366, 372, 0, 424, 441, 451, 458, 464,
-	465, 467, 468, 469, 470, 471, 333, 278, 404, 294,
-	303, 0, 0, 351, 384, 219, 445, 405, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 477, 478,
-	479, 480, 481, 482, 483, 484, 485, 486, 487, 488,
-	489, 490, 491, 492, 493, 494, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 495, 313, 396, 442,
-	0, 187, 202, 299, 0, 373, 261, 473, 453, 449,
-	0, 0, 236, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 324, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 189, 190, 203, 212, 222,
-	235, 250, 258, 269, 274, 277, 282, 283, 286, 291,
-	309, 315, 316, 317, 318, 335, 336, 337, 340, 343,
-	344, 347, 349, 350, 353, 360, 361, 362, 363, 365,
-	367, 374, 378, 386, 387, 388, 389, 390, 392, 393,
-	398, 399, 400, 401, 409, 413, 431, 432, 444, 457,
-	462, 270, 439, 463, 0, 308, 0, 0, 310, 254,
-	273, 284, 0, 452, 410, 207, 380, 262, 196, 225,
-	210, 233, 248, 251, 288, 319, 326, 355, 359, 267,
-	245, 223, 377, 220, 395, 416, 417, 418, 420, 323,
-	240, 358, 421, 0, 306, 422, 423, 280, 0, 0,
-	0, 0, 0, 0, 342, 0, 0, 0, 0, 0,
-	0, 0, 0, 244, 0, 0, 0, 0, 297, 241,
-	0, 0, 356, 0, 193, 0, 397, 228, 307, 304,
-	428, 255, 247, 243, 227, 281, 314, 354, 415, 348,
-	0, 301, 0, 0, 406, 327, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 287, 226, 192, 339, 407, 259, 0, 0, 0,
+	0, 0, 0, 0, 0, 270, 0, 330, 233, 0,
+	0, 0, 0, 462, 0, 0, 0, 0, 0, 0,
+	0, 298, 0, 295, 188, 205, 0, 0, 340, 381,
+	387, 0, 0, 0, 231, 0, 385, 354, 446, 213,
+	259, 378, 359, 383, 366, 262, 0, 0, 384, 304,
+	433, 373, 443, 463, 464, 239, 334, 453, 422, 459,
+	475, 206, 236, 348, 415, 449, 406, 327, 429, 430,
+	294, 405, 268, 191, 302, 469, 204, 393, 221, 211,
+	197, 417, 441, 218, 396, 0, 0, 477, 199, 439,
+	414, 323, 291, 292, 198, 0, 377, 244, 266, 234,
+	343, 436, 437, 232, 478, 208, 458, 201, 0, 457,
+	336, 432, 440, 324, 314, 200, 438, 322, 313, 297,
+	255, 277, 371, 307, 372, 278, 332, 331, 333, 194,
+	450, 0, 195, 0, 411, 451, 479, 214, 215, 216,
+	0, 254, 258, 265, 267, 273, 274, 281, 300, 347,
+	370, 368, 374, 0, 427, 444, 454, 461, 467, 468,
+	470, 471, 472, 473, 474, 335, 280, 407, 296, 305,
+	0, 0, 353, 386, 219, 448, 408, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 480, 481, 482,
+	483, 484, 485, 486, 487, 488, 489, 490, 491, 492,
+	493, 494, 495, 496, 497, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 498, 315, 399, 445, 0,
+	187, 202, 301, 86, 375, 263, 476, 456, 0, 0,
+	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 452, 0, 238, 0, 0, 0, 0,
+	0, 0, 326, 0, 0, 0, 189, 190, 203, 212,
+	222, 237, 252, 260, 271, 276, 279, 284, 285, 288,
+	293, 311, 317, 318, 319, 320, 337, 338, 339, 342,
+	345, 346, 349, 351, 352, 355, 362, 363, 364, 365,
+	367, 369, 376, 380, 388, 389, 390, 391, 392, 394,
+	395, 401, 402, 403, 404, 412, 416, 434, 435, 447,
+	460, 465, 272, 442, 466, 0, 310, 0, 0, 312,
+	256, 275, 286, 0, 455, 413, 207, 382, 264, 196,
+	226, 210, 235, 250, 253, 290, 321, 328, 357, 361,
+	269, 247, 223, 379, 220, 398, 419, 420, 421, 423,
+	325, 242, 360, 424, 0, 308, 425, 426, 282, 0,
+	0, 0, 0, 0, 0, 344, 0, 0, 0, 1664,
+	0, 0, 0, 0, 246, 0, 0, 0, 0, 299,
+	243, 0, 0, 358, 0, 193, 0, 400, 230, 309,
+	306, 431, 257, 249, 0, 225, 245, 228, 283, 316,
+	356, 418, 350, 0, 303, 0, 0, 409, 329, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 289, 227, 192, 341, 410, 261,
+	0, 0, 0, 0, 184, 185, 186, 0, 1435, 0,
+	0, 0, 0, 0, 0, 0, 0, 217, 0, 224,
+	0, 0, 0, 0, 0, 241, 287, 248, 240, 428,
+	0, 0, 0, 0, 0, 0, 397, 0, 0, 209,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 251, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 270, 0, 330, 233, 0, 0, 0, 0,
+	462, 0, 0, 0, 0, 0, 0, 0, 298, 0,
+	295, 188, 205, 0, 0, 340, 381, 387, 0, 0,
+	0, 231, 0, 385, 354, 446, 213, 259, 378, 359,
+	383, 366, 262, 0, 1662, 384, 304, 433, 373, 443,
+	463, 464, 239, 334, 453, 422, 459, 475, 206, 236,
+	348, 415, 449, 406, 327, 429, 430, 294, 405, 268,
+	191, 302, 469, 204, 393, 221, 211, 197, 417, 441,
+	218, 396, 0, 0, 477, 199, 439, 414, 323, 291,
+	292, 198, 0, 377, 244, 266, 234, 343, 436, 437,
+	232, 478, 208, 458, 201, 0, 457, 336, 432, 440,
+	324, 314, 200, 438, 322, 313, 297, 255, 277, 371,
+	307, 372, 278, 332, 331, 333, 194, 450, 0, 195,
+	0, 411, 451, 479, 214, 215, 216, 0, 254, 258,
+	265, 267, 273, 274, 281, 300, 347, 370, 368, 374,
+	0, 427, 444, 454, 461, 467, 468, 470, 471, 472,
+	473, 474, 335, 280, 407, 296, 305, 0, 0, 353,
+	386, 219, 448, 408, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 480, 481, 482, 483, 484, 485,
+	486, 487, 488, 489, 490, 491, 492, 493, 494, 495,
+	496, 497, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 498, 315, 399, 445, 0, 187, 202, 301,
+	0, 375, 263, 476, 456, 0, 0, 0, 229, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	452, 0, 238, 0, 0, 0, 0, 0, 0, 326,
+	0, 0, 0, 189, 190, 203, 212, 222, 237, 252,
+	260, 271, 276, 279, 284, 285, 288, 293, 311, 317,
+	318, 319, 320, 337, 338, 339, 342, 345, 346, 349,
+	351, 352, 355, 362, 363, 364, 365, 367, 369, 376,
+	380, 388, 389, 390, 391, 392, 394, 395, 401, 402,
+	403, 404, 412, 416, 434, 435, 447, 460, 465, 272,
+	442, 466, 0, 310, 0, 0, 312, 256, 275, 286,
+	0, 455, 413, 207, 382, 264, 196, 226, 210, 235,
+	250, 253, 290, 321, 328, 357, 361, 269, 247, 223,
+	379, 220, 398, 419, 420, 421, 423, 325, 242, 360,
+	424, 0, 308, 425, 426, 282, 0, 0, 0, 0,
+	0, 0, 344, 0, 0, 0, 0, 0, 0, 0,
+	0, 246, 0, 0, 0, 0, 299, 243, 0, 0,
+	358, 0, 193, 0, 400, 230, 309, 306, 431, 257,
+	249, 0, 225, 245, 228, 283, 316, 356, 418, 350,
+	0, 303, 0, 0, 409, 329, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 289, 227, 192, 341, 410, 261, 0, 0, 0,
 	0, 184, 185, 186, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 217, 0, 224, 0, 0, 0,
-	0, 0, 239, 285, 246, 238, 425, 0, 0, 0,
-	0, 0, 0, 0, 209, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 249,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 268, 0, 328,
-	231, 0, 0, 0, 0, 459, 0, 0, 0, 0,
-	0, 0, 0, 296, 0, 293, 188, 205, 0, 0,
-	338, 379, 385, 0, 0, 0, 229, 0, 383, 352,
-	443, 213, 257, 376, 357, 381, 364, 260, 0, 0,
-	382, 302, 430, 371, 440, 460, 461, 237, 332, 450,
-	419, 456, 472, 206, 234, 346, 412, 446, 403, 325,
-	426, 427, 292, 402, 266, 191, 300, 466, 204, 391,
-	221, 211, 197, 414, 438, 218, 394, 0, 0, 474,
-	199, 436, 411, 321, 289, 290, 198, 0, 375, 242,
-	264, 232, 341, 433, 434, 230, 475, 208, 455, 201,
-	0, 454, 334, 429, 437, 322, 312, 200, 435, 320,
-	311, 295, 253, 275, 369, 305, 370, 276, 330, 329,
-	331, 194, 447, 0, 195, 0, 408, 448, 476, 214,
-	215, 216, 0, 252, 256, 263, 265, 271, 272, 279,
-	298, 345, 368, 366, 372, 0, 424, 441, 451, 458,
-	464, 465, 467, 468, 469, 470, 471, 333, 278, 404,
-	294, 303, 0, 0, 351, 384, 219, 445, 405, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 477,
-	478, 479, 480, 481, 482, 483, 484, 485, 486, 487,
-	488, 489, 490, 491, 492, 493, 494, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 495, 313, 396,
-	442, 0, 187, 202, 299, 1494, 373, 261, 473, 453,
-	449, 0, 0, 236, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 324, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 189, 190, 203, 212,
-	222, 235, 250, 258, 269, 274, 277, 282, 283, 286,
-	291, 309, 315, 316, 317, 318, 335, 336, 337, 340,
-	343, 344, 347, 349, 350, 353, 360, 361, 362, 363,
-	365, 367, 374, 378, 386, 387, 388, 389, 390, 392,
-	393, 398, 399, 400, 401, 409, 413, 431, 432, 444,
-	457, 462, 270, 439, 463, 0, 308, 0, 0, 310,
-	254, 273, 284, 0, 452, 410, 207, 380, 262, 196,
-	225, 210, 233, 248, 251, 288, 319, 326, 355, 359,
-	267, 245, 223, 377, 220, 395, 416, 417, 418, 420,
-	323, 240, 358, 421, 0, 306, 422, 423, 280, 0,
-	0, 0, 0, 0, 0, 342, 0, 1374, 0, 0,
-	0, 0, 0, 0, 244, 0, 0, 0, 0, 297,
-	241, 0, 0, 356, 0, 193, 0, 397, 228, 307,
-	304, 428, 255, 247, 243, 227, 281, 314, 354, 415,
-	348, 0, 301, 0, 0, 406, 327, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 287, 226, 192, 339, 407, 259, 0, 0,
-	0, 0, 184, 185, 186, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 217, 0, 224, 0, 0,
-	0, 0, 0, 239, 285, 246, 238, 425, 0, 0,
-	0, 0, 0, 0, 0, 209, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	249, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 268, 0,
-	328, 231, 0, 0, 0, 0, 459, 0, 0, 0,
-	0, 0, 0, 0, 296, 0, 293, 188, 205, 0,
-	0, 338, 379, 385, 0, 0, 0, 229, 0, 383,
-	352, 443, 213, 257, 376, 357, 381, 364, 260, 0,
-	0, 382, 302, 430, 371, 440, 460, 461, 237, 332,
-	450, 419, 456, 472, 206, 234, 346, 412, 446, 403,
-	325, 426, 427, 292, 402, 266, 191, 300, 466, 204,
-	391, 221, 211, 197, 414, 438, 218, 394, 0, 0,
-	474, 199, 436, 411, 321, 289, 290, 198, 0, 375,
-	242, 264, 232, 341, 433, 434, 230, 475, 208, 455,
-	201, 0, 454, 334, 429, 437, 322, 312, 200, 435,
-	320, 311, 295, 253, 275, 369, 305, 370, 276, 330,
-	329, 331, 194, 447, 0, 195, 0, 408, 448, 476,
-	214, 215, 216, 0, 252, 256, 263, 265, 271, 272,
-	279, 298, 345, 368, 366, 372, 0, 424, 441, 451,
-	458, 464, 465, 467, 468, 469, 470, 471, 333, 278,
-	404, 294, 303, 0, 0, 351, 384, 219, 445, 405,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	477, 478, 479, 480, 481, 482, 483, 484, 485, 486,
-	487, 488, 489, 490, 491, 492, 493, 494, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 495, 313,
-	396, 442, 0, 187, 202, 299, 0, 373, 261, 473,
-	453, 449, 0, 0, 236, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 324, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 189, 190, 203,
-	212, 222, 235, 250, 258, 269, 274, 277, 282, 283,
-	286, 291, 309, 315, 316, 317, 318, 335, 336, 337,
-	340, 343, 344, 347, 349, 350, 353, 360, 361, 362,
-	363, 365, 367, 374, 378, 386, 387, 388, 389, 390,
-	392, 393, 398, 399, 400, 401, 409, 413, 431, 432,
-	444, 457, 462, 270, 439, 463, 0, 308, 0, 0,
-	310, 254, 273, 284, 0, 452, 410, 207, 380, 262,
-	196, 225, 210, 233, 248, 251, 288, 319, 326, 355,
-	359, 267, 245, 223, 377, 220, 395, 416, 417, 418,
-	420, 323, 240, 358, 421, 0, 306, 422, 423, 280,
-	0, 0, 0, 0, 0, 0, 342, 0, 1372, 0,
-	0, 0, 0, 0, 0, 244, 0, 0, 0, 0,
-	297, 241, 0, 0, 356, 0, 193, 0, 397, 228,
-	307, 304, 428, 255, 247, 243, 227, 281, 314, 354,
-	415, 348, 0, 301, 0, 0, 406, 327, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 287, 226, 192, 339, 407, 259, 0,
-	0, 0, 0, 184, 185, 186, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 217, 0, 224, 0,
-	0, 0, 0, 0, 239, 285, 246, 238, 425, 0,
-	0, 0, 0, 0, 0, 0, 209, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 249, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 268,
-	0, 328, 231, 0, 0, 0, 0, 459, 0, 0,
-	0, 0, 0, 0, 0, 296, 0, 293, 188, 205,
-	0, 0, 338, 379, 385, 0, 0, 0, 229, 0,
-	383, 352, 443, 213, 257, 376, 357, 381, 364, 260,
-	0, 0, 382, 302, 430, 371, 440, 460, 461, 237,
-	332, 450, 419, 456, 472, 206, 234, 346, 412, 446,
-	403, 325, 426, 427, 292, 402, 266, 191, 300, 466,
-	204, 391, 221, 211, 197, 414, 438, 218, 394, 0,
-	0, 474, 199, 436, 411, 321, 289, 290, 198, 0,
-	375, 242, 264, 232, 341, 433, 434, 230, 475, 208,
-	455, 201, 0, 454, 334, 429, 437, 322, 312, 200,
-	435, 320, 311, 295, 253, 275, 369, 305, 370, 276,
-	330, 329, 331, 194, 447, 0, 195, 0, 408, 448,
-	476, 214, 215, 216, 0, 252, 256, 263, 265, 271,
-	272, 279, 298, 345, 368, 366, 372, 0, 424, 441,
-	451, 458, 464, 465, 467, 468, 469, 470, 471, 333,
-	278, 404, 294, 303, 0, 0, 351, 384, 219, 445,
-	405, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 477, 478, 479, 480, 481, 482, 483, 484, 485,
-	486, 487, 488, 489, 490, 491, 492, 493, 494, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 495,
-	313, 396, 442, 0, 187, 202, 299, 0, 373, 261,
-	473, 453, 449, 0, 0, 236, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 324, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 189, 190,
-	203, 212, 222, 235, 250, 258, 269, 274, 277, 282,
-	283, 286, 291, 309, 315, 316, 317, 318, 335, 336,
-	337, 340, 343, 344, 347, 349, 350, 353, 360, 361,
-	362, 363, 365, 367, 374, 378, 386, 387, 388, 389,
-	390, 392, 393, 398, 399, 400, 401, 409, 413, 431,
-	432, 444, 457, 462, 270, 439, 463, 0, 308, 0,
-	0, 310, 254, 273, 284, 0, 452, 410, 207, 380,
-	262, 196, 225, 210, 233, 248, 251, 288, 319, 326,
-	355, 359, 267, 245, 223, 377, 220, 395, 416, 417,
-	418, 420, 323, 240, 358, 421, 0, 306, 422, 423,
-	280, 0, 0, 0, 0, 0, 0, 342, 0, 1370,
-	0, 0, 0, 0, 0, 0, 244, 0, 0, 0,
-	0, 297, 241, 0, 0, 356, 0, 193, 0, 397,
-	228, 307, 304, 428, 255, 247, 243, 227, 281, 314,
-	354, 415, 348, 0, 301, 0, 0, 406, 327, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 287, 226, 192, 339, 407, 259,
-	0, 0, 0, 0, 184, 185, 186, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 217, 0, 224,
-	0, 0, 0, 0, 0, 239, 285, 246, 238, 425,
-	0, 0, 0, 0, 0, 0, 0, 209, 0, 0,
+	0, 0, 241, 287, 248, 240, 428, 0, 0, 0,
+	0, 0, 0, 397, 0, 0, 209, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 251, 0, 0, 0, 0, 0, 0, 0, 0,
+	901, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 270,
+	0, 330, 233, 0, 0, 0, 0, 462, 0, 0,
+	0, 0, 0, 0, 0, 298, 907, 295, 188, 205,
+	905, 0, 340, 381, 387, 0, 0, 0, 231, 0,
+	385, 354, 446, 213, 259, 378, 359, 383, 366, 262,
+	0, 0, 384, 304, 433, 373, 443, 463, 464, 239,
+	334, 453, 422, 459, 475, 206, 236, 348, 415, 449,
+	406, 327, 429, 430, 294, 405, 268, 191, 302, 469,
+	204, 393, 221, 211, 197, 417, 441, 218, 396, 0,
+	0, 477, 199, 439, 414, 323, 291, 292, 198, 0,
+	377, 244, 266, 234, 343, 436, 437, 232, 478, 208,
+	458, 201, 0, 457, 336, 432, 440, 324, 314, 200,
+	438, 322, 313, 297, 255, 277, 371, 307, 372, 278,
+	332, 331, 333, 194, 450, 0, 195, 0, 411, 451,
+	479, 214, 215, 216, 0, 254, 258, 265, 267, 273,
+	274, 281, 300, 347, 370, 368, 374, 0, 427, 444,
+	454, 461, 467, 468, 470, 471, 472, 473, 474, 335,
+	280, 407, 296, 305, 0, 0, 353, 386, 219, 448,
+	408, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 480, 481, 482, 483, 484, 485, 486, 487, 488,
+	489, 490, 491, 492, 493, 494, 495, 496, 497, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 498,
+	315, 399, 445, 0, 187, 202, 301, 0, 375, 263,
+	476, 456, 0, 0, 0, 229, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 452, 0, 238,
+	0, 0, 0, 0, 0, 0, 326, 0, 0, 0,
+	189, 190, 203, 212, 222, 237, 252, 260, 271, 276,
+	279, 284, 285, 288, 293, 311, 317, 318, 319, 320,
+	337, 338, 339, 342, 345, 346, 349, 351, 352, 355,
+	362, 363, 364, 365, 367, 369, 376, 380, 388, 389,
+	390, 391, 392, 394, 395, 401, 402, 403, 404, 412,
+	416, 434, 435, 447, 460, 465, 272, 442, 466, 0,
+	310, 0, 0, 312, 256, 275, 286, 0, 455, 413,
+	207, 382, 264, 196, 226, 210, 235, 250, 253, 290,
+	321, 328, 357, 361, 269, 247, 223, 379, 220, 398,
+	419, 420, 421, 423, 325, 242, 360, 424, 0, 308,
+	425, 426, 282, 0, 0, 0, 0, 0, 0, 344,
+	0, 0, 0, 0, 0, 0, 0, 0, 246, 0,
+	0, 0, 0, 299, 243, 0, 0, 358, 0, 193,
+	0, 400, 230, 309, 306, 431, 257, 249, 0, 225,
+	245, 228, 283, 316, 356, 418, 350, 0, 303, 0,
+	0, 409, 329, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 289, 227,
+	192, 341, 410, 261, 0, 0, 0, 1243, 184, 185,
+	186, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 217, 0, 224, 0, 0, 0, 0, 0, 241,
+	287, 248, 240, 428, 0, 0, 0, 0, 0, 0,
+	397, 0, 0, 209, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 251, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 249, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 270, 0, 330, 233,
+	0, 0, 0, 0, 462, 0, 0, 0, 2560, 0,
+	0, 0, 298, 0, 295, 188, 205, 0, 0, 340,
+	381, 387, 0, 0, 0, 231, 0, 385, 354, 446,
+	213, 259, 378, 359, 383, 366, 262, 0, 0, 384,
+	304, 433, 373, 443, 463, 464, 239, 334, 453, 422,
+	459, 475, 206, 236, 348, 415, 449, 406, 327, 429,
+	430, 294, 405, 268, 191, 302, 469, 204, 393, 221,
+	211, 197, 417, 441, 218, 396, 0, 0, 477, 199,
+	439, 414, 323, 291, 292, 198, 0, 377, 244, 266,
+	234, 343, 436, 437, 232, 478, 208, 458, 201, 0,
+	457, 336, 432, 440, 324, 314, 200, 438, 322, 313,
+	297, 255, 277, 371, 307, 372, 278, 332, 331, 333,
+	194, 450, 0, 195, 0, 411, 451, 479, 214, 215,
+	216, 0, 254, 258, 265, 267, 273, 274, 281, 300,
+	347, 370, 368, 374, 0, 427, 444, 454, 461, 467,
+	468, 470, 471, 472, 473, 474, 335, 280, 407, 296,
+	305, 0, 0, 353, 386, 219, 448, 408, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 480, 481,
+	482, 483, 484, 485, 486, 487, 488, 489, 490, 491,
+	492, 493, 494, 495, 496, 497, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 498, 315, 399, 445,
+	0, 187, 202, 301, 0, 375, 263, 476, 456, 0,
+	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 452, 0, 238, 0, 0, 0,
+	0, 0, 0, 326, 0, 0, 0, 189, 190, 203,
+	212, 222, 237, 252, 260, 271, 276, 279, 284, 285,
+	288, 293, 311, 317, 318, 319, 320, 337, 338, 339,
+	342, 345, 346, 349, 351, 352, 355, 362, 363, 364,
+	365, 367, 369, 376, 380, 388, 389, 390, 391, 392,
+	394, 395, 401, 402, 403, 404, 412, 416, 434, 435,
+	447, 460, 465, 272, 442, 466, 0, 310, 0, 0,
+	312, 256, 275, 286, 0, 455, 413, 207, 382, 264,
+	196, 226, 210, 235, 250, 253, 290, 321, 328, 357,
+	361, 269, 247, 223, 379, 220, 398, 419, 420, 421,
+	423, 325, 242, 360, 424, 0, 308, 425, 426, 282,
+	0, 0, 0, 0, 0, 0, 344, 0, 0, 0,
+	1664, 0, 0, 0, 0, 246, 0, 0, 0, 0,
+	299, 243, 0, 0, 358, 0, 193, 0, 400, 230,
+	309, 306, 431, 257, 249, 0, 225, 245, 228, 283,
+	316, 356, 418, 350, 0, 303, 0, 0, 409, 329,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 289, 227, 192, 341, 410,
+	261, 0, 0, 0, 0, 184, 185, 186, 0, 1435,
+	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
+	224, 0, 0, 0, 0, 0, 241, 287, 248, 240,
+	428, 0, 0, 0, 0, 0, 0, 397, 0, 0,
+	209, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 251, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -4099,54 +3560,166 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	268, 0, 328, 231, 0, 0, 0, 0, 459, 0,
-	0, 0, 0, 0, 0, 0, 296, 0, 293, 188,
-	205, 0, 0, 338, 379, 385, 0, 0, 0, 229,
-	0, 383, 352, 443, 213, 257, 376, 357, 381, 364,
-	260, 0, 0, 382, 302, 430, 371, 440, 460, 461,
-	237, 332, 450, 419, 456, 472, 206, 234, 346, 412,
-	446, 403, 325, 426, 427, 292, 402, 266, 191, 300,
-	466, 204, 391, 221, 211, 197, 414, 438, 218, 394,
-	0, 0, 474, 199, 436, 411, 321, 289, 290, 198,
-	0, 375, 242, 264, 232, 341, 433, 434, 230, 475,
-	208, 455, 201, 0, 454, 334, 429, 437, 322, 312,
-	200, 435, 320, 311, 295, 253, 275, 369, 305, 370,
-	276, 330, 329, 331, 194, 447, 0, 195, 0, 408,
-	448, 476, 214, 215, 216, 0, 252, 256, 263, 265,
-	271, 272, 279, 298, 345, 368, 366, 372, 0, 424,
-	441, 451, 458, 464, 465, 467, 468, 469, 470, 471,
-	333, 278, 404, 294, 303, 0, 0, 351, 384, 219,
-	445, 405, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 477, 478, 479, 480, 481, 482, 483, 484,
+	0, 0, 0, 270, 0, 330, 233, 0, 0, 0,
+	0, 462, 0, 0, 0, 0, 0, 0, 0, 298,
+	0, 295, 188, 205, 0, 0, 340, 381, 387, 0,
+	0, 0, 231, 0, 385, 354, 446, 213, 259, 378,
+	359, 383, 366, 262, 0, 0, 384, 304, 433, 373,
+	443, 463, 464, 239, 334, 453, 422, 459, 475, 206,
+	236, 348, 415, 449, 406, 327, 429, 430, 294, 405,
+	268, 191, 302, 469, 204, 393, 221, 211, 197, 417,
+	441, 218, 396, 0, 0, 477, 199, 439, 414, 323,
+	291, 292, 198, 0, 377, 244, 266, 234, 343, 436,
+	437, 232, 478, 208, 458, 201, 0, 457, 336, 432,
+	440, 324, 314, 200, 438, 322, 313, 297, 255, 277,
+	371, 307, 372, 278, 332, 331, 333, 194, 450, 0,
+	195, 0, 411, 451, 479, 214, 215, 216, 0, 254,
+	258, 265, 267, 273, 274, 281, 300, 347, 370, 368,
+	374, 0, 427, 444, 454, 461, 467, 468, 470, 471,
+	472, 473, 474, 335, 280, 407, 296, 305, 0, 0,
+	353, 386, 219, 448, 408, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 480, 481, 482, 483, 484,
 	485, 486, 487, 488, 489, 490, 491, 492, 493, 494,
+	495, 496, 497, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 498, 315, 399, 445, 0, 187, 202,
+	301, 0, 375, 263, 476, 456, 0, 0, 0, 229,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 452, 0, 238, 0, 0, 0, 0, 0, 0,
+	326, 0, 0, 0, 189, 190, 203, 212, 222, 237,
+	252, 260, 271, 276, 279, 284, 285, 288, 293, 311,
+	317, 318, 319, 320, 337, 338, 339, 342, 345, 346,
+	349, 351, 352, 355, 362, 363, 364, 365, 367, 369,
+	376, 380, 388, 389, 390, 391, 392, 394, 395, 401,
+	402, 403, 404, 412, 416, 434, 435, 447, 460, 465,
+	272, 442, 466, 0, 310, 0, 0, 312, 256, 275,
+	286, 0, 455, 413, 207, 382, 264, 196, 226, 210,
+	235, 250, 253, 290, 321, 328, 357, 361, 269, 247,
+	223, 379, 220, 398, 419, 420, 421, 423, 325, 242,
+	360, 424, 0, 308, 425, 426, 282, 0, 0, 0,
+	0, 0, 0, 344, 0, 0, 0, 0, 0, 0,
+	0, 0, 246, 0, 0, 0, 0, 299, 243, 0,
+	0, 358, 0, 193, 0, 400, 230, 309, 306, 431,
+	257, 249, 0, 225, 245, 228, 283, 316, 356, 418,
+	350, 0, 303, 0, 0, 409, 329, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 289, 227, 192, 341, 410, 261, 0, 0,
+	0, 0, 184, 185, 186, 0, 1966, 0, 0, 0,
+	0, 0, 0, 0, 0, 217, 0, 224, 0, 0,
+	0, 0, 0, 241, 287, 248, 240, 428, 0, 0,
+	0, 0, 0, 0, 397, 0, 0, 209, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 251, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1967, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	270, 0, 330, 233, 0, 0, 0, 0, 462, 0,
+	0, 0, 0, 0, 0, 0, 298, 0, 295, 188,
+	205, 0, 0, 340, 381, 387, 0, 0, 0, 231,
+	0, 385, 354, 446, 213, 259, 378, 359, 383, 366,
+	262, 0, 0, 384, 304, 433, 373, 443, 463, 464,
+	239, 334, 453, 422, 459, 475, 206, 236, 348, 415,
+	449, 406, 327, 429, 430, 294, 405, 268, 191, 302,
+	469, 204, 393, 221, 211, 197, 417, 441, 218, 396,
+	0, 0, 477, 199, 439, 414, 323, 291, 292, 198,
+	0, 377, 244, 266, 234, 343, 436, 437, 232, 478,
+	208, 458, 201, 0, 457, 336, 432, 440, 324, 314,
+	200, 438, 322, 313, 297, 255, 277, 371, 307, 372,
+	278, 332, 331, 333, 194, 450, 0, 195, 0, 411,
+	451, 479, 214, 215, 216, 0, 254, 258, 265, 267,
+	273, 274, 281, 300, 347, 370, 368, 374, 0, 427,
+	444, 454, 461, 467, 468, 470, 471, 472, 473, 474,
+	335, 280, 407, 296, 305, 0, 0, 353, 386, 219,
+	448, 408, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 480, 481, 482, 483, 484, 485, 486, 487,
+	488, 489, 490, 491, 492, 493, 494, 495, 496, 497,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	498, 315, 399, 445, 0, 187, 202, 301, 0, 375,
+	263, 476, 456, 0, 0, 0, 229, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 452, 0,
+	238, 0, 0, 0, 0, 0, 0, 326, 0, 0,
+	0, 189, 190, 203, 212, 222, 237, 252, 260, 271,
+	276, 279, 284, 285, 288, 293, 311, 317, 318, 319,
+	320, 337, 338, 339, 342, 345, 346, 349, 351, 352,
+	355, 362, 363, 364, 365, 367, 369, 376, 380, 388,
+	389, 390, 391, 392, 394, 395, 401, 402, 403, 404,
+	412, 416, 434, 435, 447, 460, 465, 272, 442, 466,
+	0, 310, 0, 0, 312, 256, 275, 286, 0, 455,
+	413, 207, 382, 264, 196, 226, 210, 235, 250, 253,
+	290, 321, 328, 357, 361, 269, 247, 223, 379, 220,
+	398, 419, 420, 421, 423, 325, 242, 360, 424, 0,
+	308, 425, 426, 282, 0, 0, 0, 0, 0, 0,
+	344, 0, 0, 0, 0, 0, 0, 0, 0, 246,
+	0, 0, 0, 0, 299, 243, 0, 0, 358, 0,
+	193, 0, 400, 230, 309, 306, 431, 257, 249, 0,
+	225, 245, 228, 283, 316, 356, 418, 350, 0, 303,
+	0, 0, 409, 329, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 289,
+	227, 192, 341, 410, 261, 0, 0, 0, 0, 184,
+	185, 186, 0, 0, 0, 1951, 0, 0, 0, 1952,
+	0, 0, 217, 0, 224, 0, 0, 0, 0, 0,
+	241, 287, 248, 240, 428, 0, 0, 0, 0, 0,
+	0, 397, 0, 0, 209, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 251,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	495, 313, 396, 442, 0, 187, 202, 299, 0, 373,
-	261, 473, 453, 449, 0, 0, 236, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 324, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	190, 203, 212, 222, 235, 250, 258, 269, 274, 277,
-	282, 283, 286, 291, 309, 315, 316, 317, 318, 335,
-	336, 337, 340, 343, 344, 347, 349, 350, 353, 360,
-	361, 362, 363, 365, 367, 374, 378, 386, 387, 388,
-	389, 390, 392, 393, 398, 399, 400, 401, 409, 413,
-	431, 432, 444, 457, 462, 270, 439, 463, 0, 308,
-	0, 0, 310, 254, 273, 284, 0, 452, 410, 207,
-	380, 262, 196, 225, 210, 233, 248, 251, 288, 319,
-	326, 355, 359, 267, 245, 223, 377, 220, 395, 416,
-	417, 418, 420, 323, 240, 358, 421, 0, 306, 422,
-	423, 280, 0, 0, 0, 0, 0, 0, 342, 0,
-	1368, 0, 0, 0, 0, 0, 0, 244, 0, 0,
-	0, 0, 297, 241, 0, 0, 356, 0, 193, 0,
-	397, 228, 307, 304, 428, 255, 247, 243, 227, 281,
-	314, 354, 415, 348, 0, 301, 0, 0, 406, 327,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 287, 226, 192, 339, 407,
-	259, 0, 0, 0, 0, 184, 185, 186, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
-	224, 0, 0, 0, 0, 0, 239, 285, 246, 238,
-	425, 0, 0, 0, 0, 0, 0, 0, 209, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 249, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 270, 0, 330,
+	233, 0, 0, 0, 0, 462, 0, 0, 0, 0,
+	0, 0, 0, 298, 0, 295, 188, 205, 0, 0,
+	340, 381, 387, 0, 0, 0, 231, 0, 385, 354,
+	446, 213, 259, 378, 359, 383, 366, 262, 0, 0,
+	384, 304, 433, 373, 443, 463, 464, 239, 334, 453,
+	422, 459, 475, 206, 236, 348, 415, 449, 406, 327,
+	429, 430, 294, 405, 268, 191, 302, 469, 204, 393,
+	221, 211, 197, 417, 441, 218, 396, 0, 0, 477,
+	199, 439, 414, 323, 291, 292, 198, 0, 377, 244,
+	266, 234, 343, 436, 437, 232, 478, 208, 458, 201,
+	0, 457, 336, 432, 440, 324, 314, 200, 438, 322,
+	313, 297, 255, 277, 371, 307, 372, 278, 332, 331,
+	333, 194, 450, 0, 195, 0, 411, 451, 479, 214,
+	215, 216, 0, 254, 258, 265, 267, 273, 274, 281,
+	300, 347, 370, 368, 374, 0, 427, 444, 454, 461,
+	467, 468, 470, 471, 472, 473, 474, 335, 280, 407,
+	296, 305, 0, 0, 353, 386, 219, 448, 408, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 480,
+	481, 482, 483, 484, 485, 486, 487, 488, 489, 490,
+	491, 492, 493, 494, 495, 496, 497, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 498, 315, 399,
+	445, 0, 187, 202, 301, 0, 375, 263, 476, 456,
+	0, 0, 0, 229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 452, 0, 238, 0, 0,
+	0, 0, 0, 0, 326, 0, 0, 0, 189, 190,
+	203, 212, 222, 237, 252, 260, 271, 276, 279, 284,
+	285, 288, 293, 311, 317, 318, 319, 320, 337, 338,
+	339, 342, 345, 346, 349, 351, 352, 355, 362, 363,
+	364, 365, 367, 369, 376, 380, 388, 389, 390, 391,
+	392, 394, 395, 401, 402, 403, 404, 412, 416, 434,
+	435, 447, 460, 465, 272, 442, 466, 0, 310, 0,
+	0, 312, 256, 275, 286, 0, 455, 413, 207, 382,
+	264, 196, 226, 210, 235, 250, 253, 290, 321, 328,
+	357, 361, 269, 247, 223, 379, 220, 398, 419, 420,
+	421, 423, 325, 242, 360, 424, 0, 308, 425, 426,
+	282, 0, 0, 0, 0, 0, 0, 344, 0, 0,
+	0, 0, 0, 0, 0, 0, 246, 1286, 0, 0,
+	0, 299, 243, 0, 0, 358, 0, 193, 0, 400,
+	230, 309, 306, 431, 257, 249, 0, 225, 245, 228,
+	283, 316, 356, 418, 350, 0, 303, 0, 0, 409,
+	329, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 289, 227, 192, 341,
+	410, 261, 0, 0, 0, 0, 184, 185, 186, 0,
+	1285, 0, 0, 0, 0, 0, 0, 0, 0, 217,
+	0, 224, 0, 0, 0, 0, 0, 241, 287, 248,
+	240, 428, 0, 0, 0, 0, 0, 0, 397, 0,
+	0, 209, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 251, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -4154,109 +3727,166 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 268, 0, 328, 231, 0, 0, 0, 0, 459,
-	0, 0, 0, 0, 0, 0, 0, 296, 0, 293,
-	188, 205, 0, 0, 338, 379, 385, 0, 0, 0,
-	229, 0, 383, 352, 443, 213, 257, 376, 357, 381,
-	364, 260, 0, 0, 382, 302, 430, 371, 440, 460,
-	461, 237, 332, 450, 419, 456, 472, 206, 234, 346,
-	412, 446, 403, 325, 426, 427, 292, 402, 266, 191,
-	300, 466, 204, 391, 221, 211, 197, 414, 438, 218,
-	394, 0, 0, 474, 199, 436, 411, 321, 289, 290,
-	198, 0, 375, 242, 264, 232, 341, 433, 434, 230,
-	475, 208, 455, 201, 0, 454, 334, 429, 437, 322,
-	312, 200, 435, 320, 311, 295, 253, 275, 369, 305,
-	370, 276, 330, 329, 331, 194, 447, 0, 195, 0,
-	408, 448, 476, 214, 215, 216, 0, 252, 256, 263,
-	265, 271, 272, 279, 298, 345, 368, 366, 372, 0,
-	424, 441, 451, 458, 464, 465, 467, 468, 469, 470,
-	471, 333, 278, 404, 294, 303, 0, 0, 351, 384,
-	219, 445, 405, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 477, 478, 479, 480, 481, 482, 483,
+	0, 0, 0, 0, 270, 0, 330, 233, 0, 0,
+	0, 0, 462, 0, 0, 0, 0, 0, 0, 0,
+	298, 0, 295, 188, 205, 0, 0, 340, 381, 387,
+	0, 0, 0, 231, 0, 385, 354, 446, 213, 259,
+	378, 359, 383, 366, 262, 0, 0, 384, 304, 433,
+	373, 443, 463, 464, 239, 334, 453, 422, 459, 475,
+	206, 236, 348, 415, 449, 406, 327, 429, 430, 294,
+	405, 268, 191, 302, 469, 204, 393, 221, 211, 197,
+	417, 441, 218, 396, 0, 0, 477, 199, 439, 414,
+	323, 291, 292, 198, 0, 377, 244, 266, 234, 343,
+	436, 437, 232, 478, 208, 458, 201, 0, 457, 336,
+	432, 440, 324, 314, 200, 438, 322, 313, 297, 255,
+	277, 371, 307, 372, 278, 332, 331, 333, 194, 450,
+	0, 195, 0, 411, 451, 479, 214, 215, 216, 0,
+	254, 258, 265, 267, 273, 274, 281, 300, 347, 370,
+	368, 374, 0, 427, 444, 454, 461, 467, 468, 470,
+	471, 472, 473, 474, 335, 280, 407, 296, 305, 0,
+	0, 353, 386, 219, 448, 408, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 480, 481, 482, 483,
 	484, 485, 486, 487, 488, 489, 490, 491, 492, 493,
-	494, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 495, 313, 396, 442, 0, 187, 202, 299, 0,
-	373, 261, 473, 453, 449, 0, 0, 236, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 324,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	189, 190, 203, 212, 222, 235, 250, 258, 269, 274,
-	277, 282, 283, 286, 291, 309, 315, 316, 317, 318,
-	335, 336, 337, 340, 343, 344, 347, 349, 350, 353,
-	360, 361, 362, 363, 365, 367, 374, 378, 386, 387,
-	388, 389, 390, 392, 393, 398, 399, 400, 401, 409,
-	413, 431, 432, 444, 457, 462, 270, 439, 463, 0,
-	308, 0, 0, 310, 254, 273, 284, 0, 452, 410,
-	207, 380, 262, 196, 225, 210, 233, 248, 251, 288,
-	319, 326, 355, 359, 267, 245, 223, 377, 220, 395,
-	416, 417, 418, 420, 323, 240, 358, 421, 0, 306,
-	422, 423, 280, 0, 0, 0, 0, 0, 0, 342,
-	0, 1366, 0, 0, 0, 0, 0, 0, 244, 0,
-	0, 0, 0, 297, 241, 0, 0, 356, 0, 193,
-	0, 397, 228, 307, 304, 428, 255, 247, 243, 227,
-	281, 314, 354, 415, 348, 0, 301, 0, 0, 406,
-	327, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 287, 226, 192, 339,
-	407, 259, 0, 0, 0, 0, 184, 185, 186, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 217,
-	0, 224, 0, 0, 0, 0, 0, 239, 285, 246,
-	238, 425, 0, 0, 0, 0, 0, 0, 0, 209,
+	494, 495, 496, 497, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 498, 315, 399, 445, 0, 187,
+	202, 301, 0, 375, 263, 476, 456, 0, 0, 0,
+	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 452, 0, 238, 0, 0, 0, 0, 0,
+	0, 326, 0, 0, 0, 189, 190, 203, 212, 222,
+	237, 252, 260, 271, 276, 279, 284, 285, 288, 293,
+	311, 317, 318, 319, 320, 337, 338, 339, 342, 345,
+	346, 349, 351, 352, 355, 362, 363, 364, 365, 367,
+	369, 376, 380, 388, 389, 390, 391, 392, 394, 395,
+	401, 402, 403, 404, 412, 416, 434, 435, 447, 460,
+	465, 272, 442, 466, 0, 310, 0, 0, 312, 256,
+	275, 286, 0, 455, 413, 207, 382, 264, 196, 226,
+	210, 235, 250, 253, 290, 321, 328, 357, 361, 269,
+	247, 223, 379, 220, 398, 419, 420, 421, 423, 325,
+	242, 360, 424, 0, 308, 425, 426, 282, 0, 0,
+	0, 0, 0, 0, 344, 0, 0, 0, 0, 0,
+	0, 0, 0, 246, 0, 0, 0, 0, 299, 243,
+	0, 0, 358, 0, 193, 0, 400, 230, 309, 306,
+	431, 257, 249, 0, 225, 245, 228, 283, 316, 356,
+	418, 350, 0, 303, 0, 0, 409, 329, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 289, 227, 192, 341, 410, 261, 0,
+	0, 0, 0, 184, 185, 186, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 217, 0, 224, 0,
+	0, 0, 0, 0, 241, 287, 248, 240, 428, 0,
+	0, 0, 0, 0, 0, 397, 0, 0, 209, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 270, 0, 330, 233, 0, 0, 0, 0, 462,
+	0, 0, 0, 2651, 0, 0, 0, 298, 0, 295,
+	188, 205, 0, 0, 340, 381, 387, 0, 0, 0,
+	231, 0, 385, 354, 446, 213, 259, 378, 359, 383,
+	366, 262, 0, 0, 384, 304, 433, 373, 443, 463,
+	464, 239, 334, 453, 422, 459, 475, 206, 236, 348,
+	415, 449, 406, 327, 429, 430, 294, 405, 268, 191,
+	302, 469, 204, 393, 221, 211, 197, 417, 441, 218,
+	396, 0, 0, 477, 199, 439, 414, 323, 291, 292,
+	198, 0, 377, 244, 266, 234, 343, 436, 437, 232,
+	478, 208, 458, 201, 0, 457, 336, 432, 440, 324,
+	314, 200, 438, 322, 313, 297, 255, 277, 371, 307,
+	372, 278, 332, 331, 333, 194, 450, 0, 195, 0,
+	411, 451, 479, 214, 215, 216, 0, 254, 258, 265,
+	267, 273, 274, 281, 300, 347, 370, 368, 374, 0,
+	427, 444, 454, 461, 467, 468, 470, 471, 472, 473,
+	474, 335, 280, 407, 296, 305, 0, 0, 353, 386,
+	219, 448, 408, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 480, 481, 482, 483, 484, 485, 486,
+	487, 488, 489, 490, 491, 492, 493, 494, 495, 496,
+	497, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 498, 315, 399, 445, 0, 187, 202, 301, 0,
+	375, 263, 476, 456, 0, 0, 0, 229, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 452,
+	0, 238, 0, 0, 0, 0, 0, 0, 326, 0,
+	0, 0, 189, 190, 203, 212, 222, 237, 252, 260,
+	271, 276, 279, 284, 285, 288, 293, 311, 317, 318,
+	319, 320, 337, 338, 339, 342, 345, 346, 349, 351,
+	352, 355, 362, 363, 364, 365, 367, 369, 376, 380,
+	388, 389, 390, 391, 392, 394, 395, 401, 402, 403,
+	404, 412, 416, 434, 435, 447, 460, 465, 272, 442,
+	466, 0, 310, 0, 0, 312, 256, 275, 286, 0,
+	455, 413, 207, 382, 264, 196, 226, 210, 235, 250,
+	253, 290, 321, 328, 357, 361, 269, 247, 223, 379,
+	220, 398, 419, 420, 421, 423, 325, 242, 360, 424,
+	0, 308, 425, 426, 282, 0, 0, 0, 0, 0,
+	0, 344, 0, 0, 0, 0, 0, 0, 0, 0,
+	246, 0, 0, 0, 0, 299, 243, 0, 0, 358,
+	0, 193, 0, 400, 230, 309, 306, 431, 257, 249,
+	0, 225, 245, 228, 283, 316, 356, 418, 350, 0,
+	303, 0, 0, 409, 329, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	289, 227, 192, 341, 410, 261, 0, 0, 0, 0,
+	184, 185, 186, 0, 2609, 0, 0, 0, 0, 0,
+	0, 0, 0, 217, 0, 224, 0, 0, 0, 0,
+	0, 241, 287, 248, 240, 428, 0, 0, 0, 0,
+	0, 0, 397, 0, 0, 209, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 249, 0, 0, 0, 0, 0,
+	251, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 270, 0,
+	330, 233, 0, 0, 0, 0, 462, 0, 0, 0,
+	0, 0, 0, 0, 298, 0, 295, 188, 205, 0,
+	0, 340, 381, 387, 0, 0, 0, 231, 0, 385,
+	354, 446, 213, 259, 378, 359, 383, 366, 262, 0,
+	0, 384, 304, 433, 373, 443, 463, 464, 239, 334,
+	453, 422, 459, 475, 206, 236, 348, 415, 449, 406,
+	327, 429, 430, 294, 405, 268, 191, 302, 469, 204,
+	393, 221, 211, 197, 417, 441, 218, 396, 0, 0,
+	477, 199, 439, 414, 323, 291, 292, 198, 0, 377,
+	244, 266, 234, 343, 436, 437, 232, 478, 208, 458,
+	201, 0, 457, 336, 432, 440, 324, 314, 200, 438,
+	322, 313, 297, 255, 277, 371, 307, 372, 278, 332,
+	331, 333, 194, 450, 0, 195, 0, 411, 451, 479,
+	214, 215, 216, 0, 254, 258, 265, 267, 273, 274,
+	281, 300, 347, 370, 368, 374, 0, 427, 444, 454,
+	461, 467, 468, 470, 471, 472, 473, 474, 335, 280,
+	407, 296, 305, 0, 0, 353, 386, 219, 448, 408,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 268, 0, 328, 231, 0, 0, 0, 0,
-	459, 0, 0, 0, 0, 0, 0, 0, 296, 0,
-	293, 188, 205, 0, 0, 338, 379, 385, 0, 0,
-	0, 229, 0, 383, 352, 443, 213, 257, 376, 357,
-	381, 364, 260, 0, 0, 382, 302, 430, 371, 440,
-	460, 461, 237, 332, 450, 419, 456, 472, 206, 234,
-	346, 412, 446, 403, 325, 426, 427, 292, 402, 266,
-	191, 300, 466, 204, 391, 221, 211, 197, 414, 438,
-	218, 394, 0, 0, 474, 199, 436, 411, 321, 289,
-	290, 198, 0, 375, 242, 264, 232, 341, 433, 434,
-	230, 475, 208, 455, 201, 0, 454, 334, 429, 437,
-	322, 312, 200, 435, 320, 311, 295, 253, 275, 369,
-	305, 370, 276, 330, 329, 331, 194, 447, 0, 195,
-	0, 408, 448, 476, 214, 215, 216, 0, 252, 256,
-	263, 265, 271, 272, 279, 298, 345, 368, 366, 372,
-	0, 424, 441, 451, 458, 464, 465, 467, 468, 469,
-	470, 471, 333, 278, 404, 294, 303, 0, 0, 351,
-	384, 219, 445, 405, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 477, 478, 479, 480, 481, 482,
-	483, 484, 485, 486, 487, 488, 489, 490, 491, 492,
-	493, 494, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 495, 313, 396, 442, 0, 187, 202, 299,
-	0, 373, 261, 473, 453, 449, 0, 0, 236, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	324, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 189, 190, 203, 212, 222, 235, 250, 258, 269,
-	274, 277, 282, 283, 286, 291, 309, 315, 316, 317,
-	318, 335, 336, 337, 340, 343, 344, 347, 349, 350,
-	353, 360, 361, 362, 363, 365, 367, 374, 378, 386,
-	387, 388, 389, 390, 392, 393, 398, 399, 400, 401,
-	409, 413, 431, 432, 444, 457, 462, 270, 439, 463,
-	0, 308, 0, 0, 310, 254, 273, 284, 0, 452,
-	410, 207, 380, 262, 196, 225, 210, 233, 248, 251,
-	288, 319, 326, 355, 359, 267, 245, 223, 377, 220,
-	395, 416, 417, 418, 420, 323, 240, 358, 421, 0,
-	306, 422, 423, 280, 0, 0, 0, 0, 0, 0,
-	342, 0, 1362, 0, 0, 0, 0, 0, 0, 244,
-	0, 0, 0, 0, 297, 241, 0, 0, 356, 0,
-	193, 0, 397, 228, 307, 304, 428, 255, 247, 243,
-	227, 281, 314, 354, 415, 348, 0, 301, 0, 0,
-	406, 327, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 287, 226, 192,
-	339, 407, 259, 0, 0, 0, 0, 184, 185, 186,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	217, 0, 224, 0, 0, 0, 0, 0, 239, 285,
-	246, 238, 425, 0, 0, 0, 0, 0, 0, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 249, 0, 0, 0, 0,
+	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
+	490, 491, 492, 493, 494, 495, 496, 497, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 498, 315,
+	399, 445, 0, 187, 202, 301, 0, 375, 263, 476,
+	456, 0, 0, 0, 229, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 452, 0, 238, 0,
+	0, 0, 0, 0, 0, 326, 0, 0, 0, 189,
+	190, 203, 212, 222, 237, 252, 260, 271, 276, 279,
+	284, 285, 288, 293, 311, 317, 318, 319, 320, 337,
+	338, 339, 342, 345, 346, 349, 351, 352, 355, 362,
+	363, 364, 365, 367, 369, 376, 380, 388, 389, 390,
+	391, 392, 394, 395, 401, 402, 403, 404, 412, 416,
+	434, 435, 447, 460, 465, 272, 442, 466, 0, 310,
+	0, 0, 312, 256, 275, 286, 0, 455, 413, 207,
+	382, 264, 196, 226, 210, 235, 250, 253, 290, 321,
+	328, 357, 361, 269, 247, 223, 379, 220, 398, 419,
+	420, 421, 423, 325, 242, 360, 424, 0, 308, 425,
+	426, 282, 0, 0, 0, 0, 0, 0, 344, 0,
+	0, 0, 0, 0, 0, 0, 0, 246, 0, 0,
+	0, 0, 299, 243, 0, 0, 358, 0, 193, 0,
+	400, 230, 309, 306, 431, 257, 249, 0, 225, 245,
+	228, 283, 316, 356, 418, 350, 0, 303, 0, 0,
+	409, 329, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 289, 227, 192,
+	341, 410, 261, 0, 0, 0, 0, 184, 185, 186,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	217, 0, 224, 0, 0, 0, 0, 0, 241, 287,
+	248, 240, 428, 0, 0, 0, 0, 0, 0, 397,
+	0, 0, 209, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 251, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -4264,54 +3894,166 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 268, 0, 328, 231, 0, 0, 0,
-	0, 459, 0, 0, 0, 0, 0, 0, 0, 296,
-	0, 293, 188, 205, 0, 0, 338, 379, 385, 0,
-	0, 0, 229, 0, 383, 352, 443, 213, 257, 376,
-	357, 381, 364, 260, 0, 0, 382, 302, 430, 371,
-	440, 460, 461, 237, 332, 450, 419, 456, 472, 206,
-	234, 346, 412, 446, 403, 325, 426, 427, 292, 402,
-	266, 191, 300, 466, 204, 391, 221, 211, 197, 414,
-	438, 218, 394, 0, 0, 474, 199, 436, 411, 321,
-	289, 290, 198, 0, 375, 242, 264, 232, 341, 433,
-	434, 230, 475, 208, 455, 201, 0, 454, 334, 429,
-	437, 322, 312, 200, 435, 320, 311, 295, 253, 275,
-	369, 305, 370, 276, 330, 329, 331, 194, 447, 0,
-	195, 0, 408, 448, 476, 214, 215, 216, 0, 252,
-	256, 263, 265, 271, 272, 279, 298, 345, 368, 366,
-	372, 0, 424, 441, 451, 458, 464, 465, 467, 468,
-	469, 470, 471, 333, 278, 404, 294, 303, 0, 0,
-	351, 384, 219, 445, 405, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 477, 478, 479, 480, 481,
-	482, 483, 484, 485, 486, 487, 488, 489, 490, 491,
-	492, 493, 494, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 495, 313, 396, 442, 0, 187, 202,
-	299, 0, 373, 261, 473, 453, 449, 0, 0, 236,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 324, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 189, 190, 203, 212, 222, 235, 250, 258,
-	269, 274, 277, 282, 283, 286, 291, 309, 315, 316,
-	317, 318, 335, 336, 337, 340, 343, 344, 347, 349,
-	350, 353, 360, 361, 362, 363, 365, 367, 374, 378,
-	386, 387, 388, 389, 390, 392, 393, 398, 399, 400,
-	401, 409, 413, 431, 432, 444, 457, 462, 270, 439,
-	463, 0, 308, 0, 0, 310, 254, 273, 284, 0,
-	452, 410, 207, 380, 262, 196, 225, 210, 233, 248,
-	251, 288, 319, 326, 355, 359, 267, 245, 223, 377,
-	220, 395, 416, 417, 418, 420, 323, 240, 358, 421,
-	0, 306, 422, 423, 280, 0, 0, 0, 0, 0,
-	0, 342, 0, 1360, 0, 0, 0, 0, 0, 0,
-	244, 0, 0, 0, 0, 297, 241, 0, 0, 356,
-	0, 193, 0, 397, 228, 307, 304, 428, 255, 247,
-	243, 227, 281, 314, 354, 415, 348, 0, 301, 0,
-	0, 406, 327, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 287, 226,
-	192, 339, 407, 259, 0, 0, 0, 0, 184, 185,
-	186, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 217, 0, 224, 0, 0, 0, 0, 0, 239,
-	285, 246, 238, 425, 0, 0, 0, 0, 0, 0,
-	0, 209, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 249, 0, 0, 0,
+	0, 0, 0, 0, 0, 270, 0, 330, 233, 0,
+	0, 0, 0, 462, 0, 0, 0, 2560, 0, 0,
+	0, 298, 0, 295, 188, 205, 0, 0, 340, 381,
+	387, 0, 0, 0, 231, 0, 385, 354, 446, 213,
+	259, 378, 359, 383, 366, 262, 0, 0, 384, 304,
+	433, 373, 443, 463, 464, 239, 334, 453, 422, 459,
+	475, 206, 236, 348, 415, 449, 406, 327, 429, 430,
+	294, 405, 268, 191, 302, 469, 204, 393, 221, 211,
+	197, 417, 441, 218, 396, 0, 0, 477, 199, 439,
+	414, 323, 291, 292, 198, 0, 377, 244, 266, 234,
+	343, 436, 437, 232, 478, 208, 458, 201, 0, 457,
+	336, 432, 440, 324, 314, 200, 438, 322, 313, 297,
+	255, 277, 371, 307, 372, 278, 332, 331, 333, 194,
+	450, 0, 195, 0, 411, 451, 479, 214, 215, 216,
+	0, 254, 258, 265, 267, 273, 274, 281, 300, 347,
+	370, 368, 374, 0, 427, 444, 454, 461, 467, 468,
+	470, 471, 472, 473, 474, 335, 280, 407, 296, 305,
+	0, 0, 353, 386, 219, 448, 408, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 480, 481, 482,
+	483, 484, 485, 486, 487, 488, 489, 490, 491, 492,
+	493, 494, 495, 496, 497, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 498, 315, 399, 445, 0,
+	187, 202, 301, 0, 375, 263, 476, 456, 0, 0,
+	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 452, 0, 238, 0, 0, 0, 0,
+	0, 0, 326, 0, 0, 0, 189, 190, 203, 212,
+	222, 237, 252, 260, 271, 276, 279, 284, 285, 288,
+	293, 311, 317, 318, 319, 320, 337, 338, 339, 342,
+	345, 346, 349, 351, 352, 355, 362, 363, 364, 365,
+	367, 369, 376, 380, 388, 389, 390, 391, 392, 394,
+	395, 401, 402, 403, 404, 412, 416, 434, 435, 447,
+	460, 465, 272, 442, 466, 0, 310, 0, 0, 312,
+	256, 275, 286, 0, 455, 413, 207, 382, 264, 196,
+	226, 210, 235, 250, 253, 290, 321, 328, 357, 361,
+	269, 247, 223, 379, 220, 398, 419, 420, 421, 423,
+	325, 242, 360, 424, 0, 308, 425, 426, 282, 0,
+	0, 0, 0, 0, 0, 344, 0, 0, 0, 0,
+	0, 0, 0, 0, 246, 0, 0, 0, 0, 299,
+	243, 0, 0, 358, 0, 193, 0, 400, 230, 309,
+	306, 431, 257, 249, 0, 225, 245, 228, 283, 316,
+	356, 418, 350, 0, 303, 0, 0, 409, 329, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 289, 227, 192, 341, 410, 261,
+	0, 87, 0, 0, 184, 185, 186, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 217, 0, 224,
+	0, 0, 0, 0, 0, 241, 287, 248, 240, 428,
+	0, 0, 0, 0, 0, 0, 397, 0, 0, 209,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 251, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 270, 0, 330, 233, 0, 0, 0, 0,
+	462, 0, 0, 0, 0, 0, 0, 0, 298, 0,
+	295, 188, 205, 0, 0, 340, 381, 387, 0, 0,
+	0, 231, 0, 385, 354, 446, 213, 259, 378, 359,
+	383, 366, 262, 0, 0, 384, 304, 433, 373, 443,
+	463, 464, 239, 334, 453, 422, 459, 475, 206, 236,
+	348, 415, 449, 406, 327, 429, 430, 294, 405, 268,
+	191, 302, 469, 204, 393, 221, 211, 197, 417, 441,
+	218, 396, 0, 0, 477, 199, 439, 414, 323, 291,
+	292, 198, 0, 377, 244, 266, 234, 343, 436, 437,
+	232, 478, 208, 458, 201, 0, 457, 336, 432, 440,
+	324, 314, 200, 438, 322, 313, 297, 255, 277, 371,
+	307, 372, 278, 332, 331, 333, 194, 450, 0, 195,
+	0, 411, 451, 479, 214, 215, 216, 0, 254, 258,
+	265, 267, 273, 274, 281, 300, 347, 370, 368, 374,
+	0, 427, 444, 454, 461, 467, 468, 470, 471, 472,
+	473, 474, 335, 280, 407, 296, 305, 0, 0, 353,
+	386, 219, 448, 408, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 480, 481, 482, 483, 484, 485,
+	486, 487, 488, 489, 490, 491, 492, 493, 494, 495,
+	496, 497, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 498, 315, 399, 445, 0, 187, 202, 301,
+	0, 375, 263, 476, 456, 0, 0, 0, 229, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	452, 0, 238, 0, 0, 0, 0, 0, 0, 326,
+	0, 0, 0, 189, 190, 203, 212, 222, 237, 252,
+	260, 271, 276, 279, 284, 285, 288, 293, 311, 317,
+	318, 319, 320, 337, 338, 339, 342, 345, 346, 349,
+	351, 352, 355, 362, 363, 364, 365, 367, 369, 376,
+	380, 388, 389, 390, 391, 392, 394, 395, 401, 402,
+	403, 404, 412, 416, 434, 435, 447, 460, 465, 272,
+	442, 466, 0, 310, 0, 0, 312, 256, 275, 286,
+	0, 455, 413, 207, 382, 264, 196, 226, 210, 235,
+	250, 253, 290, 321, 328, 357, 361, 269, 247, 223,
+	379, 220, 398, 419, 420, 421, 423, 325, 242, 360,
+	424, 0, 308, 425, 426, 282, 0, 0, 0, 0,
+	0, 0, 344, 0, 0, 0, 0, 0, 0, 0,
+	0, 246, 0, 0, 0, 0, 299, 243, 0, 0,
+	358, 0, 193, 0, 400, 230, 309, 306, 431, 257,
+	249, 0, 225, 245, 228, 283, 316, 356, 418, 350,
+	0, 303, 0, 0, 409, 329, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 289, 227, 192, 341, 410, 261, 0, 0, 0,
+	0, 184, 185, 186, 0, 1435, 0, 0, 0, 0,
+	0, 0, 0, 0, 217, 0, 224, 0, 0, 0,
+	0, 0, 241, 287, 248, 240, 428, 0, 0, 0,
+	0, 0, 0, 397, 0, 0, 209, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 251, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 270,
+	0, 330, 233, 0, 0, 0, 0, 462, 0, 0,
+	0, 0, 0, 0, 0, 298, 0, 295, 188, 205,
+	0, 0, 340, 381, 387, 0, 0, 0, 231, 0,
+	385, 354, 446, 213, 259, 378, 359, 383, 366, 262,
+	0, 0, 384, 304, 433, 373, 443, 463, 464, 239,
+	334, 453, 422, 459, 475, 206, 236, 348, 415, 449,
+	406, 327, 429, 430, 294, 405, 268, 191, 302, 469,
+	204, 393, 221, 211, 197, 417, 441, 218, 396, 0,
+	0, 477, 199, 439, 414, 323, 291, 292, 198, 0,
+	377, 244, 266, 234, 343, 436, 437, 232, 478, 208,
+	458, 201, 0, 457, 336, 432, 440, 324, 314, 200,
+	438, 322, 313, 297, 255, 277, 371, 307, 372, 278,
+	332, 331, 333, 194, 450, 0, 195, 0, 411, 451,
+	479, 214, 215, 216, 0, 254, 258, 265, 267, 273,
+	274, 281, 300, 347, 370, 368, 374, 0, 427, 444,
+	454, 461, 467, 468, 470, 471, 472, 473, 474, 335,
+	280, 407, 296, 305, 0, 0, 353, 386, 219, 448,
+	408, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 480, 481, 482, 483, 484, 485, 486, 487, 488,
+	489, 490, 491, 492, 493, 494, 495, 496, 497, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 498,
+	315, 399, 445, 0, 187, 202, 301, 0, 375, 263,
+	476, 456, 0, 0, 0, 229, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 452, 0, 238,
+	0, 0, 0, 0, 0, 0, 326, 0, 0, 0,
+	189, 190, 203, 212, 222, 237, 252, 260, 271, 276,
+	279, 284, 285, 288, 293, 311, 317, 318, 319, 320,
+	337, 338, 339, 342, 345, 346, 349, 351, 352, 355,
+	362, 363, 364, 365, 367, 369, 376, 380, 388, 389,
+	390, 391, 392, 394, 395, 401, 402, 403, 404, 412,
+	416, 434, 435, 447, 460, 465, 272, 442, 466, 0,
+	310, 0, 0, 312, 256, 275, 286, 0, 455, 413,
+	207, 382, 264, 196, 226, 210, 235, 250, 253, 290,
+	321, 328, 357, 361, 269, 247, 223, 379, 220, 398,
+	419, 420, 421, 423, 325, 242, 360, 0, 424, 308,
+	425, 426, 282, 1711, 0, 0, 0, 0, 0, 0,
+	344, 0, 0, 0, 0, 0, 0, 0, 0, 246,
+	0, 0, 0, 0, 299, 243, 0, 0, 358, 0,
+	193, 0, 400, 230, 309, 306, 431, 257, 249, 0,
+	225, 245, 228, 283, 316, 356, 418, 350, 0, 303,
+	0, 0, 409, 329, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 289,
+	227, 192, 341, 410, 261, 0, 0, 0, 0, 184,
+	185, 186, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 217, 0, 224, 0, 0, 0, 0, 0,
+	241, 287, 248, 240, 428, 0, 0, 0, 0, 0,
+	0, 397, 0, 0, 209, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 251,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -4319,54 +4061,55 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 268, 0, 328, 231, 0, 0,
-	0, 0, 459, 0, 0, 0, 0, 0, 0, 0,
-	296, 0, 293, 188, 205, 0, 0, 338, 379, 385,
-	0, 0, 0, 229, 0, 383, 352, 443, 213, 257,
-	376, 357, 381, 364, 260, 0, 0, 382, 302, 430,
-	371, 440, 460, 461, 237, 332, 450, 419, 456, 472,
-	206, 234, 346, 412, 446, 403, 325, 426, 427, 292,
-	402, 266, 191, 300, 466, 204, 391, 221, 211, 197,
-	414, 438, 218, 394, 0, 0, 474, 199, 436, 411,
-	321, 289, 290, 198, 0, 375, 242, 264, 232, 341,
-	433, 434, 230, 475, 208, 455, 201, 0, 454, 334,
-	429, 437, 322, 312, 200, 435, 320, 311, 295, 253,
-	275, 369, 305, 370, 276, 330, 329, 331, 194, 447,
-	0, 195, 0, 408, 448, 476, 214, 215, 216, 0,
-	252, 256, 263, 265, 271, 272, 279, 298, 345, 368,
-	366, 372, 0, 424, 441, 451, 458, 464, 465, 467,
-	468, 469, 470, 471, 333, 278, 404, 294, 303, 0,
-	0, 351, 384, 219, 445, 405, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 477, 478, 479, 480,
+	0, 0, 0, 0, 0, 0, 0, 270, 0, 330,
+	233, 0, 0, 0, 0, 462, 0, 0, 0, 0,
+	0, 0, 0, 298, 0, 295, 188, 205, 0, 0,
+	340, 381, 387, 0, 0, 0, 231, 0, 385, 354,
+	446, 213, 259, 378, 359, 383, 366, 262, 0, 0,
+	384, 304, 433, 373, 443, 463, 464, 239, 334, 453,
+	422, 459, 475, 206, 236, 348, 415, 449, 406, 327,
+	429, 430, 294, 405, 268, 191, 302, 469, 204, 393,
+	221, 211, 197, 417, 441, 218, 396, 0, 0, 477,
+	199, 439, 414, 323, 291, 292, 198, 0, 377, 244,
+	266, 234, 343, 436, 437, 232, 478, 208, 458, 201,
+	0, 457, 336, 432, 440, 324, 314, 200, 438, 322,
+	313, 297, 255, 277, 371, 307, 372, 278, 332, 331,
+	333, 194, 450, 0, 195, 0, 411, 451, 479, 214,
+	215, 216, 0, 254, 258, 265, 267, 273, 274, 281,
+	300, 347, 370, 368, 374, 0, 427, 444, 454, 461,
+	467, 468, 470, 471, 472, 473, 474, 335, 280, 407,
+	296, 305, 0, 0, 353, 386, 219, 448, 408, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 480,
 	481, 482, 483, 484, 485, 486, 487, 488, 489, 490,
-	491, 492, 493, 494, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 495, 313, 396, 442, 0, 187,
-	202, 299, 0, 373, 261, 473, 453, 449, 0, 0,
-	236, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 324, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 189, 190, 203, 212, 222, 235, 250,
-	258, 269, 274, 277, 282, 283, 286, 291, 309, 315,
-	316, 317, 318, 335, 336, 337, 340, 343, 344, 347,
-	349, 350, 353, 360, 361, 362, 363, 365, 367, 374,
-	378, 386, 387, 388, 389, 390, 392, 393, 398, 399,
-	400, 401, 409, 413, 431, 432, 444, 457, 462, 270,
-	439, 463, 0, 308, 0, 0, 310, 254, 273, 284,
-	0, 452, 410, 207, 380, 262, 196, 225, 210, 233,
-	248, 251, 288, 319, 326, 355, 359, 267, 245, 223,
-	377, 220, 395, 416, 417, 418, 420, 323, 240, 358,
-	421, 0, 306, 422, 423, 280, 0, 0, 0, 0,
-	0, 0, 342, 0, 1358, 0, 0, 0, 0, 0,
-	0, 244, 0, 0, 0, 0, 297, 241, 0, 0,
-	356, 0, 193, 0, 397, 228, 307, 304, 428, 255,
-	247, 243, 227, 281, 314, 354, 415, 348, 0, 301,
-	0, 0, 406, 327, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 287,
-	226, 192, 339, 407, 259, 0, 0, 0, 0, 184,
-	185, 186, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 217, 0, 224, 0, 0, 0, 0, 0,
-	239, 285, 246, 238, 425, 0, 0, 0, 0, 0,
-	0, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 249, 0, 0,
+	491, 492, 493, 494, 495, 496, 497, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 498, 315, 399,
+	445, 0, 187, 202, 301, 0, 375, 263, 476, 456,
+	0, 0, 0, 229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 452, 0, 238, 0, 0,
+	0, 0, 0, 0, 326, 0, 0, 0, 189, 190,
+	203, 212, 222, 237, 252, 260, 271, 276, 279, 284,
+	285, 288, 293, 311, 317, 318, 319, 320, 337, 338,
+	339, 342, 345, 346, 349, 351, 352, 355, 362, 363,
+	364, 365, 367, 369, 376, 380, 388, 389, 390, 391,
+	392, 394, 395, 401, 402, 403, 404, 412, 416, 434,
+	435, 447, 460, 465, 272, 442, 466, 0, 310, 0,
+	0, 312, 256, 275, 286, 0, 455, 413, 207, 382,
+	264, 196, 226, 210, 235, 250, 253, 290, 321, 328,
+	357, 361, 269, 247, 223, 379, 220, 398, 419, 420,
+	421, 423, 325, 242, 360, 424, 0, 308, 425, 426,
+	282, 0, 0, 0, 0, 0, 0, 344, 0, 0,
+	0, 0, 0, 0, 0, 0, 246, 0, 0, 0,
+	0, 299, 243, 0, 0, 358, 0, 193, 0, 400,
+	230, 309, 306, 431, 257, 249, 0, 225, 245, 228,
+	283, 316, 356, 418, 350, 0, 303, 0, 0, 409,
+	329, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 289, 227, 192, 341,
+	410, 261, 0, 0, 0, 0, 184, 185, 186, 0,
+	1265, 0, 0, 0, 0, 0, 0, 0, 0, 217,
+	0, 224, 0, 0, 0, 0, 0, 241, 287, 248,
+	240, 428, 0, 0, 0, 0, 0, 0, 397, 0,
+	0, 209, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 251, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -4374,495 +4117,723 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 268, 0, 328, 231, 0,
-	0, 0, 0, 459, 0, 0, 0, 0, 0, 0,
-	0, 296, 0, 293, 188, 205, 0, 0, 338, 379,
-	385, 0, 0, 0, 229, 0, 383, 352, 443, 213,
-	257, 376, 357, 381, 364, 260, 0, 0, 382, 302,
-	430, 371, 440, 460, 461, 237, 332, 450, 419, 456,
-	472, 206, 234, 346, 412, 446, 403, 325, 426, 427,
-	292, 402, 266, 191, 300, 466, 204, 391, 221, 211,
-	197, 414, 438, 218, 394, 0, 0, 474, 199, 436,
-	411, 321, 289, 290, 198, 0, 375, 242, 264, 232,
-	341, 433, 434, 230, 475, 208, 455, 201, 0, 454,
-	334, 429, 437, 322, 312, 200, 435, 320, 311, 295,
-	253, 275, 369, 305, 370, 276, 330, 329, 331, 194,
-	447, 0, 195, 0, 408, 448, 476, 214, 215, 216,
-	0, 252, 256, 263, 265, 271, 272, 279, 298, 345,
-	368, 366, 372, 0, 424, 441, 451, 458, 464, 465,
-	467, 468, 469, 470, 471, 333, 278, 404, 294, 303,
-	0, 0, 351, 384, 219, 445, 405, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 477, 478, 479,
-	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
-	490, 491, 492, 493, 494, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 495, 313, 396, 442, 0,
-	187, 202, 299, 0, 373, 261, 473, 453, 449, 0,
-	0, 236, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 324, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 189, 190, 203, 212, 222, 235,
-	250, 258, 269, 274, 277, 282, 283, 286, 291, 309,
-	315, 316, 317, 318, 335, 336, 337, 340, 343, 344,
-	347, 349, 350, 353, 360, 361, 362, 363, 365, 367,
-	374, 378, 386, 387, 388, 389, 390, 392, 393, 398,
-	399, 400, 401, 409, 413, 431, 432, 444, 457, 462,
-	270, 439, 463, 0, 308, 0, 0, 310, 254, 273,
-	284, 0, 452, 410, 207, 380, 262, 196, 225, 210,
-	233, 248, 251, 288, 319, 326, 355, 359, 267, 245,
-	223, 377, 220, 395, 416, 417, 418, 420, 323, 240,
-	358, 421, 0, 306, 422, 423, 280, 0, 0, 0,
-	0, 0, 0, 342, 0, 0, 0, 0, 0, 0,
-	0, 0, 244, 0, 0, 0, 0, 297, 241, 0,
-	0, 356, 0, 193, 0, 397, 228, 307, 304, 428,
-	255, 247, 243, 227, 281, 314, 354, 415, 348, 0,
-	301, 0, 0, 406, 327, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	287, 226, 192, 339, 407, 259, 0, 1335, 0, 0,
-	184, 185, 186, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 217, 0, 224, 0, 0, 0, 0,
-	0, 239, 285, 246, 238, 425, 0, 0, 0, 0,
-	0, 0, 0, 209, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 249, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 268, 0, 328, 231,
-	0, 0, 0, 0, 459, 0, 0, 0, 0, 0,
-	0, 0, 296, 0, 293, 188, 205, 0, 0, 338,
-	379, 385, 0, 0, 0, 229, 0, 383, 352, 443,
-	213, 257, 376, 357, 381, 364, 260, 0, 0, 382,
-	302, 430, 371, 440, 460, 461, 237, 332, 450, 419,
-	456, 472, 206, 234, 346, 412, 446, 403, 325, 426,
-	427, 292, 402, 266, 191, 300, 466, 204, 391, 221,
-	211, 197, 414, 438, 218, 394, 0, 0, 474, 199,
-	436, 411, 321, 289, 290, 198, 0, 375, 242, 264,
-	232, 341, 433, 434, 230, 475, 208, 455, 201, 0,
-	454, 334, 429, 437, 322, 312, 200, 435, 320, 311,
-	295, 253, 275, 369, 305, 370, 276, 330, 329, 331,
-	194, 447, 0, 195, 0, 408, 448, 476, 214, 215,
-	216, 0, 252, 256, 263, 265, 271, 272, 279, 298,
-	345, 368, 366, 372, 0, 424, 441, 451, 458, 464,
-	465, 467, 468, 469, 470, 471, 333, 278, 404, 294,
-	303, 0, 0, 351, 384, 219, 445, 405, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 477, 478,
-	479, 480, 481, 482, 483, 484, 485, 486, 487, 488,
-	489, 490, 491, 492, 493, 494, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 495, 313, 396, 442,
-	0, 187, 202, 299, 0, 373, 261, 473, 453, 449,
-	0, 0, 236, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 324, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 189, 190, 203, 212, 222,
-	235, 250, 258, 269, 274, 277, 282, 283, 286, 291,
-	309, 315, 316, 317, 318, 335, 336, 337, 340, 343,
-	344, 347, 349, 350, 353, 360, 361, 362, 363, 365,
-	367, 374, 378, 386, 387, 388, 389, 390, 392, 393,
-	398, 399, 400, 401, 409, 413, 431, 432, 444, 457,
-	462, 270, 439, 463, 0, 308, 0, 0, 310, 254,
-	273, 284, 0, 452, 410, 207, 380, 262, 196, 225,
-	210, 233, 248, 251, 288, 319, 326, 355, 359, 267,
-	245, 223, 377, 220, 395, 416, 417, 418, 420, 323,
-	240, 358, 421, 0, 306, 422, 423, 280, 0, 0,
-	0, 0, 0, 0, 342, 0, 0, 0, 0, 0,
-	0, 0, 1237, 244, 0, 0, 0, 0, 297, 241,
-	0, 0, 356, 0, 193, 0, 397, 228, 307, 304,
-	428, 255, 247, 243, 227, 281, 314, 354, 415, 348,
-	0, 301, 0, 0, 406, 327, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 287, 226, 192, 339, 407, 259, 0, 0, 0,
-	0, 184, 185, 186, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 217, 0, 224, 0, 0, 0,
-	0, 0, 239, 285, 246, 238, 425, 0, 0, 0,
-	0, 0, 0, 0, 209, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 249,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 268, 0, 328,
-	231, 0, 0, 0, 0, 459, 0, 0, 0, 0,
-	0, 0, 0, 296, 0, 293, 188, 205, 0, 0,
-	338, 379, 385, 0, 0, 0, 229, 0, 383, 352,
-	443, 213, 257, 376, 357, 381, 364, 260, 0, 0,
-	382, 302, 430, 371, 440, 460, 461, 237, 332, 450,
-	419, 456, 472, 206, 234, 346, 412, 446, 403, 325,
-	426, 427, 292, 402, 266, 191, 300, 466, 204, 391,
-	221, 211, 197, 414, 438, 218, 394, 0, 0, 474,
-	199, 436, 411, 321, 289, 290, 198, 0, 375, 242,
-	264, 232, 341, 433, 434, 230, 475, 208, 455, 201,
-	0, 454, 334, 429, 437, 322, 312, 200, 435, 320,
-	311, 295, 253, 275, 369, 305, 370, 276, 330, 329,
-	331, 194, 447, 0, 195, 0, 408, 448, 476, 214,
-	215, 216, 0, 252, 256, 263, 265, 271, 272, 279,
-	298, 345, 368, 366, 372, 0, 424, 441, 451, 458,
-	464, 465, 467, 468, 469, 470, 471, 333, 278, 404,
-	294, 303, 0, 0, 351, 384, 219, 445, 405, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 477,
-	478, 479, 480, 481, 482, 483, 484, 485, 486, 487,
-	488, 489, 490, 491, 492, 493, 494, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 495, 313, 396,
-	442, 0, 187, 202, 299, 0, 373, 261, 473, 453,
-	449, 0, 0, 236, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 324, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 189, 190, 203, 212,
-	222, 235, 250, 258, 269, 274, 277, 282, 283, 286,
-	291, 309, 315, 316, 317, 318, 335, 336, 337, 340,
-	343, 344, 347, 349, 350, 353, 360, 361, 362, 363,
-	365, 367, 374, 378, 386, 387, 388, 389, 390, 392,
-	393, 398, 399, 400, 401, 409, 413, 431, 432, 444,
-	457, 462, 270, 439, 463, 0, 308, 0, 0, 310,
-	254, 273, 284, 0, 452, 410, 207, 380, 262, 196,
-	225, 210, 233, 248, 251, 288, 319, 326, 355, 359,
-	267, 245, 223, 377, 220, 395, 416, 417, 418, 420,
-	323, 240, 358, 421, 0, 306, 422, 423, 280, 0,
-	0, 0, 0, 0, 0, 342, 0, 0, 0, 0,
-	0, 0, 0, 0, 244, 0, 0, 0, 0, 297,
-	241, 0, 0, 356, 0, 193, 0, 397, 228, 307,
-	304, 428, 255, 247, 243, 227, 281, 314, 354, 415,
-	348, 0, 301, 0, 0, 406, 327, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 287, 226, 192, 339, 407, 259, 0, 0,
-	0, 0, 184, 185, 186, 0, 1070, 0, 0, 0,
-	0, 0, 0, 0, 0, 217, 0, 224, 0, 0,
-	0, 0, 0, 239, 285, 246, 238, 425, 0, 0,
-	0, 0, 0, 0, 0, 209, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	249, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 268, 0,
-	328, 231, 0, 0, 0, 0, 459, 0, 0, 0,
-	0, 0, 0, 0, 296, 0, 293, 188, 205, 0,
-	0, 338, 379, 385, 0, 0, 0, 229, 0, 383,
-	352, 443, 213, 257, 376, 357, 381, 364, 260, 0,
-	0, 382, 302, 430, 371, 440, 460, 461, 237, 332,
-	450, 419, 456, 472, 206, 234, 346, 412, 446, 403,
-	325, 426, 427, 292, 402, 266, 191, 300, 466, 204,
-	391, 221, 211, 197, 414, 438, 218, 394, 0, 0,
-	474, 199, 436, 411, 321, 289, 290, 198, 0, 375,
-	242, 264, 232, 341, 433, 434, 230, 475, 208, 455,
-	201, 0, 454, 334, 429, 437, 322, 312, 200, 435,
-	320, 311, 295, 253, 275, 369, 305, 370, 276, 330,
-	329, 331, 194, 447, 0, 195, 0, 408, 448, 476,
-	214, 215, 216, 0, 252, 256, 263, 265, 271, 272,
-	279, 298, 345, 368, 366, 372, 0, 424, 441, 451,
-	458, 464, 465, 467, 468, 469, 470, 471, 333, 278,
-	404, 294, 303, 0, 0, 351, 384, 219, 445, 405,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	477, 478, 479, 480, 481, 482, 483, 484, 485, 486,
-	487, 488, 489, 490, 491, 492, 493, 494, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 495, 313,
-	396, 442, 0, 187, 202, 299, 0, 373, 261, 473,
-	453, 449, 0, 0, 236, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 324, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 189, 190, 203,
-	212, 222, 235, 250, 258, 269, 274, 277, 282, 283,
-	286, 291, 309, 315, 316, 317, 318, 335, 336, 337,
-	340, 343, 344, 347, 349, 350, 353, 360, 361, 362,
-	363, 365, 367, 374, 378, 386, 387, 388, 389, 390,
-	392, 393, 398, 399, 400, 401, 409, 413, 431, 432,
-	444, 457, 462, 270, 439, 463, 0, 308, 0, 0,
-	310, 254, 273, 284, 0, 452, 410, 207, 380, 262,
-	196, 225, 210, 233, 248, 251, 288, 319, 326, 355,
-	359, 267, 245, 223, 377, 220, 395, 416, 417, 418,
-	420, 323, 240, 358, 421, 0, 306, 422, 423, 280,
-	0, 0, 0, 0, 0, 0, 342, 0, 0, 0,
-	0, 0, 0, 0, 0, 244, 0, 0, 0, 0,
-	297, 241, 0, 0, 356, 0, 193, 0, 397, 228,
-	307, 304, 428, 255, 247, 243, 227, 281, 314, 354,
-	415, 348, 0, 301, 0, 0, 406, 327, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 287, 226, 192, 339, 407, 259, 0,
+	0, 0, 0, 0, 270, 0, 330, 233, 0, 0,
+	0, 0, 462, 0, 0, 0, 0, 0, 0, 0,
+	298, 0, 295, 188, 205, 0, 0, 340, 381, 387,
+	0, 0, 0, 231, 0, 385, 354, 446, 213, 259,
+	378, 359, 383, 366, 262, 0, 0, 384, 304, 433,
+	373, 443, 463, 464, 239, 334, 453, 422, 459, 475,
+	206, 236, 348, 415, 449, 406, 327, 429, 430, 294,
+	405, 268, 191, 302, 469, 204, 393, 221, 211, 197,
+	417, 441, 218, 396, 0, 0, 477, 199, 439, 414,
+	323, 291, 292, 198, 0, 377, 244, 266, 234, 343,
+	436, 437, 232, 478, 208, 458, 201, 0, 457, 336,
+	432, 440, 324, 314, 200, 438, 322, 313, 297, 255,
+	277, 371, 307, 372, 278, 332, 331, 333, 194, 450,
+	0, 195, 0, 411, 451, 479, 214, 215, 216, 0,
+	254, 258, 265, 267, 273, 274, 281, 300, 347, 370,
+	368, 374, 0, 427, 444, 454, 461, 467, 468, 470,
+	471, 472, 473, 474, 335, 280, 407, 296, 305, 0,
+	0, 353, 386, 219, 448, 408, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 492, 493,
+	494, 495, 496, 497, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 498, 315, 399, 445, 0, 187,
+	202, 301, 0, 375, 263, 476, 456, 0, 0, 0,
+	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 452, 0, 238, 0, 0, 0, 0, 0,
+	0, 326, 0, 0, 0, 189, 190, 203, 212, 222,
+	237, 252, 260, 271, 276, 279, 284, 285, 288, 293,
+	311, 317, 318, 319, 320, 337, 338, 339, 342, 345,
+	346, 349, 351, 352, 355, 362, 363, 364, 365, 367,
+	369, 376, 380, 388, 389, 390, 391, 392, 394, 395,
+	401, 402, 403, 404, 412, 416, 434, 435, 447, 460,
+	465, 272, 442, 466, 0, 310, 0, 0, 312, 256,
+	275, 286, 0, 455, 413, 207, 382, 264, 196, 226,
+	210, 235, 250, 253, 290, 321, 328, 357, 361, 269,
+	247, 223, 379, 220, 398, 419, 420, 421, 423, 325,
+	242, 360, 424, 0, 308, 425, 426, 282, 0, 0,
+	0, 0, 0, 0, 344, 0, 0, 0, 0, 0,
+	0, 0, 0, 246, 0, 0, 0, 0, 299, 243,
+	0, 0, 358, 0, 193, 0, 400, 230, 309, 306,
+	431, 257, 249, 0, 225, 245, 228, 283, 316, 356,
+	418, 350, 0, 303, 0, 0, 409, 329, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 289, 227, 192, 341, 410, 261, 0,
 	0, 0, 0, 184, 185, 186, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 217, 0, 224, 0,
-	0, 0, 0, 0, 239, 285, 246, 238, 425, 0,
-	0, 0, 0, 0, 0, 0, 209, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 249, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 268,
-	0, 328, 231, 0, 0, 0, 0, 459, 0, 0,
-	0, 0, 0, 0, 0, 296, 0, 293, 188, 205,
-	0, 0, 338, 379, 385, 0, 0, 0, 229, 0,
-	383, 352, 443, 213, 257, 376, 357, 381, 364, 260,
-	0, 0, 382, 302, 430, 371, 440, 460, 461, 237,
-	332, 450, 419, 456, 472, 206, 234, 346, 412, 446,
-	403, 325, 426, 427, 292, 402, 266, 191, 300, 466,
-	204, 391, 221, 211, 197, 414, 438, 218, 394, 0,
-	0, 474, 199, 436, 411, 321, 289, 290, 198, 0,
-	375, 242, 264, 232, 341, 433, 434, 230, 475, 208,
-	455, 201, 0, 454, 334, 429, 437, 322, 312, 200,
-	435, 320, 311, 295, 253, 275, 369, 305, 370, 276,
-	330, 329, 331, 194, 447, 0, 195, 0, 408, 448,
-	476, 214, 215, 216, 0, 252, 256, 263, 265, 271,
-	272, 279, 298, 345, 368, 366, 372, 0, 424, 441,
-	451, 458, 464, 465, 467, 468, 469, 470, 471, 333,
-	278, 404, 294, 303, 0, 0, 351, 384, 219, 445,
-	405, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 477, 478, 479, 480, 481, 482, 483, 484, 485,
-	486, 487, 488, 489, 490, 491, 492, 493, 494, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 495,
-	313, 396, 442, 0, 187, 202, 299, 0, 373, 261,
-	473, 453, 449, 0, 0, 236, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 324, 0, 0,
-	0, 0, 0, 0, 698, 0, 0, 0, 189, 190,
-	203, 212, 222, 235, 250, 258, 269, 274, 277, 282,
-	283, 286, 291, 309, 315, 316, 317, 318, 335, 336,
-	337, 340, 343, 344, 347, 349, 350, 353, 360, 361,
-	362, 363, 365, 367, 374, 378, 386, 387, 388, 389,
-	390, 392, 393, 398, 399, 400, 401, 409, 413, 431,
-	432, 444, 457, 462, 270, 439, 463, 0, 308, 0,
-	0, 310, 254, 273, 284, 0, 452, 410, 207, 380,
-	262, 196, 225, 210, 233, 248, 251, 288, 319, 326,
-	355, 359, 267, 245, 223, 377, 220, 395, 416, 417,
-	418, 420, 323, 240, 358, 421, 0, 306, 422, 423,
-	280, 0, 0, 0, 0, 0, 0, 342, 0, 0,
-	0, 0, 0, 0, 0, 0, 244, 0, 0, 0,
-	0, 297, 241, 0, 0, 356, 0, 193, 0, 397,
-	228, 307, 304, 428, 255, 247, 243, 227, 281, 314,
-	354, 415, 348, 0, 301, 0, 0, 406, 327, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 287, 226, 192, 339, 407, 259,
+	0, 0, 0, 0, 241, 287, 248, 240, 428, 0,
+	0, 0, 0, 0, 0, 397, 0, 0, 209, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1141, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 270, 0, 330, 233, 0, 0, 0, 0, 462,
+	0, 0, 0, 0, 0, 0, 0, 298, 0, 295,
+	188, 205, 0, 0, 340, 381, 387, 0, 0, 0,
+	231, 0, 385, 354, 446, 213, 259, 378, 359, 383,
+	366, 262, 0, 0, 384, 304, 433, 373, 443, 463,
+	464, 239, 334, 453, 422, 459, 475, 206, 236, 348,
+	415, 449, 406, 327, 429, 430, 294, 405, 268, 191,
+	302, 469, 204, 393, 221, 211, 197, 417, 441, 218,
+	396, 0, 0, 477, 199, 439, 414, 323, 291, 292,
+	198, 0, 377, 244, 266, 234, 343, 436, 437, 232,
+	478, 208, 458, 201, 0, 457, 336, 432, 440, 324,
+	314, 200, 438, 322, 313, 297, 255, 277, 371, 307,
+	372, 278, 332, 331, 333, 194, 450, 0, 195, 0,
+	411, 451, 479, 214, 215, 216, 0, 254, 258, 265,
+	267, 273, 274, 281, 300, 347, 370, 368, 374, 0,
+	427, 444, 454, 461, 467, 468, 470, 471, 472, 473,
+	474, 335, 280, 407, 296, 305, 0, 0, 353, 386,
+	219, 448, 408, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 480, 481, 482, 483, 484, 485, 486,
+	487, 488, 489, 490, 491, 492, 493, 494, 495, 496,
+	497, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 498, 315, 399, 445, 0, 187, 202, 301, 0,
+	375, 263, 476, 456, 0, 0, 0, 229, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 452,
+	0, 238, 0, 0, 0, 0, 0, 0, 326, 0,
+	0, 0, 189, 190, 203, 212, 222, 237, 252, 260,
+	271, 276, 279, 284, 285, 288, 293, 311, 317, 318,
+	319, 320, 337, 338, 339, 342, 345, 346, 349, 351,
+	352, 355, 362, 363, 364, 365, 367, 369, 376, 380,
+	388, 389, 390, 391, 392, 394, 395, 401, 402, 403,
+	404, 412, 416, 434, 435, 447, 460, 465, 272, 442,
+	466, 0, 310, 0, 0, 312, 256, 275, 286, 0,
+	455, 413, 207, 382, 264, 196, 226, 210, 235, 250,
+	253, 290, 321, 328, 357, 361, 269, 247, 223, 379,
+	220, 398, 419, 420, 421, 423, 325, 242, 360, 424,
+	0, 308, 425, 426, 282, 0, 0, 0, 0, 0,
+	0, 344, 0, 0, 0, 0, 0, 0, 0, 0,
+	246, 0, 0, 0, 0, 299, 243, 0, 0, 358,
+	0, 193, 0, 400, 230, 309, 306, 431, 257, 249,
+	0, 225, 245, 228, 283, 316, 356, 418, 350, 0,
+	303, 0, 0, 409, 329, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	289, 227, 192, 341, 410, 261, 0, 0, 0, 0,
+	184, 185, 186, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 217, 0, 224, 0, 0, 0, 0,
+	0, 241, 287, 248, 240, 428, 0, 0, 0, 0,
+	0, 0, 397, 0, 0, 209, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	251, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 270, 0,
+	330, 233, 0, 0, 0, 0, 462, 0, 0, 0,
+	0, 0, 0, 0, 298, 0, 295, 188, 205, 0,
+	0, 340, 381, 387, 0, 0, 0, 231, 0, 385,
+	354, 446, 213, 259, 378, 359, 383, 366, 262, 0,
+	0, 384, 304, 433, 373, 443, 463, 464, 239, 334,
+	453, 422, 459, 475, 206, 236, 348, 415, 449, 406,
+	327, 429, 430, 294, 405, 268, 191, 302, 469, 204,
+	393, 221, 211, 197, 417, 441, 218, 396, 0, 0,
+	477, 199, 439, 414, 323, 291, 292, 198, 0, 377,
+	244, 266, 234, 343, 436, 437, 232, 478, 208, 458,
+	201, 0, 457, 336, 432, 440, 324, 314, 200, 438,
+	322, 313, 297, 255, 277, 371, 307, 372, 278, 332,
+	331, 333, 194, 450, 0, 195, 0, 411, 451, 479,
+	214, 215, 216, 0, 254, 258, 265, 267, 273, 274,
+	281, 300, 347, 370, 368, 374, 0, 427, 444, 454,
+	461, 467, 468, 470, 471, 472, 473, 474, 335, 280,
+	407, 296, 305, 0, 0, 353, 386, 219, 448, 408,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
+	490, 491, 492, 493, 494, 495, 496, 497, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 498, 315,
+	399, 445, 0, 187, 202, 301, 1527, 375, 263, 476,
+	456, 0, 0, 0, 229, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 452, 0, 238, 0,
+	0, 0, 0, 0, 0, 326, 0, 0, 0, 189,
+	190, 203, 212, 222, 237, 252, 260, 271, 276, 279,
+	284, 285, 288, 293, 311, 317, 318, 319, 320, 337,
+	338, 339, 342, 345, 346, 349, 351, 352, 355, 362,
+	363, 364, 365, 367, 369, 376, 380, 388, 389, 390,
+	391, 392, 394, 395, 401, 402, 403, 404, 412, 416,
+	434, 435, 447, 460, 465, 272, 442, 466, 0, 310,
+	0, 0, 312, 256, 275, 286, 0, 455, 413, 207,
+	382, 264, 196, 226, 210, 235, 250, 253, 290, 321,
+	328, 357, 361, 269, 247, 223, 379, 220, 398, 419,
+	420, 421, 423, 325, 242, 360, 424, 0, 308, 425,
+	426, 282, 0, 0, 0, 0, 0, 0, 344, 0,
+	1407, 0, 0, 0, 0, 0, 0, 246, 0, 0,
+	0, 0, 299, 243, 0, 0, 358, 0, 193, 0,
+	400, 230, 309, 306, 431, 257, 249, 0, 225, 245,
+	228, 283, 316, 356, 418, 350, 0, 303, 0, 0,
+	409, 329, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 289, 227, 192,
+	341, 410, 261, 0, 0, 0, 0, 184, 185, 186,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	217, 0, 224, 0, 0, 0, 0, 0, 241, 287,
+	248, 240, 428, 0, 0, 0, 0, 0, 0, 397,
+	0, 0, 209, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 251, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 270, 0, 330, 233, 0,
+	0, 0, 0, 462, 0, 0, 0, 0, 0, 0,
+	0, 298, 0, 295, 188, 205, 0, 0, 340, 381,
+	387, 0, 0, 0, 231, 0, 385, 354, 446, 213,
+	259, 378, 359, 383, 366, 262, 0, 0, 384, 304,
+	433, 373, 443, 463, 464, 239, 334, 453, 422, 459,
+	475, 206, 236, 348, 415, 449, 406, 327, 429, 430,
+	294, 405, 268, 191, 302, 469, 204, 393, 221, 211,
+	197, 417, 441, 218, 396, 0, 0, 477, 199, 439,
+	414, 323, 291, 292, 198, 0, 377, 244, 266, 234,
+	343, 436, 437, 232, 478, 208, 458, 201, 0, 457,
+	336, 432, 440, 324, 314, 200, 438, 322, 313, 297,
+	255, 277, 371, 307, 372, 278, 332, 331, 333, 194,
+	450, 0, 195, 0, 411, 451, 479, 214, 215, 216,
+	0, 254, 258, 265, 267, 273, 274, 281, 300, 347,
+	370, 368, 374, 0, 427, 444, 454, 461, 467, 468,
+	470, 471, 472, 473, 474, 335, 280, 407, 296, 305,
+	0, 0, 353, 386, 219, 448, 408, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 480, 481, 482,
+	483, 484, 485, 486, 487, 488, 489, 490, 491, 492,
+	493, 494, 495, 496, 497, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 498, 315, 399, 445, 0,
+	187, 202, 301, 0, 375, 263, 476, 456, 0, 0,
+	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 452, 0, 238, 0, 0, 0, 0,
+	0, 0, 326, 0, 0, 0, 189, 190, 203, 212,
+	222, 237, 252, 260, 271, 276, 279, 284, 285, 288,
+	293, 311, 317, 318, 319, 320, 337, 338, 339, 342,
+	345, 346, 349, 351, 352, 355, 362, 363, 364, 365,
+	367, 369, 376, 380, 388, 389, 390, 391, 392, 394,
+	395, 401, 402, 403, 404, 412, 416, 434, 435, 447,
+	460, 465, 272, 442, 466, 0, 310, 0, 0, 312,
+	256, 275, 286, 0, 455, 413, 207, 382, 264, 196,
+	226, 210, 235, 250, 253, 290, 321, 328, 357, 361,
+	269, 247, 223, 379, 220, 398, 419, 420, 421, 423,
+	325, 242, 360, 424, 0, 308, 425, 426, 282, 0,
+	0, 0, 0, 0, 0, 344, 0, 1405, 0, 0,
+	0, 0, 0, 0, 246, 0, 0, 0, 0, 299,
+	243, 0, 0, 358, 0, 193, 0, 400, 230, 309,
+	306, 431, 257, 249, 0, 225, 245, 228, 283, 316,
+	356, 418, 350, 0, 303, 0, 0, 409, 329, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 289, 227, 192, 341, 410, 261,
 	0, 0, 0, 0, 184, 185, 186, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 217, 0, 224,
-	0, 0, 0, 0, 0, 239, 285, 246, 238, 425,
-	0, 0, 0, 0, 0, 0, 0, 209, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 249, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 241, 287, 248, 240, 428,
+	0, 0, 0, 0, 0, 0, 397, 0, 0, 209,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 251, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 270, 0, 330, 233, 0, 0, 0, 0,
+	462, 0, 0, 0, 0, 0, 0, 0, 298, 0,
+	295, 188, 205, 0, 0, 340, 381, 387, 0, 0,
+	0, 231, 0, 385, 354, 446, 213, 259, 378, 359,
+	383, 366, 262, 0, 0, 384, 304, 433, 373, 443,
+	463, 464, 239, 334, 453, 422, 459, 475, 206, 236,
+	348, 415, 449, 406, 327, 429, 430, 294, 405, 268,
+	191, 302, 469, 204, 393, 221, 211, 197, 417, 441,
+	218, 396, 0, 0, 477, 199, 439, 414, 323, 291,
+	292, 198, 0, 377, 244, 266, 234, 343, 436, 437,
+	232, 478, 208, 458, 201, 0, 457, 336, 432, 440,
+	324, 314, 200, 438, 322, 313, 297, 255, 277, 371,
+	307, 372, 278, 332, 331, 333, 194, 450, 0, 195,
+	0, 411, 451, 479, 214, 215, 216, 0, 254, 258,
+	265, 267, 273, 274, 281, 300, 347, 370, 368, 374,
+	0, 427, 444, 454, 461, 467, 468, 470, 471, 472,
+	473, 474, 335, 280, 407, 296, 305, 0, 0, 353,
+	386, 219, 448, 408, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 480, 481, 482, 483, 484, 485,
+	486, 487, 488, 489, 490, 491, 492, 493, 494, 495,
+	496, 497, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 498, 315, 399, 445, 0, 187, 202, 301,
+	0, 375, 263, 476, 456, 0, 0, 0, 229, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	452, 0, 238, 0, 0, 0, 0, 0, 0, 326,
+	0, 0, 0, 189, 190, 203, 212, 222, 237, 252,
+	260, 271, 276, 279, 284, 285, 288, 293, 311, 317,
+	318, 319, 320, 337, 338, 339, 342, 345, 346, 349,
+	351, 352, 355, 362, 363, 364, 365, 367, 369, 376,
+	380, 388, 389, 390, 391, 392, 394, 395, 401, 402,
+	403, 404, 412, 416, 434, 435, 447, 460, 465, 272,
+	442, 466, 0, 310, 0, 0, 312, 256, 275, 286,
+	0, 455, 413, 207, 382, 264, 196, 226, 210, 235,
+	250, 253, 290, 321, 328, 357, 361, 269, 247, 223,
+	379, 220, 398, 419, 420, 421, 423, 325, 242, 360,
+	424, 0, 308, 425, 426, 282, 0, 0, 0, 0,
+	0, 0, 344, 0, 1403, 0, 0, 0, 0, 0,
+	0, 246, 0, 0, 0, 0, 299, 243, 0, 0,
+	358, 0, 193, 0, 400, 230, 309, 306, 431, 257,
+	249, 0, 225, 245, 228, 283, 316, 356, 418, 350,
+	0, 303, 0, 0, 409, 329, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 289, 227, 192, 341, 410, 261, 0, 0, 0,
+	0, 184, 185, 186, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 217, 0, 224, 0, 0, 0,
+	0, 0, 241, 287, 248, 240, 428, 0, 0, 0,
+	0, 0, 0, 397, 0, 0, 209, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 251, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 270,
+	0, 330, 233, 0, 0, 0, 0, 462, 0, 0,
+	0, 0, 0, 0, 0, 298, 0, 295, 188, 205,
+	0, 0, 340, 381, 387, 0, 0, 0, 231, 0,
+	385, 354, 446, 213, 259, 378, 359, 383, 366, 262,
+	0, 0, 384, 304, 433, 373, 443, 463, 464, 239,
+	334, 453, 422, 459, 475, 206, 236, 348, 415, 449,
+	406, 327, 429, 430, 294, 405, 268, 191, 302, 469,
+	204, 393, 221, 211, 197, 417, 441, 218, 396, 0,
+	0, 477, 199, 439, 414, 323, 291, 292, 198, 0,
+	377, 244, 266, 234, 343, 436, 437, 232, 478, 208,
+	458, 201, 0, 457, 336, 432, 440, 324, 314, 200,
+	438, 322, 313, 297, 255, 277, 371, 307, 372, 278,
+	332, 331, 333, 194, 450, 0, 195, 0, 411, 451,
+	479, 214, 215, 216, 0, 254, 258, 265, 267, 273,
+	274, 281, 300, 347, 370, 368, 374, 0, 427, 444,
+	454, 461, 467, 468, 470, 471, 472, 473, 474, 335,
+	280, 407, 296, 305, 0, 0, 353, 386, 219, 448,
+	408, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 480, 481, 482, 483, 484, 485, 486, 487, 488,
+	489, 490, 491, 492, 493, 494, 495, 496, 497, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 498,
+	315, 399, 445, 0, 187, 202, 301, 0, 375, 263,
+	476, 456, 0, 0, 0, 229, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 452, 0, 238,
+	0, 0, 0, 0, 0, 0, 326, 0, 0, 0,
+	189, 190, 203, 212, 222, 237, 252, 260, 271, 276,
+	279, 284, 285, 288, 293, 311, 317, 318, 319, 320,
+	337, 338, 339, 342, 345, 346, 349, 351, 352, 355,
+	362, 363, 364, 365, 367, 369, 376, 380, 388, 389,
+	390, 391, 392, 394, 395, 401, 402, 403, 404, 412,
+	416, 434, 435, 447, 460, 465, 272, 442, 466, 0,
+	310, 0, 0, 312, 256, 275, 286, 0, 455, 413,
+	207, 382, 264, 196, 226, 210, 235, 250, 253, 290,
+	321, 328, 357, 361, 269, 247, 223, 379, 220, 398,
+	419, 420, 421, 423, 325, 242, 360, 424, 0, 308,
+	425, 426, 282, 0, 0, 0, 0, 0, 0, 344,
+	0, 1401, 0, 0, 0, 0, 0, 0, 246, 0,
+	0, 0, 0, 299, 243, 0, 0, 358, 0, 193,
+	0, 400, 230, 309, 306, 431, 257, 249, 0, 225,
+	245, 228, 283, 316, 356, 418, 350, 0, 303, 0,
+	0, 409, 329, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 289, 227,
+	192, 341, 410, 261, 0, 0, 0, 0, 184, 185,
+	186, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 217, 0, 224, 0, 0, 0, 0, 0, 241,
+	287, 248, 240, 428, 0, 0, 0, 0, 0, 0,
+	397, 0, 0, 209, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 251, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 551, 0,
-	268, 0, 328, 231, 0, 0, 0, 0, 459, 0,
-	0, 0, 0, 0, 0, 0, 296, 0, 293, 188,
-	205, 0, 0, 338, 379, 385, 0, 0, 0, 229,
-	0, 383, 352, 443, 213, 257, 376, 357, 381, 364,
-	260, 0, 0, 382, 302, 430, 371, 440, 460, 461,
-	237, 332, 450, 419, 456, 472, 206, 234, 346, 412,
-	446, 403, 325, 426, 427, 292, 402, 266, 191, 300,
-	466, 204, 391, 221, 211, 197, 414, 438, 218, 394,
-	0, 0, 474, 199, 436, 411, 321, 289, 290, 198,
-	0, 375, 242, 264, 232, 341, 433, 434, 230, 475,
-	208, 455, 201, 0, 454, 334, 429, 437, 322, 312,
-	200, 435, 320, 311, 295, 253, 275, 369, 305, 370,
-	276, 330, 329, 331, 194, 447, 0, 195, 0, 408,
-	448, 476, 214, 215, 216, 0, 252, 256, 263, 265,
-	271, 272, 279, 298, 345, 368, 366, 372, 0, 424,
-	441, 451, 458, 464, 465, 467, 468, 469, 470, 471,
-	333, 278, 404, 294, 303, 0, 0, 351, 384, 219,
-	445, 405, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 477, 478, 479, 480, 481, 482, 483, 484,
-	485, 486, 487, 488, 489, 490, 491, 492, 493, 494,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	495, 313, 396, 442, 0, 187, 202, 299, 0, 373,
-	261, 473, 453, 449, 0, 0, 236, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 324, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	190, 203, 212, 222, 235, 250, 258, 269, 274, 277,
-	282, 283, 286, 291, 309, 315, 316, 317, 318, 335,
-	336, 337, 340, 343, 344, 347, 349, 350, 353, 360,
-	361, 362, 363, 365, 367, 374, 378, 386, 387, 388,
-	389, 390, 392, 393, 398, 399, 400, 401, 409, 413,
-	431, 432, 444, 457, 462, 550, 439, 463, 0, 308,
-	0, 0, 310, 254, 273, 284, 0, 452, 410, 207,
-	380, 262, 196, 225, 210, 233, 248, 251, 288, 319,
-	326, 355, 359, 267, 245, 223, 377, 220, 395, 416,
-	417, 418, 420, 323, 240, 358, 421, 0, 306, 422,
-	423, 280, 0, 0, 0, 0, 0, 0, 342, 0,
-	0, 0, 0, 0, 0, 0, 0, 244, 0, 0,
-	0, 0, 297, 241, 0, 0, 356, 0, 193, 0,
-	397, 228, 307, 304, 428, 255, 247, 243, 227, 281,
-	314, 354, 415, 348, 0, 301, 0, 0, 406, 327,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 287, 226, 192, 339, 407,
-	259, 0, 0, 0, 0, 184, 185, 186, 0, 0,
+	0, 0, 0, 0, 0, 0, 270, 0, 330, 233,
+	0, 0, 0, 0, 462, 0, 0, 0, 0, 0,
+	0, 0, 298, 0, 295, 188, 205, 0, 0, 340,
+	381, 387, 0, 0, 0, 231, 0, 385, 354, 446,
+	213, 259, 378, 359, 383, 366, 262, 0, 0, 384,
+	304, 433, 373, 443, 463, 464, 239, 334, 453, 422,
+	459, 475, 206, 236, 348, 415, 449, 406, 327, 429,
+	430, 294, 405, 268, 191, 302, 469, 204, 393, 221,
+	211, 197, 417, 441, 218, 396, 0, 0, 477, 199,
+	439, 414, 323, 291, 292, 198, 0, 377, 244, 266,
+	234, 343, 436, 437, 232, 478, 208, 458, 201, 0,
+	457, 336, 432, 440, 324, 314, 200, 438, 322, 313,
+	297, 255, 277, 371, 307, 372, 278, 332, 331, 333,
+	194, 450, 0, 195, 0, 411, 451, 479, 214, 215,
+	216, 0, 254, 258, 265, 267, 273, 274, 281, 300,
+	347, 370, 368, 374, 0, 427, 444, 454, 461, 467,
+	468, 470, 471, 472, 473, 474, 335, 280, 407, 296,
+	305, 0, 0, 353, 386, 219, 448, 408, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 480, 481,
+	482, 483, 484, 485, 486, 487, 488, 489, 490, 491,
+	492, 493, 494, 495, 496, 497, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 498, 315, 399, 445,
+	0, 187, 202, 301, 0, 375, 263, 476, 456, 0,
+	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 452, 0, 238, 0, 0, 0,
+	0, 0, 0, 326, 0, 0, 0, 189, 190, 203,
+	212, 222, 237, 252, 260, 271, 276, 279, 284, 285,
+	288, 293, 311, 317, 318, 319, 320, 337, 338, 339,
+	342, 345, 346, 349, 351, 352, 355, 362, 363, 364,
+	365, 367, 369, 376, 380, 388, 389, 390, 391, 392,
+	394, 395, 401, 402, 403, 404, 412, 416, 434, 435,
+	447, 460, 465, 272, 442, 466, 0, 310, 0, 0,
+	312, 256, 275, 286, 0, 455, 413, 207, 382, 264,
+	196, 226, 210, 235, 250, 253, 290, 321, 328, 357,
+	361, 269, 247, 223, 379, 220, 398, 419, 420, 421,
+	423, 325, 242, 360, 424, 0, 308, 425, 426, 282,
+	0, 0, 0, 0, 0, 0, 344, 0, 1399, 0,
+	0, 0, 0, 0, 0, 246, 0, 0, 0, 0,
+	299, 243, 0, 0, 358, 0, 193, 0, 400, 230,
+	309, 306, 431, 257, 249, 0, 225, 245, 228, 283,
+	316, 356, 418, 350, 0, 303, 0, 0, 409, 329,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 289, 227, 192, 341, 410,
+	261, 0, 0, 0, 0, 184, 185, 186, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
-	224, 0, 0, 0, 0, 0, 239, 285, 246, 238,
-	425, 0, 0, 0, 0, 0, 0, 0, 209, 0,
+	224, 0, 0, 0, 0, 0, 241, 287, 248, 240,
+	428, 0, 0, 0, 0, 0, 0, 397, 0, 0,
+	209, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 251, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 249, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 270, 0, 330, 233, 0, 0, 0,
+	0, 462, 0, 0, 0, 0, 0, 0, 0, 298,
+	0, 295, 188, 205, 0, 0, 340, 381, 387, 0,
+	0, 0, 231, 0, 385, 354, 446, 213, 259, 378,
+	359, 383, 366, 262, 0, 0, 384, 304, 433, 373,
+	443, 463, 464, 239, 334, 453, 422, 459, 475, 206,
+	236, 348, 415, 449, 406, 327, 429, 430, 294, 405,
+	268, 191, 302, 469, 204, 393, 221, 211, 197, 417,
+	441, 218, 396, 0, 0, 477, 199, 439, 414, 323,
+	291, 292, 198, 0, 377, 244, 266, 234, 343, 436,
+	437, 232, 478, 208, 458, 201, 0, 457, 336, 432,
+	440, 324, 314, 200, 438, 322, 313, 297, 255, 277,
+	371, 307, 372, 278, 332, 331, 333, 194, 450, 0,
+	195, 0, 411, 451, 479, 214, 215, 216, 0, 254,
+	258, 265, 267, 273, 274, 281, 300, 347, 370, 368,
+	374, 0, 427, 444, 454, 461, 467, 468, 470, 471,
+	472, 473, 474, 335, 280, 407, 296, 305, 0, 0,
+	353, 386, 219, 448, 408, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 480, 481, 482, 483, 484,
+	485, 486, 487, 488, 489, 490, 491, 492, 493, 494,
+	495, 496, 497, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 498, 315, 399, 445, 0, 187, 202,
+	301, 0, 375, 263, 476, 456, 0, 0, 0, 229,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 452, 0, 238, 0, 0, 0, 0, 0, 0,
+	326, 0, 0, 0, 189, 190, 203, 212, 222, 237,
+	252, 260, 271, 276, 279, 284, 285, 288, 293, 311,
+	317, 318, 319, 320, 337, 338, 339, 342, 345, 346,
+	349, 351, 352, 355, 362, 363, 364, 365, 367, 369,
+	376, 380, 388, 389, 390, 391, 392, 394, 395, 401,
+	402, 403, 404, 412, 416, 434, 435, 447, 460, 465,
+	272, 442, 466, 0, 310, 0, 0, 312, 256, 275,
+	286, 0, 455, 413, 207, 382, 264, 196, 226, 210,
+	235, 250, 253, 290, 321, 328, 357, 361, 269, 247,
+	223, 379, 220, 398, 419, 420, 421, 423, 325, 242,
+	360, 424, 0, 308, 425, 426, 282, 0, 0, 0,
+	0, 0, 0, 344, 0, 1395, 0, 0, 0, 0,
+	0, 0, 246, 0, 0, 0, 0, 299, 243, 0,
+	0, 358, 0, 193, 0, 400, 230, 309, 306, 431,
+	257, 249, 0, 225, 245, 228, 283, 316, 356, 418,
+	350, 0, 303, 0, 0, 409, 329, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 289, 227, 192, 341, 410, 261, 0, 0,
+	0, 0, 184, 185, 186, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 217, 0, 224, 0, 0,
+	0, 0, 0, 241, 287, 248, 240, 428, 0, 0,
+	0, 0, 0, 0, 397, 0, 0, 209, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 251, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	270, 0, 330, 233, 0, 0, 0, 0, 462, 0,
+	0, 0, 0, 0, 0, 0, 298, 0, 295, 188,
+	205, 0, 0, 340, 381, 387, 0, 0, 0, 231,
+	0, 385, 354, 446, 213, 259, 378, 359, 383, 366,
+	262, 0, 0, 384, 304, 433, 373, 443, 463, 464,
+	239, 334, 453, 422, 459, 475, 206, 236, 348, 415,
+	449, 406, 327, 429, 430, 294, 405, 268, 191, 302,
+	469, 204, 393, 221, 211, 197, 417, 441, 218, 396,
+	0, 0, 477, 199, 439, 414, 323, 291, 292, 198,
+	0, 377, 244, 266, 234, 343, 436, 437, 232, 478,
+	208, 458, 201, 0, 457, 336, 432, 440, 324, 314,
+	200, 438, 322, 313, 297, 255, 277, 371, 307, 372,
+	278, 332, 331, 333, 194, 450, 0, 195, 0, 411,
+	451, 479, 214, 215, 216, 0, 254, 258, 265, 267,
+	273, 274, 281, 300, 347, 370, 368, 374, 0, 427,
+	444, 454, 461, 467, 468, 470, 471, 472, 473, 474,
+	335, 280, 407, 296, 305, 0, 0, 353, 386, 219,
+	448, 408, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 480, 481, 482, 483, 484, 485, 486, 487,
+	488, 489, 490, 491, 492, 493, 494, 495, 496, 497,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	498, 315, 399, 445, 0, 187, 202, 301, 0, 375,
+	263, 476, 456, 0, 0, 0, 229, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 452, 0,
+	238, 0, 0, 0, 0, 0, 0, 326, 0, 0,
+	0, 189, 190, 203, 212, 222, 237, 252, 260, 271,
+	276, 279, 284, 285, 288, 293, 311, 317, 318, 319,
+	320, 337, 338, 339, 342, 345, 346, 349, 351, 352,
+	355, 362, 363, 364, 365, 367, 369, 376, 380, 388,
+	389, 390, 391, 392, 394, 395, 401, 402, 403, 404,
+	412, 416, 434, 435, 447, 460, 465, 272, 442, 466,
+	0, 310, 0, 0, 312, 256, 275, 286, 0, 455,
+	413, 207, 382, 264, 196, 226, 210, 235, 250, 253,
+	290, 321, 328, 357, 361, 269, 247, 223, 379, 220,
+	398, 419, 420, 421, 423, 325, 242, 360, 424, 0,
+	308, 425, 426, 282, 0, 0, 0, 0, 0, 0,
+	344, 0, 1393, 0, 0, 0, 0, 0, 0, 246,
+	0, 0, 0, 0, 299, 243, 0, 0, 358, 0,
+	193, 0, 400, 230, 309, 306, 431, 257, 249, 0,
+	225, 245, 228, 283, 316, 356, 418, 350, 0, 303,
+	0, 0, 409, 329, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 289,
+	227, 192, 341, 410, 261, 0, 0, 0, 0, 184,
+	185, 186, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 217, 0, 224, 0, 0, 0, 0, 0,
+	241, 287, 248, 240, 428, 0, 0, 0, 0, 0,
+	0, 397, 0, 0, 209, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 251,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 268, 0, 328, 231, 0, 0, 498, 0, 459,
-	0, 0, 0, 0, 0, 0, 0, 296, 0, 293,
-	188, 205, 0, 0, 338, 379, 385, 0, 0, 0,
-	229, 0, 383, 352, 443, 213, 257, 376, 357, 381,
-	364, 260, 0, 0, 382, 302, 430, 371, 440, 460,
-	461, 237, 332, 450, 419, 456, 472, 206, 234, 346,
-	412, 446, 403, 325, 426, 427, 292, 402, 266, 191,
-	300, 466, 204, 391, 221, 211, 197, 414, 438, 218,
-	394, 0, 0, 474, 199, 436, 411, 321, 289, 290,
-	198, 0, 375, 242, 264, 232, 341, 433, 434, 230,
-	475, 208, 455, 201, 0, 454, 334, 429, 437, 322,
-	312, 200, 435, 320, 311, 295, 253, 275, 369, 305,
-	370, 276, 330, 329, 331, 194, 447, 0, 195, 0,
-	408, 448, 476, 214, 215, 216, 0, 252, 256, 263,
-	265, 271, 272, 279, 298, 345, 368, 366, 372, 0,
-	424, 441, 451, 458, 464, 465, 467, 468, 469, 470,
-	471, 333, 278, 404, 294, 303, 0, 0, 351, 384,
-	219, 445, 405, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 477, 478, 479, 480, 481, 482, 483,
-	484, 485, 486, 487, 488, 489, 490, 491, 492, 493,
-	494, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 495, 313, 396, 442, 0, 187, 202, 299, 0,
-	373, 261, 473, 453, 449, 0, 0, 236, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 324,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	189, 190, 203, 212, 222, 235, 250, 258, 269, 274,
-	277, 282, 283, 286, 291, 309, 315, 316, 317, 318,
-	335, 336, 337, 340, 343, 344, 347, 349, 350, 353,
-	360, 361, 362, 363, 365, 367, 374, 378, 386, 387,
-	388, 389, 390, 392, 393, 398, 399, 400, 401, 409,
-	413, 431, 432, 444, 457, 462, 270, 439, 463, 0,
-	308, 0, 0, 310, 254, 273, 284, 0, 452, 410,
-	207, 380, 262, 196, 225, 210, 233, 248, 251, 288,
-	319, 326, 355, 359, 267, 245, 223, 377, 220, 395,
-	416, 417, 418, 420, 323, 240, 358, 421, 0, 306,
-	422, 423, 280, 0, 0, 0, 0, 0, 0, 342,
-	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
-	0, 0, 0, 297, 241, 0, 0, 356, 0, 193,
-	0, 397, 228, 307, 304, 428, 255, 247, 243, 227,
-	281, 314, 354, 415, 348, 0, 301, 0, 0, 406,
-	327, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 287, 226, 192, 339,
-	407, 259, 0, 0, 0, 0, 184, 185, 186, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 270, 0, 330,
+	233, 0, 0, 0, 0, 462, 0, 0, 0, 0,
+	0, 0, 0, 298, 0, 295, 188, 205, 0, 0,
+	340, 381, 387, 0, 0, 0, 231, 0, 385, 354,
+	446, 213, 259, 378, 359, 383, 366, 262, 0, 0,
+	384, 304, 433, 373, 443, 463, 464, 239, 334, 453,
+	422, 459, 475, 206, 236, 348, 415, 449, 406, 327,
+	429, 430, 294, 405, 268, 191, 302, 469, 204, 393,
+	221, 211, 197, 417, 441, 218, 396, 0, 0, 477,
+	199, 439, 414, 323, 291, 292, 198, 0, 377, 244,
+	266, 234, 343, 436, 437, 232, 478, 208, 458, 201,
+	0, 457, 336, 432, 440, 324, 314, 200, 438, 322,
+	313, 297, 255, 277, 371, 307, 372, 278, 332, 331,
+	333, 194, 450, 0, 195, 0, 411, 451, 479, 214,
+	215, 216, 0, 254, 258, 265, 267, 273, 274, 281,
+	300, 347, 370, 368, 374, 0, 427, 444, 454, 461,
+	467, 468, 470, 471, 472, 473, 474, 335, 280, 407,
+	296, 305, 0, 0, 353, 386, 219, 448, 408, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 480,
+	481, 482, 483, 484, 485, 486, 487, 488, 489, 490,
+	491, 492, 493, 494, 495, 496, 497, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 498, 315, 399,
+	445, 0, 187, 202, 301, 0, 375, 263, 476, 456,
+	0, 0, 0, 229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 452, 0, 238, 0, 0,
+	0, 0, 0, 0, 326, 0, 0, 0, 189, 190,
+	203, 212, 222, 237, 252, 260, 271, 276, 279, 284,
+	285, 288, 293, 311, 317, 318, 319, 320, 337, 338,
+	339, 342, 345, 346, 349, 351, 352, 355, 362, 363,
+	364, 365, 367, 369, 376, 380, 388, 389, 390, 391,
+	392, 394, 395, 401, 402, 403, 404, 412, 416, 434,
+	435, 447, 460, 465, 272, 442, 466, 0, 310, 0,
+	0, 312, 256, 275, 286, 0, 455, 413, 207, 382,
+	264, 196, 226, 210, 235, 250, 253, 290, 321, 328,
+	357, 361, 269, 247, 223, 379, 220, 398, 419, 420,
+	421, 423, 325, 242, 360, 424, 0, 308, 425, 426,
+	282, 0, 0, 0, 0, 0, 0, 344, 0, 1391,
+	0, 0, 0, 0, 0, 0, 246, 0, 0, 0,
+	0, 299, 243, 0, 0, 358, 0, 193, 0, 400,
+	230, 309, 306, 431, 257, 249, 0, 225, 245, 228,
+	283, 316, 356, 418, 350, 0, 303, 0, 0, 409,
+	329, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 289, 227, 192, 341,
+	410, 261, 0, 0, 0, 0, 184, 185, 186, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 217,
-	0, 224, 0, 0, 0, 0, 0, 239, 285, 246,
-	238, 425, 0, 0, 0, 0, 0, 0, 0, 209,
+	0, 224, 0, 0, 0, 0, 0, 241, 287, 248,
+	240, 428, 0, 0, 0, 0, 0, 0, 397, 0,
+	0, 209, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 251, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 249, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 270, 0, 330, 233, 0, 0,
+	0, 0, 462, 0, 0, 0, 0, 0, 0, 0,
+	298, 0, 295, 188, 205, 0, 0, 340, 381, 387,
+	0, 0, 0, 231, 0, 385, 354, 446, 213, 259,
+	378, 359, 383, 366, 262, 0, 0, 384, 304, 433,
+	373, 443, 463, 464, 239, 334, 453, 422, 459, 475,
+	206, 236, 348, 415, 449, 406, 327, 429, 430, 294,
+	405, 268, 191, 302, 469, 204, 393, 221, 211, 197,
+	417, 441, 218, 396, 0, 0, 477, 199, 439, 414,
+	323, 291, 292, 198, 0, 377, 244, 266, 234, 343,
+	436, 437, 232, 478, 208, 458, 201, 0, 457, 336,
+	432, 440, 324, 314, 200, 438, 322, 313, 297, 255,
+	277, 371, 307, 372, 278, 332, 331, 333, 194, 450,
+	0, 195, 0, 411, 451, 479, 214, 215, 216, 0,
+	254, 258, 265, 267, 273, 274, 281, 300, 347, 370,
+	368, 374, 0, 427, 444, 454, 461, 467, 468, 470,
+	471, 472, 473, 474, 335, 280, 407, 296, 305, 0,
+	0, 353, 386, 219, 448, 408, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 492, 493,
+	494, 495, 496, 497, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 498, 315, 399, 445, 0, 187,
+	202, 301, 0, 375, 263, 476, 456, 0, 0, 0,
+	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 452, 0, 238, 0, 0, 0, 0, 0,
+	0, 326, 0, 0, 0, 189, 190, 203, 212, 222,
+	237, 252, 260, 271, 276, 279, 284, 285, 288, 293,
+	311, 317, 318, 319, 320, 337, 338, 339, 342, 345,
+	346, 349, 351, 352, 355, 362, 363, 364, 365, 367,
+	369, 376, 380, 388, 389, 390, 391, 392, 394, 395,
+	401, 402, 403, 404, 412, 416, 434, 435, 447, 460,
+	465, 272, 442, 466, 0, 310, 0, 0, 312, 256,
+	275, 286, 0, 455, 413, 207, 382, 264, 196, 226,
+	210, 235, 250, 253, 290, 321, 328, 357, 361, 269,
+	247, 223, 379, 220, 398, 419, 420, 421, 423, 325,
+	242, 360, 424, 0, 308, 425, 426, 282, 0, 0,
+	0, 0, 0, 0, 344, 0, 0, 0, 0, 0,
+	0, 0, 0, 246, 0, 0, 0, 0, 299, 243,
+	0, 0, 358, 0, 193, 0, 400, 230, 309, 306,
+	431, 257, 249, 0, 225, 245, 228, 283, 316, 356,
+	418, 350, 0, 303, 0, 0, 409, 329, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 289, 227, 192, 341, 410, 261, 0,
+	1368, 0, 0, 184, 185, 186, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 217, 0, 224, 0,
+	0, 0, 0, 0, 241, 287, 248, 240, 428, 0,
+	0, 0, 0, 0, 0, 397, 0, 0, 209, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 270, 0, 330, 233, 0, 0, 0, 0, 462,
+	0, 0, 0, 0, 0, 0, 0, 298, 0, 295,
+	188, 205, 0, 0, 340, 381, 387, 0, 0, 0,
+	231, 0, 385, 354, 446, 213, 259, 378, 359, 383,
+	366, 262, 0, 0, 384, 304, 433, 373, 443, 463,
+	464, 239, 334, 453, 422, 459, 475, 206, 236, 348,
+	415, 449, 406, 327, 429, 430, 294, 405, 268, 191,
+	302, 469, 204, 393, 221, 211, 197, 417, 441, 218,
+	396, 0, 0, 477, 199, 439, 414, 323, 291, 292,
+	198, 0, 377, 244, 266, 234, 343, 436, 437, 232,
+	478, 208, 458, 201, 0, 457, 336, 432, 440, 324,
+	314, 200, 438, 322, 313, 297, 255, 277, 371, 307,
+	372, 278, 332, 331, 333, 194, 450, 0, 195, 0,
+	411, 451, 479, 214, 215, 216, 0, 254, 258, 265,
+	267, 273, 274, 281, 300, 347, 370, 368, 374, 0,
+	427, 444, 454, 461, 467, 468, 470, 471, 472, 473,
+	474, 335, 280, 407, 296, 305, 0, 0, 353, 386,
+	219, 448, 408, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 480, 481, 482, 483, 484, 485, 486,
+	487, 488, 489, 490, 491, 492, 493, 494, 495, 496,
+	497, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 498, 315, 399, 445, 0, 187, 202, 301, 0,
+	375, 263, 476, 456, 0, 0, 0, 229, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 452,
+	0, 238, 0, 0, 0, 0, 0, 0, 326, 0,
+	0, 0, 189, 190, 203, 212, 222, 237, 252, 260,
+	271, 276, 279, 284, 285, 288, 293, 311, 317, 318,
+	319, 320, 337, 338, 339, 342, 345, 346, 349, 351,
+	352, 355, 362, 363, 364, 365, 367, 369, 376, 380,
+	388, 389, 390, 391, 392, 394, 395, 401, 402, 403,
+	404, 412, 416, 434, 435, 447, 460, 465, 272, 442,
+	466, 0, 310, 0, 0, 312, 256, 275, 286, 0,
+	455, 413, 207, 382, 264, 196, 226, 210, 235, 250,
+	253, 290, 321, 328, 357, 361, 269, 247, 223, 379,
+	220, 398, 419, 420, 421, 423, 325, 242, 360, 424,
+	0, 308, 425, 426, 282, 0, 0, 0, 0, 0,
+	0, 344, 0, 0, 0, 0, 0, 0, 0, 1270,
+	246, 0, 0, 0, 0, 299, 243, 0, 0, 358,
+	0, 193, 0, 400, 230, 309, 306, 431, 257, 249,
+	0, 225, 245, 228, 283, 316, 356, 418, 350, 0,
+	303, 0, 0, 409, 329, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	289, 227, 192, 341, 410, 261, 0, 0, 0, 0,
+	184, 185, 186, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 217, 0, 224, 0, 0, 0, 0,
+	0, 241, 287, 248, 240, 428, 0, 0, 0, 0,
+	0, 0, 397, 0, 0, 209, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 268, 0, 328, 231, 0, 0, 0, 0,
-	459, 0, 0, 0, 0, 0, 0, 0, 296, 0,
-	293, 188, 205, 0, 0, 338, 379, 385, 0, 0,
-	0, 229, 0, 383, 352, 443, 213, 257, 376, 357,
-	381, 364, 260, 0, 0, 382, 302, 430, 371, 440,
-	460, 461, 237, 332, 450, 419, 456, 472, 206, 234,
-	346, 412, 446, 403, 325, 426, 427, 292, 402, 266,
-	191, 300, 466, 204, 391, 221, 211, 197, 414, 438,
-	218, 394, 0, 0, 474, 199, 436, 411, 321, 289,
-	290, 198, 0, 375, 242, 264, 232, 341, 433, 434,
-	230, 475, 208, 455, 201, 0, 454, 334, 429, 437,
-	322, 312, 200, 435, 320, 311, 295, 253, 275, 369,
-	305, 370, 276, 330, 329, 331, 194, 447, 0, 195,
-	0, 408, 448, 476, 214, 215, 216, 0, 252, 256,
-	263, 265, 271, 272, 279, 298, 345, 368, 366, 372,
-	0, 424, 441, 451, 458, 464, 465, 467, 468, 469,
-	470, 471, 333, 278, 404, 294, 303, 0, 0, 351,
-	384, 219, 445, 405, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 477, 478, 479, 480, 481, 482,
-	483, 484, 485, 486, 487, 488, 489, 490, 491, 492,
-	493, 494, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 495, 313, 396, 442, 0, 187, 202, 299,
-	0, 373, 261, 473, 453, 449, 0, 0, 236, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	324, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 189, 190, 203, 212, 222, 235, 250, 258, 269,
-	274, 277, 282, 283, 286, 291, 309, 315, 316, 317,
-	318, 335, 336, 337, 340, 343, 344, 347, 349, 350,
-	353, 360, 361, 362, 363, 365, 367, 374, 378, 386,
-	387, 388, 389, 390, 392, 393, 398, 399, 400, 401,
-	409, 413, 431, 432, 444, 457, 462, 270, 439, 463,
-	0, 308, 0, 0, 310, 254, 273, 284, 0, 452,
-	410, 207, 380, 262, 196, 225, 210, 233, 248, 251,
-	288, 319, 326, 355, 359, 267, 245, 223, 377, 220,
-	395, 416, 417, 418, 420, 323, 240, 358, 421, 0,
-	306, 422, 423, 280, 0, 0, 0, 0, 0, 0,
-	342, 0, 0, 0, 0, 0, 0, 0, 0, 244,
-	0, 0, 0, 0, 297, 241, 0, 0, 356, 0,
-	193, 0, 397, 228, 307, 304, 428, 255, 247, 243,
-	227, 281, 314, 354, 415, 348, 0, 301, 0, 0,
-	406, 327, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 287, 226, 192,
-	339, 407, 259, 0, 0, 0, 0, 184, 2276, 186,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	217, 0, 224, 0, 0, 0, 0, 0, 239, 285,
-	246, 238, 425, 0, 0, 0, 0, 0, 0, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 249, 0, 0, 0, 0,
+	251, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 270, 0,
+	330, 233, 0, 0, 0, 0, 462, 0, 0, 0,
+	0, 0, 0, 0, 298, 0, 295, 188, 205, 0,
+	0, 340, 381, 387, 0, 0, 0, 231, 0, 385,
+	354, 446, 213, 259, 378, 359, 383, 366, 262, 0,
+	0, 384, 304, 433, 373, 443, 463, 464, 239, 334,
+	453, 422, 459, 475, 206, 236, 348, 415, 449, 406,
+	327, 429, 430, 294, 405, 268, 191, 302, 469, 204,
+	393, 221, 211, 197, 417, 441, 218, 396, 0, 0,
+	477, 199, 439, 414, 323, 291, 292, 198, 0, 377,
+	244, 266, 234, 343, 436, 437, 232, 478, 208, 458,
+	201, 0, 457, 336, 432, 440, 324, 314, 200, 438,
+	322, 313, 297, 255, 277, 371, 307, 372, 278, 332,
+	331, 333, 194, 450, 0, 195, 0, 411, 451, 479,
+	214, 215, 216, 0, 254, 258, 265, 267, 273, 274,
+	281, 300, 347, 370, 368, 374, 0, 427, 444, 454,
+	461, 467, 468, 470, 471, 472, 473, 474, 335, 280,
+	407, 296, 305, 0, 0, 353, 386, 219, 448, 408,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 268, 0, 328, 231, 0, 0, 0,
-	0, 459, 0, 0, 0, 0, 0, 0, 0, 296,
-	0, 293, 188, 205, 0, 0, 338, 379, 385, 0,
-	0, 0, 229, 0, 383, 352, 443, 213, 257, 376,
-	357, 381, 364, 260, 0, 0, 382, 302, 430, 371,
-	440, 460, 461, 237, 332, 450, 419, 456, 472, 206,
-	234, 346, 412, 446, 403, 325, 426, 427, 292, 402,
-	266, 191, 300, 466, 204, 391, 221, 211, 197, 414,
-	438, 218, 394, 0, 0, 474, 199, 436, 411, 321,
-	289, 290, 198, 0, 375, 242, 264, 232, 341, 433,
-	434, 230, 475, 208, 455, 201, 0, 454, 334, 429,
-	437, 322, 312, 200, 435, 320, 311, 295, 253, 275,
-	369, 305, 370, 276, 330, 329, 331, 194, 447, 0,
-	195, 0, 408, 448, 476, 214, 215, 216, 0, 252,
-	256, 263, 265, 271, 272, 279, 298, 345, 368, 366,
-	372, 0, 424, 441, 451, 458, 464, 465, 467, 468,
-	469, 470, 471, 333, 278, 404, 294, 303, 0, 0,
-	351, 384, 219, 445, 405, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 477, 478, 479, 480, 481,
-	482, 483, 484, 485, 486, 487, 488, 489, 490, 491,
-	492, 493, 494, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 495, 313, 396, 442, 0, 187, 202,
-	299, 0, 373, 261, 473, 453, 449, 0, 0, 236,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 324, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 189, 190, 203, 212, 222, 235, 250, 258,
-	269, 274, 277, 282, 283, 286, 291, 309, 315, 316,
-	317, 318, 335, 336, 337, 340, 343, 344, 347, 349,
-	350, 353, 360, 361, 362, 363, 365, 367, 374, 378,
-	386, 387, 388, 389, 390, 392, 393, 398, 399, 400,
-	401, 409, 413, 431, 432, 444, 457, 462, 270, 439,
-	463, 0, 308, 0, 0, 310, 254, 273, 284, 0,
-	452, 410, 207, 380, 262, 196, 225, 210, 233, 248,
-	251, 288, 319, 326, 355, 359, 267, 245, 223, 377,
-	220, 395, 416, 417, 418, 420, 323, 240, 358, 421,
-	0, 306, 422, 423, 280, 0, 0, 0, 0, 0,
-	0, 342, 0, 0, 0, 0, 0, 0, 0, 0,
-	244, 0, 0, 0, 0, 297, 241, 0, 0, 356,
-	0, 193, 0, 397, 228, 307, 304, 428, 255, 247,
-	243, 227, 281, 314, 354, 415, 348, 0, 301, 0,
-	0, 406, 327, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 287, 226,
-	192, 339, 407, 259, 0, 0, 0, 0, 184, 1885,
-	186, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 217, 0, 224, 0, 0, 0, 0, 0, 239,
-	285, 246, 238, 425, 0, 0, 0, 0, 0, 0,
-	0, 209, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 249, 0, 0, 0,
+	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
+	490, 491, 492, 493, 494, 495, 496, 497, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 498, 315,
+	399, 445, 0, 187, 202, 301, 0, 375, 263, 476,
+	456, 0, 0, 0, 229, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 452, 0, 238, 0,
+	0, 0, 0, 0, 0, 326, 0, 0, 0, 189,
+	190, 203, 212, 222, 237, 252, 260, 271, 276, 279,
+	284, 285, 288, 293, 311, 317, 318, 319, 320, 337,
+	338, 339, 342, 345, 346, 349, 351, 352, 355, 362,
+	363, 364, 365, 367, 369, 376, 380, 388, 389, 390,
+	391, 392, 394, 395, 401, 402, 403, 404, 412, 416,
+	434, 435, 447, 460, 465, 272, 442, 466, 0, 310,
+	0, 0, 312, 256, 275, 286, 0, 455, 413, 207,
+	382, 264, 196, 226, 210, 235, 250, 253, 290, 321,
+	328, 357, 361, 269, 247, 223, 379, 220, 398, 419,
+	420, 421, 423, 325, 242, 360, 424, 0, 308, 425,
+	426, 282, 0, 0, 0, 0, 0, 0, 344, 0,
+	0, 0, 0, 0, 0, 0, 0, 246, 0, 0,
+	0, 0, 299, 243, 0, 0, 358, 0, 193, 0,
+	400, 230, 309, 306, 431, 257, 249, 0, 225, 245,
+	228, 283, 316, 356, 418, 350, 0, 303, 0, 0,
+	409, 329, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 289, 227, 192,
+	341, 410, 261, 0, 0, 0, 0, 184, 185, 186,
+	0, 1088, 0, 0, 0, 0, 0, 0, 0, 0,
+	217, 0, 224, 0, 0, 0, 0, 0, 241, 287,
+	248, 240, 428, 0, 0, 0, 0, 0, 0, 397,
+	0, 0, 209, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 251, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -4870,57 +4841,222 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 268, 0, 328, 231, 0, 0,
-	0, 0, 459, 0, 0, 0, 0, 0, 0, 0,
-	296, 0, 293, 188, 205, 0, 0, 338, 379, 385,
-	0, 0, 0, 229, 0, 383, 352, 443, 213, 257,
-	376, 357, 381, 364, 260, 0, 0, 382, 302, 430,
-	371, 440, 460, 461, 237, 332, 450, 419, 456, 472,
-	206, 234, 346, 412, 446, 403, 325, 426, 427, 292,
-	402, 266, 191, 300, 466, 204, 391, 221, 211, 197,
-	414, 438, 218, 394, 0, 0, 474, 199, 436, 411,
-	321, 289, 290, 198, 0, 375, 242, 264, 232, 341,
-	433, 434, 230, 475, 208, 455, 201, 0, 454, 334,
-	429, 437, 322, 312, 200, 435, 320, 311, 295, 253,
-	275, 369, 305, 370, 276, 330, 329, 331, 194, 447,
-	0, 195, 0, 408, 448, 476, 214, 215, 216, 0,
-	252, 256, 263, 265, 271, 272, 279, 298, 345, 368,
-	366, 372, 0, 424, 441, 451, 458, 464, 465, 467,
-	468, 469, 470, 471, 333, 278, 404, 294, 303, 0,
-	0, 351, 384, 219, 445, 405, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 477, 478, 479, 480,
-	481, 482, 483, 484, 485, 486, 487, 488, 489, 490,
-	491, 492, 493, 494, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 495, 313, 396, 442, 0, 187,
-	202, 299, 0, 373, 261, 473, 453, 449, 0, 0,
-	236, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 324, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 189, 190, 203, 212, 222, 235, 250,
-	258, 269, 274, 277, 282, 283, 286, 291, 309, 315,
-	316, 317, 318, 335, 336, 337, 340, 343, 344, 347,
-	349, 350, 353, 360, 361, 362, 363, 365, 367, 374,
-	378, 386, 387, 388, 389, 390, 392, 393, 398, 399,
-	400, 401, 409, 413, 431, 432, 444, 457, 462, 270,
-	439, 463, 0, 308, 0, 0, 310, 254, 273, 284,
-	0, 452, 410, 207, 380, 262, 196, 225, 210, 233,
-	248, 251, 288, 319, 326, 355, 359, 267, 245, 223,
-	377, 220, 395, 416, 417, 418, 420, 323, 240, 358,
-	87, 0, 306, 422, 423, 280, 622, 629, 630, 631,
-	632, 633, 623, 625, 0, 0, 0, 624, 0, 0,
-	627, 634, 635, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 270, 0, 330, 233, 0,
+	0, 0, 0, 462, 0, 0, 0, 0, 0, 0,
+	0, 298, 0, 295, 188, 205, 0, 0, 340, 381,
+	387, 0, 0, 0, 231, 0, 385, 354, 446, 213,
+	259, 378, 359, 383, 366, 262, 0, 0, 384, 304,
+	433, 373, 443, 463, 464, 239, 334, 453, 422, 459,
+	475, 206, 236, 348, 415, 449, 406, 327, 429, 430,
+	294, 405, 268, 191, 302, 469, 204, 393, 221, 211,
+	197, 417, 441, 218, 396, 0, 0, 477, 199, 439,
+	414, 323, 291, 292, 198, 0, 377, 244, 266, 234,
+	343, 436, 437, 232, 478, 208, 458, 201, 0, 457,
+	336, 432, 440, 324, 314, 200, 438, 322, 313, 297,
+	255, 277, 371, 307, 372, 278, 332, 331, 333, 194,
+	450, 0, 195, 0, 411, 451, 479, 214, 215, 216,
+	0, 254, 258, 265, 267, 273, 274, 281, 300, 347,
+	370, 368, 374, 0, 427, 444, 454, 461, 467, 468,
+	470, 471, 472, 473, 474, 335, 280, 407, 296, 305,
+	0, 0, 353, 386, 219, 448, 408, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 480, 481, 482,
+	483, 484, 485, 486, 487, 488, 489, 490, 491, 492,
+	493, 494, 495, 496, 497, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 498, 315, 399, 445, 0,
+	187, 202, 301, 0, 375, 263, 476, 456, 0, 0,
+	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 452, 0, 238, 0, 0, 0, 0,
+	0, 0, 326, 0, 0, 0, 189, 190, 203, 212,
+	222, 237, 252, 260, 271, 276, 279, 284, 285, 288,
+	293, 311, 317, 318, 319, 320, 337, 338, 339, 342,
+	345, 346, 349, 351, 352, 355, 362, 363, 364, 365,
+	367, 369, 376, 380, 388, 389, 390, 391, 392, 394,
+	395, 401, 402, 403, 404, 412, 416, 434, 435, 447,
+	460, 465, 272, 442, 466, 0, 310, 0, 0, 312,
+	256, 275, 286, 0, 455, 413, 207, 382, 264, 196,
+	226, 210, 235, 250, 253, 290, 321, 328, 357, 361,
+	269, 247, 223, 379, 220, 398, 419, 420, 421, 423,
+	325, 242, 360, 424, 0, 308, 425, 426, 282, 0,
+	0, 0, 0, 0, 0, 344, 0, 0, 0, 0,
+	0, 0, 0, 0, 246, 0, 0, 0, 0, 299,
+	243, 0, 0, 358, 0, 193, 0, 400, 230, 309,
+	306, 431, 257, 249, 0, 225, 245, 228, 283, 316,
+	356, 418, 350, 0, 303, 0, 0, 409, 329, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 289, 227, 192, 341, 410, 261,
+	0, 0, 0, 0, 184, 185, 186, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 217, 0, 224,
+	0, 0, 0, 0, 0, 241, 287, 248, 240, 428,
+	0, 0, 0, 0, 0, 0, 397, 0, 0, 209,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 251, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 270, 0, 330, 233, 0, 0, 0, 0,
+	462, 0, 0, 0, 0, 0, 0, 0, 298, 0,
+	295, 188, 205, 0, 0, 340, 381, 387, 0, 0,
+	0, 231, 0, 385, 354, 446, 213, 259, 378, 359,
+	383, 366, 262, 0, 0, 384, 304, 433, 373, 443,
+	463, 464, 239, 334, 453, 422, 459, 475, 206, 236,
+	348, 415, 449, 406, 327, 429, 430, 294, 405, 268,
+	191, 302, 469, 204, 393, 221, 211, 197, 417, 441,
+	218, 396, 0, 0, 477, 199, 439, 414, 323, 291,
+	292, 198, 0, 377, 244, 266, 234, 343, 436, 437,
+	232, 478, 208, 458, 201, 0, 457, 336, 432, 440,
+	324, 314, 200, 438, 322, 313, 297, 255, 277, 371,
+	307, 372, 278, 332, 331, 333, 194, 450, 0, 195,
+	0, 411, 451, 479, 214, 215, 216, 0, 254, 258,
+	265, 267, 273, 274, 281, 300, 347, 370, 368, 374,
+	0, 427, 444, 454, 461, 467, 468, 470, 471, 472,
+	473, 474, 335, 280, 407, 296, 305, 0, 0, 353,
+	386, 219, 448, 408, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 480, 481, 482, 483, 484, 485,
+	486, 487, 488, 489, 490, 491, 492, 493, 494, 495,
+	496, 497, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 498, 315, 399, 445, 0, 187, 202, 301,
+	0, 375, 263, 476, 456, 0, 0, 0, 229, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	452, 0, 238, 0, 0, 0, 0, 0, 0, 326,
+	0, 714, 0, 189, 190, 203, 212, 222, 237, 252,
+	260, 271, 276, 279, 284, 285, 288, 293, 311, 317,
+	318, 319, 320, 337, 338, 339, 342, 345, 346, 349,
+	351, 352, 355, 362, 363, 364, 365, 367, 369, 376,
+	380, 388, 389, 390, 391, 392, 394, 395, 401, 402,
+	403, 404, 412, 416, 434, 435, 447, 460, 465, 272,
+	442, 466, 0, 310, 0, 0, 312, 256, 275, 286,
+	0, 455, 413, 207, 382, 264, 196, 226, 210, 235,
+	250, 253, 290, 321, 328, 357, 361, 269, 247, 223,
+	379, 220, 398, 419, 420, 421, 423, 325, 242, 360,
+	424, 0, 308, 425, 426, 282, 0, 0, 0, 0,
+	0, 0, 344, 0, 0, 0, 0, 0, 0, 0,
+	0, 246, 0, 0, 0, 0, 299, 243, 0, 0,
+	358, 0, 193, 0, 400, 230, 309, 306, 431, 257,
+	249, 0, 225, 245, 228, 283, 316, 356, 418, 350,
+	0, 303, 0, 0, 409, 329, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 289, 227, 192, 341, 410, 261, 0, 0, 0,
+	0, 184, 185, 186, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 217, 0, 224, 0, 0, 0,
+	0, 0, 241, 287, 248, 240, 428, 0, 0, 0,
+	0, 0, 0, 397, 0, 0, 209, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 251, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 554, 0, 270,
+	0, 330, 233, 0, 0, 0, 0, 462, 0, 0,
+	0, 0, 0, 0, 0, 298, 0, 295, 188, 205,
+	0, 0, 340, 381, 387, 0, 0, 0, 231, 0,
+	385, 354, 446, 213, 259, 378, 359, 383, 366, 262,
+	0, 0, 384, 304, 433, 373, 443, 463, 464, 239,
+	334, 453, 422, 459, 475, 206, 236, 348, 415, 449,
+	406, 327, 429, 430, 294, 405, 268, 191, 302, 469,
+	204, 393, 221, 211, 197, 417, 441, 218, 396, 0,
+	0, 477, 199, 439, 414, 323, 291, 292, 198, 0,
+	377, 244, 266, 234, 343, 436, 437, 232, 478, 208,
+	458, 201, 0, 457, 336, 432, 440, 324, 314, 200,
+	438, 322, 313, 297, 255, 277, 371, 307, 372, 278,
+	332, 331, 333, 194, 450, 0, 195, 0, 411, 451,
+	479, 214, 215, 216, 0, 254, 258, 265, 267, 273,
+	274, 281, 300, 347, 370, 368, 374, 0, 427, 444,
+	454, 461, 467, 468, 470, 471, 472, 473, 474, 335,
+	280, 407, 296, 305, 0, 0, 353, 386, 219, 448,
+	408, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 480, 481, 482, 483, 484, 485, 486, 487, 488,
+	489, 490, 491, 492, 493, 494, 495, 496, 497, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 498,
+	315, 399, 445, 0, 187, 202, 301, 0, 375, 263,
+	476, 456, 0, 0, 0, 229, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 452, 0, 238,
+	0, 0, 0, 0, 0, 0, 326, 0, 0, 0,
+	189, 190, 203, 212, 222, 237, 252, 260, 271, 276,
+	279, 284, 285, 288, 293, 311, 317, 318, 319, 320,
+	337, 338, 339, 342, 345, 346, 349, 351, 352, 355,
+	362, 363, 364, 365, 367, 369, 376, 380, 388, 389,
+	390, 391, 392, 394, 395, 401, 402, 403, 404, 412,
+	416, 434, 435, 447, 460, 465, 553, 442, 466, 0,
+	310, 0, 0, 312, 256, 275, 286, 0, 455, 413,
+	207, 382, 264, 196, 226, 210, 235, 250, 253, 290,
+	321, 328, 357, 361, 269, 247, 223, 379, 220, 398,
+	419, 420, 421, 423, 325, 242, 360, 424, 0, 308,
+	425, 426, 282, 0, 0, 0, 0, 0, 0, 344,
+	0, 0, 0, 0, 0, 0, 0, 0, 246, 0,
+	0, 0, 0, 299, 243, 0, 0, 358, 0, 193,
+	0, 400, 230, 309, 306, 431, 257, 249, 0, 225,
+	245, 228, 283, 316, 356, 418, 350, 0, 303, 0,
+	0, 409, 329, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 289, 227,
+	192, 341, 410, 261, 0, 0, 0, 0, 184, 185,
+	186, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 217, 0, 224, 0, 0, 0, 0, 0, 241,
+	287, 248, 240, 428, 0, 0, 0, 0, 0, 0,
+	397, 0, 0, 209, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 251, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2218, 2219, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 636, 637,
-	638, 639, 640, 641, 642, 643, 644, 645, 646, 647,
-	648, 649, 650, 651, 652, 653, 654, 655, 656, 657,
-	658, 659, 660, 661, 662, 663, 664, 665, 666, 667,
-	668, 669, 670, 671, 672, 673, 674, 675, 676, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 270, 0, 330, 233,
+	0, 0, 501, 0, 462, 0, 0, 0, 0, 0,
+	0, 0, 298, 0, 295, 188, 205, 0, 0, 340,
+	381, 387, 0, 0, 0, 231, 0, 385, 354, 446,
+	213, 259, 378, 359, 383, 366, 262, 0, 0, 384,
+	304, 433, 373, 443, 463, 464, 239, 334, 453, 422,
+	459, 475, 206, 236, 348, 415, 449, 406, 327, 429,
+	430, 294, 405, 268, 191, 302, 469, 204, 393, 221,
+	211, 197, 417, 441, 218, 396, 0, 0, 477, 199,
+	439, 414, 323, 291, 292, 198, 0, 377, 244, 266,
+	234, 343, 436, 437, 232, 478, 208, 458, 201, 0,
+	457, 336, 432, 440, 324, 314, 200, 438, 322, 313,
+	297, 255, 277, 371, 307, 372, 278, 332, 331, 333,
+	194, 450, 0, 195, 0, 411, 451, 479, 214, 215,
+	216, 0, 254, 258, 265, 267, 273, 274, 281, 300,
+	347, 370, 368, 374, 0, 427, 444, 454, 461, 467,
+	468, 470, 471, 472, 473, 474, 335, 280, 407, 296,
+	305, 0, 0, 353, 386, 219, 448, 408, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 480, 481,
+	482, 483, 484, 485, 486, 487, 488, 489, 490, 491,
+	492, 493, 494, 495, 496, 497, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 498, 315, 399, 445,
+	0, 187, 202, 301, 0, 375, 263, 476, 456, 0,
+	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 452, 0, 238, 0, 0, 0,
+	0, 0, 0, 326, 0, 0, 0, 189, 190, 203,
+	212, 222, 237, 252, 260, 271, 276, 279, 284, 285,
+	288, 293, 311, 317, 318, 319, 320, 337, 338, 339,
+	342, 345, 346, 349, 351, 352, 355, 362, 363, 364,
+	365, 367, 369, 376, 380, 388, 389, 390, 391, 392,
+	394, 395, 401, 402, 403, 404, 412, 416, 434, 435,
+	447, 460, 465, 272, 442, 466, 0, 310, 0, 0,
+	312, 256, 275, 286, 0, 455, 413, 207, 382, 264,
+	196, 226, 210, 235, 250, 253, 290, 321, 328, 357,
+	361, 269, 247, 223, 379, 220, 398, 419, 420, 421,
+	423, 325, 242, 360, 424, 0, 308, 425, 426, 282,
+	0, 0, 0, 0, 0, 0, 344, 0, 0, 0,
+	0, 0, 0, 0, 0, 246, 0, 0, 0, 0,
+	299, 243, 0, 0, 358, 0, 193, 0, 400, 230,
+	309, 306, 431, 257, 249, 0, 225, 245, 228, 283,
+	316, 356, 418, 350, 0, 303, 0, 0, 409, 329,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 289, 227, 192, 341, 410,
+	261, 0, 0, 0, 0, 184, 185, 186, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
+	224, 0, 0, 0, 0, 0, 241, 287, 248, 240,
+	428, 0, 0, 0, 0, 0, 0, 397, 0, 0,
+	209, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 251, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -4928,6 +5064,110 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 270, 0, 330, 233, 0, 0, 0,
+	0, 462, 0, 0, 0, 0, 0, 0, 0, 298,
+	0, 295, 188, 205, 0, 0, 340, 381, 387, 0,
+	0, 0, 231, 0, 385, 354, 446, 213, 259, 378,
+	359, 383, 366, 262, 0, 0, 384, 304, 433, 373,
+	443, 463, 464, 239, 334, 453, 422, 459, 475, 206,
+	236, 348, 415, 449, 406, 327, 429, 430, 294, 405,
+	268, 191, 302, 469, 204, 393, 221, 211, 197, 417,
+	441, 218, 396, 0, 0, 477, 199, 439, 414, 323,
+	291, 292, 198, 0, 377, 244, 266, 234, 343, 436,
+	437, 232, 478, 208, 458, 201, 0, 457, 336, 432,
+	440, 324, 314, 200, 438, 322, 313, 297, 255, 277,
+	371, 307, 372, 278, 332, 331, 333, 194, 450, 0,
+	195, 0, 411, 451, 479, 214, 215, 216, 0, 254,
+	258, 265, 267, 273, 274, 281, 300, 347, 370, 368,
+	374, 0, 427, 444, 454, 461, 467, 468, 470, 471,
+	472, 473, 474, 335, 280, 407, 296, 305, 0, 0,
+	353, 386, 219, 448, 408, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 480, 481, 482, 483, 484,
+	485, 486, 487, 488, 489, 490, 491, 492, 493, 494,
+	495, 496, 497, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 498, 315, 399, 445, 0, 187, 202,
+	301, 0, 375, 263, 476, 456, 0, 0, 0, 229,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 452, 0, 238, 0, 0, 0, 0, 0, 0,
+	326, 0, 0, 0, 189, 190, 203, 212, 222, 237,
+	252, 260, 271, 276, 279, 284, 285, 288, 293, 311,
+	317, 318, 319, 320, 337, 338, 339, 342, 345, 346,
+	349, 351, 352, 355, 362, 363, 364, 365, 367, 369,
+	376, 380, 388, 389, 390, 391, 392, 394, 395, 401,
+	402, 403, 404, 412, 416, 434, 435, 447, 460, 465,
+	272, 442, 466, 0, 310, 0, 0, 312, 256, 275,
+	286, 0, 455, 413, 207, 382, 264, 196, 226, 210,
+	235, 250, 253, 290, 321, 328, 357, 361, 269, 247,
+	223, 379, 220, 398, 419, 420, 421, 423, 325, 242,
+	360, 424, 0, 308, 425, 426, 282, 0, 0, 0,
+	0, 0, 0, 344, 0, 0, 0, 0, 0, 0,
+	0, 0, 246, 0, 0, 0, 0, 299, 243, 0,
+	0, 358, 0, 193, 0, 400, 230, 309, 306, 431,
+	257, 249, 0, 225, 245, 228, 283, 316, 356, 418,
+	350, 0, 303, 0, 0, 409, 329, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 289, 227, 192, 341, 410, 261, 0, 0,
+	0, 0, 184, 2355, 186, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 217, 0, 224, 0, 0,
+	0, 0, 0, 241, 287, 248, 240, 428, 0, 0,
+	0, 0, 0, 0, 397, 0, 0, 209, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 251, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	270, 0, 330, 233, 0, 0, 0, 0, 462, 0,
+	0, 0, 0, 0, 0, 0, 298, 0, 295, 188,
+	205, 0, 0, 340, 381, 387, 0, 0, 0, 231,
+	0, 385, 354, 446, 213, 259, 378, 359, 383, 366,
+	262, 0, 0, 384, 304, 433, 373, 443, 463, 464,
+	239, 334, 453, 422, 459, 475, 206, 236, 348, 415,
+	449, 406, 327, 429, 430, 294, 405, 268, 191, 302,
+	469, 204, 393, 221, 211, 197, 417, 441, 218, 396,
+	0, 0, 477, 199, 439, 414, 323, 291, 292, 198,
+	0, 377, 244, 266, 234, 343, 436, 437, 232, 478,
+	208, 458, 201, 0, 457, 336, 432, 440, 324, 314,
+	200, 438, 322, 313, 297, 255, 277, 371, 307, 372,
+	278, 332, 331, 333, 194, 450, 0, 195, 0, 411,
+	451, 479, 214, 215, 216, 0, 254, 258, 265, 267,
+	273, 274, 281, 300, 347, 370, 368, 374, 0, 427,
+	444, 454, 461, 467, 468, 470, 471, 472, 473, 474,
+	335, 280, 407, 296, 305, 0, 0, 353, 386, 219,
+	448, 408, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 480, 481, 482, 483, 484, 485, 486, 487,
+	488, 489, 490, 491, 492, 493, 494, 495, 496, 497,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	498, 315, 399, 445, 0, 187, 202, 301, 0, 375,
+	263, 476, 456, 0, 0, 0, 229, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 452, 0,
+	238, 0, 0, 0, 0, 0, 0, 326, 0, 0,
+	0, 189, 190, 203, 212, 222, 237, 252, 260, 271,
+	276, 279, 284, 285, 288, 293, 311, 317, 318, 319,
+	320, 337, 338, 339, 342, 345, 346, 349, 351, 352,
+	355, 362, 363, 364, 365, 367, 369, 376, 380, 388,
+	389, 390, 391, 392, 394, 395, 401, 402, 403, 404,
+	412, 416, 434, 435, 447, 460, 465, 272, 442, 466,
+	0, 310, 0, 0, 312, 256, 275, 286, 0, 455,
+	413, 207, 382, 264, 196, 226, 210, 235, 250, 253,
+	290, 321, 328, 357, 361, 269, 247, 223, 379, 220,
+	398, 419, 420, 421, 423, 325, 242, 360, 424, 0,
+	308, 425, 426, 282, 0, 0, 0, 0, 0, 0,
+	344, 0, 0, 0, 0, 0, 0, 0, 0, 246,
+	0, 0, 0, 0, 299, 243, 0, 0, 358, 0,
+	193, 0, 400, 230, 309, 306, 431, 257, 249, 0,
+	225, 245, 228, 283, 316, 356, 418, 350, 0, 303,
+	0, 0, 409, 329, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 289,
+	227, 192, 341, 410, 261, 0, 0, 0, 0, 184,
+	1947, 186, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 217, 0, 224, 0, 0, 0, 0, 0,
+	241, 287, 248, 240, 428, 0, 0, 0, 0, 0,
+	0, 397, 0, 0, 209, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 251,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -4935,30 +5175,120 @@ var yyAct = [...]int{
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 613, 0, 0, 0, 614, 615, 0, 0, 0,
-	616,
+	0, 0, 0, 0, 0, 0, 0, 270, 0, 330,
+	233, 0, 0, 0, 0, 462, 0, 0, 0, 0,
+	0, 0, 0, 298, 0, 295, 188, 205, 0, 0,
+	340, 381, 387, 0, 0, 0, 231, 0, 385, 354,
+	446, 213, 259, 378, 359, 383, 366, 262, 0, 0,
+	384, 304, 433, 373, 443, 463, 464, 239, 334, 453,
+	422, 459, 475, 206, 236, 348, 415, 449, 406, 327,
+	429, 430, 294, 405, 268, 191, 302, 469, 204, 393,
+	221, 211, 197, 417, 441, 218, 396, 0, 0, 477,
+	199, 439, 414, 323, 291, 292, 198, 0, 377, 244,
+	266, 234, 343, 436, 437, 232, 478, 208, 458, 201,
+	0, 457, 336, 432, 440, 324, 314, 200, 438, 322,
+	313, 297, 255, 277, 371, 307, 372, 278, 332, 331,
+	333, 194, 450, 0, 195, 0, 411, 451, 479, 214,
+	215, 216, 0, 254, 258, 265, 267, 273, 274, 281,
+	300, 347, 370, 368, 374, 0, 427, 444, 454, 461,
+	467, 468, 470, 471, 472, 473, 474, 335, 280, 407,
+	296, 305, 0, 0, 353, 386, 219, 448, 408, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 480,
+	481, 482, 483, 484, 485, 486, 487, 488, 489, 490,
+	491, 492, 493, 494, 495, 496, 497, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 498, 315, 399,
+	445, 0, 187, 202, 301, 0, 375, 263, 476, 456,
+	0, 0, 0, 229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 452, 0, 238, 0, 0,
+	0, 0, 0, 0, 326, 0, 0, 0, 189, 190,
+	203, 212, 222, 237, 252, 260, 271, 276, 279, 284,
+	285, 288, 293, 311, 317, 318, 319, 320, 337, 338,
+	339, 342, 345, 346, 349, 351, 352, 355, 362, 363,
+	364, 365, 367, 369, 376, 380, 388, 389, 390, 391,
+	392, 394, 395, 401, 402, 403, 404, 412, 416, 434,
+	435, 447, 460, 465, 272, 442, 466, 0, 310, 0,
+	0, 312, 256, 275, 286, 0, 455, 413, 207, 382,
+	264, 196, 226, 210, 235, 250, 253, 290, 321, 328,
+	357, 361, 269, 247, 223, 379, 220, 398, 419, 420,
+	421, 423, 325, 242, 360, 424, 0, 308, 425, 426,
+	282, 0, 0, 0, 0, 0, 0, 344, 0, 0,
+	0, 0, 0, 0, 0, 0, 246, 0, 0, 0,
+	0, 299, 243, 0, 0, 358, 0, 193, 0, 400,
+	230, 309, 306, 431, 257, 249, 0, 225, 245, 228,
+	283, 316, 356, 418, 350, 0, 303, 0, 0, 409,
+	329, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 289, 227, 192, 341,
+	410, 261, 0, 0, 0, 0, 184, 185, 186, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 217,
+	0, 224, 0, 0, 0, 0, 0, 241, 287, 248,
+	240, 428, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 209, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 251, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 270, 0, 330, 233, 0, 0,
+	0, 0, 462, 0, 0, 0, 0, 0, 0, 0,
+	298, 0, 295, 188, 205, 0, 0, 340, 381, 387,
+	0, 0, 0, 231, 0, 385, 354, 446, 213, 259,
+	378, 359, 383, 366, 262, 0, 0, 384, 304, 433,
+	373, 443, 463, 464, 239, 334, 453, 422, 459, 475,
+	206, 236, 348, 415, 449, 406, 327, 429, 430, 294,
+	405, 268, 191, 302, 469, 204, 393, 221, 211, 197,
+	417, 441, 218, 396, 0, 0, 477, 199, 439, 414,
+	323, 291, 292, 198, 0, 377, 244, 266, 234, 343,
+	436, 437, 232, 478, 208, 458, 201, 0, 457, 336,
+	432, 440, 324, 314, 200, 438, 322, 313, 297, 255,
+	277, 371, 307, 372, 278, 332, 331, 333, 194, 450,
+	0, 195, 0, 411, 451, 479, 214, 215, 216, 0,
+	254, 258, 265, 267, 273, 274, 281, 300, 347, 370,
+	368, 374, 0, 427, 444, 454, 461, 467, 468, 470,
+	471, 472, 473, 474, 335, 280, 407, 296, 305, 0,
+	0, 353, 386, 219, 448, 408, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 492, 493,
+	494, 495, 496, 497, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 498, 315, 399, 445, 0, 187,
+	202, 301, 0, 375, 263, 476, 456, 0, 0, 0,
+	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 452, 0, 238, 0, 0, 0, 0, 0,
+	0, 326, 0, 0, 0, 189, 190, 203, 212, 222,
+	237, 252, 260, 271, 276, 279, 284, 285, 288, 293,
+	311, 317, 318, 319, 320, 337, 338, 339, 342, 345,
+	346, 349, 351, 352, 355, 362, 363, 364, 365, 367,
+	369, 376, 380, 388, 389, 390, 391, 392, 394, 395,
+	401, 402, 403, 404, 412, 416, 434, 435, 447, 460,
+	465, 272, 442, 466, 0, 310, 0, 0, 312, 256,
+	275, 286, 0, 455, 413, 207, 382, 264, 196, 226,
+	210, 235, 250, 253, 290, 321, 328, 357, 361, 269,
+	247, 223, 379, 220, 398, 419, 420, 421, 423, 325,
+	242, 360, 0, 0, 308, 425, 426, 282,
 }
 
 var yyPact = [...]int{
-	5109, -1000, -398, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	2744, -1000, -390, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 1713, 1775, -1000, -1000,
-	-1000, -1000, 1838, -1000, 613, 1511, -1000, 1707, 2843, -1000,
-	34480, 354, -1000, 33929, 342, 223, 34480, -1000, 96, -1000,
-	88, 34480, 92, 33378, -1000, -1000, -313, 14641, 1661, -10,
-	-20, 34480, -1000, -1000, -1000, -1000, 1819, 1505, -1000, 236,
-	-1000, -1000, -1000, -1000, -1000, -1000, 32827, -1000, -1000, -1000,
-	1718, 1726, 1843, 524, 1657, -1000, 1760, 1505, -1000, 14641,
-	1805, 1745, 14090, -1000, 14090, 317, -1000, -1000, 10226, -1000,
-	-1000, 19051, 34480, 34480, 233, -1000, 1707, -1000, -1000, 289,
-	-1000, 220, 1407, -1000, 1406, -1000, 560, 547, 239, 366,
-	365, 238, 237, 235, 234, 232, 231, 229, 226, 263,
-	-1000, 593, 593, -205, -206, 3129, 304, 304, 304, 324,
-	1681, 1678, -1000, 527, -1000, 593, 593, 271, 593, 593,
-	593, 593, 204, 201, 593, 593, 593, 593, 593, 593,
-	593, 593, 593, 593, 593, 593, 593, 593, 593, 292,
-	1707, 180, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1799, 1844, -1000, -1000,
+	-1000, -1000, 1908, -1000, 696, 1564, -1000, 1783, 2838, -1000,
+	37367, 460, -1000, 36810, 459, 110, 37367, -1000, 131, -1000,
+	115, 37367, 123, 36253, -1000, -1000, -298, 16198, 1731, 10,
+	9, 37367, -1000, -1000, -1000, -1000, 1893, 1551, -1000, 305,
+	-1000, -1000, -1000, -1000, -1000, -1000, 35696, -1000, -1000, -1000,
+	1797, 1777, 1912, 616, 1719, -1000, 1846, 1551, -1000, 16198,
+	1883, 1825, 15641, -1000, 15641, 370, -1000, -1000, 11735, -1000,
+	-1000, 21213, 37367, 37367, 309, -1000, 1783, -1000, -1000, 329,
+	-1000, 300, 1490, -1000, 1489, -1000, 455, 533, 308, 413,
+	408, 298, 297, 295, 289, 286, 280, 279, 278, 315,
+	-1000, 651, 651, -143, -144, 2753, 362, 362, 362, 394,
+	1749, 1746, -1000, 654, -1000, 651, 651, 294, 651, 651,
+	651, 651, 235, 232, 651, 651, 651, 651, 651, 651,
+	651, 651, 651, 651, 651, 651, 651, 651, 651, 321,
+	1783, 195, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4989,33 +5319,34 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 34480, 118, 34480, -1000,
-	433, 34480, 669, 669, 15, 669, 669, 669, 669, 98,
-	500, -30, -1000, 89, 196, 93, 169, 690, 86, 111,
-	-1000, -1000, 166, 690, 1053, 528, 79, -1000, 669, 7990,
-	7990, 7990, -1000, 1689, -1000, -1000, -1000, -1000, -1000, -1000,
-	1011, -1000, 323, -1000, -1000, -1000, -1000, 34480, 32276, 230,
-	616, -1000, -1000, -1000, 85, -1000, -1000, 1287, 665, 14641,
-	879, -1000, 1148, 381, -1000, -1000, -1000, -1000, -1000, 424,
-	15192, 15192, 15192, 15192, -1000, -1000, 1410, 1410, 1410, 1410,
-	15192, 1410, 15192, 1410, 1410, 1410, 1410, 14641, 1410, 1410,
-	1410, -1000, 1410, 1410, 1410, 1410, 1410, 1410, 1410, 1410,
-	1410, 1410, 1410, 431, 1410, 1410, 1410, 1410, 1410, -1000,
-	-1000, -1000, -1000, 1410, 1410, 1410, 1410, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 16845, -1000, 12437, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 37367,
+	156, 37367, -1000, 551, 37367, 760, 760, 60, 760, 760,
+	760, 760, 130, 566, 6, -1000, 129, 226, 100, 196,
+	647, 140, 117, -1000, -1000, 190, 647, 1209, 625, 108,
+	-1000, 760, 9475, 9475, 9475, -1000, 1759, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1213, -1000, 393, -1000, -1000, -1000, -1000,
+	37367, 35139, 336, 669, -1000, -1000, -1000, 29, -1000, -1000,
+	1326, 695, 16198, 1004, -1000, 1441, 577, -1000, -1000, -1000,
+	-1000, -1000, -1000, 499, 16755, 16755, 16755, 16755, -1000, -1000,
+	1492, 1492, 1492, 1492, 16755, 1492, 16755, 1492, 1492, 1492,
+	1492, 1492, 16198, 1492, 1492, 1492, 1492, 1492, 1492, 1492,
+	1492, 1492, 1492, 1492, 1492, 1492, 1492, -1000, 1492, 1492,
+	1492, 1492, 1492, 1492, 1492, 1492, 1492, 1492, 1492, 545,
+	1492, 1492, 1492, 1492, 1492, -1000, -1000, -1000, -1000, 1492,
+	1492, 1492, 1492, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 18983, -1000, 13970, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 34480, -1000,
-	1410, 105, 34480, 34480, 253, 1760, 1505, -1000, 1819, 1776,
-	236, -1000, 1778, 1504, 1194, 1072, 1505, 1384, 34480, -1000,
-	1414, -1000, -1000, -1000, 1591, 992, 1050, -1000, -1000, -1000,
-	-1000, 872, 14641, -1000, -1000, 1835, -1000, 16294, 427, 784,
-	1834, 31725, -1000, 317, 317, 1405, 9667, -45, -1000, -1000,
-	-1000, 615, 21806, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1689,
+	-1000, -1000, -1000, 218, 37367, -1000, 1492, 144, 37367, 37367,
+	291, 1846, 1551, -1000, 1893, 1858, 305, -1000, 1792, 1356,
+	1309, 1230, 1551, 1480, 37367, -1000, 1517, -1000, -1000, -1000,
+	1649, 1113, 1207, -1000, -1000, -1000, -1000, 1088, 16198, -1000,
+	-1000, 1903, -1000, 18426, 538, 860, 1901, 34582, -1000, 370,
+	370, 1487, 11170, -24, -1000, -1000, -1000, 663, 23998, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1759, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -5028,217 +5359,235 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1338, 34480, -1000, -1000, 4508, 1064, -1000, 1509,
-	-1000, 1336, -1000, 1445, 1522, 340, 1064, 335, 334, 333,
-	-1000, -125, -1000, -1000, -1000, -1000, -1000, 593, 593, -1000,
-	262, 1804, 2843, 4366, -1000, -1000, -1000, 31174, 1508, 1064,
-	-1000, 1482, -1000, 686, 408, 438, 438, 1064, -1000, -1000,
-	34480, 1064, 684, 678, 34480, 34480, -1000, 30623, -1000, 30072,
-	29521, 940, 34480, 28970, 28419, 27868, 27317, 26766, -1000, 1570,
-	-1000, 1481, -1000, -1000, -1000, 34480, 34480, 34480, 254, -1000,
-	-1000, 34480, 1064, -1000, -1000, 939, 934, 593, 593, 930,
-	1046, 1045, 1035, 593, 593, 920, 1033, 24010, 157, 916,
-	914, 912, 944, 1031, 167, 935, 932, 911, 34480, 1480,
-	34480, -1000, 159, 557, 319, 612, 1707, 1652, 1402, 322,
-	338, 1064, 311, 311, 34480, -1000, 8549, -1000, -1000, 1028,
-	14641, -1000, 691, 690, 690, -1000, -1000, -1000, -1000, -1000,
-	-1000, 669, 34480, 691, -1000, -1000, -1000, 690, 669, 34480,
-	669, 669, 669, 669, 690, 690, 690, 669, 34480, 34480,
-	34480, 34480, 34480, 34480, 34480, 34480, 34480, 7990, 7990, 7990,
-	528, 669, -317, -1000, 1021, -1000, 1546, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 87, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -117, 1401, 26215, -1000,
-	-319, -320, -321, -322, -1000, -1000, -1000, -324, -330, -1000,
-	-1000, -1000, 14641, 14641, 14641, 14641, -1000, 714, 15192, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 786, 543, 15192, 15192,
-	15192, 15192, 15192, 15192, 15192, 15192, 15192, 15192, 15192, 15192,
-	15192, 15192, 15192, 604, 1015, 1013, 381, 381, 381, 381,
-	-1000, 14090, 14641, 14641, 381, -1000, 1064, 25664, 14090, 14090,
-	14641, 1695, 638, 665, 34480, -1000, 1072, -1000, -1000, -1000,
-	874, -1000, 34480, 34480, 504, 14641, 14641, 14641, 11334, 10783,
-	8549, 14090, 14090, 14090, 14090, 14090, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 422, 1183, 1165,
-	1316, -1000, 1393, -1000, -186, 18500, 14641, 1012, 1832, 1531,
-	34480, -1000, -1000, -1000, 1760, -1000, 1760, 1183, 1748, 1573,
-	14090, -1000, -1000, 1748, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1249, -1000, 34480, 1384, 1742, 34480, 1584, 1004,
-	286, -1000, 14641, 14641, 1383, -1000, 849, 34480, -1000, -1000,
-	25113, -1000, -1000, 7431, -1000, 34480, 224, 34480, -1000, 23459,
-	24562, 9108, -45, -1000, 9108, 1344, -1000, -42, -71, 11885,
-	487, -1000, -1000, -1000, 3129, 15743, 1152, 487, 26, -1000,
-	-1000, -1000, 1445, -1000, 1445, 1445, 1445, 1445, 286, 286,
-	286, 286, -1000, -1000, -1000, -1000, -1000, 1476, 1474, -1000,
-	1445, 1445, 1445, 1445, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1472, 1472, 1472, 1446, 1446, 298, -1000, 14641, 203,
-	34480, 1716, 907, 159, 314, 1527, 1064, 1064, 1064, 314,
-	-1000, 1286, 1241, -1000, -389, 1382, -1000, -1000, 1801, -1000,
-	-1000, 577, 732, 722, 628, 34480, 121, 218, -1000, 284,
-	-1000, 34480, 1064, 677, 438, 1064, -1000, 1064, -1000, -1000,
-	-1000, -1000, -1000, 1064, 1374, -1000, 1197, 783, 702, 750,
-	698, 1374, -1000, -1000, -148, 1374, -1000, 1374, -1000, 1374,
-	-1000, 1374, -1000, 1374, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 552, 34480, 121, 604, -1000, 321, -1000, -1000,
-	604, 604, -1000, -1000, -1000, -1000, 993, 991, -1000, -1000,
+	1404, 37367, -1000, -1000, 5338, 1216, -1000, 1563, -1000, 1384,
+	-1000, 1530, 1584, 452, 1216, 418, 417, 416, -1000, -81,
+	-1000, -1000, -1000, -1000, -1000, 651, 651, -1000, 304, 1880,
+	2838, 3799, -1000, -1000, -1000, 34025, 1562, 1216, -1000, 1557,
+	-1000, 788, 497, 500, 500, 1216, -1000, -1000, 37367, 1216,
+	783, 780, 37367, 37367, -1000, 33468, -1000, 32911, 32354, 1026,
+	37367, 31797, 31240, 30683, 30126, 29569, -1000, 1639, -1000, 1552,
+	-1000, -1000, -1000, 37367, 37367, 37367, 266, -1000, -1000, 37367,
+	1216, -1000, -1000, 1025, 1014, 651, 651, 1010, 1202, 1199,
+	1198, 651, 651, 1008, 1190, 26783, 265, 1002, 1000, 999,
+	1094, 1185, 165, 1057, 988, 986, 37367, 1556, 37367, -1000,
+	183, 611, 330, 662, 1783, 1726, 1485, 391, 451, 1216,
+	365, 365, 37367, -1000, 10040, -1000, -1000, 1184, 16198, -1000,
+	755, 647, 647, -1000, -1000, -1000, -1000, -1000, -1000, 760,
+	37367, 755, -1000, -1000, -1000, 647, 760, 37367, 760, 760,
+	760, 760, 647, 647, 647, 760, 37367, 37367, 37367, 37367,
+	37367, 37367, 37367, 37367, 37367, 9475, 9475, 9475, 625, 760,
+	-303, -1000, 1180, -1000, 1625, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 122, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -98, 1484, 29012, -1000, -305, -306,
+	-307, -309, -1000, -1000, -1000, -316, -319, -1000, -1000, -1000,
+	16198, 16198, 16198, 16198, -1000, 789, 16755, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 888, 682, 16755, 16755, 16755, 16755,
+	16755, 16755, 16755, 16755, 16755, 16755, 16755, 16755, 16755, 16755,
+	16755, 836, 1173, 1171, 577, 577, 577, 577, -1000, 15641,
+	16198, 16198, 577, -1000, 1216, 28455, 15641, 15641, 15641, 16198,
+	1769, 684, 695, 37367, -1000, 1230, 1230, 1230, 1230, 1230,
+	1230, 16198, 16198, 16198, 16198, 16198, 16198, -1000, -1000, -1000,
+	883, -1000, 37367, 37367, 454, 16198, 16198, 16198, 12855, 12298,
+	10040, 15641, 15641, 15641, 15641, 15641, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 535, 1341, 1306,
+	1382, -290, -1000, -1000, -1000, 1481, -1000, -162, 20656, 16198,
+	1163, 1900, 1598, 37367, -1000, -1000, -1000, 1846, -1000, 1846,
+	1341, 1736, 1670, 15641, -1000, -1000, 1736, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1308, -1000, 37367, 1480, 1822,
+	37367, 1664, 1151, 327, -1000, 16198, 16198, 1478, -1000, 875,
+	37367, -1000, -1000, 27898, -1000, -1000, 8910, -1000, 37367, 261,
+	37367, -1000, 26226, 27341, 10605, -24, -1000, 10605, 1467, -1000,
+	-12, -23, 13412, 596, -1000, -1000, -1000, 2753, 17869, 1295,
+	596, 61, -1000, -1000, -1000, 1530, -1000, 1530, 1530, 1530,
+	1530, 327, 327, 327, 327, -1000, -1000, -1000, -1000, -1000,
+	1555, 1554, -1000, 1530, 1530, 1530, 1530, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1553, 1553, 1553, 1531, 1531, 350,
+	-1000, 16198, 223, 37367, 1795, 976, 183, 366, 1597, 1216,
+	1216, 1216, 366, -1000, 1196, 1191, -1000, -382, 1477, -1000,
+	-1000, 1879, -1000, -1000, 580, 839, 824, 554, 37367, 151,
+	262, -1000, 346, -1000, 37367, 1216, 767, 500, 1216, -1000,
+	1216, -1000, -1000, -1000, -1000, -1000, 1216, 1476, -1000, 1499,
+	869, 822, 859, 773, 1476, -1000, -1000, -108, 1476, -1000,
+	1476, -1000, 1476, -1000, 1476, -1000, 1476, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 661, 37367, 151, 836, -1000,
+	385, -1000, -1000, 836, 836, -1000, -1000, -1000, -1000, 1144,
+	1138, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -377, 34480, -1000, 133, 600,
-	208, 247, 209, 34480, 139, 1750, 182, 193, 34480, 34480,
-	311, 1545, 34480, 1724, 34480, -1000, -1000, -1000, -1000, -1000,
-	665, 34480, -1000, -1000, 669, 669, -1000, -1000, 34480, 669,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 669, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -369, 37367,
+	-1000, 178, 658, 248, 277, 229, 37367, 221, 1827, 238,
+	209, 37367, 37367, 365, 1624, 37367, 1801, 37367, -1000, -1000,
+	-1000, -1000, -1000, 695, 37367, -1000, -1000, 760, 760, -1000,
+	-1000, 37367, 760, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 760, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 37367,
+	37367, -1000, -1000, -1000, -1000, -1000, 70, -14, 290, -1000,
+	-1000, -1000, -1000, -1000, 1834, -1000, 695, 716, 742, -1000,
+	-1000, -1000, 949, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	888, 16755, 16755, 16755, 1377, 426, 1388, 1471, 1344, 879,
+	879, 784, 784, 600, 600, 600, 600, 600, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1363, -1000, 946, 920, 1230,
+	-1000, 1363, 1363, 1363, 863, 15641, -1000, -1000, 677, -1000,
+	16198, 1230, -1000, -155, -155, -155, -155, -155, 1071, 1382,
+	1382, 1071, 1071, 1110, -1000, 1230, 1475, 1472, 1899, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 34480, 34480, -1000, -1000,
-	-1000, -1000, -1000, 126, -53, 259, -1000, -1000, -1000, -1000,
-	-1000, 1753, -1000, 665, 657, 644, -1000, -1000, -1000, 773,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 786, 15192, 15192,
-	15192, 1352, 363, 1397, 1429, 1262, 831, 831, 587, 587,
-	503, 503, 503, 503, 503, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1280, -1000, 1109, 950, 1072, -1000, 1280, 1280,
-	945, 14090, -1000, -1000, 647, -1000, 14641, 1072, -1000, -1000,
-	1072, 1354, 1347, 1830, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 1104, 1003, 1003, 14641, 796,
-	-1000, -1000, -1000, 1072, 14090, 14090, 1346, 1410, 418, -1000,
-	1280, 1072, 1072, 1280, 1280, 8549, 1072, -1000, 34480, -1000,
-	-306, -1000, -80, 463, 1410, -1000, 24010, 1072, 1287, -1000,
-	982, -1000, 35582, -1000, -1000, -1000, -1000, -1000, 21255, 1163,
-	1748, -1000, -1000, 1410, 1314, -1000, -1000, 286, 11, 20704,
-	-1000, -1000, 665, 665, 14641, -1000, -1000, -1000, -1000, -1000,
-	-1000, 413, 1814, 250, 1410, -1000, 1377, 1590, -1000, -1000,
-	-1000, 1741, 17949, 34480, 1385, 1320, -1000, 405, -1000, 1344,
-	-45, -55, -1000, -1000, -1000, -1000, 665, -1000, 1196, 225,
-	4067, -1000, 288, -1000, -1000, -1000, -1000, 1669, 12, -1000,
-	-1000, -1000, 286, 286, -1000, -1000, -1000, -1000, -1000, -1000,
-	987, 987, -1000, -1000, -1000, -1000, -1000, 904, -1000, -1000,
-	-1000, 900, -1000, -1000, 1141, 1567, 203, -1000, -1000, 593,
-	972, 1673, 34480, -1000, -1000, 1115, 133, 34480, 632, 1542,
-	-1000, 1527, 1527, 1527, 34480, -1000, -1000, -1000, -1000, -378,
-	44, 281, -1000, -1000, -1000, 287, 34480, 1303, -1000, 119,
-	-1000, 1112, 1607, 34480, -1000, 1297, 1471, 1064, 1064, -1000,
-	-1000, -1000, 34480, 1410, -1000, -1000, -1000, -1000, 337, 1697,
-	1692, 121, 119, 487, 1064, -1000, -1000, -1000, -1000, -1000,
-	-380, 1293, 331, 129, 210, 34480, 34480, 34480, 34480, 34480,
-	387, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 190,
-	320, -1000, 34480, 34480, 441, -1000, -1000, -1000, 690, -1000,
-	-1000, 690, -1000, -1000, -1000, -1000, -1000, 1687, 34480, -65,
-	-345, -1000, -342, -1000, -1000, -1000, -1000, 1057, 357, 1397,
-	15192, 15192, 14090, -142, 1396, 1396, 604, -1000, -1000, -1000,
-	14641, 14641, 1408, 618, -1000, 14641, 734, -1000, -1000, 14641,
-	14641, 14641, 1072, 211, -1000, -1000, 1826, -1000, 14641, -1000,
-	1280, 1280, 14090, 8549, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 463, -1000, 438, 438, 438, 34480,
-	-1000, -1000, -1000, -1000, -1000, -1000, 1342, 1756, -1000, 1604,
-	1594, 1817, 1814, -1000, 23459, 1748, -1000, -1000, 34480, -301,
-	-1000, 1649, 1636, -1000, -1000, -1000, -1000, 6872, 1760, 14641,
-	1541, 34480, 1410, -1000, 17397, 34480, 34480, 23459, 23459, 23459,
-	23459, 23459, -1000, 1572, 1571, -1000, 1564, 1558, 1602, 34480,
-	-1000, 1275, 1072, 1776, 17949, 20153, 1310, 23459, -1000, -1000,
-	23459, 34480, 6313, -1000, -1000, -76, -92, -1000, -1000, -1000,
-	-1000, 3129, -1000, -1000, 850, 1738, 1659, -1000, -1000, -1000,
-	-1000, 1272, -1000, 1267, 1319, 1265, 90, -1000, 1519, 1685,
-	593, 593, -1000, 898, -1000, 1064, -1000, -1000, 327, -1000,
-	1719, 34480, 1540, 1539, 1538, -1000, -388, 884, 1451, 1486,
-	14641, 1449, 1800, 1305, 34480, -1000, -1000, 34480, -1000, -1000,
-	328, -1000, 203, 34480, -1000, -1000, -1000, 218, 34480, -1000,
-	5471, 119, -1000, -1000, -1000, -1000, -1000, -1000, 34480, 168,
-	-1000, 1447, 997, -1000, -1000, 1437, -1000, -1000, -1000, -1000,
-	112, 207, -1000, 34480, 430, 1567, 34480, -1000, -1000, -1000,
-	669, 669, -1000, -1000, 1684, -1000, 1064, 15192, 15192, -1000,
-	381, -1000, 1410, 1072, 1445, 1445, -1000, 1445, 1446, -1000,
-	1445, 78, 1445, 77, 1445, -1000, -1000, 1072, 1072, 808,
-	800, -132, -1000, 665, 14641, 1023, 994, 1003, -1000, 1443,
-	1441, 14641, 1003, -1000, -1000, 1072, -1000, -1000, 1752, 1752,
-	1752, 1253, 35031, 34480, -1000, -1000, -1000, -1000, 1814, 1812,
-	1312, -1000, -1000, 11, 326, -1000, 1630, 1636, -1000, 1799,
-	1631, 1798, -1000, -1000, -1000, 665, -1000, 1696, 1351, -1000,
-	590, 1285, -1000, -1000, 13539, 1260, 1576, 400, 1253, 1349,
-	1590, 1530, 1536, 1621, -1000, -1000, -1000, -1000, 1568, -1000,
-	1557, -1000, -1000, 1414, -1000, -1000, 1165, 224, 23459, 1206,
-	1206, -1000, 374, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	755, 36057, 1842, -1000, 968, -1000, 1167, -1000, 674, 671,
-	-1000, 34480, 1440, -1000, 286, 967, 286, 868, -1000, 847,
-	-1000, -1000, -243, -1000, -1000, 1403, 1566, -1000, -1000, 34480,
-	-1000, -1000, 34480, 34480, 34480, 1436, 1791, -1000, 14641, 1434,
-	574, 1016, 34480, 34480, -1000, -1000, 212, 1410, -1000, 1240,
-	1236, -1000, -200, -1000, 14641, -1000, -1000, -1000, 858, 858,
-	-1000, 1414, -1000, -1000, -1000, 1110, -1000, -1000, -175, 34480,
-	34480, 34480, 34480, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 381, 15192, -1000, -1000, 286, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 14641, -1000, 14641, -1000,
-	1760, 963, 665, 14641, 14641, -1000, 825, 803, 1003, -1000,
-	-1000, 1410, 1656, 1410, 1410, 20153, -1000, -1000, 1812, 1810,
-	1790, 1622, 1627, 1627, 1630, -1000, 1788, 1784, -1000, 962,
-	1781, 961, 663, -1000, 34480, 14641, 1410, -1000, 244, 34480,
-	1410, 34480, -1000, 1807, -1000, -1000, 14641, 1433, -1000, 14641,
-	-1000, -1000, -1000, -1000, -1000, 1814, 1206, -1000, -1000, 510,
-	-1000, 14641, -1000, -1000, -1000, 1149, -1000, -1000, -1000, -1000,
-	-1000, 1432, 14641, -1000, -1000, -1000, 1102, 1099, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 1414, -1000, -1000, -1000,
-	-1000, 218, -386, 1008, 34480, 795, -1000, 1231, 1305, 392,
-	-1000, 14641, 251, -1000, 218, -1000, -207, -209, 1003, -1000,
-	-1000, 1737, -1000, -1000, 8549, -1000, -1000, 1411, 1512, -1000,
-	265, -1000, 1003, 1003, 1072, -1000, 1003, 1003, 1095, 1093,
-	-1000, 19602, -1000, 1766, 1762, 22908, 22908, 463, 1810, -1000,
-	14641, 14641, 1619, 863, -1000, -1000, -1000, -1000, 948, 947,
-	-1000, 865, -1000, 1841, -1000, 665, -1000, 1410, -1000, 343,
-	1285, -1000, 1760, 665, 34480, 665, 1807, -1000, 1003, -1000,
-	1410, 1410, 1410, 1410, 34480, 974, -1000, -1000, 1728, 1229,
-	43, -1000, 1225, -1000, -1000, -1000, -1000, 12988, -1000, -1000,
-	-1000, -1000, -1000, -1000, 236, 1299, -1000, 562, 34480, 34480,
-	1072, 213, -188, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	1203, -1000, -1000, -1000, -1000, -1000, 1203, 1203, -1000, -1000,
-	665, 1287, -1000, 780, -1000, -1000, -1000, -1000, -1000, 34480,
-	1285, 34480, -1000, 1189, 1760, -1000, 1159, -1000, 236, -1000,
-	1195, 1486, -1000, 1072, -149, 8549, 5754, 1132, -1000, -1000,
-	1581, -185, -192, 22357, -1000, -1000, -1000, -1000, -1000, 1177,
-	-1000, -1000, -1000, 1534, 743, -149, 14641, 1150, -1000, -1000,
-	-57, -1000, -1000, -1000, -1000, -1000, 1437, -1000, 1578, -1000,
-	-1000, -1000, 1533, -1000, 1820, -1000, -1000, -1000, 745, 806,
-	-1000, -1000, -1000, -1000, 804, 34480, 290, -1000, -1000, -175,
-	-184, -1000, 1840, 494, 494, -1000, -1000, -395, 1060, 156,
-	-1000, -190, -1000, -1000, -1000, 295, 839, -1000, -1000, 779,
-	-395, -1000, -195, -1000, -1000, -1000, -1000, -1000, -1000,
+	804, 1071, 1071, 16198, 1102, -1000, -1000, -1000, 1230, 15641,
+	15641, 1470, 1492, 523, -1000, 1363, 1230, 1230, 1363, 1363,
+	10040, 1230, -1000, 1700, -1000, -1000, 37367, -1000, -291, -1000,
+	-21, 537, 1492, -1000, 26783, 1230, 1326, -1000, 1086, -1000,
+	38481, -1000, -1000, -1000, -1000, -1000, 23441, 1496, 1736, -1000,
+	-1000, 1492, 1378, -1000, -1000, 327, 37, 22884, -1000, -1000,
+	695, 695, 16198, -1000, -1000, -1000, -1000, -1000, -1000, 522,
+	1862, 299, 1492, -1000, 1479, 1623, -1000, -1000, -1000, 1821,
+	20099, 37367, 1389, 1350, -1000, 505, -1000, 1467, -24, -43,
+	-1000, -1000, -1000, -1000, 695, -1000, 1178, 273, 292, -1000,
+	357, -1000, -1000, -1000, -1000, 1737, 38, -1000, -1000, -1000,
+	327, 327, -1000, -1000, -1000, -1000, -1000, -1000, 1136, 1136,
+	-1000, -1000, -1000, -1000, -1000, 958, -1000, -1000, -1000, 957,
+	-1000, -1000, 1127, 1619, 223, -1000, -1000, 651, 1135, 1740,
+	37367, -1000, -1000, 1286, 178, 37367, 713, 1622, -1000, 1597,
+	1597, 1597, 37367, -1000, -1000, -1000, -1000, -371, 54, 303,
+	-1000, -1000, -1000, 3133, 37367, 1372, -1000, 147, -1000, 1273,
+	1682, 37367, -1000, 1369, 1547, 1216, 1216, -1000, -1000, -1000,
+	37367, 1492, -1000, -1000, -1000, -1000, 434, 1765, 1761, 151,
+	147, 596, 1216, -1000, -1000, -1000, -1000, -1000, -372, 1367,
+	410, 167, 255, 37367, 37367, 37367, 37367, 37367, 491, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 222, 384, -1000,
+	37367, 37367, 496, -1000, -1000, -1000, 647, -1000, -1000, 647,
+	-1000, -1000, -1000, -1000, -1000, 1756, 37367, -16, -336, -1000,
+	-333, -1000, -1000, -1000, -1000, 975, 423, 1388, 16755, 16755,
+	15641, -96, 602, 602, 836, -1000, -1000, -1000, -1000, 16198,
+	16198, 1464, 655, -1000, 16198, 761, -1000, -1000, 26226, -1000,
+	-1000, -1000, -1000, -155, -155, -155, -155, -155, 16198, -1000,
+	16198, 16198, 16198, 1230, 233, -1000, -1000, 1898, -1000, 16198,
+	-155, 1363, 1363, 15641, 10040, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1130, -1000, -1000, -1000, -1000, 537, -1000, 500, 500,
+	500, 37367, -1000, -1000, -1000, -1000, -1000, -1000, 1466, 1849,
+	-1000, 1680, 1678, 1891, 1862, -1000, 26226, 1736, -1000, -1000,
+	37367, -284, -1000, 1723, 1688, -1000, -1000, -1000, -1000, 8345,
+	1846, 16198, 1618, 37367, 1492, -1000, 19541, 37367, 37367, 26226,
+	26226, 26226, 26226, 26226, -1000, 1641, 1640, -1000, 1648, 1638,
+	1656, 37367, -1000, 1361, 1230, 1858, 20099, 22327, 1272, 26226,
+	-1000, -1000, 26226, 37367, 7780, -1000, -1000, -18, -33, -1000,
+	-1000, -1000, -1000, 2753, -1000, -1000, 1217, 1820, 1735, -1000,
+	-1000, -1000, -1000, 1355, -1000, 1353, 1463, 1345, 141, -1000,
+	1581, 1754, 651, 651, -1000, 948, -1000, 1216, -1000, -1000,
+	398, -1000, 1798, 37367, 1609, 1607, 1606, -1000, -381, 936,
+	1534, 1383, 16198, 1533, 1878, 1398, 37367, -1000, -1000, 37367,
+	-1000, -1000, 378, -1000, 223, 37367, -1000, -1000, -1000, 262,
+	37367, -1000, 6070, 147, -1000, -1000, -1000, -1000, -1000, -1000,
+	37367, 213, -1000, 1532, 951, -1000, -1000, 1589, -1000, -1000,
+	-1000, -1000, 148, 239, -1000, 37367, 495, 1619, 37367, -1000,
+	-1000, -1000, 760, 760, -1000, -1000, 1753, -1000, 1216, 16755,
+	16755, -1000, 577, -1000, 1492, 1230, 1530, 1530, -1000, 1530,
+	1531, -1000, 1530, 105, 1530, 104, 1530, -1000, -1000, 1230,
+	1230, 892, 800, -90, -1000, 695, 16198, 39038, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1071, 1096, 792, 1071, -1000, 1529,
+	1528, 16198, 1071, -1000, -1000, -1000, 1230, -1000, 111, -1000,
+	1830, 1830, 1830, 1336, 37924, 37367, -1000, -1000, -1000, -1000,
+	1862, 1888, 1401, -1000, -1000, 37, 353, -1000, 1696, 1688,
+	-1000, 1877, 1709, 1875, -1000, -1000, -1000, 695, -1000, 1789,
+	1436, -1000, 648, 1330, -1000, -1000, 15084, 1343, 1627, 504,
+	1336, 1438, 1623, 1596, 1605, 1586, -1000, -1000, -1000, -1000,
+	1637, -1000, 1541, -1000, -1000, 1517, -1000, -1000, 1306, 261,
+	26226, 1431, 1431, -1000, 502, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 852, 6330, 1911, -1000, 1129, -1000, 1170, -1000,
+	758, 757, -1000, 37367, 1527, -1000, 327, 1121, 327, 930,
+	-1000, 922, -1000, -1000, -196, -1000, -1000, 1522, 1608, -1000,
+	-1000, 37367, -1000, -1000, 37367, 37367, 37367, 1526, 1874, -1000,
+	16198, 1525, 637, 1123, 37367, 37367, -1000, -1000, 256, 1492,
+	-1000, 1334, 1328, -1000, -138, -1000, 16198, -1000, -1000, -1000,
+	1044, 1044, -1000, 1517, -1000, -1000, -1000, 1260, -1000, -1000,
+	-119, 37367, 37367, 37367, 37367, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 577, 16755, -1000, -1000, 327, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 16198, -1000,
+	16198, -1000, 1846, 1120, 695, 1230, 267, -1000, -155, 16198,
+	16198, -1000, 914, 897, 1071, -1000, -1000, 1791, -1000, -1000,
+	1492, 1714, 1492, 1492, 22327, -1000, -1000, 1888, 1886, 1873,
+	1692, 1697, 1697, 1696, -1000, 1867, 1866, -1000, 1119, 1865,
+	1117, 749, -1000, 37367, 16198, 1492, -1000, 301, 37367, 1492,
+	37367, -1000, 1850, -1000, -1000, 16198, 1523, -1000, 16198, -1000,
+	-1000, -1000, -1000, -1000, 1862, 1431, -1000, -1000, 609, -1000,
+	16198, -1000, -1000, -1000, 236, -1000, -1000, -1000, -1000, -1000,
+	1521, 16198, -1000, -1000, -1000, 1258, 1256, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1517, -1000, -1000, -1000, -1000,
+	262, -377, 1115, 37367, 894, -1000, 1324, 1398, 437, -1000,
+	16198, 331, -1000, 262, -1000, -145, -147, 1071, -1000, -1000,
+	1810, -1000, -1000, 10040, -1000, -1000, 1502, 1592, -1000, 288,
+	-1000, 1071, 1071, 1230, -1000, -1000, 1850, 1864, -1000, 1071,
+	1071, 1240, 1237, -1000, 429, 21770, -1000, 1863, 1861, 25669,
+	25669, 537, 1886, -157, 16198, 16198, 1689, 910, -1000, -1000,
+	-1000, -1000, 1111, 1101, -1000, 1098, -1000, 1910, -1000, 695,
+	-1000, 1492, -1000, 469, 1330, -1000, 1846, 695, 37367, 695,
+	1850, -1000, 1071, -1000, 1492, 1492, 1492, 1492, 37367, 982,
+	-1000, -1000, 1808, 1298, 51, -1000, 1291, -1000, -1000, -1000,
+	-1000, 14527, -1000, -1000, -1000, -1000, -1000, -1000, 305, 1375,
+	-1000, 635, 37367, 37367, 1230, 258, -122, -1000, -1000, -1000,
+	65, 16198, -1000, -1000, -1000, -1000, 25112, 1267, -1000, -1000,
+	-1000, -1000, -1000, 1267, 1267, -1000, -157, -1000, 37367, 695,
+	1326, -1000, 891, -1000, -1000, -1000, -1000, -1000, 37367, 1330,
+	37367, -1000, 1252, 1846, -1000, 1244, -1000, 305, -1000, 1498,
+	1383, -1000, 1230, -111, 10040, 7215, 1225, -1000, -1000, 1659,
+	-103, -127, -1000, 194, -1000, -1000, 1326, 261, -1000, -1000,
+	24555, -1000, -1000, -1000, -1000, 1317, -1000, 1804, -1000, -1000,
+	1312, -1000, -1000, -1000, 1603, 870, -111, 16198, 1494, -1000,
+	-1000, -37, -1000, -1000, -1000, -1000, -1000, 1589, -1000, 1654,
+	-1000, -1000, 208, -163, -199, -227, -1000, -1000, 16755, 327,
+	-1000, -1000, 37367, 1492, 1602, -1000, 1896, -1000, -1000, -1000,
+	775, 1089, -1000, -1000, -1000, -1000, 916, 37367, 339, -1000,
+	-1000, -119, -120, 741, -1000, -1000, -1000, -1000, -1000, 17312,
+	37, -1000, 39038, -1000, 1906, 539, 539, -1000, -1000, -387,
+	1223, 180, -1000, -123, 208, -1000, 1718, -1000, 1230, -1000,
+	-1000, -1000, 335, 969, -1000, -1000, 880, -387, -1000, -128,
+	-1000, 369, -1000, 1686, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1492, 874, 1695, 1860, 1707, 1856, 1758, 37367, 907,
+	1853, 1851, 1084, 1848, 1068, -1000, 37367, 1221, -1000, -1000,
+	-1000, 1061, 1049, -1000, 918, -1000, 1312, -1000, 37367, -1000,
+	-1000, -1000, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 2140, 2139, 16, 9, 2138, 13, 76, 153, 11,
-	178, 77, 2137, 2136, 2135, 2134, 2133, 2132, 2131, 187,
-	186, 184, 2130, 2129, 2128, 2127, 2126, 2125, 2123, 2122,
-	2120, 2119, 182, 140, 150, 2118, 2117, 2116, 99, 141,
-	74, 71, 152, 2113, 2112, 59, 2111, 2110, 2109, 180,
-	174, 670, 2108, 158, 85, 23, 2107, 2106, 2105, 2104,
-	2103, 2102, 2101, 2098, 2097, 2092, 2091, 2090, 2089, 2086,
-	273, 2085, 2083, 6, 2082, 61, 2081, 2080, 2079, 2078,
-	115, 2077, 2076, 2074, 2073, 2067, 2066, 124, 2062, 2060,
-	2058, 2057, 155, 2055, 2054, 201, 95, 90, 2053, 2052,
-	81, 161, 2051, 101, 2050, 2049, 2045, 142, 2042, 2056,
-	2039, 52, 50, 2033, 47, 66, 48, 39, 2032, 2031,
-	2030, 45, 73, 2029, 86, 49, 2028, 96, 80, 2026,
-	43, 2025, 2024, 98, 2023, 2022, 2020, 79, 2018, 2016,
-	3510, 2015, 68, 118, 82, 60, 2012, 26, 46, 2007,
-	2005, 2003, 1997, 1994, 28, 1989, 1988, 1986, 121, 15,
-	1985, 21, 40, 29, 116, 1984, 37, 63, 1983, 125,
-	120, 1979, 34, 20, 19, 1975, 17, 110, 145, 25,
-	67, 122, 1973, 1972, 35, 53, 1971, 1970, 1969, 1968,
-	1967, 1966, 44, 1965, 33, 1964, 185, 1960, 2, 1959,
-	31, 24, 1956, 41, 168, 42, 12, 1955, 163, 1952,
-	32, 138, 106, 136, 1950, 1945, 1940, 148, 218, 1937,
-	1932, 58, 147, 126, 131, 1931, 223, 1927, 1924, 78,
-	1539, 1884, 18, 139, 1917, 1914, 3060, 119, 117, 38,
-	1913, 234, 1912, 1911, 1910, 154, 144, 88, 845, 97,
-	1909, 1907, 1906, 1905, 1903, 1902, 1900, 1897, 181, 159,
-	36, 89, 133, 55, 1896, 1894, 1893, 104, 70, 1892,
-	137, 134, 102, 84, 1891, 149, 128, 107, 1890, 92,
-	1889, 1888, 1887, 1886, 75, 1885, 1883, 1881, 1879, 130,
-	129, 103, 65, 1878, 69, 100, 132, 135, 27, 1877,
-	30, 1876, 1875, 151, 7, 1874, 3, 0, 1873, 5,
-	143, 226, 146, 1872, 1871, 1, 1870, 4, 1869, 1868,
-	123, 1867, 1861, 1859, 10, 22, 8, 1858, 1856, 1855,
-	3258, 800, 108, 1854, 162,
-}
-
-//line sql.y:6250
+	0, 2225, 2224, 35, 12, 2223, 44, 81, 171, 34,
+	173, 83, 2222, 2220, 2219, 2218, 2216, 2214, 2211, 202,
+	201, 197, 2209, 2206, 2205, 2204, 2201, 2200, 2199, 2198,
+	2197, 2196, 195, 154, 166, 2195, 2194, 2193, 99, 168,
+	76, 79, 170, 2192, 2191, 73, 2189, 2188, 2187, 164,
+	162, 704, 2183, 165, 97, 31, 2182, 2181, 2179, 2177,
+	2176, 2175, 2174, 2173, 2172, 2167, 2164, 2163, 2162, 2161,
+	275, 2160, 2159, 15, 2158, 71, 2157, 2155, 2154, 2152,
+	111, 2150, 2149, 2148, 2147, 2146, 2145, 133, 2144, 2143,
+	2141, 2137, 188, 2136, 2135, 175, 102, 105, 2133, 2132,
+	91, 172, 2131, 104, 2130, 2129, 2128, 139, 2127, 3067,
+	2126, 58, 52, 2124, 32, 67, 49, 2, 2123, 2121,
+	2120, 55, 70, 2117, 92, 50, 2116, 100, 95, 2115,
+	63, 2114, 2113, 120, 2112, 2111, 2107, 82, 2106, 2105,
+	4878, 2104, 78, 121, 93, 65, 2102, 26, 41, 2098,
+	2097, 2095, 2093, 2092, 2091, 2090, 2089, 2088, 2087, 2086,
+	2074, 2072, 2070, 2069, 2068, 2065, 37, 2064, 2063, 2062,
+	2061, 2059, 48, 4, 2056, 1, 2055, 13, 21, 2054,
+	2052, 2051, 119, 18, 2048, 28, 27, 39, 116, 2047,
+	40, 68, 2043, 131, 132, 2042, 53, 24, 22, 2040,
+	89, 117, 143, 19, 88, 118, 2039, 2037, 43, 61,
+	2036, 2035, 2034, 7, 2033, 2032, 60, 2031, 46, 2029,
+	178, 2028, 9, 2027, 38, 30, 2022, 16, 125, 59,
+	6, 2020, 160, 2018, 42, 159, 123, 150, 2017, 2016,
+	2015, 158, 5, 2012, 2011, 66, 151, 135, 140, 2009,
+	243, 2008, 2006, 96, 1651, 3549, 25, 149, 2001, 1999,
+	2785, 130, 128, 47, 1994, 153, 1993, 1991, 1989, 152,
+	167, 94, 917, 103, 1988, 1976, 1975, 1974, 1973, 1972,
+	1968, 1967, 90, 174, 11, 101, 146, 69, 1966, 1965,
+	1964, 108, 77, 1963, 148, 144, 110, 84, 1962, 155,
+	147, 98, 1960, 85, 1959, 1958, 1951, 1948, 80, 1946,
+	1945, 1944, 1943, 145, 136, 107, 75, 1941, 74, 106,
+	137, 141, 36, 1940, 29, 1939, 1938, 169, 23, 1937,
+	10, 0, 1936, 14, 157, 240, 138, 1935, 1934, 3,
+	1933, 8, 1932, 1931, 129, 1930, 1929, 1928, 20, 33,
+	17, 1927, 1926, 1924, 4477, 3641, 115, 1908, 177,
+}
+
+//line sql.y:6653
 type yySymType struct {
 	union             interface{}
 	empty             struct{}
@@ -5420,6 +5769,21 @@ func (st *yySymType) exprsUnion() Exprs {
 	return v
 }
 
+func (st *yySymType) frameClauseUnion() *FrameClause {
+	v, _ := st.union.(*FrameClause)
+	return v
+}
+
+func (st *yySymType) framePointUnion() *FramePoint {
+	v, _ := st.union.(*FramePoint)
+	return v
+}
+
+func (st *yySymType) frameUnitTypeUnion() FrameUnitType {
+	v, _ := st.union.(FrameUnitType)
+	return v
+}
+
 func (st *yySymType) ignoreUnion() Ignore {
 	v, _ := st.union.(Ignore)
 	return v
@@ -5515,6 +5879,11 @@ func (st *yySymType) literalUnion() *Literal {
 	return v
 }
 
+func (st *yySymType) loadUnion() *Load {
+	v, _ := st.union.(*Load)
+	return v
+}
+
 func (st *yySymType) lockUnion() Lock {
 	v, _ := st.union.(Lock)
 	return v
@@ -5555,6 +5924,11 @@ func (st *yySymType) orderDirectionUnion() OrderDirection {
 	return v
 }
 
+func (st *yySymType) overClauseUnion() *OverClause {
+	v, _ := st.union.(*OverClause)
+	return v
+}
+
 func (st *yySymType) partDefUnion() *PartitionDefinition {
 	v, _ := st.union.(*PartitionDefinition)
 	return v
@@ -5745,174 +6119,196 @@ func (st *yySymType) whensUnion() []*When {
 	return v
 }
 
+func (st *yySymType) windowDefinitionUnion() *WindowDefinition {
+	v, _ := st.union.(*WindowDefinition)
+	return v
+}
+
+func (st *yySymType) windowDefinitionsUnion() WindowDefinitions {
+	v, _ := st.union.(WindowDefinitions)
+	return v
+}
+
+func (st *yySymType) windowSpecUnion() *WindowSpecification {
+	v, _ := st.union.(*WindowSpecification)
+	return v
+}
+
 func (st *yySymType) withUnion() *With {
 	v, _ := st.union.(*With)
 	return v
 }
 
 var yyR1 = [...]int{
-	0, 328, 329, 329, 5, 5, 5, 5, 5, 5,
+	0, 352, 353, 353, 5, 5, 5, 5, 5, 5,
 	5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
 	5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
-	5, 5, 5, 5, 5, 5, 5, 5, 307, 307,
-	307, 310, 310, 30, 65, 32, 32, 31, 31, 34,
-	34, 33, 6, 6, 6, 7, 7, 7, 7, 7,
-	7, 7, 7, 8, 8, 8, 8, 8, 9, 9,
-	9, 9, 11, 11, 11, 11, 11, 17, 18, 10,
-	10, 19, 19, 78, 78, 20, 21, 21, 21, 21,
-	332, 332, 135, 135, 133, 133, 134, 134, 203, 203,
-	22, 23, 23, 213, 213, 212, 212, 212, 214, 214,
-	214, 214, 248, 248, 24, 24, 24, 24, 24, 93,
-	93, 309, 309, 308, 306, 306, 305, 305, 304, 36,
-	37, 46, 46, 46, 46, 47, 48, 311, 311, 280,
-	52, 52, 51, 51, 51, 51, 53, 53, 50, 50,
-	49, 49, 282, 282, 269, 269, 281, 281, 281, 281,
-	281, 281, 281, 268, 268, 104, 104, 182, 182, 182,
-	182, 182, 182, 182, 182, 182, 182, 182, 323, 323,
-	183, 183, 183, 183, 183, 183, 113, 113, 121, 121,
-	121, 121, 111, 111, 112, 110, 110, 110, 116, 116,
+	5, 5, 5, 5, 5, 5, 5, 5, 331, 331,
+	331, 334, 334, 30, 65, 65, 158, 158, 156, 156,
+	156, 159, 159, 157, 157, 157, 152, 152, 153, 153,
+	153, 153, 154, 154, 155, 155, 155, 160, 160, 160,
+	162, 162, 161, 161, 163, 163, 32, 32, 31, 31,
+	34, 34, 33, 6, 6, 6, 7, 7, 7, 7,
+	7, 7, 7, 7, 8, 8, 8, 8, 8, 9,
+	9, 9, 9, 11, 11, 11, 11, 11, 17, 18,
+	10, 10, 19, 19, 78, 78, 20, 21, 21, 21,
+	21, 356, 356, 135, 135, 133, 133, 134, 134, 227,
+	227, 22, 23, 23, 237, 237, 236, 236, 236, 238,
+	238, 238, 238, 272, 272, 24, 24, 24, 24, 24,
+	93, 93, 333, 333, 332, 330, 330, 329, 329, 328,
+	36, 37, 46, 46, 46, 46, 47, 48, 335, 335,
+	304, 52, 52, 51, 51, 51, 51, 53, 53, 50,
+	50, 49, 49, 306, 306, 293, 293, 305, 305, 305,
+	305, 305, 305, 305, 292, 292, 104, 104, 206, 206,
+	206, 206, 206, 206, 206, 206, 206, 206, 206, 347,
+	347, 207, 207, 207, 207, 207, 207, 113, 113, 121,
+	121, 121, 121, 111, 111, 112, 110, 110, 110, 116,
 	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
-	327, 327, 327, 327, 327, 327, 327, 327, 327, 327,
-	327, 327, 327, 327, 327, 327, 327, 327, 327, 327,
-	327, 327, 327, 327, 327, 327, 327, 327, 327, 327,
-	327, 327, 327, 327, 327, 327, 327, 327, 327, 327,
-	327, 120, 120, 117, 117, 117, 118, 118, 118, 324,
-	324, 324, 324, 251, 251, 251, 251, 254, 254, 252,
-	252, 252, 252, 252, 252, 252, 252, 252, 253, 253,
-	253, 253, 253, 255, 255, 255, 255, 255, 256, 256,
-	256, 256, 256, 256, 256, 256, 256, 256, 256, 256,
-	256, 256, 256, 257, 257, 257, 257, 257, 257, 257,
-	257, 267, 267, 258, 258, 262, 262, 263, 263, 263,
-	264, 264, 264, 265, 265, 260, 260, 260, 260, 260,
-	260, 261, 261, 261, 270, 294, 294, 293, 293, 291,
-	291, 291, 291, 279, 279, 288, 288, 288, 288, 288,
-	278, 278, 274, 274, 274, 275, 275, 276, 276, 273,
-	273, 277, 277, 290, 290, 289, 271, 271, 272, 272,
-	296, 325, 325, 325, 325, 326, 326, 297, 316, 317,
-	315, 315, 315, 315, 315, 75, 75, 75, 225, 225,
-	225, 286, 286, 285, 285, 285, 287, 287, 284, 284,
-	284, 284, 284, 284, 284, 284, 284, 284, 284, 284,
-	284, 284, 284, 284, 284, 284, 284, 284, 284, 284,
-	284, 284, 284, 284, 284, 284, 220, 220, 220, 314,
-	314, 314, 314, 314, 314, 313, 313, 313, 283, 283,
-	283, 312, 312, 54, 54, 167, 167, 43, 43, 43,
-	43, 43, 43, 42, 42, 42, 38, 38, 38, 38,
+	116, 351, 351, 351, 351, 351, 351, 351, 351, 351,
+	351, 351, 351, 351, 351, 351, 351, 351, 351, 351,
+	351, 351, 351, 351, 351, 351, 351, 351, 351, 351,
+	351, 351, 351, 351, 351, 351, 351, 351, 351, 351,
+	351, 351, 120, 120, 117, 117, 117, 118, 118, 118,
+	348, 348, 348, 348, 275, 275, 275, 275, 278, 278,
+	276, 276, 276, 276, 276, 276, 276, 276, 276, 277,
+	277, 277, 277, 277, 279, 279, 279, 279, 279, 280,
+	280, 280, 280, 280, 280, 280, 280, 280, 280, 280,
+	280, 280, 280, 280, 281, 281, 281, 281, 281, 281,
+	281, 281, 291, 291, 282, 282, 286, 286, 287, 287,
+	287, 288, 288, 288, 289, 289, 284, 284, 284, 284,
+	284, 284, 285, 285, 285, 294, 318, 318, 317, 317,
+	315, 315, 315, 315, 303, 303, 312, 312, 312, 312,
+	312, 302, 302, 298, 298, 298, 299, 299, 300, 300,
+	297, 297, 301, 301, 314, 314, 313, 295, 295, 296,
+	296, 320, 349, 349, 349, 349, 350, 350, 321, 340,
+	341, 339, 339, 339, 339, 339, 75, 75, 75, 249,
+	249, 249, 310, 310, 309, 309, 309, 311, 311, 308,
+	308, 308, 308, 308, 308, 308, 308, 308, 308, 308,
+	308, 308, 308, 308, 308, 308, 308, 308, 308, 308,
+	308, 308, 308, 308, 308, 308, 308, 244, 244, 244,
+	338, 338, 338, 338, 338, 338, 337, 337, 337, 307,
+	307, 307, 336, 336, 54, 54, 191, 191, 43, 43,
+	43, 43, 43, 43, 42, 42, 42, 38, 38, 38,
 	38, 38, 38, 38, 38, 38, 38, 38, 38, 38,
-	38, 38, 38, 38, 38, 38, 38, 38, 38, 44,
-	44, 39, 39, 39, 39, 39, 39, 39, 39, 39,
-	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
+	38, 38, 38, 38, 38, 38, 38, 38, 38, 38,
+	44, 44, 39, 39, 39, 39, 39, 39, 39, 39,
+	39, 25, 25, 25, 25, 25, 25, 25, 25, 25,
 	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
-	80, 80, 81, 81, 81, 81, 82, 82, 82, 299,
-	299, 55, 55, 3, 3, 1, 1, 2, 2, 4,
-	4, 303, 303, 303, 303, 303, 303, 303, 303, 303,
-	303, 303, 303, 303, 303, 303, 303, 303, 303, 303,
-	303, 303, 303, 266, 266, 266, 298, 298, 300, 302,
-	302, 302, 302, 301, 89, 89, 26, 35, 35, 27,
-	27, 27, 27, 28, 28, 56, 57, 57, 57, 57,
+	25, 80, 80, 81, 81, 81, 81, 82, 82, 82,
+	323, 323, 55, 55, 3, 3, 1, 1, 2, 2,
+	4, 4, 327, 327, 327, 327, 327, 327, 327, 327,
+	327, 327, 327, 327, 327, 327, 327, 327, 327, 327,
+	327, 327, 327, 327, 290, 290, 290, 322, 322, 324,
+	326, 326, 326, 326, 325, 89, 89, 26, 35, 35,
+	27, 27, 27, 27, 28, 28, 56, 57, 57, 57,
 	57, 57, 57, 57, 57, 57, 57, 57, 57, 57,
 	57, 57, 57, 57, 57, 57, 57, 57, 57, 57,
 	57, 57, 57, 57, 57, 57, 57, 57, 57, 57,
 	57, 57, 57, 57, 57, 57, 57, 57, 57, 57,
-	57, 57, 57, 57, 318, 318, 219, 219, 227, 227,
-	218, 218, 241, 241, 241, 221, 221, 221, 222, 222,
-	322, 322, 322, 58, 58, 58, 60, 60, 61, 62,
-	62, 243, 243, 244, 244, 63, 64, 76, 76, 76,
-	76, 76, 76, 79, 79, 79, 13, 13, 13, 13,
-	72, 72, 72, 12, 12, 59, 59, 66, 319, 319,
-	320, 321, 321, 321, 321, 67, 69, 29, 29, 29,
-	29, 29, 29, 99, 99, 87, 87, 87, 87, 87,
-	87, 87, 87, 87, 87, 87, 87, 94, 94, 94,
-	88, 88, 333, 70, 71, 71, 92, 92, 92, 85,
-	85, 85, 91, 91, 91, 14, 14, 15, 202, 202,
-	16, 16, 96, 96, 98, 98, 98, 98, 98, 100,
-	100, 100, 100, 100, 100, 100, 95, 95, 97, 97,
-	97, 97, 234, 234, 234, 233, 233, 124, 124, 126,
-	125, 125, 127, 127, 128, 128, 128, 165, 143, 143,
-	200, 200, 198, 198, 199, 199, 201, 201, 201, 201,
-	204, 204, 129, 129, 129, 129, 130, 130, 131, 131,
-	132, 132, 242, 242, 239, 239, 239, 238, 238, 136,
-	136, 136, 138, 137, 137, 137, 137, 139, 139, 141,
-	141, 140, 140, 142, 147, 147, 146, 146, 144, 144,
-	144, 144, 145, 145, 145, 145, 148, 148, 109, 109,
-	109, 109, 109, 109, 119, 119, 119, 119, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 114,
+	57, 57, 57, 57, 57, 342, 342, 243, 243, 251,
+	251, 242, 242, 265, 265, 265, 245, 245, 245, 246,
+	246, 346, 346, 346, 58, 58, 58, 60, 60, 61,
+	62, 62, 267, 267, 268, 268, 63, 64, 76, 76,
+	76, 76, 76, 76, 79, 79, 79, 13, 13, 13,
+	13, 72, 72, 72, 12, 12, 59, 59, 66, 343,
+	343, 344, 345, 345, 345, 345, 67, 69, 29, 29,
+	29, 29, 29, 29, 99, 99, 87, 87, 87, 87,
+	87, 87, 87, 87, 87, 87, 87, 87, 94, 94,
+	94, 88, 88, 357, 70, 71, 71, 92, 92, 92,
+	85, 85, 85, 91, 91, 91, 14, 14, 15, 226,
+	226, 16, 16, 96, 96, 98, 98, 98, 98, 98,
+	100, 100, 100, 100, 100, 100, 100, 95, 95, 97,
+	97, 97, 97, 258, 258, 258, 257, 257, 124, 124,
+	126, 125, 125, 127, 127, 128, 128, 128, 189, 143,
+	143, 224, 224, 222, 222, 223, 223, 225, 225, 225,
+	225, 228, 228, 129, 129, 129, 129, 130, 130, 131,
+	131, 132, 132, 266, 266, 263, 263, 263, 262, 262,
+	136, 136, 136, 138, 137, 137, 137, 137, 139, 139,
+	141, 141, 140, 140, 142, 147, 147, 146, 146, 144,
+	144, 144, 144, 145, 145, 145, 145, 148, 148, 109,
+	109, 109, 109, 109, 109, 119, 119, 119, 119, 122,
+	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
 	114, 114, 114, 114, 114, 114, 114, 114, 114, 114,
-	114, 114, 115, 115, 115, 115, 115, 115, 115, 115,
+	114, 114, 114, 115, 115, 115, 115, 115, 115, 115,
 	115, 115, 115, 115, 115, 115, 115, 115, 115, 115,
-	115, 115, 115, 115, 115, 77, 77, 77, 216, 216,
-	149, 149, 157, 157, 157, 157, 150, 150, 150, 150,
-	150, 150, 150, 158, 158, 158, 164, 159, 159, 155,
-	155, 155, 155, 153, 153, 153, 153, 153, 153, 153,
-	153, 153, 153, 154, 154, 154, 154, 154, 154, 154,
-	154, 154, 154, 154, 154, 154, 154, 84, 84, 84,
+	115, 115, 115, 115, 115, 115, 115, 77, 77, 77,
+	240, 240, 149, 149, 181, 181, 181, 181, 150, 150,
+	150, 150, 150, 150, 150, 182, 182, 182, 188, 183,
+	183, 167, 167, 167, 167, 167, 169, 169, 169, 169,
+	169, 169, 169, 169, 169, 169, 169, 172, 172, 173,
+	180, 180, 171, 171, 174, 174, 174, 176, 176, 175,
+	175, 175, 175, 175, 170, 170, 170, 178, 178, 179,
+	179, 177, 165, 165, 165, 165, 165, 165, 165, 165,
+	165, 165, 165, 166, 166, 166, 166, 166, 166, 166,
+	166, 166, 166, 166, 166, 166, 166, 84, 84, 84,
 	84, 84, 84, 84, 84, 84, 84, 84, 84, 83,
-	83, 83, 83, 83, 83, 83, 83, 83, 334, 334,
-	259, 259, 259, 156, 156, 156, 156, 156, 90, 90,
-	90, 90, 90, 247, 247, 247, 250, 250, 250, 249,
-	249, 249, 249, 249, 249, 249, 249, 249, 249, 249,
-	249, 249, 249, 249, 170, 170, 86, 86, 168, 168,
-	169, 171, 171, 166, 166, 166, 152, 152, 152, 172,
-	172, 173, 173, 174, 174, 176, 175, 175, 177, 178,
-	178, 178, 179, 179, 180, 180, 180, 45, 45, 45,
+	83, 83, 83, 83, 83, 83, 83, 83, 358, 358,
+	283, 283, 283, 168, 168, 168, 168, 168, 90, 90,
+	90, 90, 90, 271, 271, 271, 274, 274, 274, 273,
+	273, 273, 273, 273, 273, 273, 273, 273, 273, 273,
+	273, 273, 273, 273, 194, 194, 86, 86, 192, 192,
+	193, 195, 195, 190, 190, 190, 164, 164, 164, 196,
+	196, 197, 197, 198, 198, 200, 199, 199, 201, 202,
+	202, 202, 203, 203, 204, 204, 204, 45, 45, 45,
 	45, 45, 40, 40, 40, 40, 41, 41, 41, 101,
 	101, 101, 101, 103, 103, 102, 102, 73, 73, 74,
 	74, 74, 107, 107, 108, 108, 108, 105, 105, 106,
-	106, 196, 196, 181, 181, 181, 188, 188, 188, 184,
-	184, 186, 186, 186, 187, 187, 187, 185, 193, 193,
-	195, 195, 194, 194, 190, 190, 191, 191, 192, 192,
-	192, 189, 189, 151, 151, 151, 151, 151, 197, 197,
-	197, 197, 205, 205, 161, 161, 163, 163, 162, 123,
-	206, 206, 210, 207, 207, 211, 211, 211, 211, 211,
-	208, 208, 209, 209, 235, 235, 235, 215, 215, 226,
-	226, 223, 223, 224, 224, 217, 217, 228, 228, 228,
-	68, 160, 160, 295, 295, 292, 231, 231, 232, 232,
-	236, 236, 240, 240, 237, 237, 229, 229, 229, 229,
-	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
-	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
-	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
-	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
-	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
-	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
-	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
-	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
-	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
-	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
-	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
-	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
-	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
-	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 330,
-	331, 245, 246, 246, 246,
+	106, 220, 220, 205, 205, 205, 212, 212, 212, 208,
+	208, 210, 210, 210, 211, 211, 211, 209, 217, 217,
+	219, 219, 218, 218, 214, 214, 215, 215, 216, 216,
+	216, 213, 213, 151, 151, 151, 151, 151, 221, 221,
+	221, 221, 229, 229, 185, 185, 187, 187, 186, 123,
+	230, 230, 234, 231, 231, 235, 235, 235, 235, 235,
+	232, 232, 233, 233, 259, 259, 259, 239, 239, 250,
+	250, 247, 247, 248, 248, 241, 241, 252, 252, 252,
+	68, 184, 184, 319, 319, 316, 255, 255, 256, 256,
+	260, 260, 264, 264, 261, 261, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 354, 355, 269, 270, 270, 270,
 }
 
 var yyR2 = [...]int{
@@ -5920,94 +6316,101 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 0, 1, 1,
-	1, 0, 1, 2, 3, 2, 3, 0, 1, 3,
-	1, 4, 3, 3, 4, 3, 2, 3, 4, 3,
-	4, 2, 7, 1, 3, 3, 3, 3, 1, 2,
-	1, 1, 3, 2, 3, 3, 2, 5, 7, 9,
-	8, 7, 8, 1, 1, 10, 11, 9, 8, 8,
-	1, 1, 1, 3, 1, 3, 1, 3, 0, 4,
-	3, 5, 4, 1, 3, 3, 2, 2, 2, 2,
-	2, 1, 1, 1, 2, 2, 6, 12, 2, 0,
-	2, 0, 2, 1, 0, 2, 1, 3, 3, 6,
-	4, 7, 8, 8, 8, 6, 3, 1, 1, 5,
-	0, 1, 1, 1, 2, 2, 0, 1, 4, 4,
-	4, 4, 2, 4, 1, 3, 1, 1, 3, 4,
-	3, 3, 3, 5, 10, 0, 2, 0, 2, 3,
-	5, 3, 4, 2, 3, 2, 3, 3, 1, 1,
-	0, 2, 2, 3, 3, 2, 1, 1, 2, 2,
-	2, 2, 1, 1, 1, 1, 2, 2, 1, 1,
-	1, 1, 1, 1, 1, 2, 2, 2, 2, 2,
+	1, 0, 1, 2, 17, 4, 1, 1, 0, 1,
+	1, 0, 1, 0, 1, 1, 0, 2, 0, 4,
+	5, 4, 0, 2, 0, 4, 4, 0, 3, 3,
+	0, 3, 1, 3, 0, 2, 2, 3, 0, 1,
+	3, 1, 4, 3, 3, 4, 3, 2, 3, 4,
+	3, 4, 2, 7, 1, 3, 3, 3, 3, 1,
+	2, 1, 1, 3, 2, 3, 3, 2, 5, 7,
+	10, 9, 7, 8, 1, 1, 10, 11, 9, 8,
+	8, 1, 1, 1, 3, 1, 3, 1, 3, 0,
+	4, 3, 5, 4, 1, 3, 3, 2, 2, 2,
+	2, 2, 1, 1, 1, 2, 2, 6, 12, 2,
+	0, 2, 0, 2, 1, 0, 2, 1, 3, 3,
+	6, 4, 7, 8, 8, 8, 6, 3, 1, 1,
+	5, 0, 1, 1, 1, 2, 2, 0, 1, 4,
+	4, 4, 4, 2, 4, 1, 3, 1, 1, 3,
+	4, 3, 3, 3, 5, 10, 0, 2, 0, 2,
+	3, 5, 3, 4, 2, 3, 2, 3, 3, 1,
+	1, 0, 2, 2, 3, 3, 2, 1, 1, 2,
+	2, 2, 2, 1, 1, 1, 1, 2, 2, 1,
+	1, 1, 1, 1, 1, 1, 2, 2, 2, 2,
+	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 2, 2,
-	1, 2, 1, 3, 1, 1, 1, 2, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 2, 2,
-	2, 2, 2, 1, 2, 2, 2, 2, 3, 3,
-	2, 2, 2, 2, 2, 2, 1, 1, 1, 1,
-	1, 5, 5, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 3, 0, 3, 0, 5, 0, 3, 5,
-	0, 1, 1, 0, 1, 0, 2, 2, 2, 1,
-	1, 0, 2, 2, 5, 0, 1, 1, 2, 1,
-	3, 2, 3, 0, 1, 4, 3, 3, 4, 2,
-	0, 2, 1, 1, 1, 1, 1, 0, 1, 1,
-	1, 0, 1, 1, 3, 3, 3, 1, 3, 1,
-	7, 5, 6, 6, 7, 0, 1, 5, 3, 3,
-	1, 1, 2, 2, 2, 0, 1, 1, 0, 1,
-	2, 0, 1, 1, 3, 2, 1, 2, 3, 3,
-	4, 4, 3, 3, 3, 3, 4, 4, 3, 3,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 2,
+	2, 1, 2, 1, 3, 1, 1, 1, 2, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 2,
+	2, 2, 2, 2, 1, 2, 2, 2, 2, 3,
+	3, 2, 2, 2, 2, 2, 2, 1, 1, 1,
+	1, 1, 5, 5, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 3, 0, 3, 0, 5, 0, 3,
+	5, 0, 1, 1, 0, 1, 0, 2, 2, 2,
+	1, 1, 0, 2, 2, 5, 0, 1, 1, 2,
+	1, 3, 2, 3, 0, 1, 4, 3, 3, 4,
+	2, 0, 2, 1, 1, 1, 1, 1, 0, 1,
+	1, 1, 0, 1, 1, 3, 3, 3, 1, 3,
+	1, 7, 5, 6, 6, 7, 0, 1, 5, 3,
+	3, 1, 1, 2, 2, 2, 0, 1, 1, 0,
+	1, 2, 0, 1, 1, 3, 2, 1, 2, 3,
+	3, 4, 4, 3, 3, 3, 3, 4, 4, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 4, 5, 0, 2, 2, 1,
+	3, 3, 3, 3, 3, 4, 5, 0, 2, 2,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 0, 1, 0, 1, 0, 2, 0, 1, 5,
-	1, 3, 7, 1, 3, 3, 1, 2, 2, 2,
-	5, 5, 5, 6, 8, 6, 5, 5, 2, 2,
-	2, 2, 3, 3, 3, 4, 1, 3, 5, 1,
-	3, 3, 3, 3, 3, 3, 3, 3, 2, 2,
-	3, 4, 4, 2, 11, 3, 6, 8, 6, 6,
-	6, 13, 8, 6, 10, 5, 5, 5, 5, 5,
-	0, 6, 5, 6, 4, 5, 0, 8, 9, 0,
-	3, 0, 1, 0, 3, 1, 1, 0, 2, 0,
-	2, 5, 3, 7, 4, 4, 4, 4, 3, 3,
-	3, 7, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 2, 0, 2, 2, 1, 3, 2, 0,
-	4, 4, 3, 2, 1, 3, 3, 3, 5, 7,
-	7, 6, 5, 3, 2, 3, 3, 3, 7, 3,
-	3, 3, 3, 4, 7, 5, 2, 4, 4, 4,
-	4, 4, 5, 5, 4, 4, 4, 4, 4, 4,
-	4, 4, 4, 4, 4, 2, 4, 2, 4, 5,
-	4, 4, 4, 4, 4, 3, 3, 3, 5, 2,
-	3, 3, 3, 3, 1, 1, 0, 1, 0, 1,
-	1, 1, 0, 2, 2, 0, 2, 2, 0, 2,
-	0, 1, 1, 2, 1, 3, 1, 2, 1, 1,
-	5, 0, 1, 0, 1, 2, 3, 0, 3, 3,
-	3, 3, 1, 1, 1, 1, 1, 1, 1, 1,
-	0, 1, 1, 3, 3, 2, 2, 3, 1, 3,
-	2, 1, 2, 1, 2, 2, 4, 3, 3, 6,
-	4, 7, 6, 1, 3, 2, 2, 2, 2, 1,
-	1, 1, 3, 2, 1, 1, 1, 0, 1, 1,
-	0, 3, 0, 2, 0, 2, 1, 2, 2, 0,
-	1, 1, 0, 1, 1, 5, 5, 4, 0, 2,
-	4, 4, 0, 1, 0, 1, 2, 3, 4, 1,
-	1, 1, 1, 1, 1, 1, 1, 3, 1, 2,
-	3, 5, 0, 1, 2, 1, 1, 0, 1, 2,
-	1, 3, 1, 1, 1, 4, 3, 3, 3, 7,
-	0, 3, 1, 3, 1, 3, 1, 1, 3, 3,
-	1, 3, 4, 4, 4, 3, 2, 4, 0, 1,
-	0, 2, 0, 1, 0, 1, 2, 1, 1, 1,
-	2, 2, 1, 2, 3, 2, 3, 2, 2, 2,
-	1, 1, 3, 3, 0, 1, 1, 2, 6, 5,
-	6, 6, 0, 2, 3, 3, 0, 2, 3, 3,
-	3, 2, 3, 1, 3, 4, 3, 1, 3, 4,
-	5, 6, 3, 4, 5, 6, 3, 4, 1, 3,
+	1, 1, 0, 1, 0, 1, 0, 2, 0, 1,
+	5, 1, 3, 7, 1, 3, 3, 1, 2, 2,
+	2, 5, 5, 5, 6, 8, 6, 5, 5, 2,
+	2, 2, 2, 3, 3, 3, 4, 1, 3, 5,
+	1, 3, 3, 3, 3, 3, 3, 3, 3, 2,
+	2, 3, 4, 4, 2, 11, 3, 6, 8, 6,
+	6, 6, 13, 8, 6, 10, 5, 5, 5, 5,
+	5, 0, 6, 5, 6, 4, 5, 0, 8, 9,
+	0, 3, 0, 1, 0, 3, 1, 1, 0, 2,
+	0, 2, 5, 3, 7, 4, 4, 4, 4, 3,
+	3, 3, 7, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 2, 0, 2, 2, 1, 3, 2,
+	0, 4, 4, 3, 2, 1, 3, 3, 3, 5,
+	7, 7, 6, 5, 3, 2, 3, 3, 3, 7,
+	3, 3, 3, 3, 4, 7, 5, 2, 4, 4,
+	4, 4, 4, 5, 5, 4, 4, 4, 4, 4,
+	4, 4, 4, 4, 4, 4, 2, 4, 2, 4,
+	5, 4, 4, 4, 4, 4, 3, 3, 3, 5,
+	2, 3, 3, 3, 3, 1, 1, 0, 1, 0,
+	1, 1, 1, 0, 2, 2, 0, 2, 2, 0,
+	2, 0, 1, 1, 2, 1, 3, 1, 2, 1,
+	1, 5, 0, 1, 0, 1, 2, 3, 0, 3,
+	3, 3, 3, 1, 1, 1, 1, 1, 1, 1,
+	1, 0, 1, 1, 3, 3, 2, 2, 3, 1,
+	3, 2, 1, 2, 1, 2, 2, 4, 3, 3,
+	6, 4, 7, 6, 1, 3, 2, 2, 2, 2,
+	1, 1, 1, 3, 2, 1, 1, 1, 0, 1,
+	1, 0, 3, 0, 2, 0, 2, 1, 2, 2,
+	0, 1, 1, 0, 1, 1, 5, 5, 4, 0,
+	2, 4, 4, 0, 1, 0, 1, 2, 3, 4,
+	1, 1, 1, 1, 1, 1, 1, 1, 3, 1,
+	2, 3, 5, 0, 1, 2, 1, 1, 0, 1,
+	2, 1, 3, 1, 1, 1, 4, 3, 3, 3,
+	7, 0, 3, 1, 3, 1, 3, 1, 1, 3,
+	3, 1, 3, 4, 4, 4, 3, 2, 4, 0,
+	1, 0, 2, 0, 1, 0, 1, 2, 1, 1,
+	1, 2, 2, 1, 2, 3, 2, 3, 2, 2,
+	2, 1, 1, 3, 3, 0, 1, 1, 2, 6,
+	5, 6, 6, 0, 2, 3, 3, 0, 2, 3,
+	3, 3, 2, 3, 1, 3, 4, 3, 1, 3,
+	4, 5, 6, 3, 4, 5, 6, 3, 4, 1,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 1, 1, 1, 1, 1, 3, 1, 1, 2,
-	2, 2, 2, 1, 1, 2, 9, 6, 6, 6,
-	2, 2, 3, 3, 3, 1, 1, 1, 0, 3,
-	1, 1, 1, 2, 1, 2, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 3, 4,
-	5, 5, 6, 4, 4, 8, 6, 8, 6, 8,
+	3, 3, 1, 1, 1, 1, 1, 1, 3, 1,
+	1, 2, 2, 2, 2, 1, 1, 2, 9, 6,
+	6, 6, 2, 2, 3, 3, 3, 1, 1, 1,
+	0, 3, 1, 1, 1, 2, 1, 2, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	3, 4, 5, 5, 6, 5, 4, 4, 4, 4,
+	4, 5, 5, 5, 5, 5, 7, 4, 2, 4,
+	0, 1, 0, 3, 0, 2, 5, 1, 1, 2,
+	2, 2, 2, 2, 1, 1, 3, 0, 2, 1,
+	3, 5, 4, 4, 4, 8, 6, 8, 6, 8,
 	5, 4, 2, 2, 1, 2, 2, 2, 8, 8,
 	6, 5, 4, 4, 7, 4, 6, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -6077,547 +6480,581 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 0, 0, 1, 1,
+	1, 1, 1, 1, 1, 1, 0, 0, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -328, -5, -9, -17, -18, -19, -20, -21, -22,
+	-1000, -352, -5, -9, -17, -18, -19, -20, -21, -22,
 	-23, -24, -25, -26, -27, -28, -56, -57, -58, -60,
 	-61, -62, -63, -64, -12, -59, -29, -30, -65, -66,
 	-67, -68, -69, -14, -15, -16, -7, -6, -11, 7,
-	8, -78, -31, 30, -36, -46, 206, -47, -37, 207,
-	-48, 209, 208, 245, 210, 238, 72, 287, 288, 290,
-	291, 292, 293, -79, 243, 244, 212, 34, 43, 31,
-	32, 35, 216, 251, 252, 215, -8, -32, 6, -330,
-	9, 413, 240, 239, 26, -10, 430, 83, -329, 564,
-	-196, -181, 20, 31, 27, -180, -176, -92, -181, 18,
-	16, 5, -70, -333, -70, -70, 10, 11, -70, -280,
-	-282, 83, 143, 83, -70, -52, -51, -50, -49, -53,
-	29, -43, -44, -303, -42, -39, 211, 208, 255, 107,
-	108, 245, 246, 247, 210, 229, 244, 248, 243, 264,
-	-38, 78, 31, 430, 433, -287, 207, 213, 214, 414,
-	110, 109, 73, 209, -284, 339, 534, -53, 536, 99,
-	101, 535, 42, 219, 537, 538, 539, 540, 228, 541,
-	542, 543, 544, 550, 551, 552, 553, 111, 5, -70,
-	-240, -236, -307, -230, 86, 87, 88, 427, 231, 461,
-	462, 280, 78, 39, 336, 339, 534, 287, 301, 295,
-	322, 314, 428, 463, 283, 232, 268, 531, 312, 119,
-	536, 286, 464, 246, 344, 345, 346, 99, 290, 381,
-	549, 285, 465, 547, 101, 535, 77, 49, 42, 241,
-	310, 215, 306, 537, 269, 466, 438, 262, 110, 107,
-	556, 34, 304, 48, 28, 546, 109, 47, 538, 134,
-	467, 539, 348, 327, 525, 46, 349, 247, 468, 81,
-	252, 432, 533, 350, 305, 351, 279, 545, 212, 469,
-	517, 352, 353, 526, 470, 328, 332, 471, 373, 354,
-	563, 50, 472, 473, 527, 108, 474, 76, 540, 299,
-	300, 475, 277, 230, 375, 326, 228, 33, 355, 429,
-	281, 56, 256, 376, 44, 330, 560, 43, 521, 476,
-	524, 325, 321, 423, 51, 477, 478, 479, 480, 541,
-	324, 298, 320, 555, 450, 274, 542, 60, 214, 334,
-	333, 335, 263, 372, 317, 481, 482, 483, 235, 79,
-	484, 307, 19, 485, 486, 356, 270, 487, 54, 488,
-	489, 379, 244, 490, 52, 543, 37, 249, 557, 544,
-	491, 492, 493, 494, 251, 495, 358, 496, 357, 329,
-	331, 258, 359, 431, 497, 303, 248, 548, 498, 236,
-	532, 250, 255, 243, 380, 237, 499, 500, 501, 502,
-	503, 284, 504, 505, 291, 550, 424, 41, 506, 507,
-	508, 509, 278, 273, 374, 383, 59, 80, 341, 510,
-	530, 297, 271, 511, 288, 53, 551, 552, 553, 265,
-	554, 7, 561, 562, 361, 111, 275, 276, 45, 318,
-	257, 512, 513, 308, 309, 323, 296, 319, 289, 518,
-	259, 362, 425, 245, 514, 382, 272, 337, 342, 435,
-	264, 363, 529, 434, 316, 313, 266, 515, 364, 220,
-	260, 261, 516, 519, 365, 366, 282, 367, 368, 369,
-	370, 371, 267, 433, 294, 311, 343, 394, 395, 396,
-	397, 398, 399, 400, 401, 402, 403, 404, 405, 406,
-	407, 408, 409, 410, 411, 422, 218, -70, 218, -140,
-	-236, 218, -208, 345, -227, 347, 360, 355, 365, 353,
-	-219, 356, 358, 258, -322, 373, 218, 362, 206, 160,
-	348, 357, 366, 367, 282, 368, 371, 267, -318, -307,
-	539, 554, 119, 315, 352, 350, 374, 521, 370, 369,
-	-236, 289, -243, 294, -231, -307, -230, 292, -140, -76,
-	517, 210, -245, -245, -94, 521, 523, -159, -109, 127,
-	-119, -122, -114, -115, -153, -154, -155, -156, -120, -166,
-	149, 150, 157, 128, -164, -123, 24, 426, 415, 414,
-	160, 29, 202, 66, 67, 417, 418, 130, 55, 390,
-	391, -121, 386, 392, 387, 420, 421, 102, 422, 423,
-	424, 425, -231, -236, 223, 385, 217, 155, 413, -116,
-	-112, -6, -162, 384, 388, 389, 393, -307, -230, -118,
-	-117, -149, 89, 95, 100, 96, -327, 103, -330, 90,
-	91, 92, 93, 94, 104, 105, 161, 162, 163, 164,
-	165, 166, 167, 168, 169, 170, 171, 172, 173, 174,
-	175, 176, 177, 178, 179, 180, 181, 182, 183, 184,
-	185, 186, 187, 188, 189, 190, 191, 192, 193, 194,
-	195, 196, 197, 198, 199, 200, 201, 42, 361, 361,
-	-140, -70, -70, -70, -70, -174, -92, -176, -8, -6,
-	-330, 6, -70, -6, -7, -11, -32, -34, 457, -33,
-	-236, -181, -196, 10, 145, 40, 48, -179, -180, -10,
-	-6, -109, 17, 21, 22, -97, 151, -109, -236, -71,
-	-97, -217, 222, -70, -70, -207, -248, 289, -211, 374,
-	373, -232, -209, -231, -229, -208, 372, 211, 436, 126,
-	23, 25, 129, 160, 114, 17, 130, 35, 213, 315,
-	229, 159, 225, 414, 206, 70, 437, 386, 387, 384,
-	390, 416, 417, 385, 347, 29, 11, 439, 26, 239,
-	22, 36, 153, 208, 133, 242, 24, 240, 102, 105,
-	442, 20, 73, 234, 12, 227, 38, 14, 443, 444,
-	15, 223, 222, 145, 219, 68, 9, 202, 27, 142,
-	64, 445, 121, 446, 447, 448, 449, 115, 66, 143,
-	18, 558, 388, 389, 31, 522, 426, 253, 155, 71,
-	57, 523, 127, 451, 452, 103, 453, 106, 74, 528,
-	123, 16, 69, 40, 454, 254, 455, 224, 559, 456,
-	377, 457, 144, 209, 413, 67, 458, 217, 360, 6,
-	419, 30, 238, 226, 113, 65, 459, 218, 132, 420,
-	421, 221, 116, 104, 5, 120, 32, 10, 72, 75,
-	391, 392, 393, 55, 112, 430, 131, 13, 460, 378,
-	125, 119, -281, 143, -268, -272, -231, 233, -297, 229,
-	-140, -290, -289, -231, -93, -226, 219, 227, 226, 120,
-	-311, 123, 275, 385, 217, -49, -50, -208, 159, -80,
-	250, 254, 84, 84, -272, -271, -270, -312, 254, 233,
-	-296, -288, 225, 234, -278, 226, 227, -273, 219, 121,
-	-312, -273, 224, 234, 254, 254, 111, 254, 111, 254,
-	254, 254, 254, 254, 254, 254, 254, 254, 249, -279,
-	135, -279, 434, 434, -284, -312, -312, -312, 221, 33,
-	33, -228, -273, 221, 23, -279, -279, -208, 159, -279,
-	-279, -279, -279, 262, 262, -279, -279, -279, -279, -279,
-	-279, -279, -279, -279, -279, -279, -279, -279, -279, -279,
-	218, -311, -101, 371, 282, 78, -51, 264, -35, -140,
-	-226, 219, 220, -311, 251, -140, 203, -140, -221, 143,
-	13, -221, -218, 361, 359, 346, 351, -221, -221, -221,
-	-221, 265, 344, -274, 219, 33, 230, 361, 265, 344,
-	265, 266, 265, 266, 354, 364, 265, -241, 12, 145,
-	385, 349, 353, 258, 218, 259, 220, 363, -307, 524,
-	266, -241, 90, -222, 143, 361, 261, -221, -246, -330,
-	-232, 315, -246, -246, 30, 87, 221, -231, -72, -231,
-	90, -13, -9, -20, -19, -21, 135, -99, 361, -87,
-	160, 539, 525, 526, 527, 524, 358, 532, 530, 528,
-	265, 529, 84, 123, 125, 126, -109, 142, -150, 135,
-	136, 137, 138, 139, 140, 141, 145, 127, 129, 143,
-	144, 124, 146, 147, 148, 149, 150, 151, 152, 154,
-	153, 155, 156, 159, 204, 205, -115, -115, -115, -115,
-	-164, -330, -330, -330, -115, -216, -330, -115, -330, -330,
-	-330, -330, -170, -109, -330, -334, -330, -334, -334, -259,
-	-330, -259, -330, -330, -330, -330, -330, -330, -330, -330,
-	203, -330, -330, -330, -330, -330, -259, -259, -259, -259,
-	100, 95, 89, -166, 96, 90, -231, -236, -6, -7,
-	-159, -245, -319, -320, -143, -140, -330, 282, -231, -231,
-	251, -179, -10, -6, -174, -180, -176, -6, -70, -85,
-	-98, 61, 62, -100, 22, 36, 65, 63, 21, -331,
-	85, -331, -196, -331, 84, -34, -200, 83, 59, 41,
-	90, 90, 84, 19, -175, -177, -109, 12, -234, -233,
-	23, -231, 90, 203, 98, 12, -141, 27, -140, -217,
-	-217, 84, 289, -211, -248, -213, -212, 375, 377, 135,
-	-235, -231, 90, 29, 85, 84, -140, -251, -254, -256,
-	-255, -257, -252, -253, 312, 313, 160, 316, 318, 319,
-	320, 321, 322, 323, 324, 325, 326, 327, 30, 241,
-	308, 309, 310, 311, 328, 329, 330, 331, 332, 333,
-	334, 335, 295, 314, 428, 296, 297, 298, 299, 300,
-	301, 303, 304, 305, 306, 307, -310, -307, 83, 85,
-	84, -258, 83, -101, 218, -307, 219, 219, 219, -70,
-	413, -279, -279, 249, 17, -42, -39, -303, 16, -38,
-	-39, 211, 107, 108, 208, 83, -268, 83, -277, -310,
-	-307, 83, 121, 224, 120, -276, -273, -276, -277, -307,
-	-166, -307, 121, 121, -204, -231, -204, -204, 21, -204,
-	21, -204, 21, 92, -231, -204, 21, -204, 21, -204,
-	21, -204, 21, -204, 21, 29, 76, 77, 29, 79,
-	80, 81, -166, -166, -268, -208, -140, -307, 92, 92,
-	-279, -279, 92, 90, 90, 90, -279, -279, 92, 90,
-	-238, -236, 90, -313, 235, 279, 281, 92, 92, 92,
-	92, 29, 90, -314, 29, 546, 545, 547, 548, 549,
-	92, 29, 92, 29, 92, -231, 83, -140, -107, 269,
-	206, 208, 211, 74, 90, 283, 135, 42, 84, 221,
-	218, -307, -223, 223, -223, -231, -237, -236, -229, 90,
-	-109, -275, 12, 145, -241, -241, -221, -140, -275, -241,
-	-221, -140, -221, -221, -221, -221, -241, -241, -241, -221,
-	-236, -236, -140, -140, -140, -140, -140, -140, -140, -246,
-	-246, -246, -222, -221, 524, 90, 74, -244, 292, 326,
-	518, 519, 520, 84, 430, -133, -140, 524, 524, 524,
-	524, 524, 524, -109, -109, -109, -109, -157, 103, 127,
-	104, 105, -122, -158, -162, -164, 97, 145, 129, 143,
-	144, -114, -115, -114, -114, -114, -114, -114, -114, -114,
-	-114, -114, -114, -114, -114, -114, -247, -307, 90, 160,
-	90, 90, -95, -97, -109, -109, -307, -231, -95, -95,
-	-109, -91, 22, 36, -168, -169, 131, -166, -331, -331,
-	92, -231, -231, -84, -83, 395, 396, 397, 398, 400,
-	401, 402, 405, 406, 410, 411, 394, 412, 399, 404,
-	407, 408, 409, 403, 311, -109, -109, -109, -77, -109,
-	114, 115, 116, -96, 22, 36, -95, -232, -237, -229,
-	-95, -96, -96, -95, -95, 203, -196, -331, 84, -321,
-	377, 378, 522, -239, 254, -238, 23, -160, -159, 90,
-	12, -202, 75, -231, -179, -179, 61, 62, 57, -95,
-	-100, -331, -33, 23, -198, -231, 60, 90, -260, -208,
-	336, 337, -109, -109, 84, -178, 25, 26, -140, -233,
-	151, -237, -140, -203, 254, -140, -125, -127, -128, -129,
-	-143, -165, -330, 12, -133, -134, -142, -236, -211, -213,
-	84, 376, 378, 379, 74, 106, -109, -261, 159, -286,
-	-285, -284, -268, -270, -271, -272, 85, -261, -264, 342,
-	341, -258, -258, -258, -258, -258, -260, -260, -260, -260,
-	83, 83, -258, -258, -258, -258, -262, 83, -262, -262,
-	-263, 83, -263, -297, -109, -294, -293, -291, -292, 228,
-	99, 430, 75, -289, -178, 92, -107, -224, 223, -295,
-	-292, -307, -307, -307, -224, -307, 90, -307, 90, -81,
-	-55, -1, 558, 559, 560, 84, 17, -269, -268, -54,
-	279, -300, -301, 254, -296, -290, -277, 121, -276, -277,
-	-277, -307, 84, 27, 111, 111, 111, 111, 430, 208,
-	30, -268, -54, -247, 221, -247, -247, 90, 90, -220,
-	554, -133, -103, 271, 135, 260, 260, 218, 218, 273,
-	-140, 284, 286, 285, 283, 21, 272, 274, 276, 262,
-	-140, -140, -223, 74, -135, -140, 24, -236, -140, -221,
-	-221, -140, -221, -221, -140, -231, -87, 377, 84, 430,
-	20, -88, 20, 103, 104, 105, -158, -114, -115, -114,
-	126, 242, 84, -331, 23, 84, 75, -331, -331, -331,
-	84, 12, -95, -171, -169, 133, -109, -331, -331, 84,
-	84, 12, -250, 23, -331, -331, -170, -331, 12, -331,
-	-95, -95, -330, 203, -331, -331, -331, -331, -331, -232,
-	-320, 521, 378, -147, -146, -144, 72, 222, 73, -330,
-	-238, -331, 90, 87, -199, 87, -198, -152, -231, 92,
-	96, -181, -124, -126, 12, -100, -164, 85, 84, -260,
-	-185, -190, -218, -231, 90, 160, -177, 203, -148, 13,
-	-151, 30, 55, -9, -330, -330, 30, 84, -136, -138,
-	-137, -139, 64, 68, 70, 65, 66, 67, 71, -242,
-	23, -125, -7, -6, -330, -140, -133, -332, 12, 75,
-	-332, 84, 203, -212, -214, 380, 377, 383, -307, 90,
-	-80, 84, -284, -272, -182, -104, 38, -265, 343, -260,
-	-260, -267, 90, -267, 92, 92, 85, -45, -40, -41,
-	31, 78, -291, -279, 90, 37, -231, 85, -103, -140,
-	127, 74, -295, -295, -295, -236, -2, 557, 563, 121,
-	83, 346, 16, -198, 84, 85, -167, 280, 85, -302,
-	55, -231, 85, 83, -277, -277, -231, -330, 218, 29,
-	29, -54, -167, -261, -307, 556, 555, 85, 220, 278,
-	-108, 390, -105, 90, 86, -140, -140, -140, -140, -140,
-	211, 208, 263, 221, -133, -140, 84, -75, 237, 232,
-	-241, -241, 31, -140, 377, 533, 531, 126, 242, -122,
-	-115, -97, 427, -249, 160, 312, 241, 310, 306, 326,
-	317, 341, 308, 342, 305, 304, 303, -249, -247, -109,
-	-109, -174, 134, -109, 132, -109, -109, -109, -331, 160,
-	312, 12, -109, -331, -331, -96, -232, -144, -273, -273,
-	-273, -204, 84, -215, 20, 12, 55, 55, -124, -148,
-	-125, -100, -231, -188, 517, -193, 44, -191, -192, 45,
-	-189, 46, 54, 151, -179, -109, -205, 74, -206, -210,
-	-166, -161, -163, -162, -330, -197, -331, -231, -204, -206,
-	-127, -128, -128, -127, -128, 64, 64, 64, 69, 64,
-	69, 64, -137, -236, -331, -331, -7, -239, 75, -125,
-	-125, -142, -236, 151, 377, 381, 382, -284, -326, 103,
-	127, 29, 74, 339, 99, -324, 159, -325, 224, 120,
-	121, 236, 23, 39, 85, 84, 85, 84, 85, 84,
-	-225, 467, 127, -41, -40, -279, -279, 92, -307, 220,
-	24, -140, 74, 74, 74, -82, 561, 92, 83, -3,
-	78, -109, 83, 17, -268, -166, 256, 145, -294, -198,
-	-298, -300, -140, -111, -330, -110, -112, -116, 149, 150,
-	-167, -140, -102, 269, 277, 83, -106, 87, -309, 75,
-	260, 339, 260, -140, -75, -45, -140, -221, -221, 31,
-	-307, -122, -115, -330, -331, -258, -258, -258, -263, -258,
-	300, -258, 300, -258, -331, -331, 84, -331, 20, -331,
-	-86, 419, -109, 84, 84, -331, 83, 83, -109, -331,
-	-331, -145, 20, -145, -145, -331, 87, -140, -148, -172,
-	14, -185, 49, 318, -195, -194, 53, 45, -192, 17,
-	47, 17, 28, -205, 84, 135, 84, -331, -331, 84,
-	55, 203, -331, -148, -131, -130, 74, 75, -132, 74,
-	-130, 64, 64, -200, -331, -203, -125, -148, -148, 203,
-	103, -330, -113, -121, -111, 10, 90, 90, -307, 121,
-	121, -140, 83, -260, 90, -260, 92, 92, 467, 29,
-	79, 80, 81, 29, 76, 77, -140, -140, -140, -140,
-	-299, 83, 17, -109, 83, 135, 85, -198, -198, 257,
-	-162, -330, 85, -331, 84, -266, 430, 433, -109, -117,
-	-117, -200, 85, -306, 430, -308, -231, -231, -231, -231,
-	-114, -260, -109, -109, -179, 90, -109, -109, 92, 92,
-	-331, -330, 64, 16, 14, -330, -330, -239, -172, -173,
-	15, 17, -186, 51, -184, 50, -184, -194, 17, 17,
-	90, 17, 90, 121, -210, -109, -163, 55, -9, -231,
-	-161, -231, -174, -109, 83, -109, -148, -148, -109, -154,
-	422, 423, 424, 425, 83, -109, 85, 85, -200, -298,
-	-55, 85, -198, 92, 85, -162, -89, -330, 253, -325,
-	-300, 434, 434, -331, 23, -305, -304, -232, 83, 75,
-	-90, 145, 430, -331, -331, -331, -331, -331, 85, 85,
-	-201, -331, -231, 224, 17, 17, -201, -201, -147, -173,
-	-109, -159, -187, 52, 74, 106, 90, 90, 90, 10,
-	-161, 203, -179, -198, -174, -331, -198, 85, 23, 85,
-	563, 121, 85, 253, -9, 84, 135, -198, -140, -331,
-	428, 71, 431, 84, -331, -331, -331, 74, 106, -206,
-	-231, 85, -179, 85, -183, -9, 83, -3, -331, -73,
-	430, -304, -283, -232, 90, 92, 85, 60, 429, 432,
-	-231, 224, -316, -317, 74, -326, -323, 103, 127, 99,
-	-324, 112, 113, -73, -109, 83, -74, 268, 521, -309,
-	60, -317, 74, 11, 10, 103, 90, 85, -198, 229,
-	-306, 430, -315, 237, 232, 235, 30, -315, -4, 562,
-	85, 270, 431, 231, 29, 103, 92, -4, 432,
+	8, -78, -31, 30, -36, -46, 210, -47, -37, 211,
+	-48, 213, 212, 249, 214, 242, 74, 291, 292, 294,
+	295, 296, 297, -79, 247, 248, 216, 34, 43, 31,
+	32, 35, 220, 255, 256, 219, -8, -32, 6, -354,
+	9, 417, 244, 243, 26, -10, 434, 85, -353, 570,
+	-220, -205, 20, 31, 27, -204, -200, -92, -205, 18,
+	16, 5, -70, -357, -70, -70, 10, 11, -70, -304,
+	-306, 85, 147, 85, -70, -52, -51, -50, -49, -53,
+	29, -43, -44, -327, -42, -39, 215, 212, 259, 109,
+	110, 249, 250, 251, 214, 233, 248, 252, 247, 268,
+	-38, 80, 31, 434, 437, -311, 211, 217, 218, 418,
+	112, 111, 75, 213, -308, 343, 540, -53, 542, 101,
+	103, 541, 42, 223, 543, 544, 545, 546, 232, 547,
+	548, 549, 550, 556, 557, 558, 559, 113, 5, -70,
+	-264, -260, -331, -254, 88, 89, 90, 431, 235, 467,
+	468, 284, 80, 39, 340, 343, 540, 291, 305, 299,
+	326, 318, 432, 469, 287, 236, 272, 537, 316, 123,
+	542, 290, 470, 250, 348, 349, 350, 101, 294, 385,
+	555, 289, 471, 553, 103, 49, 541, 79, 51, 442,
+	42, 245, 314, 219, 310, 543, 273, 472, 456, 266,
+	112, 109, 562, 34, 308, 50, 28, 552, 111, 47,
+	544, 138, 473, 545, 352, 331, 531, 46, 353, 251,
+	474, 83, 256, 436, 539, 354, 309, 355, 283, 551,
+	216, 475, 523, 356, 357, 532, 476, 332, 336, 477,
+	377, 358, 569, 52, 478, 479, 533, 110, 480, 78,
+	546, 303, 304, 481, 281, 234, 379, 330, 232, 33,
+	359, 433, 285, 58, 260, 380, 44, 334, 566, 43,
+	527, 482, 530, 329, 325, 427, 53, 483, 484, 485,
+	486, 547, 328, 302, 324, 561, 463, 278, 548, 62,
+	218, 338, 337, 339, 267, 376, 321, 487, 488, 489,
+	239, 81, 490, 311, 19, 491, 492, 360, 274, 493,
+	56, 494, 495, 383, 248, 496, 54, 549, 37, 253,
+	563, 550, 497, 498, 499, 500, 255, 501, 362, 502,
+	361, 333, 335, 262, 363, 435, 503, 307, 252, 554,
+	504, 240, 538, 254, 259, 247, 384, 241, 505, 506,
+	507, 508, 509, 288, 510, 511, 295, 120, 556, 428,
+	41, 512, 513, 514, 515, 282, 277, 378, 387, 61,
+	82, 345, 516, 536, 301, 275, 517, 292, 55, 557,
+	558, 559, 269, 560, 7, 567, 568, 365, 113, 279,
+	280, 45, 322, 261, 518, 519, 312, 313, 327, 300,
+	323, 293, 524, 263, 366, 429, 249, 520, 386, 276,
+	341, 346, 454, 268, 367, 535, 438, 320, 317, 270,
+	521, 368, 224, 264, 265, 522, 525, 369, 370, 286,
+	371, 372, 373, 374, 375, 271, 437, 298, 315, 347,
+	398, 399, 400, 401, 402, 403, 404, 405, 406, 407,
+	408, 409, 410, 411, 412, 413, 414, 415, 426, 222,
+	-70, 222, -140, -260, 222, -232, 349, -251, 351, 364,
+	359, 369, 357, -243, 360, 362, 262, -346, 377, 222,
+	366, 210, 164, 352, 361, 370, 371, 286, 372, 375,
+	271, -342, -331, 545, 560, 123, 319, 356, 354, 378,
+	527, 374, 373, -260, 293, -267, 298, -255, -331, -254,
+	296, -140, -76, 523, 214, -269, -269, -94, 527, 529,
+	-183, -109, 131, -119, -122, -114, -115, -165, -169, -166,
+	-167, -168, -120, -190, 153, 154, 161, 132, -188, -123,
+	24, 430, 419, 418, 164, 29, 206, 68, 69, 441,
+	421, 422, 134, 57, 394, 453, 452, 444, 451, 443,
+	450, 446, 448, 445, 447, 449, 395, -121, 390, 396,
+	391, 424, 425, 104, 426, 427, 428, 429, -255, -260,
+	227, 389, 221, 159, 417, -116, -112, -6, -186, 388,
+	392, 393, 397, -331, -254, -118, -117, -149, 91, 97,
+	102, 98, -351, 105, -354, 92, 93, 94, 95, 96,
+	106, 107, 165, 166, 167, 168, 169, 170, 171, 172,
+	173, 174, 175, 176, 177, 178, 179, 180, 181, 182,
+	183, 184, 185, 186, 187, 188, 189, 190, 191, 192,
+	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
+	203, 204, 205, 42, 365, 365, -140, -70, -70, -70,
+	-70, -198, -92, -200, -8, -6, -354, 6, -70, -6,
+	-7, -11, -32, -34, 465, -33, -260, -205, -220, 10,
+	149, 40, 50, -203, -204, -10, -6, -109, 17, 21,
+	22, -97, 155, -109, -260, -71, -97, -241, 226, -70,
+	-70, -231, -272, 293, -235, 378, 377, -256, -233, -255,
+	-253, -232, 376, 215, 455, 130, 23, 25, 133, 164,
+	116, 17, 134, 35, 217, 319, 233, 163, 229, 418,
+	210, 72, 443, 390, 391, 388, 394, 420, 421, 389,
+	351, 29, 11, 444, 26, 243, 22, 36, 157, 212,
+	137, 246, 24, 244, 104, 107, 445, 20, 75, 238,
+	12, 231, 38, 14, 459, 460, 15, 227, 226, 149,
+	223, 48, 70, 9, 206, 27, 146, 66, 461, 125,
+	446, 447, 462, 448, 117, 68, 147, 18, 564, 392,
+	393, 31, 528, 430, 257, 159, 73, 59, 529, 131,
+	449, 450, 105, 464, 108, 76, 534, 127, 16, 71,
+	40, 439, 258, 451, 228, 565, 452, 381, 465, 148,
+	213, 417, 69, 441, 453, 221, 364, 6, 423, 30,
+	242, 230, 115, 67, 466, 222, 136, 424, 425, 225,
+	118, 106, 5, 124, 32, 10, 74, 77, 395, 396,
+	397, 57, 114, 434, 135, 13, 440, 382, 129, 123,
+	-305, 147, -292, -296, -255, 237, -321, 233, -140, -314,
+	-313, -255, -93, -250, 223, 231, 230, 124, -335, 127,
+	279, 389, 221, -49, -50, -232, 163, -80, 254, 258,
+	86, 86, -296, -295, -294, -336, 258, 237, -320, -312,
+	229, 238, -302, 230, 231, -297, 223, 125, -336, -297,
+	228, 238, 258, 258, 113, 258, 113, 258, 258, 258,
+	258, 258, 258, 258, 258, 258, 253, -303, 139, -303,
+	438, 438, -308, -336, -336, -336, 225, 33, 33, -252,
+	-297, 225, 23, -303, -303, -232, 163, -303, -303, -303,
+	-303, 266, 266, -303, -303, -303, -303, -303, -303, -303,
+	-303, -303, -303, -303, -303, -303, -303, -303, 222, -335,
+	-101, 375, 286, 80, -51, 268, -35, -140, -250, 223,
+	224, -335, 255, -140, 207, -140, -245, 147, 13, -245,
+	-242, 365, 363, 350, 355, -245, -245, -245, -245, 269,
+	348, -298, 223, 33, 234, 365, 269, 348, 269, 270,
+	269, 270, 358, 368, 269, -265, 12, 149, 389, 353,
+	357, 262, 222, 263, 224, 367, -331, 530, 270, -265,
+	92, -246, 147, 365, 265, -245, -270, -354, -256, 319,
+	-270, -270, 30, 89, 225, -255, -72, -255, 92, -13,
+	-9, -20, -19, -21, 139, -99, 365, -87, 164, 545,
+	531, 532, 533, 530, 362, 538, 536, 534, 269, 535,
+	86, 127, 129, 130, -109, 146, -150, 139, 140, 141,
+	142, 143, 144, 145, 149, 131, 133, 147, 148, 128,
+	150, 151, 152, 153, 154, 155, 156, 158, 157, 159,
+	160, 163, 208, 209, -115, -115, -115, -115, -188, -354,
+	-354, -354, -115, -240, -354, -115, -354, -354, -354, -354,
+	-354, -194, -109, -354, -358, -354, -354, -354, -354, -354,
+	-354, -354, -354, -354, -354, -354, -354, -358, -358, -283,
+	-354, -283, -354, -354, -354, -354, -354, -354, -354, -354,
+	207, -354, -354, -354, -354, -354, -283, -283, -283, -283,
+	102, 97, 91, -190, 98, 92, -255, -260, -6, -7,
+	-183, -156, 12, 528, 49, -343, -344, -143, -140, -354,
+	286, -255, -255, 255, -203, -10, -6, -198, -204, -200,
+	-6, -70, -85, -98, 63, 64, -100, 22, 36, 67,
+	65, 21, -355, 87, -355, -220, -355, 86, -34, -224,
+	85, 61, 41, 92, 92, 86, 19, -199, -201, -109,
+	12, -258, -257, 23, -255, 92, 207, 100, 12, -141,
+	27, -140, -241, -241, 86, 293, -235, -272, -237, -236,
+	379, 381, 139, -259, -255, 92, 29, 87, 86, -140,
+	-275, -278, -280, -279, -281, -276, -277, 316, 317, 164,
+	320, 322, 323, 324, 325, 326, 327, 328, 329, 330,
+	331, 30, 245, 312, 313, 314, 315, 332, 333, 334,
+	335, 336, 337, 338, 339, 299, 318, 432, 300, 301,
+	302, 303, 304, 305, 307, 308, 309, 310, 311, -334,
+	-331, 85, 87, 86, -282, 85, -101, 222, -331, 223,
+	223, 223, -70, 417, -303, -303, 253, 17, -42, -39,
+	-327, 16, -38, -39, 215, 109, 110, 212, 85, -292,
+	85, -301, -334, -331, 85, 125, 228, 124, -300, -297,
+	-300, -301, -331, -190, -331, 125, 125, -228, -255, -228,
+	-228, 21, -228, 21, -228, 21, 94, -255, -228, 21,
+	-228, 21, -228, 21, -228, 21, -228, 21, 29, 78,
+	79, 29, 81, 82, 83, -190, -190, -292, -232, -140,
+	-331, 94, 94, -303, -303, 94, 92, 92, 92, -303,
+	-303, 94, 92, -262, -260, 92, -337, 239, 283, 285,
+	94, 94, 94, 94, 29, 92, -338, 29, 552, 551,
+	553, 554, 555, 94, 29, 94, 29, 94, -255, 85,
+	-140, -107, 273, 210, 212, 215, 76, 92, 287, 139,
+	42, 86, 225, 222, -331, -247, 227, -247, -255, -261,
+	-260, -253, 92, -109, -299, 12, 149, -265, -265, -245,
+	-140, -299, -265, -245, -140, -245, -245, -245, -245, -265,
+	-265, -265, -245, -260, -260, -140, -140, -140, -140, -140,
+	-140, -140, -270, -270, -270, -246, -245, 530, 92, 76,
+	-268, 296, 330, 524, 525, 526, 86, 434, -133, -140,
+	530, 530, 530, 530, 530, 530, -109, -109, -109, -109,
+	-181, 105, 131, 106, 107, -122, -182, -186, -188, 99,
+	149, 133, 147, 148, -114, -115, -114, -114, -114, -114,
+	-114, -114, -114, -114, -114, -114, -114, -114, -114, -271,
+	-331, 92, 164, 92, 92, -95, -97, -109, -109, -331,
+	-255, -95, -95, -95, -109, -91, 22, 36, -192, -193,
+	135, -190, -355, -355, -355, -355, -355, -355, -109, -183,
+	-183, -109, -109, -109, -355, 94, -255, -255, -84, -83,
+	399, 400, 401, 402, 404, 405, 406, 409, 410, 414,
+	415, 398, 416, 403, 408, 411, 412, 413, 407, 315,
+	-109, -109, -109, -77, -109, 116, 117, 118, -96, 22,
+	36, -95, -256, -261, -253, -95, -96, -96, -95, -95,
+	207, -220, -355, -159, 527, -269, 86, -345, 381, 382,
+	528, -263, 258, -262, 23, -184, -183, 92, 12, -226,
+	77, -255, -203, -203, 63, 64, 59, -95, -100, -355,
+	-33, 23, -222, -255, 62, 92, -284, -232, 340, 341,
+	-109, -109, 86, -202, 25, 26, -140, -257, 155, -261,
+	-140, -227, 258, -140, -125, -127, -128, -129, -143, -189,
+	-354, 12, -133, -134, -142, -260, -235, -237, 86, 380,
+	382, 383, 76, 108, -109, -285, 163, -310, -309, -308,
+	-292, -294, -295, -296, 87, -285, -288, 346, 345, -282,
+	-282, -282, -282, -282, -284, -284, -284, -284, 85, 85,
+	-282, -282, -282, -282, -286, 85, -286, -286, -287, 85,
+	-287, -321, -109, -318, -317, -315, -316, 232, 101, 434,
+	77, -313, -202, 94, -107, -248, 227, -319, -316, -331,
+	-331, -331, -248, -331, 92, -331, 92, -81, -55, -1,
+	564, 565, 566, 86, 17, -293, -292, -54, 283, -324,
+	-325, 258, -320, -314, -301, 125, -300, -301, -301, -331,
+	86, 27, 113, 113, 113, 113, 434, 212, 30, -292,
+	-54, -271, 225, -271, -271, 92, 92, -244, 560, -133,
+	-103, 275, 139, 264, 264, 222, 222, 277, -140, 288,
+	290, 289, 287, 21, 276, 278, 280, 266, -140, -140,
+	-247, 76, -135, -140, 24, -260, -140, -245, -245, -140,
+	-245, -245, -140, -255, -87, 381, 86, 434, 20, -88,
+	20, 105, 106, 107, -182, -114, -115, -114, 130, 246,
+	86, -355, 23, 86, 77, -355, -355, -355, -355, 86,
+	12, -95, -195, -193, 137, -109, -355, -172, 439, -172,
+	-172, -172, -172, -355, -355, -355, -355, -355, 86, -355,
+	86, 86, 12, -274, 23, -355, -355, -194, -355, 12,
+	-355, -95, -95, -354, 207, -355, -355, -355, -355, -355,
+	-256, 48, -344, 527, 382, -147, -146, -144, 74, 226,
+	75, -354, -262, -355, 92, 89, -223, 89, -222, -164,
+	-255, 94, 98, -205, -124, -126, 12, -100, -188, 87,
+	86, -284, -209, -214, -242, -255, 92, 164, -201, 207,
+	-148, 13, -151, 30, 57, -9, -354, -354, 30, 86,
+	-136, -138, -137, -139, 66, 70, 72, 67, 68, 69,
+	73, -266, 23, -125, -7, -6, -354, -140, -133, -356,
+	12, 77, -356, 86, 207, -236, -238, 384, 381, 387,
+	-331, 92, -80, 86, -308, -296, -206, -104, 38, -289,
+	347, -284, -284, -291, 92, -291, 94, 94, 87, -45,
+	-40, -41, 31, 80, -315, -303, 92, 37, -255, 87,
+	-103, -140, 131, 76, -319, -319, -319, -260, -2, 563,
+	569, 125, 85, 350, 16, -222, 86, 87, -191, 284,
+	87, -326, 57, -255, 87, 85, -301, -301, -255, -354,
+	222, 29, 29, -54, -191, -285, -331, 562, 561, 87,
+	224, 282, -108, 394, -105, 92, 88, -140, -140, -140,
+	-140, -140, 215, 212, 267, 225, -133, -140, 86, -75,
+	241, 236, -265, -265, 31, -140, 381, 539, 537, 130,
+	246, -122, -115, -97, 431, -273, 164, 316, 245, 314,
+	310, 330, 321, 345, 312, 346, 309, 308, 307, -273,
+	-271, -109, -109, -198, 138, -109, 136, -354, -255, -172,
+	-172, -172, -172, -172, -109, -109, -109, -109, -355, 164,
+	316, 12, -109, -172, -355, -355, -96, -256, 92, -144,
+	-297, -297, -297, -228, 86, -239, 20, 12, 57, 57,
+	-124, -148, -125, -100, -255, -212, 523, -217, 44, -215,
+	-216, 45, -213, 46, 56, 155, -203, -109, -229, 76,
+	-230, -234, -190, -185, -187, -186, -354, -221, -355, -255,
+	-228, -230, -127, -128, -128, -127, -128, 66, 66, 66,
+	71, 66, 71, 66, -137, -260, -355, -355, -7, -263,
+	77, -125, -125, -142, -260, 155, 381, 385, 386, -308,
+	-350, 105, 131, 29, 76, 343, 101, -348, 163, -349,
+	228, 124, 125, 240, 23, 39, 87, 86, 87, 86,
+	87, 86, -249, 473, 131, -41, -40, -303, -303, 94,
+	-331, 224, 24, -140, 76, 76, 76, -82, 567, 94,
+	85, -3, 80, -109, 85, 17, -292, -190, 260, 149,
+	-318, -222, -322, -324, -140, -111, -354, -110, -112, -116,
+	153, 154, -191, -140, -102, 273, 281, 85, -106, 89,
+	-333, 77, 264, 343, 264, -140, -75, -45, -140, -245,
+	-245, 31, -331, -122, -115, -354, -355, -282, -282, -282,
+	-287, -282, 304, -282, 304, -282, -355, -355, 86, -355,
+	20, -355, -86, 423, -109, -173, -180, -255, -355, 86,
+	86, -355, 85, 85, -109, -355, -355, -157, 417, 226,
+	-145, 20, -145, -145, -355, 89, -140, -148, -196, 14,
+	-209, 51, 322, -219, -218, 55, 45, -216, 17, 47,
+	17, 28, -229, 86, 139, 86, -355, -355, 86, 57,
+	207, -355, -148, -131, -130, 76, 77, -132, 76, -130,
+	66, 66, -224, -355, -227, -125, -148, -148, 207, 105,
+	-354, -113, -121, -111, 10, 92, 92, -331, 125, 125,
+	-140, 85, -284, 92, -284, 94, 94, 473, 29, 81,
+	82, 83, 29, 78, 79, -140, -140, -140, -140, -323,
+	85, 17, -109, 85, 139, 87, -222, -222, 261, -186,
+	-354, 87, -355, 86, -290, 434, 437, -109, -117, -117,
+	-224, 87, -330, 434, -332, -255, -255, -255, -255, -114,
+	-284, -109, -109, -203, 92, -355, -171, 258, -172, -109,
+	-109, 94, 94, -355, 27, -354, 66, 16, 14, -354,
+	-354, -263, -196, -197, 15, 17, -210, 53, -208, 52,
+	-208, -218, 17, 17, 92, 17, 92, 125, -234, -109,
+	-187, 57, -9, -255, -185, -255, -198, -109, 85, -109,
+	-148, -148, -109, -166, 426, 427, 428, 429, 85, -109,
+	87, 87, -224, -322, -55, 87, -222, 94, 87, -186,
+	-89, -354, 257, -349, -324, 438, 438, -355, 23, -329,
+	-328, -256, 85, 77, -90, 149, 434, -355, -355, -355,
+	-198, 17, -355, -355, 87, 87, 222, -225, -355, -255,
+	228, 17, 17, -225, -225, -147, -197, -178, 440, -109,
+	-183, -211, 54, 76, 108, 92, 92, 92, 10, -185,
+	207, -203, -222, -198, -355, -222, 87, 23, 87, 569,
+	125, 87, 257, -9, 86, 139, -222, -140, -355, 432,
+	73, 435, -174, -176, 120, 565, -183, -158, -140, 92,
+	86, -355, -355, -355, -178, -179, -177, -255, 76, 108,
+	-230, -255, 87, -203, 87, -207, -9, 85, -3, -355,
+	-73, 434, -328, -307, -256, 92, 94, 87, 62, 433,
+	436, -175, 133, 442, 520, -170, -117, 98, 206, -227,
+	-255, 228, 86, 23, -340, -341, 76, -350, -347, 105,
+	131, 101, -348, 114, 115, -73, -109, 85, -74, 272,
+	527, -333, 62, -175, 441, 500, 475, 500, 475, -114,
+	-284, -177, -354, -341, 76, 11, 10, 105, 92, 87,
+	-222, 233, -330, 434, 130, -255, -152, -242, -173, -339,
+	241, 236, 239, 30, -339, -4, 568, 87, 274, 435,
+	-175, -154, 44, -153, -355, 235, 29, 105, 94, -4,
+	436, -160, 226, -155, 45, -213, 46, -162, -354, 94,
+	55, 45, 17, 47, 17, -163, 30, -161, -255, 44,
+	120, 17, 17, 92, 17, 92, -230, -355, 86, 92,
+	92, 92, -255,
 }
 
 var yyDef = [...]int{
 	-2, -2, 2, 4, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
 	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
-	31, 32, 33, 34, 35, 36, 68, 70, 71, 712,
-	712, 712, 0, 712, 0, 0, 712, -2, -2, 712,
-	1152, 0, 712, 0, 0, -2, 644, 646, 0, 648,
-	-2, 0, 0, 657, 1611, 1611, 707, 0, 0, 0,
-	0, 0, 712, 712, 712, 712, 1013, 48, 712, 0,
-	83, 84, 663, 664, 665, 63, 0, 1609, 1, 3,
-	69, 73, 0, 0, 0, 56, 1022, 0, 76, 0,
-	0, 716, 0, 714, 0, 1135, 712, 712, 0, 114,
-	115, 0, 0, 0, -2, 118, -2, 142, 143, 0,
-	147, 510, 450, 493, 448, 479, -2, 441, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	453, 343, 343, 0, 0, -2, 441, 441, 441, 0,
-	0, 0, 476, 1137, 396, 343, 343, 0, 343, 343,
-	343, 343, 0, 0, 343, 343, 343, 343, 343, 343,
-	343, 343, 343, 343, 343, 343, 343, 343, 343, 1039,
-	146, 1153, 1150, 1151, 38, 39, 40, 1300, 1301, 1302,
+	31, 32, 33, 34, 35, 36, 99, 101, 102, 743,
+	743, 743, 0, 743, 0, 0, 743, -2, -2, 743,
+	1222, 0, 743, 0, 0, -2, 675, 677, 0, 679,
+	-2, 0, 0, 688, 1686, 1686, 738, 0, 0, 0,
+	0, 0, 743, 743, 743, 743, 1083, 79, 743, 0,
+	114, 115, 694, 695, 696, 94, 0, 1684, 1, 3,
+	100, 104, 0, 0, 0, 87, 1092, 0, 107, 0,
+	0, 747, 0, 745, 0, 1205, 743, 743, 0, 145,
+	146, 0, 0, 0, -2, 149, -2, 173, 174, 0,
+	178, 541, 481, 524, 479, 510, -2, 472, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	484, 374, 374, 0, 0, -2, 472, 472, 472, 0,
+	0, 0, 507, 1207, 427, 374, 374, 0, 374, 374,
+	374, 374, 0, 0, 374, 374, 374, 374, 374, 374,
+	374, 374, 374, 374, 374, 374, 374, 374, 374, 1109,
+	177, 1223, 1220, 1221, 38, 39, 40, 1372, 1373, 1374,
+	1375, 1376, 1377, 1378, 1379, 1380, 1381, 1382, 1383, 1384,
+	1385, 1386, 1387, 1388, 1389, 1390, 1391, 1392, 1393, 1394,
+	1395, 1396, 1397, 1398, 1399, 1400, 1401, 1402, 1403, 1404,
+	1405, 1406, 1407, 1408, 1409, 1410, 1411, 1412, 1413, 1414,
+	1415, 1416, 1417, 1418, 1419, 1420, 1421, 1422, 1423, 1424,
+	1425, 1426, 1427, 1428, 1429, 1430, 1431, 1432, 1433, 1434,
+	1435, 1436, 1437, 1438, 1439, 1440, 1441, 1442, 1443, 1444,
+	1445, 1446, 1447, 1448, 1449, 1450, 1451, 1452, 1453, 1454,
+	1455, 1456, 1457, 1458, 1459, 1460, 1461, 1462, 1463, 1464,
+	1465, 1466, 1467, 1468, 1469, 1470, 1471, 1472, 1473, 1474,
+	1475, 1476, 1477, 1478, 1479, 1480, 1481, 1482, 1483, 1484,
+	1485, 1486, 1487, 1488, 1489, 1490, 1491, 1492, 1493, 1494,
+	1495, 1496, 1497, 1498, 1499, 1500, 1501, 1502, 1503, 1504,
+	1505, 1506, 1507, 1508, 1509, 1510, 1511, 1512, 1513, 1514,
+	1515, 1516, 1517, 1518, 1519, 1520, 1521, 1522, 1523, 1524,
+	1525, 1526, 1527, 1528, 1529, 1530, 1531, 1532, 1533, 1534,
+	1535, 1536, 1537, 1538, 1539, 1540, 1541, 1542, 1543, 1544,
+	1545, 1546, 1547, 1548, 1549, 1550, 1551, 1552, 1553, 1554,
+	1555, 1556, 1557, 1558, 1559, 1560, 1561, 1562, 1563, 1564,
+	1565, 1566, 1567, 1568, 1569, 1570, 1571, 1572, 1573, 1574,
+	1575, 1576, 1577, 1578, 1579, 1580, 1581, 1582, 1583, 1584,
+	1585, 1586, 1587, 1588, 1589, 1590, 1591, 1592, 1593, 1594,
+	1595, 1596, 1597, 1598, 1599, 1600, 1601, 1602, 1603, 1604,
+	1605, 1606, 1607, 1608, 1609, 1610, 1611, 1612, 1613, 1614,
+	1615, 1616, 1617, 1618, 1619, 1620, 1621, 1622, 1623, 1624,
+	1625, 1626, 1627, 1628, 1629, 1630, 1631, 1632, 1633, 1634,
+	1635, 1636, 1637, 1638, 1639, 1640, 1641, 1642, 1643, 1644,
+	1645, 1646, 1647, 1648, 1649, 1650, 1651, 1652, 1653, 1654,
+	1655, 1656, 1657, 1658, 1659, 1660, 1661, 1662, 1663, 1664,
+	1665, 1666, 1667, 1668, 1669, 1670, 1671, 1672, 1673, 1674,
+	1675, 1676, 1677, 1678, 1679, 1680, 1681, 1682, 1683, 0,
+	1199, 0, 605, 842, 0, 666, 666, 0, 666, 666,
+	666, 666, 0, 0, 0, 617, 0, 0, 0, 0,
+	663, 0, 0, 636, 638, 0, 663, 0, 669, 0,
+	650, 666, 1687, 1687, 1687, 1190, 0, 660, 658, 672,
+	673, 655, 656, 674, 678, 0, 683, 686, 1216, 1217,
+	0, 701, 78, 1457, 693, 706, 707, 0, 739, 740,
+	43, 939, 0, 864, 868, 879, 892, 893, 894, 895,
+	896, 897, 899, 900, 0, 0, 0, 0, 905, 906,
+	0, 0, 0, 0, 0, 920, 0, 0, 0, 0,
+	0, 0, 1064, 0, 1028, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1028, 994, 1028, 1030,
+	1030, 0, 0, 0, 1683, 1500, 1584, 1630, 1073, 0,
+	0, 0, 0, 0, 0, 282, 283, 938, 1179, 1030,
+	1030, 1030, 1030, -2, -2, 229, 230, 231, 232, 233,
+	234, 235, 0, 225, 0, 287, 288, 284, 285, 286,
+	922, 923, 241, 242, 243, 244, 245, 246, 247, 248,
+	249, 250, 251, 252, 253, 254, 255, 256, 257, 258,
+	259, 260, 261, 262, 263, 264, 265, 266, 267, 268,
+	269, 270, 271, 272, 273, 274, 275, 276, 277, 278,
+	279, 280, 281, 48, 0, 716, 0, 0, 0, 0,
+	0, 1092, 0, 1084, 1083, 92, 0, 743, -2, 0,
+	0, 0, 0, 76, 0, 81, 801, 106, 105, 1131,
+	0, 0, 0, 88, 1093, 96, 98, 1094, 0, 748,
+	749, 0, 779, 783, 0, 744, 0, 0, 1206, 1205,
+	1205, 131, 0, 1626, 1183, -2, -2, 0, 0, 1218,
+	1219, 1192, -2, 1226, 1227, 1228, 1229, 1230, 1231, 1232,
+	1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242,
+	1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252,
+	1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260, 1261, 1262,
+	1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271, 1272,
+	1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281, 1282,
+	1283, 1284, 1285, 1286, 1287, 1288, 1289, 1290, 1291, 1292,
+	1293, 1294, 1295, 1296, 1297, 1298, 1299, 1300, 1301, 1302,
 	1303, 1304, 1305, 1306, 1307, 1308, 1309, 1310, 1311, 1312,
 	1313, 1314, 1315, 1316, 1317, 1318, 1319, 1320, 1321, 1322,
 	1323, 1324, 1325, 1326, 1327, 1328, 1329, 1330, 1331, 1332,
 	1333, 1334, 1335, 1336, 1337, 1338, 1339, 1340, 1341, 1342,
 	1343, 1344, 1345, 1346, 1347, 1348, 1349, 1350, 1351, 1352,
 	1353, 1354, 1355, 1356, 1357, 1358, 1359, 1360, 1361, 1362,
-	1363, 1364, 1365, 1366, 1367, 1368, 1369, 1370, 1371, 1372,
-	1373, 1374, 1375, 1376, 1377, 1378, 1379, 1380, 1381, 1382,
-	1383, 1384, 1385, 1386, 1387, 1388, 1389, 1390, 1391, 1392,
-	1393, 1394, 1395, 1396, 1397, 1398, 1399, 1400, 1401, 1402,
-	1403, 1404, 1405, 1406, 1407, 1408, 1409, 1410, 1411, 1412,
-	1413, 1414, 1415, 1416, 1417, 1418, 1419, 1420, 1421, 1422,
-	1423, 1424, 1425, 1426, 1427, 1428, 1429, 1430, 1431, 1432,
-	1433, 1434, 1435, 1436, 1437, 1438, 1439, 1440, 1441, 1442,
-	1443, 1444, 1445, 1446, 1447, 1448, 1449, 1450, 1451, 1452,
-	1453, 1454, 1455, 1456, 1457, 1458, 1459, 1460, 1461, 1462,
-	1463, 1464, 1465, 1466, 1467, 1468, 1469, 1470, 1471, 1472,
-	1473, 1474, 1475, 1476, 1477, 1478, 1479, 1480, 1481, 1482,
-	1483, 1484, 1485, 1486, 1487, 1488, 1489, 1490, 1491, 1492,
-	1493, 1494, 1495, 1496, 1497, 1498, 1499, 1500, 1501, 1502,
-	1503, 1504, 1505, 1506, 1507, 1508, 1509, 1510, 1511, 1512,
-	1513, 1514, 1515, 1516, 1517, 1518, 1519, 1520, 1521, 1522,
-	1523, 1524, 1525, 1526, 1527, 1528, 1529, 1530, 1531, 1532,
-	1533, 1534, 1535, 1536, 1537, 1538, 1539, 1540, 1541, 1542,
-	1543, 1544, 1545, 1546, 1547, 1548, 1549, 1550, 1551, 1552,
-	1553, 1554, 1555, 1556, 1557, 1558, 1559, 1560, 1561, 1562,
-	1563, 1564, 1565, 1566, 1567, 1568, 1569, 1570, 1571, 1572,
-	1573, 1574, 1575, 1576, 1577, 1578, 1579, 1580, 1581, 1582,
-	1583, 1584, 1585, 1586, 1587, 1588, 1589, 1590, 1591, 1592,
-	1593, 1594, 1595, 1596, 1597, 1598, 1599, 1600, 1601, 1602,
-	1603, 1604, 1605, 1606, 1607, 1608, 0, 1129, 0, 574,
-	811, 0, 635, 635, 0, 635, 635, 635, 635, 0,
-	0, 0, 586, 0, 0, 0, 0, 632, 0, 0,
-	605, 607, 0, 632, 0, 638, 0, 619, 635, 1612,
-	1612, 1612, 1120, 0, 629, 627, 641, 642, 624, 625,
-	643, 647, 0, 652, 655, 1146, 1147, 0, 670, 47,
-	1383, 662, 675, 676, 0, 708, 709, 43, 907, 0,
-	833, 837, 848, 861, 862, 863, 864, 865, 867, 868,
-	0, 0, 0, 0, 873, 874, 0, 0, 0, 0,
-	0, 888, 0, 0, 0, 0, 0, 994, 0, 958,
-	958, 924, 958, 960, 960, 0, 0, 0, 1608, 1426,
-	1509, 1555, 1003, 0, 0, 0, 0, 0, 0, 251,
-	252, 906, 1109, 960, 960, 960, 960, -2, -2, 198,
-	199, 200, 201, 202, 203, 204, 0, 194, 0, 256,
-	257, 253, 254, 255, 890, 891, 210, 211, 212, 213,
-	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
-	224, 225, 226, 227, 228, 229, 230, 231, 232, 233,
-	234, 235, 236, 237, 238, 239, 240, 241, 242, 243,
-	244, 245, 246, 247, 248, 249, 250, 1611, 0, 685,
-	0, 0, 0, 0, 0, 1022, 0, 1014, 1013, 61,
-	0, 712, -2, 0, 0, 0, 0, 45, 0, 50,
-	770, 75, 74, 1061, 0, 0, 0, 57, 1023, 65,
-	67, 1024, 0, 717, 718, 0, 748, 752, 0, 713,
-	0, 0, 1136, 1135, 1135, 100, 0, 1551, 1113, -2,
-	-2, 0, 0, 1148, 1149, 1122, -2, 1156, 1157, 1158,
-	1159, 1160, 1161, 1162, 1163, 1164, 1165, 1166, 1167, 1168,
-	1169, 1170, 1171, 1172, 1173, 1174, 1175, 1176, 1177, 1178,
-	1179, 1180, 1181, 1182, 1183, 1184, 1185, 1186, 1187, 1188,
-	1189, 1190, 1191, 1192, 1193, 1194, 1195, 1196, 1197, 1198,
-	1199, 1200, 1201, 1202, 1203, 1204, 1205, 1206, 1207, 1208,
-	1209, 1210, 1211, 1212, 1213, 1214, 1215, 1216, 1217, 1218,
-	1219, 1220, 1221, 1222, 1223, 1224, 1225, 1226, 1227, 1228,
-	1229, 1230, 1231, 1232, 1233, 1234, 1235, 1236, 1237, 1238,
-	1239, 1240, 1241, 1242, 1243, 1244, 1245, 1246, 1247, 1248,
-	1249, 1250, 1251, 1252, 1253, 1254, 1255, 1256, 1257, 1258,
-	1259, 1260, 1261, 1262, 1263, 1264, 1265, 1266, 1267, 1268,
-	1269, 1270, 1271, 1272, 1273, 1274, 1275, 1276, 1277, 1278,
-	1279, 1280, 1281, 1282, 1283, 1284, 1285, 1286, 1287, 1288,
-	1289, 1290, 1291, 1292, 1293, 1294, 1295, 1296, 1297, 1298,
-	1299, -2, 0, 0, 156, 157, 0, 41, 369, 0,
-	152, 0, 363, 313, 1039, 0, 0, 0, 0, 0,
-	712, 0, 1130, 137, 138, 144, 145, 343, 343, 490,
-	0, 0, 146, 146, 457, 458, 459, 0, 0, -2,
-	367, 0, 442, 0, 0, 357, 357, 361, 359, 360,
-	0, 0, 0, 0, 0, 0, 470, 0, 471, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 552, 0,
-	344, 0, 488, 489, 397, 0, 0, 0, 0, 468,
-	469, 0, 0, 1138, 1139, 0, 0, 343, 343, 0,
-	0, 0, 0, 343, 343, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 136, 1052, 0, 0, 0, -2, 0, 566, 0,
-	0, 0, 1131, 1131, 0, 573, 0, 575, 576, 0,
-	0, 577, 0, 632, 632, 630, 631, 579, 580, 581,
-	582, 635, 0, 0, 352, 353, 354, 632, 635, 0,
-	635, 635, 635, 635, 632, 632, 632, 635, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1612, 1612, 1612,
-	638, 635, 0, 615, 0, 616, 617, 620, 621, 1613,
-	1614, 1168, 622, 623, 1121, 645, 653, 656, 673, 671,
-	672, 674, 666, 667, 668, 669, 0, 687, 688, 693,
-	0, 0, 0, 0, 699, 700, 701, 0, 0, 704,
-	705, 706, 0, 0, 0, 0, 831, 0, 0, 896,
-	897, 898, 899, 900, 901, 902, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 869, 870, 871, 872,
-	875, 0, 0, 0, 880, 881, 0, 0, 0, 0,
-	0, 722, 0, 995, 0, 922, 0, 923, 925, 926,
-	0, 927, 0, 0, 0, 0, 0, 0, 0, 732,
-	0, 0, 732, 732, 0, 0, 188, 189, 190, 191,
-	205, 206, 207, 208, 209, 258, 1003, 0, 906, 0,
-	0, 44, 677, 678, 0, 794, 1141, 0, 0, 728,
-	0, 55, 64, 66, 1022, 59, 1022, 0, 734, 0,
-	0, -2, -2, 735, 741, 742, 743, 744, 745, 52,
-	1610, 53, 0, 72, 0, 46, 0, 0, 0, 0,
-	325, 1064, 0, 0, 1015, 1016, 1019, 0, 749, 753,
-	0, 755, 756, 0, 715, 0, 98, 0, 810, 0,
-	0, 0, 1551, 1119, 0, 102, 103, 0, 0, 0,
-	331, 1124, 1125, 1126, -2, 350, 0, 331, 320, 264,
-	265, 266, 313, 268, 313, 313, 313, 313, 325, 325,
-	325, 325, 296, 297, 298, 299, 300, 0, 0, 283,
-	313, 313, 313, 313, 303, 304, 305, 306, 307, 308,
-	309, 310, 269, 270, 271, 272, 273, 274, 275, 276,
-	277, 315, 315, 315, 317, 317, 0, 42, 0, 335,
-	0, 1019, 0, 1052, 1133, 1143, 0, 0, 0, 1133,
-	120, 0, 0, 491, 521, 451, 480, 492, 0, 454,
-	455, -2, 0, 0, 441, 0, 443, 0, 351, 0,
-	-2, 0, 361, 0, 357, 361, 358, 361, 349, 362,
-	472, 473, 474, 0, 532, 780, 0, 0, 0, 0,
-	0, 538, 539, 540, 0, 542, 543, 544, 545, 546,
-	547, 548, 549, 550, 551, 481, 482, 483, 484, 485,
-	486, 487, 0, 0, 443, 0, 477, 0, 398, 399,
-	0, 0, 402, 403, 404, 405, 0, 0, 408, 409,
-	410, 797, 798, 411, 435, 436, 437, 412, 413, 414,
-	415, 416, 417, 418, 429, 430, 431, 432, 433, 434,
-	419, 420, 421, 422, 423, 426, 0, 130, 1043, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1131, 0, 0, 0, 0, 731, 812, 1154, 1155, 636,
-	637, 0, 355, 356, 635, 635, 583, 606, 0, 635,
-	587, 608, 588, 590, 589, 591, 610, 611, 635, 594,
-	633, 634, 595, 596, 597, 598, 599, 600, 601, 602,
-	603, 604, 612, 613, 614, 639, 0, 0, 654, 658,
-	659, 660, 661, 0, 0, 690, 94, 695, 696, 697,
-	698, 710, 703, 908, 828, 829, 830, 832, 834, 0,
-	892, 894, 836, 838, 903, 904, 905, 0, 0, 0,
-	0, 0, 842, 846, 849, 850, 851, 852, 853, 854,
-	855, 856, 857, 858, 859, 860, 866, 973, 974, 975,
-	883, 884, 0, 746, 0, 0, 0, 882, 0, 0,
-	0, 0, 723, 724, 1001, 998, 0, 0, 959, 961,
-	0, 0, 0, 0, 937, 938, 939, 940, 941, 942,
-	943, 944, 945, 946, 947, 948, 949, 950, 951, 952,
-	953, 954, 955, 956, 957, 976, 0, 0, 994, 0,
-	885, 886, 887, 0, 0, 0, 733, 1004, 0, -2,
-	0, 0, 0, 0, 0, 0, 0, 1108, 0, 680,
-	681, 683, 0, 814, 0, 795, 0, 0, 1142, 686,
-	0, 727, 0, 730, 58, 60, 739, 740, 0, 757,
-	736, 54, 49, 0, 0, 772, 1062, 325, 1084, 0,
-	329, 330, 1025, 1026, 0, 1018, 1020, 1021, 77, 754,
-	750, 0, 826, 0, 0, 809, 0, 760, 762, 763,
-	764, 792, 0, 0, 0, 0, 96, 811, 1114, 101,
-	0, 0, 106, 107, 1115, 1116, 1117, 1118, 0, 510,
-	-2, 393, 158, 160, 161, 162, 153, -2, 323, 321,
-	322, 267, 325, 325, 290, 291, 292, 293, 294, 295,
-	0, 0, 284, 285, 286, 287, 278, 0, 279, 280,
-	281, 0, 282, 368, 0, 1027, 336, 337, 339, 343,
-	0, 0, 0, 364, 365, 0, 1043, 0, 0, 0,
-	1144, 1143, 1143, 1143, 0, 148, 149, 150, 151, 527,
-	0, 0, 522, 525, 526, 146, 0, 0, 154, 445,
-	444, 0, 559, 0, 366, 0, 0, 361, 361, 346,
-	347, 475, 0, 0, 534, 535, 536, 537, 0, 0,
-	0, 443, 445, 331, 0, 400, 401, 406, 407, 424,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 505, 506, 507, 508, 509, 1040, 1041, 1042, 0,
-	0, 567, 0, 0, 385, 92, 1132, 572, 632, 593,
-	609, 632, 585, 592, 618, 650, 694, 0, 0, 0,
-	0, 702, 0, 835, 893, 895, 839, 0, 843, 847,
-	0, 0, 0, 0, 0, 0, 0, 889, 913, 914,
-	0, 0, 1013, 0, 999, 0, 0, 921, 962, 0,
-	0, 0, 0, 0, 932, 933, 0, 935, 0, 909,
-	0, 0, 732, 0, 963, 964, 965, 966, 967, 1004,
-	679, 682, 684, 768, 815, 816, 0, 0, 0, 0,
-	796, 1140, 725, 726, 729, -2, 0, 0, 1006, 0,
-	0, 757, 826, 758, 0, 737, 51, 771, 0, 1066,
-	1065, 1078, 1091, 326, 327, 328, 1017, 0, 1022, 0,
-	1102, 0, 0, 1094, 0, 0, 0, 0, 0, 0,
-	0, 0, 799, 0, 0, 802, 0, 0, 0, 0,
-	793, 0, 0, 0, 0, -2, 0, 0, 90, 91,
-	0, 0, 0, 104, 105, 0, 0, 111, 332, 333,
-	139, 146, 395, 159, 375, 0, 0, 263, 324, 288,
-	289, 0, 311, 0, 0, 0, 388, 116, 1031, 1030,
-	343, 343, 338, 0, 341, 0, 1145, 314, 0, 129,
-	0, 0, 0, 0, 0, 135, 516, 0, 0, 523,
-	0, 0, 0, 449, 0, 460, 461, 0, 531, 558,
-	0, 563, 335, 0, 345, 348, 781, 0, 0, 462,
-	0, 445, 466, 467, 478, 427, 428, 425, 0, 0,
-	1053, 1054, 1059, 1057, 1058, 121, 498, 500, 499, 503,
-	0, 0, 496, 0, 385, 1027, 0, 571, 386, 387,
-	635, 635, 689, 95, 0, 692, 0, 0, 0, 840,
-	844, 747, 0, 0, 313, 313, 981, 313, 317, 984,
-	313, 986, 313, 989, 313, 992, 993, 0, 0, 0,
-	0, 996, 920, 1002, 0, 0, 0, 0, 931, 0,
-	0, 0, 0, 910, 911, 0, 1005, 817, 822, 822,
-	822, 0, 0, 0, 1127, 1128, 1007, 1008, 826, 1009,
-	759, 738, 773, 1084, 0, 1077, 0, -2, 1086, 0,
-	0, 0, 1092, 751, 78, 827, 81, 0, 1102, 1110,
-	0, 1093, 1104, 1106, 0, 0, 0, 1098, 0, 826,
-	761, 788, 790, 0, 785, 800, 801, 803, 0, 805,
-	0, 807, 808, 770, 766, 767, 0, 98, 0, 826,
-	826, 97, 0, 813, 108, 109, 110, 394, 163, 168,
-	0, 0, 0, 173, 0, 175, 0, 376, 0, 260,
-	262, 0, 0, 166, 325, 0, 325, 0, 318, 0,
-	377, 389, 0, 1028, 1029, 0, 0, 340, 342, 0,
-	1134, 131, 0, 0, 0, 519, 0, 528, 0, 0,
-	0, 0, 0, 0, 155, 446, 0, 0, 334, 0,
-	0, 556, 553, 463, 0, 192, 193, 195, 0, 0,
-	465, 770, 1044, 1045, 1046, 0, 1056, 1060, 124, 0,
-	0, 0, 0, 568, 569, 570, 93, 578, 584, 691,
-	711, 841, 845, 0, 877, 979, 325, 982, 983, 985,
-	987, 988, 990, 991, 878, 879, 0, 916, 0, 918,
-	1022, 0, 1000, 0, 0, 930, 0, 0, 0, 936,
-	912, 0, 0, 0, 0, 794, -2, 62, 1009, 1011,
-	0, 1071, 1069, 1069, 1079, 1080, 0, 0, 1087, 0,
-	0, 0, 0, 82, 0, 0, 0, 1107, 0, 0,
-	0, 0, 99, 1013, 782, 789, 0, 0, 783, 0,
-	784, 804, 806, 765, -2, 826, 826, 88, 89, 0,
-	169, 0, 171, 186, 187, 0, 174, 176, 177, 259,
-	261, 0, 0, 301, 312, 302, 0, 0, 390, 1032,
-	1033, 1034, 1035, 1036, 1037, 1038, 770, 132, 133, 134,
-	511, 0, 521, 0, 0, 0, 514, 0, 452, 0,
-	562, 0, 0, 533, 0, 541, 0, 0, 0, 196,
-	197, 0, 1055, 497, 0, 122, 123, 0, 0, 502,
-	968, 980, 0, 0, 0, 997, 0, 0, 0, 0,
-	934, 0, 823, 0, 0, 0, 0, 814, 1011, 80,
-	0, 0, 1074, 0, 1067, 1070, 1068, 1081, 0, 0,
-	1088, 0, 1090, 0, 1111, 1112, 1105, 0, 1097, 1100,
-	1096, 1099, 1022, 786, 0, 791, 1013, 87, 0, 172,
-	0, 0, 0, 0, 0, 0, 316, 319, 0, 0,
-	0, 512, 0, 524, 515, 560, 561, 0, 564, 370,
-	557, 554, 555, 464, 0, 125, 126, 0, 0, 0,
-	0, 0, 0, 915, 917, 919, 928, 929, 977, 978,
-	0, 819, 776, 777, 824, 825, 0, 0, 769, 79,
-	1012, 1010, 1063, 0, 1072, 1073, 1082, 1083, 1089, 0,
-	1095, 0, 85, 0, 1022, 170, 0, 180, 0, 520,
-	0, 523, 513, 0, 1047, 0, 0, 0, 504, 876,
-	0, 0, 0, 0, 818, 820, 821, 1075, 1076, 1103,
-	1101, 787, 86, 371, 375, 1047, 0, 0, 565, 494,
-	1049, 127, 128, 438, 439, 440, 121, 969, 0, 972,
-	778, 779, 372, 373, 0, 164, 181, 182, 0, 0,
-	185, 178, 179, 117, 0, 0, 0, 1050, 1051, 124,
-	970, 374, 0, 0, 0, 183, 184, 529, 0, 0,
-	501, 0, 378, 380, 381, 0, 0, 379, 517, 0,
-	529, 1048, 0, 382, 383, 384, 530, 518, 971,
+	1363, 1364, 1365, 1366, 1367, 1368, 1369, 1370, 1371, -2,
+	0, 0, 187, 188, 0, 41, 400, 0, 183, 0,
+	394, 344, 1109, 0, 0, 0, 0, 0, 743, 0,
+	1200, 168, 169, 175, 176, 374, 374, 521, 0, 0,
+	177, 177, 488, 489, 490, 0, 0, -2, 398, 0,
+	473, 0, 0, 388, 388, 392, 390, 391, 0, 0,
+	0, 0, 0, 0, 501, 0, 502, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 583, 0, 375, 0,
+	519, 520, 428, 0, 0, 0, 0, 499, 500, 0,
+	0, 1208, 1209, 0, 0, 374, 374, 0, 0, 0,
+	0, 374, 374, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 167,
+	1122, 0, 0, 0, -2, 0, 597, 0, 0, 0,
+	1201, 1201, 0, 604, 0, 606, 607, 0, 0, 608,
+	0, 663, 663, 661, 662, 610, 611, 612, 613, 666,
+	0, 0, 383, 384, 385, 663, 666, 0, 666, 666,
+	666, 666, 663, 663, 663, 666, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1687, 1687, 1687, 669, 666,
+	0, 646, 0, 647, 648, 651, 652, 1688, 1689, 1238,
+	653, 654, 1191, 676, 684, 687, 704, 702, 703, 705,
+	697, 698, 699, 700, 0, 718, 719, 724, 0, 0,
+	0, 0, 730, 731, 732, 0, 0, 735, 736, 737,
+	0, 0, 0, 0, 862, 0, 0, 928, 929, 930,
+	931, 932, 933, 934, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 901, 902, 903, 904, 907, 0,
+	0, 0, 912, 913, 0, 0, 0, 0, 0, 0,
+	753, 0, 1065, 0, 992, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 993, 995, 996,
+	0, 997, 0, 0, 0, 0, 0, 0, 0, 763,
+	0, 0, 763, 763, 0, 0, 219, 220, 221, 222,
+	236, 237, 238, 239, 240, 289, 1073, 0, 938, 0,
+	0, 51, 1686, 49, 50, 708, 709, 0, 825, 1211,
+	0, 0, 759, 0, 86, 95, 97, 1092, 90, 1092,
+	0, 765, 0, 0, -2, -2, 766, 772, 773, 774,
+	775, 776, 83, 1685, 84, 0, 103, 0, 77, 0,
+	0, 0, 0, 356, 1134, 0, 0, 1085, 1086, 1089,
+	0, 780, 784, 0, 786, 787, 0, 746, 0, 129,
+	0, 841, 0, 0, 0, 1626, 1189, 0, 133, 134,
+	0, 0, 0, 362, 1194, 1195, 1196, -2, 381, 0,
+	362, 351, 295, 296, 297, 344, 299, 344, 344, 344,
+	344, 356, 356, 356, 356, 327, 328, 329, 330, 331,
+	0, 0, 314, 344, 344, 344, 344, 334, 335, 336,
+	337, 338, 339, 340, 341, 300, 301, 302, 303, 304,
+	305, 306, 307, 308, 346, 346, 346, 348, 348, 0,
+	42, 0, 366, 0, 1089, 0, 1122, 1203, 1213, 0,
+	0, 0, 1203, 151, 0, 0, 522, 552, 482, 511,
+	523, 0, 485, 486, -2, 0, 0, 472, 0, 474,
+	0, 382, 0, -2, 0, 392, 0, 388, 392, 389,
+	392, 380, 393, 503, 504, 505, 0, 563, 811, 0,
+	0, 0, 0, 0, 569, 570, 571, 0, 573, 574,
+	575, 576, 577, 578, 579, 580, 581, 582, 512, 513,
+	514, 515, 516, 517, 518, 0, 0, 474, 0, 508,
+	0, 429, 430, 0, 0, 433, 434, 435, 436, 0,
+	0, 439, 440, 441, 828, 829, 442, 466, 467, 468,
+	443, 444, 445, 446, 447, 448, 449, 460, 461, 462,
+	463, 464, 465, 450, 451, 452, 453, 454, 457, 0,
+	161, 1113, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1201, 0, 0, 0, 0, 762, 843,
+	1224, 1225, 667, 668, 0, 386, 387, 666, 666, 614,
+	637, 0, 666, 618, 639, 619, 621, 620, 622, 641,
+	642, 666, 625, 664, 665, 626, 627, 628, 629, 630,
+	631, 632, 633, 634, 635, 643, 644, 645, 670, 0,
+	0, 685, 689, 690, 691, 692, 0, 0, 721, 125,
+	726, 727, 728, 729, 741, 734, 940, 859, 860, 861,
+	863, 865, 0, 924, 926, 867, 869, 935, 936, 937,
+	0, 0, 0, 0, 0, 873, 877, 880, 881, 882,
+	883, 884, 885, 886, 887, 888, 889, 890, 891, 898,
+	1043, 1044, 1045, 915, 916, 0, 777, 0, 0, 0,
+	914, 0, 0, 0, 0, 0, 754, 755, 1071, 1068,
+	0, 0, 1029, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1031, 0, 0, 0, 0, 1007,
+	1008, 1009, 1010, 1011, 1012, 1013, 1014, 1015, 1016, 1017,
+	1018, 1019, 1020, 1021, 1022, 1023, 1024, 1025, 1026, 1027,
+	1046, 0, 0, 1064, 0, 917, 918, 919, 0, 0,
+	0, 764, 1074, 0, -2, 0, 0, 0, 0, 0,
+	0, 0, 1178, 0, 52, 45, 0, 711, 712, 714,
+	0, 845, 0, 826, 0, 0, 1212, 717, 0, 758,
+	0, 761, 89, 91, 770, 771, 0, 788, 767, 85,
+	80, 0, 0, 803, 1132, 356, 1154, 0, 360, 361,
+	1095, 1096, 0, 1088, 1090, 1091, 108, 785, 781, 0,
+	857, 0, 0, 840, 0, 791, 793, 794, 795, 823,
+	0, 0, 0, 0, 127, 842, 1184, 132, 0, 0,
+	137, 138, 1185, 1186, 1187, 1188, 0, 541, -2, 424,
+	189, 191, 192, 193, 184, -2, 354, 352, 353, 298,
+	356, 356, 321, 322, 323, 324, 325, 326, 0, 0,
+	315, 316, 317, 318, 309, 0, 310, 311, 312, 0,
+	313, 399, 0, 1097, 367, 368, 370, 374, 0, 0,
+	0, 395, 396, 0, 1113, 0, 0, 0, 1214, 1213,
+	1213, 1213, 0, 179, 180, 181, 182, 558, 0, 0,
+	553, 556, 557, 177, 0, 0, 185, 476, 475, 0,
+	590, 0, 397, 0, 0, 392, 392, 377, 378, 506,
+	0, 0, 565, 566, 567, 568, 0, 0, 0, 474,
+	476, 362, 0, 431, 432, 437, 438, 455, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 536,
+	537, 538, 539, 540, 1110, 1111, 1112, 0, 0, 598,
+	0, 0, 416, 123, 1202, 603, 663, 624, 640, 663,
+	616, 623, 649, 681, 725, 0, 0, 0, 0, 733,
+	0, 866, 925, 927, 870, 0, 874, 878, 0, 0,
+	0, 0, 0, 0, 0, 921, 982, 983, 984, 0,
+	0, 1083, 0, 1069, 0, 0, 991, 946, 0, 947,
+	948, 949, 950, 0, 0, 0, 0, 0, 0, 1032,
+	0, 0, 0, 0, 0, 1002, 1003, 0, 1005, 0,
+	941, 0, 0, 763, 0, 1033, 1034, 1035, 1036, 1037,
+	1074, 0, 710, 713, 715, 799, 846, 847, 0, 0,
+	0, 0, 827, 1210, 756, 757, 760, -2, 0, 0,
+	1076, 0, 0, 788, 857, 789, 0, 768, 82, 802,
+	0, 1136, 1135, 1148, 1161, 357, 358, 359, 1087, 0,
+	1092, 0, 1172, 0, 0, 1164, 0, 0, 0, 0,
+	0, 0, 0, 0, 830, 0, 0, 833, 0, 0,
+	0, 0, 824, 0, 0, 0, 0, -2, 0, 0,
+	121, 122, 0, 0, 0, 135, 136, 0, 0, 142,
+	363, 364, 170, 177, 426, 190, 406, 0, 0, 294,
+	355, 319, 320, 0, 342, 0, 0, 0, 419, 147,
+	1101, 1100, 374, 374, 369, 0, 372, 0, 1215, 345,
+	0, 160, 0, 0, 0, 0, 0, 166, 547, 0,
+	0, 554, 0, 0, 0, 480, 0, 491, 492, 0,
+	562, 589, 0, 594, 366, 0, 376, 379, 812, 0,
+	0, 493, 0, 476, 497, 498, 509, 458, 459, 456,
+	0, 0, 1123, 1124, 1129, 1127, 1128, 152, 529, 531,
+	530, 534, 0, 0, 527, 0, 416, 1097, 0, 602,
+	417, 418, 666, 666, 720, 126, 0, 723, 0, 0,
+	0, 871, 875, 778, 0, 0, 344, 344, 1051, 344,
+	348, 1054, 344, 1056, 344, 1059, 344, 1062, 1063, 0,
+	0, 0, 0, 1066, 990, 1072, 0, 960, 958, 951,
+	952, 953, 954, 955, 0, 0, 0, 0, 1001, 0,
+	0, 0, 0, 945, 942, 943, 0, 1075, 53, 848,
+	853, 853, 853, 0, 0, 0, 1197, 1198, 1077, 1078,
+	857, 1079, 790, 769, 804, 1154, 0, 1147, 0, -2,
+	1156, 0, 0, 0, 1162, 782, 109, 858, 112, 0,
+	1172, 1180, 0, 1163, 1174, 1176, 0, 0, 0, 1168,
+	0, 857, 792, 819, 821, 0, 816, 831, 832, 834,
+	0, 836, 0, 838, 839, 801, 797, 798, 0, 129,
+	0, 857, 857, 128, 0, 844, 139, 140, 141, 425,
+	194, 199, 0, 0, 0, 204, 0, 206, 0, 407,
+	0, 291, 293, 0, 0, 197, 356, 0, 356, 0,
+	349, 0, 408, 420, 0, 1098, 1099, 0, 0, 371,
+	373, 0, 1204, 162, 0, 0, 0, 550, 0, 559,
+	0, 0, 0, 0, 0, 0, 186, 477, 0, 0,
+	365, 0, 0, 587, 584, 494, 0, 223, 224, 226,
+	0, 0, 496, 801, 1114, 1115, 1116, 0, 1126, 1130,
+	155, 0, 0, 0, 0, 599, 600, 601, 124, 609,
+	615, 722, 742, 872, 876, 0, 909, 1049, 356, 1052,
+	1053, 1055, 1057, 1058, 1060, 1061, 910, 911, 0, 986,
+	0, 988, 1092, 0, 1070, 0, 962, 961, 0, 0,
+	0, 1000, 0, 0, 0, 1006, 944, 0, 54, 55,
+	0, 0, 0, 0, 825, -2, 93, 1079, 1081, 0,
+	1141, 1139, 1139, 1149, 1150, 0, 0, 1157, 0, 0,
+	0, 0, 113, 0, 0, 0, 1177, 0, 0, 0,
+	0, 130, 1083, 813, 820, 0, 0, 814, 0, 815,
+	835, 837, 796, -2, 857, 857, 119, 120, 0, 200,
+	0, 202, 217, 218, 0, 205, 207, 208, 290, 292,
+	0, 0, 332, 343, 333, 0, 0, 421, 1102, 1103,
+	1104, 1105, 1106, 1107, 1108, 801, 163, 164, 165, 542,
+	0, 552, 0, 0, 0, 545, 0, 483, 0, 593,
+	0, 0, 564, 0, 572, 0, 0, 0, 227, 228,
+	0, 1125, 528, 0, 153, 154, 0, 0, 533, 1038,
+	1050, 0, 0, 0, 1067, 957, 1083, 0, 956, 0,
+	0, 0, 0, 1004, 0, 0, 854, 0, 0, 0,
+	0, 845, 1081, 977, 0, 0, 1144, 0, 1137, 1140,
+	1138, 1151, 0, 0, 1158, 0, 1160, 0, 1181, 1182,
+	1175, 0, 1167, 1170, 1166, 1169, 1092, 817, 0, 822,
+	1083, 118, 0, 203, 0, 0, 0, 0, 0, 0,
+	347, 350, 0, 0, 0, 543, 0, 555, 546, 591,
+	592, 0, 595, 401, 588, 585, 586, 495, 0, 156,
+	157, 0, 0, 0, 0, 0, 0, 985, 987, 989,
+	964, 0, 998, 999, 1047, 1048, 0, 0, 850, 807,
+	808, 855, 856, 0, 0, 800, 977, 111, 0, 1082,
+	1080, 1133, 0, 1142, 1143, 1152, 1153, 1159, 0, 1165,
+	0, 116, 0, 1092, 201, 0, 211, 0, 551, 0,
+	554, 544, 0, 1117, 0, 0, 0, 535, 908, 0,
+	0, 0, 959, 0, 967, 968, 963, 129, 46, 47,
+	0, 849, 851, 852, 110, 978, 979, 0, 1145, 1146,
+	1173, 1171, 818, 117, 402, 406, 1117, 0, 0, 596,
+	525, 1119, 158, 159, 469, 470, 471, 152, 1039, 0,
+	1042, 965, 0, 0, 0, 0, 974, 975, 0, 356,
+	809, 810, 0, 0, 403, 404, 0, 195, 212, 213,
+	0, 0, 216, 209, 210, 148, 0, 0, 0, 1120,
+	1121, 155, 1040, 0, 969, 970, 971, 972, 973, 0,
+	56, 980, 960, 405, 0, 0, 0, 214, 215, 560,
+	0, 0, 532, 0, 0, 976, 62, 58, 0, 409,
+	411, 412, 0, 0, 410, 548, 0, 560, 1118, 0,
+	966, 67, 64, -2, 981, 413, 414, 415, 561, 549,
+	1041, 70, 0, 63, 0, 0, 0, 74, 0, 0,
+	0, 0, 0, 0, 0, 44, 0, 0, 72, 68,
+	69, 0, 0, 59, 0, 61, 75, 71, 0, 65,
+	66, 60, 73,
 }
 
 var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 128, 3, 3, 3, 154, 146, 3,
-	83, 85, 151, 149, 84, 150, 203, 152, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 564,
-	136, 135, 137, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 132, 3, 3, 3, 158, 150, 3,
+	85, 87, 155, 153, 86, 154, 207, 156, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 570,
+	140, 139, 141, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 156, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 160, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 124, 3, 157,
+	3, 3, 3, 3, 128, 3, 161,
 }
 
 var yyTok2 = [...]int{
@@ -6629,17 +7066,17 @@ var yyTok2 = [...]int{
 	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
 	62, 63, 64, 65, 66, 67, 68, 69, 70, 71,
 	72, 73, 74, 75, 76, 77, 78, 79, 80, 81,
-	82, 86, 87, 88, 89, 90, 91, 92, 93, 94,
+	82, 83, 84, 88, 89, 90, 91, 92, 93, 94,
 	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
 	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 123, 125,
-	126, 127, 129, 130, 131, 132, 133, 134, 138, 139,
-	140, 141, 142, 143, 144, 145, 147, 148, 153, 155,
-	158, 159, 160, 161, 162, 163, 164, 165, 166, 167,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	125, 126, 127, 129, 130, 131, 133, 134, 135, 136,
+	137, 138, 142, 143, 144, 145, 146, 147, 148, 149,
+	151, 152, 157, 159, 162, 163, 164, 165, 166, 167,
 	168, 169, 170, 171, 172, 173, 174, 175, 176, 177,
 	178, 179, 180, 181, 182, 183, 184, 185, 186, 187,
 	188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
-	198, 199, 200, 201, 202, 204, 205, 206, 207, 208,
+	198, 199, 200, 201, 202, 203, 204, 205, 206, 208,
 	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
 	219, 220, 221, 222, 223, 224, 225, 226, 227, 228,
 	229, 230, 231, 232, 233, 234, 235, 236, 237, 238,
@@ -6707,7 +7144,9 @@ var yyTok3 = [...]int{
 	57870, 545, 57871, 546, 57872, 547, 57873, 548, 57874, 549,
 	57875, 550, 57876, 551, 57877, 552, 57878, 553, 57879, 554,
 	57880, 555, 57881, 556, 57882, 557, 57883, 558, 57884, 559,
-	57885, 560, 57886, 561, 57887, 562, 57888, 563, 0,
+	57885, 560, 57886, 561, 57887, 562, 57888, 563, 57889, 564,
+	57890, 565, 57891, 566, 57892, 567, 57893, 568, 57894, 569,
+	0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -7057,3279 +7496,3542 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:479
+//line sql.y:511
 		{
 			setParseTree(yylex, yyDollar[1].statementUnion())
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:484
+//line sql.y:516
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:485
+//line sql.y:517
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:489
+//line sql.y:521
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 37:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:525
+//line sql.y:557
 		{
 			setParseTree(yylex, nil)
 		}
 	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:531
+//line sql.y:563
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), NoAt)
 		}
 	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:535
+//line sql.y:567
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), SingleAt)
 		}
 	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:539
+//line sql.y:571
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), DoubleAt)
 		}
 	case 41:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:544
+//line sql.y:576
 		{
 			yyVAL.colIdent = NewColIdentWithAt("", NoAt)
 		}
 	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:548
+//line sql.y:580
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
 	case 43:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:554
+//line sql.y:586
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
 		yyVAL.union = yyLOCAL
 	case 44:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-17 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:560
-		{
-			yyLOCAL = &Load{}
+//line sql.y:592
+		{
+			load := &Load{
+				LowPriority:        yyDollar[3].str == "low_priority",
+				Concurrent:         yyDollar[3].str == "concurrent",
+				Local:              yyDollar[4].booleanUnion(),
+				FileName:           yyDollar[6].str,
+				Ignore:             yyDollar[7].str == "ignore",
+				Table:              yyDollar[10].tableName,
+				Partitions:         yyDollar[11].partitionsUnion(),
+				Charset:            yyDollar[12].str,
+				FieldsTerminatedBy: yyDollar[13].loadUnion().FieldsTerminatedBy,
+				FieldsEnclosedBy:   yyDollar[13].loadUnion().FieldsEnclosedBy,
+				OptionallyEnclosed: yyDollar[13].loadUnion().OptionallyEnclosed,
+				FieldsEscapedBy:    yyDollar[13].loadUnion().FieldsEscapedBy,
+				LinesStartingBy:    yyDollar[14].loadUnion().LinesStartingBy,
+				LinesTerminatedBy:  yyDollar[14].loadUnion().LinesTerminatedBy,
+				IgnoreLines:        yyDollar[15].literalUnion(),
+				Columns:            yyDollar[16].columnsUnion(),
+				SetExprs:           yyDollar[17].updateExprsUnion(),
+			}
+			if yyDollar[7].str == "replace" {
+				load.Action = ReplaceAct
+			}
+			yyLOCAL = load
 		}
 		yyVAL.union = yyLOCAL
 	case 45:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL *With
-//line sql.y:566
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:619
 		{
-			yyLOCAL = &With{ctes: yyDollar[2].ctesUnion(), Recursive: false}
+			yyLOCAL = &Load{}
 		}
 		yyVAL.union = yyLOCAL
 	case 46:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL *With
-//line sql.y:570
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:627
 		{
-			yyLOCAL = &With{ctes: yyDollar[3].ctesUnion(), Recursive: true}
+			yyVAL.tableName = yyDollar[1].tableName
 		}
-		yyVAL.union = yyLOCAL
 	case 47:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		var yyLOCAL *With
-//line sql.y:575
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:631
 		{
-			yyLOCAL = nil
+			yyVAL.tableName = TableName{Name: NewTableIdent(yyDollar[1].str)}
 		}
-		yyVAL.union = yyLOCAL
 	case 48:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL *With
-//line sql.y:579
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:636
 		{
-			yyLOCAL = yyDollar[1].withUnion()
+			yyVAL.str = ""
 		}
-		yyVAL.union = yyLOCAL
 	case 49:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:585
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:640
 		{
-			yySLICE := (*[]*CommonTableExpr)(yyIaddr(yyVAL.union))
-			*yySLICE = append(*yySLICE, yyDollar[3].cteUnion())
+			yyVAL.str = "low_priority"
 		}
 	case 50:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL []*CommonTableExpr
-//line sql.y:589
+//line sql.y:644
 		{
-			yyLOCAL = []*CommonTableExpr{yyDollar[1].cteUnion()}
+			yyVAL.str = "concurrent"
 		}
-		yyVAL.union = yyLOCAL
 	case 51:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		var yyLOCAL *CommonTableExpr
-//line sql.y:595
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line sql.y:649
 		{
-			yyLOCAL = &CommonTableExpr{TableID: yyDollar[1].tableIdent, Columns: yyDollar[2].columnsUnion(), Subquery: yyDollar[4].subqueryUnion()}
+			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
 	case 52:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL SelectStatement
-//line sql.y:601
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line sql.y:653
 		{
-			yyLOCAL = yyDollar[2].selStmtUnion()
+			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
 	case 53:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL SelectStatement
-//line sql.y:605
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:658
 		{
-			yyLOCAL = yyDollar[2].selStmtUnion()
+			yyVAL.str = ""
 		}
-		yyVAL.union = yyLOCAL
 	case 54:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		var yyLOCAL SelectStatement
-//line sql.y:609
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:662
 		{
-			setLockInSelect(yyDollar[2].selStmtUnion(), yyDollar[3].lockUnion())
-			yyLOCAL = yyDollar[2].selStmtUnion()
+			yyVAL.str = "replace"
 		}
-		yyVAL.union = yyLOCAL
 	case 55:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL SelectStatement
-//line sql.y:632
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:666
 		{
-			yyDollar[1].selStmtUnion().SetOrderBy(yyDollar[2].orderByUnion())
-			yyDollar[1].selStmtUnion().SetLimit(yyDollar[3].limitUnion())
-			yyLOCAL = yyDollar[1].selStmtUnion()
+			yyVAL.str = "ignore"
 		}
-		yyVAL.union = yyLOCAL
 	case 56:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL SelectStatement
-//line sql.y:638
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *Load
+//line sql.y:671
 		{
-			yyDollar[1].selStmtUnion().SetLimit(yyDollar[2].limitUnion())
-			yyLOCAL = yyDollar[1].selStmtUnion()
+			yyLOCAL = &Load{}
 		}
 		yyVAL.union = yyLOCAL
 	case 57:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL SelectStatement
-//line sql.y:643
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *Load
+//line sql.y:675
 		{
-			yyDollar[1].selStmtUnion().SetOrderBy(yyDollar[2].orderByUnion())
-			yyDollar[1].selStmtUnion().SetLimit(yyDollar[3].limitUnion())
-			yyLOCAL = yyDollar[1].selStmtUnion()
+			yyLOCAL = yyDollar[2].loadUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 58:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		var yyLOCAL SelectStatement
-//line sql.y:649
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *Load
+//line sql.y:680
 		{
-			yyDollar[2].selStmtUnion().SetWith(yyDollar[1].withUnion())
-			yyDollar[2].selStmtUnion().SetOrderBy(yyDollar[3].orderByUnion())
-			yyDollar[2].selStmtUnion().SetLimit(yyDollar[4].limitUnion())
-			yyLOCAL = yyDollar[2].selStmtUnion()
+			yyLOCAL = &Load{}
 		}
 		yyVAL.union = yyLOCAL
 	case 59:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL SelectStatement
-//line sql.y:656
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *Load
+//line sql.y:684
 		{
-			yyDollar[2].selStmtUnion().SetWith(yyDollar[1].withUnion())
-			yyDollar[2].selStmtUnion().SetLimit(yyDollar[3].limitUnion())
-			yyLOCAL = yyDollar[2].selStmtUnion()
+			yyDollar[1].loadUnion().FieldsTerminatedBy = NewStrLiteral(yyDollar[4].str)
+			yyLOCAL = yyDollar[1].loadUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 60:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		var yyLOCAL SelectStatement
-//line sql.y:662
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *Load
+//line sql.y:689
 		{
-			yyDollar[2].selStmtUnion().SetWith(yyDollar[1].withUnion())
-			yyDollar[2].selStmtUnion().SetOrderBy(yyDollar[3].orderByUnion())
-			yyDollar[2].selStmtUnion().SetLimit(yyDollar[4].limitUnion())
-			yyLOCAL = yyDollar[2].selStmtUnion()
+			yyDollar[1].loadUnion().OptionallyEnclosed = yyDollar[2].str != ""
+			yyDollar[1].loadUnion().FieldsEnclosedBy = NewStrLiteral(yyDollar[5].str)
+			yyLOCAL = yyDollar[1].loadUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 61:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:669
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *Load
+//line sql.y:695
 		{
-			yyDollar[2].selStmtUnion().SetWith(yyDollar[1].withUnion())
+			yyDollar[1].loadUnion().FieldsEscapedBy = NewStrLiteral(yyDollar[4].str)
+			yyLOCAL = yyDollar[1].loadUnion()
 		}
+		yyVAL.union = yyLOCAL
 	case 62:
-		yyDollar = yyS[yypt-7 : yypt+1]
-		var yyLOCAL SelectStatement
-//line sql.y:673
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *Load
+//line sql.y:701
 		{
-			yyLOCAL = NewSelect(Comments(yyDollar[2].strs), SelectExprs{&Nextval{Expr: yyDollar[5].exprUnion()}}, []string{yyDollar[3].str} /*options*/, nil, TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}, nil /*where*/, nil /*groupBy*/, nil /*having*/)
+			yyLOCAL = &Load{}
 		}
 		yyVAL.union = yyLOCAL
 	case 63:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL SelectStatement
-//line sql.y:679
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *Load
+//line sql.y:705
 		{
-			yyLOCAL = yyDollar[1].selStmtUnion()
+			yyLOCAL = yyDollar[2].loadUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 64:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL SelectStatement
-//line sql.y:683
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *Load
+//line sql.y:710
 		{
-			yyLOCAL = &Union{Left: yyDollar[1].selStmtUnion(), Distinct: yyDollar[2].booleanUnion(), Right: yyDollar[3].selStmtUnion()}
+			yyLOCAL = &Load{}
 		}
 		yyVAL.union = yyLOCAL
 	case 65:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL SelectStatement
-//line sql.y:687
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *Load
+//line sql.y:714
 		{
-			yyLOCAL = &Union{Left: yyDollar[1].selStmtUnion(), Distinct: yyDollar[2].booleanUnion(), Right: yyDollar[3].selStmtUnion()}
+			yyDollar[1].loadUnion().LinesStartingBy = NewStrLiteral(yyDollar[4].str)
+			yyLOCAL = yyDollar[1].loadUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 66:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL SelectStatement
-//line sql.y:691
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *Load
+//line sql.y:719
 		{
-			yyLOCAL = &Union{Left: yyDollar[1].selStmtUnion(), Distinct: yyDollar[2].booleanUnion(), Right: yyDollar[3].selStmtUnion()}
+			yyDollar[1].loadUnion().LinesTerminatedBy = NewStrLiteral(yyDollar[4].str)
+			yyLOCAL = yyDollar[1].loadUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 67:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL SelectStatement
-//line sql.y:695
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *Literal
+//line sql.y:725
 		{
-			yyLOCAL = &Union{Left: yyDollar[1].selStmtUnion(), Distinct: yyDollar[2].booleanUnion(), Right: yyDollar[3].selStmtUnion()}
+			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
 	case 68:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL SelectStatement
-//line sql.y:701
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *Literal
+//line sql.y:729
 		{
-			yyLOCAL = yyDollar[1].selStmtUnion()
+			yyLOCAL = NewIntLiteral(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
 	case 69:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL SelectStatement
-//line sql.y:705
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *Literal
+//line sql.y:733
 		{
-			setLockInSelect(yyDollar[1].selStmtUnion(), yyDollar[2].lockUnion())
-			yyLOCAL = yyDollar[1].selStmtUnion()
+			yyLOCAL = NewIntLiteral(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
 	case 70:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL SelectStatement
-//line sql.y:710
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL Columns
+//line sql.y:738
 		{
-			yyLOCAL = yyDollar[1].selStmtUnion()
+			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
 	case 71:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL SelectStatement
-//line sql.y:714
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Columns
+//line sql.y:742
 		{
-			yyLOCAL = yyDollar[1].selStmtUnion()
+			yyLOCAL = yyDollar[2].columnsUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 72:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL SelectStatement
-//line sql.y:720
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL Columns
+//line sql.y:749
 		{
-			yyLOCAL = yyDollar[2].selStmtUnion()
+			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
 	case 73:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL SelectStatement
-//line sql.y:724
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:753
 		{
-			yyDollar[1].selStmtUnion().SetInto(yyDollar[2].selectIntoUnion())
-			yyLOCAL = yyDollar[1].selStmtUnion()
+			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
+			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-		yyVAL.union = yyLOCAL
 	case 74:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL SelectStatement
-//line sql.y:729
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL UpdateExprs
+//line sql.y:758
 		{
-			yyDollar[1].selStmtUnion().SetInto(yyDollar[2].selectIntoUnion())
-			yyDollar[1].selStmtUnion().SetLock(yyDollar[3].lockUnion())
-			yyLOCAL = yyDollar[1].selStmtUnion()
+			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
 	case 75:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL SelectStatement
-//line sql.y:735
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL UpdateExprs
+//line sql.y:762
 		{
-			yyDollar[1].selStmtUnion().SetInto(yyDollar[3].selectIntoUnion())
-			yyDollar[1].selStmtUnion().SetLock(yyDollar[2].lockUnion())
-			yyLOCAL = yyDollar[1].selStmtUnion()
+			yyLOCAL = yyDollar[2].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 76:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL SelectStatement
-//line sql.y:741
+		var yyLOCAL *With
+//line sql.y:768
 		{
-			yyDollar[1].selStmtUnion().SetInto(yyDollar[2].selectIntoUnion())
-			yyLOCAL = yyDollar[1].selStmtUnion()
+			yyLOCAL = &With{CTEs: yyDollar[2].ctesUnion(), Recursive: false}
 		}
 		yyVAL.union = yyLOCAL
 	case 77:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		var yyLOCAL Statement
-//line sql.y:748
-		{
-			yyLOCAL = &Stream{Comments: Comments(yyDollar[2].strs), SelectExpr: yyDollar[3].selectExprUnion(), Table: yyDollar[5].tableName}
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *With
+//line sql.y:772
+		{
+			yyLOCAL = &With{CTEs: yyDollar[3].ctesUnion(), Recursive: true}
 		}
 		yyVAL.union = yyLOCAL
 	case 78:
-		yyDollar = yyS[yypt-7 : yypt+1]
-		var yyLOCAL Statement
-//line sql.y:754
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *With
+//line sql.y:777
 		{
-			yyLOCAL = &VStream{Comments: Comments(yyDollar[2].strs), SelectExpr: yyDollar[3].selectExprUnion(), Table: yyDollar[5].tableName, Where: NewWhere(WhereClause, yyDollar[6].exprUnion()), Limit: yyDollar[7].limitUnion()}
+			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
 	case 79:
-		yyDollar = yyS[yypt-9 : yypt+1]
-		var yyLOCAL SelectStatement
-//line sql.y:762
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *With
+//line sql.y:781
 		{
-			yyLOCAL = NewSelect(Comments(yyDollar[2].strs), yyDollar[4].selectExprsUnion() /*SelectExprs*/, yyDollar[3].strs /*options*/, yyDollar[5].selectIntoUnion() /*into*/, yyDollar[6].tableExprsUnion() /*from*/, NewWhere(WhereClause, yyDollar[7].exprUnion()), GroupBy(yyDollar[8].exprsUnion()), NewWhere(HavingClause, yyDollar[9].exprUnion()))
+			yyLOCAL = yyDollar[1].withUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 80:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		var yyLOCAL SelectStatement
-//line sql.y:766
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:787
 		{
-			yyLOCAL = NewSelect(Comments(yyDollar[2].strs), yyDollar[4].selectExprsUnion() /*SelectExprs*/, yyDollar[3].strs /*options*/, nil, yyDollar[5].tableExprsUnion() /*from*/, NewWhere(WhereClause, yyDollar[6].exprUnion()), GroupBy(yyDollar[7].exprsUnion()), NewWhere(HavingClause, yyDollar[8].exprUnion()))
+			yySLICE := (*[]*CommonTableExpr)(yyIaddr(yyVAL.union))
+			*yySLICE = append(*yySLICE, yyDollar[3].cteUnion())
 		}
-		yyVAL.union = yyLOCAL
 	case 81:
-		yyDollar = yyS[yypt-7 : yypt+1]
-		var yyLOCAL Statement
-//line sql.y:774
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*CommonTableExpr
+//line sql.y:791
 		{
-			// insert_data returns a *Insert pre-filled with Columns & Values
-			ins := yyDollar[6].insUnion()
-			ins.Action = yyDollar[1].insertActionUnion()
-			ins.Comments = yyDollar[2].strs
-			ins.Ignore = yyDollar[3].ignoreUnion()
-			ins.Table = yyDollar[4].tableName
-			ins.Partitions = yyDollar[5].partitionsUnion()
-			ins.OnDup = OnDup(yyDollar[7].updateExprsUnion())
-			yyLOCAL = ins
+			yyLOCAL = []*CommonTableExpr{yyDollar[1].cteUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 82:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		var yyLOCAL Statement
-//line sql.y:786
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *CommonTableExpr
+//line sql.y:797
 		{
-			cols := make(Columns, 0, len(yyDollar[7].updateExprsUnion()))
-			vals := make(ValTuple, 0, len(yyDollar[8].updateExprsUnion()))
-			for _, updateList := range yyDollar[7].updateExprsUnion() {
-				cols = append(cols, updateList.Name.Name)
-				vals = append(vals, updateList.Expr)
-			}
-			yyLOCAL = &Insert{Action: yyDollar[1].insertActionUnion(), Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitionsUnion(), Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprsUnion())}
+			yyLOCAL = &CommonTableExpr{TableID: yyDollar[1].tableIdent, Columns: yyDollar[2].columnsUnion(), Subquery: yyDollar[4].subqueryUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 83:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL InsertAction
-//line sql.y:798
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL SelectStatement
+//line sql.y:803
 		{
-			yyLOCAL = InsertAct
+			yyLOCAL = yyDollar[2].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 84:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL InsertAction
-//line sql.y:802
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL SelectStatement
+//line sql.y:807
 		{
-			yyLOCAL = ReplaceAct
+			yyLOCAL = yyDollar[2].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 85:
-		yyDollar = yyS[yypt-10 : yypt+1]
-		var yyLOCAL Statement
-//line sql.y:808
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL SelectStatement
+//line sql.y:811
 		{
-			yyLOCAL = &Update{With: yyDollar[1].withUnion(), Comments: Comments(yyDollar[3].strs), Ignore: yyDollar[4].ignoreUnion(), TableExprs: yyDollar[5].tableExprsUnion(), Exprs: yyDollar[7].updateExprsUnion(), Where: NewWhere(WhereClause, yyDollar[8].exprUnion()), OrderBy: yyDollar[9].orderByUnion(), Limit: yyDollar[10].limitUnion()}
+			setLockInSelect(yyDollar[2].selStmtUnion(), yyDollar[3].lockUnion())
+			yyLOCAL = yyDollar[2].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 86:
-		yyDollar = yyS[yypt-11 : yypt+1]
-		var yyLOCAL Statement
-//line sql.y:814
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL SelectStatement
+//line sql.y:834
 		{
-			yyLOCAL = &Delete{With: yyDollar[1].withUnion(), Comments: Comments(yyDollar[3].strs), Ignore: yyDollar[4].ignoreUnion(), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[6].tableName, As: yyDollar[7].tableIdent}}, Partitions: yyDollar[8].partitionsUnion(), Where: NewWhere(WhereClause, yyDollar[9].exprUnion()), OrderBy: yyDollar[10].orderByUnion(), Limit: yyDollar[11].limitUnion()}
+			yyDollar[1].selStmtUnion().SetOrderBy(yyDollar[2].orderByUnion())
+			yyDollar[1].selStmtUnion().SetLimit(yyDollar[3].limitUnion())
+			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 87:
-		yyDollar = yyS[yypt-9 : yypt+1]
-		var yyLOCAL Statement
-//line sql.y:818
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL SelectStatement
+//line sql.y:840
 		{
-			yyLOCAL = &Delete{With: yyDollar[1].withUnion(), Comments: Comments(yyDollar[3].strs), Ignore: yyDollar[4].ignoreUnion(), Targets: yyDollar[6].tableNamesUnion(), TableExprs: yyDollar[8].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[9].exprUnion())}
+			yyDollar[1].selStmtUnion().SetLimit(yyDollar[2].limitUnion())
+			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 88:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		var yyLOCAL Statement
-//line sql.y:822
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL SelectStatement
+//line sql.y:845
 		{
-			yyLOCAL = &Delete{With: yyDollar[1].withUnion(), Comments: Comments(yyDollar[3].strs), Ignore: yyDollar[4].ignoreUnion(), Targets: yyDollar[5].tableNamesUnion(), TableExprs: yyDollar[7].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[8].exprUnion())}
+			yyDollar[1].selStmtUnion().SetOrderBy(yyDollar[2].orderByUnion())
+			yyDollar[1].selStmtUnion().SetLimit(yyDollar[3].limitUnion())
+			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 89:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		var yyLOCAL Statement
-//line sql.y:826
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL SelectStatement
+//line sql.y:851
 		{
-			yyLOCAL = &Delete{With: yyDollar[1].withUnion(), Comments: Comments(yyDollar[3].strs), Ignore: yyDollar[4].ignoreUnion(), Targets: yyDollar[5].tableNamesUnion(), TableExprs: yyDollar[7].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[8].exprUnion())}
+			yyDollar[2].selStmtUnion().SetWith(yyDollar[1].withUnion())
+			yyDollar[2].selStmtUnion().SetOrderBy(yyDollar[3].orderByUnion())
+			yyDollar[2].selStmtUnion().SetLimit(yyDollar[4].limitUnion())
+			yyLOCAL = yyDollar[2].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 90:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:831
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL SelectStatement
+//line sql.y:858
 		{
+			yyDollar[2].selStmtUnion().SetWith(yyDollar[1].withUnion())
+			yyDollar[2].selStmtUnion().SetLimit(yyDollar[3].limitUnion())
+			yyLOCAL = yyDollar[2].selStmtUnion()
 		}
+		yyVAL.union = yyLOCAL
 	case 91:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:832
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL SelectStatement
+//line sql.y:864
 		{
+			yyDollar[2].selStmtUnion().SetWith(yyDollar[1].withUnion())
+			yyDollar[2].selStmtUnion().SetOrderBy(yyDollar[3].orderByUnion())
+			yyDollar[2].selStmtUnion().SetLimit(yyDollar[4].limitUnion())
+			yyLOCAL = yyDollar[2].selStmtUnion()
 		}
+		yyVAL.union = yyLOCAL
 	case 92:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL TableNames
-//line sql.y:836
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:871
 		{
-			yyLOCAL = TableNames{yyDollar[1].tableName.ToViewName()}
+			yyDollar[2].selStmtUnion().SetWith(yyDollar[1].withUnion())
 		}
-		yyVAL.union = yyLOCAL
 	case 93:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:840
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL SelectStatement
+//line sql.y:875
 		{
-			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
-			*yySLICE = append(*yySLICE, yyDollar[3].tableName.ToViewName())
+			yyLOCAL = NewSelect(Comments(yyDollar[2].strs), SelectExprs{&Nextval{Expr: yyDollar[5].exprUnion()}}, []string{yyDollar[3].str} /*options*/, nil, TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}, nil /*where*/, nil /*groupBy*/, nil /*having*/)
 		}
+		yyVAL.union = yyLOCAL
 	case 94:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL TableNames
-//line sql.y:846
+		var yyLOCAL SelectStatement
+//line sql.y:881
 		{
-			yyLOCAL = TableNames{yyDollar[1].tableName}
+			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 95:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:850
+		var yyLOCAL SelectStatement
+//line sql.y:885
 		{
-			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
-			*yySLICE = append(*yySLICE, yyDollar[3].tableName)
+			yyLOCAL = &Union{Left: yyDollar[1].selStmtUnion(), Distinct: yyDollar[2].booleanUnion(), Right: yyDollar[3].selStmtUnion()}
 		}
+		yyVAL.union = yyLOCAL
 	case 96:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL TableNames
-//line sql.y:856
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL SelectStatement
+//line sql.y:889
 		{
-			yyLOCAL = TableNames{yyDollar[1].tableName}
+			yyLOCAL = &Union{Left: yyDollar[1].selStmtUnion(), Distinct: yyDollar[2].booleanUnion(), Right: yyDollar[3].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 97:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:860
+		var yyLOCAL SelectStatement
+//line sql.y:893
 		{
-			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
-			*yySLICE = append(*yySLICE, yyDollar[3].tableName)
+			yyLOCAL = &Union{Left: yyDollar[1].selStmtUnion(), Distinct: yyDollar[2].booleanUnion(), Right: yyDollar[3].selStmtUnion()}
 		}
+		yyVAL.union = yyLOCAL
 	case 98:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		var yyLOCAL Partitions
-//line sql.y:865
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL SelectStatement
+//line sql.y:897
 		{
-			yyLOCAL = nil
+			yyLOCAL = &Union{Left: yyDollar[1].selStmtUnion(), Distinct: yyDollar[2].booleanUnion(), Right: yyDollar[3].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 99:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		var yyLOCAL Partitions
-//line sql.y:869
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL SelectStatement
+//line sql.y:903
 		{
-			yyLOCAL = yyDollar[3].partitionsUnion()
+			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 100:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL Statement
-//line sql.y:875
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL SelectStatement
+//line sql.y:907
 		{
-			yyLOCAL = &Set{Comments: Comments(yyDollar[2].strs), Exprs: yyDollar[3].setExprsUnion()}
+			setLockInSelect(yyDollar[1].selStmtUnion(), yyDollar[2].lockUnion())
+			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 101:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		var yyLOCAL Statement
-//line sql.y:881
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL SelectStatement
+//line sql.y:912
 		{
-			yyLOCAL = &SetTransaction{Comments: Comments(yyDollar[2].strs), Scope: yyDollar[3].scopeUnion(), Characteristics: yyDollar[5].characteristicsUnion()}
+			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 102:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		var yyLOCAL Statement
-//line sql.y:885
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL SelectStatement
+//line sql.y:916
 		{
-			yyLOCAL = &SetTransaction{Comments: Comments(yyDollar[2].strs), Characteristics: yyDollar[4].characteristicsUnion(), Scope: ImplicitScope}
+			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 103:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL []Characteristic
-//line sql.y:891
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL SelectStatement
+//line sql.y:922
 		{
-			yyLOCAL = []Characteristic{yyDollar[1].characteristicUnion()}
+			yyLOCAL = yyDollar[2].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 104:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:895
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL SelectStatement
+//line sql.y:926
 		{
-			yySLICE := (*[]Characteristic)(yyIaddr(yyVAL.union))
-			*yySLICE = append(*yySLICE, yyDollar[3].characteristicUnion())
+			yyDollar[1].selStmtUnion().SetInto(yyDollar[2].selectIntoUnion())
+			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
+		yyVAL.union = yyLOCAL
 	case 105:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL Characteristic
-//line sql.y:901
+		var yyLOCAL SelectStatement
+//line sql.y:931
 		{
-			yyLOCAL = yyDollar[3].isolationLevelUnion()
+			yyDollar[1].selStmtUnion().SetInto(yyDollar[2].selectIntoUnion())
+			yyDollar[1].selStmtUnion().SetLock(yyDollar[3].lockUnion())
+			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 106:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Characteristic
-//line sql.y:905
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL SelectStatement
+//line sql.y:937
 		{
-			yyLOCAL = ReadWrite
+			yyDollar[1].selStmtUnion().SetInto(yyDollar[3].selectIntoUnion())
+			yyDollar[1].selStmtUnion().SetLock(yyDollar[2].lockUnion())
+			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 107:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Characteristic
-//line sql.y:909
+		var yyLOCAL SelectStatement
+//line sql.y:943
 		{
-			yyLOCAL = ReadOnly
+			yyDollar[1].selStmtUnion().SetInto(yyDollar[2].selectIntoUnion())
+			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 108:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL IsolationLevel
-//line sql.y:915
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:950
 		{
-			yyLOCAL = RepeatableRead
+			yyLOCAL = &Stream{Comments: Comments(yyDollar[2].strs), SelectExpr: yyDollar[3].selectExprUnion(), Table: yyDollar[5].tableName}
 		}
 		yyVAL.union = yyLOCAL
 	case 109:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL IsolationLevel
-//line sql.y:919
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:956
 		{
-			yyLOCAL = ReadCommitted
+			yyLOCAL = &VStream{Comments: Comments(yyDollar[2].strs), SelectExpr: yyDollar[3].selectExprUnion(), Table: yyDollar[5].tableName, Where: NewWhere(WhereClause, yyDollar[6].exprUnion()), Limit: yyDollar[7].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 110:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL IsolationLevel
-//line sql.y:923
+		yyDollar = yyS[yypt-10 : yypt+1]
+		var yyLOCAL SelectStatement
+//line sql.y:964
 		{
-			yyLOCAL = ReadUncommitted
+			sel := NewSelect(Comments(yyDollar[2].strs), yyDollar[4].selectExprsUnion() /*SelectExprs*/, yyDollar[3].strs /*options*/, yyDollar[5].selectIntoUnion() /*into*/, yyDollar[6].tableExprsUnion() /*from*/, NewWhere(WhereClause, yyDollar[7].exprUnion()), GroupBy(yyDollar[8].exprsUnion()), NewWhere(HavingClause, yyDollar[9].exprUnion()))
+			sel.Windows = yyDollar[10].windowDefinitionsUnion()
+			yyLOCAL = sel
 		}
 		yyVAL.union = yyLOCAL
 	case 111:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL IsolationLevel
-//line sql.y:927
+		yyDollar = yyS[yypt-9 : yypt+1]
+		var yyLOCAL SelectStatement
+//line sql.y:970
 		{
-			yyLOCAL = Serializable
+			sel := NewSelect(Comments(yyDollar[2].strs), yyDollar[4].selectExprsUnion() /*SelectExprs*/, yyDollar[3].strs /*options*/, nil, yyDollar[5].tableExprsUnion() /*from*/, NewWhere(WhereClause, yyDollar[6].exprUnion()), GroupBy(yyDollar[7].exprsUnion()), NewWhere(HavingClause, yyDollar[8].exprUnion()))
+			sel.Windows = yyDollar[9].windowDefinitionsUnion()
+			yyLOCAL = sel
 		}
 		yyVAL.union = yyLOCAL
 	case 112:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL Scope
-//line sql.y:933
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:980
 		{
-			yyLOCAL = SessionScope
-		}
-		yyVAL.union = yyLOCAL
-	case 113:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL Scope
-//line sql.y:937
+			// insert_data returns a *Insert pre-filled with Columns & Values
+			ins := yyDollar[6].insUnion()
+			ins.Action = yyDollar[1].insertActionUnion()
+			ins.Comments = yyDollar[2].strs
+			ins.Ignore = yyDollar[3].ignoreUnion()
+			ins.Table = yyDollar[4].tableName
+			ins.Partitions = yyDollar[5].partitionsUnion()
+			ins.OnDup = OnDup(yyDollar[7].updateExprsUnion())
+			yyLOCAL = ins
+		}
+		yyVAL.union = yyLOCAL
+	case 113:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:992
 		{
-			yyLOCAL = GlobalScope
+			cols := make(Columns, 0, len(yyDollar[7].updateExprsUnion()))
+			vals := make(ValTuple, 0, len(yyDollar[8].updateExprsUnion()))
+			for _, updateList := range yyDollar[7].updateExprsUnion() {
+				cols = append(cols, updateList.Name.Name)
+				vals = append(vals, updateList.Expr)
+			}
+			yyLOCAL = &Insert{Action: yyDollar[1].insertActionUnion(), Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitionsUnion(), Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprsUnion())}
 		}
 		yyVAL.union = yyLOCAL
 	case 114:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Statement
-//line sql.y:943
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL InsertAction
+//line sql.y:1004
 		{
-			yyDollar[1].createTableUnion().TableSpec = yyDollar[2].tableSpecUnion()
-			yyDollar[1].createTableUnion().FullyParsed = true
-			yyLOCAL = yyDollar[1].createTableUnion()
+			yyLOCAL = InsertAct
 		}
 		yyVAL.union = yyLOCAL
 	case 115:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Statement
-//line sql.y:949
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL InsertAction
+//line sql.y:1008
 		{
-			// Create table [name] like [name]
-			yyDollar[1].createTableUnion().OptLike = yyDollar[2].optLikeUnion()
-			yyDollar[1].createTableUnion().FullyParsed = true
-			yyLOCAL = yyDollar[1].createTableUnion()
+			yyLOCAL = ReplaceAct
 		}
 		yyVAL.union = yyLOCAL
 	case 116:
-		yyDollar = yyS[yypt-6 : yypt+1]
+		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:956
+//line sql.y:1014
 		{
-			indexDef := yyDollar[1].alterTableUnion().AlterOptions[0].(*AddIndexDefinition).IndexDefinition
-			indexDef.Columns = yyDollar[3].indexColumnsUnion()
-			indexDef.Options = append(indexDef.Options, yyDollar[5].indexOptionsUnion()...)
-			yyDollar[1].alterTableUnion().AlterOptions = append(yyDollar[1].alterTableUnion().AlterOptions, yyDollar[6].alterOptionsUnion()...)
-			yyDollar[1].alterTableUnion().FullyParsed = true
-			yyLOCAL = yyDollar[1].alterTableUnion()
+			yyLOCAL = &Update{With: yyDollar[1].withUnion(), Comments: Comments(yyDollar[3].strs), Ignore: yyDollar[4].ignoreUnion(), TableExprs: yyDollar[5].tableExprsUnion(), Exprs: yyDollar[7].updateExprsUnion(), Where: NewWhere(WhereClause, yyDollar[8].exprUnion()), OrderBy: yyDollar[9].orderByUnion(), Limit: yyDollar[10].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 117:
-		yyDollar = yyS[yypt-12 : yypt+1]
+		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:965
+//line sql.y:1020
 		{
-			yyLOCAL = &CreateView{ViewName: yyDollar[8].tableName.ToViewName(), Comments: Comments(yyDollar[2].strs), IsReplace: yyDollar[3].booleanUnion(), Algorithm: yyDollar[4].str, Definer: yyDollar[5].definerUnion(), Security: yyDollar[6].str, Columns: yyDollar[9].columnsUnion(), Select: yyDollar[11].selStmtUnion(), CheckOption: yyDollar[12].str}
+			yyLOCAL = &Delete{With: yyDollar[1].withUnion(), Comments: Comments(yyDollar[3].strs), Ignore: yyDollar[4].ignoreUnion(), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[6].tableName, As: yyDollar[7].tableIdent}}, Partitions: yyDollar[8].partitionsUnion(), Where: NewWhere(WhereClause, yyDollar[9].exprUnion()), OrderBy: yyDollar[10].orderByUnion(), Limit: yyDollar[11].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 118:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:969
+//line sql.y:1024
 		{
-			yyDollar[1].createDatabaseUnion().FullyParsed = true
-			yyDollar[1].createDatabaseUnion().CreateOptions = yyDollar[2].collateAndCharsetsUnion()
-			yyLOCAL = yyDollar[1].createDatabaseUnion()
+			yyLOCAL = &Delete{With: yyDollar[1].withUnion(), Comments: Comments(yyDollar[3].strs), Ignore: yyDollar[4].ignoreUnion(), Targets: yyDollar[6].tableNamesUnion(), TableExprs: yyDollar[8].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[9].exprUnion())}
 		}
 		yyVAL.union = yyLOCAL
 	case 119:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		var yyLOCAL bool
-//line sql.y:976
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:1028
 		{
-			yyLOCAL = false
+			yyLOCAL = &Delete{With: yyDollar[1].withUnion(), Comments: Comments(yyDollar[3].strs), Ignore: yyDollar[4].ignoreUnion(), Targets: yyDollar[5].tableNamesUnion(), TableExprs: yyDollar[7].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[8].exprUnion())}
 		}
 		yyVAL.union = yyLOCAL
 	case 120:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL bool
-//line sql.y:980
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:1032
 		{
-			yyLOCAL = true
+			yyLOCAL = &Delete{With: yyDollar[1].withUnion(), Comments: Comments(yyDollar[3].strs), Ignore: yyDollar[4].ignoreUnion(), Targets: yyDollar[5].tableNamesUnion(), TableExprs: yyDollar[7].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[8].exprUnion())}
 		}
 		yyVAL.union = yyLOCAL
 	case 121:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:985
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1037
 		{
-			yyVAL.colIdent = NewColIdent("")
 		}
 	case 122:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:989
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1038
 		{
-			yyVAL.colIdent = yyDollar[2].colIdent
 		}
 	case 123:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:995
+		var yyLOCAL TableNames
+//line sql.y:1042
 		{
-			yyVAL.colIdent = yyDollar[1].colIdent
+			yyLOCAL = TableNames{yyDollar[1].tableName.ToViewName()}
 		}
+		yyVAL.union = yyLOCAL
 	case 124:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		var yyLOCAL []VindexParam
-//line sql.y:1000
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1046
 		{
-			var v []VindexParam
-			yyLOCAL = v
+			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
+			*yySLICE = append(*yySLICE, yyDollar[3].tableName.ToViewName())
 		}
-		yyVAL.union = yyLOCAL
 	case 125:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL []VindexParam
-//line sql.y:1005
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL TableNames
+//line sql.y:1052
 		{
-			yyLOCAL = yyDollar[2].vindexParamsUnion()
+			yyLOCAL = TableNames{yyDollar[1].tableName}
 		}
 		yyVAL.union = yyLOCAL
 	case 126:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL []VindexParam
-//line sql.y:1011
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1056
 		{
-			yyLOCAL = make([]VindexParam, 0, 4)
-			yyLOCAL = append(yyLOCAL, yyDollar[1].vindexParam)
+			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
+			*yySLICE = append(*yySLICE, yyDollar[3].tableName)
 		}
-		yyVAL.union = yyLOCAL
 	case 127:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1016
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL TableNames
+//line sql.y:1062
 		{
-			yySLICE := (*[]VindexParam)(yyIaddr(yyVAL.union))
-			*yySLICE = append(*yySLICE, yyDollar[3].vindexParam)
+			yyLOCAL = TableNames{yyDollar[1].tableName}
 		}
+		yyVAL.union = yyLOCAL
 	case 128:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1022
+//line sql.y:1066
 		{
-			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
+			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
+			*yySLICE = append(*yySLICE, yyDollar[3].tableName)
 		}
 	case 129:
-		yyDollar = yyS[yypt-6 : yypt+1]
-		var yyLOCAL *CreateTable
-//line sql.y:1028
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL Partitions
+//line sql.y:1071
 		{
-			yyLOCAL = &CreateTable{Comments: Comments(yyDollar[2].strs), Table: yyDollar[6].tableName, IfNotExists: yyDollar[5].booleanUnion(), Temp: yyDollar[3].booleanUnion()}
-			setDDL(yylex, yyLOCAL)
+			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
 	case 130:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		var yyLOCAL *AlterTable
-//line sql.y:1035
+		var yyLOCAL Partitions
+//line sql.y:1075
 		{
-			yyLOCAL = &AlterTable{Comments: Comments(yyDollar[2].strs), Table: yyDollar[4].tableName}
-			setDDL(yylex, yyLOCAL)
+			yyLOCAL = yyDollar[3].partitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 131:
-		yyDollar = yyS[yypt-7 : yypt+1]
-		var yyLOCAL *AlterTable
-//line sql.y:1042
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:1081
 		{
-			yyLOCAL = &AlterTable{Table: yyDollar[7].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[4].colIdent, Type: string(yyDollar[3].str)}, Options: yyDollar[5].indexOptionsUnion()}}}}
-			setDDL(yylex, yyLOCAL)
+			yyLOCAL = &Set{Comments: Comments(yyDollar[2].strs), Exprs: yyDollar[3].setExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 132:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		var yyLOCAL *AlterTable
-//line sql.y:1047
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:1087
 		{
-			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Fulltext: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
-			setDDL(yylex, yyLOCAL)
+			yyLOCAL = &SetTransaction{Comments: Comments(yyDollar[2].strs), Scope: yyDollar[3].scopeUnion(), Characteristics: yyDollar[5].characteristicsUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 133:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		var yyLOCAL *AlterTable
-//line sql.y:1052
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:1091
 		{
-			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Spatial: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
-			setDDL(yylex, yyLOCAL)
+			yyLOCAL = &SetTransaction{Comments: Comments(yyDollar[2].strs), Characteristics: yyDollar[4].characteristicsUnion(), Scope: ImplicitScope}
 		}
 		yyVAL.union = yyLOCAL
 	case 134:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		var yyLOCAL *AlterTable
-//line sql.y:1057
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []Characteristic
+//line sql.y:1097
 		{
-			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Unique: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
-			setDDL(yylex, yyLOCAL)
+			yyLOCAL = []Characteristic{yyDollar[1].characteristicUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 135:
-		yyDollar = yyS[yypt-6 : yypt+1]
-		var yyLOCAL *CreateDatabase
-//line sql.y:1064
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1101
 		{
-			yyLOCAL = &CreateDatabase{Comments: Comments(yyDollar[4].strs), DBName: yyDollar[6].tableIdent, IfNotExists: yyDollar[5].booleanUnion()}
-			setDDL(yylex, yyLOCAL)
+			yySLICE := (*[]Characteristic)(yyIaddr(yyVAL.union))
+			*yySLICE = append(*yySLICE, yyDollar[3].characteristicUnion())
 		}
-		yyVAL.union = yyLOCAL
 	case 136:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL *AlterDatabase
-//line sql.y:1071
+		var yyLOCAL Characteristic
+//line sql.y:1107
 		{
-			yyLOCAL = &AlterDatabase{}
-			setDDL(yylex, yyLOCAL)
+			yyLOCAL = yyDollar[3].isolationLevelUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 137:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Characteristic
+//line sql.y:1111
+		{
+			yyLOCAL = ReadWrite
+		}
+		yyVAL.union = yyLOCAL
+	case 138:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Characteristic
+//line sql.y:1115
+		{
+			yyLOCAL = ReadOnly
 		}
 		yyVAL.union = yyLOCAL
 	case 139:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		var yyLOCAL *TableSpec
-//line sql.y:1082
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL IsolationLevel
+//line sql.y:1121
 		{
-			yyLOCAL = yyDollar[2].tableSpecUnion()
-			yyLOCAL.Options = yyDollar[4].tableOptionsUnion()
-			yyLOCAL.PartitionOption = yyDollar[5].partitionOptionUnion()
+			yyLOCAL = RepeatableRead
 		}
 		yyVAL.union = yyLOCAL
 	case 140:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		var yyLOCAL []CollateAndCharset
-//line sql.y:1089
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL IsolationLevel
+//line sql.y:1125
 		{
-			yyLOCAL = nil
+			yyLOCAL = ReadCommitted
 		}
 		yyVAL.union = yyLOCAL
 	case 141:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL []CollateAndCharset
-//line sql.y:1093
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL IsolationLevel
+//line sql.y:1129
 		{
-			yyLOCAL = yyDollar[1].collateAndCharsetsUnion()
+			yyLOCAL = ReadUncommitted
 		}
 		yyVAL.union = yyLOCAL
 	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL []CollateAndCharset
-//line sql.y:1099
+		var yyLOCAL IsolationLevel
+//line sql.y:1133
 		{
-			yyLOCAL = []CollateAndCharset{yyDollar[1].collateAndCharset}
+			yyLOCAL = Serializable
 		}
 		yyVAL.union = yyLOCAL
 	case 143:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL []CollateAndCharset
-//line sql.y:1103
+		var yyLOCAL Scope
+//line sql.y:1139
 		{
-			yyLOCAL = []CollateAndCharset{yyDollar[1].collateAndCharset}
+			yyLOCAL = SessionScope
 		}
 		yyVAL.union = yyLOCAL
 	case 144:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1107
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL Scope
+//line sql.y:1143
 		{
-			yySLICE := (*[]CollateAndCharset)(yyIaddr(yyVAL.union))
-			*yySLICE = append(*yySLICE, yyDollar[2].collateAndCharset)
+			yyLOCAL = GlobalScope
 		}
+		yyVAL.union = yyLOCAL
 	case 145:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1111
+		var yyLOCAL Statement
+//line sql.y:1149
 		{
-			yySLICE := (*[]CollateAndCharset)(yyIaddr(yyVAL.union))
-			*yySLICE = append(*yySLICE, yyDollar[2].collateAndCharset)
+			yyDollar[1].createTableUnion().TableSpec = yyDollar[2].tableSpecUnion()
+			yyDollar[1].createTableUnion().FullyParsed = true
+			yyLOCAL = yyDollar[1].createTableUnion()
 		}
+		yyVAL.union = yyLOCAL
 	case 146:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		var yyLOCAL bool
-//line sql.y:1117
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:1155
 		{
-			yyLOCAL = false
+			// Create table [name] like [name]
+			yyDollar[1].createTableUnion().OptLike = yyDollar[2].optLikeUnion()
+			yyDollar[1].createTableUnion().FullyParsed = true
+			yyLOCAL = yyDollar[1].createTableUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 147:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL bool
-//line sql.y:1121
-		{
-			yyLOCAL = true
-		}
-		yyVAL.union = yyLOCAL
-	case 148:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1127
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:1162
 		{
-			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].booleanUnion()}
+			indexDef := yyDollar[1].alterTableUnion().AlterOptions[0].(*AddIndexDefinition).IndexDefinition
+			indexDef.Columns = yyDollar[3].indexColumnsUnion()
+			indexDef.Options = append(indexDef.Options, yyDollar[5].indexOptionsUnion()...)
+			yyDollar[1].alterTableUnion().AlterOptions = append(yyDollar[1].alterTableUnion().AlterOptions, yyDollar[6].alterOptionsUnion()...)
+			yyDollar[1].alterTableUnion().FullyParsed = true
+			yyLOCAL = yyDollar[1].alterTableUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 148:
+		yyDollar = yyS[yypt-12 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:1171
+		{
+			yyLOCAL = &CreateView{ViewName: yyDollar[8].tableName.ToViewName(), Comments: Comments(yyDollar[2].strs), IsReplace: yyDollar[3].booleanUnion(), Algorithm: yyDollar[4].str, Definer: yyDollar[5].definerUnion(), Security: yyDollar[6].str, Columns: yyDollar[9].columnsUnion(), Select: yyDollar[11].selStmtUnion(), CheckOption: yyDollar[12].str}
 		}
+		yyVAL.union = yyLOCAL
 	case 149:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1131
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:1175
 		{
-			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: (encodeSQLString(yyDollar[4].str)), IsDefault: yyDollar[1].booleanUnion()}
+			yyDollar[1].createDatabaseUnion().FullyParsed = true
+			yyDollar[1].createDatabaseUnion().CreateOptions = yyDollar[2].collateAndCharsetsUnion()
+			yyLOCAL = yyDollar[1].createDatabaseUnion()
 		}
+		yyVAL.union = yyLOCAL
 	case 150:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1137
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line sql.y:1182
 		{
-			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].booleanUnion()}
+			yyLOCAL = false
 		}
+		yyVAL.union = yyLOCAL
 	case 151:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1141
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL bool
+//line sql.y:1186
 		{
-			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: (encodeSQLString(yyDollar[4].str)), IsDefault: yyDollar[1].booleanUnion()}
+			yyLOCAL = true
 		}
+		yyVAL.union = yyLOCAL
 	case 152:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL *OptLike
-//line sql.y:1148
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1191
 		{
-			yyLOCAL = &OptLike{LikeTable: yyDollar[2].tableName}
+			yyVAL.colIdent = NewColIdent("")
 		}
-		yyVAL.union = yyLOCAL
 	case 153:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		var yyLOCAL *OptLike
-//line sql.y:1152
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1195
 		{
-			yyLOCAL = &OptLike{LikeTable: yyDollar[3].tableName}
+			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-		yyVAL.union = yyLOCAL
 	case 154:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL []*ColumnDefinition
-//line sql.y:1158
+//line sql.y:1201
 		{
-			yyLOCAL = []*ColumnDefinition{yyDollar[1].columnDefinitionUnion()}
+			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-		yyVAL.union = yyLOCAL
 	case 155:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1162
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []VindexParam
+//line sql.y:1206
 		{
-			yySLICE := (*[]*ColumnDefinition)(yyIaddr(yyVAL.union))
-			*yySLICE = append(*yySLICE, yyDollar[3].columnDefinitionUnion())
+			var v []VindexParam
+			yyLOCAL = v
 		}
+		yyVAL.union = yyLOCAL
 	case 156:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL *TableSpec
-//line sql.y:1168
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []VindexParam
+//line sql.y:1211
 		{
-			yyLOCAL = &TableSpec{}
-			yyLOCAL.AddColumn(yyDollar[1].columnDefinitionUnion())
+			yyLOCAL = yyDollar[2].vindexParamsUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 157:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL *TableSpec
-//line sql.y:1173
+		var yyLOCAL []VindexParam
+//line sql.y:1217
 		{
-			yyLOCAL = &TableSpec{}
-			yyLOCAL.AddConstraint(yyDollar[1].constraintDefinitionUnion())
+			yyLOCAL = make([]VindexParam, 0, 4)
+			yyLOCAL = append(yyLOCAL, yyDollar[1].vindexParam)
 		}
 		yyVAL.union = yyLOCAL
 	case 158:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1178
+//line sql.y:1222
 		{
-			yyVAL.tableSpecUnion().AddColumn(yyDollar[3].columnDefinitionUnion())
+			yySLICE := (*[]VindexParam)(yyIaddr(yyVAL.union))
+			*yySLICE = append(*yySLICE, yyDollar[3].vindexParam)
 		}
 	case 159:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1182
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1228
 		{
-			yyVAL.tableSpecUnion().AddColumn(yyDollar[3].columnDefinitionUnion())
-			yyVAL.tableSpecUnion().AddConstraint(yyDollar[4].constraintDefinitionUnion())
+			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
 	case 160:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1187
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *CreateTable
+//line sql.y:1234
 		{
-			yyVAL.tableSpecUnion().AddIndex(yyDollar[3].indexDefinitionUnion())
+			yyLOCAL = &CreateTable{Comments: Comments(yyDollar[2].strs), Table: yyDollar[6].tableName, IfNotExists: yyDollar[5].booleanUnion(), Temp: yyDollar[3].booleanUnion()}
+			setDDL(yylex, yyLOCAL)
 		}
+		yyVAL.union = yyLOCAL
 	case 161:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1191
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *AlterTable
+//line sql.y:1241
 		{
-			yyVAL.tableSpecUnion().AddConstraint(yyDollar[3].constraintDefinitionUnion())
+			yyLOCAL = &AlterTable{Comments: Comments(yyDollar[2].strs), Table: yyDollar[4].tableName}
+			setDDL(yylex, yyLOCAL)
 		}
+		yyVAL.union = yyLOCAL
 	case 162:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1195
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL *AlterTable
+//line sql.y:1248
 		{
-			yyVAL.tableSpecUnion().AddConstraint(yyDollar[3].constraintDefinitionUnion())
+			yyLOCAL = &AlterTable{Table: yyDollar[7].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[4].colIdent, Type: string(yyDollar[3].str)}, Options: yyDollar[5].indexOptionsUnion()}}}}
+			setDDL(yylex, yyLOCAL)
 		}
+		yyVAL.union = yyLOCAL
 	case 163:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		var yyLOCAL *ColumnDefinition
-//line sql.y:1206
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL *AlterTable
+//line sql.y:1253
 		{
-			yyDollar[2].columnType.Options = yyDollar[4].columnTypeOptionsUnion()
-			if yyDollar[2].columnType.Options.Collate == "" {
-				yyDollar[2].columnType.Options.Collate = yyDollar[3].str
-			}
-			yyDollar[2].columnType.Options.Reference = yyDollar[5].referenceDefinitionUnion()
-			yyLOCAL = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
+			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Fulltext: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
+			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
 	case 164:
-		yyDollar = yyS[yypt-10 : yypt+1]
-		var yyLOCAL *ColumnDefinition
-//line sql.y:1215
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL *AlterTable
+//line sql.y:1258
 		{
-			yyDollar[2].columnType.Options = yyDollar[9].columnTypeOptionsUnion()
-			yyDollar[2].columnType.Options.As = yyDollar[7].exprUnion()
-			yyDollar[2].columnType.Options.Reference = yyDollar[10].referenceDefinitionUnion()
-			yyDollar[2].columnType.Options.Collate = yyDollar[3].str
-			yyLOCAL = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
+			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Spatial: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
+			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
 	case 165:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1224
-		{
-			yyVAL.str = ""
-		}
-	case 166:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1228
-		{
-			yyVAL.str = ""
-		}
-	case 167:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1237
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL *AlterTable
+//line sql.y:1263
 		{
-			yyLOCAL = &ColumnTypeOptions{Null: nil, Default: nil, OnUpdate: nil, Autoincrement: false, KeyOpt: colKeyNone, Comment: nil, As: nil}
+			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Unique: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
+			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 168:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1241
+	case 166:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *CreateDatabase
+//line sql.y:1270
 		{
-			val := true
-			yyDollar[1].columnTypeOptionsUnion().Null = &val
-			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
+			yyLOCAL = &CreateDatabase{Comments: Comments(yyDollar[4].strs), DBName: yyDollar[6].tableIdent, IfNotExists: yyDollar[5].booleanUnion()}
+			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 169:
+	case 167:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1247
+		var yyLOCAL *AlterDatabase
+//line sql.y:1277
 		{
-			val := false
-			yyDollar[1].columnTypeOptionsUnion().Null = &val
-			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
+			yyLOCAL = &AlterDatabase{}
+			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
 	case 170:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1253
+		var yyLOCAL *TableSpec
+//line sql.y:1288
 		{
-			yyDollar[1].columnTypeOptionsUnion().Default = yyDollar[4].exprUnion()
-			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
+			yyLOCAL = yyDollar[2].tableSpecUnion()
+			yyLOCAL.Options = yyDollar[4].tableOptionsUnion()
+			yyLOCAL.PartitionOption = yyDollar[5].partitionOptionUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 171:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1258
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []CollateAndCharset
+//line sql.y:1295
 		{
-			yyDollar[1].columnTypeOptionsUnion().Default = yyDollar[3].exprUnion()
-			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
+			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
 	case 172:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1263
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []CollateAndCharset
+//line sql.y:1299
 		{
-			yyDollar[1].columnTypeOptionsUnion().OnUpdate = yyDollar[4].exprUnion()
-			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
+			yyLOCAL = yyDollar[1].collateAndCharsetsUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 173:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1268
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []CollateAndCharset
+//line sql.y:1305
 		{
-			yyDollar[1].columnTypeOptionsUnion().Autoincrement = true
-			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
+			yyLOCAL = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
 		yyVAL.union = yyLOCAL
 	case 174:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1273
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []CollateAndCharset
+//line sql.y:1309
 		{
-			yyDollar[1].columnTypeOptionsUnion().Comment = NewStrLiteral(yyDollar[3].str)
-			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
+			yyLOCAL = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
 		yyVAL.union = yyLOCAL
 	case 175:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1278
+//line sql.y:1313
 		{
-			yyDollar[1].columnTypeOptionsUnion().KeyOpt = yyDollar[2].colKeyOptUnion()
-			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
+			yySLICE := (*[]CollateAndCharset)(yyIaddr(yyVAL.union))
+			*yySLICE = append(*yySLICE, yyDollar[2].collateAndCharset)
 		}
-		yyVAL.union = yyLOCAL
 	case 176:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1283
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1317
 		{
-			yyDollar[1].columnTypeOptionsUnion().Collate = encodeSQLString(yyDollar[3].str)
+			yySLICE := (*[]CollateAndCharset)(yyIaddr(yyVAL.union))
+			*yySLICE = append(*yySLICE, yyDollar[2].collateAndCharset)
 		}
 	case 177:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1287
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line sql.y:1323
 		{
-			yyDollar[1].columnTypeOptionsUnion().Collate = string(yyDollar[3].colIdent.String())
-			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
+			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
 	case 178:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL ColumnStorage
-//line sql.y:1294
+		var yyLOCAL bool
+//line sql.y:1327
 		{
-			yyLOCAL = VirtualStorage
+			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
 	case 179:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL ColumnStorage
-//line sql.y:1298
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1333
 		{
-			yyLOCAL = StoredStorage
+			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].booleanUnion()}
 		}
-		yyVAL.union = yyLOCAL
 	case 180:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1303
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1337
 		{
-			yyLOCAL = &ColumnTypeOptions{}
+			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: (encodeSQLString(yyDollar[4].str)), IsDefault: yyDollar[1].booleanUnion()}
 		}
-		yyVAL.union = yyLOCAL
 	case 181:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1307
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1343
 		{
-			yyDollar[1].columnTypeOptionsUnion().Storage = yyDollar[2].columnStorageUnion()
-			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
+			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].booleanUnion()}
 		}
-		yyVAL.union = yyLOCAL
 	case 182:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1312
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1347
 		{
-			val := true
-			yyDollar[1].columnTypeOptionsUnion().Null = &val
-			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
+			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: (encodeSQLString(yyDollar[4].str)), IsDefault: yyDollar[1].booleanUnion()}
 		}
-		yyVAL.union = yyLOCAL
 	case 183:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1318
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *OptLike
+//line sql.y:1354
 		{
-			val := false
-			yyDollar[1].columnTypeOptionsUnion().Null = &val
-			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
+			yyLOCAL = &OptLike{LikeTable: yyDollar[2].tableName}
 		}
 		yyVAL.union = yyLOCAL
 	case 184:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1324
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *OptLike
+//line sql.y:1358
 		{
-			yyDollar[1].columnTypeOptionsUnion().Comment = NewStrLiteral(yyDollar[3].str)
-			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
+			yyLOCAL = &OptLike{LikeTable: yyDollar[3].tableName}
 		}
 		yyVAL.union = yyLOCAL
 	case 185:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1329
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*ColumnDefinition
+//line sql.y:1364
 		{
-			yyDollar[1].columnTypeOptionsUnion().KeyOpt = yyDollar[2].colKeyOptUnion()
-			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
+			yyLOCAL = []*ColumnDefinition{yyDollar[1].columnDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 186:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1368
+		{
+			yySLICE := (*[]*ColumnDefinition)(yyIaddr(yyVAL.union))
+			*yySLICE = append(*yySLICE, yyDollar[3].columnDefinitionUnion())
+		}
+	case 187:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:1336
+		var yyLOCAL *TableSpec
+//line sql.y:1374
 		{
-			yyLOCAL = yyDollar[1].exprUnion()
+			yyLOCAL = &TableSpec{}
+			yyLOCAL.AddColumn(yyDollar[1].columnDefinitionUnion())
 		}
 		yyVAL.union = yyLOCAL
 	case 188:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:1343
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *TableSpec
+//line sql.y:1379
 		{
-			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].literalUnion()}
+			yyLOCAL = &TableSpec{}
+			yyLOCAL.AddConstraint(yyDollar[1].constraintDefinitionUnion())
 		}
 		yyVAL.union = yyLOCAL
 	case 189:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:1347
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1384
 		{
-			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].literalUnion()}
+			yyVAL.tableSpecUnion().AddColumn(yyDollar[3].columnDefinitionUnion())
 		}
-		yyVAL.union = yyLOCAL
 	case 190:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:1351
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1388
 		{
-			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].literalUnion()}
+			yyVAL.tableSpecUnion().AddColumn(yyDollar[3].columnDefinitionUnion())
+			yyVAL.tableSpecUnion().AddConstraint(yyDollar[4].constraintDefinitionUnion())
 		}
-		yyVAL.union = yyLOCAL
 	case 191:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:1355
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1393
 		{
-			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].literalUnion()}
+			yyVAL.tableSpecUnion().AddIndex(yyDollar[3].indexDefinitionUnion())
+		}
+	case 192:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1397
+		{
+			yyVAL.tableSpecUnion().AddConstraint(yyDollar[3].constraintDefinitionUnion())
+		}
+	case 193:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1401
+		{
+			yyVAL.tableSpecUnion().AddConstraint(yyDollar[3].constraintDefinitionUnion())
 		}
-		yyVAL.union = yyLOCAL
 	case 194:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:1366
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *ColumnDefinition
+//line sql.y:1412
 		{
-			yyLOCAL = &NullVal{}
+			yyDollar[2].columnType.Options = yyDollar[4].columnTypeOptionsUnion()
+			if yyDollar[2].columnType.Options.Collate == "" {
+				yyDollar[2].columnType.Options.Collate = yyDollar[3].str
+			}
+			yyDollar[2].columnType.Options.Reference = yyDollar[5].referenceDefinitionUnion()
+			yyLOCAL = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
 		}
 		yyVAL.union = yyLOCAL
-	case 196:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:1373
+	case 195:
+		yyDollar = yyS[yypt-10 : yypt+1]
+		var yyLOCAL *ColumnDefinition
+//line sql.y:1421
 		{
-			yyLOCAL = yyDollar[2].exprUnion()
+			yyDollar[2].columnType.Options = yyDollar[9].columnTypeOptionsUnion()
+			yyDollar[2].columnType.Options.As = yyDollar[7].exprUnion()
+			yyDollar[2].columnType.Options.Reference = yyDollar[10].referenceDefinitionUnion()
+			yyDollar[2].columnType.Options.Collate = yyDollar[3].str
+			yyLOCAL = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
 		}
 		yyVAL.union = yyLOCAL
+	case 196:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1430
+		{
+			yyVAL.str = ""
+		}
 	case 197:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:1377
+//line sql.y:1434
 		{
-			yyLOCAL = &UnaryExpr{Operator: UMinusOp, Expr: yyDollar[2].exprUnion()}
+			yyVAL.str = ""
 		}
-		yyVAL.union = yyLOCAL
 	case 198:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:1383
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *ColumnTypeOptions
+//line sql.y:1443
 		{
-			yyLOCAL = yyDollar[1].exprUnion()
+			yyLOCAL = &ColumnTypeOptions{Null: nil, Default: nil, OnUpdate: nil, Autoincrement: false, KeyOpt: colKeyNone, Comment: nil, As: nil}
 		}
 		yyVAL.union = yyLOCAL
 	case 199:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:1387
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *ColumnTypeOptions
+//line sql.y:1447
 		{
-			yyLOCAL = yyDollar[1].exprUnion()
+			val := true
+			yyDollar[1].columnTypeOptionsUnion().Null = &val
+			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 200:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:1391
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *ColumnTypeOptions
+//line sql.y:1453
 		{
-			yyLOCAL = yyDollar[1].boolValUnion()
+			val := false
+			yyDollar[1].columnTypeOptionsUnion().Null = &val
+			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 201:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:1395
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *ColumnTypeOptions
+//line sql.y:1459
 		{
-			yyLOCAL = NewHexLiteral(yyDollar[1].str)
+			yyDollar[1].columnTypeOptionsUnion().Default = yyDollar[4].exprUnion()
+			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 202:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:1399
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *ColumnTypeOptions
+//line sql.y:1464
 		{
-			yyLOCAL = NewHexNumLiteral(yyDollar[1].str)
+			yyDollar[1].columnTypeOptionsUnion().Default = yyDollar[3].exprUnion()
+			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 203:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:1403
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *ColumnTypeOptions
+//line sql.y:1469
 		{
-			yyLOCAL = NewBitLiteral(yyDollar[1].str)
+			yyDollar[1].columnTypeOptionsUnion().OnUpdate = yyDollar[4].exprUnion()
+			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 204:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:1407
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *ColumnTypeOptions
+//line sql.y:1474
 		{
-			yyLOCAL = NewArgument(yyDollar[1].str[1:])
-			bindVariable(yylex, yyDollar[1].str[1:])
+			yyDollar[1].columnTypeOptionsUnion().Autoincrement = true
+			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 205:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:1412
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *ColumnTypeOptions
+//line sql.y:1479
 		{
-			yyLOCAL = &IntroducerExpr{CharacterSet: yyDollar[1].str, Expr: NewBitLiteral(yyDollar[2].str)}
+			yyDollar[1].columnTypeOptionsUnion().Comment = NewStrLiteral(yyDollar[3].str)
+			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 206:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:1416
+		var yyLOCAL *ColumnTypeOptions
+//line sql.y:1484
 		{
-			yyLOCAL = &IntroducerExpr{CharacterSet: yyDollar[1].str, Expr: NewHexNumLiteral(yyDollar[2].str)}
+			yyDollar[1].columnTypeOptionsUnion().KeyOpt = yyDollar[2].colKeyOptUnion()
+			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 207:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:1420
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1489
 		{
-			yyLOCAL = &IntroducerExpr{CharacterSet: yyDollar[1].str, Expr: NewHexLiteral(yyDollar[2].str)}
+			yyDollar[1].columnTypeOptionsUnion().Collate = encodeSQLString(yyDollar[3].str)
 		}
-		yyVAL.union = yyLOCAL
 	case 208:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:1424
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *ColumnTypeOptions
+//line sql.y:1493
 		{
-			yyLOCAL = &IntroducerExpr{CharacterSet: yyDollar[1].str, Expr: yyDollar[2].colNameUnion()}
+			yyDollar[1].columnTypeOptionsUnion().Collate = string(yyDollar[3].colIdent.String())
+			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 209:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:1428
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL ColumnStorage
+//line sql.y:1500
 		{
-			bindVariable(yylex, yyDollar[2].str[1:])
-			yyLOCAL = &IntroducerExpr{CharacterSet: yyDollar[1].str, Expr: NewArgument(yyDollar[2].str[1:])}
+			yyLOCAL = VirtualStorage
 		}
 		yyVAL.union = yyLOCAL
 	case 210:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1435
+		var yyLOCAL ColumnStorage
+//line sql.y:1504
 		{
-			yyVAL.str = Armscii8Str
+			yyLOCAL = StoredStorage
 		}
+		yyVAL.union = yyLOCAL
 	case 211:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1439
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *ColumnTypeOptions
+//line sql.y:1509
 		{
-			yyVAL.str = ASCIIStr
+			yyLOCAL = &ColumnTypeOptions{}
 		}
+		yyVAL.union = yyLOCAL
 	case 212:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1443
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *ColumnTypeOptions
+//line sql.y:1513
 		{
-			yyVAL.str = Big5Str
+			yyDollar[1].columnTypeOptionsUnion().Storage = yyDollar[2].columnStorageUnion()
+			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
+		yyVAL.union = yyLOCAL
 	case 213:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1447
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *ColumnTypeOptions
+//line sql.y:1518
 		{
-			yyVAL.str = UBinaryStr
+			val := true
+			yyDollar[1].columnTypeOptionsUnion().Null = &val
+			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
+		yyVAL.union = yyLOCAL
 	case 214:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1451
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *ColumnTypeOptions
+//line sql.y:1524
 		{
-			yyVAL.str = Cp1250Str
+			val := false
+			yyDollar[1].columnTypeOptionsUnion().Null = &val
+			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
+		yyVAL.union = yyLOCAL
 	case 215:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1455
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *ColumnTypeOptions
+//line sql.y:1530
 		{
-			yyVAL.str = Cp1251Str
+			yyDollar[1].columnTypeOptionsUnion().Comment = NewStrLiteral(yyDollar[3].str)
+			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
+		yyVAL.union = yyLOCAL
 	case 216:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1459
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *ColumnTypeOptions
+//line sql.y:1535
 		{
-			yyVAL.str = Cp1256Str
+			yyDollar[1].columnTypeOptionsUnion().KeyOpt = yyDollar[2].colKeyOptUnion()
+			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
+		yyVAL.union = yyLOCAL
 	case 217:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1463
-		{
-			yyVAL.str = Cp1257Str
-		}
-	case 218:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1467
+		var yyLOCAL Expr
+//line sql.y:1542
 		{
-			yyVAL.str = Cp850Str
+			yyLOCAL = yyDollar[1].exprUnion()
 		}
+		yyVAL.union = yyLOCAL
 	case 219:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1471
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:1549
 		{
-			yyVAL.str = Cp852Str
+			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].literalUnion()}
 		}
+		yyVAL.union = yyLOCAL
 	case 220:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1475
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:1553
 		{
-			yyVAL.str = Cp866Str
+			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].literalUnion()}
 		}
+		yyVAL.union = yyLOCAL
 	case 221:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1479
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:1557
 		{
-			yyVAL.str = Cp932Str
+			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].literalUnion()}
 		}
+		yyVAL.union = yyLOCAL
 	case 222:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1483
-		{
-			yyVAL.str = Dec8Str
-		}
-	case 223:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1487
-		{
-			yyVAL.str = EucjpmsStr
-		}
-	case 224:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1491
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:1561
 		{
-			yyVAL.str = EuckrStr
+			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].literalUnion()}
 		}
+		yyVAL.union = yyLOCAL
 	case 225:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1495
-		{
-			yyVAL.str = Gb18030Str
-		}
-	case 226:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1499
+		var yyLOCAL Expr
+//line sql.y:1572
 		{
-			yyVAL.str = Gb2312Str
+			yyLOCAL = &NullVal{}
 		}
+		yyVAL.union = yyLOCAL
 	case 227:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1503
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:1579
 		{
-			yyVAL.str = GbkStr
+			yyLOCAL = yyDollar[2].exprUnion()
 		}
+		yyVAL.union = yyLOCAL
 	case 228:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1507
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:1583
 		{
-			yyVAL.str = Geostd8Str
+			yyLOCAL = &UnaryExpr{Operator: UMinusOp, Expr: yyDollar[2].exprUnion()}
 		}
+		yyVAL.union = yyLOCAL
 	case 229:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1511
+		var yyLOCAL Expr
+//line sql.y:1589
 		{
-			yyVAL.str = GreekStr
+			yyLOCAL = yyDollar[1].exprUnion()
 		}
+		yyVAL.union = yyLOCAL
 	case 230:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1515
+		var yyLOCAL Expr
+//line sql.y:1593
 		{
-			yyVAL.str = HebrewStr
+			yyLOCAL = yyDollar[1].exprUnion()
 		}
+		yyVAL.union = yyLOCAL
 	case 231:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1519
+		var yyLOCAL Expr
+//line sql.y:1597
 		{
-			yyVAL.str = Hp8Str
+			yyLOCAL = yyDollar[1].boolValUnion()
 		}
+		yyVAL.union = yyLOCAL
 	case 232:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1523
+		var yyLOCAL Expr
+//line sql.y:1601
 		{
-			yyVAL.str = Keybcs2Str
+			yyLOCAL = NewHexLiteral(yyDollar[1].str)
 		}
+		yyVAL.union = yyLOCAL
 	case 233:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1527
+		var yyLOCAL Expr
+//line sql.y:1605
 		{
-			yyVAL.str = Koi8rStr
+			yyLOCAL = NewHexNumLiteral(yyDollar[1].str)
 		}
+		yyVAL.union = yyLOCAL
 	case 234:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1531
+		var yyLOCAL Expr
+//line sql.y:1609
 		{
-			yyVAL.str = Koi8uStr
+			yyLOCAL = NewBitLiteral(yyDollar[1].str)
 		}
+		yyVAL.union = yyLOCAL
 	case 235:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1535
+		var yyLOCAL Expr
+//line sql.y:1613
 		{
-			yyVAL.str = Latin1Str
+			yyLOCAL = NewArgument(yyDollar[1].str[1:])
+			bindVariable(yylex, yyDollar[1].str[1:])
 		}
+		yyVAL.union = yyLOCAL
 	case 236:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1539
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:1618
 		{
-			yyVAL.str = Latin2Str
+			yyLOCAL = &IntroducerExpr{CharacterSet: yyDollar[1].str, Expr: NewBitLiteral(yyDollar[2].str)}
 		}
+		yyVAL.union = yyLOCAL
 	case 237:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1543
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:1622
 		{
-			yyVAL.str = Latin5Str
+			yyLOCAL = &IntroducerExpr{CharacterSet: yyDollar[1].str, Expr: NewHexNumLiteral(yyDollar[2].str)}
 		}
+		yyVAL.union = yyLOCAL
 	case 238:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1547
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:1626
 		{
-			yyVAL.str = Latin7Str
+			yyLOCAL = &IntroducerExpr{CharacterSet: yyDollar[1].str, Expr: NewHexLiteral(yyDollar[2].str)}
 		}
+		yyVAL.union = yyLOCAL
 	case 239:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1551
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:1630
 		{
-			yyVAL.str = MacceStr
+			yyLOCAL = &IntroducerExpr{CharacterSet: yyDollar[1].str, Expr: yyDollar[2].colNameUnion()}
 		}
+		yyVAL.union = yyLOCAL
 	case 240:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1555
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:1634
 		{
-			yyVAL.str = MacromanStr
+			bindVariable(yylex, yyDollar[2].str[1:])
+			yyLOCAL = &IntroducerExpr{CharacterSet: yyDollar[1].str, Expr: NewArgument(yyDollar[2].str[1:])}
 		}
+		yyVAL.union = yyLOCAL
 	case 241:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1559
+//line sql.y:1641
 		{
-			yyVAL.str = SjisStr
+			yyVAL.str = Armscii8Str
 		}
 	case 242:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1563
+//line sql.y:1645
 		{
-			yyVAL.str = Swe7Str
+			yyVAL.str = ASCIIStr
 		}
 	case 243:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1567
+//line sql.y:1649
 		{
-			yyVAL.str = Tis620Str
+			yyVAL.str = Big5Str
 		}
 	case 244:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1571
+//line sql.y:1653
 		{
-			yyVAL.str = Ucs2Str
+			yyVAL.str = UBinaryStr
 		}
 	case 245:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1575
+//line sql.y:1657
 		{
-			yyVAL.str = UjisStr
+			yyVAL.str = Cp1250Str
 		}
 	case 246:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1579
+//line sql.y:1661
 		{
-			yyVAL.str = Utf16Str
+			yyVAL.str = Cp1251Str
 		}
 	case 247:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1583
+//line sql.y:1665
 		{
-			yyVAL.str = Utf16leStr
+			yyVAL.str = Cp1256Str
 		}
 	case 248:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1587
+//line sql.y:1669
 		{
-			yyVAL.str = Utf32Str
+			yyVAL.str = Cp1257Str
 		}
 	case 249:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1591
+//line sql.y:1673
 		{
-			yyVAL.str = Utf8Str
+			yyVAL.str = Cp850Str
 		}
 	case 250:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1595
+//line sql.y:1677
 		{
-			yyVAL.str = Utf8mb4Str
+			yyVAL.str = Cp852Str
+		}
+	case 251:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1681
+		{
+			yyVAL.str = Cp866Str
+		}
+	case 252:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1685
+		{
+			yyVAL.str = Cp932Str
 		}
 	case 253:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:1605
+//line sql.y:1689
 		{
-			yyLOCAL = NewIntLiteral(yyDollar[1].str)
+			yyVAL.str = Dec8Str
 		}
-		yyVAL.union = yyLOCAL
 	case 254:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:1609
+//line sql.y:1693
 		{
-			yyLOCAL = NewFloatLiteral(yyDollar[1].str)
+			yyVAL.str = EucjpmsStr
 		}
-		yyVAL.union = yyLOCAL
 	case 255:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:1613
+//line sql.y:1697
 		{
-			yyLOCAL = NewDecimalLiteral(yyDollar[1].str)
+			yyVAL.str = EuckrStr
 		}
-		yyVAL.union = yyLOCAL
 	case 256:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:1620
+//line sql.y:1701
 		{
-			yyLOCAL = NewStrLiteral(yyDollar[1].str)
+			yyVAL.str = Gb18030Str
 		}
-		yyVAL.union = yyLOCAL
 	case 257:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:1624
+//line sql.y:1705
 		{
-			yyLOCAL = &UnaryExpr{Operator: NStringOp, Expr: NewStrLiteral(yyDollar[1].str)}
+			yyVAL.str = Gb2312Str
 		}
-		yyVAL.union = yyLOCAL
 	case 258:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:1628
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1709
 		{
-			yyLOCAL = &IntroducerExpr{CharacterSet: yyDollar[1].str, Expr: NewStrLiteral(yyDollar[2].str)}
+			yyVAL.str = GbkStr
 		}
-		yyVAL.union = yyLOCAL
 	case 259:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL ColumnKeyOption
-//line sql.y:1634
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1713
 		{
-			yyLOCAL = colKeyPrimary
+			yyVAL.str = Geostd8Str
 		}
-		yyVAL.union = yyLOCAL
 	case 260:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL ColumnKeyOption
-//line sql.y:1638
+//line sql.y:1717
 		{
-			yyLOCAL = colKeyUnique
+			yyVAL.str = GreekStr
 		}
-		yyVAL.union = yyLOCAL
 	case 261:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL ColumnKeyOption
-//line sql.y:1642
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1721
 		{
-			yyLOCAL = colKeyUniqueKey
+			yyVAL.str = HebrewStr
 		}
-		yyVAL.union = yyLOCAL
 	case 262:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL ColumnKeyOption
-//line sql.y:1646
+//line sql.y:1725
 		{
-			yyLOCAL = colKey
+			yyVAL.str = Hp8Str
 		}
-		yyVAL.union = yyLOCAL
 	case 263:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1652
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1729
 		{
-			yyVAL.columnType = yyDollar[1].columnType
-			yyVAL.columnType.Unsigned = yyDollar[2].booleanUnion()
-			yyVAL.columnType.Zerofill = yyDollar[3].booleanUnion()
+			yyVAL.str = Keybcs2Str
+		}
+	case 264:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1733
+		{
+			yyVAL.str = Koi8rStr
+		}
+	case 265:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1737
+		{
+			yyVAL.str = Koi8uStr
+		}
+	case 266:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1741
+		{
+			yyVAL.str = Latin1Str
 		}
 	case 267:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1663
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1745
 		{
-			yyVAL.columnType = yyDollar[1].columnType
-			yyVAL.columnType.Length = yyDollar[2].literalUnion()
+			yyVAL.str = Latin2Str
 		}
 	case 268:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1668
+//line sql.y:1749
 		{
-			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.str = Latin5Str
 		}
 	case 269:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1674
+//line sql.y:1753
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+			yyVAL.str = Latin7Str
 		}
 	case 270:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1678
+//line sql.y:1757
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+			yyVAL.str = MacceStr
 		}
 	case 271:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1682
+//line sql.y:1761
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+			yyVAL.str = MacromanStr
 		}
 	case 272:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1686
+//line sql.y:1765
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+			yyVAL.str = SjisStr
 		}
 	case 273:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1690
+//line sql.y:1769
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+			yyVAL.str = Swe7Str
 		}
 	case 274:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1694
+//line sql.y:1773
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+			yyVAL.str = Tis620Str
 		}
 	case 275:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1698
+//line sql.y:1777
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+			yyVAL.str = Ucs2Str
 		}
 	case 276:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1702
+//line sql.y:1781
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+			yyVAL.str = UjisStr
 		}
 	case 277:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1706
+//line sql.y:1785
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+			yyVAL.str = Utf16Str
 		}
 	case 278:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1712
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1789
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
-			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
-			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+			yyVAL.str = Utf16leStr
 		}
 	case 279:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1718
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1793
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
-			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
-			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+			yyVAL.str = Utf32Str
 		}
 	case 280:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1724
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1797
+		{
+			yyVAL.str = Utf8Str
+		}
+	case 281:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1801
+		{
+			yyVAL.str = Utf8mb4Str
+		}
+	case 284:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:1811
+		{
+			yyLOCAL = NewIntLiteral(yyDollar[1].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 285:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:1815
+		{
+			yyLOCAL = NewFloatLiteral(yyDollar[1].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 286:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:1819
+		{
+			yyLOCAL = NewDecimalLiteral(yyDollar[1].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 287:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:1826
+		{
+			yyLOCAL = NewStrLiteral(yyDollar[1].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 288:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:1830
+		{
+			yyLOCAL = &UnaryExpr{Operator: NStringOp, Expr: NewStrLiteral(yyDollar[1].str)}
+		}
+		yyVAL.union = yyLOCAL
+	case 289:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:1834
+		{
+			yyLOCAL = &IntroducerExpr{CharacterSet: yyDollar[1].str, Expr: NewStrLiteral(yyDollar[2].str)}
+		}
+		yyVAL.union = yyLOCAL
+	case 290:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL ColumnKeyOption
+//line sql.y:1840
+		{
+			yyLOCAL = colKeyPrimary
+		}
+		yyVAL.union = yyLOCAL
+	case 291:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL ColumnKeyOption
+//line sql.y:1844
+		{
+			yyLOCAL = colKeyUnique
+		}
+		yyVAL.union = yyLOCAL
+	case 292:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL ColumnKeyOption
+//line sql.y:1848
+		{
+			yyLOCAL = colKeyUniqueKey
+		}
+		yyVAL.union = yyLOCAL
+	case 293:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL ColumnKeyOption
+//line sql.y:1852
+		{
+			yyLOCAL = colKey
+		}
+		yyVAL.union = yyLOCAL
+	case 294:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1858
+		{
+			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.columnType.Unsigned = yyDollar[2].booleanUnion()
+			yyVAL.columnType.Zerofill = yyDollar[3].booleanUnion()
+		}
+	case 298:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1869
+		{
+			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.columnType.Length = yyDollar[2].literalUnion()
+		}
+	case 299:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1874
+		{
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 300:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1880
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+		}
+	case 301:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1884
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+		}
+	case 302:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1888
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+		}
+	case 303:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1892
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+		}
+	case 304:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1896
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+		}
+	case 305:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1900
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+		}
+	case 306:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1904
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+		}
+	case 307:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1908
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+		}
+	case 308:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1912
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+		}
+	case 309:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1918
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 281:
+	case 310:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1924
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 311:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1930
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 312:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1730
+//line sql.y:1936
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 282:
+	case 313:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1736
+//line sql.y:1942
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 283:
+	case 314:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1744
+//line sql.y:1950
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 284:
+	case 315:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1748
+//line sql.y:1954
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
-	case 285:
+	case 316:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1752
+//line sql.y:1958
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
-	case 286:
+	case 317:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1756
+//line sql.y:1962
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
-	case 287:
+	case 318:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1760
+//line sql.y:1966
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
-	case 288:
+	case 319:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1766
+//line sql.y:1972
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str}
 		}
-	case 289:
+	case 320:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1770
+//line sql.y:1976
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str}
 		}
-	case 290:
+	case 321:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1774
+//line sql.y:1980
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
-	case 291:
+	case 322:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1778
+//line sql.y:1984
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
-	case 292:
+	case 323:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1782
+//line sql.y:1988
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str}
 		}
-	case 293:
+	case 324:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1786
+//line sql.y:1992
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str}
 		}
-	case 294:
+	case 325:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1790
+//line sql.y:1996
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str}
 		}
-	case 295:
+	case 326:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1794
+//line sql.y:2000
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str}
 		}
-	case 296:
+	case 327:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1798
+//line sql.y:2004
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 297:
+	case 328:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1802
+//line sql.y:2008
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 298:
+	case 329:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1806
+//line sql.y:2012
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 299:
+	case 330:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1810
+//line sql.y:2016
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 300:
+	case 331:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1814
+//line sql.y:2020
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 301:
+	case 332:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1818
+//line sql.y:2024
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str}
 		}
-	case 302:
+	case 333:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1823
+//line sql.y:2029
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str}
 		}
-	case 303:
+	case 334:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1829
+//line sql.y:2035
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 304:
+	case 335:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1833
+//line sql.y:2039
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 305:
+	case 336:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1837
+//line sql.y:2043
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 306:
+	case 337:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1841
+//line sql.y:2047
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 307:
+	case 338:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1845
+//line sql.y:2051
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 308:
+	case 339:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1849
+//line sql.y:2055
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 309:
+	case 340:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1853
+//line sql.y:2059
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 310:
+	case 341:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1857
+//line sql.y:2063
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
-	case 311:
+	case 342:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1863
+//line sql.y:2069
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, encodeSQLString(yyDollar[1].str))
 		}
-	case 312:
+	case 343:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1868
+//line sql.y:2074
 		{
 			yyVAL.strs = append(yyDollar[1].strs, encodeSQLString(yyDollar[3].str))
 		}
-	case 313:
+	case 344:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:1873
+//line sql.y:2079
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 314:
+	case 345:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:1877
+//line sql.y:2083
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 315:
+	case 346:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1882
+//line sql.y:2088
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 316:
+	case 347:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1886
+//line sql.y:2092
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
 				Scale:  NewIntLiteral(yyDollar[4].str),
 			}
 		}
-	case 317:
+	case 348:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1894
+//line sql.y:2100
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 318:
+	case 349:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1898
+//line sql.y:2104
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
 			}
 		}
-	case 319:
+	case 350:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1904
+//line sql.y:2110
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
 				Scale:  NewIntLiteral(yyDollar[4].str),
 			}
 		}
-	case 320:
+	case 351:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1912
+//line sql.y:2118
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 321:
+	case 352:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1916
+//line sql.y:2122
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 322:
+	case 353:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1920
+//line sql.y:2126
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 323:
+	case 354:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1925
+//line sql.y:2131
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 324:
+	case 355:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1929
+//line sql.y:2135
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 325:
+	case 356:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1934
+//line sql.y:2140
 		{
 			yyVAL.str = ""
 		}
-	case 326:
+	case 357:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1938
+//line sql.y:2144
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 327:
+	case 358:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1942
+//line sql.y:2148
 		{
 			yyVAL.str = encodeSQLString(yyDollar[2].str)
 		}
-	case 328:
+	case 359:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1946
+//line sql.y:2152
 		{
 			yyVAL.str = string(yyDollar[2].str)
 		}
-	case 329:
+	case 360:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1950
+//line sql.y:2156
 		{
 			// ASCII: Shorthand for CHARACTER SET latin1.
 			yyVAL.str = "latin1"
 		}
-	case 330:
+	case 361:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1955
+//line sql.y:2161
 		{
 			// UNICODE: Shorthand for CHARACTER SET ucs2.
 			yyVAL.str = "ucs2"
 		}
-	case 331:
+	case 362:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1961
+//line sql.y:2167
 		{
 			yyVAL.str = ""
 		}
-	case 332:
+	case 363:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1965
+//line sql.y:2171
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 333:
+	case 364:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1969
+//line sql.y:2175
 		{
 			yyVAL.str = encodeSQLString(yyDollar[2].str)
 		}
-	case 334:
+	case 365:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexDefinition
-//line sql.y:1976
+//line sql.y:2182
 		{
 			yyLOCAL = &IndexDefinition{Info: yyDollar[1].indexInfoUnion(), Columns: yyDollar[3].indexColumnsUnion(), Options: yyDollar[5].indexOptionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 335:
+	case 366:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1981
+//line sql.y:2187
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 336:
+	case 367:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1985
+//line sql.y:2191
 		{
 			yyLOCAL = yyDollar[1].indexOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 337:
+	case 368:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1991
+//line sql.y:2197
 		{
 			yyLOCAL = []*IndexOption{yyDollar[1].indexOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 338:
+	case 369:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1995
+//line sql.y:2201
 		{
 			yySLICE := (*[]*IndexOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].indexOptionUnion())
 		}
-	case 339:
+	case 370:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:2001
+//line sql.y:2207
 		{
 			yyLOCAL = yyDollar[1].indexOptionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 340:
+	case 371:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:2005
+//line sql.y:2211
 		{
 			// should not be string
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 341:
+	case 372:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:2010
+//line sql.y:2216
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 342:
+	case 373:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:2014
+//line sql.y:2220
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str) + " " + string(yyDollar[2].str), String: yyDollar[3].colIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
-	case 343:
+	case 374:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2020
+//line sql.y:2226
 		{
 			yyVAL.str = ""
 		}
-	case 344:
+	case 375:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2024
+//line sql.y:2230
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 345:
+	case 376:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:2030
+//line sql.y:2236
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 346:
+	case 377:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:2034
+//line sql.y:2240
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 347:
+	case 378:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:2038
+//line sql.y:2244
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Fulltext: true, Unique: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 348:
+	case 379:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:2042
+//line sql.y:2248
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent(yyDollar[4].str), Unique: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 349:
+	case 380:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:2046
+//line sql.y:2252
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 350:
+	case 381:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2051
+//line sql.y:2257
 		{
 			yyVAL.str = ""
 		}
-	case 351:
+	case 382:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2055
+//line sql.y:2261
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 352:
+	case 383:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2061
+//line sql.y:2267
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 353:
+	case 384:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2065
+//line sql.y:2271
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 354:
+	case 385:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2069
+//line sql.y:2275
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 355:
+	case 386:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2076
+//line sql.y:2282
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 356:
+	case 387:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2080
+//line sql.y:2286
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 357:
+	case 388:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2085
+//line sql.y:2291
 		{
 			yyVAL.str = "key"
 		}
-	case 358:
+	case 389:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2089
+//line sql.y:2295
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 359:
+	case 390:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2095
+//line sql.y:2301
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 360:
+	case 391:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2099
+//line sql.y:2305
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 361:
+	case 392:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2104
+//line sql.y:2310
 		{
 			yyVAL.str = ""
 		}
-	case 362:
+	case 393:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2108
+//line sql.y:2314
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 363:
+	case 394:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexColumn
-//line sql.y:2114
+//line sql.y:2320
 		{
 			yyLOCAL = []*IndexColumn{yyDollar[1].indexColumnUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 364:
+	case 395:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2118
+//line sql.y:2324
 		{
 			yySLICE := (*[]*IndexColumn)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].indexColumnUnion())
 		}
-	case 365:
+	case 396:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexColumn
-//line sql.y:2124
+//line sql.y:2330
 		{
 			yyLOCAL = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].literalUnion(), Direction: yyDollar[3].orderDirectionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 366:
+	case 397:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:2130
+//line sql.y:2336
 		{
 			yyLOCAL = &ConstraintDefinition{Name: yyDollar[2].colIdent, Details: yyDollar[3].constraintInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 367:
+	case 398:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:2134
+//line sql.y:2340
 		{
 			yyLOCAL = &ConstraintDefinition{Details: yyDollar[1].constraintInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 368:
+	case 399:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:2140
+//line sql.y:2346
 		{
 			yyLOCAL = &ConstraintDefinition{Name: yyDollar[2].colIdent, Details: yyDollar[3].constraintInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 369:
+	case 400:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:2144
+//line sql.y:2350
 		{
 			yyLOCAL = &ConstraintDefinition{Details: yyDollar[1].constraintInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 370:
+	case 401:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:2150
+//line sql.y:2356
 		{
 			yyLOCAL = &ForeignKeyDefinition{IndexName: NewColIdent(yyDollar[3].str), Source: yyDollar[5].columnsUnion(), ReferenceDefinition: yyDollar[7].referenceDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 371:
+	case 402:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:2156
+//line sql.y:2362
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 372:
+	case 403:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:2160
+//line sql.y:2366
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion(), OnDelete: yyDollar[6].ReferenceActionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 373:
+	case 404:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:2164
+//line sql.y:2370
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion(), OnUpdate: yyDollar[6].ReferenceActionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 374:
+	case 405:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:2168
+//line sql.y:2374
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion(), OnDelete: yyDollar[6].ReferenceActionUnion(), OnUpdate: yyDollar[7].ReferenceActionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 375:
+	case 406:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:2173
+//line sql.y:2379
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 376:
+	case 407:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:2177
+//line sql.y:2383
 		{
 			yyLOCAL = yyDollar[1].referenceDefinitionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 377:
+	case 408:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:2183
+//line sql.y:2389
 		{
 			yyLOCAL = &CheckConstraintDefinition{Expr: yyDollar[3].exprUnion(), Enforced: yyDollar[5].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 378:
+	case 409:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2189
+//line sql.y:2395
 		{
 			yyLOCAL = yyDollar[3].ReferenceActionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 379:
+	case 410:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2195
+//line sql.y:2401
 		{
 			yyLOCAL = yyDollar[3].ReferenceActionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 380:
+	case 411:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2201
+//line sql.y:2407
 		{
 			yyLOCAL = Restrict
 		}
 		yyVAL.union = yyLOCAL
-	case 381:
+	case 412:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2205
+//line sql.y:2411
 		{
 			yyLOCAL = Cascade
 		}
 		yyVAL.union = yyLOCAL
-	case 382:
+	case 413:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2209
+//line sql.y:2415
 		{
 			yyLOCAL = NoAction
 		}
 		yyVAL.union = yyLOCAL
-	case 383:
+	case 414:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2213
+//line sql.y:2419
 		{
 			yyLOCAL = SetDefault
 		}
 		yyVAL.union = yyLOCAL
-	case 384:
+	case 415:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2217
+//line sql.y:2423
 		{
 			yyLOCAL = SetNull
 		}
 		yyVAL.union = yyLOCAL
-	case 385:
+	case 416:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2222
+//line sql.y:2428
 		{
 			yyVAL.str = ""
 		}
-	case 386:
+	case 417:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2226
+//line sql.y:2432
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 387:
+	case 418:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2230
+//line sql.y:2436
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 388:
+	case 419:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2235
+//line sql.y:2441
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 389:
+	case 420:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2239
+//line sql.y:2445
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 390:
+	case 421:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2243
+//line sql.y:2449
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 391:
+	case 422:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:2248
+//line sql.y:2454
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 392:
+	case 423:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:2252
+//line sql.y:2458
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 393:
+	case 424:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:2258
+//line sql.y:2464
 		{
 			yyLOCAL = TableOptions{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 394:
+	case 425:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2262
+//line sql.y:2468
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableOptionUnion())
 		}
-	case 395:
+	case 426:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2266
+//line sql.y:2472
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].tableOptionUnion())
 		}
-	case 396:
+	case 427:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:2272
+//line sql.y:2478
 		{
 			yyLOCAL = TableOptions{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 397:
+	case 428:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2276
+//line sql.y:2482
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].tableOptionUnion())
 		}
-	case 398:
+	case 429:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2282
+//line sql.y:2488
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 399:
+	case 430:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2286
+//line sql.y:2492
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 400:
+	case 431:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2290
+//line sql.y:2496
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[2].str)), String: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 401:
+	case 432:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2294
+//line sql.y:2500
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[2].str), String: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 402:
+	case 433:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2298
+//line sql.y:2504
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 403:
+	case 434:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2302
+//line sql.y:2508
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 404:
+	case 435:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2306
+//line sql.y:2512
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 405:
+	case 436:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2310
+//line sql.y:2516
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 406:
+	case 437:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2314
+//line sql.y:2520
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[1].str) + " " + string(yyDollar[2].str)), Value: NewStrLiteral(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 407:
+	case 438:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2318
+//line sql.y:2524
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[1].str) + " " + string(yyDollar[2].str)), Value: NewStrLiteral(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 408:
+	case 439:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2322
+//line sql.y:2528
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 409:
+	case 440:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2326
+//line sql.y:2532
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 410:
+	case 441:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2330
+//line sql.y:2536
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: yyDollar[3].tableIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
-	case 411:
+	case 442:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2334
+//line sql.y:2540
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 412:
+	case 443:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2338
+//line sql.y:2544
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 413:
+	case 444:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2342
+//line sql.y:2548
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 414:
+	case 445:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2346
+//line sql.y:2552
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 415:
+	case 446:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2350
+//line sql.y:2556
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 416:
+	case 447:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2354
+//line sql.y:2560
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 417:
+	case 448:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2358
+//line sql.y:2564
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 418:
+	case 449:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2362
+//line sql.y:2568
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 419:
+	case 450:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2366
+//line sql.y:2572
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 420:
+	case 451:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2370
+//line sql.y:2576
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 421:
+	case 452:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2374
+//line sql.y:2580
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 422:
+	case 453:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2378
+//line sql.y:2584
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 423:
+	case 454:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2382
+//line sql.y:2588
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 424:
+	case 455:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2386
+//line sql.y:2592
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: (yyDollar[3].colIdent.String() + yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 425:
+	case 456:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2390
+//line sql.y:2596
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Tables: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 426:
+	case 457:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2395
+//line sql.y:2601
 		{
 			yyVAL.str = ""
 		}
-	case 427:
+	case 458:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2399
+//line sql.y:2605
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 428:
+	case 459:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2403
+//line sql.y:2609
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 438:
+	case 469:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2422
+//line sql.y:2628
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 439:
+	case 470:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2426
+//line sql.y:2632
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str)
 		}
-	case 440:
+	case 471:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2430
+//line sql.y:2636
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 441:
+	case 472:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2435
+//line sql.y:2641
 		{
 			yyVAL.str = ""
 		}
-	case 443:
+	case 474:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2441
+//line sql.y:2647
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 444:
+	case 475:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2445
+//line sql.y:2651
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 445:
+	case 476:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:2450
+//line sql.y:2656
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 446:
+	case 477:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:2454
+//line sql.y:2660
 		{
 			yyLOCAL = yyDollar[2].colNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 447:
+	case 478:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2459
+//line sql.y:2665
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 448:
+	case 479:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2463
+//line sql.y:2669
 		{
 			yyLOCAL = yyDollar[1].alterOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 449:
+	case 480:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2467
+//line sql.y:2673
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, &OrderByOption{Cols: yyDollar[5].columnsUnion()})
 		}
-	case 450:
+	case 481:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2471
+//line sql.y:2677
 		{
 			yyLOCAL = yyDollar[1].alterOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 451:
+	case 482:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2475
+//line sql.y:2681
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionsUnion()...)
 		}
-	case 452:
+	case 483:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2479
+//line sql.y:2685
 		{
 			yyLOCAL = append(append(yyDollar[1].alterOptionsUnion(), yyDollar[3].alterOptionsUnion()...), &OrderByOption{Cols: yyDollar[7].columnsUnion()})
 		}
 		yyVAL.union = yyLOCAL
-	case 453:
+	case 484:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2485
+//line sql.y:2691
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 454:
+	case 485:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2489
+//line sql.y:2695
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
-	case 455:
+	case 486:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2493
+//line sql.y:2699
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
-	case 456:
+	case 487:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2499
+//line sql.y:2705
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 457:
+	case 488:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2503
+//line sql.y:2709
 		{
 			yyLOCAL = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 458:
+	case 489:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2507
+//line sql.y:2713
 		{
 			yyLOCAL = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 459:
+	case 490:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2511
+//line sql.y:2717
 		{
 			yyLOCAL = &AddIndexDefinition{IndexDefinition: yyDollar[2].indexDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 460:
+	case 491:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2515
+//line sql.y:2721
 		{
 			yyLOCAL = &AddColumns{Columns: yyDollar[4].columnDefinitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 461:
+	case 492:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2519
+//line sql.y:2725
 		{
 			yyLOCAL = &AddColumns{Columns: []*ColumnDefinition{yyDollar[3].columnDefinitionUnion()}, First: yyDollar[4].booleanUnion(), After: yyDollar[5].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 462:
+	case 493:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2523
+//line sql.y:2729
 		{
 			yyLOCAL = &AlterColumn{Column: yyDollar[3].colNameUnion(), DropDefault: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 463:
+	case 494:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2527
+//line sql.y:2733
 		{
 			yyLOCAL = &AlterColumn{Column: yyDollar[3].colNameUnion(), DropDefault: false, DefaultVal: yyDollar[6].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 464:
+	case 495:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2531
+//line sql.y:2737
 		{
 			yyLOCAL = &AlterColumn{Column: yyDollar[3].colNameUnion(), DropDefault: false, DefaultVal: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 465:
+	case 496:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2535
+//line sql.y:2741
 		{
 			yyLOCAL = &ChangeColumn{OldColumn: yyDollar[3].colNameUnion(), NewColDefinition: yyDollar[4].columnDefinitionUnion(), First: yyDollar[5].booleanUnion(), After: yyDollar[6].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 466:
+	case 497:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2539
+//line sql.y:2745
 		{
 			yyLOCAL = &ModifyColumn{NewColDefinition: yyDollar[3].columnDefinitionUnion(), First: yyDollar[4].booleanUnion(), After: yyDollar[5].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 467:
+	case 498:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2543
+//line sql.y:2749
 		{
 			yyLOCAL = &AlterCharset{CharacterSet: yyDollar[4].str, Collate: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 468:
+	case 499:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2547
+//line sql.y:2753
 		{
 			yyLOCAL = &KeyState{Enable: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 469:
+	case 500:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2551
+//line sql.y:2757
 		{
 			yyLOCAL = &KeyState{Enable: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 470:
+	case 501:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2555
+//line sql.y:2761
 		{
 			yyLOCAL = &TablespaceOperation{Import: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 471:
+	case 502:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2559
+//line sql.y:2765
 		{
 			yyLOCAL = &TablespaceOperation{Import: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 472:
+	case 503:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2563
+//line sql.y:2769
 		{
 			yyLOCAL = &DropColumn{Name: yyDollar[3].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 473:
+	case 504:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2567
+//line sql.y:2773
 		{
 			yyLOCAL = &DropKey{Type: NormalKeyType, Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 474:
+	case 505:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2571
+//line sql.y:2777
 		{
 			yyLOCAL = &DropKey{Type: PrimaryKeyType}
 		}
 		yyVAL.union = yyLOCAL
-	case 475:
+	case 506:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2575
+//line sql.y:2781
 		{
 			yyLOCAL = &DropKey{Type: ForeignKeyType, Name: yyDollar[4].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 476:
+	case 507:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2579
+//line sql.y:2785
 		{
 			yyLOCAL = &Force{}
 		}
 		yyVAL.union = yyLOCAL
-	case 477:
+	case 508:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2583
+//line sql.y:2789
 		{
 			yyLOCAL = &RenameTableName{Table: yyDollar[3].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 478:
+	case 509:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2587
+//line sql.y:2793
 		{
 			yyLOCAL = &RenameIndex{OldName: yyDollar[3].colIdent, NewName: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 479:
+	case 510:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2593
+//line sql.y:2799
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 480:
+	case 511:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2597
+//line sql.y:2803
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
-	case 481:
+	case 512:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2603
+//line sql.y:2809
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 482:
+	case 513:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2607
+//line sql.y:2813
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 483:
+	case 514:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2611
+//line sql.y:2817
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 484:
+	case 515:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2615
+//line sql.y:2821
 		{
 			yyLOCAL = &LockOption{Type: DefaultType}
 		}
 		yyVAL.union = yyLOCAL
-	case 485:
+	case 516:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2619
+//line sql.y:2825
 		{
 			yyLOCAL = &LockOption{Type: NoneType}
 		}
 		yyVAL.union = yyLOCAL
-	case 486:
+	case 517:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2623
+//line sql.y:2829
 		{
 			yyLOCAL = &LockOption{Type: SharedType}
 		}
 		yyVAL.union = yyLOCAL
-	case 487:
+	case 518:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2627
+//line sql.y:2833
 		{
 			yyLOCAL = &LockOption{Type: ExclusiveType}
 		}
 		yyVAL.union = yyLOCAL
-	case 488:
+	case 519:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2631
+//line sql.y:2837
 		{
 			yyLOCAL = &Validation{With: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 489:
+	case 520:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2635
+//line sql.y:2841
 		{
 			yyLOCAL = &Validation{With: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 490:
+	case 521:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2641
+//line sql.y:2847
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
@@ -10337,10 +11039,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 491:
+	case 522:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2648
+//line sql.y:2854
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
@@ -10348,10 +11050,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 492:
+	case 523:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2655
+//line sql.y:2861
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
@@ -10359,28 +11061,28 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 493:
+	case 524:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2662
+//line sql.y:2868
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().PartitionSpec = yyDollar[2].partSpecUnion()
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 494:
+	case 525:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2668
+//line sql.y:2874
 		{
 			yyLOCAL = &AlterView{ViewName: yyDollar[7].tableName.ToViewName(), Comments: Comments(yyDollar[2].strs), Algorithm: yyDollar[3].str, Definer: yyDollar[4].definerUnion(), Security: yyDollar[5].str, Columns: yyDollar[8].columnsUnion(), Select: yyDollar[10].selStmtUnion(), CheckOption: yyDollar[11].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 495:
+	case 526:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2672
+//line sql.y:2878
 		{
 			yyDollar[1].alterDatabaseUnion().FullyParsed = true
 			yyDollar[1].alterDatabaseUnion().DBName = yyDollar[2].tableIdent
@@ -10388,10 +11090,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterDatabaseUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 496:
+	case 527:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2679
+//line sql.y:2885
 		{
 			yyDollar[1].alterDatabaseUnion().FullyParsed = true
 			yyDollar[1].alterDatabaseUnion().DBName = yyDollar[2].tableIdent
@@ -10399,10 +11101,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterDatabaseUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 497:
+	case 528:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2686
+//line sql.y:2892
 		{
 			yyLOCAL = &AlterVschema{
 				Action: CreateVindexDDLAction,
@@ -10415,10 +11117,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 498:
+	case 529:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2698
+//line sql.y:2904
 		{
 			yyLOCAL = &AlterVschema{
 				Action: DropVindexDDLAction,
@@ -10429,26 +11131,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 499:
+	case 530:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2708
+//line sql.y:2914
 		{
 			yyLOCAL = &AlterVschema{Action: AddVschemaTableDDLAction, Table: yyDollar[6].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 500:
+	case 531:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2712
+//line sql.y:2918
 		{
 			yyLOCAL = &AlterVschema{Action: DropVschemaTableDDLAction, Table: yyDollar[6].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 501:
+	case 532:
 		yyDollar = yyS[yypt-13 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2716
+//line sql.y:2922
 		{
 			yyLOCAL = &AlterVschema{
 				Action: AddColVindexDDLAction,
@@ -10462,10 +11164,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 502:
+	case 533:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2729
+//line sql.y:2935
 		{
 			yyLOCAL = &AlterVschema{
 				Action: DropColVindexDDLAction,
@@ -10476,18 +11178,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 503:
+	case 534:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2739
+//line sql.y:2945
 		{
 			yyLOCAL = &AlterVschema{Action: AddSequenceDDLAction, Table: yyDollar[6].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 504:
+	case 535:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2743
+//line sql.y:2949
 		{
 			yyLOCAL = &AlterVschema{
 				Action: AddAutoIncDDLAction,
@@ -10499,10 +11201,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 505:
+	case 536:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2754
+//line sql.y:2960
 		{
 			yyLOCAL = &AlterMigration{
 				Type: RetryMigrationType,
@@ -10510,10 +11212,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 506:
+	case 537:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2761
+//line sql.y:2967
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CleanupMigrationType,
@@ -10521,10 +11223,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 507:
+	case 538:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2768
+//line sql.y:2974
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CompleteMigrationType,
@@ -10532,10 +11234,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 508:
+	case 539:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2775
+//line sql.y:2981
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CancelMigrationType,
@@ -10543,28 +11245,28 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 509:
+	case 540:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2782
+//line sql.y:2988
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CancelAllMigrationType,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 510:
+	case 541:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *PartitionOption
-//line sql.y:2789
+//line sql.y:2995
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 511:
+	case 542:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *PartitionOption
-//line sql.y:2793
+//line sql.y:2999
 		{
 			yyDollar[3].partitionOptionUnion().Partitions = yyDollar[4].integerUnion()
 			yyDollar[3].partitionOptionUnion().SubPartition = yyDollar[5].subPartitionUnion()
@@ -10572,10 +11274,10 @@ yydefault:
 			yyLOCAL = yyDollar[3].partitionOptionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 512:
+	case 543:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *PartitionOption
-//line sql.y:2802
+//line sql.y:3008
 		{
 			yyLOCAL = &PartitionOption{
 				IsLinear: yyDollar[1].booleanUnion(),
@@ -10584,10 +11286,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 513:
+	case 544:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *PartitionOption
-//line sql.y:2810
+//line sql.y:3016
 		{
 			yyLOCAL = &PartitionOption{
 				IsLinear:     yyDollar[1].booleanUnion(),
@@ -10597,10 +11299,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 514:
+	case 545:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionOption
-//line sql.y:2819
+//line sql.y:3025
 		{
 			yyLOCAL = &PartitionOption{
 				Type: yyDollar[1].partitionByTypeUnion(),
@@ -10608,10 +11310,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 515:
+	case 546:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *PartitionOption
-//line sql.y:2826
+//line sql.y:3032
 		{
 			yyLOCAL = &PartitionOption{
 				Type:    yyDollar[1].partitionByTypeUnion(),
@@ -10619,18 +11321,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 516:
+	case 547:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *SubPartition
-//line sql.y:2834
+//line sql.y:3040
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 517:
+	case 548:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *SubPartition
-//line sql.y:2838
+//line sql.y:3044
 		{
 			yyLOCAL = &SubPartition{
 				IsLinear:      yyDollar[3].booleanUnion(),
@@ -10640,10 +11342,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 518:
+	case 549:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL *SubPartition
-//line sql.y:2847
+//line sql.y:3053
 		{
 			yyLOCAL = &SubPartition{
 				IsLinear:      yyDollar[3].booleanUnion(),
@@ -10654,335 +11356,335 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 519:
+	case 550:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*PartitionDefinition
-//line sql.y:2858
+//line sql.y:3064
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 520:
+	case 551:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*PartitionDefinition
-//line sql.y:2862
+//line sql.y:3068
 		{
 			yyLOCAL = yyDollar[2].partDefsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 521:
+	case 552:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2867
+//line sql.y:3073
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 522:
+	case 553:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2871
+//line sql.y:3077
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 523:
+	case 554:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int
-//line sql.y:2876
+//line sql.y:3082
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 524:
+	case 555:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int
-//line sql.y:2880
+//line sql.y:3086
 		{
 			yyLOCAL = convertStringToInt(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 525:
+	case 556:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL PartitionByType
-//line sql.y:2886
+//line sql.y:3092
 		{
 			yyLOCAL = RangeType
 		}
 		yyVAL.union = yyLOCAL
-	case 526:
+	case 557:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL PartitionByType
-//line sql.y:2890
+//line sql.y:3096
 		{
 			yyLOCAL = ListType
 		}
 		yyVAL.union = yyLOCAL
-	case 527:
+	case 558:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int
-//line sql.y:2895
+//line sql.y:3101
 		{
 			yyLOCAL = -1
 		}
 		yyVAL.union = yyLOCAL
-	case 528:
+	case 559:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int
-//line sql.y:2899
+//line sql.y:3105
 		{
 			yyLOCAL = convertStringToInt(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 529:
+	case 560:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int
-//line sql.y:2904
+//line sql.y:3110
 		{
 			yyLOCAL = -1
 		}
 		yyVAL.union = yyLOCAL
-	case 530:
+	case 561:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int
-//line sql.y:2908
+//line sql.y:3114
 		{
 			yyLOCAL = convertStringToInt(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 531:
+	case 562:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2914
+//line sql.y:3120
 		{
 			yyLOCAL = &PartitionSpec{Action: AddAction, Definitions: []*PartitionDefinition{yyDollar[4].partDefUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 532:
+	case 563:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2918
+//line sql.y:3124
 		{
 			yyLOCAL = &PartitionSpec{Action: DropAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 533:
+	case 564:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2922
+//line sql.y:3128
 		{
 			yyLOCAL = &PartitionSpec{Action: ReorganizeAction, Names: yyDollar[3].partitionsUnion(), Definitions: yyDollar[6].partDefsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 534:
+	case 565:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2926
+//line sql.y:3132
 		{
 			yyLOCAL = &PartitionSpec{Action: DiscardAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 535:
+	case 566:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2930
+//line sql.y:3136
 		{
 			yyLOCAL = &PartitionSpec{Action: DiscardAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 536:
+	case 567:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2934
+//line sql.y:3140
 		{
 			yyLOCAL = &PartitionSpec{Action: ImportAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 537:
+	case 568:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2938
+//line sql.y:3144
 		{
 			yyLOCAL = &PartitionSpec{Action: ImportAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 538:
+	case 569:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2942
+//line sql.y:3148
 		{
 			yyLOCAL = &PartitionSpec{Action: TruncateAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 539:
+	case 570:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2946
+//line sql.y:3152
 		{
 			yyLOCAL = &PartitionSpec{Action: TruncateAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 540:
+	case 571:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2950
+//line sql.y:3156
 		{
 			yyLOCAL = &PartitionSpec{Action: CoalesceAction, Number: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 541:
+	case 572:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2954
+//line sql.y:3160
 		{
 			yyLOCAL = &PartitionSpec{Action: ExchangeAction, Names: Partitions{yyDollar[3].colIdent}, TableName: yyDollar[6].tableName, WithoutValidation: yyDollar[7].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 542:
+	case 573:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2958
+//line sql.y:3164
 		{
 			yyLOCAL = &PartitionSpec{Action: AnalyzeAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 543:
+	case 574:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2962
+//line sql.y:3168
 		{
 			yyLOCAL = &PartitionSpec{Action: AnalyzeAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 544:
+	case 575:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2966
+//line sql.y:3172
 		{
 			yyLOCAL = &PartitionSpec{Action: CheckAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 545:
+	case 576:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2970
+//line sql.y:3176
 		{
 			yyLOCAL = &PartitionSpec{Action: CheckAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 546:
+	case 577:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2974
+//line sql.y:3180
 		{
 			yyLOCAL = &PartitionSpec{Action: OptimizeAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 547:
+	case 578:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2978
+//line sql.y:3184
 		{
 			yyLOCAL = &PartitionSpec{Action: OptimizeAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 548:
+	case 579:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2982
+//line sql.y:3188
 		{
 			yyLOCAL = &PartitionSpec{Action: RebuildAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 549:
+	case 580:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2986
+//line sql.y:3192
 		{
 			yyLOCAL = &PartitionSpec{Action: RebuildAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 550:
+	case 581:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2990
+//line sql.y:3196
 		{
 			yyLOCAL = &PartitionSpec{Action: RepairAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 551:
+	case 582:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2994
+//line sql.y:3200
 		{
 			yyLOCAL = &PartitionSpec{Action: RepairAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 552:
+	case 583:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2998
+//line sql.y:3204
 		{
 			yyLOCAL = &PartitionSpec{Action: UpgradeAction}
 		}
 		yyVAL.union = yyLOCAL
-	case 553:
+	case 584:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3003
+//line sql.y:3209
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 554:
+	case 585:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3007
+//line sql.y:3213
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 555:
+	case 586:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3011
+//line sql.y:3217
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 556:
+	case 587:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*PartitionDefinition
-//line sql.y:3018
+//line sql.y:3224
 		{
 			yyLOCAL = []*PartitionDefinition{yyDollar[1].partDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 557:
+	case 588:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3022
+//line sql.y:3228
 		{
 			yySLICE := (*[]*PartitionDefinition)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].partDefUnion())
 		}
-	case 558:
+	case 589:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3028
+//line sql.y:3234
 		{
 			yyVAL.partDefUnion().ValueRange = yyDollar[2].partitionValueRangeUnion()
 		}
-	case 559:
+	case 590:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *PartitionValueRange
-//line sql.y:3033
+//line sql.y:3239
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 560:
+	case 591:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionValueRange
-//line sql.y:3037
+//line sql.y:3243
 		{
 			yyLOCAL = &PartitionValueRange{
 				Type:  LessThanType,
@@ -10990,10 +11692,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 561:
+	case 592:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionValueRange
-//line sql.y:3044
+//line sql.y:3250
 		{
 			yyLOCAL = &PartitionValueRange{
 				Type:     LessThanType,
@@ -11001,10 +11703,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 562:
+	case 593:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionValueRange
-//line sql.y:3051
+//line sql.y:3257
 		{
 			yyLOCAL = &PartitionValueRange{
 				Type:  InType,
@@ -11012,61 +11714,61 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 563:
+	case 594:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *PartitionDefinition
-//line sql.y:3060
+//line sql.y:3266
 		{
 			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 564:
+	case 595:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3066
+//line sql.y:3272
 		{
 			yyVAL.str = ""
 		}
-	case 565:
+	case 596:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3070
+//line sql.y:3276
 		{
 			yyVAL.str = ""
 		}
-	case 566:
+	case 597:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3076
+//line sql.y:3282
 		{
 			yyLOCAL = &RenameTable{TablePairs: yyDollar[3].renameTablePairsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 567:
+	case 598:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*RenameTablePair
-//line sql.y:3082
+//line sql.y:3288
 		{
 			yyLOCAL = []*RenameTablePair{{FromTable: yyDollar[1].tableName, ToTable: yyDollar[3].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 568:
+	case 599:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3086
+//line sql.y:3292
 		{
 			yySLICE := (*[]*RenameTablePair)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, &RenameTablePair{FromTable: yyDollar[3].tableName, ToTable: yyDollar[5].tableName})
 		}
-	case 569:
+	case 600:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3092
+//line sql.y:3298
 		{
 			yyLOCAL = &DropTable{FromTables: yyDollar[6].tableNamesUnion(), IfExists: yyDollar[5].booleanUnion(), Comments: Comments(yyDollar[2].strs), Temp: yyDollar[3].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 570:
+	case 601:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3096
+//line sql.y:3302
 		{
 			// Change this to an alter statement
 			if yyDollar[4].colIdent.Lowered() == "primary" {
@@ -11076,404 +11778,404 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 571:
+	case 602:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3105
+//line sql.y:3311
 		{
 			yyLOCAL = &DropView{FromTables: yyDollar[5].tableNamesUnion(), Comments: Comments(yyDollar[2].strs), IfExists: yyDollar[4].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 572:
+	case 603:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3109
+//line sql.y:3315
 		{
 			yyLOCAL = &DropDatabase{Comments: Comments(yyDollar[2].strs), DBName: yyDollar[5].tableIdent, IfExists: yyDollar[4].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 573:
+	case 604:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3115
+//line sql.y:3321
 		{
 			yyLOCAL = &TruncateTable{Table: yyDollar[3].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 574:
+	case 605:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3119
+//line sql.y:3325
 		{
 			yyLOCAL = &TruncateTable{Table: yyDollar[2].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 575:
+	case 606:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3124
+//line sql.y:3330
 		{
 			yyLOCAL = &OtherRead{}
 		}
 		yyVAL.union = yyLOCAL
-	case 576:
+	case 607:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3130
+//line sql.y:3336
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Charset, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 577:
+	case 608:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3134
+//line sql.y:3340
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Collation, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 578:
+	case 609:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3138
+//line sql.y:3344
 		{
 			yyLOCAL = &Show{&ShowBasic{Full: yyDollar[2].booleanUnion(), Command: Column, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].tableIdent, Filter: yyDollar[7].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 579:
+	case 610:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3142
+//line sql.y:3348
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 580:
+	case 611:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3146
+//line sql.y:3352
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 581:
+	case 612:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3150
+//line sql.y:3356
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 582:
+	case 613:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3154
+//line sql.y:3360
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 583:
+	case 614:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3158
+//line sql.y:3364
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Function, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 584:
+	case 615:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3162
+//line sql.y:3368
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Index, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].tableIdent, Filter: yyDollar[7].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 585:
+	case 616:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3166
+//line sql.y:3372
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: OpenTable, DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 586:
+	case 617:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3170
+//line sql.y:3376
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Privilege}}
 		}
 		yyVAL.union = yyLOCAL
-	case 587:
+	case 618:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3174
+//line sql.y:3380
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Procedure, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 619:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3178
+//line sql.y:3384
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: StatusSession, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 620:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3182
+//line sql.y:3388
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: StatusGlobal, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 590:
+	case 621:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3186
+//line sql.y:3392
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VariableSession, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 591:
+	case 622:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3190
+//line sql.y:3396
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VariableGlobal, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 592:
+	case 623:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3194
+//line sql.y:3400
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: TableStatus, DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 593:
+	case 624:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3198
+//line sql.y:3404
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Table, Full: yyDollar[2].booleanUnion(), DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 594:
+	case 625:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3202
+//line sql.y:3408
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Trigger, DbName: yyDollar[3].tableIdent, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 595:
+	case 626:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3206
+//line sql.y:3412
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateDb, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 596:
+	case 627:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3210
+//line sql.y:3416
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateE, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 597:
+	case 628:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3214
+//line sql.y:3420
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateF, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 598:
+	case 629:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3218
+//line sql.y:3424
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateProc, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 599:
+	case 630:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3222
+//line sql.y:3428
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateTbl, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 600:
+	case 631:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3226
+//line sql.y:3432
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateTr, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 601:
+	case 632:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3230
+//line sql.y:3436
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateV, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 602:
+	case 633:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3234
+//line sql.y:3440
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 603:
+	case 634:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3238
+//line sql.y:3444
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].colIdent.String()), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 604:
+	case 635:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3242
+//line sql.y:3448
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 605:
+	case 636:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3246
+//line sql.y:3452
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 606:
+	case 637:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3250
+//line sql.y:3456
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 607:
+	case 638:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3254
+//line sql.y:3460
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 608:
+	case 639:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3258
+//line sql.y:3464
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 609:
+	case 640:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3262
+//line sql.y:3468
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 610:
+	case 641:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3266
+//line sql.y:3472
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: GtidExecGlobal, DbName: yyDollar[4].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 611:
+	case 642:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3270
+//line sql.y:3476
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VGtidExecGlobal, DbName: yyDollar[4].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 612:
+	case 643:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3274
+//line sql.y:3480
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilterUnion()}
 			yyLOCAL = &Show{&ShowLegacy{Scope: VitessMetadataScope, Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt}}
 		}
 		yyVAL.union = yyLOCAL
-	case 613:
+	case 644:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3279
+//line sql.y:3485
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VitessMigrations, Filter: yyDollar[4].showFilterUnion(), DbName: yyDollar[3].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 614:
+	case 645:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3283
+//line sql.y:3489
 		{
 			yyLOCAL = &ShowMigrationLogs{UUID: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 615:
+	case 646:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3287
+//line sql.y:3493
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[3].showFilterUnion()}
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope, ShowTablesOpt: showTablesOpt}}
 		}
 		yyVAL.union = yyLOCAL
-	case 616:
+	case 647:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3292
+//line sql.y:3498
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 617:
+	case 648:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3296
+//line sql.y:3502
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 618:
+	case 649:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3300
+//line sql.y:3506
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), OnTable: yyDollar[5].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 619:
+	case 650:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3304
+//line sql.y:3510
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Warnings}}
 		}
 		yyVAL.union = yyLOCAL
-	case 620:
+	case 651:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3309
+//line sql.y:3515
 		{
 			// This should probably be a different type (ShowVitessTopoOpt), but
 			// just getting the thing working for now
@@ -11481,2874 +12183,3190 @@ yydefault:
 			yyLOCAL = &Show{&ShowLegacy{Type: yyDollar[2].str, ShowTablesOpt: showTablesOpt}}
 		}
 		yyVAL.union = yyLOCAL
-	case 621:
+	case 652:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3323
+//line sql.y:3529
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].colIdent.String()), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 622:
+	case 653:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3327
+//line sql.y:3533
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 623:
+	case 654:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3331
+//line sql.y:3537
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 624:
+	case 655:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3337
+//line sql.y:3543
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 625:
+	case 656:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3341
+//line sql.y:3547
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 626:
+	case 657:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3347
+//line sql.y:3553
 		{
 			yyVAL.str = ""
 		}
-	case 627:
+	case 658:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3351
+//line sql.y:3557
 		{
 			yyVAL.str = "extended "
 		}
-	case 628:
+	case 659:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3357
+//line sql.y:3563
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 629:
+	case 660:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3361
+//line sql.y:3567
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 630:
+	case 661:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3367
+//line sql.y:3573
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 631:
+	case 662:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3371
+//line sql.y:3577
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 632:
+	case 663:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3377
+//line sql.y:3583
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 633:
+	case 664:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3381
+//line sql.y:3587
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 634:
+	case 665:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3385
+//line sql.y:3591
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 635:
+	case 666:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:3391
+//line sql.y:3597
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 636:
+	case 667:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:3395
+//line sql.y:3601
 		{
 			yyLOCAL = &ShowFilter{Like: string(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 637:
+	case 668:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:3399
+//line sql.y:3605
 		{
 			yyLOCAL = &ShowFilter{Filter: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 638:
+	case 669:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:3405
+//line sql.y:3611
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 639:
+	case 670:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:3409
+//line sql.y:3615
 		{
 			yyLOCAL = &ShowFilter{Like: string(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 640:
+	case 671:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3415
+//line sql.y:3621
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 641:
+	case 672:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3419
+//line sql.y:3625
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 642:
+	case 673:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3423
+//line sql.y:3629
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 643:
+	case 674:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3429
+//line sql.y:3635
 		{
 			yyLOCAL = &Use{DBName: yyDollar[2].tableIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 644:
+	case 675:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3433
+//line sql.y:3639
 		{
 			yyLOCAL = &Use{DBName: TableIdent{v: ""}}
 		}
 		yyVAL.union = yyLOCAL
-	case 645:
+	case 676:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3437
+//line sql.y:3643
 		{
 			yyLOCAL = &Use{DBName: NewTableIdent(yyDollar[2].tableIdent.String() + "@" + string(yyDollar[3].str))}
 		}
 		yyVAL.union = yyLOCAL
-	case 646:
+	case 677:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3443
+//line sql.y:3649
 		{
 			yyLOCAL = &Begin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 647:
+	case 678:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3447
+//line sql.y:3653
 		{
 			yyLOCAL = &Begin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 648:
+	case 679:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3453
+//line sql.y:3659
 		{
 			yyLOCAL = &Commit{}
 		}
 		yyVAL.union = yyLOCAL
-	case 649:
+	case 680:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3459
+//line sql.y:3665
 		{
 			yyLOCAL = &Rollback{}
 		}
 		yyVAL.union = yyLOCAL
-	case 650:
+	case 681:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3463
+//line sql.y:3669
 		{
 			yyLOCAL = &SRollback{Name: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 651:
+	case 682:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3468
+//line sql.y:3674
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 652:
+	case 683:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3470
+//line sql.y:3676
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 653:
+	case 684:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3473
+//line sql.y:3679
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 654:
+	case 685:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3475
+//line sql.y:3681
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 655:
+	case 686:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3480
+//line sql.y:3686
 		{
 			yyLOCAL = &Savepoint{Name: yyDollar[2].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 656:
+	case 687:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3486
+//line sql.y:3692
 		{
 			yyLOCAL = &Release{Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 657:
+	case 688:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3491
+//line sql.y:3697
 		{
 			yyLOCAL = EmptyType
 		}
 		yyVAL.union = yyLOCAL
-	case 658:
+	case 689:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3495
+//line sql.y:3701
 		{
 			yyLOCAL = JSONType
 		}
 		yyVAL.union = yyLOCAL
-	case 659:
+	case 690:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3499
+//line sql.y:3705
 		{
 			yyLOCAL = TreeType
 		}
 		yyVAL.union = yyLOCAL
-	case 660:
+	case 691:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3503
+//line sql.y:3709
 		{
 			yyLOCAL = VitessType
 		}
 		yyVAL.union = yyLOCAL
-	case 661:
+	case 692:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3507
+//line sql.y:3713
 		{
 			yyLOCAL = TraditionalType
 		}
 		yyVAL.union = yyLOCAL
-	case 662:
+	case 693:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3511
+//line sql.y:3717
 		{
 			yyLOCAL = AnalyzeType
 		}
 		yyVAL.union = yyLOCAL
-	case 663:
+	case 694:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3517
+//line sql.y:3723
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 664:
+	case 695:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3521
+//line sql.y:3727
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 665:
+	case 696:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3525
+//line sql.y:3731
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 666:
+	case 697:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3531
+//line sql.y:3737
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 667:
+	case 698:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3535
+//line sql.y:3741
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 668:
+	case 699:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3539
+//line sql.y:3745
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 669:
+	case 700:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3543
+//line sql.y:3749
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 670:
+	case 701:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3548
+//line sql.y:3754
 		{
 			yyVAL.str = ""
 		}
-	case 671:
+	case 702:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3552
+//line sql.y:3758
 		{
 			yyVAL.str = yyDollar[1].colIdent.val
 		}
-	case 672:
+	case 703:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3556
+//line sql.y:3762
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str)
 		}
-	case 673:
+	case 704:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3562
+//line sql.y:3768
 		{
 			yyLOCAL = &ExplainTab{Table: yyDollar[2].tableName, Wild: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 705:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3566
+//line sql.y:3772
 		{
 			yyLOCAL = &ExplainStmt{Type: yyDollar[2].explainTypeUnion(), Statement: yyDollar[3].statementUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 706:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3572
+//line sql.y:3778
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 707:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3576
+//line sql.y:3782
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 677:
+	case 708:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3582
+//line sql.y:3788
 		{
 			yyLOCAL = &LockTables{Tables: yyDollar[3].tableAndLockTypesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 678:
+	case 709:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableAndLockTypes
-//line sql.y:3588
+//line sql.y:3794
 		{
 			yyLOCAL = TableAndLockTypes{yyDollar[1].tableAndLockTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 679:
+	case 710:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3592
+//line sql.y:3798
 		{
 			yySLICE := (*TableAndLockTypes)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableAndLockTypeUnion())
 		}
-	case 680:
+	case 711:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *TableAndLockType
-//line sql.y:3598
+//line sql.y:3804
 		{
 			yyLOCAL = &TableAndLockType{Table: yyDollar[1].aliasedTableNameUnion(), Lock: yyDollar[2].lockTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 681:
+	case 712:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:3604
+//line sql.y:3810
 		{
 			yyLOCAL = Read
 		}
 		yyVAL.union = yyLOCAL
-	case 682:
+	case 713:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:3608
+//line sql.y:3814
 		{
 			yyLOCAL = ReadLocal
 		}
 		yyVAL.union = yyLOCAL
-	case 683:
+	case 714:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:3612
+//line sql.y:3818
 		{
 			yyLOCAL = Write
 		}
 		yyVAL.union = yyLOCAL
-	case 684:
+	case 715:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:3616
+//line sql.y:3822
 		{
 			yyLOCAL = LowPriorityWrite
 		}
 		yyVAL.union = yyLOCAL
-	case 685:
+	case 716:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3622
+//line sql.y:3828
 		{
 			yyLOCAL = &UnlockTables{}
 		}
 		yyVAL.union = yyLOCAL
-	case 686:
+	case 717:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3628
+//line sql.y:3834
 		{
 			yyLOCAL = &RevertMigration{Comments: Comments(yyDollar[2].strs), UUID: string(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 687:
+	case 718:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3634
+//line sql.y:3840
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), FlushOptions: yyDollar[3].strs}
 		}
 		yyVAL.union = yyLOCAL
-	case 688:
+	case 719:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3638
+//line sql.y:3844
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 689:
+	case 720:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3642
+//line sql.y:3848
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), WithLock: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 690:
+	case 721:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3646
+//line sql.y:3852
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 691:
+	case 722:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3650
+//line sql.y:3856
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion(), WithLock: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 692:
+	case 723:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3654
+//line sql.y:3860
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion(), ForExport: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 693:
+	case 724:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3660
+//line sql.y:3866
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 694:
+	case 725:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3664
+//line sql.y:3870
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[3].str)
 		}
-	case 695:
+	case 726:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3670
+//line sql.y:3876
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 696:
+	case 727:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3674
+//line sql.y:3880
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 697:
+	case 728:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3678
+//line sql.y:3884
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 698:
+	case 729:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3682
+//line sql.y:3888
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 699:
+	case 730:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3686
+//line sql.y:3892
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 700:
+	case 731:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3690
+//line sql.y:3896
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 701:
+	case 732:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3694
+//line sql.y:3900
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 702:
+	case 733:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3698
+//line sql.y:3904
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str) + yyDollar[3].str
 		}
-	case 703:
+	case 734:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3702
+//line sql.y:3908
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 704:
+	case 735:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3706
+//line sql.y:3912
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 705:
+	case 736:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3710
+//line sql.y:3916
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 706:
+	case 737:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3714
+//line sql.y:3920
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 707:
+	case 738:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3719
+//line sql.y:3925
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 708:
+	case 739:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3723
+//line sql.y:3929
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 709:
+	case 740:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3727
+//line sql.y:3933
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 710:
+	case 741:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3732
+//line sql.y:3938
 		{
 			yyVAL.str = ""
 		}
-	case 711:
+	case 742:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3736
+//line sql.y:3942
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str) + " " + yyDollar[3].colIdent.String()
 		}
-	case 712:
+	case 743:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3741
+//line sql.y:3947
 		{
 			setAllowComments(yylex, true)
 		}
-	case 713:
+	case 744:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3745
+//line sql.y:3951
 		{
 			yyVAL.strs = yyDollar[2].strs
 			setAllowComments(yylex, false)
 		}
-	case 714:
+	case 745:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3751
+//line sql.y:3957
 		{
 			yyVAL.strs = nil
 		}
-	case 715:
+	case 746:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3755
+//line sql.y:3961
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[2].str)
 		}
-	case 716:
+	case 747:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3761
+//line sql.y:3967
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 717:
+	case 748:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3765
+//line sql.y:3971
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 718:
+	case 749:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3769
+//line sql.y:3975
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 719:
+	case 750:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3774
+//line sql.y:3980
 		{
 			yyVAL.str = ""
 		}
-	case 720:
+	case 751:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3778
+//line sql.y:3984
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 721:
+	case 752:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3782
+//line sql.y:3988
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 722:
+	case 753:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3787
+//line sql.y:3993
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 723:
+	case 754:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3791
+//line sql.y:3997
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 724:
+	case 755:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3795
+//line sql.y:4001
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 725:
+	case 756:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3801
+//line sql.y:4007
 		{
 			yyLOCAL = &PrepareStmt{Name: yyDollar[3].colIdent, Comments: yyDollar[2].strs, Statement: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 726:
+	case 757:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3805
+//line sql.y:4011
 		{
 			yyLOCAL = &PrepareStmt{Name: yyDollar[3].colIdent, Comments: yyDollar[2].strs, StatementIdentifier: NewColIdentWithAt(string(yyDollar[5].str), SingleAt)}
 		}
 		yyVAL.union = yyLOCAL
-	case 727:
+	case 758:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3811
+//line sql.y:4017
 		{
 			yyLOCAL = &ExecuteStmt{Name: yyDollar[3].colIdent, Comments: yyDollar[2].strs, Arguments: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 728:
+	case 759:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3816
+//line sql.y:4022
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 729:
+	case 760:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3820
+//line sql.y:4026
 		{
 			yyLOCAL = yyDollar[2].columnsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 730:
+	case 761:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3826
+//line sql.y:4032
 		{
 			yyLOCAL = &DeallocateStmt{Type: DeallocateType, Comments: yyDollar[2].strs, Name: yyDollar[4].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 731:
+	case 762:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3830
+//line sql.y:4036
 		{
 			yyLOCAL = &DeallocateStmt{Type: DropType, Comments: yyDollar[2].strs, Name: yyDollar[4].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 732:
+	case 763:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3835
+//line sql.y:4041
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 733:
+	case 764:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3839
+//line sql.y:4045
 		{
 			yyLOCAL = yyDollar[1].selectExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 734:
+	case 765:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3844
+//line sql.y:4050
 		{
 			yyVAL.strs = nil
 		}
-	case 735:
+	case 766:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3848
+//line sql.y:4054
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 736:
+	case 767:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3852
+//line sql.y:4058
 		{ // TODO: This is a hack since I couldn't get it to work in a nicer way. I got 'conflicts: 8 shift/reduce'
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str}
 		}
-	case 737:
+	case 768:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3856
+//line sql.y:4062
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str}
 		}
-	case 738:
+	case 769:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3860
+//line sql.y:4066
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str, yyDollar[4].str}
 		}
-	case 739:
+	case 770:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3866
+//line sql.y:4072
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 740:
+	case 771:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3870
+//line sql.y:4076
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 741:
+	case 772:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3874
+//line sql.y:4080
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 742:
+	case 773:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3878
+//line sql.y:4084
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 743:
+	case 774:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3882
+//line sql.y:4088
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 744:
+	case 775:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3886
+//line sql.y:4092
 		{
 			yyVAL.str = SQLCalcFoundRowsStr
 		}
-	case 745:
+	case 776:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3890
+//line sql.y:4096
 		{
 			yyVAL.str = AllStr // These are not picked up by NewSelect, and so ALL will be dropped. But this is OK, since it's redundant anyway
 		}
-	case 746:
+	case 777:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3896
+//line sql.y:4102
 		{
 			yyLOCAL = SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 747:
+	case 778:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3900
+//line sql.y:4106
 		{
 			yySLICE := (*SelectExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].selectExprUnion())
 		}
-	case 748:
+	case 779:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3906
+//line sql.y:4112
 		{
 			yyLOCAL = &StarExpr{}
 		}
 		yyVAL.union = yyLOCAL
-	case 749:
+	case 780:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3910
+//line sql.y:4116
 		{
 			yyLOCAL = &AliasedExpr{Expr: yyDollar[1].exprUnion(), As: yyDollar[2].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 750:
+	case 781:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3914
+//line sql.y:4120
 		{
 			yyLOCAL = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 751:
+	case 782:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3918
+//line sql.y:4124
 		{
 			yyLOCAL = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 752:
+	case 783:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3923
+//line sql.y:4129
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 753:
+	case 784:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3927
+//line sql.y:4133
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 754:
+	case 785:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3931
+//line sql.y:4137
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 756:
+	case 787:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3938
+//line sql.y:4144
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 757:
+	case 788:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3943
+//line sql.y:4149
 		{
 			yyLOCAL = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
 		yyVAL.union = yyLOCAL
-	case 758:
+	case 789:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3947
+//line sql.y:4153
 		{
 			yyLOCAL = yyDollar[1].tableExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 759:
+	case 790:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3953
+//line sql.y:4159
 		{
 			yyLOCAL = yyDollar[2].tableExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 760:
+	case 791:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3959
+//line sql.y:4165
 		{
 			yyLOCAL = TableExprs{yyDollar[1].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 761:
+	case 792:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3963
+//line sql.y:4169
 		{
 			yySLICE := (*TableExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableExprUnion())
 		}
-	case 764:
+	case 795:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3973
+//line sql.y:4179
 		{
 			yyLOCAL = yyDollar[1].aliasedTableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 765:
+	case 796:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3977
+//line sql.y:4183
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].derivedTableUnion(), As: yyDollar[3].tableIdent, Columns: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 766:
+	case 797:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3981
+//line sql.y:4187
 		{
 			yyLOCAL = &ParenTableExpr{Exprs: yyDollar[2].tableExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 767:
+	case 798:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *DerivedTable
-//line sql.y:3987
+//line sql.y:4193
 		{
 			yyLOCAL = &DerivedTable{yyDollar[2].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 768:
+	case 799:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *AliasedTableExpr
-//line sql.y:3993
+//line sql.y:4199
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHintsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 769:
+	case 800:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *AliasedTableExpr
-//line sql.y:3997
+//line sql.y:4203
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitionsUnion(), As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHintsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 770:
+	case 801:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:4002
+//line sql.y:4208
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 771:
+	case 802:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:4006
+//line sql.y:4212
 		{
 			yyLOCAL = yyDollar[2].columnsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 772:
+	case 803:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:4012
+//line sql.y:4218
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 804:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4016
+//line sql.y:4222
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 774:
+	case 805:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:4022
+//line sql.y:4228
 		{
 			yyLOCAL = Columns{NewColIdentWithAt(string(yyDollar[1].str), SingleAt)}
 		}
 		yyVAL.union = yyLOCAL
-	case 775:
+	case 806:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4026
+//line sql.y:4232
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, NewColIdentWithAt(string(yyDollar[3].str), SingleAt))
 		}
-	case 776:
+	case 807:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:4032
+//line sql.y:4238
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 777:
+	case 808:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:4036
+//line sql.y:4242
 		{
 			yyLOCAL = Columns{NewColIdent(string(yyDollar[1].str))}
 		}
 		yyVAL.union = yyLOCAL
-	case 778:
+	case 809:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4040
+//line sql.y:4246
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 779:
+	case 810:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4044
+//line sql.y:4250
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, NewColIdent(string(yyDollar[3].str)))
 		}
-	case 780:
+	case 811:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:4050
+//line sql.y:4256
 		{
 			yyLOCAL = Partitions{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 781:
+	case 812:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4054
+//line sql.y:4260
 		{
 			yySLICE := (*Partitions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 782:
+	case 813:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:4067
+//line sql.y:4273
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 783:
+	case 814:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:4071
+//line sql.y:4277
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 784:
+	case 815:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:4075
+//line sql.y:4281
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 785:
+	case 816:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:4079
+//line sql.y:4285
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 786:
+	case 817:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4085
+//line sql.y:4291
 		{
 			yyVAL.joinCondition = &JoinCondition{On: yyDollar[2].exprUnion()}
 		}
-	case 787:
+	case 818:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4087
+//line sql.y:4293
 		{
 			yyVAL.joinCondition = &JoinCondition{Using: yyDollar[3].columnsUnion()}
 		}
-	case 788:
+	case 819:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4091
+//line sql.y:4297
 		{
 			yyVAL.joinCondition = &JoinCondition{}
 		}
-	case 789:
+	case 820:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4093
+//line sql.y:4299
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 790:
+	case 821:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4097
+//line sql.y:4303
 		{
 			yyVAL.joinCondition = &JoinCondition{}
 		}
-	case 791:
+	case 822:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4099
+//line sql.y:4305
 		{
 			yyVAL.joinCondition = &JoinCondition{On: yyDollar[2].exprUnion()}
 		}
-	case 792:
+	case 823:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4102
+//line sql.y:4308
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 793:
+	case 824:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4104
+//line sql.y:4310
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 794:
+	case 825:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4107
+//line sql.y:4313
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 795:
+	case 826:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4111
+//line sql.y:4317
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 796:
+	case 827:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4115
+//line sql.y:4321
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 798:
+	case 829:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4122
+//line sql.y:4328
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 799:
+	case 830:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4128
+//line sql.y:4334
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 800:
+	case 831:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4132
+//line sql.y:4338
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 801:
+	case 832:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4136
+//line sql.y:4342
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 802:
+	case 833:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4142
+//line sql.y:4348
 		{
 			yyLOCAL = StraightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 803:
+	case 834:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4148
+//line sql.y:4354
 		{
 			yyLOCAL = LeftJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 804:
+	case 835:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL JoinType
+//line sql.y:4358
+		{
+			yyLOCAL = LeftJoinType
+		}
+		yyVAL.union = yyLOCAL
+	case 836:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL JoinType
+//line sql.y:4362
+		{
+			yyLOCAL = RightJoinType
+		}
+		yyVAL.union = yyLOCAL
+	case 837:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL JoinType
+//line sql.y:4366
+		{
+			yyLOCAL = RightJoinType
+		}
+		yyVAL.union = yyLOCAL
+	case 838:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL JoinType
+//line sql.y:4372
+		{
+			yyLOCAL = NaturalJoinType
+		}
+		yyVAL.union = yyLOCAL
+	case 839:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL JoinType
+//line sql.y:4376
+		{
+			if yyDollar[2].joinTypeUnion() == LeftJoinType {
+				yyLOCAL = NaturalLeftJoinType
+			} else {
+				yyLOCAL = NaturalRightJoinType
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 840:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4386
+		{
+			yyVAL.tableName = yyDollar[2].tableName
+		}
+	case 841:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4390
+		{
+			yyVAL.tableName = yyDollar[1].tableName
+		}
+	case 842:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4396
+		{
+			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
+		}
+	case 843:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4400
+		{
+			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
+		}
+	case 844:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4406
+		{
+			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
+		}
+	case 845:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL IndexHints
+//line sql.y:4411
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 846:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL IndexHints
+//line sql.y:4415
+		{
+			yyLOCAL = yyDollar[1].indexHintsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 847:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL IndexHints
+//line sql.y:4421
+		{
+			yyLOCAL = IndexHints{yyDollar[1].indexHintUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 848:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4425
+		{
+			yySLICE := (*IndexHints)(yyIaddr(yyVAL.union))
+			*yySLICE = append(*yySLICE, yyDollar[2].indexHintUnion())
+		}
+	case 849:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *IndexHint
+//line sql.y:4431
+		{
+			yyLOCAL = &IndexHint{Type: UseOp, ForType: yyDollar[3].indexHintForTypeUnion(), Indexes: yyDollar[5].columnsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 850:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *IndexHint
+//line sql.y:4435
+		{
+			yyLOCAL = &IndexHint{Type: UseOp, ForType: yyDollar[3].indexHintForTypeUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 851:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *IndexHint
+//line sql.y:4439
+		{
+			yyLOCAL = &IndexHint{Type: IgnoreOp, ForType: yyDollar[3].indexHintForTypeUnion(), Indexes: yyDollar[5].columnsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 852:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *IndexHint
+//line sql.y:4443
+		{
+			yyLOCAL = &IndexHint{Type: ForceOp, ForType: yyDollar[3].indexHintForTypeUnion(), Indexes: yyDollar[5].columnsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 853:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL IndexHintForType
+//line sql.y:4448
+		{
+			yyLOCAL = NoForType
+		}
+		yyVAL.union = yyLOCAL
+	case 854:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL IndexHintForType
+//line sql.y:4452
+		{
+			yyLOCAL = JoinForType
+		}
+		yyVAL.union = yyLOCAL
+	case 855:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL IndexHintForType
+//line sql.y:4456
+		{
+			yyLOCAL = OrderByForType
+		}
+		yyVAL.union = yyLOCAL
+	case 856:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL IndexHintForType
+//line sql.y:4460
+		{
+			yyLOCAL = GroupByForType
+		}
+		yyVAL.union = yyLOCAL
+	case 857:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4466
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 858:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4470
+		{
+			yyLOCAL = yyDollar[2].exprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 859:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4477
+		{
+			yyLOCAL = &OrExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 860:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4481
+		{
+			yyLOCAL = &XorExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 861:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4485
+		{
+			yyLOCAL = &AndExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 862:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4489
+		{
+			yyLOCAL = &NotExpr{Expr: yyDollar[2].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 863:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4493
+		{
+			yyLOCAL = &IsExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].isExprOperatorUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 864:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4497
+		{
+			yyLOCAL = yyDollar[1].exprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 865:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4504
+		{
+			yyLOCAL = &IsExpr{Left: yyDollar[1].exprUnion(), Right: IsNullOp}
+		}
+		yyVAL.union = yyLOCAL
+	case 866:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4508
+		{
+			yyLOCAL = &IsExpr{Left: yyDollar[1].exprUnion(), Right: IsNotNullOp}
+		}
+		yyVAL.union = yyLOCAL
+	case 867:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4512
+		{
+			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: yyDollar[2].comparisonExprOperatorUnion(), Right: yyDollar[3].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 868:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4516
+		{
+			yyLOCAL = yyDollar[1].exprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 869:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4522
+		{
+			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: InOp, Right: yyDollar[3].colTupleUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 870:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4526
+		{
+			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotInOp, Right: yyDollar[4].colTupleUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 871:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4530
+		{
+			yyLOCAL = &BetweenExpr{Left: yyDollar[1].exprUnion(), IsBetween: true, From: yyDollar[3].exprUnion(), To: yyDollar[5].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 872:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4534
+		{
+			yyLOCAL = &BetweenExpr{Left: yyDollar[1].exprUnion(), IsBetween: false, From: yyDollar[4].exprUnion(), To: yyDollar[6].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 873:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL JoinType
-//line sql.y:4152
+		var yyLOCAL Expr
+//line sql.y:4538
 		{
-			yyLOCAL = LeftJoinType
+			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: LikeOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 805:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL JoinType
-//line sql.y:4156
+	case 874:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4542
 		{
-			yyLOCAL = RightJoinType
+			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotLikeOp, Right: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 806:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL JoinType
-//line sql.y:4160
+	case 875:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4546
 		{
-			yyLOCAL = RightJoinType
+			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: LikeOp, Right: yyDollar[3].exprUnion(), Escape: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 807:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL JoinType
-//line sql.y:4166
+	case 876:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4550
 		{
-			yyLOCAL = NaturalJoinType
+			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotLikeOp, Right: yyDollar[4].exprUnion(), Escape: yyDollar[6].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 808:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL JoinType
-//line sql.y:4170
+	case 877:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4554
 		{
-			if yyDollar[2].joinTypeUnion() == LeftJoinType {
-				yyLOCAL = NaturalLeftJoinType
-			} else {
-				yyLOCAL = NaturalRightJoinType
-			}
+			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: RegexpOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 809:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4180
+	case 878:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4558
 		{
-			yyVAL.tableName = yyDollar[2].tableName
+			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotRegexpOp, Right: yyDollar[4].exprUnion()}
 		}
-	case 810:
+		yyVAL.union = yyLOCAL
+	case 879:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4184
+		var yyLOCAL Expr
+//line sql.y:4562
 		{
-			yyVAL.tableName = yyDollar[1].tableName
+			yyLOCAL = yyDollar[1].exprUnion()
 		}
-	case 811:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4190
+		yyVAL.union = yyLOCAL
+	case 880:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4568
 		{
-			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
+			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitOrOp, Right: yyDollar[3].exprUnion()}
 		}
-	case 812:
+		yyVAL.union = yyLOCAL
+	case 881:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4194
+		var yyLOCAL Expr
+//line sql.y:4572
 		{
-			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
+			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitAndOp, Right: yyDollar[3].exprUnion()}
 		}
-	case 813:
+		yyVAL.union = yyLOCAL
+	case 882:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4200
+		var yyLOCAL Expr
+//line sql.y:4576
 		{
-			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
+			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ShiftLeftOp, Right: yyDollar[3].exprUnion()}
 		}
-	case 814:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		var yyLOCAL IndexHints
-//line sql.y:4205
+		yyVAL.union = yyLOCAL
+	case 883:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4580
 		{
-			yyLOCAL = nil
+			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ShiftRightOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 815:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL IndexHints
-//line sql.y:4209
+	case 884:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4584
 		{
-			yyLOCAL = yyDollar[1].indexHintsUnion()
+			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: PlusOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 816:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL IndexHints
-//line sql.y:4215
+	case 885:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4588
 		{
-			yyLOCAL = IndexHints{yyDollar[1].indexHintUnion()}
+			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: MinusOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 817:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4219
+	case 886:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4592
 		{
-			yySLICE := (*IndexHints)(yyIaddr(yyVAL.union))
-			*yySLICE = append(*yySLICE, yyDollar[2].indexHintUnion())
+			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: MultOp, Right: yyDollar[3].exprUnion()}
 		}
-	case 818:
-		yyDollar = yyS[yypt-6 : yypt+1]
-		var yyLOCAL *IndexHint
-//line sql.y:4225
+		yyVAL.union = yyLOCAL
+	case 887:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4596
 		{
-			yyLOCAL = &IndexHint{Type: UseOp, ForType: yyDollar[3].indexHintForTypeUnion(), Indexes: yyDollar[5].columnsUnion()}
+			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: DivOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 819:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		var yyLOCAL *IndexHint
-//line sql.y:4229
+	case 888:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4600
 		{
-			yyLOCAL = &IndexHint{Type: UseOp, ForType: yyDollar[3].indexHintForTypeUnion()}
+			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ModOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 820:
-		yyDollar = yyS[yypt-6 : yypt+1]
-		var yyLOCAL *IndexHint
-//line sql.y:4233
+	case 889:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4604
 		{
-			yyLOCAL = &IndexHint{Type: IgnoreOp, ForType: yyDollar[3].indexHintForTypeUnion(), Indexes: yyDollar[5].columnsUnion()}
+			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: IntDivOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 821:
-		yyDollar = yyS[yypt-6 : yypt+1]
-		var yyLOCAL *IndexHint
-//line sql.y:4237
+	case 890:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4608
 		{
-			yyLOCAL = &IndexHint{Type: ForceOp, ForType: yyDollar[3].indexHintForTypeUnion(), Indexes: yyDollar[5].columnsUnion()}
+			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ModOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 822:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		var yyLOCAL IndexHintForType
-//line sql.y:4242
+	case 891:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4612
 		{
-			yyLOCAL = NoForType
+			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitXorOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 823:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL IndexHintForType
-//line sql.y:4246
+	case 892:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4616
 		{
-			yyLOCAL = JoinForType
+			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 824:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL IndexHintForType
-//line sql.y:4250
+	case 893:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4622
 		{
-			yyLOCAL = OrderByForType
+			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 825:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL IndexHintForType
-//line sql.y:4254
+	case 894:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4626
 		{
-			yyLOCAL = GroupByForType
+			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 826:
-		yyDollar = yyS[yypt-0 : yypt+1]
+	case 895:
+		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4260
+//line sql.y:4630
 		{
-			yyLOCAL = nil
+			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 827:
-		yyDollar = yyS[yypt-2 : yypt+1]
+	case 896:
+		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4264
+//line sql.y:4634
 		{
-			yyLOCAL = yyDollar[2].exprUnion()
+			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 828:
-		yyDollar = yyS[yypt-3 : yypt+1]
+	case 897:
+		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4271
+//line sql.y:4638
 		{
-			yyLOCAL = &OrExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
+			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 829:
+	case 898:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4275
+//line sql.y:4642
 		{
-			yyLOCAL = &XorExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
+			yyLOCAL = &CollateExpr{Expr: yyDollar[1].exprUnion(), Collation: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 830:
-		yyDollar = yyS[yypt-3 : yypt+1]
+	case 899:
+		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4279
+//line sql.y:4646
 		{
-			yyLOCAL = &AndExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
+			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 831:
-		yyDollar = yyS[yypt-2 : yypt+1]
+	case 900:
+		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4283
+//line sql.y:4650
 		{
-			yyLOCAL = &NotExpr{Expr: yyDollar[2].exprUnion()}
+			yyLOCAL = yyDollar[1].colNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 832:
-		yyDollar = yyS[yypt-3 : yypt+1]
+	case 901:
+		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4287
+//line sql.y:4654
 		{
-			yyLOCAL = &IsExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].isExprOperatorUnion()}
+			yyLOCAL = yyDollar[2].exprUnion() // TODO: do we really want to ignore unary '+' before any kind of literals?
 		}
 		yyVAL.union = yyLOCAL
-	case 833:
-		yyDollar = yyS[yypt-1 : yypt+1]
+	case 902:
+		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4291
+//line sql.y:4658
 		{
-			yyLOCAL = yyDollar[1].exprUnion()
+			yyLOCAL = &UnaryExpr{Operator: UMinusOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 834:
-		yyDollar = yyS[yypt-3 : yypt+1]
+	case 903:
+		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4298
+//line sql.y:4662
 		{
-			yyLOCAL = &IsExpr{Left: yyDollar[1].exprUnion(), Right: IsNullOp}
+			yyLOCAL = &UnaryExpr{Operator: TildaOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 835:
-		yyDollar = yyS[yypt-4 : yypt+1]
+	case 904:
+		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4302
+//line sql.y:4666
 		{
-			yyLOCAL = &IsExpr{Left: yyDollar[1].exprUnion(), Right: IsNotNullOp}
+			yyLOCAL = &UnaryExpr{Operator: BangOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 836:
-		yyDollar = yyS[yypt-3 : yypt+1]
+	case 905:
+		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4306
+//line sql.y:4670
 		{
-			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: yyDollar[2].comparisonExprOperatorUnion(), Right: yyDollar[3].exprUnion()}
+			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 837:
+	case 906:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4310
+//line sql.y:4674
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 838:
-		yyDollar = yyS[yypt-3 : yypt+1]
+	case 907:
+		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4316
+//line sql.y:4678
 		{
-			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: InOp, Right: yyDollar[3].colTupleUnion()}
+			yyLOCAL = &ExistsExpr{Subquery: yyDollar[2].subqueryUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 839:
-		yyDollar = yyS[yypt-4 : yypt+1]
+	case 908:
+		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4320
+//line sql.y:4682
 		{
-			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotInOp, Right: yyDollar[4].colTupleUnion()}
+			yyLOCAL = &MatchExpr{Columns: yyDollar[3].selectExprsUnion(), Expr: yyDollar[7].exprUnion(), Option: yyDollar[8].matchExprOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 840:
-		yyDollar = yyS[yypt-5 : yypt+1]
+	case 909:
+		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4324
+//line sql.y:4686
 		{
-			yyLOCAL = &BetweenExpr{Left: yyDollar[1].exprUnion(), IsBetween: true, From: yyDollar[3].exprUnion(), To: yyDollar[5].exprUnion()}
+			yyLOCAL = &ConvertExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].convertTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 841:
+	case 910:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4328
+//line sql.y:4690
 		{
-			yyLOCAL = &BetweenExpr{Left: yyDollar[1].exprUnion(), IsBetween: false, From: yyDollar[4].exprUnion(), To: yyDollar[6].exprUnion()}
+			yyLOCAL = &ConvertExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].convertTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 842:
-		yyDollar = yyS[yypt-3 : yypt+1]
+	case 911:
+		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4332
+//line sql.y:4694
 		{
-			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: LikeOp, Right: yyDollar[3].exprUnion()}
+			yyLOCAL = &ConvertUsingExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 843:
-		yyDollar = yyS[yypt-4 : yypt+1]
+	case 912:
+		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4336
+//line sql.y:4698
 		{
-			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotLikeOp, Right: yyDollar[4].exprUnion()}
+			// From: https://dev.mysql.com/doc/refman/8.0/en/cast-functions.html#operator_binary
+			// To convert a string expression to a binary string, these constructs are equivalent:
+			//    CAST(expr AS BINARY)
+			//    BINARY expr
+			yyLOCAL = &ConvertExpr{Expr: yyDollar[2].exprUnion(), Type: &ConvertType{Type: yyDollar[1].str}}
 		}
 		yyVAL.union = yyLOCAL
-	case 844:
-		yyDollar = yyS[yypt-5 : yypt+1]
+	case 913:
+		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4340
+//line sql.y:4706
 		{
-			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: LikeOp, Right: yyDollar[3].exprUnion(), Escape: yyDollar[5].exprUnion()}
+			yyLOCAL = &Default{ColName: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 845:
-		yyDollar = yyS[yypt-6 : yypt+1]
+	case 914:
+		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4344
+//line sql.y:4710
 		{
-			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotLikeOp, Right: yyDollar[4].exprUnion(), Escape: yyDollar[6].exprUnion()}
+			// This rule prevents the usage of INTERVAL
+			// as a function. If support is needed for that,
+			// we'll need to revisit this. The solution
+			// will be non-trivial because of grammar conflicts.
+			yyLOCAL = &IntervalExpr{Expr: yyDollar[2].exprUnion(), Unit: yyDollar[3].colIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
-	case 846:
+	case 915:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4348
+//line sql.y:4718
 		{
-			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: RegexpOp, Right: yyDollar[3].exprUnion()}
+			yyLOCAL = &BinaryExpr{Left: yyDollar[1].colNameUnion(), Operator: JSONExtractOp, Right: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 847:
-		yyDollar = yyS[yypt-4 : yypt+1]
+	case 916:
+		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4352
+//line sql.y:4722
 		{
-			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotRegexpOp, Right: yyDollar[4].exprUnion()}
+			yyLOCAL = &BinaryExpr{Left: yyDollar[1].colNameUnion(), Operator: JSONUnquoteExtractOp, Right: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 848:
+	case 917:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:4356
+		var yyLOCAL TrimType
+//line sql.y:4728
 		{
-			yyLOCAL = yyDollar[1].exprUnion()
+			yyLOCAL = BothTrimType
 		}
 		yyVAL.union = yyLOCAL
-	case 849:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:4362
+	case 918:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL TrimType
+//line sql.y:4732
 		{
-			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitOrOp, Right: yyDollar[3].exprUnion()}
+			yyLOCAL = LeadingTrimType
 		}
 		yyVAL.union = yyLOCAL
-	case 850:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:4366
+	case 919:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL TrimType
+//line sql.y:4736
 		{
-			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitAndOp, Right: yyDollar[3].exprUnion()}
+			yyLOCAL = TrailingTrimType
 		}
 		yyVAL.union = yyLOCAL
-	case 851:
+	case 920:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4742
+		{
+			yyVAL.str = ""
+		}
+	case 921:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:4370
+//line sql.y:4746
 		{
-			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ShiftLeftOp, Right: yyDollar[3].exprUnion()}
+			yyVAL.str = string(yyDollar[2].colIdent.String())
+		}
+	case 922:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL BoolVal
+//line sql.y:4752
+		{
+			yyLOCAL = BoolVal(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 852:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:4374
+	case 923:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL BoolVal
+//line sql.y:4756
 		{
-			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ShiftRightOp, Right: yyDollar[3].exprUnion()}
+			yyLOCAL = BoolVal(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 853:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:4378
+	case 924:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL IsExprOperator
+//line sql.y:4763
 		{
-			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: PlusOp, Right: yyDollar[3].exprUnion()}
+			yyLOCAL = IsTrueOp
 		}
 		yyVAL.union = yyLOCAL
-	case 854:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:4382
+	case 925:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL IsExprOperator
+//line sql.y:4767
 		{
-			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: MinusOp, Right: yyDollar[3].exprUnion()}
+			yyLOCAL = IsNotTrueOp
 		}
 		yyVAL.union = yyLOCAL
-	case 855:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:4386
+	case 926:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL IsExprOperator
+//line sql.y:4771
 		{
-			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: MultOp, Right: yyDollar[3].exprUnion()}
+			yyLOCAL = IsFalseOp
 		}
 		yyVAL.union = yyLOCAL
-	case 856:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:4390
+	case 927:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL IsExprOperator
+//line sql.y:4775
 		{
-			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: DivOp, Right: yyDollar[3].exprUnion()}
+			yyLOCAL = IsNotFalseOp
 		}
 		yyVAL.union = yyLOCAL
-	case 857:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:4394
+	case 928:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL ComparisonExprOperator
+//line sql.y:4781
 		{
-			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ModOp, Right: yyDollar[3].exprUnion()}
+			yyLOCAL = EqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 858:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:4398
+	case 929:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL ComparisonExprOperator
+//line sql.y:4785
 		{
-			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: IntDivOp, Right: yyDollar[3].exprUnion()}
+			yyLOCAL = LessThanOp
 		}
 		yyVAL.union = yyLOCAL
-	case 859:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:4402
+	case 930:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL ComparisonExprOperator
+//line sql.y:4789
 		{
-			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ModOp, Right: yyDollar[3].exprUnion()}
+			yyLOCAL = GreaterThanOp
 		}
 		yyVAL.union = yyLOCAL
-	case 860:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:4406
+	case 931:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL ComparisonExprOperator
+//line sql.y:4793
 		{
-			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitXorOp, Right: yyDollar[3].exprUnion()}
+			yyLOCAL = LessEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 861:
+	case 932:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:4410
+		var yyLOCAL ComparisonExprOperator
+//line sql.y:4797
 		{
-			yyLOCAL = yyDollar[1].exprUnion()
+			yyLOCAL = GreaterEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 862:
+	case 933:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:4416
+		var yyLOCAL ComparisonExprOperator
+//line sql.y:4801
 		{
-			yyLOCAL = yyDollar[1].exprUnion()
+			yyLOCAL = NotEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 863:
+	case 934:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:4420
+		var yyLOCAL ComparisonExprOperator
+//line sql.y:4805
 		{
-			yyLOCAL = yyDollar[1].exprUnion()
+			yyLOCAL = NullSafeEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 864:
+	case 935:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:4424
+		var yyLOCAL ColTuple
+//line sql.y:4811
 		{
-			yyLOCAL = yyDollar[1].exprUnion()
+			yyLOCAL = yyDollar[1].valTupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 865:
+	case 936:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:4428
+		var yyLOCAL ColTuple
+//line sql.y:4815
 		{
-			yyLOCAL = yyDollar[1].exprUnion()
+			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 866:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:4432
+	case 937:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL ColTuple
+//line sql.y:4819
 		{
-			yyLOCAL = &CollateExpr{Expr: yyDollar[1].exprUnion(), Collation: yyDollar[3].str}
+			yyLOCAL = ListArg(yyDollar[1].str[2:])
+			bindVariable(yylex, yyDollar[1].str[2:])
 		}
 		yyVAL.union = yyLOCAL
-	case 867:
+	case 938:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:4436
+		var yyLOCAL *Subquery
+//line sql.y:4826
 		{
-			yyLOCAL = yyDollar[1].exprUnion()
+			yyLOCAL = &Subquery{yyDollar[1].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 868:
+	case 939:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:4440
+		var yyLOCAL Exprs
+//line sql.y:4832
 		{
-			yyLOCAL = yyDollar[1].colNameUnion()
+			yyLOCAL = Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 869:
-		yyDollar = yyS[yypt-2 : yypt+1]
+	case 940:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4836
+		{
+			yySLICE := (*Exprs)(yyIaddr(yyVAL.union))
+			*yySLICE = append(*yySLICE, yyDollar[3].exprUnion())
+		}
+	case 941:
+		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4444
+//line sql.y:4846
 		{
-			yyLOCAL = yyDollar[2].exprUnion() // TODO: do we really want to ignore unary '+' before any kind of literals?
+			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprsUnion()}
 		}
-		yyVAL.union = yyLOCAL
-	case 870:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyVAL.union = yyLOCAL
+	case 942:
+		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4448
+//line sql.y:4850
 		{
-			yyLOCAL = &UnaryExpr{Operator: UMinusOp, Expr: yyDollar[2].exprUnion()}
+			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 871:
-		yyDollar = yyS[yypt-2 : yypt+1]
+	case 943:
+		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4452
+//line sql.y:4854
 		{
-			yyLOCAL = &UnaryExpr{Operator: TildaOp, Expr: yyDollar[2].exprUnion()}
+			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 872:
-		yyDollar = yyS[yypt-2 : yypt+1]
+	case 944:
+		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4456
+//line sql.y:4858
 		{
-			yyLOCAL = &UnaryExpr{Operator: BangOp, Expr: yyDollar[2].exprUnion()}
+			yyLOCAL = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 873:
-		yyDollar = yyS[yypt-1 : yypt+1]
+	case 945:
+		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4460
+//line sql.y:4862
 		{
-			yyLOCAL = yyDollar[1].subqueryUnion()
+			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprsUnion(), Over: yyDollar[5].overClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 874:
-		yyDollar = yyS[yypt-1 : yypt+1]
+	case 946:
+		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4464
+//line sql.y:4872
 		{
-			yyLOCAL = yyDollar[1].exprUnion()
+			yyLOCAL = &WindowFuncExpr{Type: RowNumberType, Over: yyDollar[4].overClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 875:
-		yyDollar = yyS[yypt-2 : yypt+1]
+	case 947:
+		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4468
+//line sql.y:4876
 		{
-			yyLOCAL = &ExistsExpr{Subquery: yyDollar[2].subqueryUnion()}
+			yyLOCAL = &WindowFuncExpr{Type: RankType, Over: yyDollar[4].overClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 876:
-		yyDollar = yyS[yypt-9 : yypt+1]
+	case 948:
+		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4472
+//line sql.y:4880
 		{
-			yyLOCAL = &MatchExpr{Columns: yyDollar[3].selectExprsUnion(), Expr: yyDollar[7].exprUnion(), Option: yyDollar[8].matchExprOptionUnion()}
+			yyLOCAL = &WindowFuncExpr{Type: DenseRankType, Over: yyDollar[4].overClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 877:
-		yyDollar = yyS[yypt-6 : yypt+1]
+	case 949:
+		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4476
+//line sql.y:4884
 		{
-			yyLOCAL = &ConvertExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].convertTypeUnion()}
+			yyLOCAL = &WindowFuncExpr{Type: PercentRankType, Over: yyDollar[4].overClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 878:
-		yyDollar = yyS[yypt-6 : yypt+1]
+	case 950:
+		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4480
+//line sql.y:4888
 		{
-			yyLOCAL = &ConvertExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].convertTypeUnion()}
+			yyLOCAL = &WindowFuncExpr{Type: CumeDistType, Over: yyDollar[4].overClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 879:
-		yyDollar = yyS[yypt-6 : yypt+1]
+	case 951:
+		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4484
+//line sql.y:4892
 		{
-			yyLOCAL = &ConvertUsingExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].str}
+			yyLOCAL = &WindowFuncExpr{Type: NtileType, Exprs: Exprs{yyDollar[3].exprUnion()}, Over: yyDollar[5].overClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 880:
-		yyDollar = yyS[yypt-2 : yypt+1]
+	case 952:
+		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4488
+//line sql.y:4896
 		{
-			// From: https://dev.mysql.com/doc/refman/8.0/en/cast-functions.html#operator_binary
-			// To convert a string expression to a binary string, these constructs are equivalent:
-			//    CAST(expr AS BINARY)
-			//    BINARY expr
-			yyLOCAL = &ConvertExpr{Expr: yyDollar[2].exprUnion(), Type: &ConvertType{Type: yyDollar[1].str}}
+			if len(yyDollar[3].exprsUnion()) > 3 {
+				yylex.Error("incorrect parameter count in the call to native function 'lag'")
+				return 1
+			}
+			yyLOCAL = &WindowFuncExpr{Type: LagType, Exprs: yyDollar[3].exprsUnion(), Over: yyDollar[5].overClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 881:
-		yyDollar = yyS[yypt-2 : yypt+1]
+	case 953:
+		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4496
+//line sql.y:4904
 		{
-			yyLOCAL = &Default{ColName: yyDollar[2].str}
+			if len(yyDollar[3].exprsUnion()) > 3 {
+				yylex.Error("incorrect parameter count in the call to native function 'lead'")
+				return 1
+			}
+			yyLOCAL = &WindowFuncExpr{Type: LeadType, Exprs: yyDollar[3].exprsUnion(), Over: yyDollar[5].overClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 882:
-		yyDollar = yyS[yypt-3 : yypt+1]
+	case 954:
+		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4500
+//line sql.y:4912
 		{
-			// This rule prevents the usage of INTERVAL
-			// as a function. If support is needed for that,
-			// we'll need to revisit this. The solution
-			// will be non-trivial because of grammar conflicts.
-			yyLOCAL = &IntervalExpr{Expr: yyDollar[2].exprUnion(), Unit: yyDollar[3].colIdent.String()}
+			yyLOCAL = &WindowFuncExpr{Type: FirstValueType, Exprs: Exprs{yyDollar[3].exprUnion()}, Over: yyDollar[5].overClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 883:
-		yyDollar = yyS[yypt-3 : yypt+1]
+	case 955:
+		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4508
+//line sql.y:4916
 		{
-			yyLOCAL = &BinaryExpr{Left: yyDollar[1].colNameUnion(), Operator: JSONExtractOp, Right: NewStrLiteral(yyDollar[3].str)}
+			yyLOCAL = &WindowFuncExpr{Type: LastValueType, Exprs: Exprs{yyDollar[3].exprUnion()}, Over: yyDollar[5].overClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 884:
-		yyDollar = yyS[yypt-3 : yypt+1]
+	case 956:
+		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4512
+//line sql.y:4920
 		{
-			yyLOCAL = &BinaryExpr{Left: yyDollar[1].colNameUnion(), Operator: JSONUnquoteExtractOp, Right: NewStrLiteral(yyDollar[3].str)}
+			yyLOCAL = &WindowFuncExpr{Type: NthValueType, Exprs: Exprs{yyDollar[3].exprUnion(), yyDollar[5].exprUnion()}, Over: yyDollar[7].overClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 885:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL TrimType
-//line sql.y:4518
+	case 957:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *OverClause
+//line sql.y:4926
 		{
-			yyLOCAL = BothTrimType
+			yyLOCAL = &OverClause{WindowSpec: yyDollar[3].windowSpecUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 886:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL TrimType
-//line sql.y:4522
+	case 958:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *OverClause
+//line sql.y:4930
 		{
-			yyLOCAL = LeadingTrimType
+			yyLOCAL = &OverClause{WindowName: yyDollar[2].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 887:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL TrimType
-//line sql.y:4526
+	case 959:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *WindowSpecification
+//line sql.y:4936
 		{
-			yyLOCAL = TrailingTrimType
+			yyLOCAL = &WindowSpecification{Name: yyDollar[1].colIdent, PartitionClause: yyDollar[2].exprsUnion(), OrderClause: yyDollar[3].orderByUnion(), FrameClause: yyDollar[4].frameClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 888:
+	case 960:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4532
-		{
-			yyVAL.str = ""
-		}
-	case 889:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4536
-		{
-			yyVAL.str = string(yyDollar[2].colIdent.String())
-		}
-	case 890:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL BoolVal
-//line sql.y:4542
+//line sql.y:4942
 		{
-			yyLOCAL = BoolVal(true)
+			yyVAL.colIdent = ColIdent{}
 		}
-		yyVAL.union = yyLOCAL
-	case 891:
+	case 961:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL BoolVal
-//line sql.y:4546
+//line sql.y:4946
 		{
-			yyLOCAL = BoolVal(false)
+			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-		yyVAL.union = yyLOCAL
-	case 892:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL IsExprOperator
-//line sql.y:4553
+	case 962:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL Exprs
+//line sql.y:4951
 		{
-			yyLOCAL = IsTrueOp
+			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 893:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL IsExprOperator
-//line sql.y:4557
+	case 963:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Exprs
+//line sql.y:4955
 		{
-			yyLOCAL = IsNotTrueOp
+			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 894:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL IsExprOperator
-//line sql.y:4561
+	case 964:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *FrameClause
+//line sql.y:4960
 		{
-			yyLOCAL = IsFalseOp
+			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 895:
+	case 965:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL IsExprOperator
-//line sql.y:4565
+		var yyLOCAL *FrameClause
+//line sql.y:4964
 		{
-			yyLOCAL = IsNotFalseOp
+			yyLOCAL = &FrameClause{Unit: yyDollar[1].frameUnitTypeUnion(), Start: yyDollar[2].framePointUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 896:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL ComparisonExprOperator
-//line sql.y:4571
+	case 966:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *FrameClause
+//line sql.y:4968
 		{
-			yyLOCAL = EqualOp
+			yyLOCAL = &FrameClause{Unit: yyDollar[1].frameUnitTypeUnion(), Start: yyDollar[3].framePointUnion(), End: yyDollar[5].framePointUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 897:
+	case 967:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL ComparisonExprOperator
-//line sql.y:4575
+		var yyLOCAL FrameUnitType
+//line sql.y:4974
 		{
-			yyLOCAL = LessThanOp
+			yyLOCAL = RowsFrameUnit
 		}
 		yyVAL.union = yyLOCAL
-	case 898:
+	case 968:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL ComparisonExprOperator
-//line sql.y:4579
+		var yyLOCAL FrameUnitType
+//line sql.y:4978
 		{
-			yyLOCAL = GreaterThanOp
+			yyLOCAL = RangeFrameUnit
 		}
 		yyVAL.union = yyLOCAL
-	case 899:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL ComparisonExprOperator
-//line sql.y:4583
+	case 969:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:4984
 		{
-			yyLOCAL = LessEqualOp
+			yyLOCAL = &FramePoint{Type: CurrentRowType}
 		}
 		yyVAL.union = yyLOCAL
-	case 900:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL ComparisonExprOperator
-//line sql.y:4587
+	case 970:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:4988
 		{
-			yyLOCAL = GreaterEqualOp
+			yyLOCAL = &FramePoint{Type: UnboundedPrecedingType}
 		}
 		yyVAL.union = yyLOCAL
-	case 901:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL ComparisonExprOperator
-//line sql.y:4591
+	case 971:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:4992
 		{
-			yyLOCAL = NotEqualOp
+			yyLOCAL = &FramePoint{Type: UnboundedFollowingType}
 		}
 		yyVAL.union = yyLOCAL
-	case 902:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL ComparisonExprOperator
-//line sql.y:4595
+	case 972:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:4996
 		{
-			yyLOCAL = NullSafeEqualOp
+			yyLOCAL = &FramePoint{Type: ExprPrecedingType, Expr: yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 903:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL ColTuple
-//line sql.y:4601
+	case 973:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:5000
 		{
-			yyLOCAL = yyDollar[1].valTupleUnion()
+			yyLOCAL = &FramePoint{Type: ExprFollowingType, Expr: yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 904:
+	case 974:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL ColTuple
-//line sql.y:4605
+		var yyLOCAL Expr
+//line sql.y:5008
 		{
-			yyLOCAL = yyDollar[1].subqueryUnion()
+			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 905:
+	case 975:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL ColTuple
-//line sql.y:4609
+		var yyLOCAL Expr
+//line sql.y:5012
 		{
-			yyLOCAL = ListArg(yyDollar[1].str[2:])
-			bindVariable(yylex, yyDollar[1].str[2:])
+			yyLOCAL = NewArgument(yyDollar[1].str[1:])
+			bindVariable(yylex, yyDollar[1].str[1:])
 		}
 		yyVAL.union = yyLOCAL
-	case 906:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL *Subquery
-//line sql.y:4616
+	case 976:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:5017
 		{
-			yyLOCAL = &Subquery{yyDollar[1].selStmtUnion()}
+			yyLOCAL = &IntervalExpr{Expr: yyDollar[2].exprUnion(), Unit: yyDollar[3].colIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
-	case 907:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL Exprs
-//line sql.y:4622
+	case 977:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL WindowDefinitions
+//line sql.y:5022
 		{
-			yyLOCAL = Exprs{yyDollar[1].exprUnion()}
+			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 908:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4626
+	case 978:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL WindowDefinitions
+//line sql.y:5026
 		{
-			yySLICE := (*Exprs)(yyIaddr(yyVAL.union))
-			*yySLICE = append(*yySLICE, yyDollar[3].exprUnion())
+			yyLOCAL = yyDollar[2].windowDefinitionsUnion()
 		}
-	case 909:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:4636
+		yyVAL.union = yyLOCAL
+	case 979:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL WindowDefinitions
+//line sql.y:5032
 		{
-			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprsUnion()}
+			yyLOCAL = WindowDefinitions{yyDollar[1].windowDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 910:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:4640
+	case 980:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5036
 		{
-			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
+			yySLICE := (*WindowDefinitions)(yyIaddr(yyVAL.union))
+			*yySLICE = append(*yySLICE, yyDollar[3].windowDefinitionUnion())
 		}
-		yyVAL.union = yyLOCAL
-	case 911:
+	case 981:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:4644
+		var yyLOCAL *WindowDefinition
+//line sql.y:5042
 		{
-			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
+			yyLOCAL = &WindowDefinition{Name: yyDollar[1].colIdent, WindowSpec: yyDollar[4].windowSpecUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 912:
-		yyDollar = yyS[yypt-6 : yypt+1]
+	case 982:
+		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4648
+//line sql.y:5052
 		{
-			yyLOCAL = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprsUnion()}
+			yyLOCAL = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 913:
+	case 983:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4658
+//line sql.y:5056
 		{
-			yyLOCAL = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprsUnion()}
+			yyLOCAL = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 914:
+	case 984:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4662
+//line sql.y:5060
 		{
-			yyLOCAL = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprsUnion()}
+			yyLOCAL = &FuncExpr{Name: NewColIdent("row"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 915:
+	case 985:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4666
+//line sql.y:5064
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].exprUnion(), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 916:
+	case 986:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4670
+//line sql.y:5068
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].exprUnion(), From: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 917:
+	case 987:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4674
+//line sql.y:5072
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].exprUnion(), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 918:
+	case 988:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4678
+//line sql.y:5076
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].exprUnion(), From: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 919:
+	case 989:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4682
+//line sql.y:5080
 		{
 			yyLOCAL = &GroupConcatExpr{Distinct: yyDollar[3].booleanUnion(), Exprs: yyDollar[4].selectExprsUnion(), OrderBy: yyDollar[5].orderByUnion(), Separator: yyDollar[6].str, Limit: yyDollar[7].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 920:
+	case 990:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4686
+//line sql.y:5084
 		{
 			yyLOCAL = &CaseExpr{Expr: yyDollar[2].exprUnion(), Whens: yyDollar[3].whensUnion(), Else: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 921:
+	case 991:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4690
+//line sql.y:5088
 		{
 			yyLOCAL = &ValuesFuncExpr{Name: yyDollar[3].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 922:
+	case 992:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4694
+//line sql.y:5092
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 923:
+	case 993:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4705
+//line sql.y:5103
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
 		yyVAL.union = yyLOCAL
-	case 924:
+	case 994:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4709
+//line sql.y:5107
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 925:
+	case 995:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4715
+//line sql.y:5113
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("current_date")}
 		}
 		yyVAL.union = yyLOCAL
-	case 926:
+	case 996:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4719
+//line sql.y:5117
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 927:
+	case 997:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4724
+//line sql.y:5122
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 928:
+	case 998:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4728
+//line sql.y:5126
 		{
 			yyLOCAL = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].exprUnion(), Expr2: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 929:
+	case 999:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4732
+//line sql.y:5130
 		{
 			yyLOCAL = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].exprUnion(), Expr2: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 930:
+	case 1000:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4736
+//line sql.y:5134
 		{
 			yyLOCAL = &ExtractFuncExpr{IntervalTypes: yyDollar[3].intervalTypeUnion(), Expr: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 931:
+	case 1001:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4740
+//line sql.y:5138
 		{
 			yyLOCAL = &WeightStringFuncExpr{Expr: yyDollar[3].exprUnion(), As: yyDollar[4].convertTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 932:
+	case 1002:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4744
+//line sql.y:5142
 		{
 			yyLOCAL = &TrimFuncExpr{TrimFuncType: LTrimType, StringArg: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 933:
+	case 1003:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4748
+//line sql.y:5146
 		{
 			yyLOCAL = &TrimFuncExpr{TrimFuncType: RTrimType, StringArg: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 934:
+	case 1004:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4752
+//line sql.y:5150
 		{
 			yyLOCAL = &TrimFuncExpr{Type: yyDollar[3].trimTypeUnion(), TrimArg: yyDollar[4].exprUnion(), StringArg: yyDollar[6].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 935:
+	case 1005:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4756
+//line sql.y:5154
 		{
 			yyLOCAL = &TrimFuncExpr{StringArg: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 936:
+	case 1006:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4760
+//line sql.y:5158
 		{
 			yyLOCAL = &TrimFuncExpr{TrimArg: yyDollar[3].exprUnion(), StringArg: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 937:
+	case 1007:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4766
+//line sql.y:5164
 		{
 		}
-	case 938:
+	case 1008:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4768
+//line sql.y:5166
 		{
 			yyLOCAL = IntervalDayHour
 		}
 		yyVAL.union = yyLOCAL
-	case 939:
+	case 1009:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4772
+//line sql.y:5170
 		{
 			yyLOCAL = IntervalDayMicrosecond
 		}
 		yyVAL.union = yyLOCAL
-	case 940:
+	case 1010:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4776
+//line sql.y:5174
 		{
 			yyLOCAL = IntervalDayMinute
 		}
 		yyVAL.union = yyLOCAL
-	case 941:
+	case 1011:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4780
+//line sql.y:5178
 		{
 			yyLOCAL = IntervalDaySecond
 		}
 		yyVAL.union = yyLOCAL
-	case 942:
+	case 1012:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4784
+//line sql.y:5182
 		{
 			yyLOCAL = IntervalHourMicrosecond
 		}
 		yyVAL.union = yyLOCAL
-	case 943:
+	case 1013:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4788
+//line sql.y:5186
 		{
 			yyLOCAL = IntervalHourMinute
 		}
 		yyVAL.union = yyLOCAL
-	case 944:
+	case 1014:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4792
+//line sql.y:5190
 		{
 			yyLOCAL = IntervalHourSecond
 		}
 		yyVAL.union = yyLOCAL
-	case 945:
+	case 1015:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4796
+//line sql.y:5194
 		{
 			yyLOCAL = IntervalMinuteMicrosecond
 		}
 		yyVAL.union = yyLOCAL
-	case 946:
+	case 1016:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4800
+//line sql.y:5198
 		{
 			yyLOCAL = IntervalMinuteSecond
 		}
 		yyVAL.union = yyLOCAL
-	case 947:
+	case 1017:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4804
+//line sql.y:5202
 		{
 			yyLOCAL = IntervalSecondMicrosecond
 		}
 		yyVAL.union = yyLOCAL
-	case 948:
+	case 1018:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4808
+//line sql.y:5206
 		{
 			yyLOCAL = IntervalYearMonth
 		}
 		yyVAL.union = yyLOCAL
-	case 949:
+	case 1019:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4814
+//line sql.y:5212
 		{
 			yyLOCAL = IntervalDay
 		}
 		yyVAL.union = yyLOCAL
-	case 950:
+	case 1020:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4818
+//line sql.y:5216
 		{
 			yyLOCAL = IntervalWeek
 		}
 		yyVAL.union = yyLOCAL
-	case 951:
+	case 1021:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4822
+//line sql.y:5220
 		{
 			yyLOCAL = IntervalHour
 		}
 		yyVAL.union = yyLOCAL
-	case 952:
+	case 1022:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4826
+//line sql.y:5224
 		{
 			yyLOCAL = IntervalMinute
 		}
 		yyVAL.union = yyLOCAL
-	case 953:
+	case 1023:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4830
+//line sql.y:5228
 		{
 			yyLOCAL = IntervalMonth
 		}
 		yyVAL.union = yyLOCAL
-	case 954:
+	case 1024:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4834
+//line sql.y:5232
 		{
 			yyLOCAL = IntervalQuarter
 		}
 		yyVAL.union = yyLOCAL
-	case 955:
+	case 1025:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4838
+//line sql.y:5236
 		{
 			yyLOCAL = IntervalSecond
 		}
 		yyVAL.union = yyLOCAL
-	case 956:
+	case 1026:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4842
+//line sql.y:5240
 		{
 			yyLOCAL = IntervalMicrosecond
 		}
 		yyVAL.union = yyLOCAL
-	case 957:
+	case 1027:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4846
+//line sql.y:5244
 		{
 			yyLOCAL = IntervalYear
 		}
 		yyVAL.union = yyLOCAL
-	case 960:
+	case 1030:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:4856
+//line sql.y:5254
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 961:
+	case 1031:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:4860
+//line sql.y:5258
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 962:
+	case 1032:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:4864
+//line sql.y:5262
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 963:
+	case 1033:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4874
+//line sql.y:5272
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 964:
+	case 1034:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4878
+//line sql.y:5276
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 965:
+	case 1035:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4882
+//line sql.y:5280
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("schema"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 966:
+	case 1036:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4886
+//line sql.y:5284
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 967:
+	case 1037:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4890
+//line sql.y:5288
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 968:
+	case 1038:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4896
+//line sql.y:5294
 		{
 			yyLOCAL = NoOption
 		}
 		yyVAL.union = yyLOCAL
-	case 969:
+	case 1039:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4900
+//line sql.y:5298
 		{
 			yyLOCAL = BooleanModeOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 970:
+	case 1040:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4904
+//line sql.y:5302
 		{
 			yyLOCAL = NaturalLanguageModeOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 971:
+	case 1041:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4908
+//line sql.y:5306
 		{
 			yyLOCAL = NaturalLanguageModeWithQueryExpansionOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 972:
+	case 1042:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4912
+//line sql.y:5310
 		{
 			yyLOCAL = QueryExpansionOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 973:
+	case 1043:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4918
+//line sql.y:5316
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 974:
+	case 1044:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4922
+//line sql.y:5320
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 975:
+	case 1045:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4926
+//line sql.y:5324
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 976:
+	case 1046:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4932
+//line sql.y:5330
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 977:
+	case 1047:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4936
+//line sql.y:5334
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[2].str), Length: NewIntLiteral(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 978:
+	case 1048:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4940
+//line sql.y:5338
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[2].str), Length: NewIntLiteral(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 979:
+	case 1049:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4946
+//line sql.y:5344
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 980:
+	case 1050:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4950
+//line sql.y:5348
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 981:
+	case 1051:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4954
+//line sql.y:5352
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 982:
+	case 1052:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4958
+//line sql.y:5356
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 983:
+	case 1053:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4962
+//line sql.y:5360
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 			yyLOCAL.Length = yyDollar[2].LengthScaleOption.Length
 			yyLOCAL.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 		yyVAL.union = yyLOCAL
-	case 984:
+	case 1054:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4968
+//line sql.y:5366
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 985:
+	case 1055:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4972
+//line sql.y:5370
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 986:
+	case 1056:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4976
+//line sql.y:5374
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 987:
+	case 1057:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4980
+//line sql.y:5378
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 988:
+	case 1058:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4984
+//line sql.y:5382
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 989:
+	case 1059:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4988
+//line sql.y:5386
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 990:
+	case 1060:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4992
+//line sql.y:5390
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 991:
+	case 1061:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4996
+//line sql.y:5394
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 992:
+	case 1062:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:5000
+//line sql.y:5398
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 993:
+	case 1063:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:5004
+//line sql.y:5402
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 994:
+	case 1064:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5010
+//line sql.y:5408
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 995:
+	case 1065:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5014
+//line sql.y:5412
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 996:
+	case 1066:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5019
+//line sql.y:5417
 		{
 			yyVAL.str = string("")
 		}
-	case 997:
+	case 1067:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5023
+//line sql.y:5421
 		{
 			yyVAL.str = " separator " + encodeSQLString(yyDollar[2].str)
 		}
-	case 998:
+	case 1068:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*When
-//line sql.y:5029
+//line sql.y:5427
 		{
 			yyLOCAL = []*When{yyDollar[1].whenUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 999:
+	case 1069:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5033
+//line sql.y:5431
 		{
 			yySLICE := (*[]*When)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].whenUnion())
 		}
-	case 1000:
+	case 1070:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *When
-//line sql.y:5039
+//line sql.y:5437
 		{
 			yyLOCAL = &When{Cond: yyDollar[2].exprUnion(), Val: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1001:
+	case 1071:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5044
+//line sql.y:5442
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1002:
+	case 1072:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5048
+//line sql.y:5446
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1003:
+	case 1073:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:5054
+//line sql.y:5452
 		{
 			yyLOCAL = &ColName{Name: yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 1004:
+	case 1074:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:5058
+//line sql.y:5456
 		{
 			yyLOCAL = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 1005:
+	case 1075:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:5062
+//line sql.y:5460
 		{
 			yyLOCAL = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 1006:
+	case 1076:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5068
+//line sql.y:5466
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -14358,380 +15376,380 @@ yydefault:
 			yyLOCAL = NewIntLiteral("1")
 		}
 		yyVAL.union = yyLOCAL
-	case 1007:
+	case 1077:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5077
+//line sql.y:5475
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1008:
+	case 1078:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5081
+//line sql.y:5479
 		{
 			yyLOCAL = NewArgument(yyDollar[1].str[1:])
 			bindVariable(yylex, yyDollar[1].str[1:])
 		}
 		yyVAL.union = yyLOCAL
-	case 1009:
+	case 1079:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:5087
+//line sql.y:5485
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1010:
+	case 1080:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:5091
+//line sql.y:5489
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1011:
+	case 1081:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5096
+//line sql.y:5494
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1012:
+	case 1082:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5100
+//line sql.y:5498
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1013:
+	case 1083:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:5105
+//line sql.y:5503
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1014:
+	case 1084:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:5109
+//line sql.y:5507
 		{
 			yyLOCAL = yyDollar[1].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1015:
+	case 1085:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:5115
+//line sql.y:5513
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1016:
+	case 1086:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:5121
+//line sql.y:5519
 		{
 			yyLOCAL = OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1017:
+	case 1087:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5125
+//line sql.y:5523
 		{
 			yySLICE := (*OrderBy)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].orderUnion())
 		}
-	case 1018:
+	case 1088:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Order
-//line sql.y:5131
+//line sql.y:5529
 		{
 			yyLOCAL = &Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].orderDirectionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1019:
+	case 1089:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:5136
+//line sql.y:5534
 		{
 			yyLOCAL = AscOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 1020:
+	case 1090:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:5140
+//line sql.y:5538
 		{
 			yyLOCAL = AscOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 1021:
+	case 1091:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:5144
+//line sql.y:5542
 		{
 			yyLOCAL = DescOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 1022:
+	case 1092:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:5149
+//line sql.y:5547
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1023:
+	case 1093:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:5153
+//line sql.y:5551
 		{
 			yyLOCAL = yyDollar[1].limitUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1024:
+	case 1094:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:5159
+//line sql.y:5557
 		{
 			yyLOCAL = &Limit{Rowcount: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1025:
+	case 1095:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:5163
+//line sql.y:5561
 		{
 			yyLOCAL = &Limit{Offset: yyDollar[2].exprUnion(), Rowcount: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1026:
+	case 1096:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:5167
+//line sql.y:5565
 		{
 			yyLOCAL = &Limit{Offset: yyDollar[4].exprUnion(), Rowcount: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1027:
+	case 1097:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:5172
+//line sql.y:5570
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1028:
+	case 1098:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:5176
+//line sql.y:5574
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion(), yyDollar[2].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1029:
+	case 1099:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:5180
+//line sql.y:5578
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion(), yyDollar[2].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1030:
+	case 1100:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:5184
+//line sql.y:5582
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1031:
+	case 1101:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:5188
+//line sql.y:5586
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1032:
+	case 1102:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:5195
+//line sql.y:5593
 		{
 			yyLOCAL = &LockOption{Type: DefaultType}
 		}
 		yyVAL.union = yyLOCAL
-	case 1033:
+	case 1103:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:5199
+//line sql.y:5597
 		{
 			yyLOCAL = &LockOption{Type: NoneType}
 		}
 		yyVAL.union = yyLOCAL
-	case 1034:
+	case 1104:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:5203
+//line sql.y:5601
 		{
 			yyLOCAL = &LockOption{Type: SharedType}
 		}
 		yyVAL.union = yyLOCAL
-	case 1035:
+	case 1105:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:5207
+//line sql.y:5605
 		{
 			yyLOCAL = &LockOption{Type: ExclusiveType}
 		}
 		yyVAL.union = yyLOCAL
-	case 1036:
+	case 1106:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:5213
+//line sql.y:5611
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1037:
+	case 1107:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:5217
+//line sql.y:5615
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1038:
+	case 1108:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:5221
+//line sql.y:5619
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1039:
+	case 1109:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5226
+//line sql.y:5624
 		{
 			yyVAL.str = ""
 		}
-	case 1040:
+	case 1110:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5230
+//line sql.y:5628
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 1041:
+	case 1111:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5234
+//line sql.y:5632
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 1042:
+	case 1112:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5238
+//line sql.y:5636
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 1043:
+	case 1113:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5243
+//line sql.y:5641
 		{
 			yyVAL.str = ""
 		}
-	case 1044:
+	case 1114:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5247
+//line sql.y:5645
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 1045:
+	case 1115:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5253
+//line sql.y:5651
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 1046:
+	case 1116:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5257
+//line sql.y:5655
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 1047:
+	case 1117:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5262
+//line sql.y:5660
 		{
 			yyVAL.str = ""
 		}
-	case 1048:
+	case 1118:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:5266
+//line sql.y:5664
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 1049:
+	case 1119:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5271
+//line sql.y:5669
 		{
 			yyVAL.str = "cascaded"
 		}
-	case 1050:
+	case 1120:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5275
+//line sql.y:5673
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 1051:
+	case 1121:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5279
+//line sql.y:5677
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 1052:
+	case 1122:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Definer
-//line sql.y:5284
+//line sql.y:5682
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1053:
+	case 1123:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Definer
-//line sql.y:5288
+//line sql.y:5686
 		{
 			yyLOCAL = yyDollar[3].definerUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1054:
+	case 1124:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *Definer
-//line sql.y:5294
+//line sql.y:5692
 		{
 			yyLOCAL = &Definer{
 				Name: string(yyDollar[1].str),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1055:
+	case 1125:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Definer
-//line sql.y:5300
+//line sql.y:5698
 		{
 			yyLOCAL = &Definer{
 				Name: string(yyDollar[1].str),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1056:
+	case 1126:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Definer
-//line sql.y:5306
+//line sql.y:5704
 		{
 			yyLOCAL = &Definer{
 				Name:    yyDollar[1].str,
@@ -14739,361 +15757,361 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1057:
+	case 1127:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5315
+//line sql.y:5713
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str)
 		}
-	case 1058:
+	case 1128:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5319
+//line sql.y:5717
 		{
 			yyVAL.str = formatIdentifier(yyDollar[1].str)
 		}
-	case 1059:
+	case 1129:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5324
+//line sql.y:5722
 		{
 			yyVAL.str = ""
 		}
-	case 1060:
+	case 1130:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5328
+//line sql.y:5726
 		{
 			yyVAL.str = formatAddress(yyDollar[1].str)
 		}
-	case 1061:
+	case 1131:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:5334
+//line sql.y:5732
 		{
 			yyLOCAL = ForUpdateLock
 		}
 		yyVAL.union = yyLOCAL
-	case 1062:
+	case 1132:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:5338
+//line sql.y:5736
 		{
 			yyLOCAL = ShareModeLock
 		}
 		yyVAL.union = yyLOCAL
-	case 1063:
+	case 1133:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:5344
+//line sql.y:5742
 		{
 			yyLOCAL = &SelectInto{Type: IntoOutfileS3, FileName: encodeSQLString(yyDollar[4].str), Charset: yyDollar[5].str, FormatOption: yyDollar[6].str, ExportOption: yyDollar[7].str, Manifest: yyDollar[8].str, Overwrite: yyDollar[9].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 1064:
+	case 1134:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:5348
+//line sql.y:5746
 		{
 			yyLOCAL = &SelectInto{Type: IntoDumpfile, FileName: encodeSQLString(yyDollar[3].str), Charset: "", FormatOption: "", ExportOption: "", Manifest: "", Overwrite: ""}
 		}
 		yyVAL.union = yyLOCAL
-	case 1065:
+	case 1135:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:5352
+//line sql.y:5750
 		{
 			yyLOCAL = &SelectInto{Type: IntoOutfile, FileName: encodeSQLString(yyDollar[3].str), Charset: yyDollar[4].str, FormatOption: "", ExportOption: yyDollar[5].str, Manifest: "", Overwrite: ""}
 		}
 		yyVAL.union = yyLOCAL
-	case 1066:
+	case 1136:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5357
+//line sql.y:5755
 		{
 			yyVAL.str = ""
 		}
-	case 1067:
+	case 1137:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5361
+//line sql.y:5759
 		{
 			yyVAL.str = " format csv" + yyDollar[3].str
 		}
-	case 1068:
+	case 1138:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5365
+//line sql.y:5763
 		{
 			yyVAL.str = " format text" + yyDollar[3].str
 		}
-	case 1069:
+	case 1139:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5370
+//line sql.y:5768
 		{
 			yyVAL.str = ""
 		}
-	case 1070:
+	case 1140:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5374
+//line sql.y:5772
 		{
 			yyVAL.str = " header"
 		}
-	case 1071:
+	case 1141:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5379
+//line sql.y:5777
 		{
 			yyVAL.str = ""
 		}
-	case 1072:
+	case 1142:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5383
+//line sql.y:5781
 		{
 			yyVAL.str = " manifest on"
 		}
-	case 1073:
+	case 1143:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5387
+//line sql.y:5785
 		{
 			yyVAL.str = " manifest off"
 		}
-	case 1074:
+	case 1144:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5392
+//line sql.y:5790
 		{
 			yyVAL.str = ""
 		}
-	case 1075:
+	case 1145:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5396
+//line sql.y:5794
 		{
 			yyVAL.str = " overwrite on"
 		}
-	case 1076:
+	case 1146:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5400
+//line sql.y:5798
 		{
 			yyVAL.str = " overwrite off"
 		}
-	case 1077:
+	case 1147:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5406
+//line sql.y:5804
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 1078:
+	case 1148:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5411
+//line sql.y:5809
 		{
 			yyVAL.str = ""
 		}
-	case 1079:
+	case 1149:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5415
+//line sql.y:5813
 		{
 			yyVAL.str = " lines" + yyDollar[2].str
 		}
-	case 1080:
+	case 1150:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5421
+//line sql.y:5819
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 1081:
+	case 1151:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5425
+//line sql.y:5823
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 1082:
+	case 1152:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5431
+//line sql.y:5829
 		{
 			yyVAL.str = " starting by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 1083:
+	case 1153:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5435
+//line sql.y:5833
 		{
 			yyVAL.str = " terminated by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 1084:
+	case 1154:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5440
+//line sql.y:5838
 		{
 			yyVAL.str = ""
 		}
-	case 1085:
+	case 1155:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5444
+//line sql.y:5842
 		{
 			yyVAL.str = " " + yyDollar[1].str + yyDollar[2].str
 		}
-	case 1086:
+	case 1156:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5450
+//line sql.y:5848
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 1087:
+	case 1157:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5454
+//line sql.y:5852
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 1088:
+	case 1158:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5460
+//line sql.y:5858
 		{
 			yyVAL.str = " terminated by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 1089:
+	case 1159:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:5464
+//line sql.y:5862
 		{
 			yyVAL.str = yyDollar[1].str + " enclosed by " + encodeSQLString(yyDollar[4].str)
 		}
-	case 1090:
+	case 1160:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5468
+//line sql.y:5866
 		{
 			yyVAL.str = " escaped by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 1091:
+	case 1161:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5473
+//line sql.y:5871
 		{
 			yyVAL.str = ""
 		}
-	case 1092:
+	case 1162:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5477
+//line sql.y:5875
 		{
 			yyVAL.str = " optionally"
 		}
-	case 1093:
+	case 1163:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:5490
+//line sql.y:5888
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[2].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1094:
+	case 1164:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:5494
+//line sql.y:5892
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[1].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1095:
+	case 1165:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:5498
+//line sql.y:5896
 		{
 			yyLOCAL = &Insert{Columns: yyDollar[2].columnsUnion(), Rows: yyDollar[5].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1096:
+	case 1166:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:5502
+//line sql.y:5900
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[4].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1097:
+	case 1167:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:5506
+//line sql.y:5904
 		{
 			yyLOCAL = &Insert{Columns: yyDollar[2].columnsUnion(), Rows: yyDollar[4].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1098:
+	case 1168:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:5512
+//line sql.y:5910
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 1099:
+	case 1169:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:5516
+//line sql.y:5914
 		{
 			yyLOCAL = Columns{yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 1100:
+	case 1170:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5520
+//line sql.y:5918
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 1101:
+	case 1171:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:5524
+//line sql.y:5922
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[5].colIdent)
 		}
-	case 1102:
+	case 1172:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:5529
+//line sql.y:5927
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1103:
+	case 1173:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:5533
+//line sql.y:5931
 		{
 			yyLOCAL = yyDollar[5].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1104:
+	case 1174:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Values
-//line sql.y:5539
+//line sql.y:5937
 		{
 			yyLOCAL = Values{yyDollar[1].valTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1105:
+	case 1175:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5543
+//line sql.y:5941
 		{
 			yySLICE := (*Values)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].valTupleUnion())
 		}
-	case 1106:
+	case 1176:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:5549
+//line sql.y:5947
 		{
 			yyLOCAL = yyDollar[1].valTupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1107:
+	case 1177:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:5553
+//line sql.y:5951
 		{
 			yyLOCAL = ValTuple{}
 		}
 		yyVAL.union = yyLOCAL
-	case 1108:
+	case 1178:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:5559
+//line sql.y:5957
 		{
 			yyLOCAL = ValTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1109:
+	case 1179:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5564
+//line sql.y:5962
 		{
 			if len(yyDollar[1].valTupleUnion()) == 1 {
 				yyLOCAL = yyDollar[1].valTupleUnion()[0]
@@ -15102,329 +16120,329 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1110:
+	case 1180:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:5574
+//line sql.y:5972
 		{
 			yyLOCAL = UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1111:
+	case 1181:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5578
+//line sql.y:5976
 		{
 			yySLICE := (*UpdateExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].updateExprUnion())
 		}
-	case 1112:
+	case 1182:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *UpdateExpr
-//line sql.y:5584
+//line sql.y:5982
 		{
 			yyLOCAL = &UpdateExpr{Name: yyDollar[1].colNameUnion(), Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1113:
+	case 1183:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SetExprs
-//line sql.y:5590
+//line sql.y:5988
 		{
 			yyLOCAL = SetExprs{yyDollar[1].setExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1114:
+	case 1184:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5594
+//line sql.y:5992
 		{
 			yySLICE := (*SetExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].setExprUnion())
 		}
-	case 1115:
+	case 1185:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:5600
+//line sql.y:5998
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral("on")}
 		}
 		yyVAL.union = yyLOCAL
-	case 1116:
+	case 1186:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:5604
+//line sql.y:6002
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral("off")}
 		}
 		yyVAL.union = yyLOCAL
-	case 1117:
+	case 1187:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:5608
+//line sql.y:6006
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1118:
+	case 1188:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:5612
+//line sql.y:6010
 		{
 			yyLOCAL = &SetExpr{Name: NewColIdent(string(yyDollar[1].str)), Scope: ImplicitScope, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1119:
+	case 1189:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:5616
+//line sql.y:6014
 		{
 			yyDollar[2].setExprUnion().Scope = yyDollar[1].scopeUnion()
 			yyLOCAL = yyDollar[2].setExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1121:
+	case 1191:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5624
+//line sql.y:6022
 		{
 			yyVAL.str = "charset"
 		}
-	case 1124:
+	case 1194:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5634
+//line sql.y:6032
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].colIdent.String())
 		}
 		yyVAL.union = yyLOCAL
-	case 1125:
+	case 1195:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5638
+//line sql.y:6036
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1126:
+	case 1196:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5642
+//line sql.y:6040
 		{
 			yyLOCAL = &Default{}
 		}
 		yyVAL.union = yyLOCAL
-	case 1129:
+	case 1199:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5651
+//line sql.y:6049
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1130:
+	case 1200:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5653
+//line sql.y:6051
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1131:
+	case 1201:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5656
+//line sql.y:6054
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1132:
+	case 1202:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5658
+//line sql.y:6056
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1133:
+	case 1203:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5661
+//line sql.y:6059
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1134:
+	case 1204:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5663
+//line sql.y:6061
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1135:
+	case 1205:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Ignore
-//line sql.y:5666
+//line sql.y:6064
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1136:
+	case 1206:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Ignore
-//line sql.y:5668
+//line sql.y:6066
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1137:
+	case 1207:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5671
+//line sql.y:6069
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 1138:
+	case 1208:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5673
+//line sql.y:6071
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 1139:
+	case 1209:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5675
+//line sql.y:6073
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 1140:
+	case 1210:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:5679
+//line sql.y:6077
 		{
 			yyLOCAL = &CallProc{Name: yyDollar[2].tableName, Params: yyDollar[4].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1141:
+	case 1211:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:5684
+//line sql.y:6082
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1142:
+	case 1212:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:5688
+//line sql.y:6086
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1143:
+	case 1213:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:5693
+//line sql.y:6091
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1144:
+	case 1214:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:5695
+//line sql.y:6093
 		{
 			yyLOCAL = []*IndexOption{yyDollar[1].indexOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1145:
+	case 1215:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:5699
+//line sql.y:6097
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), String: string(yyDollar[2].colIdent.String())}
 		}
 		yyVAL.union = yyLOCAL
-	case 1146:
+	case 1216:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5705
+//line sql.y:6103
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 1147:
+	case 1217:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5709
+//line sql.y:6107
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 1149:
+	case 1219:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5716
+//line sql.y:6114
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 1150:
+	case 1220:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5722
+//line sql.y:6120
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].colIdent.String()))
 		}
-	case 1151:
+	case 1221:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5726
+//line sql.y:6124
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 1152:
+	case 1222:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5732
+//line sql.y:6130
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 1153:
+	case 1223:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5736
+//line sql.y:6134
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 1155:
+	case 1225:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5743
+//line sql.y:6141
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 1609:
+	case 1684:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:6221
+//line sql.y:6624
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 1610:
+	case 1685:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:6230
+//line sql.y:6633
 		{
 			decNesting(yylex)
 		}
-	case 1611:
+	case 1686:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:6235
+//line sql.y:6638
 		{
 			skipToEnd(yylex)
 		}
-	case 1612:
+	case 1687:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:6240
+//line sql.y:6643
 		{
 			skipToEnd(yylex)
 		}
-	case 1613:
+	case 1688:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:6244
+//line sql.y:6647
 		{
 			skipToEnd(yylex)
 		}
-	case 1614:
+	case 1689:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:6248
+//line sql.y:6651
 		{
 			skipToEnd(yylex)
 		}
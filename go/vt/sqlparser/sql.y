@@ -109,6 +109,7 @@ func bindVariable(yylex yyLexer, bvar string) {
   showFilter    *ShowFilter
   optLike       *OptLike
   selectInto	  *SelectInto
+  load          *Load
   createDatabase  *CreateDatabase
   alterDatabase  *AlterDatabase
   createTable      *CreateTable
@@ -120,6 +121,13 @@ func bindVariable(yylex yyLexer, bvar string) {
   revertMigration *RevertMigration
   alterMigration  *AlterMigration
   trimType        TrimType
+  overClause      *OverClause
+  windowSpec      *WindowSpecification
+  frameClause     *FrameClause
+  framePoint      *FramePoint
+  frameUnitType   FrameUnitType
+  windowDefinition *WindowDefinition
+  windowDefinitions WindowDefinitions
 
   whens         []*When
   columnDefinitions []*ColumnDefinition
@@ -181,7 +189,7 @@ func bindVariable(yylex yyLexer, bvar string) {
 %token <str> SELECT STREAM VSTREAM INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER BY LIMIT OFFSET FOR
 %token <str> ALL DISTINCT AS EXISTS ASC DESC INTO DUPLICATE DEFAULT SET LOCK UNLOCK KEYS DO CALL
 %token <str> DISTINCTROW PARSER GENERATED ALWAYS
-%token <str> OUTFILE S3 DATA LOAD LINES TERMINATED ESCAPED ENCLOSED
+%token <str> OUTFILE S3 DATA LOAD LINES TERMINATED ESCAPED ENCLOSED INFILE CONCURRENT
 %token <str> DUMPFILE CSV HEADER MANIFEST OVERWRITE STARTING OPTIONALLY
 %token <str> VALUES LAST_INSERT_ID
 %token <str> NEXT VALUE SHARE MODE
@@ -199,6 +207,9 @@ func bindVariable(yylex yyLexer, bvar string) {
 
 %left EMPTY_FROM_CLAUSE
 %right INTO
+// ROWS is non-reserved, so an empty window name must win over using it as one.
+%nonassoc <str> ROWS
+%nonassoc <str> EMPTY_WINDOW_NAME
 
 // Precedence dictated by mysql. But the vitess grammar is simplified.
 // Some of these operators don't conflict in our situation. Nevertheless,
@@ -291,9 +302,13 @@ func bindVariable(yylex yyLexer, bvar string) {
 // Match
 %token <str> MATCH AGAINST BOOLEAN LANGUAGE WITH QUERY EXPANSION WITHOUT VALIDATION
 
+// Window function tokens
+%token <str> OVER WINDOW ROW CURRENT
+%token <str> CUME_DIST DENSE_RANK FIRST_VALUE LAG LAST_VALUE LEAD NTH_VALUE NTILE PERCENT_RANK RANK ROW_NUMBER
+
 // MySQL reserved words that are unused by this grammar will map to this token.
-%token <str> UNUSED ARRAY CUME_DIST DESCRIPTION DENSE_RANK EMPTY EXCEPT FIRST_VALUE GROUPING GROUPS JSON_TABLE LAG LAST_VALUE LATERAL LEAD MEMBER
-%token <str> NTH_VALUE NTILE OF OVER PERCENT_RANK RANK RECURSIVE ROW_NUMBER SYSTEM WINDOW
+%token <str> UNUSED ARRAY DESCRIPTION EMPTY EXCEPT GROUPING GROUPS JSON_TABLE LATERAL MEMBER
+%token <str> OF RECURSIVE SYSTEM
 %token <str> ACTIVE ADMIN BUCKETS CLONE COMPONENT DEFINITION ENFORCED EXCLUDE FOLLOWING GEOMCOLLECTION GET_MASTER_PUBLIC_KEY HISTOGRAM HISTORY
 %token <str> INACTIVE INVISIBLE LOCKED MASTER_COMPRESSION_ALGORITHMS MASTER_PUBLIC_KEY_PATH MASTER_TLS_CIPHERSUITES MASTER_ZSTD_COMPRESSION_LEVEL
 %token <str> NESTED NETWORK_NAMESPACE NOWAIT NULLS OJ OLD OPTIONAL ORDINALITY ORGANIZATION OTHERS PATH PERSIST PERSIST_ONLY PRECEDING PRIVILEGE_CHECKS_USER PROCESS
@@ -376,8 +391,25 @@ func bindVariable(yylex yyLexer, bvar string) {
 %type <boolVal> boolean_value
 %type <comparisonExprOperator> compare
 %type <ins> insert_data
+%type <load> load_fields_opt load_field_opt_list load_lines_opt load_line_opt_list
+%type <str> load_priority_opt load_duplicate_opt
+%type <tableName> load_table_name
+%type <boolean> load_local_opt
+%type <literal> load_ignore_lines_opt
+%type <columns> load_column_list load_column_list_opt
+%type <updateExprs> load_set_opt
 %type <expr> num_val
 %type <expr> function_call_keyword function_call_nonkeyword function_call_generic function_call_conflict
+%type <expr> function_call_window frame_expr
+%type <exprs> partition_clause_opt
+%type <overClause> over_clause
+%type <windowSpec> window_spec
+%type <frameClause> frame_clause_opt
+%type <framePoint> frame_point
+%type <frameUnitType> frame_unit
+%type <windowDefinition> window_definition
+%type <windowDefinitions> window_clause_opt window_definition_list
+%type <colIdent> window_name_opt
 %type <isExprOperator> is_suffix
 %type <colTuple> col_tuple
 %type <exprs> expression_list expression_list_opt
@@ -556,19 +588,189 @@ do_statement:
   }
 
 load_statement:
-  LOAD DATA skip_to_end
+  LOAD DATA load_priority_opt load_local_opt INFILE STRING load_duplicate_opt INTO TABLE load_table_name opt_partition_clause charset_opt load_fields_opt load_lines_opt load_ignore_lines_opt load_column_list_opt load_set_opt
+  {
+    load := &Load{
+      LowPriority: $3 == "low_priority",
+      Concurrent: $3 == "concurrent",
+      Local: $4,
+      FileName: $6,
+      Ignore: $7 == "ignore",
+      Table: $10,
+      Partitions: $11,
+      Charset: $12,
+      FieldsTerminatedBy: $13.FieldsTerminatedBy,
+      FieldsEnclosedBy: $13.FieldsEnclosedBy,
+      OptionallyEnclosed: $13.OptionallyEnclosed,
+      FieldsEscapedBy: $13.FieldsEscapedBy,
+      LinesStartingBy: $14.LinesStartingBy,
+      LinesTerminatedBy: $14.LinesTerminatedBy,
+      IgnoreLines: $15,
+      Columns: $16,
+      SetExprs: $17,
+    }
+    if $7 == "replace" {
+      load.Action = ReplaceAct
+    }
+    $$ = load
+  }
+// LOAD DATA FROM S3 is an extension of Aurora that is passed through as it is
+| LOAD DATA FROM skip_to_end
+  {
+    $$ = &Load{}
+  }
+
+// the table name of LOAD DATA has always been accepted as a string by vitess,
+// since these statements used to be passed through without being parsed
+load_table_name:
+  table_name
+  {
+    $$ = $1
+  }
+| STRING
+  {
+    $$ = TableName{Name: NewTableIdent($1)}
+  }
+
+load_priority_opt:
+  {
+    $$ = ""
+  }
+| LOW_PRIORITY
+  {
+    $$ = "low_priority"
+  }
+| CONCURRENT
+  {
+    $$ = "concurrent"
+  }
+
+load_local_opt:
+  {
+    $$ = false
+  }
+| LOCAL
+  {
+    $$ = true
+  }
+
+load_duplicate_opt:
+  {
+    $$ = ""
+  }
+| REPLACE
+  {
+    $$ = "replace"
+  }
+| IGNORE
+  {
+    $$ = "ignore"
+  }
+
+load_fields_opt:
+  {
+    $$ = &Load{}
+  }
+| columns_or_fields load_field_opt_list
+  {
+    $$ = $2
+  }
+
+load_field_opt_list:
+  {
+    $$ = &Load{}
+  }
+| load_field_opt_list TERMINATED BY STRING
+  {
+    $1.FieldsTerminatedBy = NewStrLiteral($4)
+    $$ = $1
+  }
+| load_field_opt_list optionally_opt ENCLOSED BY STRING
+  {
+    $1.OptionallyEnclosed = $2 != ""
+    $1.FieldsEnclosedBy = NewStrLiteral($5)
+    $$ = $1
+  }
+| load_field_opt_list ESCAPED BY STRING
+  {
+    $1.FieldsEscapedBy = NewStrLiteral($4)
+    $$ = $1
+  }
+
+load_lines_opt:
+  {
+    $$ = &Load{}
+  }
+| LINES load_line_opt_list
+  {
+    $$ = $2
+  }
+
+load_line_opt_list:
   {
     $$ = &Load{}
   }
+| load_line_opt_list STARTING BY STRING
+  {
+    $1.LinesStartingBy = NewStrLiteral($4)
+    $$ = $1
+  }
+| load_line_opt_list TERMINATED BY STRING
+  {
+    $1.LinesTerminatedBy = NewStrLiteral($4)
+    $$ = $1
+  }
+
+load_ignore_lines_opt:
+  {
+    $$ = nil
+  }
+| IGNORE INTEGRAL LINES
+  {
+    $$ = NewIntLiteral($2)
+  }
+| IGNORE INTEGRAL ROWS
+  {
+    $$ = NewIntLiteral($2)
+  }
+
+load_column_list_opt:
+  {
+    $$ = nil
+  }
+| openb load_column_list closeb
+  {
+    $$ = $2
+  }
+
+// sql_id also accepts user variables, that can be assigned the fields of the file
+load_column_list:
+  sql_id
+  {
+    $$ = Columns{$1}
+  }
+| load_column_list ',' sql_id
+  {
+    $$ = append($1, $3)
+  }
+
+load_set_opt:
+  {
+    $$ = nil
+  }
+| SET update_list
+  {
+    $$ = $2
+  }
 
 with_clause:
   WITH with_list
   {
-	$$ = &With{ctes: $2, Recursive: false}
+	$$ = &With{CTEs: $2, Recursive: false}
   }
 | WITH RECURSIVE with_list
   {
-	$$ = &With{ctes: $3, Recursive: true}
+	$$ = &With{CTEs: $3, Recursive: true}
   }
 
 with_clause_opt:
@@ -757,14 +959,18 @@ vstream_statement:
 
 // query_primary is an unparenthesized SELECT with no order by clause or beyond.
 query_primary:
-//  1         2            3              4                    5             6                7           8
-  SELECT comment_opt select_options select_expression_list into_clause from_opt where_expression_opt group_by_opt having_opt
+//  1         2            3              4                    5             6                7           8            9
+  SELECT comment_opt select_options select_expression_list into_clause from_opt where_expression_opt group_by_opt having_opt window_clause_opt
   {
-    $$ = NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, $5/*into*/, $6/*from*/, NewWhere(WhereClause, $7), GroupBy($8), NewWhere(HavingClause, $9))
+    sel := NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, $5/*into*/, $6/*from*/, NewWhere(WhereClause, $7), GroupBy($8), NewWhere(HavingClause, $9))
+    sel.Windows = $10
+    $$ = sel
   }
-| SELECT comment_opt select_options select_expression_list from_opt where_expression_opt group_by_opt having_opt
+| SELECT comment_opt select_options select_expression_list from_opt where_expression_opt group_by_opt having_opt window_clause_opt
   {
-    $$ = NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, nil, $5/*from*/, NewWhere(WhereClause, $6), GroupBy($7), NewWhere(HavingClause, $8))
+    sel := NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, nil, $5/*from*/, NewWhere(WhereClause, $6), GroupBy($7), NewWhere(HavingClause, $8))
+    sel.Windows = $9
+    $$ = sel
   }
 
 
@@ -4416,6 +4622,10 @@ function_call_keyword
   {
   	$$ = $1
   }
+| function_call_window
+  {
+  	$$ = $1
+  }
 | function_call_nonkeyword
   {
   	$$ = $1
@@ -4648,6 +4858,190 @@ function_call_generic:
   {
     $$ = &FuncExpr{Qualifier: $1, Name: $3, Exprs: $5}
   }
+| sql_id openb select_expression_list_opt closeb over_clause
+  {
+    $$ = &FuncExpr{Name: $1, Exprs: $3, Over: $5}
+  }
+
+/*
+  Dedicated window functions. Aggregate functions used as window
+  functions are handled by function_call_generic
+*/
+function_call_window:
+  ROW_NUMBER openb closeb over_clause
+  {
+    $$ = &WindowFuncExpr{Type: RowNumberType, Over: $4}
+  }
+| RANK openb closeb over_clause
+  {
+    $$ = &WindowFuncExpr{Type: RankType, Over: $4}
+  }
+| DENSE_RANK openb closeb over_clause
+  {
+    $$ = &WindowFuncExpr{Type: DenseRankType, Over: $4}
+  }
+| PERCENT_RANK openb closeb over_clause
+  {
+    $$ = &WindowFuncExpr{Type: PercentRankType, Over: $4}
+  }
+| CUME_DIST openb closeb over_clause
+  {
+    $$ = &WindowFuncExpr{Type: CumeDistType, Over: $4}
+  }
+| NTILE openb expression closeb over_clause
+  {
+    $$ = &WindowFuncExpr{Type: NtileType, Exprs: Exprs{$3}, Over: $5}
+  }
+| LAG openb expression_list closeb over_clause
+  {
+    if len($3) > 3 {
+      yylex.Error("incorrect parameter count in the call to native function 'lag'")
+      return 1
+    }
+    $$ = &WindowFuncExpr{Type: LagType, Exprs: $3, Over: $5}
+  }
+| LEAD openb expression_list closeb over_clause
+  {
+    if len($3) > 3 {
+      yylex.Error("incorrect parameter count in the call to native function 'lead'")
+      return 1
+    }
+    $$ = &WindowFuncExpr{Type: LeadType, Exprs: $3, Over: $5}
+  }
+| FIRST_VALUE openb expression closeb over_clause
+  {
+    $$ = &WindowFuncExpr{Type: FirstValueType, Exprs: Exprs{$3}, Over: $5}
+  }
+| LAST_VALUE openb expression closeb over_clause
+  {
+    $$ = &WindowFuncExpr{Type: LastValueType, Exprs: Exprs{$3}, Over: $5}
+  }
+| NTH_VALUE openb expression ',' expression closeb over_clause
+  {
+    $$ = &WindowFuncExpr{Type: NthValueType, Exprs: Exprs{$3, $5}, Over: $7}
+  }
+
+over_clause:
+  OVER openb window_spec closeb
+  {
+    $$ = &OverClause{WindowSpec: $3}
+  }
+| OVER sql_id
+  {
+    $$ = &OverClause{WindowName: $2}
+  }
+
+window_spec:
+  window_name_opt partition_clause_opt order_by_opt frame_clause_opt
+  {
+    $$ = &WindowSpecification{Name: $1, PartitionClause: $2, OrderClause: $3, FrameClause: $4}
+  }
+
+window_name_opt:
+  %prec EMPTY_WINDOW_NAME
+  {
+    $$ = ColIdent{}
+  }
+| sql_id
+  {
+    $$ = $1
+  }
+
+partition_clause_opt:
+  {
+    $$ = nil
+  }
+| PARTITION BY expression_list
+  {
+    $$ = $3
+  }
+
+frame_clause_opt:
+  {
+    $$ = nil
+  }
+| frame_unit frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $2}
+  }
+| frame_unit BETWEEN frame_point AND frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $3, End: $5}
+  }
+
+frame_unit:
+  ROWS
+  {
+    $$ = RowsFrameUnit
+  }
+| RANGE
+  {
+    $$ = RangeFrameUnit
+  }
+
+frame_point:
+  CURRENT ROW
+  {
+    $$ = &FramePoint{Type: CurrentRowType}
+  }
+| UNBOUNDED PRECEDING
+  {
+    $$ = &FramePoint{Type: UnboundedPrecedingType}
+  }
+| UNBOUNDED FOLLOWING
+  {
+    $$ = &FramePoint{Type: UnboundedFollowingType}
+  }
+| frame_expr PRECEDING
+  {
+    $$ = &FramePoint{Type: ExprPrecedingType, Expr: $1}
+  }
+| frame_expr FOLLOWING
+  {
+    $$ = &FramePoint{Type: ExprFollowingType, Expr: $1}
+  }
+
+// MySQL only accepts a non-negative numeric literal, a parameter
+// marker or a temporal interval as the bound of a frame.
+frame_expr:
+  NUM_literal
+  {
+    $$ = $1
+  }
+| VALUE_ARG
+  {
+    $$ = NewArgument($1[1:])
+    bindVariable(yylex, $1[1:])
+  }
+| INTERVAL bit_expr sql_id
+  {
+    $$ = &IntervalExpr{Expr: $2, Unit: $3.String()}
+  }
+
+window_clause_opt:
+  {
+    $$ = nil
+  }
+| WINDOW window_definition_list
+  {
+    $$ = $2
+  }
+
+window_definition_list:
+  window_definition
+  {
+    $$ = WindowDefinitions{$1}
+  }
+| window_definition_list ',' window_definition
+  {
+    $$ = append($1, $3)
+  }
+
+window_definition:
+  sql_id AS openb window_spec closeb
+  {
+    $$ = &WindowDefinition{Name: $1, WindowSpec: $4}
+  }
 
 /*
   Function calls using reserved keywords, with dedicated grammar rules
@@ -4662,6 +5056,10 @@ function_call_keyword:
   {
     $$ = &FuncExpr{Name: NewColIdent("right"), Exprs: $3}
   }
+| ROW openb select_expression_list closeb
+  {
+    $$ = &FuncExpr{Name: NewColIdent("row"), Exprs: $3}
+  }
 | SUBSTRING openb expression ',' expression ',' expression closeb
   {
     $$ = &SubstrExpr{Name: $3, From: $5, To: $7}
@@ -5811,6 +6209,7 @@ reserved_keyword:
 | IGNORE
 | IN
 | INDEX
+| INFILE
 | INNER
 | INSERT
 | INTERVAL
@@ -5862,6 +6261,7 @@ reserved_keyword:
 | RENAME
 | REPLACE
 | RIGHT
+| ROW
 | ROW_NUMBER
 | SCHEMA
 | SCHEMAS
@@ -5944,9 +6344,11 @@ non_reserved_keyword:
 | COMPONENT
 | COMPRESSED
 | COMPRESSION
+| CONCURRENT
 | CONNECTION
 | COPY
 | CSV
+| CURRENT
 | DATA
 | DATE
 | DATETIME
@@ -6114,6 +6516,7 @@ non_reserved_keyword:
 | REUSE
 | ROLE
 | ROLLBACK
+| ROWS
 | ROW_FORMAT
 | RTRIM
 | S3
@@ -198,6 +198,14 @@ func (ts *Server) RebuildSrvVSchema(ctx context.Context, cells []string) error {
 	}
 	srvVSchema.RoutingRules = rr
 
+	qr, err := ts.GetQueryRules(ctx)
+	if err != nil {
+		return fmt.Errorf("GetQueryRules failed: %v", err)
+	}
+	if len(qr.Rules) > 0 {
+		srvVSchema.QueryRules = qr
+	}
+
 	// now save the SrvVSchema in all cells in parallel
 	for _, cell := range cells {
 		wg.Add(1)
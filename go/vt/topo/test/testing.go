@@ -91,6 +91,11 @@ func TopoServerTestSuite(t *testing.T, factory func() *topo.Server) {
 	checkRoutingRules(t, ts)
 	ts.Close()
 
+	t.Log("=== checkQueryRules")
+	ts = factory()
+	checkQueryRules(t, ts)
+	ts.Close()
+
 	t.Log("=== checkElection")
 	ts = factory()
 	checkElection(t, ts)
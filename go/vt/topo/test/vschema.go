@@ -115,3 +115,33 @@ func checkRoutingRules(t *testing.T, ts *topo.Server) {
 		t.Errorf("GetRoutingRules: %v, want %v", got, want)
 	}
 }
+
+// checkQueryRules runs the tests on the query rules part of the API
+func checkQueryRules(t *testing.T, ts *topo.Server) {
+	ctx := context.Background()
+
+	got, err := ts.GetQueryRules(ctx)
+	require.NoError(t, err)
+	require.Empty(t, got.Rules)
+
+	want := &vschemapb.QueryRules{
+		Rules: []*vschemapb.QueryRule{{
+			Name:   "r1",
+			Tables: []string{"t1"},
+			Block:  true,
+		}},
+	}
+	require.NoError(t, ts.SaveQueryRules(ctx, want))
+
+	got, err = ts.GetQueryRules(ctx)
+	require.NoError(t, err)
+	if !proto.Equal(got, want) {
+		t.Errorf("GetQueryRules: %v, want %v", got, want)
+	}
+
+	// Saving no rules removes them.
+	require.NoError(t, ts.SaveQueryRules(ctx, &vschemapb.QueryRules{}))
+	got, err = ts.GetQueryRules(ctx)
+	require.NoError(t, err)
+	require.Empty(t, got.Rules)
+}
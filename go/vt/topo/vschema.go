@@ -130,3 +130,39 @@ func (ts *Server) GetRoutingRules(ctx context.Context) (*vschemapb.RoutingRules,
 	}
 	return rr, nil
 }
+
+// SaveQueryRules saves the vtgate query rules into the topo.
+func (ts *Server) SaveQueryRules(ctx context.Context, queryRules *vschemapb.QueryRules) error {
+	data, err := proto.Marshal(queryRules)
+	if err != nil {
+		return err
+	}
+
+	if len(data) == 0 {
+		// No rules, remove the file.
+		if err := ts.globalCell.Delete(ctx, QueryRulesFile, nil); err != nil && !IsErrType(err, NoNode) {
+			return err
+		}
+		return nil
+	}
+
+	_, err = ts.globalCell.Update(ctx, QueryRulesFile, data, nil)
+	return err
+}
+
+// GetQueryRules fetches the vtgate query rules from the topo.
+func (ts *Server) GetQueryRules(ctx context.Context) (*vschemapb.QueryRules, error) {
+	qr := &vschemapb.QueryRules{}
+	data, _, err := ts.globalCell.Get(ctx, QueryRulesFile)
+	if err != nil {
+		if IsErrType(err, NoNode) {
+			return qr, nil
+		}
+		return nil, err
+	}
+	err = proto.Unmarshal(data, qr)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "bad query rules data: %q", data)
+	}
+	return qr, nil
+}
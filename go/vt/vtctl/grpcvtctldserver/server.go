@@ -44,6 +44,7 @@ import (
 	hk "vitess.io/vitess/go/vt/hook"
 	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/schemadiff"
 
 	"vitess.io/vitess/go/vt/schemamanager"
 
@@ -62,6 +63,7 @@ import (
 	"vitess.io/vitess/go/vt/vtctl/schematools"
 	"vitess.io/vitess/go/vt/vtctl/workflow"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/queryrules"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
 	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
@@ -140,6 +142,36 @@ func (s *VtctldServer) AddCellsAlias(ctx context.Context, req *vtctldatapb.AddCe
 	return &vtctldatapb.AddCellsAliasResponse{}, nil
 }
 
+// ApplyQueryRules is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ApplyQueryRules(ctx context.Context, req *vtctldatapb.ApplyQueryRulesRequest) (*vtctldatapb.ApplyQueryRulesResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.ApplyQueryRules")
+	defer span.Finish()
+
+	span.Annotate("skip_rebuild", req.SkipRebuild)
+	span.Annotate("rebuild_cells", strings.Join(req.RebuildCells, ","))
+
+	if _, err := queryrules.Build(req.QueryRules); err != nil {
+		return nil, err
+	}
+
+	if err := s.ts.SaveQueryRules(ctx, req.QueryRules); err != nil {
+		return nil, err
+	}
+
+	resp := &vtctldatapb.ApplyQueryRulesResponse{}
+
+	if req.SkipRebuild {
+		log.Warningf("Skipping rebuild of SrvVSchema, will need to run RebuildVSchemaGraph for changes to take effect")
+		return resp, nil
+	}
+
+	if err := s.ts.RebuildSrvVSchema(ctx, req.RebuildCells); err != nil {
+		return nil, vterrors.Wrapf(err, "RebuildSrvVSchema(%v) failed: %v", req.RebuildCells, err)
+	}
+
+	return resp, nil
+}
+
 // ApplyRoutingRules is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) ApplyRoutingRules(ctx context.Context, req *vtctldatapb.ApplyRoutingRulesRequest) (*vtctldatapb.ApplyRoutingRulesResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.ApplyRoutingRules")
@@ -174,17 +206,47 @@ func (s *VtctldServer) ApplySchema(ctx context.Context, req *vtctldatapb.ApplySc
 	span.Annotate("keyspace", req.Keyspace)
 	span.Annotate("skip_preflight", req.SkipPreflight)
 	span.Annotate("ddl_strategy", req.DdlStrategy)
+	span.Annotate("declarative", req.Declarative)
+	span.Annotate("dry_run", req.DryRun)
 
 	if len(req.Sql) == 0 {
 		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "Sql must be a non-empty array")
 	}
 
+	if req.DryRun && !req.Declarative {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "DryRun is only supported for Declarative schema changes")
+	}
+
 	// Attach the callerID as the EffectiveCallerID.
 	if req.CallerId != nil {
 		span.Annotate("caller_id", req.CallerId.Principal)
 		ctx = callerid.NewContext(ctx, req.CallerId, &querypb.VTGateCallerID{Username: req.CallerId.Principal})
 	}
 
+	sql := req.Sql
+	var statements []string
+	if req.Declarative {
+		statements, err = s.diffDeclarativeSchema(ctx, req.Keyspace, req.Sql)
+		if err != nil {
+			return nil, err
+		}
+		if req.DryRun || len(statements) == 0 {
+			return &vtctldatapb.ApplySchemaResponse{Statements: statements}, nil
+		}
+
+		ddlStrategySetting, err := schema.ParseDDLStrategy(req.DdlStrategy)
+		if err != nil {
+			return resp, vterrors.Wrapf(err, "invalid DdlStrategy: %s", req.DdlStrategy)
+		}
+		if ddlStrategySetting.Strategy.IsDirect() {
+			return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "Declarative schema changes require an online DdlStrategy, got %s", req.DdlStrategy)
+		}
+		if ddlStrategySetting.IsDeclarative() {
+			return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "Declarative schema changes do not support the -declarative DdlStrategy flag")
+		}
+		sql = statements
+	}
+
 	executionUUID, err := schema.CreateUUID()
 	if err != nil {
 		return resp, vterrors.Wrapf(err, "unable to create execution UUID")
@@ -231,7 +293,7 @@ func (s *VtctldServer) ApplySchema(ctx context.Context, req *vtctldatapb.ApplySc
 
 	execResult, err := schemamanager.Run(
 		ctx,
-		schemamanager.NewPlainController(req.Sql, req.Keyspace),
+		schemamanager.NewPlainController(sql, req.Keyspace),
 		executor,
 	)
 
@@ -240,10 +302,85 @@ func (s *VtctldServer) ApplySchema(ctx context.Context, req *vtctldatapb.ApplySc
 	}
 
 	return &vtctldatapb.ApplySchemaResponse{
-		UuidList: execResult.UUIDs,
+		UuidList:   execResult.UUIDs,
+		Statements: statements,
 	}, err
 }
 
+// diffDeclarativeSchema computes the statements which transform the current
+// schema of a keyspace into the given desired schema. The current schema is
+// read from the primary tablet of every shard, and all shards must agree on
+// the statements to apply.
+func (s *VtctldServer) diffDeclarativeSchema(ctx context.Context, keyspace string, sql []string) ([]string, error) {
+	desired, err := schemadiff.NewSchemaFromQueries(sql)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "invalid declarative schema")
+	}
+
+	shards, err := s.ts.GetShardNames(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	if len(shards) == 0 {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "keyspace %s has no shards", keyspace)
+	}
+	sort.Strings(shards)
+
+	var (
+		statements []string
+		firstShard string
+	)
+	hints := &schemadiff.DiffHints{AutoIncrementStrategy: schemadiff.AutoIncrementIgnore}
+	for _, shard := range shards {
+		si, err := s.ts.GetShard(ctx, keyspace, shard)
+		if err != nil {
+			return nil, err
+		}
+		if !si.HasPrimary() {
+			return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "shard %s/%s has no primary", keyspace, shard)
+		}
+		ti, err := s.ts.GetTablet(ctx, si.PrimaryAlias)
+		if err != nil {
+			return nil, err
+		}
+		sd, err := s.tmc.GetSchema(ctx, ti.Tablet, nil, nil, true)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "GetSchema(%v) failed", topoproto.TabletAliasString(si.PrimaryAlias))
+		}
+		// the internal tables of online DDL and vreplication are not part of the declarative schema
+		tableDefinitions := make([]*tabletmanagerdatapb.TableDefinition, 0, len(sd.TableDefinitions))
+		for _, td := range sd.TableDefinitions {
+			if !schema.IsInternalOperationTableName(td.Name) {
+				tableDefinitions = append(tableDefinitions, td)
+			}
+		}
+		sd = proto.Clone(sd).(*tabletmanagerdatapb.SchemaDefinition)
+		sd.TableDefinitions = tableDefinitions
+		current, err := schemadiff.NewSchemaFromSchemaDefinition(sd)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "cannot load schema of %s/%s", keyspace, shard)
+		}
+		diffs, err := current.Diff(desired, hints)
+		if err != nil {
+			return nil, err
+		}
+		shardStatements := make([]string, 0, len(diffs))
+		for _, diff := range diffs {
+			shardStatements = append(shardStatements, diff.StatementString())
+		}
+
+		if firstShard == "" {
+			statements = shardStatements
+			firstShard = shard
+			continue
+		}
+		if strings.Join(statements, ";\n") != strings.Join(shardStatements, ";\n") {
+			return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "schema of %s/%s differs from %s/%s; run ValidateSchemaKeyspace and reconcile the shards first", keyspace, shard, keyspace, firstShard)
+		}
+	}
+	return statements, nil
+}
+
 // ApplyVSchema is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) ApplyVSchema(ctx context.Context, req *vtctldatapb.ApplyVSchemaRequest) (*vtctldatapb.ApplyVSchemaResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.ApplyVSchema")
@@ -1126,6 +1263,21 @@ func (s *VtctldServer) GetKeyspaces(ctx context.Context, req *vtctldatapb.GetKey
 	return &vtctldatapb.GetKeyspacesResponse{Keyspaces: keyspaces}, nil
 }
 
+// GetQueryRules is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetQueryRules(ctx context.Context, req *vtctldatapb.GetQueryRulesRequest) (*vtctldatapb.GetQueryRulesResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetQueryRules")
+	defer span.Finish()
+
+	qr, err := s.ts.GetQueryRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.GetQueryRulesResponse{
+		QueryRules: qr,
+	}, nil
+}
+
 // GetRoutingRules is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) GetRoutingRules(ctx context.Context, req *vtctldatapb.GetRoutingRulesRequest) (*vtctldatapb.GetRoutingRulesResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetRoutingRules")
@@ -3018,6 +3170,66 @@ func (s *VtctldServer) UpdateCellsAlias(ctx context.Context, req *vtctldatapb.Up
 	}, nil
 }
 
+// VDiffCreate is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) VDiffCreate(ctx context.Context, req *vtctldatapb.VDiffCreateRequest) (*vtctldatapb.VDiffCreateResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.VDiffCreate")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+	span.Annotate("uuid", req.Uuid)
+
+	return s.ws.VDiffCreate(ctx, req)
+}
+
+// VDiffDelete is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) VDiffDelete(ctx context.Context, req *vtctldatapb.VDiffDeleteRequest) (*vtctldatapb.VDiffDeleteResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.VDiffDelete")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+	span.Annotate("uuid", req.Uuid)
+
+	return s.ws.VDiffDelete(ctx, req)
+}
+
+// VDiffResume is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) VDiffResume(ctx context.Context, req *vtctldatapb.VDiffResumeRequest) (*vtctldatapb.VDiffResumeResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.VDiffResume")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+	span.Annotate("uuid", req.Uuid)
+
+	return s.ws.VDiffResume(ctx, req)
+}
+
+// VDiffShow is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) VDiffShow(ctx context.Context, req *vtctldatapb.VDiffShowRequest) (*vtctldatapb.VDiffShowResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.VDiffShow")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+	span.Annotate("uuid", req.Uuid)
+
+	return s.ws.VDiffShow(ctx, req)
+}
+
+// VDiffStop is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) VDiffStop(ctx context.Context, req *vtctldatapb.VDiffStopRequest) (*vtctldatapb.VDiffStopResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.VDiffStop")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+	span.Annotate("uuid", req.Uuid)
+
+	return s.ws.VDiffStop(ctx, req)
+}
+
 // Validate is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) Validate(ctx context.Context, req *vtctldatapb.ValidateRequest) (*vtctldatapb.ValidateResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.Validate")
@@ -25,6 +25,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
@@ -215,6 +216,114 @@ func TestAddCellsAlias(t *testing.T) {
 	}
 }
 
+func TestApplyQueryRules(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rules := &vschemapb.QueryRules{
+		Rules: []*vschemapb.QueryRule{
+			{
+				Name:   "r1",
+				Tables: []string{"t1"},
+				Block:  true,
+			},
+		},
+	}
+	tests := []struct {
+		name          string
+		cells         []string
+		req           *vtctldatapb.ApplyQueryRulesRequest
+		expectedRules *vschemapb.QueryRules
+		topoDown      bool
+		shouldErr     bool
+	}{
+		{
+			name:  "success",
+			cells: []string{"zone1"},
+			req: &vtctldatapb.ApplyQueryRulesRequest{
+				QueryRules: rules,
+			},
+			expectedRules: rules,
+		},
+		{
+			name:  "invalid rule",
+			cells: []string{"zone1"},
+			req: &vtctldatapb.ApplyQueryRulesRequest{
+				QueryRules: &vschemapb.QueryRules{
+					Rules: []*vschemapb.QueryRule{
+						{
+							Name:   "r1",
+							Tables: []string{"t1"},
+						},
+					},
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			name:  "rebuild failed (bad cell)",
+			cells: []string{"zone1"},
+			req: &vtctldatapb.ApplyQueryRulesRequest{
+				QueryRules:   rules,
+				RebuildCells: []string{"zone1", "zone2"},
+			},
+			shouldErr: true,
+		},
+		{
+			name:  "rebuild skipped",
+			cells: []string{"zone1"},
+			req: &vtctldatapb.ApplyQueryRulesRequest{
+				QueryRules:   rules,
+				SkipRebuild:  true,
+				RebuildCells: []string{"zone1", "zone2"},
+			},
+			expectedRules: rules,
+		},
+		{
+			name:      "topo down",
+			cells:     []string{"zone1"},
+			req:       &vtctldatapb.ApplyQueryRulesRequest{},
+			topoDown:  true,
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts, factory := memorytopo.NewServerAndFactory(tt.cells...)
+			if tt.topoDown {
+				factory.SetError(errors.New("topo down for testing"))
+			}
+
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+			_, err := vtctld.ApplyQueryRules(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err, "ApplyQueryRules(%+v) failed", tt.req)
+
+			qr, err := ts.GetQueryRules(ctx)
+			require.NoError(t, err, "failed to get query rules from topo to compare")
+			utils.MustMatch(t, tt.expectedRules, qr)
+
+			srvVSchema, err := ts.GetSrvVSchema(ctx, "zone1")
+			if tt.req.SkipRebuild {
+				assert.True(t, topo.IsErrType(err, topo.NoNode), "the SrvVSchema should not be built, got %v", err)
+				return
+			}
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expectedRules, srvVSchema.QueryRules)
+		})
+	}
+}
+
 func TestApplyRoutingRules(t *testing.T) {
 	t.Parallel()
 
@@ -330,6 +439,204 @@ func TestApplyRoutingRules(t *testing.T) {
 	}
 }
 
+func TestApplySchemaDeclarative(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	tablets := []*topodatapb.Tablet{
+		{
+			Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+			Keyspace: "testkeyspace",
+			Shard:    "-80",
+			Type:     topodatapb.TabletType_PRIMARY,
+		},
+		{
+			Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 200},
+			Keyspace: "testkeyspace",
+			Shard:    "80-",
+			Type:     topodatapb.TabletType_PRIMARY,
+		},
+	}
+	testutil.AddTablets(ctx, t, ts, &testutil.AddTabletOptions{
+		AlsoSetShardPrimary: true,
+	}, tablets...)
+
+	schemaDefinition := func(tables ...string) struct {
+		Schema *tabletmanagerdatapb.SchemaDefinition
+		Error  error
+	} {
+		sd := &tabletmanagerdatapb.SchemaDefinition{}
+		for _, table := range tables {
+			sd.TableDefinitions = append(sd.TableDefinitions, &tabletmanagerdatapb.TableDefinition{Schema: table})
+		}
+		return struct {
+			Schema *tabletmanagerdatapb.SchemaDefinition
+			Error  error
+		}{Schema: sd}
+	}
+	t1 := "CREATE TABLE `t1` (\n  `id` int NOT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB"
+	v1 := "CREATE ALGORITHM=UNDEFINED DEFINER=`root`@`localhost` SQL SECURITY DEFINER VIEW {{.DatabaseName}}.`v1` AS select `id` AS `id` from {{.DatabaseName}}.`t1`"
+
+	tests := []struct {
+		name     string
+		schemas  []string
+		req      *vtctldatapb.ApplySchemaRequest
+		expected *vtctldatapb.ApplySchemaResponse
+		err      string
+	}{
+		{
+			name:    "dry run",
+			schemas: []string{t1, v1},
+			req: &vtctldatapb.ApplySchemaRequest{
+				Keyspace: "testkeyspace",
+				Sql: []string{
+					"create table t1 (id int not null, name varchar(64), primary key (id))",
+					"create table t2 (id int not null, primary key (id))",
+				},
+				Declarative: true,
+				DryRun:      true,
+			},
+			expected: &vtctldatapb.ApplySchemaResponse{
+				Statements: []string{
+					"drop view v1",
+					"create table t2 (\n\tid int not null,\n\tprimary key (id)\n)",
+					"alter table t1 add column `name` varchar(64)",
+				},
+			},
+		},
+		{
+			name:    "no changes",
+			schemas: []string{t1},
+			req: &vtctldatapb.ApplySchemaRequest{
+				Keyspace:    "testkeyspace",
+				Sql:         []string{"create table t1 (id int not null, primary key (id))"},
+				Declarative: true,
+				DdlStrategy: "direct",
+			},
+			expected: &vtctldatapb.ApplySchemaResponse{
+				Statements: []string{},
+			},
+		},
+		{
+			name:    "direct strategy",
+			schemas: []string{t1},
+			req: &vtctldatapb.ApplySchemaRequest{
+				Keyspace:    "testkeyspace",
+				Sql:         []string{"create table t2 (id int not null, primary key (id))"},
+				Declarative: true,
+				DdlStrategy: "direct",
+			},
+			err: "Declarative schema changes require an online DdlStrategy",
+		},
+		{
+			name:    "invalid schema",
+			schemas: []string{t1},
+			req: &vtctldatapb.ApplySchemaRequest{
+				Keyspace:    "testkeyspace",
+				Sql:         []string{"create view v1 as select * from t3"},
+				Declarative: true,
+				DryRun:      true,
+			},
+			err: "invalid declarative schema",
+		},
+		{
+			name: "dry run without declarative",
+			req: &vtctldatapb.ApplySchemaRequest{
+				Keyspace: "testkeyspace",
+				Sql:      []string{"create table t2 (id int not null, primary key (id))"},
+				DryRun:   true,
+			},
+			err: "DryRun is only supported for Declarative schema changes",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tmc := &testutil.TabletManagerClient{
+				GetSchemaResults: map[string]struct {
+					Schema *tabletmanagerdatapb.SchemaDefinition
+					Error  error
+				}{
+					"zone1-0000000100": schemaDefinition(tt.schemas...),
+					"zone1-0000000200": schemaDefinition(tt.schemas...),
+				},
+			}
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+
+			resp, err := vtctld.ApplySchema(ctx, tt.req)
+			if tt.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.err)
+				return
+			}
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, resp)
+		})
+	}
+
+	t.Run("shards differ", func(t *testing.T) {
+		t.Parallel()
+
+		tmc := &testutil.TabletManagerClient{
+			GetSchemaResults: map[string]struct {
+				Schema *tabletmanagerdatapb.SchemaDefinition
+				Error  error
+			}{
+				"zone1-0000000100": schemaDefinition(t1),
+				"zone1-0000000200": schemaDefinition(t1, v1),
+			},
+		}
+		vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+			return NewVtctldServer(ts)
+		})
+
+		_, err := vtctld.ApplySchema(ctx, &vtctldatapb.ApplySchemaRequest{
+			Keyspace:    "testkeyspace",
+			Sql:         []string{"create table t1 (id int not null, primary key (id))"},
+			Declarative: true,
+			DryRun:      true,
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "schema of testkeyspace/80- differs from testkeyspace/-80")
+	})
+
+	t.Run("internal tables", func(t *testing.T) {
+		t.Parallel()
+
+		// an online DDL leftover on a single primary is neither dropped nor makes the shards differ
+		internal := schemaDefinition(t1, "CREATE TABLE `_vt_HOLD_6ace8bcef73211ea87e9f875a4d24e90_20200915120410` (\n  `id` int NOT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB")
+		internal.Schema.TableDefinitions[1].Name = "_vt_HOLD_6ace8bcef73211ea87e9f875a4d24e90_20200915120410"
+		tmc := &testutil.TabletManagerClient{
+			GetSchemaResults: map[string]struct {
+				Schema *tabletmanagerdatapb.SchemaDefinition
+				Error  error
+			}{
+				"zone1-0000000100": schemaDefinition(t1),
+				"zone1-0000000200": internal,
+			},
+		}
+		vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+			return NewVtctldServer(ts)
+		})
+
+		resp, err := vtctld.ApplySchema(ctx, &vtctldatapb.ApplySchemaRequest{
+			Keyspace:    "testkeyspace",
+			Sql:         []string{"create table t1 (id int not null, primary key (id))"},
+			Declarative: true,
+			DryRun:      true,
+		})
+		require.NoError(t, err)
+		utils.MustMatch(t, &vtctldatapb.ApplySchemaResponse{Statements: []string{}}, resp)
+		assert.Len(t, internal.Schema.TableDefinitions, 2)
+	})
+}
+
 func TestApplyVSchema(t *testing.T) {
 	t.Parallel()
 
@@ -4025,6 +4332,80 @@ func TestGetKeyspaces(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestGetQueryRules(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		topoDown  bool
+		qrIn      *vschemapb.QueryRules
+		expected  *vschemapb.QueryRules
+		shouldErr bool
+	}{
+		{
+			name: "success",
+			qrIn: &vschemapb.QueryRules{
+				Rules: []*vschemapb.QueryRule{
+					{
+						Name:     "r1",
+						Users:    []string{"u1"},
+						Keyspace: "ks",
+					},
+				},
+			},
+			expected: &vschemapb.QueryRules{
+				Rules: []*vschemapb.QueryRule{
+					{
+						Name:     "r1",
+						Users:    []string{"u1"},
+						Keyspace: "ks",
+					},
+				},
+			},
+		},
+		{
+			name:     "empty query rules",
+			qrIn:     nil,
+			expected: &vschemapb.QueryRules{},
+		},
+		{
+			name:      "topo error",
+			topoDown:  true,
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts, factory := memorytopo.NewServerAndFactory()
+			if tt.qrIn != nil {
+				err := ts.SaveQueryRules(ctx, tt.qrIn)
+				require.NoError(t, err, "could not save query rules: %+v", tt.qrIn)
+			}
+
+			if tt.topoDown {
+				factory.SetError(errors.New("topo down for testing"))
+			}
+
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+			resp, err := vtctld.GetQueryRules(ctx, &vtctldatapb.GetQueryRulesRequest{})
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			utils.MustMatch(t, resp.QueryRules, tt.expected)
+		})
+	}
+}
+
 func TestGetRoutingRules(t *testing.T) {
 	t.Parallel()
 
@@ -10277,6 +10658,94 @@ func TestUpdateCellsAlias(t *testing.T) {
 	}
 }
 
+func TestVDiff(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	testutil.AddTablets(ctx, t, ts, &testutil.AddTabletOptions{
+		AlsoSetShardPrimary: true,
+	}, &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+		Keyspace: "testkeyspace",
+		Shard:    "-80",
+		Type:     topodatapb.TabletType_PRIMARY,
+	}, &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 200},
+		Keyspace: "testkeyspace",
+		Shard:    "80-",
+		Type:     topodatapb.TabletType_PRIMARY,
+	})
+
+	const (
+		vdiffUUID = "4d9c5ba1-1c62-4e4f-9d1f-4a5d7a1fb2b8"
+		query     = "select id from _vt.vreplication where db_name = 'vt_testkeyspace' and workflow = 'wf1'"
+	)
+	type vexecResult = struct {
+		Result *querypb.QueryResult
+		Error  error
+	}
+	type vdiffResult = struct {
+		Response *tabletmanagerdatapb.VDiffResponse
+		Error    error
+	}
+	status := func(shard string) *tabletmanagerdatapb.VDiffStatus {
+		return &tabletmanagerdatapb.VDiffStatus{
+			VdiffUuid: vdiffUUID,
+			Keyspace:  "testkeyspace",
+			Shard:     shard,
+			Workflow:  "wf1",
+			State:     "completed",
+		}
+	}
+	tmc := &testutil.TabletManagerClient{
+		VReplicationExecResults: map[string]map[string]vexecResult{
+			"zone1-0000000100": {
+				query: {Result: &querypb.QueryResult{Rows: []*querypb.Row{{Lengths: []int64{1}, Values: []byte("1")}}}},
+			},
+			"zone1-0000000200": {
+				query: {Result: &querypb.QueryResult{}},
+			},
+		},
+		VDiffResults: map[string]vdiffResult{
+			"zone1-0000000100": {
+				Response: &tabletmanagerdatapb.VDiffResponse{Vdiffs: []*tabletmanagerdatapb.VDiffStatus{status("-80")}},
+			},
+			"zone1-0000000200": {
+				Error: assert.AnError,
+			},
+		},
+	}
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	// The vdiffs only run on the shards which have streams of the workflow.
+	created, err := vtctld.VDiffCreate(ctx, &vtctldatapb.VDiffCreateRequest{Keyspace: "testkeyspace", Workflow: "wf1"})
+	require.NoError(t, err)
+	_, err = uuid.Parse(created.Uuid)
+	assert.NoError(t, err)
+
+	_, err = vtctld.VDiffCreate(ctx, &vtctldatapb.VDiffCreateRequest{Keyspace: "testkeyspace", Workflow: "wf1", Uuid: "not-a-uuid"})
+	assert.Error(t, err)
+
+	shown, err := vtctld.VDiffShow(ctx, &vtctldatapb.VDiffShowRequest{Keyspace: "testkeyspace", Workflow: "wf1", Uuid: vdiffUUID})
+	require.NoError(t, err)
+	utils.MustMatch(t, &vtctldatapb.VDiffShowResponse{Vdiffs: []*tabletmanagerdatapb.VDiffStatus{status("-80")}}, shown)
+
+	_, err = vtctld.VDiffStop(ctx, &vtctldatapb.VDiffStopRequest{Keyspace: "testkeyspace", Workflow: "wf1", Uuid: vdiffUUID})
+	assert.NoError(t, err)
+	_, err = vtctld.VDiffResume(ctx, &vtctldatapb.VDiffResumeRequest{Keyspace: "testkeyspace", Workflow: "wf1", Uuid: vdiffUUID})
+	assert.NoError(t, err)
+	_, err = vtctld.VDiffDelete(ctx, &vtctldatapb.VDiffDeleteRequest{Keyspace: "testkeyspace", Workflow: "wf1", Uuid: vdiffUUID})
+	assert.NoError(t, err)
+
+	_, err = vtctld.VDiffShow(ctx, &vtctldatapb.VDiffShowRequest{Keyspace: "testkeyspace", Workflow: "nosuchworkflow"})
+	assert.Error(t, err)
+	_, err = vtctld.VDiffShow(ctx, &vtctldatapb.VDiffShowRequest{Keyspace: "testkeyspace"})
+	assert.Error(t, err)
+}
+
 func TestValidate(t *testing.T) {
 	t.Parallel()
 
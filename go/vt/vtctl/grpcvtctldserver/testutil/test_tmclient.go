@@ -260,6 +260,11 @@ type TabletManagerClient struct {
 	UndoDemotePrimaryDelays map[string]time.Duration
 	// keyed by tablet alias
 	UndoDemotePrimaryResults map[string]error
+	// keyed by tablet alias.
+	VDiffResults map[string]struct {
+		Response *tabletmanagerdatapb.VDiffResponse
+		Error    error
+	}
 	// tablet alias => duration
 	VReplicationExecDelays map[string]time.Duration
 	// tablet alias => query string => result
@@ -1115,6 +1120,20 @@ func (fake *TabletManagerClient) UndoDemotePrimary(ctx context.Context, tablet *
 	return assert.AnError
 }
 
+// VDiff is part of the tmclient.TabletManagerClient interface.
+func (fake *TabletManagerClient) VDiff(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	if fake.VDiffResults == nil {
+		return nil, assert.AnError
+	}
+
+	key := topoproto.TabletAliasString(tablet.Alias)
+	if result, ok := fake.VDiffResults[key]; ok {
+		return result.Response, result.Error
+	}
+
+	return nil, assert.AnError
+}
+
 // VReplicationExec is part of the tmclient.TabletManagerCLient interface.
 func (fake *TabletManagerClient) VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
 	if fake.VReplicationExecResults == nil {
@@ -39,6 +39,11 @@ func (client *localVtctldClient) AddCellsAlias(ctx context.Context, in *vtctldat
 	return client.s.AddCellsAlias(ctx, in)
 }
 
+// ApplyQueryRules is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) ApplyQueryRules(ctx context.Context, in *vtctldatapb.ApplyQueryRulesRequest, opts ...grpc.CallOption) (*vtctldatapb.ApplyQueryRulesResponse, error) {
+	return client.s.ApplyQueryRules(ctx, in)
+}
+
 // ApplyRoutingRules is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) ApplyRoutingRules(ctx context.Context, in *vtctldatapb.ApplyRoutingRulesRequest, opts ...grpc.CallOption) (*vtctldatapb.ApplyRoutingRulesResponse, error) {
 	return client.s.ApplyRoutingRules(ctx, in)
@@ -246,6 +251,11 @@ func (client *localVtctldClient) GetKeyspaces(ctx context.Context, in *vtctldata
 	return client.s.GetKeyspaces(ctx, in)
 }
 
+// GetQueryRules is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) GetQueryRules(ctx context.Context, in *vtctldatapb.GetQueryRulesRequest, opts ...grpc.CallOption) (*vtctldatapb.GetQueryRulesResponse, error) {
+	return client.s.GetQueryRules(ctx, in)
+}
+
 // GetRoutingRules is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) GetRoutingRules(ctx context.Context, in *vtctldatapb.GetRoutingRulesRequest, opts ...grpc.CallOption) (*vtctldatapb.GetRoutingRulesResponse, error) {
 	return client.s.GetRoutingRules(ctx, in)
@@ -502,6 +512,31 @@ func (client *localVtctldClient) UpdateCellsAlias(ctx context.Context, in *vtctl
 	return client.s.UpdateCellsAlias(ctx, in)
 }
 
+// VDiffCreate is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) VDiffCreate(ctx context.Context, in *vtctldatapb.VDiffCreateRequest, opts ...grpc.CallOption) (*vtctldatapb.VDiffCreateResponse, error) {
+	return client.s.VDiffCreate(ctx, in)
+}
+
+// VDiffDelete is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) VDiffDelete(ctx context.Context, in *vtctldatapb.VDiffDeleteRequest, opts ...grpc.CallOption) (*vtctldatapb.VDiffDeleteResponse, error) {
+	return client.s.VDiffDelete(ctx, in)
+}
+
+// VDiffResume is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) VDiffResume(ctx context.Context, in *vtctldatapb.VDiffResumeRequest, opts ...grpc.CallOption) (*vtctldatapb.VDiffResumeResponse, error) {
+	return client.s.VDiffResume(ctx, in)
+}
+
+// VDiffShow is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) VDiffShow(ctx context.Context, in *vtctldatapb.VDiffShowRequest, opts ...grpc.CallOption) (*vtctldatapb.VDiffShowResponse, error) {
+	return client.s.VDiffShow(ctx, in)
+}
+
+// VDiffStop is part of the vtctlservicepb.VtctldClient interface.
+func (client *localVtctldClient) VDiffStop(ctx context.Context, in *vtctldatapb.VDiffStopRequest, opts ...grpc.CallOption) (*vtctldatapb.VDiffStopResponse, error) {
+	return client.s.VDiffStop(ctx, in)
+}
+
 // Validate is part of the vtctlservicepb.VtctldClient interface.
 func (client *localVtctldClient) Validate(ctx context.Context, in *vtctldatapb.ValidateRequest, opts ...grpc.CallOption) (*vtctldatapb.ValidateResponse, error) {
 	return client.s.Validate(ctx, in)
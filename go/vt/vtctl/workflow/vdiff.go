@@ -0,0 +1,190 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"vitess.io/vitess/go/trace"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtctl/workflow/vexec"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// VDiffCreate starts a vdiff of a workflow on all its target primaries,
+// which run it in the background and save its progress. A uuid is
+// generated for the vdiff if the request has none.
+func (s *Server) VDiffCreate(ctx context.Context, req *vtctldatapb.VDiffCreateRequest) (*vtctldatapb.VDiffCreateResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "workflow.Server.VDiffCreate")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+
+	vdiffUUID := req.Uuid
+	if vdiffUUID == "" {
+		vdiffUUID = uuid.New().String()
+	} else if _, err := uuid.Parse(vdiffUUID); err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid vdiff uuid %s: %v", vdiffUUID, err)
+	}
+	if _, err := s.vdiffAction(ctx, req.Keyspace, req.Workflow, vdiff.CreateAction, vdiffUUID, req.Options); err != nil {
+		return nil, err
+	}
+	return &vtctldatapb.VDiffCreateResponse{Uuid: vdiffUUID}, nil
+}
+
+// VDiffShow returns the progress of a vdiff of a workflow on each target
+// shard, or of all its vdiffs if the request has no uuid.
+func (s *Server) VDiffShow(ctx context.Context, req *vtctldatapb.VDiffShowRequest) (*vtctldatapb.VDiffShowResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "workflow.Server.VDiffShow")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+
+	vdiffs, err := s.vdiffAction(ctx, req.Keyspace, req.Workflow, vdiff.ShowAction, req.Uuid, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &vtctldatapb.VDiffShowResponse{Vdiffs: vdiffs}, nil
+}
+
+// VDiffStop stops a running vdiff of a workflow. It can be resumed later.
+func (s *Server) VDiffStop(ctx context.Context, req *vtctldatapb.VDiffStopRequest) (*vtctldatapb.VDiffStopResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "workflow.Server.VDiffStop")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+
+	if _, err := s.vdiffAction(ctx, req.Keyspace, req.Workflow, vdiff.StopAction, req.Uuid, nil); err != nil {
+		return nil, err
+	}
+	return &vtctldatapb.VDiffStopResponse{}, nil
+}
+
+// VDiffResume resumes a stopped or failed vdiff of a workflow where it
+// stopped. Resuming a completed vdiff compares the rows that were added to
+// the tables since it completed.
+func (s *Server) VDiffResume(ctx context.Context, req *vtctldatapb.VDiffResumeRequest) (*vtctldatapb.VDiffResumeResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "workflow.Server.VDiffResume")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+
+	if _, err := s.vdiffAction(ctx, req.Keyspace, req.Workflow, vdiff.ResumeAction, req.Uuid, nil); err != nil {
+		return nil, err
+	}
+	return &vtctldatapb.VDiffResumeResponse{}, nil
+}
+
+// VDiffDelete stops a vdiff of a workflow, and deletes its progress.
+func (s *Server) VDiffDelete(ctx context.Context, req *vtctldatapb.VDiffDeleteRequest) (*vtctldatapb.VDiffDeleteResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "workflow.Server.VDiffDelete")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+
+	if _, err := s.vdiffAction(ctx, req.Keyspace, req.Workflow, vdiff.DeleteAction, req.Uuid, nil); err != nil {
+		return nil, err
+	}
+	return &vtctldatapb.VDiffDeleteResponse{}, nil
+}
+
+// vdiffAction runs the vdiff action on all the target primaries of the
+// workflow, and returns the status of the vdiffs, sorted by uuid and shard.
+func (s *Server) vdiffAction(ctx context.Context, keyspace, workflow, action, vdiffUUID string, options *tabletmanagerdatapb.VDiffOptions) ([]*tabletmanagerdatapb.VDiffStatus, error) {
+	if keyspace == "" || workflow == "" {
+		return nil, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "keyspace and workflow are required")
+	}
+	targets, err := s.getWorkflowTargets(ctx, keyspace, workflow)
+	if err != nil {
+		return nil, err
+	}
+	req := &tabletmanagerdatapb.VDiffRequest{
+		Keyspace:  keyspace,
+		Workflow:  workflow,
+		Action:    action,
+		VdiffUuid: vdiffUUID,
+		Options:   options,
+	}
+
+	var (
+		m      sync.Mutex
+		wg     sync.WaitGroup
+		rec    concurrency.AllErrorRecorder
+		vdiffs []*tabletmanagerdatapb.VDiffStatus
+	)
+	for _, target := range targets {
+		wg.Add(1)
+		go func(target *topo.TabletInfo) {
+			defer wg.Done()
+			resp, err := s.tmc.VDiff(ctx, target.Tablet, req)
+			if err != nil {
+				rec.RecordError(vterrors.Wrapf(err, "VDiff on tablet %v", topoproto.TabletAliasString(target.Alias)))
+				return
+			}
+			m.Lock()
+			defer m.Unlock()
+			vdiffs = append(vdiffs, resp.Vdiffs...)
+		}(target)
+	}
+	wg.Wait()
+	if rec.HasErrors() {
+		return nil, rec.Error()
+	}
+	sort.Slice(vdiffs, func(i, j int) bool {
+		if vdiffs[i].VdiffUuid != vdiffs[j].VdiffUuid {
+			return vdiffs[i].VdiffUuid < vdiffs[j].VdiffUuid
+		}
+		return vdiffs[i].Shard < vdiffs[j].Shard
+	})
+	return vdiffs, nil
+}
+
+// getWorkflowTargets returns the primaries of the shards of the keyspace
+// which have streams of the workflow.
+func (s *Server) getWorkflowTargets(ctx context.Context, keyspace, workflow string) ([]*topo.TabletInfo, error) {
+	vx := vexec.NewVExec(keyspace, workflow, s.ts, s.tmc)
+	results, err := vx.QueryContext(ctx, "select id from _vt.vreplication")
+	if err != nil {
+		return nil, err
+	}
+	var targets []*topo.TabletInfo
+	for tablet, result := range results {
+		if len(result.Rows) > 0 {
+			targets = append(targets, tablet)
+		}
+	}
+	if len(targets) == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "workflow %s not found in keyspace %s", workflow, keyspace)
+	}
+	return targets, nil
+}
@@ -43,6 +43,7 @@ const (
 	MixOfGroupFuncAndFields
 	DupFieldName
 	WrongValueCountOnRow
+	WrongArguments
 
 	// failed precondition
 	NoDB
@@ -51,6 +52,8 @@ const (
 	CantDoThisInTransaction
 	RequiresPrimaryKey
 	OperandColumns
+	RowIsReferenced2
+	NoReferencedRow2
 
 	// not found
 	BadDb
@@ -60,6 +63,7 @@ const (
 	UnknownSystemVariable
 	UnknownTable
 	NoSuchSession
+	UnknownStmtHandler
 
 	// already exists
 	DbCreateExists
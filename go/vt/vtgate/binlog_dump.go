@@ -0,0 +1,834 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"math"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// This file implements ComBinlogDumpGTID: the VStream of a keyspace is
+// translated into a synthetic MySQL binlog stream, so that tools that
+// speak the replication protocol can consume changes from vtgate.
+//
+// Every transaction is given a pseudo-GTID of the form <sid>:<seq>, where
+// the SID identifies the dump stream and seq is incremented per transaction.
+// vtgate saves the VGTIDs reached by the pseudo-GTIDs in the global topo,
+// which lets a replica resume the stream from its executed GTID set, through
+// any vtgate. The VGTIDs are saved in chunks of consecutive pseudo-GTIDs,
+// and transactions are held back until their pseudo-GTID is saved: a stream
+// writes to the topo at most once per -binlog_dump_checkpoint_interval or
+// -binlog_dump_checkpoint_transactions, whichever comes first.
+
+var (
+	binlogDumpPositionCacheSize      = flag.Int("binlog_dump_position_cache_size", 10000, "Number of pseudo-GTIDs remembered for each ComBinlogDumpGTID stream. This bounds how far back a replica can resume a binlog dump from.")
+	binlogDumpMaxStreams             = flag.Int("binlog_dump_max_streams", 100, "Maximum number of ComBinlogDumpGTID streams whose pseudo-GTIDs are remembered when vtgate has no topo server to save them in. The least recently used stream is forgotten first.")
+	binlogDumpPositionTTL            = flag.Duration("binlog_dump_position_ttl", 7*24*time.Hour, "How long the pseudo-GTIDs of a ComBinlogDumpGTID stream are remembered once no vtgate serves it anymore. A replica cannot resume a binlog dump after that.")
+	binlogDumpCheckpointInterval     = flag.Duration("binlog_dump_checkpoint_interval", time.Second, "How often the pseudo-GTIDs of a ComBinlogDumpGTID stream are saved. Transactions are only sent to the replica once their pseudo-GTID is saved, so this bounds the delay added to the replication.")
+	binlogDumpCheckpointTransactions = flag.Int("binlog_dump_checkpoint_transactions", 100, "Maximum number of transactions of a ComBinlogDumpGTID stream held back until their pseudo-GTIDs are saved.")
+)
+
+// binlogDumpFilename is the binlog file name announced to replicas.
+// It carries no meaning, since replicas are positioned by GTID.
+const binlogDumpFilename = "vt-bin.000001"
+
+// binlogDumpTopoDir is the directory of the global topo where the
+// VGTIDs of the pseudo-GTIDs are saved, as <sid>/<chunk> files.
+const binlogDumpTopoDir = "binlog_dump"
+
+// binlogDumpExpireInterval is how often vtgate deletes the pseudo-GTIDs
+// of the streams that expired.
+const binlogDumpExpireInterval = time.Hour
+
+// binlogDumpChunkSize is the number of consecutive pseudo-GTIDs saved
+// together: chunk n holds the VGTIDs of seq n*binlogDumpChunkSize+1 onwards.
+var binlogDumpChunkSize int64 = 100
+
+// binlogDumpGTIDs is shared by all the binlog dumps of this vtgate.
+// Init sets it up to save the pseudo-GTIDs in the topo.
+var binlogDumpGTIDs = newPseudoGTIDs(nil)
+
+// binlogDump streams the changes of a keyspace as binlog events, starting
+// from the VGTID that corresponds to gtidSet. An empty gtidSet starts a
+// new stream from the current position.
+func (vtg *VTGate) binlogDump(ctx context.Context, keyspace string, tabletType topodatapb.TabletType, gtidSet mysql.GTIDSet, send func(mysql.BinlogEvent) error) error {
+	var bds *binlogDumpStream
+	if gtidSet == nil || gtidSet.String() == "" {
+		vgtid := &binlogdatapb.VGtid{
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: keyspace,
+				Gtid:     "current",
+			}},
+		}
+		bds = newBinlogDumpStream(ctx, binlogDumpGTIDs, binlogDumpGTIDs.newStream(), 0, nil, vgtid, send)
+	} else {
+		sid, seq, positions, err := binlogDumpGTIDs.resume(ctx, gtidSet)
+		if err != nil {
+			return err
+		}
+		bds = newBinlogDumpStream(ctx, binlogDumpGTIDs, sid, seq, positions, positions[len(positions)-1], send)
+	}
+
+	if err := bds.start(); err != nil {
+		return err
+	}
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match: "/.*/",
+		}},
+	}
+	// Heartbeats let the held back transactions be sent when the stream is idle.
+	flags := &vtgatepb.VStreamFlags{HeartbeatInterval: 1}
+	return vtg.VStream(ctx, tabletType, bds.vgtid, filter, flags, bds.onEvents)
+}
+
+// pseudoGTIDs maps the pseudo-GTIDs handed out by binlog dumps back
+// to the VGTIDs they were generated from. The mapping is saved in the
+// global topo, so that it survives vtgate restarts and is shared by all
+// the vtgates. Without a topo server, it is only kept in memory.
+type pseudoGTIDs struct {
+	ts *topo.Server
+
+	// mu protects streams, which is only used without a topo server.
+	mu      sync.Mutex
+	streams map[mysql.SID]*pseudoGTIDStream
+}
+
+type pseudoGTIDStream struct {
+	chunks   map[int64][]*binlogdatapb.VGtid
+	lastUsed time.Time
+}
+
+func newPseudoGTIDs(ts *topo.Server) *pseudoGTIDs {
+	return &pseudoGTIDs{
+		ts:      ts,
+		streams: make(map[mysql.SID]*pseudoGTIDStream),
+	}
+}
+
+func pseudoGTIDStreamPath(sid mysql.SID) string {
+	return path.Join(binlogDumpTopoDir, sid.String())
+}
+
+func pseudoGTIDChunkPath(sid mysql.SID, chunk int64) string {
+	return path.Join(pseudoGTIDStreamPath(sid), fmt.Sprintf("%d", chunk))
+}
+
+// pseudoGTIDChunk returns the chunk that holds the VGTID of seq.
+func pseudoGTIDChunk(seq int64) int64 {
+	return (seq - 1) / binlogDumpChunkSize
+}
+
+// newStream registers a new stream and returns its SID.
+func (pg *pseudoGTIDs) newStream() mysql.SID {
+	sid := mysql.SID(uuid.New())
+	if pg.ts != nil {
+		return sid
+	}
+
+	pg.mu.Lock()
+	defer pg.mu.Unlock()
+
+	pg.evict()
+	pg.streams[sid] = &pseudoGTIDStream{
+		chunks:   make(map[int64][]*binlogdatapb.VGtid),
+		lastUsed: time.Now(),
+	}
+	return sid
+}
+
+// evict forgets the streams unused for longer than binlogDumpPositionTTL,
+// and the least recently used ones beyond binlogDumpMaxStreams.
+// It must be called with the lock held.
+func (pg *pseudoGTIDs) evict() {
+	for sid, stream := range pg.streams {
+		if time.Since(stream.lastUsed) > *binlogDumpPositionTTL {
+			delete(pg.streams, sid)
+		}
+	}
+	for len(pg.streams) >= *binlogDumpMaxStreams && len(pg.streams) > 0 {
+		var oldest mysql.SID
+		var oldestTime time.Time
+		for sid, stream := range pg.streams {
+			if oldestTime.IsZero() || stream.lastUsed.Before(oldestTime) {
+				oldest, oldestTime = sid, stream.lastUsed
+			}
+		}
+		delete(pg.streams, oldest)
+	}
+}
+
+// save remembers the VGTIDs of the pseudo-GTIDs of a chunk of the stream
+// sid, replacing the ones saved before.
+func (pg *pseudoGTIDs) save(ctx context.Context, sid mysql.SID, chunk int64, positions []*binlogdatapb.VGtid) error {
+	if pg.ts != nil {
+		data, err := proto.Marshal(&vtgatepb.BinlogDumpPositions{
+			Positions:   positions,
+			TimeUpdated: time.Now().Unix(),
+		})
+		if err != nil {
+			return err
+		}
+		conn, err := pg.ts.ConnForCell(ctx, topo.GlobalCell)
+		if err != nil {
+			return err
+		}
+		_, err = conn.Update(ctx, pseudoGTIDChunkPath(sid, chunk), data, nil)
+		return err
+	}
+
+	pg.mu.Lock()
+	defer pg.mu.Unlock()
+
+	stream, ok := pg.streams[sid]
+	if !ok {
+		pg.evict()
+		stream = &pseudoGTIDStream{chunks: make(map[int64][]*binlogdatapb.VGtid)}
+		pg.streams[sid] = stream
+	}
+	stream.lastUsed = time.Now()
+	// The caller keeps appending to positions.
+	stream.chunks[chunk] = append([]*binlogdatapb.VGtid(nil), positions...)
+	return nil
+}
+
+// load returns the VGTIDs saved for a chunk of the stream sid, or nil
+// if there are none.
+func (pg *pseudoGTIDs) load(ctx context.Context, sid mysql.SID, chunk int64) ([]*binlogdatapb.VGtid, error) {
+	if pg.ts != nil {
+		conn, err := pg.ts.ConnForCell(ctx, topo.GlobalCell)
+		if err != nil {
+			return nil, err
+		}
+		data, _, err := conn.Get(ctx, pseudoGTIDChunkPath(sid, chunk))
+		if topo.IsErrType(err, topo.NoNode) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		positions := &vtgatepb.BinlogDumpPositions{}
+		if err := proto.Unmarshal(data, positions); err != nil {
+			return nil, vterrors.Wrapf(err, "invalid pseudo-GTIDs in %v", pseudoGTIDChunkPath(sid, chunk))
+		}
+		return positions.Positions, nil
+	}
+
+	pg.mu.Lock()
+	defer pg.mu.Unlock()
+
+	stream, ok := pg.streams[sid]
+	if !ok {
+		return nil, nil
+	}
+	stream.lastUsed = time.Now()
+	return append([]*binlogdatapb.VGtid(nil), stream.chunks[chunk]...), nil
+}
+
+// forget deletes the VGTIDs saved for a chunk of the stream sid.
+// It returns false if there were none.
+func (pg *pseudoGTIDs) forget(ctx context.Context, sid mysql.SID, chunk int64) (bool, error) {
+	if pg.ts != nil {
+		conn, err := pg.ts.ConnForCell(ctx, topo.GlobalCell)
+		if err != nil {
+			return false, err
+		}
+		err = conn.Delete(ctx, pseudoGTIDChunkPath(sid, chunk), nil)
+		if topo.IsErrType(err, topo.NoNode) {
+			return false, nil
+		}
+		return err == nil, err
+	}
+
+	pg.mu.Lock()
+	defer pg.mu.Unlock()
+
+	stream, ok := pg.streams[sid]
+	if !ok {
+		return false, nil
+	}
+	if _, ok := stream.chunks[chunk]; !ok {
+		return false, nil
+	}
+	delete(stream.chunks, chunk)
+	return true, nil
+}
+
+// expire deletes from the topo the pseudo-GTIDs of the streams that
+// were not saved for longer than ttl: those streams are no longer served
+// by any vtgate. Without a topo server, evict forgets such streams.
+func (pg *pseudoGTIDs) expire(ctx context.Context, ttl time.Duration) error {
+	if pg.ts == nil {
+		return nil
+	}
+	conn, err := pg.ts.ConnForCell(ctx, topo.GlobalCell)
+	if err != nil {
+		return err
+	}
+	streams, err := conn.ListDir(ctx, binlogDumpTopoDir, false)
+	if topo.IsErrType(err, topo.NoNode) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, stream := range streams {
+		dir := path.Join(binlogDumpTopoDir, stream.Name)
+		chunks, err := conn.ListDir(ctx, dir, false)
+		if topo.IsErrType(err, topo.NoNode) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		// The last chunk is the one saved last.
+		var last int64
+		for _, chunk := range chunks {
+			if n, err := strconv.ParseInt(chunk.Name, 10, 64); err == nil && n > last {
+				last = n
+			}
+		}
+		data, _, err := conn.Get(ctx, path.Join(dir, fmt.Sprintf("%d", last)))
+		if topo.IsErrType(err, topo.NoNode) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		positions := &vtgatepb.BinlogDumpPositions{}
+		if err := proto.Unmarshal(data, positions); err == nil && time.Since(time.Unix(positions.TimeUpdated, 0)) <= ttl {
+			continue
+		}
+		for _, chunk := range chunks {
+			if err := conn.Delete(ctx, path.Join(dir, chunk.Name), nil); err != nil && !topo.IsErrType(err, topo.NoNode) {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// expireLoop periodically deletes the pseudo-GTIDs of the streams that
+// expired, until ctx is done.
+func (pg *pseudoGTIDs) expireLoop(ctx context.Context) {
+	ticker := time.NewTicker(binlogDumpExpireInterval)
+	defer ticker.Stop()
+	for {
+		if err := pg.expire(ctx, *binlogDumpPositionTTL); err != nil {
+			log.Warningf("Failed to delete the expired pseudo-GTIDs of binlog dumps: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// resume returns the stream and sequence number to resume from for a
+// replica whose executed GTID set is gtidSet, along with the VGTIDs of
+// the chunk of that sequence number up to it: the last one is the VGTID
+// to resume from.
+func (pg *pseudoGTIDs) resume(ctx context.Context, gtidSet mysql.GTIDSet) (mysql.SID, int64, []*binlogdatapb.VGtid, error) {
+	set, ok := gtidSet.(mysql.Mysql56GTIDSet)
+	if !ok || len(set.SIDs()) != 1 {
+		return mysql.SID{}, 0, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "cannot resume binlog dump from GTID set %v: it must contain a single pseudo-GTID", gtidSet)
+	}
+	last, err := mysql.ParseGTID(mysql.Mysql56FlavorID, set.Last())
+	if err != nil {
+		return mysql.SID{}, 0, nil, err
+	}
+	gtid := last.(mysql.Mysql56GTID)
+
+	chunk := pseudoGTIDChunk(gtid.Sequence)
+	positions, err := pg.load(ctx, gtid.Server, chunk)
+	if err != nil {
+		return mysql.SID{}, 0, nil, err
+	}
+	n := gtid.Sequence - chunk*binlogDumpChunkSize
+	if gtid.Sequence < 1 || n > int64(len(positions)) {
+		return mysql.SID{}, 0, nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "cannot resume binlog dump from GTID set %v: pseudo-GTID %v is unknown or no longer available", gtidSet, gtid)
+	}
+
+	// Anything streamed past this point will be streamed again.
+	if n < int64(len(positions)) {
+		positions = positions[:n]
+		if err := pg.save(ctx, gtid.Server, chunk, positions); err != nil {
+			return mysql.SID{}, 0, nil, err
+		}
+	}
+	for next := chunk + 1; ; next++ {
+		found, err := pg.forget(ctx, gtid.Server, next)
+		if err != nil {
+			return mysql.SID{}, 0, nil, err
+		}
+		if !found {
+			break
+		}
+	}
+	return gtid.Server, gtid.Sequence, positions, nil
+}
+
+// binlogDumpStream translates VStream events into binlog events.
+type binlogDumpStream struct {
+	ctx   context.Context
+	gtids *pseudoGTIDs
+	sid   mysql.SID
+	seq   int64
+	vgtid *binlogdatapb.VGtid
+	send  func(mysql.BinlogEvent) error
+
+	// positions are the VGTIDs of the pseudo-GTIDs of the current chunk,
+	// up to seq. They are saved up to saved, at savedAt.
+	positions []*binlogdatapb.VGtid
+	saved     int64
+	savedAt   time.Time
+
+	// pending holds the events held back until the pseudo-GTIDs of
+	// their transactions are saved.
+	pending []mysql.BinlogEvent
+
+	format   mysql.BinlogFormat
+	stream   *mysql.FakeBinlogStream
+	position uint32
+
+	tables      map[string]*binlogDumpTable
+	nextTableID uint64
+
+	// rows holds the row events of the current transaction.
+	rows []*binlogdatapb.RowEvent
+}
+
+// binlogDumpTable is the table map of a streamed table.
+type binlogDumpTable struct {
+	id     uint64
+	fields []*querypb.Field
+	tm     *mysql.TableMap
+}
+
+// newBinlogDumpStream returns a stream that resumes after the pseudo-GTID
+// sid:seq, whose chunk holds positions, from the VGTID vgtid.
+func newBinlogDumpStream(ctx context.Context, gtids *pseudoGTIDs, sid mysql.SID, seq int64, positions []*binlogdatapb.VGtid, vgtid *binlogdatapb.VGtid, send func(mysql.BinlogEvent) error) *binlogDumpStream {
+	format := mysql.NewMySQL56BinlogFormat()
+	format.ServerVersion = servenv.AppVersion.MySQLVersion()
+	format.ChecksumAlgorithm = mysql.BinlogChecksumAlgOff
+
+	stream := mysql.NewFakeBinlogStream()
+	stream.LogPosition = 0
+	stream.Timestamp = uint32(time.Now().Unix())
+
+	return &binlogDumpStream{
+		ctx:         ctx,
+		gtids:       gtids,
+		sid:         sid,
+		seq:         seq,
+		vgtid:       vgtid,
+		send:        send,
+		positions:   positions,
+		saved:       seq,
+		savedAt:     time.Now(),
+		format:      format,
+		stream:      stream,
+		position:    4, // The binlog magic number.
+		tables:      make(map[string]*binlogDumpTable),
+		nextTableID: 1,
+	}
+}
+
+// start sends the events that open a binlog stream.
+func (bds *binlogDumpStream) start() error {
+	// The rotate event is artificial and does not advance the position.
+	if err := bds.send(mysql.NewRotateEvent(bds.format, bds.stream, uint64(bds.position), binlogDumpFilename)); err != nil {
+		return err
+	}
+	bds.write(mysql.NewFormatDescriptionEvent(bds.format, bds.stream))
+	return bds.flush()
+}
+
+// write queues an event and advances the log position. The event is sent
+// by the next flush.
+func (bds *binlogDumpStream) write(ev mysql.BinlogEvent) {
+	buf := ev.Bytes()
+	bds.position += uint32(len(buf))
+	// The log position of an event header is the position of the next event.
+	binary.LittleEndian.PutUint32(buf[13:17], bds.position)
+	bds.pending = append(bds.pending, ev)
+}
+
+// flush sends the queued events.
+func (bds *binlogDumpStream) flush() error {
+	for i, ev := range bds.pending {
+		bds.pending[i] = nil
+		if err := bds.send(ev); err != nil {
+			return err
+		}
+	}
+	bds.pending = bds.pending[:0]
+	return nil
+}
+
+// checkpoint saves the pseudo-GTIDs of the current chunk, then sends the
+// transactions that were held back. Once in a while, the chunk is saved
+// again even if it did not change, so that the stream does not expire
+// while it is served.
+func (bds *binlogDumpStream) checkpoint() error {
+	if bds.saved < bds.seq || (bds.seq > 0 && time.Since(bds.savedAt) >= *binlogDumpPositionTTL/2) {
+		if err := bds.gtids.save(bds.ctx, bds.sid, pseudoGTIDChunk(bds.seq), bds.positions); err != nil {
+			return err
+		}
+		bds.saved = bds.seq
+		bds.savedAt = time.Now()
+	}
+	return bds.flush()
+}
+
+// onEvents is the VStream callback.
+func (bds *binlogDumpStream) onEvents(events []*binlogdatapb.VEvent) error {
+	for _, event := range events {
+		switch event.Type {
+		case binlogdatapb.VEventType_BEGIN:
+			bds.rows = bds.rows[:0]
+		case binlogdatapb.VEventType_FIELD:
+			bds.addTable(event.FieldEvent)
+		case binlogdatapb.VEventType_ROW:
+			bds.rows = append(bds.rows, event.RowEvent)
+		case binlogdatapb.VEventType_VGTID:
+			bds.vgtid = event.Vgtid
+		case binlogdatapb.VEventType_COMMIT:
+			if len(bds.rows) == 0 {
+				continue
+			}
+			if err := bds.commit(event); err != nil {
+				return err
+			}
+		case binlogdatapb.VEventType_DDL:
+			if err := bds.ddl(event); err != nil {
+				return err
+			}
+		}
+		if bds.seq-bds.saved >= int64(*binlogDumpCheckpointTransactions) {
+			if err := bds.checkpoint(); err != nil {
+				return err
+			}
+		}
+	}
+	if time.Since(bds.savedAt) >= *binlogDumpCheckpointInterval {
+		return bds.checkpoint()
+	}
+	return nil
+}
+
+// addTable creates a new table map for the table described by ev.
+func (bds *binlogDumpStream) addTable(ev *binlogdatapb.FieldEvent) {
+	database, name := splitQualifiedTableName(ev.TableName)
+	tm := &mysql.TableMap{
+		Database:  database,
+		Name:      name,
+		Types:     make([]byte, len(ev.Fields)),
+		CanBeNull: mysql.NewServerBitmap(len(ev.Fields)),
+		Metadata:  make([]uint16, len(ev.Fields)),
+	}
+	for i, field := range ev.Fields {
+		tm.Types[i], tm.Metadata[i] = binlogColumnType(field.Type)
+		tm.CanBeNull.Set(i, field.Flags&uint32(querypb.MySqlFlag_NOT_NULL_FLAG) == 0)
+	}
+	bds.tables[ev.TableName] = &binlogDumpTable{
+		id:     bds.nextTableID,
+		fields: ev.Fields,
+		tm:     tm,
+	}
+	bds.nextTableID++
+}
+
+// commit queues the buffered row events as a transaction.
+func (bds *binlogDumpStream) commit(ev *binlogdatapb.VEvent) error {
+	bds.setTimestamp(ev)
+	database, _ := splitQualifiedTableName(bds.rows[0].TableName)
+	if err := bds.beginTransaction(database, "BEGIN"); err != nil {
+		return err
+	}
+	for _, rowEvent := range bds.rows {
+		table, ok := bds.tables[rowEvent.TableName]
+		if !ok {
+			return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] no fields received for table %s", rowEvent.TableName)
+		}
+		if err := bds.writeRows(table, rowEvent.RowChanges); err != nil {
+			return err
+		}
+	}
+	bds.write(mysql.NewXIDEvent(bds.format, bds.stream))
+	bds.rows = bds.rows[:0]
+	return nil
+}
+
+// ddl queues a DDL as a transaction of its own.
+func (bds *binlogDumpStream) ddl(ev *binlogdatapb.VEvent) error {
+	bds.setTimestamp(ev)
+	return bds.beginTransaction(ev.Keyspace, ev.Statement)
+}
+
+// beginTransaction queues the GTID event of the next transaction, followed
+// by its first query event, and remembers the VGTID reached by the
+// transaction. The chunk of the previous transaction is saved first if
+// the transaction starts a new one, and the chunks beyond
+// binlogDumpPositionCacheSize are forgotten.
+func (bds *binlogDumpStream) beginTransaction(database, sql string) error {
+	if bds.seq > 0 && bds.seq%binlogDumpChunkSize == 0 {
+		if err := bds.checkpoint(); err != nil {
+			return err
+		}
+		bds.positions = nil
+		keep := (int64(*binlogDumpPositionCacheSize) + binlogDumpChunkSize - 1) / binlogDumpChunkSize
+		if old := pseudoGTIDChunk(bds.seq+1) - keep - 1; old >= 0 {
+			if _, err := bds.gtids.forget(bds.ctx, bds.sid, old); err != nil {
+				return err
+			}
+		}
+	}
+	bds.seq++
+	bds.positions = append(bds.positions, bds.vgtid)
+
+	gtid := mysql.Mysql56GTID{Server: bds.sid, Sequence: bds.seq}
+	bds.write(mysql.NewMySQL56GTIDEvent(bds.format, bds.stream, gtid))
+	bds.write(mysql.NewQueryEvent(bds.format, bds.stream, mysql.Query{
+		Database: database,
+		SQL:      sql,
+	}))
+	return nil
+}
+
+func (bds *binlogDumpStream) setTimestamp(ev *binlogdatapb.VEvent) {
+	if ev.Timestamp != 0 {
+		bds.stream.Timestamp = uint32(ev.Timestamp)
+	}
+}
+
+// writeRows queues consecutive changes of the same kind as a single rows
+// event, each preceded by the table map.
+func (bds *binlogDumpStream) writeRows(table *binlogDumpTable, changes []*binlogdatapb.RowChange) error {
+	for len(changes) > 0 {
+		typ := rowChangeType(changes[0])
+		n := 1
+		for n < len(changes) && rowChangeType(changes[n]) == typ {
+			n++
+		}
+
+		columns := mysql.NewServerBitmap(len(table.fields))
+		for i := range table.fields {
+			columns.Set(i, true)
+		}
+		rows := mysql.Rows{
+			// Every rows event ends a statement, which releases the table map.
+			Flags: 0x0001,
+		}
+		for _, change := range changes[:n] {
+			var row mysql.Row
+			var err error
+			if change.Before != nil {
+				rows.IdentifyColumns = columns
+				if row.NullIdentifyColumns, row.Identify, err = encodeBinlogRow(table, change.Before); err != nil {
+					return err
+				}
+			}
+			if change.After != nil {
+				rows.DataColumns = columns
+				if row.NullColumns, row.Data, err = encodeBinlogRow(table, change.After); err != nil {
+					return err
+				}
+			}
+			rows.Rows = append(rows.Rows, row)
+		}
+
+		bds.write(mysql.NewTableMapEvent(bds.format, bds.stream, table.id, table.tm))
+		var ev mysql.BinlogEvent
+		switch typ {
+		case rowInsert:
+			ev = mysql.NewWriteRowsEvent(bds.format, bds.stream, table.id, rows)
+		case rowUpdate:
+			ev = mysql.NewUpdateRowsEvent(bds.format, bds.stream, table.id, rows)
+		default:
+			ev = mysql.NewDeleteRowsEvent(bds.format, bds.stream, table.id, rows)
+		}
+		bds.write(ev)
+		changes = changes[n:]
+	}
+	return nil
+}
+
+const (
+	rowInsert = iota
+	rowUpdate
+	rowDelete
+)
+
+func rowChangeType(change *binlogdatapb.RowChange) int {
+	switch {
+	case change.Before == nil:
+		return rowInsert
+	case change.After == nil:
+		return rowDelete
+	default:
+		return rowUpdate
+	}
+}
+
+// splitQualifiedTableName splits the keyspace qualified table names
+// sent by the VStream.
+func splitQualifiedTableName(name string) (string, string) {
+	if idx := strings.IndexByte(name, '.'); idx >= 0 {
+		return name[:idx], name[idx+1:]
+	}
+	return "", name
+}
+
+// binlogColumnType returns the binlog type and metadata used to encode
+// values of the given type. Values that have no exact binary counterpart
+// are sent in their text representation.
+func binlogColumnType(typ querypb.Type) (byte, uint16) {
+	switch typ {
+	case sqltypes.Int8, sqltypes.Uint8:
+		return mysql.TypeTiny, 0
+	case sqltypes.Int16, sqltypes.Uint16:
+		return mysql.TypeShort, 0
+	case sqltypes.Int24, sqltypes.Uint24:
+		return mysql.TypeInt24, 0
+	case sqltypes.Int32, sqltypes.Uint32:
+		return mysql.TypeLong, 0
+	case sqltypes.Int64, sqltypes.Uint64:
+		return mysql.TypeLongLong, 0
+	case sqltypes.Float32:
+		return mysql.TypeFloat, 4
+	case sqltypes.Float64:
+		return mysql.TypeDouble, 8
+	case sqltypes.Text, sqltypes.Blob, sqltypes.TypeJSON, sqltypes.Geometry:
+		// A 4 byte length prefix.
+		return mysql.TypeBlob, 4
+	default:
+		return mysql.TypeVarchar, math.MaxUint16
+	}
+}
+
+// encodeBinlogRow returns the null bitmap and the binary encoding of row.
+func encodeBinlogRow(table *binlogDumpTable, row *querypb.Row) (mysql.Bitmap, []byte, error) {
+	values := sqltypes.MakeRowTrusted(table.fields, row)
+	nulls := mysql.NewServerBitmap(len(table.fields))
+	var data []byte
+	for i, value := range values {
+		if value.IsNull() {
+			nulls.Set(i, true)
+			continue
+		}
+		var err error
+		if data, err = appendBinlogValue(data, table.tm.Types[i], value); err != nil {
+			return nulls, nil, err
+		}
+	}
+	return nulls, data, nil
+}
+
+func appendBinlogValue(data []byte, typ byte, value sqltypes.Value) ([]byte, error) {
+	switch typ {
+	case mysql.TypeTiny, mysql.TypeShort, mysql.TypeInt24, mysql.TypeLong, mysql.TypeLongLong:
+		var n uint64
+		if sqltypes.IsUnsigned(value.Type()) {
+			u, err := value.ToUint64()
+			if err != nil {
+				return nil, err
+			}
+			n = u
+		} else {
+			i, err := value.ToInt64()
+			if err != nil {
+				return nil, err
+			}
+			n = uint64(i)
+		}
+		var buf [8]byte
+		binary.LittleEndian.PutUint64(buf[:], n)
+		return append(data, buf[:binlogIntegerLength(typ)]...), nil
+	case mysql.TypeFloat:
+		f, err := value.ToFloat64()
+		if err != nil {
+			return nil, err
+		}
+		var buf [4]byte
+		binary.LittleEndian.PutUint32(buf[:], math.Float32bits(float32(f)))
+		return append(data, buf[:]...), nil
+	case mysql.TypeDouble:
+		f, err := value.ToFloat64()
+		if err != nil {
+			return nil, err
+		}
+		var buf [8]byte
+		binary.LittleEndian.PutUint64(buf[:], math.Float64bits(f))
+		return append(data, buf[:]...), nil
+	case mysql.TypeBlob:
+		raw := value.Raw()
+		var buf [4]byte
+		binary.LittleEndian.PutUint32(buf[:], uint32(len(raw)))
+		data = append(data, buf[:]...)
+		return append(data, raw...), nil
+	default:
+		raw := value.Raw()
+		if len(raw) > math.MaxUint16 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "value of type %v is too long for a binlog dump: %d bytes", value.Type(), len(raw))
+		}
+		var buf [2]byte
+		binary.LittleEndian.PutUint16(buf[:], uint16(len(raw)))
+		data = append(data, buf[:]...)
+		return append(data, raw...), nil
+	}
+}
+
+func binlogIntegerLength(typ byte) int {
+	switch typ {
+	case mysql.TypeTiny:
+		return 1
+	case mysql.TypeShort:
+		return 2
+	case mysql.TypeInt24:
+		return 3
+	case mysql.TypeLong:
+		return 4
+	default:
+		return 8
+	}
+}
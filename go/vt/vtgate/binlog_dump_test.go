@@ -0,0 +1,328 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func TestBinlogDumpStream(t *testing.T) {
+	defer func(interval time.Duration) { *binlogDumpCheckpointInterval = interval }(*binlogDumpCheckpointInterval)
+	*binlogDumpCheckpointInterval = 0
+
+	ctx := context.Background()
+	gtids := newPseudoGTIDs(nil)
+	sid := gtids.newStream()
+	var events []mysql.BinlogEvent
+	bds := newBinlogDumpStream(ctx, gtids, sid, 0, nil, nil, func(ev mysql.BinlogEvent) error {
+		events = append(events, ev)
+		return nil
+	})
+	require.NoError(t, bds.start())
+
+	fields := []*querypb.Field{
+		{Name: "id", Type: sqltypes.Int64, Flags: uint32(querypb.MySqlFlag_NOT_NULL_FLAG)},
+		{Name: "name", Type: sqltypes.VarChar},
+		{Name: "price", Type: sqltypes.Float64},
+		{Name: "note", Type: sqltypes.Text},
+	}
+	row := func(values ...string) *querypb.Row {
+		var vals []sqltypes.Value
+		for i, v := range values {
+			if v == "NULL" {
+				vals = append(vals, sqltypes.NULL)
+				continue
+			}
+			vals = append(vals, sqltypes.MakeTrusted(fields[i].Type, []byte(v)))
+		}
+		return sqltypes.RowToProto3(vals)
+	}
+	vgtid1 := &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{Keyspace: "ks", Shard: "-80", Gtid: "pos1"}}}
+	vgtid2 := &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{Keyspace: "ks", Shard: "-80", Gtid: "pos2"}}}
+	vgtid3 := &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{Keyspace: "ks", Shard: "-80", Gtid: "pos3"}}}
+
+	err := bds.onEvents([]*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_BEGIN},
+		{Type: binlogdatapb.VEventType_FIELD, FieldEvent: &binlogdatapb.FieldEvent{TableName: "ks.t1", Fields: fields}},
+		{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{
+			TableName: "ks.t1",
+			RowChanges: []*binlogdatapb.RowChange{
+				{After: row("1", "a", "1.5", "NULL")},
+				{After: row("2", "b", "2.5", "long note")},
+				{Before: row("1", "a", "1.5", "NULL"), After: row("1", "c", "1.5", "NULL")},
+				{Before: row("2", "b", "2.5", "long note")},
+			},
+		}},
+		{Type: binlogdatapb.VEventType_VGTID, Vgtid: vgtid1},
+		{Type: binlogdatapb.VEventType_COMMIT, Timestamp: 1000},
+		// Transactions without rows are skipped.
+		{Type: binlogdatapb.VEventType_BEGIN},
+		{Type: binlogdatapb.VEventType_VGTID, Vgtid: vgtid2},
+		{Type: binlogdatapb.VEventType_COMMIT},
+		{Type: binlogdatapb.VEventType_VGTID, Vgtid: vgtid3},
+		{Type: binlogdatapb.VEventType_DDL, Keyspace: "ks", Statement: "alter table t1 add column x int"},
+	})
+	require.NoError(t, err)
+
+	f := bds.format
+	var got []string
+	var tm *mysql.TableMap
+	for _, ev := range events {
+		switch {
+		case ev.IsRotate():
+			got = append(got, "rotate")
+		case ev.IsFormatDescription():
+			got = append(got, "format")
+		case ev.IsGTID():
+			gtid, _, err := ev.GTID(f)
+			require.NoError(t, err)
+			got = append(got, fmt.Sprintf("gtid %v", gtid))
+		case ev.IsQuery():
+			q, err := ev.Query(f)
+			require.NoError(t, err)
+			got = append(got, fmt.Sprintf("query %s: %s", q.Database, q.SQL))
+		case ev.IsTableMap():
+			tm, err = ev.TableMap(f)
+			require.NoError(t, err)
+			got = append(got, fmt.Sprintf("table map %d %s.%s", ev.TableID(f), tm.Database, tm.Name))
+		case ev.IsWriteRows(), ev.IsUpdateRows(), ev.IsDeleteRows():
+			rows, err := ev.Rows(f, tm)
+			require.NoError(t, err)
+			for i := range rows.Rows {
+				var before, after []string
+				if ev.IsUpdateRows() || ev.IsDeleteRows() {
+					before, err = rows.StringIdentifiesForTests(tm, i)
+					require.NoError(t, err)
+				}
+				if ev.IsWriteRows() || ev.IsUpdateRows() {
+					after, err = rows.StringValuesForTests(tm, i)
+					require.NoError(t, err)
+				}
+				got = append(got, fmt.Sprintf("row %v -> %v", before, after))
+			}
+		case ev.IsXID():
+			got = append(got, "commit")
+		default:
+			t.Fatalf("unexpected event type %v", ev.Bytes()[4])
+		}
+	}
+
+	want := []string{
+		"rotate",
+		"format",
+		fmt.Sprintf("gtid %v:1", sid),
+		"query ks: BEGIN",
+		"table map 1 ks.t1",
+		"row [] -> [1 a 1.5E+00 NULL]",
+		"row [] -> [2 b 2.5E+00 long note]",
+		"table map 1 ks.t1",
+		"row [1 a 1.5E+00 NULL] -> [1 c 1.5E+00 NULL]",
+		"table map 1 ks.t1",
+		"row [2 b 2.5E+00 long note] -> []",
+		"commit",
+		fmt.Sprintf("gtid %v:2", sid),
+		"query ks: alter table t1 add column x int",
+	}
+	assert.Equal(t, want, got)
+
+	// The log positions are contiguous.
+	pos := uint32(4)
+	for _, ev := range events[1:] {
+		pos += uint32(len(ev.Bytes()))
+		assert.EqualValues(t, pos, ev.NextPosition())
+	}
+
+	set := mysql.Mysql56GTIDSet{}.AddGTID(mysql.Mysql56GTID{Server: sid, Sequence: 1})
+	_, seq, positions, err := gtids.resume(ctx, set)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, seq)
+	assert.Equal(t, []*binlogdatapb.VGtid{vgtid1}, positions)
+}
+
+// ddlEvents returns the events of a DDL that reaches the position pos.
+func ddlEvents(pos string) []*binlogdatapb.VEvent {
+	return []*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_VGTID, Vgtid: &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{Keyspace: "ks", Shard: "0", Gtid: pos}}}},
+		{Type: binlogdatapb.VEventType_DDL, Keyspace: "ks", Statement: "create table t" + pos + "(id int)"},
+	}
+}
+
+func gtidSet(sid mysql.SID, last int64) mysql.GTIDSet {
+	var set mysql.GTIDSet = mysql.Mysql56GTIDSet{}
+	for i := int64(1); i <= last; i++ {
+		set = set.AddGTID(mysql.Mysql56GTID{Server: sid, Sequence: i})
+	}
+	return set
+}
+
+func TestBinlogDumpCheckpoint(t *testing.T) {
+	defer func(interval time.Duration, transactions int) {
+		*binlogDumpCheckpointInterval = interval
+		*binlogDumpCheckpointTransactions = transactions
+	}(*binlogDumpCheckpointInterval, *binlogDumpCheckpointTransactions)
+	*binlogDumpCheckpointInterval = time.Hour
+	*binlogDumpCheckpointTransactions = 3
+
+	ctx := context.Background()
+	gtids := newPseudoGTIDs(memorytopo.NewServer("cell1"))
+	sid := gtids.newStream()
+	var sent int
+	bds := newBinlogDumpStream(ctx, gtids, sid, 0, nil, nil, func(ev mysql.BinlogEvent) error {
+		sent++
+		return nil
+	})
+	require.NoError(t, bds.start())
+	// The rotate and format description events.
+	assert.Equal(t, 2, sent)
+
+	// Transactions are held back until their pseudo-GTIDs are saved.
+	require.NoError(t, bds.onEvents(ddlEvents("pos1")))
+	require.NoError(t, bds.onEvents(ddlEvents("pos2")))
+	assert.Equal(t, 2, sent)
+	_, _, _, err := gtids.resume(ctx, gtidSet(sid, 1))
+	assert.EqualError(t, err, fmt.Sprintf("cannot resume binlog dump from GTID set %v:1: pseudo-GTID %v:1 is unknown or no longer available", sid, sid))
+
+	// They are saved every binlog_dump_checkpoint_transactions.
+	require.NoError(t, bds.onEvents(ddlEvents("pos3")))
+	assert.Equal(t, 2+3*2, sent)
+	_, seq, positions, err := gtids.resume(ctx, gtidSet(sid, 2))
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, seq)
+	assert.Len(t, positions, 2)
+
+	// And every binlog_dump_checkpoint_interval, which heartbeats let
+	// happen when the stream is idle.
+	require.NoError(t, bds.onEvents(ddlEvents("pos4")))
+	assert.Equal(t, 2+3*2, sent)
+	*binlogDumpCheckpointInterval = 0
+	require.NoError(t, bds.onEvents([]*binlogdatapb.VEvent{{Type: binlogdatapb.VEventType_HEARTBEAT}}))
+	assert.Equal(t, 2+4*2, sent)
+}
+
+func TestPseudoGTIDsResume(t *testing.T) {
+	defer func(size int, transactions int, chunkSize int64) {
+		*binlogDumpPositionCacheSize = size
+		*binlogDumpCheckpointTransactions = transactions
+		binlogDumpChunkSize = chunkSize
+	}(*binlogDumpPositionCacheSize, *binlogDumpCheckpointTransactions, binlogDumpChunkSize)
+	*binlogDumpPositionCacheSize = 3
+	*binlogDumpCheckpointTransactions = 1
+	binlogDumpChunkSize = 2
+
+	inMemory := newPseudoGTIDs(nil)
+	ts := memorytopo.NewServer("cell1")
+	testcases := []struct {
+		name string
+		// The stream is resumed by resumer, which can be another vtgate.
+		recorder, resumer *pseudoGTIDs
+	}{{
+		name:     "memory",
+		recorder: inMemory,
+		resumer:  inMemory,
+	}, {
+		name:     "topo",
+		recorder: newPseudoGTIDs(ts),
+		resumer:  newPseudoGTIDs(ts),
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			ctx := context.Background()
+			sid := tcase.recorder.newStream()
+			bds := newBinlogDumpStream(ctx, tcase.recorder, sid, 0, nil, nil, func(mysql.BinlogEvent) error { return nil })
+			var vgtids []*binlogdatapb.VGtid
+			for i := 1; i <= 7; i++ {
+				events := ddlEvents(fmt.Sprintf("pos%d", i))
+				vgtids = append(vgtids, events[0].Vgtid)
+				require.NoError(t, bds.onEvents(events))
+			}
+
+			gotSID, seq, positions, err := tcase.resumer.resume(ctx, gtidSet(sid, 6))
+			require.NoError(t, err)
+			assert.Equal(t, sid, gotSID)
+			assert.EqualValues(t, 6, seq)
+			// The positions of the chunk of seq 5 and 6.
+			require.Len(t, positions, 2)
+			for i, vgtid := range positions {
+				assert.True(t, proto.Equal(vgtids[4+i], vgtid), "got %v, want %v", vgtid, vgtids[4+i])
+			}
+
+			// Resuming forgets what was streamed past the resume point.
+			_, _, _, err = tcase.resumer.resume(ctx, gtidSet(sid, 7))
+			assert.EqualError(t, err, fmt.Sprintf("cannot resume binlog dump from GTID set %v:1-7: pseudo-GTID %v:7 is unknown or no longer available", sid, sid))
+
+			// Only the last positions are remembered.
+			_, _, _, err = tcase.resumer.resume(ctx, gtidSet(sid, 2))
+			assert.EqualError(t, err, fmt.Sprintf("cannot resume binlog dump from GTID set %v:1-2: pseudo-GTID %v:2 is unknown or no longer available", sid, sid))
+			_, seq, positions, err = tcase.resumer.resume(ctx, gtidSet(sid, 3))
+			require.NoError(t, err)
+			assert.EqualValues(t, 3, seq)
+			require.Len(t, positions, 1)
+			assert.True(t, proto.Equal(vgtids[2], positions[0]), "got %v, want %v", positions[0], vgtids[2])
+
+			other := tcase.recorder.newStream()
+			_, _, _, err = tcase.resumer.resume(ctx, gtidSet(sid, 3).Union(gtidSet(other, 1)))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestPseudoGTIDsExpire(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	gtids := newPseudoGTIDs(ts)
+	vgtid := &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{Keyspace: "ks", Shard: "0", Gtid: "pos"}}}
+
+	active := gtids.newStream()
+	require.NoError(t, gtids.save(ctx, active, 0, []*binlogdatapb.VGtid{vgtid}))
+
+	// A stream that was last saved two hours ago.
+	abandoned := gtids.newStream()
+	data, err := proto.Marshal(&vtgatepb.BinlogDumpPositions{
+		Positions:   []*binlogdatapb.VGtid{vgtid},
+		TimeUpdated: time.Now().Add(-2 * time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+	conn, err := ts.ConnForCell(ctx, topo.GlobalCell)
+	require.NoError(t, err)
+	_, err = conn.Create(ctx, pseudoGTIDChunkPath(abandoned, 0), data)
+	require.NoError(t, err)
+
+	require.NoError(t, gtids.expire(ctx, time.Hour))
+
+	_, _, _, err = gtids.resume(ctx, gtidSet(active, 1))
+	assert.NoError(t, err)
+	_, _, _, err = gtids.resume(ctx, gtidSet(abandoned, 1))
+	assert.Error(t, err)
+	_, err = conn.ListDir(ctx, pseudoGTIDStreamPath(abandoned), false)
+	assert.True(t, topo.IsErrType(err, topo.NoNode), "%v", err)
+}
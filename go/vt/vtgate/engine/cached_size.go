@@ -78,6 +78,58 @@ func (cached *Concatenate) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+
+//go:nocheckptr
+func (cached *CorrelatedSubquery) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(176)
+	}
+	// field SubqueryResult string
+	size += hack.RuntimeAllocSize(int64(len(cached.SubqueryResult)))
+	// field HasValues string
+	size += hack.RuntimeAllocSize(int64(len(cached.HasValues)))
+	// field Outer vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Outer.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Subquery vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Subquery.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Cols []int
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Cols)) * int64(8))
+	}
+	// field Vars map[string]int
+	if cached.Vars != nil {
+		size += int64(48)
+		hmap := reflect.ValueOf(cached.Vars)
+		numBuckets := int(math.Pow(2, float64((*(*uint8)(unsafe.Pointer(hmap.Pointer() + uintptr(9)))))))
+		numOldBuckets := (*(*uint16)(unsafe.Pointer(hmap.Pointer() + uintptr(10))))
+		size += hack.RuntimeAllocSize(int64(numOldBuckets * 208))
+		if len(cached.Vars) > 0 || numBuckets > 1 {
+			size += hack.RuntimeAllocSize(int64(numBuckets * 208))
+		}
+		for k := range cached.Vars {
+			size += hack.RuntimeAllocSize(int64(len(k)))
+		}
+	}
+	// field Predicate vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Predicate.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field ASTPredicate vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.ASTPredicate.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field BatchVar string
+	size += hack.RuntimeAllocSize(int64(len(cached.BatchVar)))
+	return size
+}
 func (cached *DBDDL) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -118,7 +170,7 @@ func (cached *DML) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(96)
+		size += int64(112)
 	}
 	// field Query string
 	size += hack.RuntimeAllocSize(int64(len(cached.Query)))
@@ -130,6 +182,10 @@ func (cached *DML) CachedSize(alloc bool) int64 {
 	size += cached.Table.CachedSize(true)
 	// field OwnedVindexQuery string
 	size += hack.RuntimeAllocSize(int64(len(cached.OwnedVindexQuery)))
+	// field LimitQuery vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.LimitQuery.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
 	// field RoutingParameters *vitess.io/vitess/go/vt/vtgate/engine.RoutingParameters
 	size += cached.RoutingParameters.CachedSize(true)
 	return size
@@ -164,6 +220,48 @@ func (cached *Distinct) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+
+//go:nocheckptr
+func (cached *ExecStmt) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(80)
+	}
+	// field Name string
+	size += hack.RuntimeAllocSize(int64(len(cached.Name)))
+	// field Params []string
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Params)) * int64(16))
+		for _, elem := range cached.Params {
+			size += hack.RuntimeAllocSize(int64(len(elem)))
+		}
+	}
+	// field BindVars map[string]*vitess.io/vitess/go/vt/proto/query.BindVariable
+	if cached.BindVars != nil {
+		size += int64(48)
+		hmap := reflect.ValueOf(cached.BindVars)
+		numBuckets := int(math.Pow(2, float64((*(*uint8)(unsafe.Pointer(hmap.Pointer() + uintptr(9)))))))
+		numOldBuckets := (*(*uint16)(unsafe.Pointer(hmap.Pointer() + uintptr(10))))
+		size += hack.RuntimeAllocSize(int64(numOldBuckets * 208))
+		if len(cached.BindVars) > 0 || numBuckets > 1 {
+			size += hack.RuntimeAllocSize(int64(numBuckets * 208))
+		}
+		for k, v := range cached.BindVars {
+			size += hack.RuntimeAllocSize(int64(len(k)))
+			size += v.CachedSize(true)
+		}
+	}
+	// field BindVarNeeds *vitess.io/vitess/go/vt/sqlparser.BindVarNeeds
+	size += cached.BindVarNeeds.CachedSize(true)
+	// field Input vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Input.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *Filter) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -186,6 +284,100 @@ func (cached *Filter) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *FkCascade) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field Selection vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Selection.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Children []*vitess.io/vitess/go/vt/vtgate/engine.FkChild
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Children)) * int64(8))
+		for _, elem := range cached.Children {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field DML vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.DML.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *FkCheck) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Values [][]vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Values)) * int64(24))
+		for _, elem := range cached.Values {
+			{
+				size += hack.RuntimeAllocSize(int64(cap(elem)) * int64(16))
+				for _, elem := range elem {
+					if cc, ok := elem.(cachedObject); ok {
+						size += cc.CachedSize(true)
+					}
+				}
+			}
+		}
+	}
+	// field Parent vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Parent.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *FkChild) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field Cols []int
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Cols)) * int64(8))
+	}
+	// field OnDelete string
+	size += hack.RuntimeAllocSize(int64(len(cached.OnDelete)))
+	// field Exec vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Exec.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *FkVerify) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Checks []*vitess.io/vitess/go/vt/vtgate/engine.FkCheck
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Checks)) * int64(8))
+		for _, elem := range cached.Checks {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field DML vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.DML.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *Gen4CompareV3) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -268,7 +460,7 @@ func (cached *Insert) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(224)
+		size += int64(320)
 	}
 	// field Keyspace *vitess.io/vitess/go/vt/vtgate/vindexes.Keyspace
 	size += cached.Keyspace.CachedSize(true)
@@ -328,6 +520,48 @@ func (cached *Insert) CachedSize(alloc bool) int64 {
 	if cc, ok := cached.Input.(cachedObject); ok {
 		size += cc.CachedSize(true)
 	}
+	// field OwnedVindexQuery string
+	size += hack.RuntimeAllocSize(int64(len(cached.OwnedVindexQuery)))
+	// field ReplaceKeys [][]vitess.io/vitess/go/vt/sqlparser.ColIdent
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.ReplaceKeys)) * int64(24))
+		for _, elem := range cached.ReplaceKeys {
+			{
+				size += hack.RuntimeAllocSize(int64(cap(elem)) * int64(40))
+				for _, elem := range elem {
+					size += elem.CachedSize(false)
+				}
+			}
+		}
+	}
+	// field ReplaceKeyValues [][][]vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.ReplaceKeyValues)) * int64(24))
+		for _, elem := range cached.ReplaceKeyValues {
+			{
+				size += hack.RuntimeAllocSize(int64(cap(elem)) * int64(24))
+				for _, elem := range elem {
+					{
+						size += hack.RuntimeAllocSize(int64(cap(elem)) * int64(16))
+						for _, elem := range elem {
+							if cc, ok := elem.(cachedObject); ok {
+								size += cc.CachedSize(true)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	// field ReplaceKeyOffsets [][]int
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.ReplaceKeyOffsets)) * int64(24))
+		for _, elem := range cached.ReplaceKeyOffsets {
+			{
+				size += hack.RuntimeAllocSize(int64(cap(elem)) * int64(8))
+			}
+		}
+	}
 	return size
 }
 
@@ -390,6 +624,42 @@ func (cached *Limit) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *Load) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(128)
+	}
+	// field Insert *vitess.io/vitess/go/vt/vtgate/engine.Insert
+	size += cached.Insert.CachedSize(true)
+	// field FileName string
+	size += hack.RuntimeAllocSize(int64(len(cached.FileName)))
+	// field Format vitess.io/vitess/go/vt/vtgate/engine.LoadFormat
+	size += cached.Format.CachedSize(false)
+	return size
+}
+func (cached *LoadFormat) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(96)
+	}
+	// field FieldsTerminatedBy string
+	size += hack.RuntimeAllocSize(int64(len(cached.FieldsTerminatedBy)))
+	// field FieldsEnclosedBy string
+	size += hack.RuntimeAllocSize(int64(len(cached.FieldsEnclosedBy)))
+	// field FieldsEscapedBy string
+	size += hack.RuntimeAllocSize(int64(len(cached.FieldsEscapedBy)))
+	// field LinesStartingBy string
+	size += hack.RuntimeAllocSize(int64(len(cached.LinesStartingBy)))
+	// field LinesTerminatedBy string
+	size += hack.RuntimeAllocSize(int64(len(cached.LinesTerminatedBy)))
+	return size
+}
 func (cached *Lock) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -544,7 +814,7 @@ func (cached *Plan) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(128)
+		size += int64(160)
 	}
 	// field Original string
 	size += hack.RuntimeAllocSize(int64(len(cached.Original)))
@@ -561,6 +831,13 @@ func (cached *Plan) CachedSize(alloc bool) int64 {
 			size += elem.CachedSize(true)
 		}
 	}
+	// field ResultCacheTables []string
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.ResultCacheTables)) * int64(16))
+		for _, elem := range cached.ResultCacheTables {
+			size += hack.RuntimeAllocSize(int64(len(elem)))
+		}
+	}
 	return size
 }
 func (cached *Projection) CachedSize(alloc bool) int64 {
@@ -615,6 +892,24 @@ func (cached *PulloutSubquery) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *RecurseCTE) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Seed vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Seed.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Recurse vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Recurse.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *RenameFields) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -1021,7 +1316,7 @@ func (cached *Update) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(16)
+		size += int64(48)
 	}
 	// field DML *vitess.io/vitess/go/vt/vtgate/engine.DML
 	size += cached.DML.CachedSize(true)
@@ -1040,6 +1335,10 @@ func (cached *Update) CachedSize(alloc bool) int64 {
 			size += v.CachedSize(true)
 		}
 	}
+	// field MovedRowsQuery string
+	size += hack.RuntimeAllocSize(int64(len(cached.MovedRowsQuery)))
+	// field MovedRowsDeleteQuery string
+	size += hack.RuntimeAllocSize(int64(len(cached.MovedRowsDeleteQuery)))
 	return size
 }
 func (cached *UpdateTarget) CachedSize(alloc bool) int64 {
@@ -1148,6 +1447,58 @@ func (cached *VindexValues) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *Window) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(96)
+	}
+	// field PartitionBy []*vitess.io/vitess/go/vt/vtgate/engine.GroupByParams
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.PartitionBy)) * int64(8))
+		for _, elem := range cached.PartitionBy {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field OrderBy []vitess.io/vitess/go/vt/vtgate/engine.OrderByParams
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.OrderBy)) * int64(36))
+	}
+	// field Functions []*vitess.io/vitess/go/vt/vtgate/engine.WindowFuncParams
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Functions)) * int64(8))
+		for _, elem := range cached.Functions {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Input vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Input.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *WindowFuncParams) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(80)
+	}
+	// field Frame *vitess.io/vitess/go/vt/vtgate/engine.WindowFrame
+	if cached.Frame != nil {
+		size += hack.RuntimeAllocSize(int64(40))
+	}
+	// field Alias string
+	size += hack.RuntimeAllocSize(int64(len(cached.Alias)))
+	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Expr.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 
 //go:nocheckptr
 func (cached *shardRoute) CachedSize(alloc bool) int64 {
@@ -0,0 +1,329 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var _ Primitive = (*CorrelatedSubquery)(nil)
+
+// CorrelatedSubquery evaluates a subquery that depends on the rows of the outer query.
+// The outer query is executed first, and the subquery is then executed for its rows,
+// with the values of the outer columns it depends on sent down as bind variables.
+// The result of the subquery is stored in the same bind variables a PulloutSubquery
+// would use, and an outer row is only returned if the Predicate that uses them is true.
+//
+// When BatchVar is set, the subquery is not executed once per outer row: it is executed
+// a single time with the list of all the distinct values of BatchVar found in the outer rows,
+// and it returns the value it was correlated on in the BatchKey column, so that its rows
+// can be matched back with the outer rows they belong to.
+type CorrelatedSubquery struct {
+	Opcode PulloutOpcode
+
+	// SubqueryResult and HasValues are the bind variables used by the Predicate
+	// to access the result of the subquery
+	SubqueryResult string
+	HasValues      string
+
+	Outer, Subquery Primitive
+
+	// Cols defines which columns from the outer
+	// results should be used to build the
+	// return result. The index values go as -1, -2, etc.
+	// If Cols is {-1, -2}, it means that
+	// the returned result will be {Outer0, Outer1}.
+	Cols []int `json:",omitempty"`
+
+	// Vars defines the list of bind variables that need to
+	// be built from the outer row before invoking the subquery.
+	Vars map[string]int `json:",omitempty"`
+
+	// Predicate is evaluated on every outer row to decide whether it is returned
+	Predicate    evalengine.Expr
+	ASTPredicate sqlparser.Expr
+
+	// BatchVar is the bind variable from Vars that the subquery is executed with as a list,
+	// and BatchKey is the offset of the column where the subquery returns its value.
+	// BatchKey is also the number of columns of the subquery itself.
+	BatchVar string `json:",omitempty"`
+	BatchKey int    `json:",omitempty"`
+
+	// Collation and ComparisonType are used to match the rows of a batched subquery
+	// with the outer rows
+	Collation      collations.ID
+	ComparisonType querypb.Type
+}
+
+// TryExecute performs a non-streaming exec.
+func (cs *CorrelatedSubquery) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	outer, err := vcursor.ExecutePrimitive(cs.Outer, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	return cs.filter(vcursor, bindVars, outer)
+}
+
+// TryStreamExecute performs a streaming exec.
+func (cs *CorrelatedSubquery) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	return vcursor.StreamExecutePrimitive(cs.Outer, bindVars, wantfields, func(outer *sqltypes.Result) error {
+		result, err := cs.filter(vcursor, bindVars, outer)
+		if err != nil {
+			return err
+		}
+		return callback(result)
+	})
+}
+
+// filter executes the subquery for the given outer rows and returns the ones that satisfy the predicate
+func (cs *CorrelatedSubquery) filter(vcursor VCursor, bindVars map[string]*querypb.BindVariable, outer *sqltypes.Result) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{Fields: projectFields(outer.Fields, cs.Cols)}
+	if len(outer.Rows) == 0 {
+		return result, nil
+	}
+
+	var batch *subqueryBatch
+	if cs.BatchVar != "" {
+		var err error
+		batch, err = cs.execBatch(vcursor, bindVars, outer.Rows)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	env := evalengine.NewExpressionEnv(bindVars, vcursor)
+	env.Fields = outer.Fields
+	for _, lrow := range outer.Rows {
+		var rows []row
+		var err error
+		if batch != nil {
+			rows, err = batch.rowsFor(lrow[cs.Vars[cs.BatchVar]])
+		} else {
+			rows, err = cs.execSubquery(vcursor, bindVars, lrow)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		env.BindVars = make(map[string]*querypb.BindVariable, len(bindVars)+2)
+		for k, v := range bindVars {
+			env.BindVars[k] = v
+		}
+		if err := bindSubqueryResult(cs.Opcode, cs.SubqueryResult, cs.HasValues, rows, env.BindVars); err != nil {
+			return nil, err
+		}
+		env.Row = lrow
+		evalResult, err := env.Evaluate(cs.Predicate)
+		if err != nil {
+			return nil, err
+		}
+		intEvalResult, err := evalResult.Value().ToInt64()
+		if err != nil {
+			return nil, err
+		}
+		if intEvalResult == 1 {
+			result.Rows = append(result.Rows, projectRows(lrow, cs.Cols))
+		}
+	}
+	return result, nil
+}
+
+// execSubquery executes the subquery for a single outer row
+func (cs *CorrelatedSubquery) execSubquery(vcursor VCursor, bindVars map[string]*querypb.BindVariable, lrow []sqltypes.Value) ([]row, error) {
+	joinVars := make(map[string]*querypb.BindVariable, len(cs.Vars))
+	for k, col := range cs.Vars {
+		joinVars[k] = sqltypes.ValueBindVariable(lrow[col])
+	}
+	result, err := vcursor.ExecutePrimitive(cs.Subquery, combineVars(bindVars, joinVars), false)
+	if err != nil {
+		return nil, err
+	}
+	return result.Rows, nil
+}
+
+// execBatch executes the subquery once for all the given outer rows
+func (cs *CorrelatedSubquery) execBatch(vcursor VCursor, bindVars map[string]*querypb.BindVariable, outerRows [][]sqltypes.Value) (*subqueryBatch, error) {
+	batch := &subqueryBatch{
+		rows:           map[evalengine.HashCode][]row{},
+		width:          cs.BatchKey,
+		collation:      cs.Collation,
+		comparisonType: cs.ComparisonType,
+	}
+
+	// a correlation on a NULL value can never match, so those values are not sent down
+	values := &querypb.BindVariable{Type: querypb.Type_TUPLE}
+	seen := map[evalengine.HashCode][]sqltypes.Value{}
+	for _, lrow := range outerRows {
+		value := lrow[cs.Vars[cs.BatchVar]]
+		if value.IsNull() {
+			continue
+		}
+		hashcode, err := evalengine.NullsafeHashcode(value, cs.Collation, cs.ComparisonType)
+		if err != nil {
+			return nil, err
+		}
+		found, err := batch.contains(seen[hashcode], value)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			seen[hashcode] = append(seen[hashcode], value)
+			values.Values = append(values.Values, sqltypes.ValueToProto(value))
+		}
+	}
+	if len(values.Values) == 0 {
+		return batch, nil
+	}
+
+	joinVars := map[string]*querypb.BindVariable{cs.BatchVar: values}
+	result, err := vcursor.ExecutePrimitive(cs.Subquery, combineVars(bindVars, joinVars), false)
+	if err != nil {
+		return nil, err
+	}
+	for _, rrow := range result.Rows {
+		key := rrow[cs.BatchKey]
+		if key.IsNull() {
+			continue
+		}
+		hashcode, err := evalengine.NullsafeHashcode(key, cs.Collation, cs.ComparisonType)
+		if err != nil {
+			return nil, err
+		}
+		batch.rows[hashcode] = append(batch.rows[hashcode], rrow)
+	}
+	return batch, nil
+}
+
+// subqueryBatch holds the rows returned by a batched subquery, indexed by the hashcode
+// of the value they were correlated on
+type subqueryBatch struct {
+	rows           map[evalengine.HashCode][]row
+	width          int
+	collation      collations.ID
+	comparisonType querypb.Type
+}
+
+// rowsFor returns the rows of the subquery that correlate with the given outer value,
+// without the column holding the correlated value
+func (b *subqueryBatch) rowsFor(value sqltypes.Value) ([]row, error) {
+	if value.IsNull() {
+		return nil, nil
+	}
+	hashcode, err := evalengine.NullsafeHashcode(value, b.collation, b.comparisonType)
+	if err != nil {
+		return nil, err
+	}
+	var rows []row
+	for _, rrow := range b.rows[hashcode] {
+		// hash codes can give false positives, so we need to check with a real comparison as well
+		cmp, err := evalengine.NullsafeCompare(rrow[b.width], value, b.collation)
+		if err != nil {
+			return nil, err
+		}
+		if cmp == 0 {
+			rows = append(rows, rrow[:b.width])
+		}
+	}
+	return rows, nil
+}
+
+func (b *subqueryBatch) contains(values []sqltypes.Value, value sqltypes.Value) (bool, error) {
+	for _, v := range values {
+		cmp, err := evalengine.NullsafeCompare(v, value, b.collation)
+		if err != nil {
+			return false, err
+		}
+		if cmp == 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// GetFields fetches the field info.
+func (cs *CorrelatedSubquery) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	result, err := cs.Outer.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: projectFields(result.Fields, cs.Cols)}, nil
+}
+
+// Inputs returns the input primitives for this CorrelatedSubquery
+func (cs *CorrelatedSubquery) Inputs() []Primitive {
+	return []Primitive{cs.Outer, cs.Subquery}
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (cs *CorrelatedSubquery) RouteType() string {
+	return "CorrelatedSubquery"
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (cs *CorrelatedSubquery) GetKeyspaceName() string {
+	if cs.Outer.GetKeyspaceName() == cs.Subquery.GetKeyspaceName() {
+		return cs.Outer.GetKeyspaceName()
+	}
+	return cs.Outer.GetKeyspaceName() + "_" + cs.Subquery.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (cs *CorrelatedSubquery) GetTableName() string {
+	return cs.Outer.GetTableName() + "_" + cs.Subquery.GetTableName()
+}
+
+// NeedsTransaction implements the Primitive interface
+func (cs *CorrelatedSubquery) NeedsTransaction() bool {
+	return cs.Outer.NeedsTransaction() || cs.Subquery.NeedsTransaction()
+}
+
+func (cs *CorrelatedSubquery) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"TableName":        cs.GetTableName(),
+		"ProjectedIndexes": strings.Trim(strings.Join(strings.Fields(fmt.Sprint(cs.Cols)), ","), "[]"),
+		"Predicate":        sqlparser.String(cs.ASTPredicate),
+	}
+	if len(cs.Vars) > 0 {
+		other["JoinVars"] = orderedStringIntMap(cs.Vars)
+	}
+	var pulloutVars []string
+	if cs.HasValues != "" {
+		pulloutVars = append(pulloutVars, cs.HasValues)
+	}
+	if cs.SubqueryResult != "" {
+		pulloutVars = append(pulloutVars, cs.SubqueryResult)
+	}
+	if len(pulloutVars) > 0 {
+		other["PulloutVars"] = pulloutVars
+	}
+	if cs.BatchVar != "" {
+		other["BatchVar"] = cs.BatchVar
+		other["BatchKey"] = cs.BatchKey
+	}
+	return PrimitiveDescription{
+		OperatorType: "CorrelatedSubquery",
+		Variant:      cs.Opcode.String(),
+		Other:        other,
+	}
+}
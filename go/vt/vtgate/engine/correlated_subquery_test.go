@@ -0,0 +1,295 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+// correlatedInPredicate returns the predicate for `<column 0> IN (subquery)`
+func correlatedInPredicate(t *testing.T) (evalengine.Expr, sqlparser.Expr) {
+	ast := sqlparser.AndExpressions(
+		&sqlparser.ComparisonExpr{Operator: sqlparser.EqualOp, Left: sqlparser.NewArgument("__sq_has_values1"), Right: sqlparser.NewIntLiteral("1")},
+		&sqlparser.ComparisonExpr{Operator: sqlparser.InOp, Left: sqlparser.Offset(0), Right: sqlparser.NewListArg("__sq1")},
+	)
+	predicate, err := evalengine.Translate(ast, nil)
+	require.NoError(t, err)
+	return predicate, ast
+}
+
+func TestCorrelatedSubqueryExecute(t *testing.T) {
+	outerFields := sqltypes.MakeTestFields(
+		"id|col",
+		"int64|varchar",
+	)
+	outer := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				outerFields,
+				"1|a",
+				"2|b",
+				"3|c",
+			),
+		},
+	}
+	subqueryFields := sqltypes.MakeTestFields(
+		"id",
+		"int64",
+	)
+	subquery := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				subqueryFields,
+				"1",
+				"5",
+			),
+			sqltypes.MakeTestResult(
+				subqueryFields,
+			),
+			sqltypes.MakeTestResult(
+				subqueryFields,
+				"3",
+			),
+		},
+	}
+	bv := map[string]*querypb.BindVariable{
+		"a": sqltypes.Int64BindVariable(10),
+	}
+
+	predicate, ast := correlatedInPredicate(t)
+	cs := &CorrelatedSubquery{
+		Opcode:         PulloutIn,
+		SubqueryResult: "__sq1",
+		HasValues:      "__sq_has_values1",
+		Outer:          outer,
+		Subquery:       subquery,
+		Vars: map[string]int{
+			"bv": 1,
+		},
+		Cols:         []int{-2},
+		Predicate:    predicate,
+		ASTPredicate: ast,
+	}
+	r, err := cs.TryExecute(&noopVCursor{}, bv, true)
+	require.NoError(t, err)
+	outer.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10" true`,
+	})
+	subquery.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10" bv: type:VARCHAR value:"a" false`,
+		`Execute a: type:INT64 value:"10" bv: type:VARCHAR value:"b" false`,
+		`Execute a: type:INT64 value:"10" bv: type:VARCHAR value:"c" false`,
+	})
+	utils.MustMatch(t, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col",
+			"varchar",
+		),
+		"a",
+		"c",
+	), r)
+}
+
+func TestCorrelatedSubqueryStreamExecute(t *testing.T) {
+	outerFields := sqltypes.MakeTestFields(
+		"id|col",
+		"int64|varchar",
+	)
+	outer := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				outerFields,
+				"1|a",
+				"2|b",
+			),
+			sqltypes.MakeTestResult(
+				outerFields,
+				"3|c",
+			),
+		},
+		allResultsInOneCall: true,
+	}
+	subqueryFields := sqltypes.MakeTestFields(
+		"id",
+		"int64",
+	)
+	subquery := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				subqueryFields,
+				"5",
+			),
+			sqltypes.MakeTestResult(
+				subqueryFields,
+				"2",
+			),
+			sqltypes.MakeTestResult(
+				subqueryFields,
+				"3",
+			),
+		},
+	}
+
+	predicate, ast := correlatedInPredicate(t)
+	cs := &CorrelatedSubquery{
+		Opcode:         PulloutIn,
+		SubqueryResult: "__sq1",
+		HasValues:      "__sq_has_values1",
+		Outer:          outer,
+		Subquery:       subquery,
+		Vars: map[string]int{
+			"bv": 1,
+		},
+		Cols:         []int{-1, -2},
+		Predicate:    predicate,
+		ASTPredicate: ast,
+	}
+	r, err := wrapStreamExecute(cs, &noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	outer.ExpectLog(t, []string{
+		`StreamExecute  true`,
+	})
+	subquery.ExpectLog(t, []string{
+		`Execute bv: type:VARCHAR value:"a" false`,
+		`Execute bv: type:VARCHAR value:"b" false`,
+		`Execute bv: type:VARCHAR value:"c" false`,
+	})
+	expectResult(t, "cs.StreamExecute", r, sqltypes.MakeTestResult(
+		outerFields,
+		"2|b",
+		"3|c",
+	))
+}
+
+func TestCorrelatedSubqueryBatched(t *testing.T) {
+	outer := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id|col",
+					"int64|int64",
+				),
+				"1|10",
+				"2|20",
+				"3|10",
+				"4|null",
+				"5|30",
+			),
+		},
+	}
+	subquery := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id|col",
+					"int64|int64",
+				),
+				"1|10",
+				"3|10",
+				"2|20",
+				"4|20",
+			),
+		},
+	}
+
+	predicate, ast := correlatedInPredicate(t)
+	cs := &CorrelatedSubquery{
+		Opcode:         PulloutIn,
+		SubqueryResult: "__sq1",
+		HasValues:      "__sq_has_values1",
+		Outer:          outer,
+		Subquery:       subquery,
+		Vars: map[string]int{
+			"bv": 1,
+		},
+		Cols:           []int{-1},
+		Predicate:      predicate,
+		ASTPredicate:   ast,
+		BatchVar:       "bv",
+		BatchKey:       1,
+		Collation:      collations.CollationBinaryID,
+		ComparisonType: querypb.Type_INT64,
+	}
+	r, err := cs.TryExecute(&noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	// the subquery is executed a single time, with the distinct values of the outer rows
+	subquery.ExpectLog(t, []string{
+		`Execute bv: type:TUPLE values:{type:INT64 value:"10"} values:{type:INT64 value:"20"} values:{type:INT64 value:"30"} false`,
+	})
+	utils.MustMatch(t, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id",
+			"int64",
+		),
+		"1",
+		"2",
+		"3",
+	), r)
+}
+
+func TestCorrelatedSubqueryTooManyRows(t *testing.T) {
+	outer := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id|col",
+					"int64|varchar",
+				),
+				"1|a",
+			),
+		},
+	}
+	subquery := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id",
+					"int64",
+				),
+				"1",
+				"2",
+			),
+		},
+	}
+
+	ast := &sqlparser.ComparisonExpr{Operator: sqlparser.EqualOp, Left: sqlparser.Offset(0), Right: sqlparser.NewArgument("__sq1")}
+	predicate, err := evalengine.Translate(ast, nil)
+	require.NoError(t, err)
+	cs := &CorrelatedSubquery{
+		Opcode:         PulloutValue,
+		SubqueryResult: "__sq1",
+		Outer:          outer,
+		Subquery:       subquery,
+		Vars: map[string]int{
+			"bv": 1,
+		},
+		Cols:         []int{-1},
+		Predicate:    predicate,
+		ASTPredicate: ast,
+	}
+	_, err = cs.TryExecute(&noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.EqualError(t, err, "subquery returned more than one row")
+}
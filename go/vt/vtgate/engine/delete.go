@@ -36,9 +36,6 @@ var _ Primitive = (*Delete)(nil)
 // Delete represents the instructions to perform a delete.
 type Delete struct {
 	*DML
-
-	// Delete does not take inputs
-	noInputs
 }
 
 // RouteType returns a description of the query routing type used by the primitive
@@ -103,13 +100,13 @@ func (del *Delete) GetFields(VCursor, map[string]*querypb.BindVariable) (*sqltyp
 // deleteVindexEntries performs an delete if table owns vindex.
 // Note: the commit order may be different from the DML order because it's possible
 // for DMLs to reuse existing transactions.
-func (del *Delete) deleteVindexEntries(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard) error {
+func (del *Delete) deleteVindexEntries(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard, bvs []map[string]*querypb.BindVariable) error {
 	if del.OwnedVindexQuery == "" {
 		return nil
 	}
 	queries := make([]*querypb.BoundQuery, len(rss))
 	for i := range rss {
-		queries[i] = &querypb.BoundQuery{Sql: del.OwnedVindexQuery, BindVariables: bindVars}
+		queries[i] = &querypb.BoundQuery{Sql: del.OwnedVindexQuery, BindVariables: bvs[i]}
 	}
 	subQueryResults, errors := vcursor.ExecuteMultiShard(rss, queries, false, false)
 	for _, err := range errors {
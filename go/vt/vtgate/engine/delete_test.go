@@ -382,6 +382,55 @@ func TestDeleteSharded(t *testing.T) {
 	require.EqualError(t, err, "shard_error")
 }
 
+func TestDeleteScatterLimit(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	limitQuery := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id",
+				"int64",
+			),
+			"1",
+			"2",
+			"3",
+		)},
+	}
+	del := &Delete{
+		DML: &DML{
+			RoutingParameters: &RoutingParameters{
+				Opcode:   Scatter,
+				Keyspace: ks.Keyspace,
+			},
+			Query:      "dummy_delete",
+			Table:      ks.Tables["t2"],
+			LimitQuery: limitQuery,
+			KsidVindex: ks.Vindexes["hash"],
+			KsidLength: 1,
+		},
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"-20", "20-", "-20"}
+	_, err := del.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationAllShards()`,
+		// The rows selected are counted on each shard, which deletes that many of them.
+		`ResolveDestinations sharded [type:INT64 value:"1" type:INT64 value:"2" type:INT64 value:"3"] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f),DestinationKeyspaceID(4eb190c9a2fa169c)`,
+		`ExecuteMultiShard sharded.-20: dummy_delete {__dml_limit: type:INT64 value:"2"} sharded.20-: dummy_delete {__dml_limit: type:INT64 value:"1"} true false`,
+	})
+
+	// No rows selected, nothing to delete.
+	limitQuery.rewind()
+	limitQuery.results = []*sqltypes.Result{{}}
+	vc = newDMLTestVCursor("-20", "20-")
+	_, err = del.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationAllShards()`,
+	})
+}
+
 func TestDeleteShardedStreaming(t *testing.T) {
 	ks := buildTestVSchema().Keyspaces["sharded"]
 	del := &Delete{
@@ -55,12 +55,21 @@ type DML struct {
 	// QueryTimeout contains the optional timeout (in milliseconds) to apply to this query
 	QueryTimeout int
 
+	// LimitQuery is set for a multi-shard DML with a LIMIT clause. It selects the
+	// primary vindex columns of the rows to change, and each shard is then sent
+	// the DML with the number of those rows it holds as limit.
+	LimitQuery Primitive
+
 	// RoutingParameters parameters required for query routing.
 	*RoutingParameters
 
 	txNeeded
 }
 
+// DMLLimitVar is the bind variable holding the limit of
+// a multi-shard DML with a LIMIT clause on each shard.
+const DMLLimitVar = "__dml_limit"
+
 // NewDML returns and empty initialized DML struct.
 func NewDML() *DML {
 	return &DML{RoutingParameters: &RoutingParameters{}}
@@ -70,22 +79,92 @@ func (dml *DML) execUnsharded(vcursor VCursor, bindVars map[string]*querypb.Bind
 	return execShard(vcursor, dml.Query, bindVars, rss[0], true, true /* canAutocommit */)
 }
 
-func (dml *DML) execMultiDestination(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard, dmlSpecialFunc func(VCursor, map[string]*querypb.BindVariable, []*srvtopo.ResolvedShard) error) (*sqltypes.Result, error) {
+// Inputs implements the Primitive interface
+func (dml *DML) Inputs() []Primitive {
+	if dml.LimitQuery == nil {
+		return nil
+	}
+	return []Primitive{dml.LimitQuery}
+}
+
+func (dml *DML) execMultiDestination(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard, dmlSpecialFunc func(VCursor, map[string]*querypb.BindVariable, []*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable) error) (*sqltypes.Result, error) {
+	rss, bvs, err := dml.shardBindVars(vcursor, bindVars, rss)
+	if err != nil {
+		return nil, err
+	}
 	if len(rss) == 0 {
 		return &sqltypes.Result{}, nil
 	}
-	err := dmlSpecialFunc(vcursor, bindVars, rss)
+	err = dmlSpecialFunc(vcursor, bindVars, rss, bvs)
 	if err != nil {
 		return nil, err
 	}
+	return execMultiShard(vcursor, rss, dml.boundQueries(rss, bvs), dml.MultiShardAutocommit)
+}
+
+// shardBindVars returns the shards to send the DML to, along with the bind variables for each of them.
+func (dml *DML) shardBindVars(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
+	if dml.LimitQuery != nil {
+		return dml.limitShards(vcursor, bindVars)
+	}
+	bvs := make([]map[string]*querypb.BindVariable, len(rss))
+	for i := range rss {
+		bvs[i] = bindVars
+	}
+	return rss, bvs, nil
+}
+
+func (dml *DML) boundQueries(rss []*srvtopo.ResolvedShard, bvs []map[string]*querypb.BindVariable) []*querypb.BoundQuery {
 	queries := make([]*querypb.BoundQuery, len(rss))
 	for i := range rss {
 		queries[i] = &querypb.BoundQuery{
 			Sql:           dml.Query,
-			BindVariables: bindVars,
+			BindVariables: bvs[i],
+		}
+	}
+	return queries
+}
+
+// limitShards runs the LimitQuery and returns the shards holding the selected rows,
+// along with the bind variables giving each of them the number of rows it holds as limit.
+func (dml *DML) limitShards(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
+	limitBindVars := make(map[string]*querypb.BindVariable, len(bindVars))
+	for k, v := range bindVars {
+		limitBindVars[k] = v
+	}
+	result, err := vcursor.ExecutePrimitive(dml.LimitQuery, limitBindVars, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	var ids []*querypb.Value
+	var destinations []key.Destination
+	for _, row := range result.Rows {
+		ksid, err := resolveKeyspaceID(vcursor, dml.KsidVindex, row[0:dml.KsidLength])
+		if err != nil {
+			return nil, nil, err
+		}
+		if ksid == nil {
+			continue
+		}
+		ids = append(ids, sqltypes.ValueToProto(row[0]))
+		destinations = append(destinations, key.DestinationKeyspaceID(ksid))
+	}
+	if len(destinations) == 0 {
+		return nil, nil, nil
+	}
+	rss, values, err := vcursor.ResolveDestinations(dml.Keyspace.Name, ids, destinations)
+	if err != nil {
+		return nil, nil, err
+	}
+	bvs := make([]map[string]*querypb.BindVariable, len(rss))
+	for i := range rss {
+		bvs[i] = make(map[string]*querypb.BindVariable, len(bindVars)+1)
+		for k, v := range bindVars {
+			bvs[i][k] = v
 		}
+		bvs[i][DMLLimitVar] = sqltypes.Int64BindVariable(int64(len(values[i])))
 	}
-	return execMultiShard(vcursor, rss, queries, dml.MultiShardAutocommit)
+	return rss, bvs, nil
 }
 
 func allowOnlyPrimary(rss ...*srvtopo.ResolvedShard) error {
@@ -0,0 +1,118 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"strconv"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+var _ Primitive = (*ExecStmt)(nil)
+
+// ExecStmt executes a statement prepared with PREPARE. The user defined
+// variables given to EXECUTE are bound to the placeholders of the statement.
+type ExecStmt struct {
+	// Name is the name of the prepared statement.
+	Name string
+	// Params are the names of the user defined variables
+	// bound to the placeholders, in order.
+	Params []string
+	// BindVars are the values normalized out of the
+	// prepared statement when it was planned.
+	BindVars map[string]*querypb.BindVariable
+	// BindVarNeeds are the bind variables the prepared statement
+	// and its params need to be provided with by the executor.
+	BindVarNeeds *sqlparser.BindVarNeeds
+
+	Input Primitive
+}
+
+// NeedsTransaction implements the Primitive interface
+func (e *ExecStmt) NeedsTransaction() bool {
+	return e.Input.NeedsTransaction()
+}
+
+// RouteType implements the Primitive interface
+func (e *ExecStmt) RouteType() string {
+	return e.Input.RouteType()
+}
+
+// GetKeyspaceName implements the Primitive interface
+func (e *ExecStmt) GetKeyspaceName() string {
+	return e.Input.GetKeyspaceName()
+}
+
+// GetTableName implements the Primitive interface
+func (e *ExecStmt) GetTableName() string {
+	return e.Input.GetTableName()
+}
+
+// TryExecute implements the Primitive interface
+func (e *ExecStmt) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	return vcursor.ExecutePrimitive(e.Input, e.bindParams(bindVars), wantfields)
+}
+
+// TryStreamExecute implements the Primitive interface
+func (e *ExecStmt) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	return vcursor.StreamExecutePrimitive(e.Input, e.bindParams(bindVars), wantfields, callback)
+}
+
+// GetFields implements the Primitive interface
+func (e *ExecStmt) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return e.Input.GetFields(vcursor, e.bindParams(bindVars))
+}
+
+// Inputs implements the Primitive interface
+func (e *ExecStmt) Inputs() []Primitive {
+	return []Primitive{e.Input}
+}
+
+// bindParams returns the bind variables the prepared statement is executed with.
+// The placeholders of the statement are named v1, v2... by the parser.
+func (e *ExecStmt) bindParams(bindVars map[string]*querypb.BindVariable) map[string]*querypb.BindVariable {
+	bv := make(map[string]*querypb.BindVariable, len(bindVars)+len(e.BindVars)+len(e.Params))
+	for k, v := range bindVars {
+		bv[k] = v
+	}
+	for k, v := range e.BindVars {
+		bv[k] = v
+	}
+	for i, param := range e.Params {
+		val := bindVars[sqlparser.UserDefinedVariableName+param]
+		if val == nil {
+			val = sqltypes.NullBindVariable
+		}
+		bv["v"+strconv.Itoa(i+1)] = val
+	}
+	return bv
+}
+
+func (e *ExecStmt) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"Name": e.Name,
+	}
+	if len(e.Params) > 0 {
+		other["Params"] = e.Params
+	}
+	return PrimitiveDescription{
+		OperatorType: "ExecStmt",
+		Other:        other,
+	}
+}
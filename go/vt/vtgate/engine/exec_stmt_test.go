@@ -0,0 +1,50 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestExecStmt(t *testing.T) {
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1")
+	input := &fakePrimitive{results: []*sqltypes.Result{result}}
+	exec := &ExecStmt{
+		Name:     "stmt",
+		Params:   []string{"a", "b"},
+		BindVars: map[string]*querypb.BindVariable{"vtg1": sqltypes.Int64BindVariable(10)},
+		Input:    input,
+	}
+
+	bindVars := map[string]*querypb.BindVariable{
+		"__vtudva": sqltypes.StringBindVariable("x"),
+	}
+	qr, err := exec.TryExecute(&noopVCursor{}, bindVars, true)
+	require.NoError(t, err)
+	require.Equal(t, result, qr)
+	// the placeholders take the values of the params, an unset one is NULL
+	input.ExpectLog(t, []string{
+		`Execute __vtudva: type:VARCHAR value:"x" v1: type:VARCHAR value:"x" v2:  vtg1: type:INT64 value:"10" true`,
+	})
+	// the bind variables of the caller are left untouched
+	require.Len(t, bindVars, 1)
+}
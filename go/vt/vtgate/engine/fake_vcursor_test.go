@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"reflect"
 	"sort"
 	"strings"
@@ -59,6 +60,11 @@ func (t *noopVCursor) ConnCollation() collations.ID {
 	return collations.CollationUtf8mb4ID
 }
 
+// TimeZone implements VCursor
+func (t *noopVCursor) TimeZone() *time.Location {
+	return nil
+}
+
 func (t *noopVCursor) ExecutePrimitive(primitive Primitive, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
 	return primitive.TryExecute(t, bindVars, wantfields)
 }
@@ -83,6 +89,10 @@ func (t *noopVCursor) VStream(rss []*srvtopo.ResolvedShard, filter *binlogdatapb
 	panic("implement me")
 }
 
+func (t *noopVCursor) LocalInfile(fileName string) (io.ReadCloser, error) {
+	panic("implement me")
+}
+
 func (t *noopVCursor) MessageStream(rss []*srvtopo.ResolvedShard, tableName string, callback func(*sqltypes.Result) error) error {
 	panic("implement me")
 }
@@ -131,6 +141,18 @@ func (t *noopVCursor) HasCreatedTempTable() {
 	panic("implement me")
 }
 
+func (t *noopVCursor) StorePrepareData(name string, data *vtgatepb.PrepareData) {
+	panic("implement me")
+}
+
+func (t *noopVCursor) GetPrepareData(name string) *vtgatepb.PrepareData {
+	panic("implement me")
+}
+
+func (t *noopVCursor) ClearPrepareData(name string) {
+	panic("implement me")
+}
+
 func (t *noopVCursor) LookupRowLockShardSession() vtgatepb.CommitOrder {
 	panic("implement me")
 }
@@ -316,6 +338,9 @@ type loggingVCursor struct {
 
 	// map different shards to keyspaces in the test.
 	ksShardMap map[string][]string
+
+	// content of the file sent by the client for LOAD DATA LOCAL INFILE
+	localInfile string
 }
 
 type tableRoutes struct {
@@ -330,6 +355,11 @@ func (f *loggingVCursor) StreamExecutePrimitive(primitive Primitive, bindVars ma
 	return primitive.TryStreamExecute(f, bindVars, wantfields, callback)
 }
 
+func (f *loggingVCursor) LocalInfile(fileName string) (io.ReadCloser, error) {
+	f.log = append(f.log, fmt.Sprintf("LocalInfile %s", fileName))
+	return io.NopCloser(strings.NewReader(f.localInfile)), nil
+}
+
 func (f *loggingVCursor) KeyspaceAvailable(ks string) bool {
 	return f.ksAvailable
 }
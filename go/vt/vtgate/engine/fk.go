@@ -0,0 +1,287 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var _ Primitive = (*FkVerify)(nil)
+var _ Primitive = (*FkCascade)(nil)
+
+// FkVarName returns the name of the bind variable holding the value
+// of the i-th column of a foreign key, in the queries selecting or
+// changing the rows of the other side of the foreign key.
+func FkVarName(i int) string {
+	return fmt.Sprintf("__fk_%d", i)
+}
+
+var (
+	errNoReferencedRow = vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.NoReferencedRow2, "Cannot add or update a child row: a foreign key constraint fails")
+	errRowIsReferenced = vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.RowIsReferenced2, "Cannot delete or update a parent row: a foreign key constraint fails")
+)
+
+// FkVerify runs a DML after verifying that the parent rows
+// referenced by the foreign keys of the rows it writes exist.
+type FkVerify struct {
+	Checks []*FkCheck
+	DML    Primitive
+
+	// The parent rows stay locked until the DML is done, so it runs in a transaction.
+	txNeeded
+}
+
+// FkCheck verifies the parent rows referenced by a foreign key.
+type FkCheck struct {
+	// Values are the values of the foreign key columns, one list per written row.
+	Values [][]evalengine.Expr
+	// Parent selects the parent row matching the values bound to the FkVarName variables.
+	Parent Primitive
+}
+
+// RouteType implements the Primitive interface
+func (fv *FkVerify) RouteType() string {
+	return fv.DML.RouteType()
+}
+
+// GetKeyspaceName implements the Primitive interface
+func (fv *FkVerify) GetKeyspaceName() string {
+	return fv.DML.GetKeyspaceName()
+}
+
+// GetTableName implements the Primitive interface
+func (fv *FkVerify) GetTableName() string {
+	return fv.DML.GetTableName()
+}
+
+// TryExecute implements the Primitive interface
+func (fv *FkVerify) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	env := evalengine.NewExpressionEnv(bindVars, vcursor)
+	for _, check := range fv.Checks {
+		var rows [][]sqltypes.Value
+		for _, exprs := range check.Values {
+			row := make([]sqltypes.Value, 0, len(exprs))
+			for _, expr := range exprs {
+				val, err := env.Evaluate(expr)
+				if err != nil {
+					return nil, err
+				}
+				row = append(row, val.Value())
+			}
+			rows = append(rows, row)
+		}
+		for _, fkBindVars := range fkBindVariables(bindVars, rows) {
+			result, err := vcursor.ExecutePrimitive(check.Parent, fkBindVars, false)
+			if err != nil {
+				return nil, err
+			}
+			if len(result.Rows) == 0 {
+				return nil, errNoReferencedRow
+			}
+		}
+	}
+	return vcursor.ExecutePrimitive(fv.DML, bindVars, wantfields)
+}
+
+// TryStreamExecute implements the Primitive interface
+func (fv *FkVerify) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	res, err := fv.TryExecute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(res)
+}
+
+// GetFields implements the Primitive interface
+func (fv *FkVerify) GetFields(VCursor, map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unreachable code for foreign key verification")
+}
+
+// Inputs implements the Primitive interface
+func (fv *FkVerify) Inputs() []Primitive {
+	inputs := make([]Primitive, 0, len(fv.Checks)+1)
+	for _, check := range fv.Checks {
+		inputs = append(inputs, check.Parent)
+	}
+	return append(inputs, fv.DML)
+}
+
+func (fv *FkVerify) description() PrimitiveDescription {
+	var values []string
+	for _, check := range fv.Checks {
+		var rows []string
+		for _, exprs := range check.Values {
+			var row []string
+			for _, expr := range exprs {
+				row = append(row, evalengine.FormatExpr(expr))
+			}
+			rows = append(rows, "("+strings.Join(row, ", ")+")")
+		}
+		values = append(values, strings.Join(rows, ", "))
+	}
+	return PrimitiveDescription{
+		OperatorType: "FkVerify",
+		Other: map[string]interface{}{
+			"Values": values,
+		},
+	}
+}
+
+// FkCascade runs a DELETE after taking the ON DELETE actions
+// of the foreign keys referencing the rows it deletes.
+type FkCascade struct {
+	// Selection selects the columns referenced by the Children in the rows to delete.
+	Selection Primitive
+	Children  []*FkChild
+	DML       Primitive
+
+	// The child rows are changed in the transaction of the DML.
+	txNeeded
+}
+
+// FkChild is a foreign key referencing the rows deleted by FkCascade.
+type FkChild struct {
+	// Cols are the offsets of the referenced columns in the rows of the Selection.
+	Cols []int
+	// OnDelete is the action taken on the child rows.
+	OnDelete string
+	// Exec takes the action on the child rows referencing the values bound
+	// to the FkVarName variables. For a restrict, it selects them.
+	Exec Primitive
+}
+
+// RouteType implements the Primitive interface
+func (fc *FkCascade) RouteType() string {
+	return fc.DML.RouteType()
+}
+
+// GetKeyspaceName implements the Primitive interface
+func (fc *FkCascade) GetKeyspaceName() string {
+	return fc.DML.GetKeyspaceName()
+}
+
+// GetTableName implements the Primitive interface
+func (fc *FkCascade) GetTableName() string {
+	return fc.DML.GetTableName()
+}
+
+// TryExecute implements the Primitive interface
+func (fc *FkCascade) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	selection, err := vcursor.ExecutePrimitive(fc.Selection, bindVars, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, child := range fc.Children {
+		rows := make([][]sqltypes.Value, 0, len(selection.Rows))
+		for _, row := range selection.Rows {
+			values := make([]sqltypes.Value, 0, len(child.Cols))
+			for _, col := range child.Cols {
+				values = append(values, row[col])
+			}
+			rows = append(rows, values)
+		}
+		for _, fkBindVars := range fkBindVariables(bindVars, rows) {
+			result, err := vcursor.ExecutePrimitive(child.Exec, fkBindVars, false)
+			if err != nil {
+				return nil, err
+			}
+			if child.OnDelete == vindexes.FkRestrict && len(result.Rows) > 0 {
+				return nil, errRowIsReferenced
+			}
+		}
+	}
+	return vcursor.ExecutePrimitive(fc.DML, bindVars, wantfields)
+}
+
+// TryStreamExecute implements the Primitive interface
+func (fc *FkCascade) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	res, err := fc.TryExecute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(res)
+}
+
+// GetFields implements the Primitive interface
+func (fc *FkCascade) GetFields(VCursor, map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unreachable code for foreign key cascade")
+}
+
+// Inputs implements the Primitive interface
+func (fc *FkCascade) Inputs() []Primitive {
+	inputs := make([]Primitive, 0, len(fc.Children)+2)
+	inputs = append(inputs, fc.Selection)
+	for _, child := range fc.Children {
+		inputs = append(inputs, child.Exec)
+	}
+	return append(inputs, fc.DML)
+}
+
+func (fc *FkCascade) description() PrimitiveDescription {
+	var children []string
+	for _, child := range fc.Children {
+		children = append(children, fmt.Sprintf("%s: %s %v", child.Exec.GetTableName(), child.OnDelete, child.Cols))
+	}
+	return PrimitiveDescription{
+		OperatorType: "FkCascade",
+		Other: map[string]interface{}{
+			"Children": children,
+		},
+	}
+}
+
+// fkBindVariables returns the bind variables binding each distinct row of
+// foreign key values to the FkVarName variables. A row holding a NULL does
+// not reference any row, and is skipped.
+func fkBindVariables(bindVars map[string]*querypb.BindVariable, rows [][]sqltypes.Value) []map[string]*querypb.BindVariable {
+	var bvs []map[string]*querypb.BindVariable
+	seen := make(map[string]bool, len(rows))
+rows:
+	for _, row := range rows {
+		var key strings.Builder
+		for _, val := range row {
+			if val.IsNull() {
+				continue rows
+			}
+			fmt.Fprintf(&key, "%d:%d:", val.Type(), len(val.Raw()))
+			key.Write(val.Raw())
+		}
+		if seen[key.String()] {
+			continue
+		}
+		seen[key.String()] = true
+
+		fkBindVars := make(map[string]*querypb.BindVariable, len(bindVars)+len(row))
+		for k, v := range bindVars {
+			fkBindVars[k] = v
+		}
+		for i, val := range row {
+			fkBindVars[FkVarName(i)] = sqltypes.ValueBindVariable(val)
+		}
+		bvs = append(bvs, fkBindVars)
+	}
+	return bvs
+}
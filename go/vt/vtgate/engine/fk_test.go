@@ -0,0 +1,147 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestFkVerify(t *testing.T) {
+	parentResult := sqltypes.MakeTestResult(sqltypes.MakeTestFields("1", "int64"), "1")
+	parent := &fakePrimitive{results: []*sqltypes.Result{parentResult}}
+	dml := &fakePrimitive{results: []*sqltypes.Result{{RowsAffected: 3}}}
+	fv := &FkVerify{
+		Checks: []*FkCheck{{
+			Values: [][]evalengine.Expr{
+				{evalengine.NewLiteralInt(10)},
+				{evalengine.NullExpr},
+				{evalengine.NewLiteralInt(10)},
+			},
+			Parent: parent,
+		}},
+		DML: dml,
+	}
+	// The parent rows must stay locked until the DML is done.
+	require.True(t, fv.NeedsTransaction())
+
+	result, err := fv.TryExecute(&loggingVCursor{}, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, result.RowsAffected)
+	// The NULL and the duplicate values are not checked.
+	parent.ExpectLog(t, []string{
+		`Execute __fk_0: type:INT64 value:"10" false`,
+	})
+	dml.ExpectLog(t, []string{
+		`Execute  false`,
+	})
+
+	// A missing parent row fails the DML.
+	parent = &fakePrimitive{results: []*sqltypes.Result{{}}}
+	dml = &fakePrimitive{results: []*sqltypes.Result{{RowsAffected: 1}}}
+	fv.Checks[0].Parent = parent
+	fv.DML = dml
+	_, err = fv.TryExecute(&loggingVCursor{}, map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "Cannot add or update a child row: a foreign key constraint fails")
+	dml.ExpectLog(t, nil)
+}
+
+func TestFkCascade(t *testing.T) {
+	selection := &fakePrimitive{results: []*sqltypes.Result{
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("id|name", "int64|varchar"),
+			"1|a",
+			"2|null",
+			"3|a",
+		),
+	}}
+	cascade := &fakePrimitive{results: []*sqltypes.Result{{}}}
+	setNull := &fakePrimitive{results: []*sqltypes.Result{{}, {}, {}}}
+	restrict := &fakePrimitive{results: []*sqltypes.Result{{}, {}}}
+	dml := &fakePrimitive{results: []*sqltypes.Result{{RowsAffected: 3}}}
+	fc := &FkCascade{
+		Selection: selection,
+		Children: []*FkChild{
+			{Cols: []int{1}, OnDelete: vindexes.FkCascade, Exec: cascade},
+			{Cols: []int{0}, OnDelete: vindexes.FkSetNull, Exec: setNull},
+			{Cols: []int{0, 1}, OnDelete: vindexes.FkRestrict, Exec: restrict},
+		},
+		DML: dml,
+	}
+	require.True(t, fc.NeedsTransaction())
+
+	result, err := fc.TryExecute(&loggingVCursor{}, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, result.RowsAffected)
+	cascade.ExpectLog(t, []string{
+		`Execute __fk_0: type:VARCHAR value:"a" false`,
+	})
+	setNull.ExpectLog(t, []string{
+		`Execute __fk_0: type:INT64 value:"1" false`,
+		`Execute __fk_0: type:INT64 value:"2" false`,
+		`Execute __fk_0: type:INT64 value:"3" false`,
+	})
+	restrict.ExpectLog(t, []string{
+		`Execute __fk_0: type:INT64 value:"1" __fk_1: type:VARCHAR value:"a" false`,
+		`Execute __fk_0: type:INT64 value:"3" __fk_1: type:VARCHAR value:"a" false`,
+	})
+	dml.ExpectLog(t, []string{
+		`Execute  false`,
+	})
+}
+
+func TestFkCascadeRestrict(t *testing.T) {
+	selection := &fakePrimitive{results: []*sqltypes.Result{
+		sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1"),
+	}}
+	restrict := &fakePrimitive{results: []*sqltypes.Result{
+		sqltypes.MakeTestResult(sqltypes.MakeTestFields("1", "int64"), "1"),
+	}}
+	dml := &fakePrimitive{results: []*sqltypes.Result{{RowsAffected: 1}}}
+	fc := &FkCascade{
+		Selection: selection,
+		Children: []*FkChild{
+			{Cols: []int{0}, OnDelete: vindexes.FkRestrict, Exec: restrict},
+		},
+		DML: dml,
+	}
+
+	_, err := fc.TryExecute(&loggingVCursor{}, map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "Cannot delete or update a parent row: a foreign key constraint fails")
+	dml.ExpectLog(t, nil)
+}
+
+func TestFkBindVariables(t *testing.T) {
+	rows := [][]sqltypes.Value{
+		{sqltypes.NewInt64(1), sqltypes.NewVarChar("a")},
+		{sqltypes.NewVarChar("1"), sqltypes.NewVarChar("a")},
+		{sqltypes.NewInt64(1), sqltypes.NewVarChar("a")},
+		{sqltypes.NewInt64(1), sqltypes.NULL},
+	}
+	bvs := fkBindVariables(map[string]*querypb.BindVariable{}, rows)
+	// Values of different types are distinct, duplicates and NULLs are skipped.
+	require.Len(t, bvs, 2)
+	require.Equal(t, sqltypes.Int64BindVariable(1), bvs[0][FkVarName(0)])
+	require.Equal(t, sqltypes.StringBindVariable("1"), bvs[1][FkVarName(0)])
+}
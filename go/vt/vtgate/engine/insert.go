@@ -99,6 +99,24 @@ type (
 		// Input is a select query plan to retrieve results for inserting data.
 		Input Primitive `json:",omitempty"`
 
+		// OwnedVindexQuery is set for a sharded REPLACE on a table owning lookup vindexes.
+		// It selects the owned vindex columns of the rows being replaced, so that their
+		// lookup vindex entries are deleted. The condition matching the new rows on
+		// ReplaceKeys is added when the query is executed.
+		OwnedVindexQuery string
+
+		// ReplaceKeys are the primary key and the unique keys of the table: a REPLACE
+		// replaces the rows having the same values as a new row for any of them.
+		ReplaceKeys [][]sqlparser.ColIdent
+
+		// ReplaceKeyValues are the values of the ReplaceKeys columns, indexed like VindexValues:
+		// ReplaceKeyValues[i][j][k] is the value of the j'th column of the i'th key in row k.
+		ReplaceKeyValues [][][]evalengine.Expr
+
+		// ReplaceKeyOffsets stores the offset of each column of the ReplaceKeys
+		// in the result set of the select query.
+		ReplaceKeyOffsets [][]int
+
 		// Insert needs tx handling
 		txNeeded
 	}
@@ -257,6 +275,8 @@ func (ins *Insert) GetFields(VCursor, map[string]*querypb.BindVariable) (*sqltyp
 
 func (ins *Insert) execInsertUnsharded(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
 	query := ins.Query
+	var insertID int64
+	var err error
 	if ins.Input != nil {
 		result, err := vcursor.ExecutePrimitive(ins.Input, bindVars, false)
 		if err != nil {
@@ -265,6 +285,10 @@ func (ins *Insert) execInsertUnsharded(vcursor VCursor, bindVars map[string]*que
 		if len(result.Rows) == 0 {
 			return &sqltypes.Result{}, nil
 		}
+		insertID, err = ins.processGenerateFromRows(vcursor, result.Rows)
+		if err != nil {
+			return nil, err
+		}
 		var mids sqlparser.Values
 		for r, inputRow := range result.Rows {
 			row := sqlparser.ValTuple{}
@@ -276,11 +300,11 @@ func (ins *Insert) execInsertUnsharded(vcursor VCursor, bindVars map[string]*que
 			mids = append(mids, row)
 		}
 		query = ins.Prefix + sqlparser.String(mids) + ins.Suffix
-	}
-
-	insertID, err := ins.processGenerateFromValues(vcursor, bindVars)
-	if err != nil {
-		return nil, err
+	} else {
+		insertID, err = ins.processGenerateFromValues(vcursor, bindVars)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	rss, _, err := vcursor.ResolveDestinations(ins.Keyspace.Name, nil, []key.Destination{key.DestinationAllShards{}})
@@ -360,6 +384,7 @@ func (ins *Insert) getInsertSelectQueries(
 
 	// Here we go over the incoming rows and extract values for the vindexes we need to update
 	shardingCols := make([][]sqltypes.Row, len(colVindexes))
+	replaceKeys := make([][]sqltypes.Row, len(ins.ReplaceKeyOffsets))
 	for _, inputRow := range rows {
 		for colIdx := range colVindexes {
 			offsets := ins.VindexValueOffset[colIdx]
@@ -373,12 +398,22 @@ func (ins *Insert) getInsertSelectQueries(
 			}
 			shardingCols[colIdx] = append(shardingCols[colIdx], row)
 		}
+		for keyIdx, offsets := range ins.ReplaceKeyOffsets {
+			row := make(sqltypes.Row, 0, len(offsets))
+			for _, offset := range offsets {
+				row = append(row, inputRow[offset])
+			}
+			replaceKeys[keyIdx] = append(replaceKeys[keyIdx], row)
+		}
 	}
 
 	keyspaceIDs, err := ins.processPrimary(vcursor, shardingCols[0], colVindexes[0])
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := ins.deleteReplacedVindexEntries(vcursor, replaceKeys, colVindexes[0], keyspaceIDs); err != nil {
+		return nil, nil, err
+	}
 
 	for vIdx := 1; vIdx < len(colVindexes); vIdx++ {
 		colVindex := colVindexes[vIdx]
@@ -493,7 +528,7 @@ func (ins *Insert) processGenerateFromValues(vcursor VCursor, bindVars map[strin
 
 	// Scan input values to compute the number of values to generate, and
 	// keep track of where they should be filled.
-	env := evalengine.EnvWithBindVars(bindVars, vcursor.ConnCollation())
+	env := evalengine.NewExpressionEnv(bindVars, vcursor)
 	resolved, err := env.Evaluate(ins.Generate.Values)
 	if err != nil {
 		return 0, err
@@ -617,7 +652,7 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 	// require inputs in that format.
 	vindexRowsValues := make([][]sqltypes.Row, len(ins.VindexValues))
 	rowCount := 0
-	env := evalengine.EnvWithBindVars(bindVars, vcursor.ConnCollation())
+	env := evalengine.NewExpressionEnv(bindVars, vcursor)
 	colVindexes := ins.ColVindexes
 	if colVindexes == nil {
 		colVindexes = ins.Table.ColumnVindexes
@@ -666,6 +701,22 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 	if err != nil {
 		return nil, nil, err
 	}
+	replaceKeys := make([][]sqltypes.Row, len(ins.ReplaceKeyValues))
+	for keyIdx, keyColValues := range ins.ReplaceKeyValues {
+		replaceKeys[keyIdx] = make([]sqltypes.Row, rowCount)
+		for _, colValues := range keyColValues {
+			for rowNum, colValue := range colValues {
+				result, err := env.Evaluate(colValue)
+				if err != nil {
+					return nil, nil, err
+				}
+				replaceKeys[keyIdx][rowNum] = append(replaceKeys[keyIdx][rowNum], result.Value())
+			}
+		}
+	}
+	if err := ins.deleteReplacedVindexEntries(vcursor, replaceKeys, colVindexes[0], keyspaceIDs); err != nil {
+		return nil, nil, err
+	}
 
 	for vIdx := 1; vIdx < len(colVindexes); vIdx++ {
 		colVindex := colVindexes[vIdx]
@@ -765,6 +816,131 @@ func (ins *Insert) processPrimary(vcursor VCursor, vindexColumnsKeys []sqltypes.
 	return keyspaceIDs, nil
 }
 
+// deleteReplacedVindexEntries deletes the owned lookup vindex entries of the rows
+// a REPLACE is about to replace. They are the rows having the same primary key or
+// unique key values as a new row, which are locked in the shard of the new row.
+// replaceKeys holds the values of the ReplaceKeys columns, indexed by key and row.
+func (ins *Insert) deleteReplacedVindexEntries(vcursor VCursor, replaceKeys [][]sqltypes.Row, colVindex *vindexes.ColumnVindex, ksids []ksID) error {
+	if ins.OwnedVindexQuery == "" {
+		return nil
+	}
+	var indexes []*querypb.Value
+	var destinations []key.Destination
+	for rowNum, ksid := range ksids {
+		if ksid == nil {
+			continue
+		}
+		indexes = append(indexes, &querypb.Value{
+			Value: strconv.AppendInt(nil, int64(rowNum), 10),
+		})
+		destinations = append(destinations, key.DestinationKeyspaceID(ksid))
+	}
+	if len(destinations) == 0 {
+		return nil
+	}
+	rss, indexesPerRss, err := vcursor.ResolveDestinations(ins.Keyspace.Name, indexes, destinations)
+	if err != nil {
+		return err
+	}
+	var queryRss []*srvtopo.ResolvedShard
+	var queries []*querypb.BoundQuery
+	for i := range rss {
+		rowNums := make([]int, 0, len(indexesPerRss[i]))
+		for _, indexValue := range indexesPerRss[i] {
+			index, _ := strconv.ParseInt(string(indexValue.Value), 0, 64)
+			rowNums = append(rowNums, int(index))
+		}
+		bvs := make(map[string]*querypb.BindVariable)
+		cond := ins.replacedRowsCondition(replaceKeys, rowNums, bvs)
+		if cond == nil {
+			continue
+		}
+		queryRss = append(queryRss, rss[i])
+		queries = append(queries, &querypb.BoundQuery{
+			Sql:           fmt.Sprintf("%s where %s for update", ins.OwnedVindexQuery, sqlparser.String(cond)),
+			BindVariables: bvs,
+		})
+	}
+	if len(queries) == 0 {
+		return nil
+	}
+	result, errs := vcursor.ExecuteMultiShard(queryRss, queries, false /* rollbackOnError */, false /* canAutocommit */)
+	if err := vterrors.Aggregate(errs); err != nil {
+		return err
+	}
+
+	ksidLength := len(colVindex.Columns)
+	for _, row := range result.Rows {
+		ksid, err := resolveKeyspaceID(vcursor, colVindex.Vindex, row[0:ksidLength])
+		if err != nil {
+			return err
+		}
+		colnum := ksidLength
+		for _, owned := range ins.Table.Owned {
+			// Fetch the column values. colnum must keep incrementing.
+			fromIds := make([]sqltypes.Value, 0, len(owned.Columns))
+			for range owned.Columns {
+				fromIds = append(fromIds, row[colnum])
+				colnum++
+			}
+			if err := owned.Vindex.(vindexes.Lookup).Delete(vcursor, [][]sqltypes.Value{fromIds}, ksid); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// replacedRowsCondition returns the condition matching the rows that have the same values
+// as one of the given new rows for any of the ReplaceKeys, adding its values to bvs.
+// It returns nil if no key can match, since a key with a NULL value conflicts with no row.
+func (ins *Insert) replacedRowsCondition(replaceKeys [][]sqltypes.Row, rowNums []int, bvs map[string]*querypb.BindVariable) sqlparser.Expr {
+	var conds []sqlparser.Expr
+	for keyIdx, keyCols := range ins.ReplaceKeys {
+		var tuples sqlparser.ValTuple
+	nextRow:
+		for _, rowNum := range rowNums {
+			values := replaceKeys[keyIdx][rowNum]
+			for _, value := range values {
+				if value.IsNull() {
+					continue nextRow
+				}
+			}
+			tuple := make(sqlparser.ValTuple, 0, len(values))
+			for colIdx, value := range values {
+				name := fmt.Sprintf("__replace_%d_%d_%d", keyIdx, colIdx, rowNum)
+				bvs[name] = sqltypes.ValueBindVariable(value)
+				tuple = append(tuple, sqlparser.NewArgument(name))
+			}
+			if len(tuple) == 1 {
+				tuples = append(tuples, tuple[0])
+			} else {
+				tuples = append(tuples, tuple)
+			}
+		}
+		if len(tuples) == 0 {
+			continue
+		}
+		cols := make(sqlparser.ValTuple, 0, len(keyCols))
+		for _, col := range keyCols {
+			cols = append(cols, &sqlparser.ColName{Name: col})
+		}
+		var left sqlparser.Expr = cols
+		if len(cols) == 1 {
+			left = cols[0]
+		}
+		conds = append(conds, &sqlparser.ComparisonExpr{Operator: sqlparser.InOp, Left: left, Right: tuples})
+	}
+	if len(conds) == 0 {
+		return nil
+	}
+	cond := conds[0]
+	for _, expr := range conds[1:] {
+		cond = &sqlparser.OrExpr{Left: cond, Right: expr}
+	}
+	return cond
+}
+
 // processOwned creates vindex entries for the values of an owned column.
 func (ins *Insert) processOwned(vcursor VCursor, vindexColumnsKeys []sqltypes.Row, colVindex *vindexes.ColumnVindex, ksids []ksID) error {
 	if !ins.Ignore {
@@ -945,6 +1121,14 @@ func (ins *Insert) description() PrimitiveDescription {
 	if ins.Ignore {
 		other["InsertIgnore"] = true
 	}
+	if ins.OwnedVindexQuery != "" {
+		other["OwnedVindexQuery"] = ins.OwnedVindexQuery
+		var keys []string
+		for _, key := range ins.ReplaceKeys {
+			keys = append(keys, sqlparser.String(sqlparser.Columns(key)))
+		}
+		other["ReplaceKeys"] = keys
+	}
 	return PrimitiveDescription{
 		OperatorType:     "Insert",
 		Keyspace:         ins.Keyspace,
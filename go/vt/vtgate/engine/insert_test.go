@@ -26,7 +26,9 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -121,6 +123,65 @@ func TestInsertUnshardedGenerate(t *testing.T) {
 	expectResult(t, "Execute", result, &sqltypes.Result{InsertID: 4})
 }
 
+func TestInsertUnshardedSelectGenerate(t *testing.T) {
+	ks := &vindexes.Keyspace{
+		Name:    "ks",
+		Sharded: false,
+	}
+	ins := NewQueryInsert(InsertUnsharded, ks, "")
+	ins.Input = &Route{
+		Query:      "dummy_select",
+		FieldQuery: "dummy_field_query",
+		RoutingParameters: &RoutingParameters{
+			Opcode:   Unsharded,
+			Keyspace: ks,
+		},
+	}
+	ins.Generate = &Generate{
+		Keyspace: &vindexes.Keyspace{
+			Name:    "ks2",
+			Sharded: false,
+		},
+		Query:  "dummy_generate",
+		Offset: 1,
+	}
+	ins.Prefix = "prefix "
+
+	vc := newDMLTestVCursor("0")
+	vc.results = []*sqltypes.Result{
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"name|id",
+				"varchar|int64"),
+			"a|1",
+			"b|null"),
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"nextval",
+				"int64",
+			),
+			"4",
+		),
+		{InsertID: 1},
+	}
+
+	result, err := ins.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.0: dummy_select {} false false`,
+		// Fetch a sequence value for the row without id.
+		`ResolveDestinations ks2 [] Destinations:DestinationAnyShard()`,
+		`ExecuteStandalone dummy_generate n: type:INT64 value:"1" ks2 0`,
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.0: prefix values (:_c0_0, :_c0_1), (:_c1_0, :_c1_1) ` +
+			`{_c0_0: type:VARCHAR value:"a" _c0_1: type:INT64 value:"1" _c1_0: type:VARCHAR value:"b" _c1_1: type:INT64 value:"4"} true true`,
+	})
+
+	// The insert id returned by ExecuteMultiShard should be overwritten by processGenerateFromRows.
+	expectResult(t, "Execute", result, &sqltypes.Result{InsertID: 4})
+}
+
 func TestInsertUnshardedGenerate_Zeros(t *testing.T) {
 	ins := NewQueryInsert(
 		InsertUnsharded,
@@ -570,6 +631,223 @@ func TestInsertShardedOwned(t *testing.T) {
 	})
 }
 
+func TestInsertShardedReplaceOwned(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+					"onecol": {
+						Type: "lookup",
+						Params: map[string]string{
+							"table": "lkp1",
+							"from":  "from",
+							"to":    "toc",
+						},
+						Owner: "t1",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}, {
+							Name:    "onecol",
+							Columns: []string{"c3"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs := vindexes.BuildVSchema(invschema)
+	ks := vs.Keyspaces["sharded"]
+
+	ins := NewInsert(
+		InsertSharded,
+		false,
+		ks.Keyspace,
+		[][][]evalengine.Expr{{
+			// colVindex columns: id
+			{
+				evalengine.NewLiteralInt(1),
+				evalengine.NewLiteralInt(2),
+			},
+		}, {
+			// colVindex columns: c3
+			{
+				evalengine.NewLiteralInt(10),
+				evalengine.NewLiteralInt(11),
+			},
+		}},
+		ks.Tables["t1"],
+		"prefix",
+		[]string{" mid1", " mid2"},
+		" suffix",
+	)
+	ins.OwnedVindexQuery = "select id, c3 from t1"
+	ins.ReplaceKeys = [][]sqlparser.ColIdent{{sqlparser.NewColIdent("id")}}
+	ins.ReplaceKeyValues = [][][]evalengine.Expr{{{
+		evalengine.NewLiteralInt(1),
+		evalengine.NewLiteralInt(2),
+	}}}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"20-", "-20", "20-", "-20"}
+	vc.results = []*sqltypes.Result{
+		// Only the row with id 1 exists, its lookup entry is for 5.
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id|c3",
+				"int64|int64",
+			),
+			"1|5",
+		),
+	}
+
+	_, err := ins.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		// The rows being replaced are locked in the shards of the new rows.
+		`ResolveDestinations sharded [value:"0" value:"1"] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard sharded.20-: select id, c3 from t1 where id in (:__replace_0_0_0) for update {__replace_0_0_0: type:INT64 value:"1"} ` +
+			`sharded.-20: select id, c3 from t1 where id in (:__replace_0_0_1) for update {__replace_0_0_1: type:INT64 value:"2"} false false`,
+		// Their lookup entries are deleted before the entries of the new rows are created.
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"5" toc: type:VARBINARY value:"\x16k@\xb4J\xbaK\xd6" true`,
+		`Execute insert into lkp1(from, toc) values(:from_0, :toc_0), (:from_1, :toc_1) ` +
+			`from_0: type:INT64 value:"10" from_1: type:INT64 value:"11" ` +
+			`toc_0: type:VARBINARY value:"\x16k@\xb4J\xbaK\xd6" toc_1: type:VARBINARY value:"\x06\xe7\xea\"Βp\x8f" true`,
+		`ResolveDestinations sharded [value:"0" value:"1"] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix mid1 suffix ` +
+			`{_c3_0: type:INT64 value:"10" _c3_1: type:INT64 value:"11" _id_0: type:INT64 value:"1" _id_1: type:INT64 value:"2"} ` +
+			`sharded.-20: prefix mid2 suffix ` +
+			`{_c3_0: type:INT64 value:"10" _c3_1: type:INT64 value:"11" _id_0: type:INT64 value:"1" _id_1: type:INT64 value:"2"} ` +
+			`true false`,
+	})
+}
+
+func TestInsertShardedReplaceSharedVindexValue(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+					"onecol": {
+						Type: "lookup",
+						Params: map[string]string{
+							"table": "lkp1",
+							"from":  "from",
+							"to":    "toc",
+						},
+						Owner: "t1",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"uid"},
+						}, {
+							Name:    "onecol",
+							Columns: []string{"c3"},
+						}},
+						UniqueKeys: []*vschemapb.UniqueKey{
+							{Columns: []string{"id"}},
+							{Columns: []string{"c4", "c5"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	vs := vindexes.BuildVSchema(invschema)
+	ks := vs.Keyspaces["sharded"]
+
+	// Both new rows have the uid 1, like the rows of the table they don't replace.
+	ins := NewInsert(
+		InsertSharded,
+		false,
+		ks.Keyspace,
+		[][][]evalengine.Expr{{
+			// colVindex columns: uid
+			{
+				evalengine.NewLiteralInt(1),
+				evalengine.NewLiteralInt(1),
+			},
+		}, {
+			// colVindex columns: c3
+			{
+				evalengine.NewLiteralInt(20),
+				evalengine.NewLiteralInt(21),
+			},
+		}},
+		ks.Tables["t1"],
+		"prefix",
+		[]string{" mid1", " mid2"},
+		" suffix",
+	)
+	ins.OwnedVindexQuery = "select uid, c3 from t1"
+	ins.ReplaceKeys = ks.Tables["t1"].UniqueKeys
+	ins.ReplaceKeyValues = [][][]evalengine.Expr{{
+		// key columns: id
+		{
+			evalengine.NewLiteralInt(10),
+			evalengine.NewLiteralInt(11),
+		},
+	}, {
+		// key columns: c4, c5
+		{
+			evalengine.NewLiteralString([]byte("a"), collations.TypedCollation{}),
+			evalengine.NewLiteralString([]byte("b"), collations.TypedCollation{}),
+		},
+		{
+			evalengine.NullExpr,
+			evalengine.NewLiteralString([]byte("c"), collations.TypedCollation{}),
+		},
+	}}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.results = []*sqltypes.Result{
+		// The row with id 10 and the row with the unique key ('b', 'c') are replaced.
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"uid|c3",
+				"int64|int64",
+			),
+			"1|5",
+			"1|7",
+		),
+	}
+
+	_, err := ins.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		// The first new row has a NULL in its unique key, which conflicts with no row.
+		`ResolveDestinations sharded [value:"0" value:"1"] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard sharded.-20: select uid, c3 from t1 where id in (:__replace_0_0_0, :__replace_0_0_1) or (c4, c5) in ((:__replace_1_0_1, :__replace_1_1_1)) for update ` +
+			`{__replace_0_0_0: type:INT64 value:"10" __replace_0_0_1: type:INT64 value:"11" ` +
+			`__replace_1_0_1: type:VARCHAR value:"b" __replace_1_1_1: type:VARCHAR value:"c"} false false`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"5" toc: type:VARBINARY value:"\x16k@\xb4J\xbaK\xd6" true`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"7" toc: type:VARBINARY value:"\x16k@\xb4J\xbaK\xd6" true`,
+		`Execute insert into lkp1(from, toc) values(:from_0, :toc_0), (:from_1, :toc_1) ` +
+			`from_0: type:INT64 value:"20" from_1: type:INT64 value:"21" ` +
+			`toc_0: type:VARBINARY value:"\x16k@\xb4J\xbaK\xd6" toc_1: type:VARBINARY value:"\x16k@\xb4J\xbaK\xd6" true`,
+		`ResolveDestinations sharded [value:"0" value:"1"] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard ` +
+			`sharded.-20: prefix mid1, mid2 suffix ` +
+			`{_c3_0: type:INT64 value:"20" _c3_1: type:INT64 value:"21" _uid_0: type:INT64 value:"1" _uid_1: type:INT64 value:"1"} ` +
+			`true true`,
+	})
+}
+
 func TestInsertShardedOwnedWithNull(t *testing.T) {
 	invschema := &vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
@@ -0,0 +1,513 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var _ Primitive = (*Load)(nil)
+
+type (
+	// Load represents the instructions to perform a LOAD DATA LOCAL INFILE.
+	// The file is requested from the client and parsed by vtgate, and its rows
+	// are inserted in batches, each row being routed to its shard.
+	Load struct {
+		// Insert routes and inserts the rows read from the file.
+		// Its VindexValueOffset and Generate.Offset are offsets
+		// in the fields of a line of the file.
+		Insert *Insert
+
+		// FileName is the name of the file the client is asked for.
+		FileName string
+
+		// Format describes how the fields and lines of the file are delimited.
+		Format LoadFormat
+
+		// Columns is the number of fields expected on every line of the file.
+		Columns int
+
+		// BatchSize is the maximum number of rows inserted by a single statement.
+		BatchSize int
+
+		// Load needs tx handling
+		txNeeded
+	}
+
+	// LoadFormat describes the layout of a file read by LOAD DATA.
+	LoadFormat struct {
+		FieldsTerminatedBy string
+		FieldsEnclosedBy   string
+		FieldsEscapedBy    string
+		LinesStartingBy    string
+		LinesTerminatedBy  string
+		IgnoreLines        int
+	}
+)
+
+// DefaultLoadFormat returns the format MySQL uses when the LOAD DATA
+// statement does not specify one.
+func DefaultLoadFormat() LoadFormat {
+	return LoadFormat{
+		FieldsTerminatedBy: "\t",
+		FieldsEscapedBy:    "\\",
+		LinesTerminatedBy:  "\n",
+	}
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (l *Load) RouteType() string {
+	return "Load"
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (l *Load) GetKeyspaceName() string {
+	return l.Insert.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (l *Load) GetTableName() string {
+	return l.Insert.GetTableName()
+}
+
+// Inputs implements the Primitive interface
+func (l *Load) Inputs() []Primitive {
+	return nil
+}
+
+// GetFields fetches the field info.
+func (l *Load) GetFields(VCursor, map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unreachable code for LOAD DATA")
+}
+
+// TryExecute performs a non-streaming exec.
+func (l *Load) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, _ bool) (*sqltypes.Result, error) {
+	file, err := vcursor.LocalInfile(l.FileName)
+	if err != nil {
+		return nil, err
+	}
+	// Closing the file reads what the client has not sent yet, so that
+	// the connection can be used again if we stop early because of an error.
+	defer file.Close()
+
+	reader := newLoadReader(file, l.Format)
+	if err := reader.skipLines(l.Format.IgnoreLines); err != nil {
+		return nil, err
+	}
+
+	result := &sqltypes.Result{}
+	var rows []sqltypes.Row
+	firstRow := 1
+	for {
+		row, err := reader.readRow()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		rowNum := firstRow + len(rows)
+		if len(row) < l.Columns {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Row %d doesn't contain data for all columns", rowNum)
+		}
+		if len(row) > l.Columns {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Row %d was truncated; it contained more data than there were input columns", rowNum)
+		}
+		rows = append(rows, row)
+		if len(rows) < l.BatchSize {
+			continue
+		}
+		if err := l.insertBatch(vcursor, bindVars, rows, firstRow, result); err != nil {
+			return nil, err
+		}
+		firstRow += len(rows)
+		rows = nil
+	}
+	if len(rows) > 0 {
+		if err := l.insertBatch(vcursor, bindVars, rows, firstRow, result); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// TryStreamExecute performs a streaming exec.
+func (l *Load) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	res, err := l.TryExecute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(res)
+}
+
+// insertBatch inserts a batch of rows read from the file, and adds the
+// outcome to the result. Errors mention the rows of the batch, and there
+// is one line per shard that failed.
+func (l *Load) insertBatch(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rows []sqltypes.Row, firstRow int, result *sqltypes.Result) error {
+	ins := l.Insert
+	insertID, err := ins.processGenerateFromRows(vcursor, rows)
+	if err != nil {
+		return vterrors.Wrapf(err, "rows %d to %d", firstRow, firstRow+len(rows)-1)
+	}
+
+	var rss []*srvtopo.ResolvedShard
+	var queries []*querypb.BoundQuery
+	if ins.Keyspace.Sharded {
+		rss, queries, err = ins.getInsertSelectQueries(vcursor, bindVars, rows)
+	} else {
+		rss, queries, err = l.getUnshardedQueries(vcursor, bindVars, rows)
+	}
+	if err != nil {
+		return vterrors.Wrapf(err, "rows %d to %d", firstRow, firstRow+len(rows)-1)
+	}
+	if len(rss) == 0 {
+		// every row of the batch was ignored
+		return nil
+	}
+	if err := allowOnlyPrimary(rss...); err != nil {
+		return err
+	}
+
+	qr, errs := vcursor.ExecuteMultiShard(rss, queries, true /* rollbackOnError */, false /* canAutocommit */)
+	if errs != nil {
+		return vterrors.Wrapf(vterrors.Aggregate(errs), "rows %d to %d", firstRow, firstRow+len(rows)-1)
+	}
+	result.RowsAffected += qr.RowsAffected
+	if result.InsertID == 0 {
+		if insertID != 0 {
+			result.InsertID = uint64(insertID)
+		} else {
+			result.InsertID = qr.InsertID
+		}
+	}
+	return nil
+}
+
+// getUnshardedQueries returns the single query inserting all the rows in an unsharded keyspace.
+func (l *Load) getUnshardedQueries(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rows []sqltypes.Row) ([]*srvtopo.ResolvedShard, []*querypb.BoundQuery, error) {
+	ins := l.Insert
+	rss, _, err := vcursor.ResolveDestinations(ins.Keyspace.Name, nil, []key.Destination{key.DestinationAllShards{}})
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(rss) != 1 {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "Keyspace does not have exactly one shard: %v", rss)
+	}
+
+	bvs := sqltypes.CopyBindVariables(bindVars)
+	mids := make(sqlparser.Values, 0, len(rows))
+	for r, inputRow := range rows {
+		row := make(sqlparser.ValTuple, 0, len(inputRow))
+		for c, value := range inputRow {
+			bvName := insertVarOffset(r, c)
+			bvs[bvName] = sqltypes.ValueBindVariable(value)
+			row = append(row, sqlparser.NewArgument(bvName))
+		}
+		mids = append(mids, row)
+	}
+	return rss, []*querypb.BoundQuery{{
+		Sql:           ins.Prefix + sqlparser.String(mids) + ins.Suffix,
+		BindVariables: bvs,
+	}}, nil
+}
+
+func (l *Load) description() PrimitiveDescription {
+	variant := "Unsharded"
+	if l.Insert.Keyspace.Sharded {
+		variant = "Sharded"
+	}
+	other := map[string]interface{}{
+		"FileName":  l.FileName,
+		"Query":     strings.TrimSpace(l.Insert.Prefix),
+		"TableName": l.GetTableName(),
+		"BatchSize": l.BatchSize,
+	}
+	// only the options that differ from the defaults are shown
+	def := DefaultLoadFormat()
+	for name, value := range map[string][2]string{
+		"FieldsTerminatedBy": {l.Format.FieldsTerminatedBy, def.FieldsTerminatedBy},
+		"FieldsEnclosedBy":   {l.Format.FieldsEnclosedBy, def.FieldsEnclosedBy},
+		"FieldsEscapedBy":    {l.Format.FieldsEscapedBy, def.FieldsEscapedBy},
+		"LinesStartingBy":    {l.Format.LinesStartingBy, def.LinesStartingBy},
+		"LinesTerminatedBy":  {l.Format.LinesTerminatedBy, def.LinesTerminatedBy},
+	} {
+		if value[0] != value[1] {
+			other[name] = value[0]
+		}
+	}
+	if l.Format.IgnoreLines > 0 {
+		other["IgnoreLines"] = l.Format.IgnoreLines
+	}
+	insDesc := l.Insert.description()
+	for _, k := range []string{"VindexOffsetFromSelect", "AutoIncrement", "InsertIgnore", "OwnedVindexQuery", "ReplaceKeys"} {
+		if v, ok := insDesc.Other[k]; ok {
+			other[k] = v
+		}
+	}
+	return PrimitiveDescription{
+		OperatorType:     "Load",
+		Keyspace:         l.Insert.Keyspace,
+		Variant:          variant,
+		TargetTabletType: topodatapb.TabletType_PRIMARY,
+		Other:            other,
+	}
+}
+
+// loadReader splits the content of a file in rows of values, following
+// the rules MySQL uses for LOAD DATA.
+type loadReader struct {
+	r      *bufio.Reader
+	format LoadFormat
+	eof    bool
+}
+
+func newLoadReader(r io.Reader, format LoadFormat) *loadReader {
+	return &loadReader{
+		r:      bufio.NewReader(r),
+		format: format,
+	}
+}
+
+// skipLines discards the first n lines of the file.
+func (lr *loadReader) skipLines(n int) error {
+	for i := 0; i < n && !lr.eof; i++ {
+		for {
+			found, err := lr.consume(lr.format.LinesTerminatedBy)
+			if err != nil {
+				return err
+			}
+			if found {
+				break
+			}
+			if _, err := lr.r.ReadByte(); err == io.EOF {
+				lr.eof = true
+				break
+			} else if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// readRow returns the values of the next line, or io.EOF
+// once all the lines have been read.
+func (lr *loadReader) readRow() (sqltypes.Row, error) {
+	if lr.eof {
+		return nil, io.EOF
+	}
+	if err := lr.skipToLineStart(); err != nil {
+		return nil, err
+	}
+	if _, err := lr.r.Peek(1); err == io.EOF {
+		lr.eof = true
+		return nil, io.EOF
+	}
+
+	var row sqltypes.Row
+	for {
+		value, endOfLine, err := lr.readField()
+		if err != nil {
+			return nil, err
+		}
+		row = append(row, value)
+		if endOfLine {
+			return row, nil
+		}
+	}
+}
+
+// skipToLineStart discards everything up to the LINES STARTING BY prefix.
+// Lines that do not contain the prefix are skipped entirely.
+func (lr *loadReader) skipToLineStart() error {
+	if lr.format.LinesStartingBy == "" {
+		return nil
+	}
+	for {
+		found, err := lr.consume(lr.format.LinesStartingBy)
+		if err != nil || found {
+			return err
+		}
+		if _, err := lr.r.ReadByte(); err == io.EOF {
+			lr.eof = true
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+// readField reads the next field of the current line, and tells if it was the last one.
+func (lr *loadReader) readField() (sqltypes.Value, bool, error) {
+	enclosed := false
+	if lr.format.FieldsEnclosedBy != "" {
+		var err error
+		enclosed, err = lr.consume(lr.format.FieldsEnclosedBy)
+		if err != nil {
+			return sqltypes.NULL, false, err
+		}
+	}
+
+	var buf bytes.Buffer
+	escapedNull := false
+	endOfLine := false
+	for {
+		if enclosed {
+			closing, err := lr.consume(lr.format.FieldsEnclosedBy)
+			if err != nil {
+				return sqltypes.NULL, false, err
+			}
+			if closing {
+				// a doubled enclosing character stands for itself
+				doubled, err := lr.consume(lr.format.FieldsEnclosedBy)
+				if err != nil {
+					return sqltypes.NULL, false, err
+				}
+				if doubled {
+					buf.WriteString(lr.format.FieldsEnclosedBy)
+					continue
+				}
+				endOfField, eol, err := lr.consumeTerminator()
+				if err != nil {
+					return sqltypes.NULL, false, err
+				}
+				if endOfField || eol {
+					return sqltypes.MakeTrusted(sqltypes.VarChar, buf.Bytes()), eol, nil
+				}
+				// not followed by a terminator: the enclosing character is part of the value
+				buf.WriteString(lr.format.FieldsEnclosedBy)
+				continue
+			}
+		} else {
+			endOfField, eol, err := lr.consumeTerminator()
+			if err != nil {
+				return sqltypes.NULL, false, err
+			}
+			if endOfField || eol {
+				endOfLine = eol
+				break
+			}
+		}
+
+		b, err := lr.r.ReadByte()
+		if err == io.EOF {
+			lr.eof = true
+			endOfLine = true
+			break
+		}
+		if err != nil {
+			return sqltypes.NULL, false, err
+		}
+		if escapedNull {
+			// \N is only NULL when it is the whole field
+			escapedNull = false
+			buf.WriteByte('N')
+		}
+		if lr.format.FieldsEscapedBy == "" || b != lr.format.FieldsEscapedBy[0] {
+			buf.WriteByte(b)
+			continue
+		}
+		b, err = lr.r.ReadByte()
+		if err == io.EOF {
+			// a trailing escape character stands for itself
+			lr.eof = true
+			endOfLine = true
+			buf.WriteByte(lr.format.FieldsEscapedBy[0])
+			break
+		}
+		if err != nil {
+			return sqltypes.NULL, false, err
+		}
+		if b == 'N' && !enclosed && buf.Len() == 0 {
+			escapedNull = true
+			continue
+		}
+		buf.WriteByte(unescapeLoadByte(b))
+	}
+
+	if enclosed {
+		// the file ended before the closing character
+		return sqltypes.MakeTrusted(sqltypes.VarChar, buf.Bytes()), true, nil
+	}
+	if escapedNull {
+		return sqltypes.NULL, endOfLine, nil
+	}
+	if lr.format.FieldsEnclosedBy != "" && buf.String() == "NULL" {
+		return sqltypes.NULL, endOfLine, nil
+	}
+	return sqltypes.MakeTrusted(sqltypes.VarChar, buf.Bytes()), endOfLine, nil
+}
+
+// consumeTerminator consumes the field or the line terminator if it comes next.
+func (lr *loadReader) consumeTerminator() (endOfField, endOfLine bool, err error) {
+	// the line terminator is checked first, for the formats where
+	// it starts with the field terminator
+	endOfLine, err = lr.consume(lr.format.LinesTerminatedBy)
+	if err != nil || endOfLine {
+		return false, endOfLine, err
+	}
+	endOfField, err = lr.consume(lr.format.FieldsTerminatedBy)
+	return endOfField, false, err
+}
+
+// consume reads s if it comes next in the file, and tells if it did.
+func (lr *loadReader) consume(s string) (bool, error) {
+	if s == "" {
+		return false, nil
+	}
+	next, err := lr.r.Peek(len(s))
+	if err == io.EOF || err == bufio.ErrBufferFull {
+		return false, nil
+	}
+	if err != nil || string(next) != s {
+		return false, err
+	}
+	_, err = lr.r.Discard(len(s))
+	return true, err
+}
+
+// unescapeLoadByte returns the character represented by an escape sequence.
+func unescapeLoadByte(b byte) byte {
+	switch b {
+	case '0':
+		return 0
+	case 'b':
+		return '\b'
+	case 'n':
+		return '\n'
+	case 'r':
+		return '\r'
+	case 't':
+		return '\t'
+	case 'Z':
+		return 26
+	}
+	return b
+}
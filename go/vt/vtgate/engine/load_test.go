@@ -0,0 +1,303 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+func loadTestKeyspace() *vindexes.KeyspaceSchema {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash"}},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"}}}}}}}}
+
+	vs := vindexes.BuildVSchema(invschema)
+	return vs.Keyspaces["sharded"]
+}
+
+func TestLoadSharded(t *testing.T) {
+	ks := loadTestKeyspace()
+	load := &Load{
+		Insert: &Insert{
+			Opcode:            InsertSelect,
+			Keyspace:          ks.Keyspace,
+			Table:             ks.Tables["t1"],
+			VindexValueOffset: [][]int{{0}},
+			Prefix:            "insert into t1(id, name) ",
+		},
+		FileName:  "data.txt",
+		Format:    DefaultLoadFormat(),
+		Columns:   2,
+		BatchSize: 2,
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"20-", "-20", "20-"}
+	vc.localInfile = "1\ta\n2\tb\n3\tc\n"
+	vc.results = []*sqltypes.Result{
+		{RowsAffected: 2},
+		{RowsAffected: 1},
+	}
+
+	result, err := load.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`LocalInfile data.txt`,
+		// the first batch goes to both shards
+		`ResolveDestinations sharded [value:"0" value:"1"] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: insert into t1(id, name) values (:_c0_0, :_c0_1) {_c0_0: type:VARCHAR value:"1" _c0_1: type:VARCHAR value:"a"} ` +
+			`sharded.-20: insert into t1(id, name) values (:_c1_0, :_c1_1) {_c1_0: type:VARCHAR value:"2" _c1_1: type:VARCHAR value:"b"} ` +
+			`true false`,
+		// the second batch only has a single row
+		`ResolveDestinations sharded [value:"0"] Destinations:DestinationKeyspaceID(4eb190c9a2fa169c)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: insert into t1(id, name) values (:_c0_0, :_c0_1) {_c0_0: type:VARCHAR value:"3" _c0_1: type:VARCHAR value:"c"} ` +
+			`true false`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 3})
+}
+
+func TestLoadShardedGenerate(t *testing.T) {
+	ks := loadTestKeyspace()
+	load := &Load{
+		Insert: &Insert{
+			Opcode:            InsertSelect,
+			Keyspace:          ks.Keyspace,
+			Table:             ks.Tables["t1"],
+			VindexValueOffset: [][]int{{0}},
+			Generate: &Generate{
+				Keyspace: &vindexes.Keyspace{
+					Name:    "ks2",
+					Sharded: false,
+				},
+				Query:  "dummy_generate",
+				Offset: 2,
+			},
+			Prefix: "insert into t1(id, name, seq) ",
+		},
+		FileName:  "data.txt",
+		Format:    DefaultLoadFormat(),
+		Columns:   2,
+		BatchSize: 10,
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"-20"}
+	vc.localInfile = "1\ta\n"
+	vc.results = []*sqltypes.Result{
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"nextval",
+				"int64",
+			),
+			"10",
+		),
+		{RowsAffected: 1},
+	}
+
+	result, err := load.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`LocalInfile data.txt`,
+		`ResolveDestinations ks2 [] Destinations:DestinationAnyShard()`,
+		`ExecuteStandalone dummy_generate n: type:INT64 value:"1" ks2 -20`,
+		`ResolveDestinations sharded [value:"0"] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard ` +
+			`sharded.-20: insert into t1(id, name, seq) values (:_c0_0, :_c0_1, :_c0_2) ` +
+			`{_c0_0: type:VARCHAR value:"1" _c0_1: type:VARCHAR value:"a" _c0_2: type:INT64 value:"10"} ` +
+			`true false`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 1, InsertID: 10})
+}
+
+func TestLoadUnsharded(t *testing.T) {
+	load := &Load{
+		Insert: &Insert{
+			Opcode: InsertUnsharded,
+			Keyspace: &vindexes.Keyspace{
+				Name:    "ks",
+				Sharded: false,
+			},
+			Prefix: "insert into t1(id, name) ",
+		},
+		FileName:  "data.csv",
+		Format:    LoadFormat{FieldsTerminatedBy: ",", FieldsEnclosedBy: `"`, LinesTerminatedBy: "\r\n", IgnoreLines: 1},
+		Columns:   2,
+		BatchSize: 10,
+	}
+
+	vc := newDMLTestVCursor("0")
+	vc.localInfile = "id,name\r\n1,\"a,b\"\r\n2,NULL\r\n"
+	vc.results = []*sqltypes.Result{{RowsAffected: 2}}
+
+	result, err := load.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`LocalInfile data.csv`,
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.0: insert into t1(id, name) values (:_c0_0, :_c0_1), (:_c1_0, :_c1_1) ` +
+			`{_c0_0: type:VARCHAR value:"1" _c0_1: type:VARCHAR value:"a,b" _c1_0: type:VARCHAR value:"2" _c1_1: } ` +
+			`true false`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 2})
+}
+
+func TestLoadErrors(t *testing.T) {
+	ks := loadTestKeyspace()
+	newLoad := func() *Load {
+		return &Load{
+			Insert: &Insert{
+				Opcode:            InsertSelect,
+				Keyspace:          ks.Keyspace,
+				Table:             ks.Tables["t1"],
+				VindexValueOffset: [][]int{{0}},
+				Prefix:            "insert into t1(id, name) ",
+			},
+			FileName:  "data.txt",
+			Format:    DefaultLoadFormat(),
+			Columns:   2,
+			BatchSize: 2,
+		}
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.localInfile = "1\ta\n2\n"
+	_, err := newLoad().TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "Row 2 doesn't contain data for all columns")
+
+	vc = newDMLTestVCursor("-20", "20-")
+	vc.localInfile = "1\ta\tx\n"
+	_, err = newLoad().TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "Row 1 was truncated; it contained more data than there were input columns")
+
+	// the error of every shard is reported, along with the rows of the batch that failed
+	vc = newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"20-", "-20", "20-", "-20"}
+	vc.localInfile = "1\ta\n2\tb\n3\tc\n4\td\n"
+	vc.results = []*sqltypes.Result{{RowsAffected: 2}, {}}
+	vc.multiShardErrs = []error{
+		errors.New("target: sharded.-20.primary: duplicate key"),
+		errors.New("target: sharded.20-.primary: duplicate key"),
+	}
+	_, err = newLoad().TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "rows 1 to 2: target: sharded.-20.primary: duplicate key\ntarget: sharded.20-.primary: duplicate key")
+}
+
+func TestLoadReader(t *testing.T) {
+	null := "<null>"
+	tcases := []struct {
+		name    string
+		format  LoadFormat
+		content string
+		rows    [][]string
+	}{{
+		name:    "default format",
+		format:  DefaultLoadFormat(),
+		content: "1\ta\n2\tb",
+		rows:    [][]string{{"1", "a"}, {"2", "b"}},
+	}, {
+		name:    "escape sequences",
+		format:  DefaultLoadFormat(),
+		content: "a\\tb\t\\N\tc\\\\d\t\\x\t\\Nx\n",
+		rows:    [][]string{{"a\tb", null, "c\\d", "x", "Nx"}},
+	}, {
+		name:    "escaped terminators",
+		format:  DefaultLoadFormat(),
+		content: "a\\\tb\tc\\\nd\n",
+		rows:    [][]string{{"a\tb", "c\nd"}},
+	}, {
+		name:    "empty fields",
+		format:  DefaultLoadFormat(),
+		content: "\t\n",
+		rows:    [][]string{{"", ""}},
+	}, {
+		name:    "enclosed fields",
+		format:  LoadFormat{FieldsTerminatedBy: ",", FieldsEnclosedBy: `"`, FieldsEscapedBy: "\\", LinesTerminatedBy: "\n"},
+		content: "\"a,b\",\"c\"\"d\",\"NULL\",NULL,\"e\"f\"\n1,\"x\ny\"\n",
+		rows:    [][]string{{"a,b", `c"d`, "NULL", null, `e"f`}, {"1", "x\ny"}},
+	}, {
+		name:    "multi-character terminators",
+		format:  LoadFormat{FieldsTerminatedBy: "||", LinesTerminatedBy: "\r\n"},
+		content: "a||b|c\r\nd||\\N\r\n",
+		rows:    [][]string{{"a", "b|c"}, {"d", `\N`}},
+	}, {
+		name:    "lines starting by",
+		format:  LoadFormat{FieldsTerminatedBy: ",", LinesStartingBy: "xxx", LinesTerminatedBy: "\n"},
+		content: "xxx1,a\nnot a row\nsomethingxxx2,b\n",
+		rows:    [][]string{{"1", "a"}, {"2", "b"}},
+	}, {
+		name:    "ignore lines",
+		format:  LoadFormat{FieldsTerminatedBy: ",", LinesTerminatedBy: "\n", IgnoreLines: 2},
+		content: "id,name\n--,--\n1,a\n",
+		rows:    [][]string{{"1", "a"}},
+	}, {
+		name:    "ignore more lines than the file has",
+		format:  LoadFormat{FieldsTerminatedBy: ",", LinesTerminatedBy: "\n", IgnoreLines: 5},
+		content: "id,name\n1,a\n",
+	}, {
+		name:    "empty file",
+		format:  DefaultLoadFormat(),
+		content: "",
+	}}
+
+	for _, tcase := range tcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			lr := newLoadReader(strings.NewReader(tcase.content), tcase.format)
+			require.NoError(t, lr.skipLines(tcase.format.IgnoreLines))
+			var rows [][]string
+			for {
+				row, err := lr.readRow()
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+				var values []string
+				for _, v := range row {
+					if v.IsNull() {
+						values = append(values, null)
+						continue
+					}
+					values = append(values, v.ToString())
+				}
+				rows = append(rows, values)
+			}
+			assert.Equal(t, tcase.rows, rows)
+		})
+	}
+}
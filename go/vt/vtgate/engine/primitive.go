@@ -19,6 +19,7 @@ package engine
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"sync/atomic"
 	"time"
 
@@ -95,6 +96,10 @@ type (
 
 		ConnCollation() collations.ID
 
+		// TimeZone returns the time zone of the session, as set by the
+		// time_zone system variable
+		TimeZone() *time.Location
+
 		ExecuteLock(rs *srvtopo.ResolvedShard, query *querypb.BoundQuery) (*sqltypes.Result, error)
 
 		InTransactionAndIsDML() bool
@@ -112,6 +117,9 @@ type (
 		MessageStream(rss []*srvtopo.ResolvedShard, tableName string, callback func(*sqltypes.Result) error) error
 
 		VStream(rss []*srvtopo.ResolvedShard, filter *binlogdatapb.Filter, gtid string, callback func(evs []*binlogdatapb.VEvent) error) error
+
+		// LocalInfile asks the client for the content of a file, for LOAD DATA LOCAL INFILE.
+		LocalInfile(fileName string) (io.ReadCloser, error)
 	}
 
 	//SessionActions gives primitives ability to interact with the session state
@@ -163,6 +171,13 @@ type (
 		// HasCreatedTempTable will mark the session as having created temp tables
 		HasCreatedTempTable()
 		GetWarnings() []*querypb.QueryWarning
+
+		// StorePrepareData stores the statement prepared with PREPARE under the given name
+		StorePrepareData(name string, data *vtgatepb.PrepareData)
+		// GetPrepareData returns the statement prepared under the given name, or nil if there is none
+		GetPrepareData(name string) *vtgatepb.PrepareData
+		// ClearPrepareData removes the statement prepared under the given name
+		ClearPrepareData(name string)
 	}
 
 	// Plan represents the execution strategy for a given query.
@@ -177,6 +192,9 @@ type (
 		BindVarNeeds *sqlparser.BindVarNeeds // Stores BindVars needed to be provided as part of expression rewriting
 		Warnings     []*querypb.QueryWarning // Warnings that need to be yielded every time this query runs
 
+		ResultCacheTTL    time.Duration // How long vtgate may cache the results of the query, zero if they are not cached
+		ResultCacheTables []string      // The keyspace.table names whose changes invalidate the cached results
+
 		ExecCount    uint64 // Count of times this plan was executed
 		ExecTime     uint64 // Total execution time
 		ShardQueries uint64 // Total number of shard queries
@@ -54,7 +54,7 @@ func (p *Projection) TryExecute(vcursor VCursor, bindVars map[string]*querypb.Bi
 		return nil, err
 	}
 
-	env := evalengine.EnvWithBindVars(bindVars, vcursor.ConnCollation())
+	env := evalengine.NewExpressionEnv(bindVars, vcursor)
 	env.Fields = result.Fields
 	var resultRows []sqltypes.Row
 	for _, row := range result.Rows {
@@ -86,7 +86,7 @@ func (p *Projection) TryStreamExecute(vcursor VCursor, bindVars map[string]*quer
 		return err
 	}
 
-	env := evalengine.EnvWithBindVars(bindVars, vcursor.ConnCollation())
+	env := evalengine.NewExpressionEnv(bindVars, vcursor)
 	if wantields {
 		err = p.addFields(env, result)
 		if err != nil {
@@ -116,7 +116,7 @@ func (p *Projection) GetFields(vcursor VCursor, bindVars map[string]*querypb.Bin
 	if err != nil {
 		return nil, err
 	}
-	env := evalengine.EnvWithBindVars(bindVars, vcursor.ConnCollation())
+	env := evalengine.NewExpressionEnv(bindVars, vcursor)
 	err = p.addFields(env, qr)
 	if err != nil {
 		return nil, err
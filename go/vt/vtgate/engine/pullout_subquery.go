@@ -123,51 +123,60 @@ func (ps *PulloutSubquery) execSubquery(vcursor VCursor, bindVars map[string]*qu
 	for k, v := range bindVars {
 		combinedVars[k] = v
 	}
-	switch ps.Opcode {
+	if err := bindSubqueryResult(ps.Opcode, ps.SubqueryResult, ps.HasValues, result.Rows, combinedVars); err != nil {
+		return nil, err
+	}
+	return combinedVars, nil
+}
+
+// bindSubqueryResult stores the rows returned by a subquery in the bind variables
+// that the query using the subquery expects for the given opcode
+func bindSubqueryResult(opcode PulloutOpcode, subqueryResult, hasValues string, rows [][]sqltypes.Value, bindVars map[string]*querypb.BindVariable) error {
+	switch opcode {
 	case PulloutValue:
-		switch len(result.Rows) {
+		switch len(rows) {
 		case 0:
-			combinedVars[ps.SubqueryResult] = sqltypes.NullBindVariable
+			bindVars[subqueryResult] = sqltypes.NullBindVariable
 		case 1:
-			if len(result.Rows[0]) != 1 {
-				return nil, errSqColumn
+			if len(rows[0]) != 1 {
+				return errSqColumn
 			}
-			combinedVars[ps.SubqueryResult] = sqltypes.ValueBindVariable(result.Rows[0][0])
+			bindVars[subqueryResult] = sqltypes.ValueBindVariable(rows[0][0])
 		default:
-			return nil, errSqRow
+			return errSqRow
 		}
 	case PulloutIn, PulloutNotIn:
-		switch len(result.Rows) {
+		switch len(rows) {
 		case 0:
-			combinedVars[ps.HasValues] = sqltypes.Int64BindVariable(0)
+			bindVars[hasValues] = sqltypes.Int64BindVariable(0)
 			// Add a bogus value. It will not be checked.
-			combinedVars[ps.SubqueryResult] = &querypb.BindVariable{
+			bindVars[subqueryResult] = &querypb.BindVariable{
 				Type:   querypb.Type_TUPLE,
 				Values: []*querypb.Value{sqltypes.ValueToProto(sqltypes.NewInt64(0))},
 			}
 		default:
-			if len(result.Rows[0]) != 1 {
-				return nil, errSqColumn
+			if len(rows[0]) != 1 {
+				return errSqColumn
 			}
-			combinedVars[ps.HasValues] = sqltypes.Int64BindVariable(1)
+			bindVars[hasValues] = sqltypes.Int64BindVariable(1)
 			values := &querypb.BindVariable{
 				Type:   querypb.Type_TUPLE,
-				Values: make([]*querypb.Value, len(result.Rows)),
+				Values: make([]*querypb.Value, len(rows)),
 			}
-			for i, v := range result.Rows {
+			for i, v := range rows {
 				values.Values[i] = sqltypes.ValueToProto(v[0])
 			}
-			combinedVars[ps.SubqueryResult] = values
+			bindVars[subqueryResult] = values
 		}
 	case PulloutExists:
-		switch len(result.Rows) {
+		switch len(rows) {
 		case 0:
-			combinedVars[ps.HasValues] = sqltypes.Int64BindVariable(0)
+			bindVars[hasValues] = sqltypes.Int64BindVariable(0)
 		default:
-			combinedVars[ps.HasValues] = sqltypes.Int64BindVariable(1)
+			bindVars[hasValues] = sqltypes.Int64BindVariable(1)
 		}
 	}
-	return combinedVars, nil
+	return nil
 }
 
 func (ps *PulloutSubquery) description() PrimitiveDescription {
@@ -0,0 +1,169 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var _ Primitive = (*RecurseCTE)(nil)
+
+// CTEMaxRecursionDepth is the number of iterations after which
+// the evaluation of a recursive common table expression is aborted,
+// like the default of @@cte_max_recursion_depth in MySQL.
+const CTEMaxRecursionDepth = 1000
+
+// CTEVarName returns the name of the bind variable holding the value of the
+// i-th column of a row of a recursive common table expression, in the queries
+// reading that row.
+func CTEVarName(i int) string {
+	return fmt.Sprintf("__cte_%d", i)
+}
+
+// RecurseCTE evaluates a recursive common table expression at vtgate.
+// The rows of the Seed are the rows of the first iteration. Every next
+// iteration runs Recurse once for each row of the previous iteration,
+// with the columns of the row bound to the CTEVarName variables.
+// The evaluation ends with the first iteration giving no rows.
+type RecurseCTE struct {
+	Seed    Primitive
+	Recurse Primitive
+	// Distinct drops the rows that were already given, like UNION DISTINCT.
+	Distinct bool
+}
+
+// RouteType implements the Primitive interface
+func (rc *RecurseCTE) RouteType() string {
+	return "RecurseCTE"
+}
+
+// GetKeyspaceName implements the Primitive interface
+func (rc *RecurseCTE) GetKeyspaceName() string {
+	return formatTwoOptionsNicely(rc.Seed.GetKeyspaceName(), rc.Recurse.GetKeyspaceName())
+}
+
+// GetTableName implements the Primitive interface
+func (rc *RecurseCTE) GetTableName() string {
+	return formatTwoOptionsNicely(rc.Seed.GetTableName(), rc.Recurse.GetTableName())
+}
+
+// TryExecute implements the Primitive interface
+func (rc *RecurseCTE) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	seed, err := vcursor.ExecutePrimitive(rc.Seed, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	result := &sqltypes.Result{Fields: seed.Fields}
+	seen := make(map[string]bool)
+	add := func(rows []sqltypes.Row) []sqltypes.Row {
+		if !rc.Distinct {
+			result.Rows = append(result.Rows, rows...)
+			return rows
+		}
+		var added []sqltypes.Row
+		for _, row := range rows {
+			key := cteRowKey(row)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			added = append(added, row)
+		}
+		result.Rows = append(result.Rows, added...)
+		return added
+	}
+
+	rows := add(seed.Rows)
+	for depth := 0; len(rows) > 0; depth++ {
+		if depth == CTEMaxRecursionDepth {
+			return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "Recursive query aborted after %d iterations. Try increasing @@cte_max_recursion_depth to a larger value.", depth+1)
+		}
+		var next []sqltypes.Row
+		for _, row := range rows {
+			rowBindVars := make(map[string]*querypb.BindVariable, len(bindVars)+len(row))
+			for k, v := range bindVars {
+				rowBindVars[k] = v
+			}
+			for i, val := range row {
+				rowBindVars[CTEVarName(i)] = sqltypes.ValueBindVariable(val)
+			}
+			qr, err := vcursor.ExecutePrimitive(rc.Recurse, rowBindVars, false)
+			if err != nil {
+				return nil, err
+			}
+			next = append(next, qr.Rows...)
+		}
+		rows = add(next)
+	}
+	return result, nil
+}
+
+// cteRowKey returns a key identifying the values of the row.
+func cteRowKey(row sqltypes.Row) string {
+	var key strings.Builder
+	for _, val := range row {
+		if val.IsNull() {
+			key.WriteString("NULL")
+		} else {
+			key.WriteString(val.ToString())
+		}
+		key.WriteByte(0)
+	}
+	return key.String()
+}
+
+// TryStreamExecute implements the Primitive interface
+func (rc *RecurseCTE) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	res, err := rc.TryExecute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(res)
+}
+
+// GetFields implements the Primitive interface
+func (rc *RecurseCTE) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return rc.Seed.GetFields(vcursor, bindVars)
+}
+
+// NeedsTransaction implements the Primitive interface
+func (rc *RecurseCTE) NeedsTransaction() bool {
+	return rc.Seed.NeedsTransaction() || rc.Recurse.NeedsTransaction()
+}
+
+// Inputs implements the Primitive interface
+func (rc *RecurseCTE) Inputs() []Primitive {
+	return []Primitive{rc.Seed, rc.Recurse}
+}
+
+func (rc *RecurseCTE) description() PrimitiveDescription {
+	other := map[string]interface{}{}
+	if rc.Distinct {
+		other["Distinct"] = true
+	}
+	return PrimitiveDescription{
+		OperatorType: "RecurseCTE",
+		Other:        other,
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestRecurseCTE(t *testing.T) {
+	fields := sqltypes.MakeTestFields("id|parent", "int64|int64")
+	seed := &fakePrimitive{results: []*sqltypes.Result{
+		sqltypes.MakeTestResult(fields, "1|null"),
+	}}
+	recurse := &fakePrimitive{results: []*sqltypes.Result{
+		sqltypes.MakeTestResult(fields, "2|1", "3|1"),
+		sqltypes.MakeTestResult(fields, "4|2"),
+		sqltypes.MakeTestResult(fields),
+		sqltypes.MakeTestResult(fields),
+	}}
+	rc := &RecurseCTE{Seed: seed, Recurse: recurse}
+
+	result, err := rc.TryExecute(&loggingVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	expectResult(t, "rc.Execute", result, sqltypes.MakeTestResult(fields, "1|null", "2|1", "3|1", "4|2"))
+	seed.ExpectLog(t, []string{
+		`Execute  true`,
+	})
+	recurse.ExpectLog(t, []string{
+		`Execute __cte_0: type:INT64 value:"1" __cte_1:  false`,
+		`Execute __cte_0: type:INT64 value:"2" __cte_1: type:INT64 value:"1" false`,
+		`Execute __cte_0: type:INT64 value:"3" __cte_1: type:INT64 value:"1" false`,
+		`Execute __cte_0: type:INT64 value:"4" __cte_1: type:INT64 value:"2" false`,
+	})
+}
+
+func TestRecurseCTEDistinct(t *testing.T) {
+	fields := sqltypes.MakeTestFields("id", "int64")
+	seed := &fakePrimitive{results: []*sqltypes.Result{
+		sqltypes.MakeTestResult(fields, "1", "1"),
+	}}
+	// The cycle back to 1 ends the evaluation.
+	recurse := &fakePrimitive{results: []*sqltypes.Result{
+		sqltypes.MakeTestResult(fields, "2"),
+		sqltypes.MakeTestResult(fields, "1"),
+	}}
+	rc := &RecurseCTE{Seed: seed, Recurse: recurse, Distinct: true}
+
+	result, err := rc.TryExecute(&loggingVCursor{}, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	expectResult(t, "rc.Execute", result, sqltypes.MakeTestResult(fields, "1", "2"))
+	recurse.ExpectLog(t, []string{
+		`Execute __cte_0: type:INT64 value:"1" false`,
+		`Execute __cte_0: type:INT64 value:"2" false`,
+	})
+}
+
+func TestRecurseCTEMaxDepth(t *testing.T) {
+	fields := sqltypes.MakeTestFields("id", "int64")
+	seed := &fakePrimitive{results: []*sqltypes.Result{
+		sqltypes.MakeTestResult(fields, "1"),
+	}}
+	// Without Distinct, the cycle never ends.
+	results := make([]*sqltypes.Result, CTEMaxRecursionDepth)
+	for i := range results {
+		results[i] = sqltypes.MakeTestResult(fields, "1")
+	}
+	recurse := &fakePrimitive{results: results}
+	rc := &RecurseCTE{Seed: seed, Recurse: recurse}
+
+	_, err := rc.TryExecute(&loggingVCursor{}, map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "Recursive query aborted after 1001 iterations. Try increasing @@cte_max_recursion_depth to a larger value.")
+}
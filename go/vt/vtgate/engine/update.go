@@ -17,6 +17,7 @@ limitations under the License.
 package engine
 
 import (
+	"bytes"
 	"fmt"
 	"sort"
 	"time"
@@ -26,10 +27,14 @@ import (
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 var _ Primitive = (*Update)(nil)
@@ -47,8 +52,11 @@ type Update struct {
 	// ChangedVindexValues contains values for updated Vindexes during an update statement.
 	ChangedVindexValues map[string]*VindexValues
 
-	// Update does not take inputs
-	noInputs
+	// MovedRowsQuery and MovedRowsDeleteQuery are set when the primary vindex
+	// columns are updated. They select and delete the updated rows on the shards
+	// they no longer belong to, so that they are inserted into their new shard.
+	MovedRowsQuery       string
+	MovedRowsDeleteQuery string
 }
 
 // RouteType returns a description of the query routing type used by the primitive
@@ -89,6 +97,9 @@ func (upd *Update) TryExecute(vcursor VCursor, bindVars map[string]*querypb.Bind
 	case Unsharded:
 		return upd.execUnsharded(vcursor, bindVars, rss)
 	case Equal, IN, Scatter, ByDestination:
+		if upd.MovedRowsQuery != "" {
+			return upd.execMoveRows(vcursor, bindVars, rss)
+		}
 		return upd.execMultiDestination(vcursor, bindVars, rss, upd.updateVindexEntries)
 	default:
 		// Unreachable.
@@ -117,74 +128,273 @@ func (upd *Update) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindV
 // for DMLs to reuse existing transactions.
 // Note 2: While changes are being committed, the changing row could be
 // unreachable by either the new or old column values.
-func (upd *Update) updateVindexEntries(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard) error {
+func (upd *Update) updateVindexEntries(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard, bvs []map[string]*querypb.BindVariable) error {
 	if len(upd.ChangedVindexValues) == 0 {
 		return nil
 	}
+	subQueryResult, err := upd.ownedVindexRows(vcursor, rss, bvs)
+	if err != nil || len(subQueryResult.Rows) == 0 {
+		return err
+	}
+
+	fieldColNumMap := fieldColNums(subQueryResult.Fields)
+	env := evalengine.NewExpressionEnv(bindVars, vcursor)
+	for _, row := range subQueryResult.Rows {
+		ksid, err := resolveKeyspaceID(vcursor, upd.KsidVindex, row[0:upd.KsidLength])
+		if err != nil {
+			return err
+		}
+		if err := upd.updateRowVindexEntries(vcursor, env, fieldColNumMap, row, ksid); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ownedVindexRows runs the OwnedVindexQuery, locking the rows the statement is about to update.
+func (upd *Update) ownedVindexRows(vcursor VCursor, rss []*srvtopo.ResolvedShard, bvs []map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
 	queries := make([]*querypb.BoundQuery, len(rss))
 	for i := range rss {
-		queries[i] = &querypb.BoundQuery{Sql: upd.OwnedVindexQuery, BindVariables: bindVars}
+		queries[i] = &querypb.BoundQuery{Sql: upd.OwnedVindexQuery, BindVariables: bvs[i]}
 	}
 	subQueryResult, errors := vcursor.ExecuteMultiShard(rss, queries, false, false)
 	for _, err := range errors {
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
+	return subQueryResult, nil
+}
 
-	if len(subQueryResult.Rows) == 0 {
-		return nil
-	}
-
+func fieldColNums(fields []*querypb.Field) map[string]int {
 	fieldColNumMap := make(map[string]int)
-	for colNum, field := range subQueryResult.Fields {
+	for colNum, field := range fields {
 		fieldColNumMap[field.Name] = colNum
 	}
-	env := evalengine.EnvWithBindVars(bindVars, vcursor.ConnCollation())
+	return fieldColNumMap
+}
+
+// updateRowVindexEntries updates the owned lookup vindexes changed
+// by the statement for a row staying on its keyspace id.
+func (upd *Update) updateRowVindexEntries(vcursor VCursor, env *evalengine.ExpressionEnv, fieldColNumMap map[string]int, row sqltypes.Row, ksid []byte) error {
+	for _, colVindex := range upd.Table.Owned {
+		// Update columns only if they're being changed.
+		if updColValues, ok := upd.ChangedVindexValues[colVindex.Name]; ok {
+			offset := updColValues.Offset
+			if !row[offset].IsNull() {
+				val, err := evalengine.ToInt64(row[offset])
+				if err != nil {
+					return err
+				}
+				if val == int64(1) { // 1 means that the old and new value are same and vindex update is not required.
+					continue
+				}
+			}
+			fromIds, vindexColumnKeys, err := upd.vindexColumnValues(env, fieldColNumMap, row, colVindex)
+			if err != nil {
+				return err
+			}
+			if err := colVindex.Vindex.(vindexes.Lookup).Update(vcursor, fromIds, ksid, vindexColumnKeys); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// vindexColumnValues returns the values of the vindex columns of a row, before and after the update.
+func (upd *Update) vindexColumnValues(env *evalengine.ExpressionEnv, fieldColNumMap map[string]int, row sqltypes.Row, colVindex *vindexes.ColumnVindex) ([]sqltypes.Value, []sqltypes.Value, error) {
+	fromIds := make([]sqltypes.Value, 0, len(colVindex.Columns))
+	var vindexColumnKeys []sqltypes.Value
+	updColValues := upd.ChangedVindexValues[colVindex.Name]
+	for _, vCol := range colVindex.Columns {
+		// Fetch the column values.
+		origColValue := row[fieldColNumMap[vCol.String()]]
+		fromIds = append(fromIds, origColValue)
+		if colValue, exists := updColValues.pvMapValue(vCol.String()); exists {
+			resolvedVal, err := env.Evaluate(colValue)
+			if err != nil {
+				return nil, nil, err
+			}
+			vindexColumnKeys = append(vindexColumnKeys, resolvedVal.Value())
+		} else {
+			// Set the column value to original as this column in vindex is not updated.
+			vindexColumnKeys = append(vindexColumnKeys, origColValue)
+		}
+	}
+	return fromIds, vindexColumnKeys, nil
+}
+
+func (vv *VindexValues) pvMapValue(col string) (evalengine.Expr, bool) {
+	if vv == nil {
+		return nil, false
+	}
+	expr, ok := vv.PvMap[col]
+	return expr, ok
+}
+
+// execMoveRows executes an update of the primary vindex columns. The rows getting a
+// new keyspace id are updated in place, then deleted from their shard and inserted
+// into the shard of the new keyspace id. All of it happens in the transaction of
+// the session, which is committed with 2PC when it is the transaction mode.
+func (upd *Update) execMoveRows(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard) (*sqltypes.Result, error) {
+	rss, bvs, err := upd.shardBindVars(vcursor, bindVars, rss)
+	if err != nil {
+		return nil, err
+	}
+	if len(rss) == 0 {
+		return &sqltypes.Result{}, nil
+	}
+	env := evalengine.NewExpressionEnv(bindVars, vcursor)
+	ksid, err := upd.newKeyspaceID(vcursor, env)
+	if err != nil {
+		return nil, err
+	}
+	targets, _, err := vcursor.ResolveDestinations(upd.Keyspace.Name, nil, []key.Destination{key.DestinationKeyspaceID(ksid)})
+	if err != nil {
+		return nil, err
+	}
+	if len(targets) != 1 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "keyspace id %x does not map to a single shard", ksid)
+	}
+	target := targets[0]
+
+	sources, err := upd.moveVindexEntries(vcursor, env, rss, bvs, ksid, target)
+	if err != nil {
+		return nil, err
+	}
+	result, errs := vcursor.ExecuteMultiShard(rss, upd.boundQueries(rss, bvs), true /* rollbackOnError */, false /* canAutocommit */)
+	if err := vterrors.Aggregate(errs); err != nil {
+		return nil, err
+	}
+	if len(sources) == 0 {
+		return result, nil
+	}
 
+	// The updated rows now hold the new values of the primary vindex columns.
+	queries := make([]*querypb.BoundQuery, len(sources))
+	for i := range sources {
+		queries[i] = &querypb.BoundQuery{Sql: upd.MovedRowsQuery, BindVariables: bindVars}
+	}
+	movedRows, errs := vcursor.ExecuteMultiShard(sources, queries, true /* rollbackOnError */, false /* canAutocommit */)
+	if err := vterrors.Aggregate(errs); err != nil {
+		return nil, err
+	}
+	if len(movedRows.Rows) == 0 {
+		return result, nil
+	}
+	for i := range sources {
+		queries[i] = &querypb.BoundQuery{Sql: upd.MovedRowsDeleteQuery, BindVariables: bindVars}
+	}
+	if _, errs := vcursor.ExecuteMultiShard(sources, queries, true /* rollbackOnError */, false /* canAutocommit */); vterrors.Aggregate(errs) != nil {
+		return nil, vterrors.Aggregate(errs)
+	}
+	query, insertVars := upd.movedRowsInsert(movedRows)
+	if _, err := execShard(vcursor, query, insertVars, target, true /* rollbackOnError */, false /* canAutocommit */); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// newKeyspaceID returns the keyspace id given to the rows by the new values of the primary vindex columns.
+func (upd *Update) newKeyspaceID(vcursor VCursor, env *evalengine.ExpressionEnv) ([]byte, error) {
+	primary := upd.Table.ColumnVindexes[0]
+	values := make([]sqltypes.Value, 0, len(primary.Columns))
+	for _, col := range primary.Columns {
+		expr, ok := upd.ChangedVindexValues[primary.Name].pvMapValue(col.String())
+		if !ok {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: no new value for the primary vindex column %v", col)
+		}
+		val, err := env.Evaluate(expr)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, val.Value())
+	}
+	ksid, err := resolveKeyspaceID(vcursor, upd.KsidVindex, values)
+	if err != nil {
+		return nil, err
+	}
+	if ksid == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "could not map %v to a keyspace id", values)
+	}
+	return ksid, nil
+}
+
+// moveVindexEntries updates the owned lookup vindexes for the rows changed by the statement.
+// The entries of the rows changing of keyspace id are moved to the new one. It returns
+// the shards holding rows that have to move to the target shard.
+func (upd *Update) moveVindexEntries(vcursor VCursor, env *evalengine.ExpressionEnv, rss []*srvtopo.ResolvedShard, bvs []map[string]*querypb.BindVariable, newKsid []byte, target *srvtopo.ResolvedShard) ([]*srvtopo.ResolvedShard, error) {
+	subQueryResult, err := upd.ownedVindexRows(vcursor, rss, bvs)
+	if err != nil || len(subQueryResult.Rows) == 0 {
+		return nil, err
+	}
+
+	fieldColNumMap := fieldColNums(subQueryResult.Fields)
+	var destinations []key.Destination
 	for _, row := range subQueryResult.Rows {
 		ksid, err := resolveKeyspaceID(vcursor, upd.KsidVindex, row[0:upd.KsidLength])
 		if err != nil {
-			return err
+			return nil, err
+		}
+		if bytes.Equal(ksid, newKsid) {
+			if err := upd.updateRowVindexEntries(vcursor, env, fieldColNumMap, row, ksid); err != nil {
+				return nil, err
+			}
+			continue
 		}
 		for _, colVindex := range upd.Table.Owned {
-			// Update columns only if they're being changed.
-			if updColValues, ok := upd.ChangedVindexValues[colVindex.Name]; ok {
-				offset := updColValues.Offset
-				if !row[offset].IsNull() {
-					val, err := evalengine.ToInt64(row[offset])
-					if err != nil {
-						return err
-					}
-					if val == int64(1) { // 1 means that the old and new value are same and vindex update is not required.
-						continue
-					}
-				}
-				fromIds := make([]sqltypes.Value, 0, len(colVindex.Columns))
-				var vindexColumnKeys []sqltypes.Value
-				for _, vCol := range colVindex.Columns {
-					// Fetch the column values.
-					origColValue := row[fieldColNumMap[vCol.String()]]
-					fromIds = append(fromIds, origColValue)
-					if colValue, exists := updColValues.PvMap[vCol.String()]; exists {
-						resolvedVal, err := env.Evaluate(colValue)
-						if err != nil {
-							return err
-						}
-						vindexColumnKeys = append(vindexColumnKeys, resolvedVal.Value())
-					} else {
-						// Set the column value to original as this column in vindex is not updated.
-						vindexColumnKeys = append(vindexColumnKeys, origColValue)
-					}
-				}
-
-				if err := colVindex.Vindex.(vindexes.Lookup).Update(vcursor, fromIds, ksid, vindexColumnKeys); err != nil {
-					return err
-				}
+			fromIds, toIds, err := upd.vindexColumnValues(env, fieldColNumMap, row, colVindex)
+			if err != nil {
+				return nil, err
 			}
+			lookup := colVindex.Vindex.(vindexes.Lookup)
+			if err := lookup.Delete(vcursor, [][]sqltypes.Value{fromIds}, ksid); err != nil {
+				return nil, err
+			}
+			if err := lookup.Create(vcursor, [][]sqltypes.Value{toIds}, [][]byte{newKsid}, false /* ignoreMode */); err != nil {
+				return nil, err
+			}
+		}
+		if ksid != nil {
+			destinations = append(destinations, key.DestinationKeyspaceID(ksid))
 		}
 	}
-	return nil
+	if len(destinations) == 0 {
+		return nil, nil
+	}
+	shards, _, err := vcursor.ResolveDestinations(upd.Keyspace.Name, nil, destinations)
+	if err != nil {
+		return nil, err
+	}
+	var sources []*srvtopo.ResolvedShard
+	for _, rs := range shards {
+		if rs.Target.Shard != target.Target.Shard {
+			sources = append(sources, rs)
+		}
+	}
+	return sources, nil
+}
+
+// movedRowsInsert returns the query inserting the moved rows into their new shard.
+func (upd *Update) movedRowsInsert(movedRows *sqltypes.Result) (string, map[string]*querypb.BindVariable) {
+	ins := &sqlparser.Insert{Table: sqlparser.TableName{Name: upd.Table.Name}}
+	for _, field := range movedRows.Fields {
+		ins.Columns = append(ins.Columns, sqlparser.NewColIdent(field.Name))
+	}
+	bindVars := make(map[string]*querypb.BindVariable, len(movedRows.Rows)*len(movedRows.Fields))
+	rows := make(sqlparser.Values, 0, len(movedRows.Rows))
+	for rowNum, row := range movedRows.Rows {
+		tuple := make(sqlparser.ValTuple, 0, len(row))
+		for colNum, value := range row {
+			name := insertVarOffset(rowNum, colNum)
+			bindVars[name] = sqltypes.ValueBindVariable(value)
+			tuple = append(tuple, sqlparser.NewArgument(name))
+		}
+		rows = append(rows, tuple)
+	}
+	ins.Rows = rows
+	return sqlparser.String(ins), bindVars
 }
 
 func (upd *Update) description() PrimitiveDescription {
@@ -206,6 +416,10 @@ func (upd *Update) description() PrimitiveDescription {
 	if len(changedVindexes) > 0 {
 		other["ChangedVindexValues"] = changedVindexes
 	}
+	if upd.MovedRowsQuery != "" {
+		other["MovedRowsQuery"] = upd.MovedRowsQuery
+		other["MovedRowsDeleteQuery"] = upd.MovedRowsDeleteQuery
+	}
 
 	return PrimitiveDescription{
 		OperatorType:     "Update",
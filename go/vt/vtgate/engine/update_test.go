@@ -916,6 +916,98 @@ func TestUpdateEqualSubshard(t *testing.T) {
 	})
 }
 
+func TestUpdateScatterMoveRows(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	upd := &Update{
+		DML: &DML{
+			RoutingParameters: &RoutingParameters{
+				Opcode:   Scatter,
+				Keyspace: ks.Keyspace,
+			},
+			Query:            "dummy_update",
+			Table:            ks.Tables["t1"],
+			OwnedVindexQuery: "dummy_subquery",
+			KsidVindex:       ks.Vindexes["hash"],
+			KsidLength:       1,
+		},
+		ChangedVindexValues: map[string]*VindexValues{
+			"hash": {
+				PvMap: map[string]evalengine.Expr{
+					"id": evalengine.NewLiteralInt(3),
+				},
+				Offset: 4,
+			},
+		},
+		MovedRowsQuery:       "dummy_moved_rows",
+		MovedRowsDeleteQuery: "dummy_delete_moved_rows",
+	}
+
+	results := []*sqltypes.Result{
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id|c1|c2|c3|hash",
+				"int64|int64|int64|int64|int64",
+			),
+			"1|4|5|6|0", // moves to the keyspace id of 3
+			"3|7|8|9|1", // keeps its keyspace id
+		),
+		nil, nil, nil, nil, // lookup vindex entries
+		{RowsAffected: 2},
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id|c1|c2|c3",
+				"int64|int64|int64|int64",
+			),
+			"3|4|5|6",
+		),
+	}
+	vc := newDMLTestVCursor("-20", "20-")
+	// The new keyspace id is in 20-, the moving row is in -20.
+	vc.shardForKsid = []string{"20-", "-20"}
+	vc.results = results
+
+	result, err := upd.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, result.RowsAffected)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationAllShards()`,
+		// The keyspace id of the new value of id.
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(4eb190c9a2fa169c)`,
+		`ExecuteMultiShard sharded.-20: dummy_subquery {} sharded.20-: dummy_subquery {} false false`,
+		// The lookup vindex entries of the moving row are moved to the new keyspace id.
+		`Execute delete from lkp2 where from1 = :from1 and from2 = :from2 and toc = :toc from1: type:INT64 value:"4" from2: type:INT64 value:"5" toc: type:VARBINARY value:"\x16k@\xb4J\xbaK\xd6" true`,
+		`Execute insert into lkp2(from1, from2, toc) values(:from1_0, :from2_0, :toc_0) from1_0: type:INT64 value:"4" from2_0: type:INT64 value:"5" toc_0: type:VARBINARY value:"N\xb1\x90ɢ\xfa\x16\x9c" true`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"6" toc: type:VARBINARY value:"\x16k@\xb4J\xbaK\xd6" true`,
+		`Execute insert into lkp1(from, toc) values(:from_0, :toc_0) from_0: type:INT64 value:"6" toc_0: type:VARBINARY value:"N\xb1\x90ɢ\xfa\x16\x9c" true`,
+		// The shard of the moving row.
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		// The update is not autocommitted, the rows move within the same transaction.
+		`ExecuteMultiShard sharded.-20: dummy_update {} sharded.20-: dummy_update {} true false`,
+		`ExecuteMultiShard sharded.-20: dummy_moved_rows {} true false`,
+		`ExecuteMultiShard sharded.-20: dummy_delete_moved_rows {} true false`,
+		`ExecuteMultiShard sharded.20-: insert into t1(id, c1, c2, c3) values (:_c0_0, :_c0_1, :_c0_2, :_c0_3) {_c0_0: type:INT64 value:"3" _c0_1: type:INT64 value:"4" _c0_2: type:INT64 value:"5" _c0_3: type:INT64 value:"6"} true false`,
+	})
+
+	// The moving row already is in the shard of the new keyspace id.
+	vc = newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"-20", "-20"}
+	vc.results = results
+
+	_, err = upd.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationAllShards()`,
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(4eb190c9a2fa169c)`,
+		`ExecuteMultiShard sharded.-20: dummy_subquery {} sharded.20-: dummy_subquery {} false false`,
+		`Execute delete from lkp2 where from1 = :from1 and from2 = :from2 and toc = :toc from1: type:INT64 value:"4" from2: type:INT64 value:"5" toc: type:VARBINARY value:"\x16k@\xb4J\xbaK\xd6" true`,
+		`Execute insert into lkp2(from1, from2, toc) values(:from1_0, :from2_0, :toc_0) from1_0: type:INT64 value:"4" from2_0: type:INT64 value:"5" toc_0: type:VARBINARY value:"N\xb1\x90ɢ\xfa\x16\x9c" true`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"6" toc: type:VARBINARY value:"\x16k@\xb4J\xbaK\xd6" true`,
+		`Execute insert into lkp1(from, toc) values(:from_0, :toc_0) from_0: type:INT64 value:"6" toc_0: type:VARBINARY value:"N\xb1\x90ɢ\xfa\x16\x9c" true`,
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard sharded.-20: dummy_update {} sharded.20-: dummy_update {} true false`,
+	})
+}
+
 func buildTestVSchema() *vindexes.VSchema {
 	invschema := &vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
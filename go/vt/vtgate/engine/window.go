@@ -0,0 +1,687 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var _ Primitive = (*Window)(nil)
+
+// Window is a primitive that computes window functions over the rows
+// of its input. It expects the underlying primitive to feed results
+// sorted by the PartitionBy keys followed by the OrderBy keys, which
+// is what a scatter route returns when it merge-sorts the shard results.
+// The rows of a partition are buffered until the partition is complete,
+// and the result of every window function is written into its column.
+type Window struct {
+	// PartitionBy specifies the input values that define a partition.
+	PartitionBy []*GroupByParams
+
+	// OrderBy specifies the ordering of the rows inside a partition.
+	// Rows that are equal on all the ordering keys are peers.
+	OrderBy []OrderByParams
+
+	// Functions specifies the window functions to compute.
+	Functions []*WindowFuncParams
+
+	// TruncateColumnCount specifies the number of columns to return
+	// in the final result. Rest of the columns are truncated
+	// from the result received. If 0, no truncation happens.
+	TruncateColumnCount int `json:",omitempty"`
+
+	// Input is the primitive that will feed into this Primitive.
+	Input Primitive
+}
+
+// WindowFuncParams specify the parameters for each window function.
+type WindowFuncParams struct {
+	Opcode WindowOpcode
+	// Col is the column the result is written to. Functions that take an
+	// argument read it from the same column before overwriting it.
+	Col int
+	// N is the bucket count of NTILE, the offset of LAG and LEAD,
+	// and the row number of NTH_VALUE.
+	N int64 `json:",omitempty"`
+	// DefaultCol is the column holding the default value of LAG and LEAD.
+	// It is set to -1 if no default was given.
+	DefaultCol int
+	// Frame is the frame the function is computed over. It is only used by
+	// the functions that respect frames. If nil, the default frame is used.
+	Frame       *WindowFrame `json:",omitempty"`
+	CollationID collations.ID
+
+	Alias string `json:",omitempty"`
+	Expr  sqlparser.Expr
+}
+
+// WindowFrame specifies the rows, relative to the current row, that
+// a window function is computed over.
+type WindowFrame struct {
+	// Range is true for RANGE frames. A CURRENT ROW bound of a RANGE
+	// frame extends to the peers of the current row.
+	Range bool
+	Start FrameBound
+	End   FrameBound
+}
+
+// FrameBound is one end of a window frame. An unbounded start is the first
+// row of the partition and an unbounded end is the last one. Otherwise,
+// Offset is the position relative to the current row: negative values are
+// PRECEDING rows and positive values are FOLLOWING rows.
+type FrameBound struct {
+	Unbounded bool  `json:",omitempty"`
+	Offset    int64 `json:",omitempty"`
+}
+
+// WindowOpcode is the window function Opcode.
+type WindowOpcode int
+
+// These constants list the possible window function opcodes.
+const (
+	WindowRowNumber = WindowOpcode(iota)
+	WindowRank
+	WindowDenseRank
+	WindowPercentRank
+	WindowCumeDist
+	WindowNtile
+	WindowLag
+	WindowLead
+	WindowFirstValue
+	WindowLastValue
+	WindowNthValue
+	WindowCount
+	WindowCountStar
+	WindowSum
+	WindowAvg
+	WindowMin
+	WindowMax
+)
+
+// SupportedWindowFunctions maps the list of supported window
+// functions to their opcodes.
+var SupportedWindowFunctions = map[string]WindowOpcode{
+	"row_number":   WindowRowNumber,
+	"rank":         WindowRank,
+	"dense_rank":   WindowDenseRank,
+	"percent_rank": WindowPercentRank,
+	"cume_dist":    WindowCumeDist,
+	"ntile":        WindowNtile,
+	"lag":          WindowLag,
+	"lead":         WindowLead,
+	"first_value":  WindowFirstValue,
+	"last_value":   WindowLastValue,
+	"nth_value":    WindowNthValue,
+	"count":        WindowCount,
+	"sum":          WindowSum,
+	"avg":          WindowAvg,
+	"min":          WindowMin,
+	"max":          WindowMax,
+	// This function doesn't exist in mysql, but is used
+	// to display the plan.
+	"count_star": WindowCountStar,
+}
+
+func (code WindowOpcode) String() string {
+	for k, v := range SupportedWindowFunctions {
+		if v == code {
+			return k
+		}
+	}
+	panic("unreachable")
+}
+
+// MarshalJSON serializes the WindowOpcode as a JSON string.
+// It's used for testing and diagnostics.
+func (code WindowOpcode) MarshalJSON() ([]byte, error) {
+	return ([]byte)(fmt.Sprintf("\"%s\"", code.String())), nil
+}
+
+func (wf *WindowFuncParams) String() string {
+	args := []string{strconv.Itoa(wf.Col)}
+	switch wf.Opcode {
+	case WindowNtile, WindowNthValue:
+		args = append(args, strconv.FormatInt(wf.N, 10))
+	case WindowLag, WindowLead:
+		args = append(args, strconv.FormatInt(wf.N, 10))
+		if wf.DefaultCol != -1 {
+			args = append(args, strconv.Itoa(wf.DefaultCol))
+		}
+	}
+	out := fmt.Sprintf("%s(%s)", wf.Opcode.String(), strings.Join(args, ", "))
+	if wf.CollationID != collations.Unknown {
+		collation := collations.Local().LookupByID(wf.CollationID)
+		out += " COLLATE " + collation.Name()
+	}
+	if wf.Frame != nil {
+		out += " " + wf.Frame.String()
+	}
+	if wf.Alias != "" {
+		out += " AS " + wf.Alias
+	}
+	return out
+}
+
+// String returns a string. Used for plan descriptions
+func (wf *WindowFrame) String() string {
+	unit := "rows"
+	if wf.Range {
+		unit = "range"
+	}
+	return fmt.Sprintf("%s between %s and %s", unit, wf.Start.format(true), wf.End.format(false))
+}
+
+func (fb FrameBound) format(start bool) string {
+	switch {
+	case fb.Unbounded && start:
+		return "unbounded preceding"
+	case fb.Unbounded:
+		return "unbounded following"
+	case fb.Offset < 0:
+		return fmt.Sprintf("%d preceding", -fb.Offset)
+	case fb.Offset > 0:
+		return fmt.Sprintf("%d following", fb.Offset)
+	}
+	return "current row"
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (w *Window) RouteType() string {
+	return w.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (w *Window) GetKeyspaceName() string {
+	return w.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (w *Window) GetTableName() string {
+	return w.Input.GetTableName()
+}
+
+// SetTruncateColumnCount sets the truncate column count.
+func (w *Window) SetTruncateColumnCount(count int) {
+	w.TruncateColumnCount = count
+}
+
+// TryExecute is a Primitive function.
+func (w *Window) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result, err := vcursor.ExecutePrimitive(w.Input, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	fields := w.convertFields(result.Fields)
+	out := &sqltypes.Result{
+		Fields: fields,
+		Rows:   make([][]sqltypes.Value, 0, len(result.Rows)),
+	}
+	// This code is similar to the one in StreamExecute.
+	start := 0
+	for i := 1; i <= len(result.Rows); i++ {
+		if i < len(result.Rows) {
+			equal, err := w.samePartition(result.Rows[start], result.Rows[i])
+			if err != nil {
+				return nil, err
+			}
+			if equal {
+				continue
+			}
+		}
+		rows, err := w.processPartition(result.Fields, result.Rows[start:i])
+		if err != nil {
+			return nil, err
+		}
+		out.Rows = append(out.Rows, rows...)
+		start = i
+	}
+	return out.Truncate(w.TruncateColumnCount), nil
+}
+
+// TryStreamExecute is a Primitive function.
+func (w *Window) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	var fields []*querypb.Field
+	var partition [][]sqltypes.Value
+
+	cb := func(qr *sqltypes.Result) error {
+		return callback(qr.Truncate(w.TruncateColumnCount))
+	}
+	flush := func() error {
+		rows, err := w.processPartition(fields, partition)
+		if err != nil {
+			return err
+		}
+		partition = nil
+		return cb(&sqltypes.Result{Rows: rows})
+	}
+
+	err := vcursor.StreamExecutePrimitive(w.Input, bindVars, true, func(qr *sqltypes.Result) error {
+		if len(qr.Fields) != 0 {
+			fields = qr.Fields
+			if err := cb(&sqltypes.Result{Fields: w.convertFields(fields)}); err != nil {
+				return err
+			}
+		}
+		// This code is similar to the one in Execute.
+		for _, row := range qr.Rows {
+			if len(partition) > 0 {
+				equal, err := w.samePartition(partition[0], row)
+				if err != nil {
+					return err
+				}
+				if !equal {
+					if err := flush(); err != nil {
+						return err
+					}
+				}
+			}
+			partition = append(partition, row)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(partition) > 0 {
+		return flush()
+	}
+	return nil
+}
+
+// GetFields is a Primitive function.
+func (w *Window) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := w.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	qr = &sqltypes.Result{Fields: w.convertFields(qr.Fields)}
+	return qr.Truncate(w.TruncateColumnCount), nil
+}
+
+// Inputs returns the Primitive input for this window
+func (w *Window) Inputs() []Primitive {
+	return []Primitive{w.Input}
+}
+
+// NeedsTransaction implements the Primitive interface
+func (w *Window) NeedsTransaction() bool {
+	return w.Input.NeedsTransaction()
+}
+
+func (w *Window) convertFields(fields []*querypb.Field) []*querypb.Field {
+	if fields == nil {
+		return nil
+	}
+	out := make([]*querypb.Field, len(fields))
+	copy(out, fields)
+	for _, wf := range w.Functions {
+		out[wf.Col] = &querypb.Field{
+			Name: wf.Alias,
+			Type: wf.resultType(fields),
+		}
+	}
+	return out
+}
+
+func (wf *WindowFuncParams) resultType(fields []*querypb.Field) querypb.Type {
+	switch wf.Opcode {
+	case WindowRowNumber, WindowRank, WindowDenseRank, WindowNtile, WindowCount, WindowCountStar:
+		return sqltypes.Int64
+	case WindowPercentRank, WindowCumeDist:
+		return sqltypes.Float64
+	case WindowSum, WindowAvg:
+		if sqltypes.IsFloat(fields[wf.Col].Type) {
+			return sqltypes.Float64
+		}
+		return sqltypes.Decimal
+	}
+	return fields[wf.Col].Type
+}
+
+func (w *Window) samePartition(row1, row2 []sqltypes.Value) (bool, error) {
+	for _, key := range w.PartitionBy {
+		equal, err := valuesEqual(row1, row2, key.KeyCol, key.WeightStringCol, key.CollationID)
+		if err != nil || !equal {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (w *Window) peers(row1, row2 []sqltypes.Value) (bool, error) {
+	for _, key := range w.OrderBy {
+		equal, err := valuesEqual(row1, row2, key.Col, key.WeightStringCol, key.CollationID)
+		if err != nil || !equal {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func valuesEqual(row1, row2 []sqltypes.Value, col, weightStringCol int, collationID collations.ID) (bool, error) {
+	cmp, err := evalengine.NullsafeCompare(row1[col], row2[col], collationID)
+	if err != nil {
+		_, isComparisonErr := err.(evalengine.UnsupportedComparisonError)
+		_, isCollationErr := err.(evalengine.UnsupportedCollationError)
+		if !isComparisonErr && !isCollationErr || weightStringCol == -1 {
+			return false, err
+		}
+		cmp, err = evalengine.NullsafeCompare(row1[weightStringCol], row2[weightStringCol], collationID)
+		if err != nil {
+			return false, err
+		}
+	}
+	return cmp == 0, nil
+}
+
+// windowPartition holds the rows of a partition along with the
+// position of the first and last peer of every row.
+type windowPartition struct {
+	rows      [][]sqltypes.Value
+	peerStart []int
+	peerEnd   []int
+}
+
+// processPartition computes all the window functions over the rows of one
+// partition and returns copies of the rows with the results filled in.
+func (w *Window) processPartition(fields []*querypb.Field, rows [][]sqltypes.Value) ([][]sqltypes.Value, error) {
+	n := len(rows)
+	p := &windowPartition{
+		rows:      rows,
+		peerStart: make([]int, n),
+		peerEnd:   make([]int, n),
+	}
+	for i := 1; i < n; i++ {
+		equal, err := w.peers(rows[i-1], rows[i])
+		if err != nil {
+			return nil, err
+		}
+		if equal {
+			p.peerStart[i] = p.peerStart[i-1]
+		} else {
+			p.peerStart[i] = i
+		}
+	}
+	for i := n - 1; i >= 0; i-- {
+		if i < n-1 && p.peerStart[i+1] == p.peerStart[i] {
+			p.peerEnd[i] = p.peerEnd[i+1]
+		} else {
+			p.peerEnd[i] = i
+		}
+	}
+
+	// All results are computed before any of them is written, because
+	// the arguments are read from the result columns.
+	results := make([][]sqltypes.Value, len(w.Functions))
+	for idx, wf := range w.Functions {
+		var err error
+		results[idx], err = w.compute(wf, fields, p)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	out := make([][]sqltypes.Value, n)
+	for i, row := range rows {
+		out[i] = sqltypes.CopyRow(row)
+		for idx, wf := range w.Functions {
+			out[i][wf.Col] = results[idx][i]
+		}
+	}
+	return out, nil
+}
+
+func (w *Window) compute(wf *WindowFuncParams, fields []*querypb.Field, p *windowPartition) ([]sqltypes.Value, error) {
+	n := len(p.rows)
+	res := make([]sqltypes.Value, n)
+	switch wf.Opcode {
+	case WindowRowNumber:
+		for i := range res {
+			res[i] = sqltypes.NewInt64(int64(i + 1))
+		}
+	case WindowRank:
+		for i := range res {
+			res[i] = sqltypes.NewInt64(int64(p.peerStart[i] + 1))
+		}
+	case WindowDenseRank:
+		var rank int64
+		for i := range res {
+			if p.peerStart[i] == i {
+				rank++
+			}
+			res[i] = sqltypes.NewInt64(rank)
+		}
+	case WindowPercentRank:
+		for i := range res {
+			var pr float64
+			if n > 1 {
+				pr = float64(p.peerStart[i]) / float64(n-1)
+			}
+			res[i] = sqltypes.NewFloat64(pr)
+		}
+	case WindowCumeDist:
+		for i := range res {
+			res[i] = sqltypes.NewFloat64(float64(p.peerEnd[i]+1) / float64(n))
+		}
+	case WindowNtile:
+		if wf.N <= 0 {
+			return nil, fmt.Errorf("incorrect arguments to ntile")
+		}
+		// The first n%N buckets get one row more than the others.
+		size, extra := int64(n)/wf.N, int64(n)%wf.N
+		bucket, left := int64(0), int64(0)
+		for i := range res {
+			if left == 0 {
+				bucket++
+				left = size
+				if bucket <= extra {
+					left++
+				}
+			}
+			left--
+			res[i] = sqltypes.NewInt64(bucket)
+		}
+	case WindowLag, WindowLead:
+		offset := int(wf.N)
+		if wf.Opcode == WindowLag {
+			offset = -offset
+		}
+		for i, row := range p.rows {
+			switch j := i + offset; {
+			case j >= 0 && j < n:
+				res[i] = p.rows[j][wf.Col]
+			case wf.DefaultCol != -1:
+				res[i] = row[wf.DefaultCol]
+			default:
+				res[i] = sqltypes.NULL
+			}
+		}
+	case WindowFirstValue, WindowLastValue, WindowNthValue:
+		for i := range res {
+			lo, hi := w.frameBounds(wf, p, i)
+			pos := -1
+			switch wf.Opcode {
+			case WindowFirstValue:
+				pos = lo
+			case WindowLastValue:
+				pos = hi
+			case WindowNthValue:
+				pos = lo + int(wf.N) - 1
+			}
+			if lo > hi || pos < lo || pos > hi {
+				res[i] = sqltypes.NULL
+				continue
+			}
+			res[i] = p.rows[pos][wf.Col]
+		}
+	case WindowCount, WindowCountStar, WindowSum, WindowAvg, WindowMin, WindowMax:
+		return w.computeAggregate(wf, wf.resultType(fields), p)
+	default:
+		return nil, fmt.Errorf("BUG: Unexpected opcode: %v", wf.Opcode)
+	}
+	return res, nil
+}
+
+// computeAggregate computes an aggregate function over the frame of every row.
+// Frames that only grow at the end reuse the previous aggregation state, so
+// the common running totals take linear time.
+func (w *Window) computeAggregate(wf *WindowFuncParams, resultType querypb.Type, p *windowPartition) ([]sqltypes.Value, error) {
+	res := make([]sqltypes.Value, len(p.rows))
+	agg := &windowAggregate{wf: wf, resultType: resultType}
+	curLo, curHi := -1, -1
+	for i := range res {
+		lo, hi := w.frameBounds(wf, p, i)
+		if lo > hi {
+			curLo, curHi = -1, -1
+			agg.reset()
+		} else {
+			from := curHi + 1
+			if curLo == -1 || lo != curLo || hi < curHi {
+				agg.reset()
+				from = lo
+			}
+			for j := from; j <= hi; j++ {
+				if err := agg.add(p.rows[j][wf.Col]); err != nil {
+					return nil, err
+				}
+			}
+			curLo, curHi = lo, hi
+		}
+		var err error
+		res[i], err = agg.result()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// frameBounds returns the positions of the first and last rows of the frame of
+// row i. The frame is empty if the first position is after the last one.
+func (w *Window) frameBounds(wf *WindowFuncParams, p *windowPartition, i int) (int, int) {
+	n := len(p.rows)
+	frame := wf.Frame
+	if frame == nil {
+		// Without an ORDER BY all the rows of the partition are peers, so the
+		// default frame becomes the whole partition.
+		frame = &WindowFrame{Range: true, Start: FrameBound{Unbounded: true}}
+	}
+
+	var lo, hi int
+	switch {
+	case frame.Start.Unbounded:
+		lo = 0
+	case frame.Range:
+		lo = p.peerStart[i]
+	default:
+		lo = i + int(frame.Start.Offset)
+	}
+	switch {
+	case frame.End.Unbounded:
+		hi = n - 1
+	case frame.Range:
+		hi = p.peerEnd[i]
+	default:
+		hi = i + int(frame.End.Offset)
+	}
+	if lo < 0 {
+		lo = 0
+	}
+	if hi > n-1 {
+		hi = n - 1
+	}
+	return lo, hi
+}
+
+// windowAggregate is the running state of an aggregate window function.
+type windowAggregate struct {
+	wf         *WindowFuncParams
+	resultType querypb.Type
+	count      int64
+	value      sqltypes.Value
+}
+
+func (wa *windowAggregate) reset() {
+	wa.count = 0
+	wa.value = sqltypes.NULL
+}
+
+func (wa *windowAggregate) add(v sqltypes.Value) error {
+	if wa.wf.Opcode == WindowCountStar {
+		wa.count++
+		return nil
+	}
+	if v.IsNull() {
+		return nil
+	}
+	wa.count++
+	var err error
+	switch wa.wf.Opcode {
+	case WindowSum, WindowAvg:
+		wa.value, err = evalengine.NullSafeAdd(wa.value, v, wa.resultType)
+	case WindowMin:
+		wa.value, err = evalengine.Min(wa.value, v, wa.wf.CollationID)
+	case WindowMax:
+		wa.value, err = evalengine.Max(wa.value, v, wa.wf.CollationID)
+	}
+	return err
+}
+
+func (wa *windowAggregate) result() (sqltypes.Value, error) {
+	switch wa.wf.Opcode {
+	case WindowCount, WindowCountStar:
+		return sqltypes.NewInt64(wa.count), nil
+	case WindowAvg:
+		if wa.count == 0 {
+			return sqltypes.NULL, nil
+		}
+		return evalengine.Divide(wa.value, sqltypes.NewInt64(wa.count))
+	}
+	return wa.value, nil
+}
+
+func windowFuncParamsToString(in interface{}) string {
+	return in.(*WindowFuncParams).String()
+}
+
+func (w *Window) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"Functions": GenericJoin(w.Functions, windowFuncParamsToString),
+	}
+	if len(w.PartitionBy) > 0 {
+		other["PartitionBy"] = GenericJoin(w.PartitionBy, groupByParamsToString)
+	}
+	if len(w.OrderBy) > 0 {
+		other["OrderBy"] = GenericJoin(w.OrderBy, orderByParamsToString)
+	}
+	if w.TruncateColumnCount > 0 {
+		other["ResultColumns"] = w.TruncateColumnCount
+	}
+	return PrimitiveDescription{
+		OperatorType: "Window",
+		Other:        other,
+	}
+}
@@ -0,0 +1,293 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestWindowExecuteRanking(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"dept|salary|rn|rnk|drnk",
+				"varbinary|int64|null|null|null",
+			),
+			"a|10|null|null|null",
+			"a|20|null|null|null",
+			"a|20|null|null|null",
+			"a|30|null|null|null",
+			"b|5|null|null|null",
+		)},
+	}
+
+	w := &Window{
+		PartitionBy: []*GroupByParams{{KeyCol: 0, WeightStringCol: -1}},
+		OrderBy:     []OrderByParams{{Col: 1, WeightStringCol: -1}},
+		Functions: []*WindowFuncParams{
+			{Opcode: WindowRowNumber, Col: 2, DefaultCol: -1, Alias: "rn"},
+			{Opcode: WindowRank, Col: 3, DefaultCol: -1, Alias: "rnk"},
+			{Opcode: WindowDenseRank, Col: 4, DefaultCol: -1, Alias: "drnk"},
+		},
+		Input: fp,
+	}
+
+	result, err := w.TryExecute(&noopVCursor{}, nil, false)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"dept|salary|rn|rnk|drnk",
+			"varbinary|int64|int64|int64|int64",
+		),
+		"a|10|1|1|1",
+		"a|20|2|2|2",
+		"a|20|3|2|2",
+		"a|30|4|4|3",
+		"b|5|1|1|1",
+	)
+	assert.Equal(t, wantResult, result)
+}
+
+func TestWindowExecuteDistribution(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"val|pr|cd|nt",
+				"int64|null|null|null",
+			),
+			"1|null|null|null",
+			"2|null|null|null",
+			"2|null|null|null",
+			"3|null|null|null",
+			"4|null|null|null",
+		)},
+	}
+
+	w := &Window{
+		OrderBy: []OrderByParams{{Col: 0, WeightStringCol: -1}},
+		Functions: []*WindowFuncParams{
+			{Opcode: WindowPercentRank, Col: 1, DefaultCol: -1, Alias: "pr"},
+			{Opcode: WindowCumeDist, Col: 2, DefaultCol: -1, Alias: "cd"},
+			{Opcode: WindowNtile, Col: 3, N: 2, DefaultCol: -1, Alias: "nt"},
+		},
+		Input: fp,
+	}
+
+	result, err := w.TryExecute(&noopVCursor{}, nil, false)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"val|pr|cd|nt",
+			"int64|float64|float64|int64",
+		),
+		"1|0|0.2|1",
+		"2|0.25|0.6|1",
+		"2|0.25|0.6|1",
+		"3|0.75|0.8|2",
+		"4|1|1|2",
+	)
+	assert.Equal(t, wantResult, result)
+}
+
+func TestWindowExecuteLagLead(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"grp|val|lag(val)|lead(val)|def",
+				"varbinary|int64|int64|int64|int64",
+			),
+			"a|1|1|1|0",
+			"a|2|2|2|0",
+			"a|3|3|3|0",
+			"b|4|4|4|0",
+		)},
+	}
+
+	w := &Window{
+		PartitionBy: []*GroupByParams{{KeyCol: 0, WeightStringCol: -1}},
+		OrderBy:     []OrderByParams{{Col: 1, WeightStringCol: -1}},
+		Functions: []*WindowFuncParams{
+			{Opcode: WindowLag, Col: 2, N: 1, DefaultCol: 4, Alias: "lag(val)"},
+			{Opcode: WindowLead, Col: 3, N: 2, DefaultCol: -1, Alias: "lead(val)"},
+		},
+		TruncateColumnCount: 4,
+		Input:               fp,
+	}
+
+	result, err := w.TryExecute(&noopVCursor{}, nil, false)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"grp|val|lag(val)|lead(val)",
+			"varbinary|int64|int64|int64",
+		),
+		"a|1|0|3",
+		"a|2|1|null",
+		"a|3|2|null",
+		"b|4|0|null",
+	)
+	assert.Equal(t, wantResult, result)
+}
+
+func TestWindowExecuteAggregates(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"val|sum(val)|count(val)|max(val)|sum_rows(val)|first_value(val)",
+				"int64|int64|int64|int64|int64|int64",
+			),
+			"1|1|1|1|1|1",
+			"2|2|2|2|2|2",
+			"2|2|2|2|2|2",
+			"null|null|null|null|null|null",
+		)},
+	}
+
+	w := &Window{
+		OrderBy: []OrderByParams{{Col: 0, WeightStringCol: -1}},
+		Functions: []*WindowFuncParams{
+			// The default frame includes the peers of the current row.
+			{Opcode: WindowSum, Col: 1, DefaultCol: -1, Alias: "sum(val)"},
+			{Opcode: WindowCount, Col: 2, DefaultCol: -1, Alias: "count(val)"},
+			{Opcode: WindowMax, Col: 3, DefaultCol: -1, Alias: "max(val)", Frame: &WindowFrame{
+				Start: FrameBound{Unbounded: true},
+				End:   FrameBound{Unbounded: true},
+			}},
+			{Opcode: WindowSum, Col: 4, DefaultCol: -1, Alias: "sum_rows(val)", Frame: &WindowFrame{
+				Start: FrameBound{Offset: -1},
+				End:   FrameBound{Offset: 0},
+			}},
+			{Opcode: WindowFirstValue, Col: 5, DefaultCol: -1, Alias: "first_value(val)", Frame: &WindowFrame{
+				Start: FrameBound{Offset: 1},
+				End:   FrameBound{Unbounded: true},
+			}},
+		},
+		Input: fp,
+	}
+
+	result, err := w.TryExecute(&noopVCursor{}, nil, false)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"val|sum(val)|count(val)|max(val)|sum_rows(val)|first_value(val)",
+			"int64|decimal|int64|int64|decimal|int64",
+		),
+		"1|1|1|2|1|2",
+		"2|5|3|2|3|2",
+		"2|5|3|2|4|null",
+		"null|5|3|2|2|null",
+	)
+	assert.Equal(t, wantResult, result)
+}
+
+func TestWindowStreamExecute(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"grp|val|row_number()",
+		"varbinary|int64|int64",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"a|1|null",
+			"a|2|null",
+			"a|3|null",
+			"b|4|null",
+			"c|5|null",
+		)},
+	}
+
+	w := &Window{
+		PartitionBy: []*GroupByParams{{KeyCol: 0, WeightStringCol: -1}},
+		Functions: []*WindowFuncParams{
+			{Opcode: WindowRowNumber, Col: 2, DefaultCol: -1, Alias: "row_number()"},
+		},
+		Input: fp,
+	}
+
+	var results []*sqltypes.Result
+	err := w.TryStreamExecute(&noopVCursor{}, nil, true, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	require.NoError(t, err)
+
+	// A partition is only sent once the first row of the next one is seen.
+	wantResults := sqltypes.MakeTestStreamingResults(
+		fields,
+		"a|1|1",
+		"a|2|2",
+		"a|3|3",
+		"---",
+		"b|4|1",
+		"---",
+		"c|5|1",
+	)
+	assert.Equal(t, wantResults, results)
+}
+
+func TestWindowGetFieldsTruncate(t *testing.T) {
+	result := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col|rank()|weight_string(col)",
+			"varchar|null|varbinary",
+		),
+	)
+	fp := &fakePrimitive{results: []*sqltypes.Result{result}}
+
+	w := &Window{
+		PartitionBy:         []*GroupByParams{{KeyCol: 0, WeightStringCol: 2}},
+		Functions:           []*WindowFuncParams{{Opcode: WindowRank, Col: 1, DefaultCol: -1, Alias: "rank()"}},
+		TruncateColumnCount: 2,
+		Input:               fp,
+	}
+
+	got, err := w.GetFields(nil, nil)
+	require.NoError(t, err)
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col|rank()",
+			"varchar|int64",
+		),
+	)
+	assert.Equal(t, wantResult, got)
+}
+
+func TestWindowInputFail(t *testing.T) {
+	fp := &fakePrimitive{sendErr: errors.New("input fail")}
+
+	w := &Window{
+		Functions: []*WindowFuncParams{{Opcode: WindowRowNumber, Col: 0, DefaultCol: -1}},
+		Input:     fp,
+	}
+
+	_, err := w.TryExecute(&noopVCursor{}, nil, false)
+	assert.EqualError(t, err, "input fail")
+
+	fp.rewind()
+	err = w.TryStreamExecute(&noopVCursor{}, nil, false, func(_ *sqltypes.Result) error { return nil })
+	assert.EqualError(t, err, "input fail")
+}
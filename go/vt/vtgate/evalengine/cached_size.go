@@ -135,6 +135,27 @@ func (cached *CallExpr) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *CaseExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Cases []vitess.io/vitess/go/vt/vtgate/evalengine.WhenThen
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Cases)) * int64(32))
+		for _, elem := range cached.Cases {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field Else vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Else.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *CollateExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -199,6 +220,24 @@ func (cached *ConvertUsingExpr) CachedSize(alloc bool) int64 {
 	size += cached.UnaryExpr.CachedSize(false)
 	return size
 }
+func (cached *DateAddExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Date vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Date.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Interval vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Interval.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *EvalResult) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -237,7 +276,7 @@ func (cached *ExpressionEnv) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(64)
+		size += int64(96)
 	}
 	// field BindVars map[string]*vitess.io/vitess/go/vt/proto/query.BindVariable
 	if cached.BindVars != nil {
@@ -254,6 +293,10 @@ func (cached *ExpressionEnv) CachedSize(alloc bool) int64 {
 			size += v.CachedSize(true)
 		}
 	}
+	// field TimeZone *time.Location
+	if cached.TimeZone != nil {
+		size += hack.RuntimeAllocSize(int64(104))
+	}
 	// field Row []vitess.io/vitess/go/sqltypes.Value
 	{
 		size += hack.RuntimeAllocSize(int64(cap(cached.Row)) * int64(32))
@@ -270,6 +313,18 @@ func (cached *ExpressionEnv) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *ExtractExpr) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field UnaryExpr vitess.io/vitess/go/vt/vtgate/evalengine.UnaryExpr
+	size += cached.UnaryExpr.CachedSize(false)
+	return size
+}
 
 //go:nocheckptr
 func (cached *InExpr) CachedSize(alloc bool) int64 {
@@ -403,6 +458,96 @@ func (cached *WeightStringCallExpr) CachedSize(alloc bool) int64 {
 	size += hack.RuntimeAllocSize(int64(len(cached.Cast)))
 	return size
 }
+func (cached *WhenThen) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field When vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.When.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Then vitess.io/vitess/go/vt/vtgate/evalengine.Expr
+	if cc, ok := cached.Then.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *builtinChangeCase) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field name string
+	size += hack.RuntimeAllocSize(int64(len(cached.name)))
+	return size
+}
+func (cached *builtinCurdate) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field name string
+	size += hack.RuntimeAllocSize(int64(len(cached.name)))
+	return size
+}
+func (cached *builtinCurtime) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field name string
+	size += hack.RuntimeAllocSize(int64(len(cached.name)))
+	return size
+}
+func (cached *builtinExtract) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field name string
+	size += hack.RuntimeAllocSize(int64(len(cached.name)))
+	return size
+}
+func (cached *builtinLeftRight) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field name string
+	size += hack.RuntimeAllocSize(int64(len(cached.name)))
+	return size
+}
+func (cached *builtinLocate) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field name string
+	size += hack.RuntimeAllocSize(int64(len(cached.name)))
+	return size
+}
 func (cached *builtinMultiComparison) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -415,3 +560,39 @@ func (cached *builtinMultiComparison) CachedSize(alloc bool) int64 {
 	size += hack.RuntimeAllocSize(int64(len(cached.name)))
 	return size
 }
+func (cached *builtinNow) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field name string
+	size += hack.RuntimeAllocSize(int64(len(cached.name)))
+	return size
+}
+func (cached *builtinPad) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field name string
+	size += hack.RuntimeAllocSize(int64(len(cached.name)))
+	return size
+}
+func (cached *builtinTrim) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field name string
+	size += hack.RuntimeAllocSize(int64(len(cached.name)))
+	return size
+}
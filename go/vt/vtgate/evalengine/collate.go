@@ -42,6 +42,13 @@ var collationBinary = collations.TypedCollation{
 	Repertoire:   collations.RepertoireASCII,
 }
 
+// collationJSON is the collation of JSON documents, and of the strings extracted from them
+var collationJSON = collations.TypedCollation{
+	Collation:    collations.Local().LookupByName("utf8mb4_bin").ID(),
+	Coercibility: collations.CoerceImplicit,
+	Repertoire:   collations.RepertoireUnicode,
+}
+
 func (c *CollateExpr) eval(env *ExpressionEnv, out *EvalResult) {
 	out.init(env, c.Inner)
 	if err := collations.Local().EnsureCollate(out.collation().Collation, c.TypedCollation.Collation); err != nil {
@@ -203,6 +203,9 @@ func evalCompareAll(lVal, rVal *EvalResult, fulleq bool) (int, bool, error) {
 // 		- https://dev.mysql.com/doc/refman/8.0/en/type-conversion.html
 func evalCompare(lVal, rVal *EvalResult) (comp int, err error) {
 	switch {
+	case lVal.typeof() == sqltypes.TypeJSON || rVal.typeof() == sqltypes.TypeJSON:
+		return compareJSONResults(lVal, rVal)
+
 	case evalResultsAreStrings(lVal, rVal):
 		return compareStrings(lVal, rVal), nil
 
@@ -216,12 +219,7 @@ func evalCompare(lVal, rVal *EvalResult) (comp int, err error) {
 		return compareDateAndString(lVal, rVal)
 
 	case evalResultsAreDateAndNumeric(lVal, rVal):
-		// TODO: support comparison between a date and a numeric value
-		// 		queries like the ones below should be supported:
-		// 			- select 1 where 20210101 = cast("2021-01-01" as date)
-		// 			- select 1 where 2021210101 = cast("2021-01-01" as date)
-		// 			- select 1 where 104200 = cast("10:42:00" as time)
-		return 0, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "cannot compare a date with a numeric value")
+		return compareDateAndNumeric(lVal, rVal)
 
 	case lVal.typeof() == sqltypes.Tuple || rVal.typeof() == sqltypes.Tuple:
 		panic("evalCompare: tuple comparison should be handled early")
@@ -428,6 +426,18 @@ func NullsafeCompare(v1, v2 sqltypes.Value, collationID collations.ID) (int, err
 		return 1, nil
 	}
 
+	if v1.Type() == sqltypes.TypeJSON || v2.Type() == sqltypes.TypeJSON {
+		var l, r EvalResult
+		coll := collations.TypedCollation{Collation: collationID, Coercibility: collations.CoerceImplicit, Repertoire: collations.RepertoireUnicode}
+		if err := l.setValue(v1, coll); err != nil {
+			return 0, err
+		}
+		if err := r.setValue(v2, coll); err != nil {
+			return 0, err
+		}
+		return compareJSONResults(&l, &r)
+	}
+
 	if isByteComparable(v1.Type(), collationID) && isByteComparable(v2.Type(), collationID) {
 		return bytes.Compare(v1.Raw(), v2.Raw()), nil
 	}
@@ -496,7 +506,7 @@ func isByteComparable(typ sqltypes.Type, collationID collations.ID) bool {
 		return collationID == collations.CollationBinaryID
 	}
 	switch typ {
-	case sqltypes.Timestamp, sqltypes.Date, sqltypes.Time, sqltypes.Datetime, sqltypes.Enum, sqltypes.Set, sqltypes.TypeJSON, sqltypes.Bit:
+	case sqltypes.Timestamp, sqltypes.Date, sqltypes.Time, sqltypes.Datetime, sqltypes.Enum, sqltypes.Set, sqltypes.Bit:
 		return true
 	default:
 		return false
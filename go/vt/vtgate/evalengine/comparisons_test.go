@@ -867,6 +867,30 @@ func TestCompareDates(t *testing.T) {
 			out: &T, op: sqlparser.NotEqualOp,
 			row: []sqltypes.Value{sqltypes.NewDate("2021-03-30"), sqltypes.NewVarChar("2021-02-20")},
 		},
+		{
+			name: "string less than date (relaxed format)",
+			v1:   NewColumn(0, defaultCollation), v2: NewColumn(1, defaultCollation),
+			out: &T, op: sqlparser.LessThanOp,
+			row: []sqltypes.Value{sqltypes.NewVarChar("2021/2/3"), sqltypes.NewDate("2021-02-20")},
+		},
+		{
+			name: "date equal integer",
+			v1:   NewColumn(0, defaultCollation), v2: NewColumn(1, defaultCollation),
+			out: &T, op: sqlparser.EqualOp,
+			row: []sqltypes.Value{sqltypes.NewDate("2021-02-20"), sqltypes.NewInt64(20210220)},
+		},
+		{
+			name: "integer less than datetime",
+			v1:   NewColumn(0, defaultCollation), v2: NewColumn(1, defaultCollation),
+			out: &T, op: sqlparser.LessThanOp,
+			row: []sqltypes.Value{sqltypes.NewInt64(20210220), sqltypes.NewDatetime("2021-02-20 10:00:00")},
+		},
+		{
+			name: "time equal integer",
+			v1:   NewColumn(0, defaultCollation), v2: NewColumn(1, defaultCollation),
+			out: &T, op: sqlparser.EqualOp,
+			row: []sqltypes.Value{sqltypes.NewTime("10:42:00"), sqltypes.NewInt64(104200)},
+		},
 	}
 
 	for i, tcase := range tests {
@@ -1136,6 +1160,31 @@ func TestNullsafeCompare(t *testing.T) {
 		v1:  TestValue(sqltypes.Bit, "0"),
 		v2:  TestValue(sqltypes.Bit, "1"),
 		out: -1,
+	}, {
+		// JSON documents compare by value, not by their text
+		v1:  TestValue(sqltypes.TypeJSON, `{"a": 1, "b": [1.0, 2]}`),
+		v2:  TestValue(sqltypes.TypeJSON, `{"b":[1,2],"a":1}`),
+		out: 0,
+	}, {
+		// JSON numbers sort before JSON strings
+		v1:  TestValue(sqltypes.TypeJSON, `10`),
+		v2:  TestValue(sqltypes.TypeJSON, `"1"`),
+		out: -1,
+	}, {
+		// JSON against a SQL number
+		v1:  TestValue(sqltypes.TypeJSON, `2.5`),
+		v2:  NewInt64(2),
+		out: 1,
+	}, {
+		// JSON against a SQL string
+		v1:  TestValue(sqltypes.VarChar, "abc"),
+		v2:  TestValue(sqltypes.TypeJSON, `"abc"`),
+		out: 0,
+	}, {
+		// Invalid JSON documents are reported
+		v1:  TestValue(sqltypes.TypeJSON, `{"a": }`),
+		v2:  TestValue(sqltypes.TypeJSON, `{}`),
+		err: vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, `Invalid JSON text: "Invalid value." at position 6.`),
 	}}
 	for _, tcase := range tcases {
 		got, err := NullsafeCompare(tcase.v1, tcase.v2, collation)
@@ -17,6 +17,9 @@ limitations under the License.
 package evalengine
 
 import (
+	"math"
+	"time"
+
 	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
@@ -101,7 +104,32 @@ func (c *ConvertExpr) eval(env *ExpressionEnv, result *EvalResult) {
 		result.makeSignedIntegral()
 	case "UNSIGNED", "UNSIGNED INTEGER":
 		result.makeUnsignedIntegral()
-	case "DATE", "DATETIME", "YEAR", "JSON", "TIME":
+	case "DATE":
+		if dt, ok := datetimeArg(env, result); ok {
+			result.setRaw(sqltypes.Date, appendDate(nil, dt.t), collationNumeric)
+		} else {
+			result.setNull()
+		}
+	case "DATETIME":
+		if dt, ok := datetimeArg(env, result); ok {
+			dt.date = false
+			dt.prec = uint8(c.Length)
+			dt.t = truncateTime(dt.t, dt.prec)
+			result.setRaw(sqltypes.Datetime, dt.format(), collationNumeric)
+		} else {
+			result.setNull()
+		}
+	case "TIME":
+		if d, _, ok := timeArg(result); ok {
+			prec := uint8(c.Length)
+			d = d.Truncate(time.Duration(math.Pow10(9 - int(prec))))
+			result.setRaw(sqltypes.Time, formatTime(d, prec), collationNumeric)
+		} else {
+			result.setNull()
+		}
+	case "JSON":
+		result.setJSON(castJSON(result))
+	case "YEAR":
 		c.unsupported()
 	default:
 		panic("BUG: sqlparser emitted unknown type")
@@ -127,7 +155,15 @@ func (c *ConvertExpr) typeof(env *ExpressionEnv) (sqltypes.Type, flag) {
 		return sqltypes.Int64, f
 	case "UNSIGNED", "UNSIGNED INTEGER":
 		return sqltypes.Uint64, f
-	case "DATE", "DATETIME", "YEAR", "JSON", "TIME":
+	case "DATE":
+		return sqltypes.Date, f | flagNullable
+	case "DATETIME":
+		return sqltypes.Datetime, f | flagNullable
+	case "TIME":
+		return sqltypes.Time, f | flagNullable
+	case "JSON":
+		return sqltypes.TypeJSON, f
+	case "YEAR":
 		c.unsupported()
 		return sqltypes.Null, f
 	default:
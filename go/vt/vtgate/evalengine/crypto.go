@@ -0,0 +1,121 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+)
+
+type (
+	builtinMD5  struct{}
+	builtinSHA1 struct{}
+	builtinSHA2 struct{}
+)
+
+// setDigestResult sets the result of a hashing function, which MySQL returns
+// as a string of lowercase hex digits in the connection's collation
+func setDigestResult(env *ExpressionEnv, result *EvalResult, sum []byte) {
+	collation := env.DefaultCollation
+	if collation == collations.Unknown {
+		collation = collations.Default()
+	}
+	result.setRaw(sqltypes.VarChar, []byte(hex.EncodeToString(sum)), collations.TypedCollation{
+		Collation:    collation,
+		Coercibility: collations.CoerceCoercible,
+		Repertoire:   collations.RepertoireASCII,
+	})
+}
+
+func (builtinMD5) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	arg := &args[0]
+	if arg.isNull() {
+		result.setNull()
+		return
+	}
+	raw, _ := textualArg(env, arg)
+	sum := md5.Sum(raw)
+	setDigestResult(env, result, sum[:])
+}
+
+func (builtinMD5) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 {
+		throwArgError("MD5")
+	}
+	return sqltypes.VarChar, nullFlags(env, args[0])
+}
+
+func (builtinSHA1) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	arg := &args[0]
+	if arg.isNull() {
+		result.setNull()
+		return
+	}
+	raw, _ := textualArg(env, arg)
+	sum := sha1.Sum(raw)
+	setDigestResult(env, result, sum[:])
+}
+
+func (builtinSHA1) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 {
+		throwArgError("SHA1")
+	}
+	return sqltypes.VarChar, nullFlags(env, args[0])
+}
+
+func (builtinSHA2) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	arg, length := &args[0], &args[1]
+	if arg.isNull() || length.isNull() {
+		result.setNull()
+		return
+	}
+	raw, _ := textualArg(env, arg)
+
+	// SHA2 returns NULL for any hash length that is not one of the supported ones
+	var sum []byte
+	switch integralArg(length) {
+	case 0, 256:
+		s := sha256.Sum256(raw)
+		sum = s[:]
+	case 224:
+		s := sha256.Sum224(raw)
+		sum = s[:]
+	case 384:
+		s := sha512.Sum384(raw)
+		sum = s[:]
+	case 512:
+		s := sha512.Sum512(raw)
+		sum = s[:]
+	default:
+		result.setNull()
+		return
+	}
+	setDigestResult(env, result, sum)
+}
+
+func (builtinSHA2) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 2 {
+		throwArgError("SHA2")
+	}
+	return sqltypes.VarChar, flagNullable
+}
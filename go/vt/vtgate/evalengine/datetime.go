@@ -0,0 +1,917 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// datetime is a DATE or DATETIME value broken down into its parts. The wall clock
+// time is always stored in UTC: time zones only come into play when converting
+// from or into UNIX timestamps.
+type datetime struct {
+	t time.Time
+	// prec is the number of fractional digits in the seconds of this value
+	prec uint8
+	// date is set for values that have no time part
+	date bool
+}
+
+const (
+	maxTimePrecision = 6
+	// maxTime is the largest value that can be stored in a TIME: 838:59:59
+	maxTime = 838*time.Hour + 59*time.Minute + 59*time.Second
+)
+
+// systemTimeZone is the time zone of the MySQL servers, used for the sessions
+// whose time_zone is `SYSTEM`. It defaults to the local time zone of the process
+var systemTimeZone = time.Local
+
+// SetSystemTimeZone configures the time zone of the MySQL servers, which is used
+// to evaluate temporal expressions when the time_zone of the session is `SYSTEM`
+// or has not been set. tz is an offset from UTC such as `+08:00`, or a named time zone
+func SetSystemTimeZone(tz string) error {
+	if tz = strings.TrimSpace(tz); tz == "" || strings.EqualFold(tz, "SYSTEM") {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Unknown or incorrect time zone: '%s'", tz)
+	}
+	loc, err := ParseTimeZone(tz)
+	if err != nil {
+		return err
+	}
+	systemTimeZone = loc
+	return nil
+}
+
+// ParseTimeZone parses the value of the `time_zone` system variable, which can
+// be `SYSTEM`, an offset from UTC such as `+08:00`, or a named time zone.
+// `SYSTEM` is the time zone configured with SetSystemTimeZone
+func ParseTimeZone(tz string) (*time.Location, error) {
+	tz = strings.TrimSpace(tz)
+	switch {
+	case tz == "" || strings.EqualFold(tz, "SYSTEM"):
+		return systemTimeZone, nil
+	case tz[0] == '+' || tz[0] == '-':
+		hours, minutes, ok := cut(tz[1:], ':')
+		if ok {
+			h, herr := strconv.ParseUint(hours, 10, 8)
+			m, merr := strconv.ParseUint(minutes, 10, 8)
+			if herr == nil && merr == nil && m < 60 && h*60+m <= 14*60 {
+				offset := int(h*3600 + m*60)
+				if tz[0] == '-' {
+					if offset == 14*3600 {
+						break
+					}
+					offset = -offset
+				}
+				return time.FixedZone(tz, offset), nil
+			}
+		}
+	default:
+		if loc, err := time.LoadLocation(tz); err == nil {
+			return loc, nil
+		}
+	}
+	return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Unknown or incorrect time zone: '%s'", tz)
+}
+
+// cut slices s around the first instance of sep
+func cut(s string, sep byte) (before, after string, found bool) {
+	if i := strings.IndexByte(s, sep); i >= 0 {
+		return s[:i], s[i+1:], true
+	}
+	return s, "", false
+}
+
+func isDigit(b byte) bool {
+	return b >= '0' && b <= '9'
+}
+
+// parseDigits parses a run of up to max decimal digits at the start of s;
+// it returns the parsed value and the number of digits consumed
+func parseDigits(s string, max int) (int, int) {
+	var n, i int
+	for i < len(s) && i < max && isDigit(s[i]) {
+		n = n*10 + int(s[i]-'0')
+		i++
+	}
+	return n, i
+}
+
+// parseFraction parses the digits of fractional seconds into nanoseconds,
+// truncating them to microsecond precision
+func parseFraction(s string) (nsec int, prec uint8, ok bool) {
+	if s == "" {
+		return 0, 0, true
+	}
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return 0, 0, false
+		}
+	}
+	digits := s
+	if len(digits) > maxTimePrecision {
+		digits = digits[:maxTimePrecision]
+	}
+	nsec, _ = parseDigits(digits, maxTimePrecision)
+	for i := len(digits); i < 9; i++ {
+		nsec *= 10
+	}
+	return nsec, uint8(len(digits)), true
+}
+
+func daysIn(month time.Month, year int) int {
+	if month == time.February {
+		if year%4 == 0 && (year%100 != 0 || year%400 == 0) {
+			return 29
+		}
+		return 28
+	}
+	return 31 - int(month-1)%7%2
+}
+
+// makeDatetime builds a datetime out of its parts, checking that all of them are
+// in range; zero dates or dates with zero parts are not supported
+func makeDatetime(year, month, day, hour, minute, second, nsec int, prec uint8, date bool) (datetime, bool) {
+	if year < 0 || year > 9999 || month < 1 || month > 12 || day < 1 || day > daysIn(time.Month(month), year) {
+		return datetime{}, false
+	}
+	if hour > 23 || minute > 59 || second > 59 {
+		return datetime{}, false
+	}
+	t := time.Date(year, time.Month(month), day, hour, minute, second, nsec, time.UTC)
+	return datetime{t: t, prec: prec, date: date}, true
+}
+
+// expandYear converts a two-digit year into a four-digit one following MySQL's
+// rules: 00-69 map to 2000-2069 and 70-99 map to 1970-1999
+func expandYear(year int) int {
+	if year < 70 {
+		return year + 2000
+	}
+	return year + 1900
+}
+
+// parseDatetimeDigits parses a DATE or DATETIME written as a sequence of digits
+// without delimiters, e.g. YYMMDD, YYYYMMDD, YYMMDDhhmmss or YYYYMMDDhhmmss
+func parseDatetimeDigits(s, frac string) (datetime, bool) {
+	var year, rest int
+	switch len(s) {
+	case 6, 12:
+		year, _ = parseDigits(s, 2)
+		year = expandYear(year)
+		rest = 2
+	case 8, 14:
+		year, _ = parseDigits(s, 4)
+		rest = 4
+	default:
+		return datetime{}, false
+	}
+
+	month, _ := parseDigits(s[rest:], 2)
+	day, _ := parseDigits(s[rest+2:], 2)
+	if len(s)-rest == 4 {
+		if frac != "" {
+			return datetime{}, false
+		}
+		return makeDatetime(year, month, day, 0, 0, 0, 0, 0, true)
+	}
+
+	hour, _ := parseDigits(s[rest+4:], 2)
+	minute, _ := parseDigits(s[rest+6:], 2)
+	second, _ := parseDigits(s[rest+8:], 2)
+	nsec, prec, ok := parseFraction(frac)
+	if !ok {
+		return datetime{}, false
+	}
+	return makeDatetime(year, month, day, hour, minute, second, nsec, prec, false)
+}
+
+// parseDatetime parses a DATE or DATETIME literal following MySQL's relaxed rules:
+// any punctuation character can delimit the different parts of the value, the time
+// part is optional, and the whole value can also be written as a sequence of digits
+func parseDatetime(s string) (datetime, bool) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return datetime{}, false
+	}
+
+	if digits, frac, _ := cut(s, '.'); strings.IndexFunc(digits, func(r rune) bool { return r < '0' || r > '9' }) < 0 {
+		return parseDatetimeDigits(digits, frac)
+	}
+
+	var parts [6]int
+	var part int
+	var yearDigits int
+	var prec uint8
+	var nsec int
+
+	for part < len(parts) {
+		n, size := parseDigits(s, 4)
+		if size == 0 {
+			return datetime{}, false
+		}
+		if part == 0 {
+			yearDigits = size
+		} else if size > 2 {
+			return datetime{}, false
+		}
+		parts[part] = n
+		part++
+		s = s[size:]
+
+		if s == "" {
+			break
+		}
+
+		switch {
+		case part == 3:
+			// the date and the time parts are separated by whitespace or a 'T'
+			if s[0] == 'T' {
+				s = s[1:]
+			} else {
+				trimmed := strings.TrimLeft(s, " \t\n\r")
+				if len(trimmed) == len(s) {
+					return datetime{}, false
+				}
+				s = trimmed
+			}
+		case part == 6 || (part > 3 && s[0] == '.'):
+			if s[0] != '.' {
+				return datetime{}, false
+			}
+			var ok bool
+			nsec, prec, ok = parseFraction(s[1:])
+			if !ok {
+				return datetime{}, false
+			}
+			s = ""
+			part = len(parts)
+		default:
+			if isDigit(s[0]) {
+				return datetime{}, false
+			}
+			s = s[1:]
+		}
+	}
+
+	if part < 3 {
+		return datetime{}, false
+	}
+	if yearDigits <= 2 {
+		parts[0] = expandYear(parts[0])
+	}
+	return makeDatetime(parts[0], parts[1], parts[2], parts[3], parts[4], parts[5], nsec, prec, part == 3 && s == "")
+}
+
+// parseDatetimeNumeric parses the decimal representation of a number into a DATE or
+// DATETIME, with the same rules as MySQL uses when a number is used in a temporal context
+func parseDatetimeNumeric(s string) (datetime, bool) {
+	digits, frac, _ := cut(s, '.')
+	n, err := strconv.ParseUint(digits, 10, 64)
+	if err != nil {
+		return datetime{}, false
+	}
+
+	var width int
+	switch {
+	case n <= 991231:
+		width = 6
+	case n <= 99991231:
+		width = 8
+	case n <= 991231235959:
+		width = 12
+	default:
+		width = 14
+	}
+	if len(digits) > width {
+		digits = strings.TrimLeft(digits, "0")
+	}
+	if len(digits) < width {
+		digits = strings.Repeat("0", width-len(digits)) + digits
+	}
+	if width <= 8 {
+		frac = ""
+	}
+	return parseDatetimeDigits(digits, frac)
+}
+
+// makeTime builds a TIME out of its parts, clamping it to the range of valid TIME values
+func makeTime(neg bool, hour, minute, second, nsec int, prec uint8) (time.Duration, uint8, bool) {
+	if minute > 59 || second > 59 {
+		return 0, 0, false
+	}
+	d := time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute + time.Duration(second)*time.Second + time.Duration(nsec)
+	if hour > 838 || d > maxTime {
+		d = maxTime
+	}
+	if neg {
+		d = -d
+	}
+	return d, prec, true
+}
+
+// parseTime parses a TIME literal following MySQL's relaxed rules: the value can be
+// written as '[-][D ]hh:mm:ss[.fraction]' with some of its parts omitted, as a sequence
+// of digits ('hhmmss', 'mmss' or 'ss'), or as a full DATETIME whose time part is kept
+func parseTime(s string) (time.Duration, uint8, bool) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, 0, false
+	}
+
+	if len(s) > 1 && strings.ContainsAny(s[1:], "-/") {
+		dt, ok := parseDatetime(s)
+		if !ok {
+			return 0, 0, false
+		}
+		return dt.timeOfDay(), dt.prec, true
+	}
+
+	neg := s[0] == '-'
+	if neg {
+		s = s[1:]
+	}
+
+	body, frac, _ := cut(s, '.')
+	nsec, prec, ok := parseFraction(frac)
+	if !ok || body == "" {
+		return 0, 0, false
+	}
+
+	if !strings.Contains(body, ":") {
+		if strings.IndexFunc(body, func(r rune) bool { return r < '0' || r > '9' }) >= 0 {
+			return 0, 0, false
+		}
+		if len(body) >= 12 {
+			dt, ok := parseDatetimeDigits(body, frac)
+			if !ok {
+				return 0, 0, false
+			}
+			return dt.timeOfDay(), dt.prec, true
+		}
+		n, _ := strconv.Atoi(body)
+		return makeTime(neg, n/10000, n/100%100, n%100, nsec, prec)
+	}
+
+	var days int
+	if d, rest, ok := cut(body, ' '); ok {
+		var size int
+		days, size = parseDigits(d, 2)
+		if size == 0 || size != len(d) {
+			return 0, 0, false
+		}
+		body = strings.TrimLeft(rest, " ")
+	}
+
+	var parts [3]int
+	for i := range parts {
+		n, size := parseDigits(body, 3)
+		if size == 0 || (i > 0 && size > 2) {
+			return 0, 0, false
+		}
+		parts[i] = n
+		body = body[size:]
+		if body == "" {
+			break
+		}
+		if body[0] != ':' || i == len(parts)-1 {
+			return 0, 0, false
+		}
+		body = body[1:]
+	}
+	return makeTime(neg, days*24+parts[0], parts[1], parts[2], nsec, prec)
+}
+
+// parseTimeNumeric parses the decimal representation of a number into a TIME,
+// interpreting its digits as 'hhmmss'
+func parseTimeNumeric(s string) (time.Duration, uint8, bool) {
+	if len(s) > 0 && s[0] == '-' {
+		d, prec, ok := parseTimeNumeric(s[1:])
+		return -d, prec, ok
+	}
+	digits, frac, _ := cut(s, '.')
+	if len(digits) >= 12 {
+		dt, ok := parseDatetimeNumeric(s)
+		if !ok {
+			return 0, 0, false
+		}
+		return dt.timeOfDay(), dt.prec, true
+	}
+	n, err := strconv.Atoi(digits)
+	if err != nil {
+		return 0, 0, false
+	}
+	nsec, prec, ok := parseFraction(frac)
+	if !ok {
+		return 0, 0, false
+	}
+	return makeTime(false, n/10000, n/100%100, n%100, nsec, prec)
+}
+
+// numericString returns the decimal representation of a numeric EvalResult
+func numericString(arg *EvalResult) string {
+	switch tt := arg.typeof(); {
+	case sqltypes.IsFloat(tt):
+		return strconv.FormatFloat(arg.float64(), 'f', -1, 64)
+	default:
+		return string(arg.toRawBytes())
+	}
+}
+
+// datetimeArg converts an argument to a temporal function into a datetime. TIME
+// values are converted into the current date at that time.
+func datetimeArg(env *ExpressionEnv, arg *EvalResult) (datetime, bool) {
+	switch tt := arg.typeof(); {
+	case tt == sqltypes.Date || tt == sqltypes.Datetime || tt == sqltypes.Timestamp:
+		dt, ok := parseDatetime(arg.string())
+		dt.date = tt == sqltypes.Date
+		return dt, ok
+	case tt == sqltypes.Time:
+		d, prec, ok := parseTime(arg.string())
+		if !ok {
+			return datetime{}, false
+		}
+		now := env.currentTime()
+		t := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).Add(d)
+		return datetime{t: t, prec: prec}, true
+	case arg.isNumeric():
+		return parseDatetimeNumeric(numericString(arg))
+	case arg.isTextual():
+		return parseDatetime(arg.string())
+	default:
+		return datetime{}, false
+	}
+}
+
+// timeArg converts an argument to a temporal function into a TIME; the time part
+// is extracted from DATETIME values
+func timeArg(arg *EvalResult) (time.Duration, uint8, bool) {
+	switch tt := arg.typeof(); {
+	case tt == sqltypes.Date:
+		return 0, 0, true
+	case tt == sqltypes.Datetime || tt == sqltypes.Timestamp:
+		dt, ok := parseDatetime(arg.string())
+		return dt.timeOfDay(), dt.prec, ok
+	case arg.isNumeric():
+		return parseTimeNumeric(numericString(arg))
+	case tt == sqltypes.Time || arg.isTextual():
+		return parseTime(arg.string())
+	default:
+		return 0, 0, false
+	}
+}
+
+// precisionArg returns the fractional seconds precision passed as an argument to a
+// temporal function, which must be between 0 and 6
+func precisionArg(fname string, arg *EvalResult) uint8 {
+	prec := integralArg(arg)
+	if prec < 0 || prec > maxTimePrecision {
+		throwEvalError(vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT,
+			"Too-big precision %d specified for '%s'. Maximum is %d.", prec, fname, maxTimePrecision))
+	}
+	return uint8(prec)
+}
+
+// datetimeFromTime returns the wall clock time of t, in its own time zone, as a datetime
+func datetimeFromTime(t time.Time, prec uint8) datetime {
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+	return datetime{t: time.Date(year, month, day, hour, min, sec, t.Nanosecond(), time.UTC), prec: prec}
+}
+
+func (dt datetime) timeOfDay() time.Duration {
+	hour, min, sec := dt.t.Clock()
+	return time.Duration(hour)*time.Hour + time.Duration(min)*time.Minute + time.Duration(sec)*time.Second + time.Duration(dt.t.Nanosecond())
+}
+
+// unix returns the UNIX timestamp for this wall clock time in the given time zone
+func (dt datetime) unix(loc *time.Location) time.Time {
+	year, month, day := dt.t.Date()
+	hour, min, sec := dt.t.Clock()
+	return time.Date(year, month, day, hour, min, sec, dt.t.Nanosecond(), loc)
+}
+
+// daynr returns the number of days since the year 0, as MySQL's TO_DAYS
+func (dt datetime) daynr() int64 {
+	year, month, day := dt.t.Date()
+	return calcDaynr(year, int(month), day)
+}
+
+func calcDaynr(year, month, day int) int64 {
+	if year == 0 && month == 0 {
+		return 0
+	}
+	delsum := int64(365*year + 31*(month-1) + day)
+	if month <= 2 {
+		year--
+	} else {
+		delsum -= int64((month*4 + 23) / 10)
+	}
+	temp := ((year/100 + 1) * 3) / 4
+	return delsum + int64(year/4-temp)
+}
+
+// truncate drops the fractional seconds of a time past the given precision
+func truncateTime(t time.Time, prec uint8) time.Time {
+	return t.Truncate(time.Duration(math.Pow10(9 - int(prec))))
+}
+
+func appendDigits(buf []byte, n int, width int) []byte {
+	var tmp [16]byte
+	digits := strconv.AppendInt(tmp[:0], int64(n), 10)
+	for i := len(digits); i < width; i++ {
+		buf = append(buf, '0')
+	}
+	return append(buf, digits...)
+}
+
+func appendFraction(buf []byte, nsec int, prec uint8) []byte {
+	if prec == 0 {
+		return buf
+	}
+	buf = append(buf, '.')
+	return appendDigits(buf, nsec/int(math.Pow10(9-int(prec))), int(prec))
+}
+
+func appendDate(buf []byte, t time.Time) []byte {
+	year, month, day := t.Date()
+	buf = appendDigits(buf, year, 4)
+	buf = append(buf, '-')
+	buf = appendDigits(buf, int(month), 2)
+	buf = append(buf, '-')
+	return appendDigits(buf, day, 2)
+}
+
+func appendClock(buf []byte, t time.Time, prec uint8) []byte {
+	hour, min, sec := t.Clock()
+	buf = appendDigits(buf, hour, 2)
+	buf = append(buf, ':')
+	buf = appendDigits(buf, min, 2)
+	buf = append(buf, ':')
+	buf = appendDigits(buf, sec, 2)
+	return appendFraction(buf, t.Nanosecond(), prec)
+}
+
+// format returns the MySQL representation for this datetime: dates are formatted
+// as DATE values and everything else as DATETIME values with their precision
+func (dt datetime) format() []byte {
+	buf := appendDate(make([]byte, 0, 26), dt.t)
+	if dt.date {
+		return buf
+	}
+	buf = append(buf, ' ')
+	return appendClock(buf, dt.t, dt.prec)
+}
+
+// formatTime returns the MySQL representation of a TIME value
+func formatTime(d time.Duration, prec uint8) []byte {
+	buf := make([]byte, 0, 18)
+	if d < 0 {
+		buf = append(buf, '-')
+		d = -d
+	}
+	buf = appendDigits(buf, int(d/time.Hour), 2)
+	buf = append(buf, ':')
+	buf = appendDigits(buf, int(d/time.Minute%60), 2)
+	buf = append(buf, ':')
+	buf = appendDigits(buf, int(d/time.Second%60), 2)
+	return appendFraction(buf, int(d%time.Second), prec)
+}
+
+// interval is the value of an `INTERVAL expr unit` expression
+type interval struct {
+	months int
+	days   int
+	dur    time.Duration
+	prec   uint8
+}
+
+// parseIntervalUnit returns the IntervalTypes for the unit of an IntervalExpr
+func parseIntervalUnit(unit string) (sqlparser.IntervalTypes, bool) {
+	for it := sqlparser.IntervalYear; it <= sqlparser.IntervalSecondMicrosecond; it++ {
+		if strings.EqualFold(unit, it.ToString()) {
+			return it, true
+		}
+	}
+	return 0, false
+}
+
+// intervalHasDate returns whether intervals of the given unit have date parts
+func intervalHasDate(unit sqlparser.IntervalTypes) bool {
+	switch unit {
+	case sqlparser.IntervalYear, sqlparser.IntervalQuarter, sqlparser.IntervalMonth, sqlparser.IntervalWeek,
+		sqlparser.IntervalDay, sqlparser.IntervalYearMonth, sqlparser.IntervalDayHour, sqlparser.IntervalDayMinute,
+		sqlparser.IntervalDaySecond, sqlparser.IntervalDayMicrosecond:
+		return true
+	}
+	return false
+}
+
+// intervalHasTime returns whether intervals of the given unit have time parts
+func intervalHasTime(unit sqlparser.IntervalTypes) bool {
+	switch unit {
+	case sqlparser.IntervalYear, sqlparser.IntervalQuarter, sqlparser.IntervalMonth, sqlparser.IntervalWeek,
+		sqlparser.IntervalDay, sqlparser.IntervalYearMonth:
+		return false
+	}
+	return true
+}
+
+// intervalFields returns the number of fields in the string representation of a
+// compound interval unit, and whether its last field is in microseconds
+func intervalFields(unit sqlparser.IntervalTypes) (int, bool) {
+	switch unit {
+	case sqlparser.IntervalYearMonth, sqlparser.IntervalDayHour, sqlparser.IntervalHourMinute, sqlparser.IntervalMinuteSecond:
+		return 2, false
+	case sqlparser.IntervalDayMinute, sqlparser.IntervalHourSecond:
+		return 3, false
+	case sqlparser.IntervalDaySecond:
+		return 4, false
+	case sqlparser.IntervalSecondMicrosecond:
+		return 2, true
+	case sqlparser.IntervalMinuteMicrosecond:
+		return 3, true
+	case sqlparser.IntervalHourMicrosecond:
+		return 4, true
+	case sqlparser.IntervalDayMicrosecond:
+		return 5, true
+	}
+	return 1, false
+}
+
+// parseCompoundInterval parses the string value of an interval with a compound unit
+// (e.g. '1:30' HOUR_MINUTE): any non-digit characters delimit the fields, and when
+// fewer fields than expected are given they are assigned to the smaller units
+func parseCompoundInterval(unit sqlparser.IntervalTypes, s string) (interval, bool) {
+	count, micro := intervalFields(unit)
+
+	s = strings.TrimSpace(s)
+	neg := strings.HasPrefix(s, "-")
+	if neg {
+		s = s[1:]
+	}
+
+	var values [5]int
+	var n, lastSize int
+	for s != "" && n < count {
+		v, size := parseDigits(s, 18)
+		if size == 0 {
+			s = s[1:]
+			continue
+		}
+		values[n] = v
+		lastSize = size
+		n++
+		s = s[size:]
+	}
+	if strings.IndexFunc(s, func(r rune) bool { return r >= '0' && r <= '9' }) >= 0 || n == 0 {
+		return interval{}, false
+	}
+	copy(values[count-n:count], values[:n])
+	for i := 0; i < count-n; i++ {
+		values[i] = 0
+	}
+	if micro {
+		for i := lastSize; i < maxTimePrecision; i++ {
+			values[count-1] *= 10
+		}
+	}
+
+	var iv interval
+	var fields []int
+	switch unit {
+	case sqlparser.IntervalYearMonth:
+		iv.months = values[0]*12 + values[1]
+	case sqlparser.IntervalDayHour, sqlparser.IntervalDayMinute, sqlparser.IntervalDaySecond, sqlparser.IntervalDayMicrosecond:
+		iv.days = values[0]
+		fields = values[1:count]
+	default:
+		fields = values[:count]
+	}
+
+	var unitDurations []time.Duration
+	switch unit {
+	case sqlparser.IntervalDayHour:
+		unitDurations = []time.Duration{time.Hour}
+	case sqlparser.IntervalDayMinute, sqlparser.IntervalHourMinute:
+		unitDurations = []time.Duration{time.Hour, time.Minute}
+	case sqlparser.IntervalDaySecond, sqlparser.IntervalHourSecond:
+		unitDurations = []time.Duration{time.Hour, time.Minute, time.Second}
+	case sqlparser.IntervalMinuteSecond:
+		unitDurations = []time.Duration{time.Minute, time.Second}
+	case sqlparser.IntervalDayMicrosecond, sqlparser.IntervalHourMicrosecond:
+		unitDurations = []time.Duration{time.Hour, time.Minute, time.Second, time.Microsecond}
+	case sqlparser.IntervalMinuteMicrosecond:
+		unitDurations = []time.Duration{time.Minute, time.Second, time.Microsecond}
+	case sqlparser.IntervalSecondMicrosecond:
+		unitDurations = []time.Duration{time.Second, time.Microsecond}
+	}
+	for i, d := range unitDurations {
+		iv.dur += time.Duration(fields[i]) * d
+	}
+	if micro {
+		iv.prec = maxTimePrecision
+	}
+	if neg {
+		iv.months, iv.days, iv.dur = -iv.months, -iv.days, -iv.dur
+	}
+	return iv, true
+}
+
+// intervalArg converts the value of an `INTERVAL expr unit` expression into an interval
+func intervalArg(unit sqlparser.IntervalTypes, arg *EvalResult) (interval, bool) {
+	if count, _ := intervalFields(unit); count > 1 {
+		var s string
+		if arg.isNumeric() {
+			s = numericString(arg)
+		} else {
+			s = arg.string()
+		}
+		return parseCompoundInterval(unit, s)
+	}
+
+	var iv interval
+	if unit == sqlparser.IntervalSecond {
+		var seconds float64
+		switch tt := arg.typeof(); {
+		case sqltypes.IsIntegral(tt):
+			arg.makeSignedIntegral()
+			iv.dur = time.Duration(arg.int64()) * time.Second
+			return iv, true
+		case tt == sqltypes.Decimal:
+			if frac := -arg.decimal().Exponent(); frac > 0 {
+				iv.prec = uint8(frac)
+				if frac > maxTimePrecision {
+					iv.prec = maxTimePrecision
+				}
+			}
+			seconds, _ = arg.decimal().Float64()
+		case arg.isNumeric():
+			seconds = arg.float64()
+			iv.prec = maxTimePrecision
+		default:
+			s := strings.TrimSpace(arg.string())
+			if _, frac, ok := cut(s, '.'); ok {
+				iv.prec = uint8(len(frac))
+				if len(frac) > maxTimePrecision {
+					iv.prec = maxTimePrecision
+				}
+			}
+			seconds = parseStringToFloat(s)
+		}
+		iv.dur = time.Duration(math.Round(seconds*1e6)) * time.Microsecond
+		return iv, true
+	}
+
+	var n int
+	if arg.isNumeric() {
+		arg.makeNumeric()
+		switch tt := arg.typeof(); {
+		case sqltypes.IsFloat(tt):
+			n = int(math.Round(arg.float64()))
+		case tt == sqltypes.Decimal:
+			f, _ := arg.decimal().Round(0).Float64()
+			n = int(f)
+		default:
+			n = int(integralArg(arg))
+		}
+	} else {
+		n = int(parseStringToFloat(arg.string()))
+	}
+
+	switch unit {
+	case sqlparser.IntervalYear:
+		iv.months = n * 12
+	case sqlparser.IntervalQuarter:
+		iv.months = n * 3
+	case sqlparser.IntervalMonth:
+		iv.months = n
+	case sqlparser.IntervalWeek:
+		iv.days = n * 7
+	case sqlparser.IntervalDay:
+		iv.days = n
+	case sqlparser.IntervalHour:
+		iv.dur = time.Duration(n) * time.Hour
+	case sqlparser.IntervalMinute:
+		iv.dur = time.Duration(n) * time.Minute
+	case sqlparser.IntervalMicrosecond:
+		iv.dur = time.Duration(n) * time.Microsecond
+		iv.prec = maxTimePrecision
+	}
+	return iv, true
+}
+
+// add returns the result of adding an interval to this datetime. As in MySQL, adding
+// months to a date clamps its day to the last day of the resulting month.
+func (dt datetime) add(iv interval) (datetime, bool) {
+	t := dt.t
+	if iv.months != 0 {
+		year, month, day := t.Date()
+		months := year*12 + int(month-1) + iv.months
+		if months < 0 || months >= 10000*12 {
+			return datetime{}, false
+		}
+		year, month = months/12, time.Month(months%12+1)
+		if days := daysIn(month, year); day > days {
+			day = days
+		}
+		hour, min, sec := t.Clock()
+		t = time.Date(year, month, day, hour, min, sec, t.Nanosecond(), time.UTC)
+	}
+	if iv.days != 0 {
+		t = t.AddDate(0, 0, iv.days)
+	}
+	t = t.Add(iv.dur)
+	if t.Year() < 0 || t.Year() > 9999 {
+		return datetime{}, false
+	}
+	prec := dt.prec
+	if iv.prec > prec {
+		prec = iv.prec
+	}
+	return datetime{t: t, prec: prec, date: dt.date}, true
+}
+
+// calcWeek returns the week number of a date following MySQL's calc_week, where
+// the behaviour is a combination of weekMondayFirst, weekYear and weekFirstWeekday
+func calcWeek(t time.Time, behaviour int) (year int, week int) {
+	var days int
+	y, m, d := t.Date()
+	daynr := calcDaynr(y, int(m), d)
+	firstDaynr := calcDaynr(y, 1, 1)
+	mondayFirst := behaviour&weekMondayFirst != 0
+	inWeekYear := behaviour&weekYear != 0
+	firstWeekday := behaviour&weekFirstWeekday != 0
+
+	weekday := calcWeekday(firstDaynr, !mondayFirst)
+	year = y
+
+	if m == time.January && d <= 7-weekday {
+		if !inWeekYear && ((firstWeekday && weekday != 0) || (!firstWeekday && weekday >= 4)) {
+			return year, 0
+		}
+		inWeekYear = true
+		year--
+		days = calcDaysInYear(year)
+		firstDaynr -= int64(days)
+		weekday = (weekday + 53*7 - days) % 7
+	}
+
+	if (firstWeekday && weekday != 0) || (!firstWeekday && weekday >= 4) {
+		days = int(daynr - (firstDaynr + int64(7-weekday)))
+	} else {
+		days = int(daynr - (firstDaynr - int64(weekday)))
+	}
+
+	if inWeekYear && days >= 52*7 {
+		weekday = (weekday + calcDaysInYear(year)) % 7
+		if (!firstWeekday && weekday < 4) || (firstWeekday && weekday == 0) {
+			return year + 1, 1
+		}
+	}
+	return year, days/7 + 1
+}
+
+const (
+	weekMondayFirst  = 1
+	weekYear         = 2
+	weekFirstWeekday = 4
+)
+
+// calcWeekday returns the day of the week for a day number, where 0 is Monday, or
+// Sunday if sundayFirst is set
+func calcWeekday(daynr int64, sundayFirst bool) int {
+	if sundayFirst {
+		return int((daynr + 6) % 7)
+	}
+	return int((daynr + 5) % 7)
+}
+
+func calcDaysInYear(year int) int {
+	if year&3 == 0 && (year%100 != 0 || (year%400 == 0 && year != 0)) {
+		return 366
+	}
+	return 365
+}
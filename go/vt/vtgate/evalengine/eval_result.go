@@ -273,6 +273,10 @@ func (er *EvalResult) setDecimal(dec decimal.Decimal, frac int32) {
 	er.clearFlags(flagIntegerRange)
 }
 
+func (er *EvalResult) setJSON(v *jsonValue) {
+	er.setRaw(sqltypes.TypeJSON, v.marshal(nil), collationJSON)
+}
+
 func (er *EvalResult) setTuple(t []EvalResult) {
 	er.type_ = int16(sqltypes.Tuple)
 	er.tuple_ = &t
@@ -469,11 +473,17 @@ func (er *EvalResult) nullSafeHashcode() (HashCode, error) {
 		}
 		return coll.Hash(er.bytes(), 0), nil
 	case sqltypes.IsDate(er.typeof()):
-		time, err := parseDate(er)
+		t, err := temporalInstant(er)
 		if err != nil {
 			return 0, err
 		}
-		return HashCode(time.UnixNano()), nil
+		return HashCode(uint64(t.Unix())*1000000 + uint64(t.Nanosecond()/1000)), nil
+	case er.typeof() == sqltypes.TypeJSON:
+		doc, err := parseJSON(er.bytes())
+		if err != nil {
+			return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Invalid JSON text: %v", err)
+		}
+		return HashCode(doc.hash()), nil
 	default:
 		return 0, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "types does not support hashcode yet: %v", er.typeof())
 	}
@@ -567,6 +577,13 @@ func (er *EvalResult) setValueCast(v sqltypes.Value, typ sqltypes.Type) error {
 		default:
 			return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "coercion should not try to coerce this value to a text: %v", v)
 		}
+
+	case typ == sqltypes.TypeJSON:
+		if v.Type() != sqltypes.TypeJSON {
+			return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "coercion should not try to coerce this value to JSON: %v", v)
+		}
+		er.setRaw(sqltypes.TypeJSON, v.Raw(), collationJSON)
+		return nil
 	}
 	return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "coercion should not try to coerce this value: %v", v)
 }
@@ -639,6 +656,8 @@ func (er *EvalResult) setValue(value sqltypes.Value, collation collations.TypedC
 		er.setRaw(sqltypes.VarBinary, value.Raw(), collationBinary)
 	case sqltypes.IsDate(tt):
 		er.setRaw(value.Type(), value.Raw(), collationNumeric)
+	case tt == sqltypes.TypeJSON:
+		er.setRaw(sqltypes.TypeJSON, value.Raw(), collationJSON)
 	case sqltypes.IsNull(tt):
 		er.setNull()
 	default:
@@ -928,6 +947,15 @@ func (er *EvalResult) TupleValues() []sqltypes.Value {
 	return result
 }
 
+// ToBoolean returns whether the result is true as a MySQL condition,
+// i.e. whether it is neither NULL nor zero
+func (er *EvalResult) ToBoolean() bool {
+	if er.expr != nil {
+		panic("did not resolve EvalResult after evaluation")
+	}
+	return er.isTruthy() == boolTrue
+}
+
 // ToBooleanStrict is used when the casting to a boolean has to be minimally forgiving,
 // such as when assigning to a system variable that is expected to be a boolean
 func (er *EvalResult) ToBooleanStrict() (bool, error) {
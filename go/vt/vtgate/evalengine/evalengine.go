@@ -17,6 +17,7 @@ limitations under the License.
 package evalengine
 
 import (
+	"bytes"
 	"math"
 	"time"
 
@@ -220,95 +221,150 @@ func compareNumeric(v1, v2 *EvalResult) (int, error) {
 	return 1, nil
 }
 
-func parseDate(expr *EvalResult) (t time.Time, err error) {
-	switch expr.typeof() {
-	case sqltypes.Date:
-		t, err = time.Parse("2006-01-02", expr.string())
-	case sqltypes.Timestamp, sqltypes.Datetime:
-		t, err = time.Parse("2006-01-02 15:04:05", expr.string())
-	case sqltypes.Time:
-		t, err = time.Parse("15:04:05", expr.string())
-		if err == nil {
-			now := time.Now()
-			// setting the date to today's date, because we use AddDate on t
-			// which is "0000-01-01 xx:xx:xx", we do minus one on the month
-			// and day to take into account the 01 in both month and day of t
-			t = t.AddDate(now.Year(), int(now.Month()-1), now.Day()-1)
-		}
+// temporalInstant converts a temporal value into a point in time, so it can be compared
+// with other temporal values: DATE values are converted to midnight of that date, and
+// TIME values are converted to that time of the current date
+func temporalInstant(er *EvalResult) (time.Time, error) {
+	dt, ok := datetimeArg(er.env, er)
+	if !ok {
+		return time.Time{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Incorrect %s value: '%s'", er.typeof(), er.string())
 	}
-	return
+	return dt.t, nil
 }
 
-// matchExprWithAnyDateFormat formats the given expr (usually a string) to a date using the first format
-// that does not return an error.
-func matchExprWithAnyDateFormat(expr *EvalResult) (t time.Time, err error) {
-	layouts := []string{"2006-01-02", "2006-01-02 15:04:05", "15:04:05"}
-	for _, layout := range layouts {
-		t, err = time.Parse(layout, expr.string())
-		if err == nil {
-			if layout == "15:04:05" {
-				now := time.Now()
-				// setting the date to today's date, because we use AddDate on t
-				// which is "0000-01-01 xx:xx:xx", we do minus one on the month
-				// and day to take into account the 01 in both month and day of t
-				t = t.AddDate(now.Year(), int(now.Month()-1), now.Day()-1)
-			}
-			return
+// temporalDuration returns the value of a TIME
+func temporalDuration(er *EvalResult) (time.Duration, error) {
+	d, _, ok := parseTime(er.string())
+	if !ok {
+		return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Incorrect TIME value: '%s'", er.string())
+	}
+	return d, nil
+}
+
+// temporalNumber returns the numeric representation of a temporal value, e.g.
+// 20210101 for '2021-01-01' or 104200 for '10:42:00'
+func temporalNumber(er *EvalResult) float64 {
+	raw := er.bytes()
+	digits := make([]byte, 0, len(raw))
+	for i, b := range raw {
+		if isDigit(b) || b == '.' || (b == '-' && i == 0) {
+			digits = append(digits, b)
 		}
 	}
-	return
+	return parseStringToFloat(string(digits))
 }
 
 // Date comparison based on:
 // 		- https://dev.mysql.com/doc/refman/8.0/en/type-conversion.html
 // 		- https://dev.mysql.com/doc/refman/8.0/en/date-and-time-type-conversion.html
 func compareDates(l, r *EvalResult) (int, error) {
-	lTime, err := parseDate(l)
+	if l.typeof() == sqltypes.Time && r.typeof() == sqltypes.Time {
+		lDur, err := temporalDuration(l)
+		if err != nil {
+			return 0, err
+		}
+		rDur, err := temporalDuration(r)
+		if err != nil {
+			return 0, err
+		}
+		return compareDurations(lDur, rDur), nil
+	}
+
+	lTime, err := temporalInstant(l)
 	if err != nil {
 		return 0, err
 	}
-	rTime, err := parseDate(r)
+	rTime, err := temporalInstant(r)
 	if err != nil {
 		return 0, err
 	}
-
-	return compareGoTimes(lTime, rTime)
+	return compareGoTimes(lTime, rTime), nil
 }
 
+// compareDateAndString compares a temporal value with a string by converting the
+// string into the temporal type of the other value; strings that are not valid
+// temporal values are compared as strings
 func compareDateAndString(l, r *EvalResult) (int, error) {
-	var lTime, rTime time.Time
-	var err error
-	switch {
-	case sqltypes.IsDate(l.typeof()):
-		lTime, err = parseDate(l)
-		if err != nil {
-			return 0, err
+	date, str, sign := l, r, 1
+	if l.isTextual() {
+		date, str, sign = r, l, -1
+	}
+
+	if date.typeof() == sqltypes.Time {
+		strDur, _, ok := parseTime(str.string())
+		if !ok {
+			return bytes.Compare(l.bytes(), r.bytes()), nil
 		}
-		rTime, err = matchExprWithAnyDateFormat(r)
+		dateDur, err := temporalDuration(date)
 		if err != nil {
 			return 0, err
 		}
-	case l.isTextual():
-		rTime, err = parseDate(r)
-		if err != nil {
-			return 0, err
+		return sign * compareDurations(dateDur, strDur), nil
+	}
+
+	strTime, ok := parseDatetime(str.string())
+	if !ok {
+		return bytes.Compare(l.bytes(), r.bytes()), nil
+	}
+	dateTime, err := temporalInstant(date)
+	if err != nil {
+		return 0, err
+	}
+	return sign * compareGoTimes(dateTime, strTime.t), nil
+}
+
+// compareDateAndNumeric compares a temporal value with a number by converting the
+// number into the temporal type of the other value, e.g. 20210101 = DATE'2021-01-01'
+// or 104200 = TIME'10:42:00'; numbers that are not valid temporal values are compared
+// with the numeric representation of the temporal value
+func compareDateAndNumeric(l, r *EvalResult) (int, error) {
+	date, num, sign := l, r, 1
+	if l.isNumeric() {
+		date, num, sign = r, l, -1
+	}
+
+	if date.typeof() == sqltypes.Time {
+		if numDur, _, ok := parseTimeNumeric(numericString(num)); ok {
+			dateDur, err := temporalDuration(date)
+			if err != nil {
+				return 0, err
+			}
+			return sign * compareDurations(dateDur, numDur), nil
 		}
-		lTime, err = matchExprWithAnyDateFormat(l)
+	} else if numTime, ok := parseDatetimeNumeric(numericString(num)); ok {
+		dateTime, err := temporalInstant(date)
 		if err != nil {
 			return 0, err
 		}
+		return sign * compareGoTimes(dateTime, numTime.t), nil
+	}
+
+	var dateNum EvalResult
+	dateNum.setFloat(temporalNumber(date))
+	num.makeFloat()
+	n, err := compareNumeric(&dateNum, num)
+	return sign * n, err
+}
+
+func compareDurations(l, r time.Duration) int {
+	switch {
+	case l < r:
+		return -1
+	case l > r:
+		return 1
+	default:
+		return 0
 	}
-	return compareGoTimes(lTime, rTime)
 }
 
-func compareGoTimes(lTime, rTime time.Time) (int, error) {
+func compareGoTimes(lTime, rTime time.Time) int {
 	if lTime.Before(rTime) {
-		return -1, nil
+		return -1
 	}
 	if lTime.After(rTime) {
-		return 1, nil
+		return 1
 	}
-	return 0, nil
+	return 0
 }
 
 // More on string collations coercibility on MySQL documentation:
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"time"
 	"unicode/utf8"
 
 	"vitess.io/vitess/go/mysql/collations"
@@ -38,9 +39,25 @@ type (
 		BindVars         map[string]*querypb.BindVariable
 		DefaultCollation collations.ID
 
+		// TimeZone is the time zone of the session, used by temporal functions;
+		// if nil, the time zone of the MySQL servers is used, like for a session
+		// whose time_zone is SYSTEM
+		TimeZone *time.Location
+
 		// Row and Fields should line up
 		Row    []sqltypes.Value
 		Fields []*querypb.Field
+
+		// now is the current time for this environment, so that all the
+		// temporal functions in a query see the same time
+		now time.Time
+	}
+
+	// VCursor is the subset of the vtgate VCursor that is needed to
+	// evaluate expressions in the context of a session
+	VCursor interface {
+		ConnCollation() collations.ID
+		TimeZone() *time.Location
 	}
 
 	// Expr is the interface that all evaluating expressions must implement
@@ -97,6 +114,7 @@ var _ Expr = (*BitwiseExpr)(nil)
 var _ Expr = (*BitwiseNotExpr)(nil)
 var _ Expr = (*ConvertExpr)(nil)
 var _ Expr = (*ConvertUsingExpr)(nil)
+var _ Expr = (*CaseExpr)(nil)
 
 type evalError struct {
 	error
@@ -197,8 +215,14 @@ func (env *ExpressionEnv) typecheck(expr Expr) {
 		env.typecheckUnary(expr.Inner)
 	case *BitwiseNotExpr:
 		env.typecheckUnary(expr.Inner)
+	case *NotExpr:
+		env.typecheckUnary(expr.Inner)
 	case *WeightStringCallExpr:
 		env.typecheckUnary(expr.String)
+	case *ExtractExpr:
+		env.typecheckUnary(expr.Inner)
+	case *DateAddExpr:
+		env.typecheckBinary(expr.Date, expr.Interval)
 	case *ArithmeticExpr:
 		env.typecheckBinary(expr.Left, expr.Right)
 	case *LogicalExpr:
@@ -234,6 +258,14 @@ func (env *ExpressionEnv) typecheck(expr Expr) {
 		}
 	case *CallExpr:
 		env.typecheck(expr.Arguments)
+	case *CaseExpr:
+		for _, wt := range expr.Cases {
+			env.typecheckUnary(wt.When)
+			env.typecheckUnary(wt.Then)
+		}
+		if expr.Else != nil {
+			env.typecheckUnary(expr.Else)
+		}
 	case *Literal, *Column, *BindVariable: // noop
 	default:
 		panic(fmt.Sprintf("unhandled cardinality: %T", expr))
@@ -285,6 +317,34 @@ func EnvWithBindVars(bindVars map[string]*querypb.BindVariable, coll collations.
 	return &ExpressionEnv{BindVars: bindVars, DefaultCollation: coll}
 }
 
+// NewExpressionEnv returns an expression environment with no current row, but with
+// bindvars, and with the collation and time zone of the session of the given VCursor
+func NewExpressionEnv(bindVars map[string]*querypb.BindVariable, vc VCursor) *ExpressionEnv {
+	env := EnvWithBindVars(bindVars, vc.ConnCollation())
+	env.TimeZone = vc.TimeZone()
+	return env
+}
+
+// timeZone returns the time zone in which temporal expressions are evaluated
+func (env *ExpressionEnv) timeZone() *time.Location {
+	if env == nil || env.TimeZone == nil {
+		return systemTimeZone
+	}
+	return env.TimeZone
+}
+
+// currentTime returns the current time in the time zone of this environment; the
+// time is fixed the first time it is requested for the environment
+func (env *ExpressionEnv) currentTime() time.Time {
+	if env == nil {
+		return time.Now()
+	}
+	if env.now.IsZero() {
+		env.now = time.Now()
+	}
+	return env.now.In(env.timeZone())
+}
+
 // NullExpr is just what you are lead to believe
 var NullExpr = &Literal{}
 
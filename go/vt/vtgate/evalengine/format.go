@@ -162,6 +162,43 @@ func (c *CallExpr) format(w *formatter, depth int) {
 	w.WriteByte(')')
 }
 
+func (c *CaseExpr) format(w *formatter, depth int) {
+	w.WriteString("CASE")
+	for _, wt := range c.Cases {
+		w.WriteString(" WHEN ")
+		wt.When.format(w, depth+1)
+		w.WriteString(" THEN ")
+		wt.Then.format(w, depth+1)
+	}
+	if c.Else != nil {
+		w.WriteString(" ELSE ")
+		c.Else.format(w, depth+1)
+	}
+	w.WriteString(" END")
+}
+
+func (d *DateAddExpr) format(w *formatter, depth int) {
+	if d.Sub {
+		w.WriteString("DATE_SUB(")
+	} else {
+		w.WriteString("DATE_ADD(")
+	}
+	d.Date.format(w, depth+1)
+	w.WriteString(", INTERVAL ")
+	d.Interval.format(w, depth+1)
+	w.WriteByte(' ')
+	w.WriteString(strings.ToUpper(d.Unit.ToString()))
+	w.WriteByte(')')
+}
+
+func (e *ExtractExpr) format(w *formatter, depth int) {
+	w.WriteString("EXTRACT(")
+	w.WriteString(strings.ToUpper(e.Unit.ToString()))
+	w.WriteString(" FROM ")
+	e.Inner.format(w, depth+1)
+	w.WriteByte(')')
+}
+
 func (c *WeightStringCallExpr) format(w *formatter, depth int) {
 	w.WriteString("WEIGHT_STRING(")
 	c.String.format(w, depth)
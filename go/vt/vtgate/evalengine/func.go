@@ -31,6 +31,8 @@ import (
 
 var builtinFunctions = map[string]builtin{
 	"coalesce":   builtinCoalesce{},
+	"if":         builtinIf{},
+	"ifnull":     builtinIfNull{},
 	"greatest":   &builtinMultiComparison{name: "GREATEST", cmp: 1},
 	"least":      &builtinMultiComparison{name: "LEAST", cmp: -1},
 	"collation":  builtinCollation{},
@@ -39,6 +41,77 @@ var builtinFunctions = map[string]builtin{
 	"ascii":      builtinAscii{},
 	"bin":        builtinBin{},
 	"bit_length": builtinBitLength{},
+
+	"concat":           builtinConcat{},
+	"concat_ws":        builtinConcatWs{},
+	"length":           builtinLength{},
+	"octet_length":     builtinLength{},
+	"char_length":      builtinCharLength{},
+	"character_length": builtinCharLength{},
+	"lower":            builtinChangeCase{name: "LOWER"},
+	"lcase":            builtinChangeCase{name: "LCASE"},
+	"upper":            builtinChangeCase{name: "UPPER", upcase: true},
+	"ucase":            builtinChangeCase{name: "UCASE", upcase: true},
+	"left":             builtinLeftRight{name: "LEFT"},
+	"right":            builtinLeftRight{name: "RIGHT", right: true},
+	"lpad":             builtinPad{name: "LPAD"},
+	"rpad":             builtinPad{name: "RPAD", right: true},
+	"substr":           builtinSubstring{},
+	"substring":        builtinSubstring{},
+	"mid":              builtinSubstring{},
+	"replace":          builtinReplace{},
+	"repeat":           builtinRepeat{},
+	"reverse":          builtinReverse{},
+	"locate":           builtinLocate{name: "LOCATE"},
+	"instr":            builtinLocate{name: "INSTR", instr: true},
+	"trim":             builtinTrim{name: "TRIM", leading: true, trailing: true},
+	"ltrim":            builtinTrim{name: "LTRIM", leading: true},
+	"rtrim":            builtinTrim{name: "RTRIM", trailing: true},
+
+	"now":               &builtinNow{name: "NOW"},
+	"current_timestamp": &builtinNow{name: "CURRENT_TIMESTAMP"},
+	"localtime":         &builtinNow{name: "LOCALTIME"},
+	"localtimestamp":    &builtinNow{name: "LOCALTIMESTAMP"},
+	"sysdate":           &builtinNow{name: "SYSDATE", sysdate: true},
+	"utc_timestamp":     &builtinNow{name: "UTC_TIMESTAMP", utc: true},
+	"curdate":           &builtinCurdate{name: "CURDATE"},
+	"current_date":      &builtinCurdate{name: "CURRENT_DATE"},
+	"utc_date":          &builtinCurdate{name: "UTC_DATE", utc: true},
+	"curtime":           &builtinCurtime{name: "CURTIME"},
+	"current_time":      &builtinCurtime{name: "CURRENT_TIME"},
+	"utc_time":          &builtinCurtime{name: "UTC_TIME", utc: true},
+	"date":              builtinDate{},
+	"date_format":       builtinDateFormat{},
+	"datediff":          builtinDatediff{},
+	"unix_timestamp":    builtinUnixTimestamp{},
+	"from_unixtime":     builtinFromUnixtime{},
+	"year":              &builtinExtract{name: "YEAR", unit: sqlparser.IntervalYear},
+	"quarter":           &builtinExtract{name: "QUARTER", unit: sqlparser.IntervalQuarter},
+	"month":             &builtinExtract{name: "MONTH", unit: sqlparser.IntervalMonth},
+	"day":               &builtinExtract{name: "DAY", unit: sqlparser.IntervalDay},
+	"dayofmonth":        &builtinExtract{name: "DAYOFMONTH", unit: sqlparser.IntervalDay},
+	"hour":              &builtinExtract{name: "HOUR", unit: sqlparser.IntervalHour},
+	"minute":            &builtinExtract{name: "MINUTE", unit: sqlparser.IntervalMinute},
+	"second":            &builtinExtract{name: "SECOND", unit: sqlparser.IntervalSecond},
+	"microsecond":       &builtinExtract{name: "MICROSECOND", unit: sqlparser.IntervalMicrosecond},
+
+	"json_extract":       builtinJSONExtract{},
+	"json_unquote":       builtinJSONUnquote{},
+	"json_quote":         builtinJSONQuote{},
+	"json_contains":      builtinJSONContains{},
+	"json_contains_path": builtinJSONContainsPath{},
+	"json_object":        builtinJSONObject{},
+	"json_array":         builtinJSONArray{},
+	"json_type":          builtinJSONType{},
+	"json_valid":         builtinJSONValid{},
+	"json_keys":          builtinJSONKeys{},
+	"json_length":        builtinJSONLength{},
+	"json_depth":         builtinJSONDepth{},
+
+	"md5":  builtinMD5{},
+	"sha":  builtinSHA1{},
+	"sha1": builtinSHA1{},
+	"sha2": builtinSHA2{},
 }
 
 var builtinFunctionsRewrite = map[string]builtinRewrite{
@@ -50,6 +123,14 @@ type builtin interface {
 	typeof(*ExpressionEnv, []Expr) (sqltypes.Type, flag)
 }
 
+// builtinVolatile is implemented by the builtins whose result depends on the
+// current time or on the session's time zone: calls to these functions are
+// never constant, so they are not folded when planning
+type builtinVolatile interface {
+	builtin
+	volatile()
+}
+
 type builtinRewrite func([]Expr, TranslationLookup) (Expr, error)
 
 type CallExpr struct {
@@ -88,6 +169,46 @@ func (builtinCoalesce) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, f
 	return aggregatedType(env, args), flagNullable
 }
 
+type builtinIf struct{}
+
+func (builtinIf) call(_ *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	if args[0].isTruthy() == boolTrue {
+		*result = args[1]
+	} else {
+		*result = args[2]
+	}
+	result.resolve()
+}
+
+func (builtinIf) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 3 {
+		throwArgError("IF")
+	}
+	var f flag
+	if nullFlags(env, args[1:]...) != 0 {
+		f = flagNullable
+	}
+	return aggregatedType(env, args[1:]), f
+}
+
+type builtinIfNull struct{}
+
+func (builtinIfNull) call(_ *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	if args[0].isNull() {
+		*result = args[1]
+	} else {
+		*result = args[0]
+	}
+	result.resolve()
+}
+
+func (builtinIfNull) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 2 {
+		throwArgError("IFNULL")
+	}
+	return aggregatedType(env, args), flagNullable
+}
+
 type multiComparisonFunc func(args []EvalResult, result *EvalResult, cmp int)
 
 func getMultiComparisonFunc(args []EvalResult) multiComparisonFunc {
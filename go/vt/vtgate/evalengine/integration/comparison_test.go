@@ -572,3 +572,27 @@ func TestCharsetConversionOperators(t *testing.T) {
 		}
 	}
 }
+
+func TestControlFlowFunctions(t *testing.T) {
+	var conds = []string{
+		"NULL", "TRUE", "FALSE", `1`, `0`, `"1"`, `"0"`, `"POTATO"`, `0.0`, `-1`,
+	}
+	var exprs = []string{
+		"IF(%s, 'yes', 'no')",
+		"IF(%s, 1, 2)",
+		"CASE WHEN %s THEN 'yes' ELSE 'no' END",
+		"CASE WHEN %s THEN 'yes' END",
+		"CASE %s WHEN 1 THEN 'one' WHEN 0 THEN 'zero' ELSE 'other' END",
+	}
+
+	var conn = mysqlconn(t)
+	defer conn.Close()
+
+	for _, expr := range exprs {
+		t.Run(expr, func(t *testing.T) {
+			for _, cond := range conds {
+				compareRemoteQuery(t, conn, "SELECT "+fmt.Sprintf(expr, cond))
+			}
+		})
+	}
+}
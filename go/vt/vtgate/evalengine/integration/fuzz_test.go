@@ -108,6 +108,10 @@ func errorsMatch(remote, local error) bool {
 	return false
 }
 
+// localTimeZone is the time zone of the local evaluation, which must be
+// the session time zone of the remote connection
+var localTimeZone *time.Location
+
 func safeEvaluate(query string) (evalengine.EvalResult, sqltypes.Type, error) {
 	stmt, err := sqlparser.Parse(query)
 	if err != nil {
@@ -135,6 +139,7 @@ func safeEvaluate(query string) (evalengine.EvalResult, sqltypes.Type, error) {
 				}
 			}()
 			env := evalengine.EnvWithBindVars(nil, 255)
+			env.TimeZone = localTimeZone
 			eval, err = env.Evaluate(local)
 			if err == nil && *debugCheckTypes {
 				tt, err = env.TypeOf(local)
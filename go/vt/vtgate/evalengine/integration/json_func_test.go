@@ -0,0 +1,77 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestBuiltinJSONFunctions(t *testing.T) {
+	var docs = []string{
+		"NULL",
+		"'null'",
+		"'1'",
+		"'\"abc\"'",
+		"'[1, 2.5, \"x\", [3, 4], {\"a\": 5}]'",
+		"'{\"a\": 1, \"b\": [1, 2, {\"a\": \"x\"}], \"c\": {\"d\": null}}'",
+		"'{\"a\": '",
+		"CAST('{\"b\": true, \"a\": false}' AS JSON)",
+	}
+
+	var funcs = []string{
+		"JSON_EXTRACT(%s, '$')",
+		"JSON_EXTRACT(%s, '$.a')",
+		"JSON_EXTRACT(%s, '$[1]')",
+		"JSON_EXTRACT(%s, '$[last]')",
+		"JSON_EXTRACT(%s, '$[1 to 3]')",
+		"JSON_EXTRACT(%s, '$.*')",
+		"JSON_EXTRACT(%s, '$**.a')",
+		"JSON_EXTRACT(%s, '$.a', '$.b[0]')",
+		"JSON_UNQUOTE(JSON_EXTRACT(%s, '$.a'))",
+		"JSON_UNQUOTE(%s)",
+		"JSON_CONTAINS(%s, '1')",
+		"JSON_CONTAINS(%s, '{\"a\": 1}')",
+		"JSON_CONTAINS(%s, '[2]', '$.b')",
+		"JSON_CONTAINS_PATH(%s, 'one', '$.a', '$.z')",
+		"JSON_CONTAINS_PATH(%s, 'all', '$.a', '$.z')",
+		"JSON_OBJECT('k', %s)",
+		"JSON_ARRAY(%s, 1, 'a')",
+		"JSON_TYPE(%s)",
+		"JSON_VALID(%s)",
+		"JSON_KEYS(%s)",
+		"JSON_LENGTH(%s)",
+		"JSON_DEPTH(%s)",
+		"JSON_QUOTE(%s)",
+		"CAST(%s AS JSON)",
+		"JSON_EXTRACT(%s, '$.a') = 1",
+		"JSON_EXTRACT(%s, '$.a') = 'x'",
+		"CAST(%s AS JSON) < CAST('[1, 3]' AS JSON)",
+	}
+
+	var conn = mysqlconn(t)
+	defer conn.Close()
+
+	for _, fn := range funcs {
+		t.Run(fn, func(t *testing.T) {
+			for _, doc := range docs {
+				query := "SELECT " + fmt.Sprintf(fn, doc)
+				compareRemoteQuery(t, conn, query)
+			}
+		})
+	}
+}
@@ -117,3 +117,112 @@ func TestBuiltinBitLength(t *testing.T) {
 		}
 	})
 }
+
+func TestBuiltinStringFunctions(t *testing.T) {
+	var elems = []string{
+		"NULL",
+		"\"\"",
+		"\"a\"",
+		"\"  abc  \"",
+		"\"AbC\"",
+		"1",
+		"-1",
+		"0xAACC",
+		"3.1415926",
+		"\"ñandú\"",
+		"\"ÑANDÚ\"",
+		"\"中文测试\"",
+		"\"日本語テスト\"",
+		"\"한국어 시험\"",
+		"\"😊😂🤢\"",
+		"_latin1 \"abcÀÉÍ\"",
+		"_binary \"AbC\"",
+		"\"AbC\" COLLATE utf8mb4_bin",
+	}
+
+	var funcs = []string{
+		"LENGTH(%s)",
+		"OCTET_LENGTH(%s)",
+		"CHAR_LENGTH(%s)",
+		"CHARACTER_LENGTH(%s)",
+		"LOWER(%s)",
+		"LCASE(%s)",
+		"UPPER(%s)",
+		"UCASE(%s)",
+		"REVERSE(%s)",
+		"TRIM(%s)",
+		"LTRIM(%s)",
+		"RTRIM(%s)",
+		"CONCAT(%s, \"x\")",
+		"CONCAT(\"x\", %s, 42)",
+		"CONCAT_WS(\",\", %s, NULL, \"y\")",
+		"LEFT(%s, 2)",
+		"RIGHT(%s, 2)",
+		"LPAD(%s, 6, \"xy\")",
+		"RPAD(%s, 6, \"xy\")",
+		"LPAD(%s, 2, \"xy\")",
+		"REPEAT(%s, 3)",
+		"REPLACE(%s, \"b\", \"XX\")",
+		"LOCATE(\"b\", %s)",
+		"LOCATE(\"B\", %s, 2)",
+		"INSTR(%s, \"c\")",
+		"SUBSTRING(%s, 2)",
+		"SUBSTRING(%s, -2)",
+		"SUBSTRING(%s, 2, 2)",
+		"SUBSTRING(%s FROM 0)",
+		"SUBSTRING(%s FROM -3 FOR 2)",
+		"MID(%s, 1, 3)",
+		"TRIM(LEADING \" \" FROM %s)",
+		"TRIM(TRAILING \" \" FROM %s)",
+		"TRIM(BOTH \"a\" FROM %s)",
+	}
+
+	var conn = mysqlconn(t)
+	defer conn.Close()
+
+	for _, fn := range funcs {
+		t.Run(fn, func(t *testing.T) {
+			for _, elem := range elems {
+				query := "SELECT " + fmt.Sprintf(fn, elem)
+				compareRemoteQuery(t, conn, query)
+			}
+		})
+	}
+}
+
+func TestBuiltinHashes(t *testing.T) {
+	var elems = []string{
+		"NULL",
+		"\"\"",
+		"\"abc\"",
+		"1",
+		"-1.5",
+		"0xAACC",
+		"\"中文测试\"",
+		"_binary \"abc\"",
+	}
+	var funcs = []string{
+		"MD5(%s)",
+		"SHA(%s)",
+		"SHA1(%s)",
+		"SHA2(%s, 0)",
+		"SHA2(%s, 224)",
+		"SHA2(%s, 256)",
+		"SHA2(%s, 384)",
+		"SHA2(%s, 512)",
+		"SHA2(%s, 100)",
+		"SHA2(%s, NULL)",
+	}
+
+	var conn = mysqlconn(t)
+	defer conn.Close()
+
+	for _, fn := range funcs {
+		t.Run(fn, func(t *testing.T) {
+			for _, elem := range elems {
+				query := "SELECT " + fmt.Sprintf(fn, elem)
+				compareRemoteQuery(t, conn, query)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"fmt"
+	"testing"
+
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+func TestBuiltinTimeFunctions(t *testing.T) {
+	var elems = []string{
+		"NULL",
+		"\"2021-03-04\"",
+		"\"2021-03-04 13:05:09\"",
+		"\"2021-03-04 13:05:09.123456\"",
+		"\"2020-02-29\"",
+		"\"2021-01-01 00:00:00\"",
+		"\"2021/3/4 1:2:3\"",
+		"\"foobar\"",
+		"20210304",
+		"20210304130509",
+		"CAST(\"2021-12-31\" AS DATE)",
+		"CAST(\"2021-12-31 23:59:59\" AS DATETIME)",
+		"CAST(\"2021-12-31 23:59:59.5\" AS DATETIME(1))",
+	}
+
+	var funcs = []string{
+		"DATE(%s)",
+		"YEAR(%s)",
+		"QUARTER(%s)",
+		"MONTH(%s)",
+		"DAY(%s)",
+		"HOUR(%s)",
+		"MINUTE(%s)",
+		"SECOND(%s)",
+		"MICROSECOND(%s)",
+		"EXTRACT(YEAR_MONTH FROM %s)",
+		"EXTRACT(DAY_MICROSECOND FROM %s)",
+		"DATEDIFF(%s, \"2021-01-01\")",
+		"DATE_FORMAT(%s, \"%%a %%b %%c %%D %%e %%f %%H %%I %%j %%k %%l %%p %%r %%T %%W %%w %%y\")",
+		"DATE_FORMAT(%s, \"%%U %%u %%V %%v %%X %%x\")",
+		"DATE_ADD(%s, INTERVAL 1 DAY)",
+		"DATE_ADD(%s, INTERVAL 1 MONTH)",
+		"DATE_ADD(%s, INTERVAL -1 YEAR)",
+		"DATE_ADD(%s, INTERVAL 90 MINUTE)",
+		"DATE_ADD(%s, INTERVAL 1.5 SECOND)",
+		"DATE_ADD(%s, INTERVAL \"1 1:1:1\" DAY_SECOND)",
+		"DATE_SUB(%s, INTERVAL \"1-2\" YEAR_MONTH)",
+		"DATE_SUB(%s, INTERVAL 1 QUARTER)",
+		"%s + INTERVAL 1 WEEK",
+		"%s - INTERVAL 1 HOUR",
+		"ADDDATE(%s, 31)",
+		"%s = \"2021-03-04\"",
+		"%s < \"2021-03-04 13:05:09\"",
+		"%s = 20210304",
+	}
+
+	var conn = mysqlconn(t)
+	defer conn.Close()
+
+	for _, fn := range funcs {
+		t.Run(fn, func(t *testing.T) {
+			for _, elem := range elems {
+				query := "SELECT " + fmt.Sprintf(fn, elem)
+				compareRemoteQuery(t, conn, query)
+			}
+		})
+	}
+}
+
+func TestBuiltinUnixTime(t *testing.T) {
+	var queries = []string{
+		"SELECT FROM_UNIXTIME(0)",
+		"SELECT FROM_UNIXTIME(1614863109)",
+		"SELECT FROM_UNIXTIME(1614863109.5)",
+		"SELECT FROM_UNIXTIME(1614863109, \"%Y %D %M %h:%i:%s %x\")",
+		"SELECT FROM_UNIXTIME(-1)",
+		"SELECT UNIX_TIMESTAMP(\"2021-03-04 13:05:09\")",
+		"SELECT UNIX_TIMESTAMP(\"2021-03-04 13:05:09.25\")",
+		"SELECT UNIX_TIMESTAMP(FROM_UNIXTIME(1614863109))",
+		"SELECT UNIX_TIMESTAMP(\"1960-01-01\")",
+	}
+
+	var conn = mysqlconn(t)
+	defer conn.Close()
+
+	// keep the time zone of the local evaluation in sync
+	// with the session time zone of the remote connection
+	defer func() { localTimeZone = nil }()
+
+	for _, tz := range []string{"+00:00", "-05:30", "Europe/Madrid"} {
+		loc, err := evalengine.ParseTimeZone(tz)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := conn.ExecuteFetch(fmt.Sprintf("SET time_zone = '%s'", tz), 0, false); err != nil {
+			t.Fatal(err)
+		}
+		localTimeZone = loc
+		t.Run(tz, func(t *testing.T) {
+			for _, query := range queries {
+				compareRemoteQuery(t, conn, query)
+			}
+		})
+	}
+}
@@ -0,0 +1,549 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"encoding/base64"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+type (
+	builtinJSONExtract      struct{}
+	builtinJSONUnquote      struct{}
+	builtinJSONQuote        struct{}
+	builtinJSONContains     struct{}
+	builtinJSONContainsPath struct{}
+	builtinJSONObject       struct{}
+	builtinJSONArray        struct{}
+	builtinJSONType         struct{}
+	builtinJSONValid        struct{}
+	builtinJSONKeys         struct{}
+	builtinJSONLength       struct{}
+	builtinJSONDepth        struct{}
+)
+
+var errJSONPathWildcard = vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT,
+	"In this situation, path expressions may not contain the * and ** tokens or an array range.")
+
+func errInvalidJSONText(fname string, pos int, err error) error {
+	if err == errJSONTooDeep {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
+	}
+	return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Invalid JSON text in argument %d to function %s: %v", pos, fname, err)
+}
+
+// anyNull returns whether any of the given arguments is NULL
+func anyNull(args []EvalResult) bool {
+	for i := range args {
+		if args[i].isNull() {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonText returns the contents of a textual argument transcoded to utf8mb4,
+// which is the character set for all JSON documents
+func jsonText(arg *EvalResult) ([]byte, error) {
+	if !arg.isTextual() {
+		return arg.toRawBytes(), nil
+	}
+	coll := arg.collation().Collation
+	if coll == collations.Unknown || coll == collationJSON.Collation {
+		return arg.bytes(), nil
+	}
+	environment := collations.Local()
+	from := environment.LookupByID(coll)
+	if from == nil {
+		return arg.bytes(), nil
+	}
+	return collations.Convert(nil, environment.LookupByID(collationJSON.Collation), arg.bytes(), from)
+}
+
+// isBinaryString returns whether the argument is a string with the binary charset,
+// which cannot be used as JSON text
+func isBinaryString(arg *EvalResult) bool {
+	tt := arg.typeof()
+	return sqltypes.IsBinary(tt) || (sqltypes.IsText(tt) && arg.collation().Collation == collations.CollationBinaryID)
+}
+
+// parseJSONText parses a textual argument as a JSON document
+func parseJSONText(fname string, pos int, arg *EvalResult) (*jsonValue, error) {
+	if isBinaryString(arg) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Cannot create a JSON value from a string with CHARACTER SET 'binary'.")
+	}
+	text, err := jsonText(arg)
+	if err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
+	}
+	doc, err := parseJSON(text)
+	if err != nil {
+		return nil, errInvalidJSONText(fname, pos, err)
+	}
+	return doc, nil
+}
+
+// jsonDocumentArg returns the document for an argument to a JSON function that expects
+// one: JSON values are used as they are, and strings are parsed as JSON text
+func jsonDocumentArg(fname string, pos int, arg *EvalResult) *jsonValue {
+	var doc *jsonValue
+	var err error
+	switch tt := arg.typeof(); {
+	case tt == sqltypes.TypeJSON:
+		doc, err = parseJSON(arg.bytes())
+		if err != nil {
+			err = errInvalidJSONText(fname, pos, err)
+		}
+	case sqltypes.IsText(tt) || sqltypes.IsBinary(tt):
+		doc, err = parseJSONText(fname, pos, arg)
+	default:
+		err = vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT,
+			"Invalid data type for JSON data in argument %d to function %s; a JSON string or JSON type is required.", pos, fname)
+	}
+	if err != nil {
+		throwEvalError(err)
+	}
+	return doc
+}
+
+// jsonPathArg parses an argument to a JSON function as a JSON path
+func jsonPathArg(arg *EvalResult) *jsonPath {
+	text, err := jsonText(arg)
+	if err != nil {
+		throwEvalError(vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err))
+	}
+	path, err := parseJSONPath(string(text))
+	if err != nil {
+		throwEvalError(err)
+	}
+	return path
+}
+
+// jsonSinglePathArg parses an argument to a JSON function which must select at most one value
+func jsonSinglePathArg(arg *EvalResult) *jsonPath {
+	path := jsonPathArg(arg)
+	if path.wildcard() {
+		throwEvalError(errJSONPathWildcard)
+	}
+	return path
+}
+
+// jsonValueArg converts an argument into a JSON value, as MySQL does for the values in
+// JSON_OBJECT or JSON_ARRAY, or when comparing a SQL value with a JSON value: strings
+// are converted into JSON strings and not parsed as JSON text
+func jsonValueArg(arg *EvalResult) (*jsonValue, error) {
+	if arg.isNull() {
+		return jsonNullValue, nil
+	}
+
+	switch tt := arg.typeof(); {
+	case tt == sqltypes.TypeJSON:
+		doc, err := parseJSON(arg.bytes())
+		if err != nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Invalid JSON text: %v", err)
+		}
+		return doc, nil
+	case sqltypes.IsSigned(tt):
+		return newJSONInt(arg.int64()), nil
+	case sqltypes.IsUnsigned(tt):
+		return &jsonValue{typ: jsonUnsigned, u: arg.uint64()}, nil
+	case sqltypes.IsFloat(tt):
+		return &jsonValue{typ: jsonDouble, f: arg.float64()}, nil
+	case tt == sqltypes.Decimal:
+		return &jsonValue{typ: jsonDecimal, dec: arg.decimal(), frac: arg.length_}, nil
+	case isBinaryString(arg):
+		// binary strings are stored as opaque values, which MySQL prints in base64
+		// together with the type of the column they come from
+		return &jsonValue{typ: jsonOpaque, str: "base64:type15:" + base64.StdEncoding.EncodeToString(arg.bytes())}, nil
+	case sqltypes.IsText(tt):
+		text, err := jsonText(arg)
+		if err != nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
+		}
+		return newJSONString(string(text)), nil
+	case tt == sqltypes.Date:
+		if dt, ok := parseDatetime(arg.string()); ok {
+			return &jsonValue{typ: jsonDate, str: string(appendDate(nil, dt.t))}, nil
+		}
+	case tt == sqltypes.Datetime || tt == sqltypes.Timestamp:
+		// temporal values are always stored with microsecond precision
+		if dt, ok := parseDatetime(arg.string()); ok {
+			dt.date = false
+			dt.prec = maxTimePrecision
+			return &jsonValue{typ: jsonDatetime, str: string(dt.format())}, nil
+		}
+	case tt == sqltypes.Time:
+		if d, _, ok := parseTime(arg.string()); ok {
+			return &jsonValue{typ: jsonTime, str: string(formatTime(d, maxTimePrecision))}, nil
+		}
+	}
+	return newJSONString(string(arg.toRawBytes())), nil
+}
+
+// compareJSONResults compares two values when any of them is JSON: the other one
+// is converted into a JSON value and they're compared with the JSON rules
+func compareJSONResults(l, r *EvalResult) (int, error) {
+	lj, err := jsonValueArg(l)
+	if err != nil {
+		return 0, err
+	}
+	rj, err := jsonValueArg(r)
+	if err != nil {
+		return 0, err
+	}
+	return compareJSON(lj, rj), nil
+}
+
+// castJSON converts a value into JSON as CAST(x AS JSON) does: strings are parsed as
+// JSON text, and other types are converted into their JSON representation
+func castJSON(arg *EvalResult) *jsonValue {
+	if arg.typeof() != sqltypes.TypeJSON && (sqltypes.IsText(arg.typeof()) || sqltypes.IsBinary(arg.typeof())) {
+		doc, err := parseJSONText("cast_as_json", 1, arg)
+		if err != nil {
+			throwEvalError(err)
+		}
+		return doc
+	}
+	doc, err := jsonValueArg(arg)
+	if err != nil {
+		throwEvalError(err)
+	}
+	return doc
+}
+
+func (builtinJSONExtract) call(_ *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	if anyNull(args) {
+		result.setNull()
+		return
+	}
+
+	doc := jsonDocumentArg("json_extract", 1, &args[0])
+	wrap := len(args) > 2
+	var matches []*jsonValue
+	for i := range args[1:] {
+		path := jsonPathArg(&args[i+1])
+		if path.wildcard() {
+			wrap = true
+		}
+		matches = append(matches, path.find(doc)...)
+	}
+
+	switch {
+	case len(matches) == 0:
+		result.setNull()
+	case wrap:
+		result.setJSON(newJSONArray(matches))
+	default:
+		result.setJSON(matches[0])
+	}
+}
+
+func (builtinJSONExtract) typeof(_ *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) < 2 {
+		throwArgError("JSON_EXTRACT")
+	}
+	return sqltypes.TypeJSON, flagNullable
+}
+
+func (builtinJSONUnquote) call(_ *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	arg := &args[0]
+	if arg.isNull() {
+		result.setNull()
+		return
+	}
+
+	if arg.typeof() == sqltypes.TypeJSON {
+		doc := jsonDocumentArg("json_unquote", 1, arg)
+		result.setRaw(sqltypes.VarChar, doc.unquoted(), collationJSON)
+		return
+	}
+
+	text, err := jsonText(arg)
+	if err != nil {
+		throwEvalError(vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err))
+	}
+	// only strings which are quoted are unquoted; anything else is returned as-is
+	if len(text) >= 2 && text[0] == '"' && text[len(text)-1] == '"' {
+		doc, err := parseJSON(text)
+		if err != nil {
+			throwEvalError(errInvalidJSONText("json_unquote", 1, err))
+		}
+		text = doc.unquoted()
+	}
+	result.setRaw(sqltypes.VarChar, text, collationJSON)
+}
+
+func (builtinJSONUnquote) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 {
+		throwArgError("JSON_UNQUOTE")
+	}
+	return sqltypes.VarChar, nullFlags(env, args...)
+}
+
+func (builtinJSONQuote) call(_ *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	arg := &args[0]
+	if arg.isNull() {
+		result.setNull()
+		return
+	}
+	tt := arg.typeof()
+	if isBinaryString(arg) || !(sqltypes.IsText(tt) || tt == sqltypes.TypeJSON) {
+		throwEvalError(vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT,
+			"Invalid data type for JSON data in argument 1 to function json_quote; a JSON string or JSON type is required."))
+	}
+	text, err := jsonText(arg)
+	if err != nil {
+		throwEvalError(vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err))
+	}
+	result.setRaw(sqltypes.VarChar, appendJSONString(nil, string(text)), collationJSON)
+}
+
+func (builtinJSONQuote) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 {
+		throwArgError("JSON_QUOTE")
+	}
+	return sqltypes.VarChar, nullFlags(env, args...)
+}
+
+func (builtinJSONContains) call(_ *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	if anyNull(args) {
+		result.setNull()
+		return
+	}
+
+	target := jsonDocumentArg("json_contains", 1, &args[0])
+	candidate := jsonDocumentArg("json_contains", 2, &args[1])
+	if len(args) == 3 {
+		matches := jsonSinglePathArg(&args[2]).find(target)
+		if len(matches) == 0 {
+			result.setNull()
+			return
+		}
+		target = matches[0]
+	}
+	result.setBool(target.contains(candidate))
+}
+
+func (builtinJSONContains) typeof(_ *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 2 && len(args) != 3 {
+		throwArgError("JSON_CONTAINS")
+	}
+	return sqltypes.Int64, flagNullable
+}
+
+func (builtinJSONContainsPath) call(_ *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	if anyNull(args) {
+		result.setNull()
+		return
+	}
+
+	doc := jsonDocumentArg("json_contains_path", 1, &args[0])
+	var all bool
+	switch mode := bytes.ToLower(args[1].toRawBytes()); string(mode) {
+	case "one":
+	case "all":
+		all = true
+	default:
+		throwEvalError(vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT,
+			"The oneOrAll argument to json_contains_path may take these values: 'one' or 'all'."))
+	}
+
+	// all the paths must be valid, even if the result is known before checking them
+	paths := make([]*jsonPath, 0, len(args)-2)
+	for i := range args[2:] {
+		paths = append(paths, jsonPathArg(&args[i+2]))
+	}
+	for _, path := range paths {
+		if path.exists(doc) != all {
+			result.setBool(!all)
+			return
+		}
+	}
+	result.setBool(all)
+}
+
+func (builtinJSONContainsPath) typeof(_ *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) < 3 {
+		throwArgError("JSON_CONTAINS_PATH")
+	}
+	return sqltypes.Int64, flagNullable
+}
+
+func (builtinJSONObject) call(_ *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	members := make([]jsonMember, 0, len(args)/2)
+	for i := 0; i < len(args); i += 2 {
+		key := &args[i]
+		if key.isNull() {
+			throwEvalError(vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "JSON documents may not contain NULL member names."))
+		}
+		name, err := jsonText(key)
+		if err != nil {
+			throwEvalError(vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err))
+		}
+		value, err := jsonValueArg(&args[i+1])
+		if err != nil {
+			throwEvalError(err)
+		}
+		members = append(members, jsonMember{key: string(name), value: value})
+	}
+	result.setJSON(newJSONObject(members))
+}
+
+func (builtinJSONObject) typeof(_ *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args)%2 != 0 {
+		throwArgError("JSON_OBJECT")
+	}
+	return sqltypes.TypeJSON, 0
+}
+
+func (builtinJSONArray) call(_ *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	elems := make([]*jsonValue, 0, len(args))
+	for i := range args {
+		elem, err := jsonValueArg(&args[i])
+		if err != nil {
+			throwEvalError(err)
+		}
+		elems = append(elems, elem)
+	}
+	result.setJSON(newJSONArray(elems))
+}
+
+func (builtinJSONArray) typeof(_ *ExpressionEnv, _ []Expr) (sqltypes.Type, flag) {
+	return sqltypes.TypeJSON, 0
+}
+
+func (builtinJSONType) call(_ *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	arg := &args[0]
+	if arg.isNull() {
+		result.setNull()
+		return
+	}
+	doc := jsonDocumentArg("json_type", 1, arg)
+	result.setRaw(sqltypes.VarChar, []byte(doc.typ.String()), collationJSON)
+}
+
+func (builtinJSONType) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 {
+		throwArgError("JSON_TYPE")
+	}
+	return sqltypes.VarChar, nullFlags(env, args...)
+}
+
+func (builtinJSONValid) call(_ *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	arg := &args[0]
+	switch tt := arg.typeof(); {
+	case arg.isNull():
+		result.setNull()
+	case tt == sqltypes.TypeJSON:
+		result.setBool(true)
+	case sqltypes.IsText(tt) && !isBinaryString(arg):
+		text, err := jsonText(arg)
+		if err == nil {
+			_, err = parseJSON(text)
+		}
+		result.setBool(err == nil)
+	default:
+		result.setBool(false)
+	}
+}
+
+func (builtinJSONValid) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 {
+		throwArgError("JSON_VALID")
+	}
+	return sqltypes.Int64, nullFlags(env, args...)
+}
+
+// jsonTarget returns the value in the document that will be inspected by functions
+// that take an optional path argument, or nil if the path doesn't match any value
+func jsonTarget(fname string, args []EvalResult) *jsonValue {
+	doc := jsonDocumentArg(fname, 1, &args[0])
+	if len(args) > 1 {
+		matches := jsonSinglePathArg(&args[1]).find(doc)
+		if len(matches) == 0 {
+			return nil
+		}
+		return matches[0]
+	}
+	return doc
+}
+
+func (builtinJSONKeys) call(_ *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	if anyNull(args) {
+		result.setNull()
+		return
+	}
+	target := jsonTarget("json_keys", args)
+	if target == nil || target.typ != jsonObject {
+		result.setNull()
+		return
+	}
+	keys := make([]*jsonValue, 0, len(target.members))
+	for _, m := range target.members {
+		keys = append(keys, newJSONString(m.key))
+	}
+	result.setJSON(newJSONArray(keys))
+}
+
+func (builtinJSONKeys) typeof(_ *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 && len(args) != 2 {
+		throwArgError("JSON_KEYS")
+	}
+	return sqltypes.TypeJSON, flagNullable
+}
+
+func (builtinJSONLength) call(_ *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	if anyNull(args) {
+		result.setNull()
+		return
+	}
+	target := jsonTarget("json_length", args)
+	if target == nil {
+		result.setNull()
+		return
+	}
+	result.setInt64(int64(target.length()))
+}
+
+func (builtinJSONLength) typeof(_ *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 && len(args) != 2 {
+		throwArgError("JSON_LENGTH")
+	}
+	return sqltypes.Int64, flagNullable
+}
+
+func (builtinJSONDepth) call(_ *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	arg := &args[0]
+	if arg.isNull() {
+		result.setNull()
+		return
+	}
+	doc := jsonDocumentArg("json_depth", 1, arg)
+	result.setInt64(int64(doc.depth()))
+}
+
+func (builtinJSONDepth) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 {
+		throwArgError("JSON_DEPTH")
+	}
+	return sqltypes.Int64, nullFlags(env, args...)
+}
@@ -0,0 +1,358 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+type jsonPathLegType uint8
+
+const (
+	jsonPathMember jsonPathLegType = iota
+	jsonPathMemberWildcard
+	jsonPathIndex
+	jsonPathRange
+	jsonPathIndexWildcard
+	jsonPathEllipsis
+)
+
+type (
+	// jsonPath is a parsed JSON path expression, as used by MySQL's JSON functions:
+	// a `$` followed by any number of legs that select members, array cells or ranges,
+	// and the `.*`, `[*]` and `**` wildcards.
+	// See https://dev.mysql.com/doc/refman/8.0/en/json.html#json-path-syntax
+	jsonPath struct {
+		legs []jsonPathLeg
+	}
+
+	jsonPathLeg struct {
+		typ jsonPathLegType
+		key string
+		// from and to are the boundaries of an index or range leg
+		from, to jsonArrayIndex
+	}
+
+	// jsonArrayIndex is an index into an array, which can be relative to its end: `last-N`
+	jsonArrayIndex struct {
+		n    int
+		last bool
+	}
+)
+
+// resolve returns the absolute index for an array of the given length
+func (idx jsonArrayIndex) resolve(length int) int {
+	if idx.last {
+		return length - 1 - idx.n
+	}
+	return idx.n
+}
+
+// wildcard returns whether this path can match more than one value
+func (path *jsonPath) wildcard() bool {
+	for _, leg := range path.legs {
+		switch leg.typ {
+		case jsonPathMemberWildcard, jsonPathIndexWildcard, jsonPathEllipsis, jsonPathRange:
+			return true
+		}
+	}
+	return false
+}
+
+// find returns all the values in the document that match this path, in document
+// order and without duplicates
+func (path *jsonPath) find(doc *jsonValue) []*jsonValue {
+	var matches []*jsonValue
+	var seen map[*jsonValue]struct{}
+	path.match(doc, path.legs, func(v *jsonValue) bool {
+		if seen == nil {
+			seen = make(map[*jsonValue]struct{})
+		}
+		if _, dup := seen[v]; !dup {
+			seen[v] = struct{}{}
+			matches = append(matches, v)
+		}
+		return true
+	})
+	return matches
+}
+
+// exists returns whether any value in the document matches this path
+func (path *jsonPath) exists(doc *jsonValue) bool {
+	var found bool
+	path.match(doc, path.legs, func(*jsonValue) bool {
+		found = true
+		return false
+	})
+	return found
+}
+
+// match calls yield for every value under v that matches the given legs, until
+// yield returns false; the return value is whether the matching must continue
+func (path *jsonPath) match(v *jsonValue, legs []jsonPathLeg, yield func(*jsonValue) bool) bool {
+	if len(legs) == 0 {
+		return yield(v)
+	}
+
+	leg, rest := legs[0], legs[1:]
+	switch leg.typ {
+	case jsonPathMember:
+		if v.typ == jsonObject {
+			if child := v.member(leg.key); child != nil {
+				return path.match(child, rest, yield)
+			}
+		}
+	case jsonPathMemberWildcard:
+		if v.typ == jsonObject {
+			for _, m := range v.members {
+				if !path.match(m.value, rest, yield) {
+					return false
+				}
+			}
+		}
+	case jsonPathIndex, jsonPathRange:
+		// values which are not arrays are automatically wrapped in a single-element
+		// array when they're accessed with an index
+		elems := v.array
+		if v.typ != jsonArray {
+			elems = []*jsonValue{v}
+		}
+		from := leg.from.resolve(len(elems))
+		to := from
+		if leg.typ == jsonPathRange {
+			to = leg.to.resolve(len(elems))
+			if from < 0 {
+				from = 0
+			}
+			if to >= len(elems) {
+				to = len(elems) - 1
+			}
+		}
+		for i := from; i <= to; i++ {
+			if i < 0 || i >= len(elems) {
+				continue
+			}
+			if !path.match(elems[i], rest, yield) {
+				return false
+			}
+		}
+	case jsonPathIndexWildcard:
+		if v.typ == jsonArray {
+			for _, elem := range v.array {
+				if !path.match(elem, rest, yield) {
+					return false
+				}
+			}
+		}
+	case jsonPathEllipsis:
+		if !path.match(v, rest, yield) {
+			return false
+		}
+		switch v.typ {
+		case jsonArray:
+			for _, elem := range v.array {
+				if !path.match(elem, legs, yield) {
+					return false
+				}
+			}
+		case jsonObject:
+			for _, m := range v.members {
+				if !path.match(m.value, legs, yield) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+func errInvalidJSONPath(pos int) error {
+	return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Invalid JSON path expression. The error is around character position %d.", pos)
+}
+
+type jsonPathParser struct {
+	path string
+	pos  int
+}
+
+// parseJSONPath parses the given JSON path expression
+func parseJSONPath(path string) (*jsonPath, error) {
+	p := jsonPathParser{path: path}
+	p.skipSpace()
+	if !p.consume('$') {
+		return nil, errInvalidJSONPath(p.pos + 1)
+	}
+
+	var parsed jsonPath
+	for {
+		p.skipSpace()
+		if p.pos == len(p.path) {
+			break
+		}
+		leg, ok := p.parseLeg()
+		if !ok {
+			return nil, errInvalidJSONPath(p.pos + 1)
+		}
+		parsed.legs = append(parsed.legs, leg)
+	}
+
+	// a path cannot end with an ellipsis
+	if n := len(parsed.legs); n > 0 && parsed.legs[n-1].typ == jsonPathEllipsis {
+		return nil, errInvalidJSONPath(len(path))
+	}
+	return &parsed, nil
+}
+
+func (p *jsonPathParser) skipSpace() {
+	for p.pos < len(p.path) && isJSONPathSpace(p.path[p.pos]) {
+		p.pos++
+	}
+}
+
+func isJSONPathSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func (p *jsonPathParser) consume(c byte) bool {
+	if p.pos < len(p.path) && p.path[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *jsonPathParser) parseLeg() (jsonPathLeg, bool) {
+	switch {
+	case p.consume('.'):
+		p.skipSpace()
+		if p.consume('*') {
+			return jsonPathLeg{typ: jsonPathMemberWildcard}, true
+		}
+		key, ok := p.parseKey()
+		return jsonPathLeg{typ: jsonPathMember, key: key}, ok
+
+	case p.consume('['):
+		p.skipSpace()
+		if p.consume('*') {
+			p.skipSpace()
+			return jsonPathLeg{typ: jsonPathIndexWildcard}, p.consume(']')
+		}
+		from, ok := p.parseArrayIndex()
+		if !ok {
+			return jsonPathLeg{}, false
+		}
+		leg := jsonPathLeg{typ: jsonPathIndex, from: from}
+		p.skipSpace()
+		if p.consumeWord("to") {
+			leg.typ = jsonPathRange
+			if leg.to, ok = p.parseArrayIndex(); !ok {
+				return jsonPathLeg{}, false
+			}
+			// ranges where both boundaries are absolute must be in ascending order
+			if !from.last && !leg.to.last && leg.to.n < from.n {
+				return jsonPathLeg{}, false
+			}
+			p.skipSpace()
+		}
+		return leg, p.consume(']')
+
+	case p.consume('*'):
+		return jsonPathLeg{typ: jsonPathEllipsis}, p.consume('*')
+
+	default:
+		return jsonPathLeg{}, false
+	}
+}
+
+// consumeWord consumes the given keyword, which must be followed by whitespace
+func (p *jsonPathParser) consumeWord(word string) bool {
+	end := p.pos + len(word)
+	if end < len(p.path) && p.path[p.pos:end] == word && isJSONPathSpace(p.path[end]) {
+		p.pos = end
+		p.skipSpace()
+		return true
+	}
+	return false
+}
+
+func (p *jsonPathParser) parseArrayIndex() (jsonArrayIndex, bool) {
+	var idx jsonArrayIndex
+	if strings.HasPrefix(p.path[p.pos:], "last") {
+		p.pos += len("last")
+		idx.last = true
+		p.skipSpace()
+		if !p.consume('-') {
+			return idx, true
+		}
+		p.skipSpace()
+	}
+
+	start := p.pos
+	for p.pos < len(p.path) && isDigit(p.path[p.pos]) {
+		p.pos++
+	}
+	if p.pos == start {
+		return idx, false
+	}
+	n, err := strconv.ParseUint(p.path[start:p.pos], 10, 32)
+	if err != nil {
+		return idx, false
+	}
+	idx.n = int(n)
+	return idx, true
+}
+
+// parseKey parses the key in a member leg, which is either an ECMAScript
+// identifier or a double-quoted JSON string
+func (p *jsonPathParser) parseKey() (string, bool) {
+	if p.pos < len(p.path) && p.path[p.pos] == '"' {
+		jp := jsonParser{data: []byte(p.path), pos: p.pos}
+		key, err := jp.parseString()
+		if err != nil {
+			return "", false
+		}
+		p.pos = jp.pos
+		return key, true
+	}
+
+	start := p.pos
+	for p.pos < len(p.path) {
+		r, size := utf8.DecodeRuneInString(p.path[p.pos:])
+		if !isJSONPathIdentifier(r, p.pos == start) {
+			break
+		}
+		p.pos += size
+	}
+	return p.path[start:p.pos], p.pos > start
+}
+
+func isJSONPathIdentifier(r rune, first bool) bool {
+	switch {
+	case r == '$' || r == '_' || unicode.IsLetter(r):
+		return true
+	case first:
+		return false
+	default:
+		return unicode.IsDigit(r) || unicode.Is(unicode.Mn, r) || unicode.Is(unicode.Mc, r) || unicode.Is(unicode.Pc, r)
+	}
+}
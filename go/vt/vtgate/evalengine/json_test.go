@@ -0,0 +1,285 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func TestParseJSON(t *testing.T) {
+	tcases := []struct {
+		in, out, err string
+	}{
+		{in: `null`, out: `null`},
+		{in: ` true `, out: `true`},
+		{in: `-12`, out: `-12`},
+		{in: `18446744073709551615`, out: `18446744073709551615`},
+		{in: `18446744073709551616`, out: `1.8446744073709552e19`},
+		{in: `1.50`, out: `1.5`},
+		{in: `1e2`, out: `100.0`},
+		{in: `"a\"bé😀\n"`, out: `"a\"bé😀\n"`},
+		{in: `[1,[2, {}],"x"]`, out: `[1, [2, {}], "x"]`},
+		{in: `{"bb": 1, "a": 2, "c": 3, "a": 4}`, out: `{"a": 4, "c": 3, "bb": 1}`},
+		{in: ``, err: `"The document is empty." at position 0.`},
+		{in: `[1, 2`, err: `"Missing a comma or ']' after an array element." at position 5.`},
+		{in: `{"a" 1}`, err: `"Missing a colon after a name of object member." at position 5.`},
+		{in: `{1: 2}`, err: `"Missing a name for object member." at position 1.`},
+		{in: `[01]`, err: `"Missing a comma or ']' after an array element." at position 2.`},
+		{in: `"abc`, err: `"Missing a closing quotation mark in string." at position 4.`},
+		{in: `tru`, err: `"Invalid value." at position 0.`},
+		{in: `1 2`, err: `"The document root must not be followed by other values." at position 2.`},
+		{in: strings.Repeat("[", 101) + strings.Repeat("]", 101), err: `The JSON document exceeds the maximum depth of 100.`},
+	}
+	for _, tcase := range tcases {
+		t.Run(tcase.in, func(t *testing.T) {
+			doc, err := parseJSON([]byte(tcase.in))
+			if tcase.err != "" {
+				assert.EqualError(t, err, tcase.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tcase.out, string(doc.marshal(nil)))
+		})
+	}
+}
+
+func TestJSONPath(t *testing.T) {
+	doc, err := parseJSON([]byte(`{"a": [1, 2, {"a": 3}], "b": {"c": [4, 5]}, "d e": 6}`))
+	require.NoError(t, err)
+
+	tcases := []struct {
+		path, out string
+		err       int
+	}{
+		{path: `$`, out: `{"a": [1, 2, {"a": 3}], "b": {"c": [4, 5]}, "d e": 6}`},
+		{path: `$.a[1]`, out: `2`},
+		{path: `$.a[5]`, out: ``},
+		{path: `$.b.c[last]`, out: `5`},
+		{path: `$.b.c[last - 1]`, out: `4`},
+		{path: `$.a[1 to last]`, out: `2, {"a": 3}`},
+		{path: `$.a[1 to 10]`, out: `2, {"a": 3}`},
+		{path: `$."d e"`, out: `6`},
+		{path: `$.b[0]`, out: `{"c": [4, 5]}`},
+		{path: `$.b[0].c[0]`, out: `4`},
+		{path: `$.*[0]`, out: `1, {"c": [4, 5]}, 6`},
+		{path: `$.a[*]`, out: `1, 2, {"a": 3}`},
+		{path: `$**.a`, out: `[1, 2, {"a": 3}], 3`},
+		{path: `$**[1]`, out: `2, 5`},
+		{path: `a`, err: 1},
+		{path: `$.`, err: 3},
+		{path: `$a`, err: 2},
+		{path: `$[1 to 0]`, err: 9},
+		{path: `$**`, err: 3},
+		{path: `$.a[`, err: 5},
+	}
+	for _, tcase := range tcases {
+		t.Run(tcase.path, func(t *testing.T) {
+			path, err := parseJSONPath(tcase.path)
+			if tcase.err != 0 {
+				assert.EqualError(t, err, errInvalidJSONPath(tcase.err).Error())
+				return
+			}
+			require.NoError(t, err)
+
+			var matches []string
+			for _, match := range path.find(doc) {
+				matches = append(matches, string(match.marshal(nil)))
+			}
+			assert.Equal(t, tcase.out, strings.Join(matches, ", "))
+			assert.Equal(t, tcase.out != "", path.exists(doc))
+		})
+	}
+}
+
+func TestCompareJSON(t *testing.T) {
+	// every document sorts strictly before the following one
+	ordered := []string{
+		`null`,
+		`-1`,
+		`0.5`,
+		`1`,
+		`18446744073709551615`,
+		`""`,
+		`"a"`,
+		`"b"`,
+		`{}`,
+		`{"a": 1}`,
+		`{"b": 1}`,
+		`{"a": 1, "b": 1}`,
+		`[]`,
+		`[1]`,
+		`[1, 2]`,
+		`[2]`,
+		`false`,
+		`true`,
+	}
+	docs := make([]*jsonValue, 0, len(ordered))
+	for _, in := range ordered {
+		doc, err := parseJSON([]byte(in))
+		require.NoError(t, err)
+		docs = append(docs, doc)
+	}
+	for i := range docs {
+		for j := range docs {
+			want := 0
+			switch {
+			case i < j:
+				want = -1
+			case i > j:
+				want = 1
+			}
+			assert.Equal(t, want, compareJSON(docs[i], docs[j]), "compare(%s, %s)", ordered[i], ordered[j])
+		}
+	}
+
+	equal := [][2]string{
+		{`1`, `1.0`},
+		{`1`, `1e0`},
+		{`[1, {"a": 2}]`, `[1.0, {"a": 2.0}]`},
+		{`{"a": 1, "b": 2}`, `{"b": 2, "a": 1}`},
+	}
+	for _, pair := range equal {
+		left, err := parseJSON([]byte(pair[0]))
+		require.NoError(t, err)
+		right, err := parseJSON([]byte(pair[1]))
+		require.NoError(t, err)
+		assert.Equal(t, 0, compareJSON(left, right), "compare(%s, %s)", pair[0], pair[1])
+		assert.Equal(t, left.hash(), right.hash(), "hash(%s) != hash(%s)", pair[0], pair[1])
+	}
+}
+
+func TestJSONFunctions(t *testing.T) {
+	tcases := []struct {
+		expr, out, err string
+	}{
+		{expr: `json_extract('{"a": [1, 2, {"b": "x"}]}', '$.a[2].b')`, out: `"x"`},
+		{expr: `json_extract('{"a": [1, 2, {"b": "x"}]}', '$.a[*]')`, out: `[1, 2, {"b": "x"}]`},
+		{expr: `json_extract('{"a": [1, 2, {"b": "x"}]}', '$.a[0]', '$.c')`, out: `[1]`},
+		{expr: `json_extract('{"a": 1}', '$.c')`, out: `NULL`},
+		{expr: `json_extract('{"a": 1', '$.a')`, err: `Invalid JSON text in argument 1 to function json_extract: "Missing a comma or '}' after an object member." at position 7.`},
+		{expr: `json_extract(1, '$')`, err: `Invalid data type for JSON data in argument 1 to function json_extract; a JSON string or JSON type is required.`},
+		{expr: `json_unquote(json_extract('{"a": "x\\ty"}', '$.a'))`, out: "x\ty"},
+		{expr: `json_unquote('"abc"')`, out: `abc`},
+		{expr: `json_unquote('abc')`, out: `abc`},
+		{expr: `json_quote('a"b')`, out: `"a\"b"`},
+		{expr: `json_contains('{"a": 1, "b": {"c": [2, 3]}}', '{"c": [3]}', '$.b')`, out: `1`},
+		{expr: `json_contains('[1, 2, [3, 4]]', '[1, [4]]')`, out: `1`},
+		{expr: `json_contains('[1, 2]', '3')`, out: `0`},
+		{expr: `json_contains('[1, 2]', '1', '$[5]')`, out: `NULL`},
+		{expr: `json_contains('[1, 2]', '1', '$[*]')`, err: `In this situation, path expressions may not contain the * and ** tokens or an array range.`},
+		{expr: `json_contains_path('{"a": 1}', 'one', '$.a', '$.b')`, out: `1`},
+		{expr: `json_contains_path('{"a": 1}', 'all', '$.a', '$.b')`, out: `0`},
+		{expr: `json_contains_path('{"a": 1}', 'some', '$.a')`, err: `The oneOrAll argument to json_contains_path may take these values: 'one' or 'all'.`},
+		{expr: `json_object('b', 1, 'aa', null, 'a', 'x', 'b', 2.50)`, out: `{"a": "x", "b": 2.50, "aa": null}`},
+		{expr: `json_object(null, 1)`, err: `JSON documents may not contain NULL member names.`},
+		{expr: `json_array(1, 'a', null, 1.5e0, 0x41, json_object('a', json_array()))`, out: `[1, "a", null, 1.5, "base64:type15:QQ==", {"a": []}]`},
+		{expr: `json_array(cast('2021-01-01' as date), cast('2021-01-01 10:00:00' as datetime))`, out: `["2021-01-01", "2021-01-01 10:00:00.000000"]`},
+		{expr: `json_type(json_extract('{"a": 1.5}', '$.a'))`, out: `DOUBLE`},
+		{expr: `json_valid('{"a": ')`, out: `0`},
+		{expr: `json_keys('{"b": 1, "a": {"c": 2}}')`, out: `["a", "b"]`},
+		{expr: `json_keys('[1]')`, out: `NULL`},
+		{expr: `json_length('[1, 2, {"a": 3}]', '$[2]')`, out: `1`},
+		{expr: `json_depth('[1, {"a": [2]}]')`, out: `4`},
+		{expr: `cast('{"b": 1, "a": [true, null]}' as json)`, out: `{"a": [true, null], "b": 1}`},
+		{expr: `cast('[1, 2] x' as json)`, err: `Invalid JSON text in argument 1 to function cast_as_json: "The document root must not be followed by other values." at position 7.`},
+		{expr: `json_extract('{"a": 10}', '$.a') > json_extract('{"a": 9}', '$.a')`, out: `1`},
+		{expr: `json_extract('{"a": "x"}', '$.a') = 'x'`, out: `1`},
+		{expr: `cast('1' as json) = '1'`, out: `0`},
+		{expr: `cast('1' as json) = 1`, out: `1`},
+		{expr: `cast('true' as json) > cast('[1, 3]' as json)`, out: `1`},
+	}
+	for _, tcase := range tcases {
+		t.Run(tcase.expr, func(t *testing.T) {
+			stmt, err := sqlparser.Parse("select " + tcase.expr)
+			require.NoError(t, err)
+			astExpr := stmt.(*sqlparser.Select).SelectExprs[0].(*sqlparser.AliasedExpr).Expr
+			expr, err := TranslateEx(astExpr, LookupDefaultCollation(45), false)
+			require.NoError(t, err)
+
+			r, err := EnvWithBindVars(nil, 45).Evaluate(expr)
+			if tcase.err != "" {
+				assert.EqualError(t, err, tcase.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tcase.out, jsonTestResult(r))
+		})
+	}
+}
+
+func TestJSONExtractOperators(t *testing.T) {
+	tcases := []struct {
+		expr, out string
+	}{
+		{expr: `doc->'$.a'`, out: `"x"`},
+		{expr: `doc->>'$.a'`, out: `x`},
+		{expr: `doc->'$.b[1]'`, out: `2`},
+		{expr: `doc->>'$.c'`, out: `NULL`},
+		{expr: `doc->>'$.a' = 'x'`, out: `1`},
+		{expr: `doc->'$.b' = json_array(1, 2)`, out: `1`},
+	}
+	row := []sqltypes.Value{sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(`{"a": "x", "b": [1, 2]}`))}
+	for _, tcase := range tcases {
+		t.Run(tcase.expr, func(t *testing.T) {
+			stmt, err := sqlparser.Parse("select " + tcase.expr + " from t")
+			require.NoError(t, err)
+			astExpr := stmt.(*sqlparser.Select).SelectExprs[0].(*sqlparser.AliasedExpr).Expr
+			expr, err := Translate(astExpr, jsonColumnLookup(45))
+			require.NoError(t, err)
+
+			env := EnvWithBindVars(nil, 45)
+			env.Row = row
+			r, err := env.Evaluate(expr)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.out, jsonTestResult(r))
+		})
+	}
+}
+
+func jsonTestResult(r EvalResult) string {
+	if r.Value().IsNull() {
+		return "NULL"
+	}
+	return r.Value().ToString()
+}
+
+// jsonColumnLookup resolves the `doc` column to the first column of the row
+type jsonColumnLookup collations.ID
+
+func (l jsonColumnLookup) ColumnLookup(col *sqlparser.ColName) (int, error) {
+	if col.Name.EqualString("doc") {
+		return 0, nil
+	}
+	return 0, fmt.Errorf("unknown column %s", sqlparser.String(col))
+}
+
+func (l jsonColumnLookup) CollationForExpr(_ sqlparser.Expr) collations.ID {
+	return collations.Unknown
+}
+
+func (l jsonColumnLookup) DefaultCollation() collations.ID {
+	return collations.ID(l)
+}
@@ -0,0 +1,889 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"sort"
+	"strconv"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine/internal/decimal"
+)
+
+// jsonType is the type of a JSON value. The types are declared in the order
+// in which MySQL sorts values of different types when comparing them, with
+// all the numeric types sharing the same rank.
+// See https://dev.mysql.com/doc/refman/8.0/en/json.html#json-comparison
+type jsonType uint8
+
+const (
+	jsonNull jsonType = iota
+	jsonInteger
+	jsonUnsigned
+	jsonDouble
+	jsonDecimal
+	jsonString
+	jsonObject
+	jsonArray
+	jsonBoolean
+	jsonDate
+	jsonTime
+	jsonDatetime
+	jsonOpaque
+)
+
+// maxJSONDepth is the maximum nesting depth of a JSON document in MySQL
+const maxJSONDepth = 100
+
+var jsonTypeNames = [...]string{
+	jsonNull:     "NULL",
+	jsonInteger:  "INTEGER",
+	jsonUnsigned: "UNSIGNED INTEGER",
+	jsonDouble:   "DOUBLE",
+	jsonDecimal:  "DECIMAL",
+	jsonString:   "STRING",
+	jsonObject:   "OBJECT",
+	jsonArray:    "ARRAY",
+	jsonBoolean:  "BOOLEAN",
+	jsonDate:     "DATE",
+	jsonTime:     "TIME",
+	jsonDatetime: "DATETIME",
+	jsonOpaque:   "BLOB",
+}
+
+func (t jsonType) String() string {
+	return jsonTypeNames[t]
+}
+
+// rank returns the position of this type when comparing values of different types
+func (t jsonType) rank() jsonType {
+	if t.isNumeric() {
+		return jsonInteger
+	}
+	return t
+}
+
+func (t jsonType) isNumeric() bool {
+	return t >= jsonInteger && t <= jsonDecimal
+}
+
+type (
+	// jsonValue is a node in a JSON document. Besides the types defined in the JSON
+	// standard, MySQL documents can hold temporal values and opaque binary data, which
+	// are stored here as strings. Objects are kept normalized as MySQL does: their
+	// members are sorted by key and there are no duplicate keys.
+	jsonValue struct {
+		typ jsonType
+		i   int64
+		u   uint64
+		f   float64
+		dec decimal.Decimal
+		// frac is the number of fractional digits in a jsonDecimal
+		frac int32
+		// str is the contents of strings, temporal and opaque values
+		str     string
+		b       bool
+		array   []*jsonValue
+		members []jsonMember
+	}
+
+	jsonMember struct {
+		key   string
+		value *jsonValue
+	}
+)
+
+var jsonNullValue = &jsonValue{typ: jsonNull}
+
+func newJSONString(s string) *jsonValue {
+	return &jsonValue{typ: jsonString, str: s}
+}
+
+func newJSONInt(i int64) *jsonValue {
+	return &jsonValue{typ: jsonInteger, i: i}
+}
+
+func newJSONBool(b bool) *jsonValue {
+	return &jsonValue{typ: jsonBoolean, b: b}
+}
+
+func newJSONArray(elems []*jsonValue) *jsonValue {
+	return &jsonValue{typ: jsonArray, array: elems}
+}
+
+// newJSONObject returns a new object with the given members, normalized as MySQL
+// does: the members are sorted by key and, for duplicate keys, the last one wins
+func newJSONObject(members []jsonMember) *jsonValue {
+	sort.SliceStable(members, func(i, j int) bool {
+		return compareJSONKeys(members[i].key, members[j].key) < 0
+	})
+	out := members[:0]
+	for _, m := range members {
+		if len(out) > 0 && out[len(out)-1].key == m.key {
+			out[len(out)-1] = m
+			continue
+		}
+		out = append(out, m)
+	}
+	return &jsonValue{typ: jsonObject, members: out}
+}
+
+// compareJSONKeys compares the keys of two object members: shorter keys sort first
+func compareJSONKeys(a, b string) int {
+	if len(a) != len(b) {
+		if len(a) < len(b) {
+			return -1
+		}
+		return 1
+	}
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// member returns the value for the given key in an object, or nil if the
+// object has no such key
+func (v *jsonValue) member(key string) *jsonValue {
+	idx := sort.Search(len(v.members), func(i int) bool {
+		return compareJSONKeys(v.members[i].key, key) >= 0
+	})
+	if idx < len(v.members) && v.members[idx].key == key {
+		return v.members[idx].value
+	}
+	return nil
+}
+
+// length returns the length of a value as JSON_LENGTH does
+func (v *jsonValue) length() int {
+	switch v.typ {
+	case jsonArray:
+		return len(v.array)
+	case jsonObject:
+		return len(v.members)
+	default:
+		return 1
+	}
+}
+
+// depth returns the maximum depth of a value as JSON_DEPTH does
+func (v *jsonValue) depth() int {
+	var inner int
+	switch v.typ {
+	case jsonArray:
+		for _, elem := range v.array {
+			if d := elem.depth(); d > inner {
+				inner = d
+			}
+		}
+	case jsonObject:
+		for _, m := range v.members {
+			if d := m.value.depth(); d > inner {
+				inner = d
+			}
+		}
+	}
+	return inner + 1
+}
+
+// contains returns whether the candidate value is contained in v, following the
+// rules for JSON_CONTAINS: a scalar is contained in another if they're equal, an
+// array is contained in another if all its elements are, an object is contained
+// in another if all its members are, and a non-array candidate is contained in an
+// array if it is contained in any of its elements
+func (v *jsonValue) contains(candidate *jsonValue) bool {
+	switch v.typ {
+	case jsonArray:
+		if candidate.typ == jsonArray {
+			for _, celem := range candidate.array {
+				if !v.contains(celem) {
+					return false
+				}
+			}
+			return true
+		}
+		for _, elem := range v.array {
+			if elem.contains(candidate) {
+				return true
+			}
+		}
+		return false
+	case jsonObject:
+		if candidate.typ != jsonObject {
+			return false
+		}
+		for _, cm := range candidate.members {
+			value := v.member(cm.key)
+			if value == nil || !value.contains(cm.value) {
+				return false
+			}
+		}
+		return true
+	default:
+		if candidate.typ == jsonArray || candidate.typ == jsonObject {
+			return false
+		}
+		return compareJSON(v, candidate) == 0
+	}
+}
+
+// compareJSON compares two JSON values: values of different types are ordered by
+// the precedence of their types, and values of the same type by their contents
+func compareJSON(a, b *jsonValue) int {
+	if ra, rb := a.typ.rank(), b.typ.rank(); ra != rb {
+		if ra < rb {
+			return -1
+		}
+		return 1
+	}
+
+	switch a.typ {
+	case jsonNull:
+		return 0
+	case jsonInteger, jsonUnsigned, jsonDouble, jsonDecimal:
+		return compareJSONNumbers(a, b)
+	case jsonBoolean:
+		switch {
+		case a.b == b.b:
+			return 0
+		case b.b:
+			return -1
+		default:
+			return 1
+		}
+	case jsonArray:
+		for i := 0; i < len(a.array) && i < len(b.array); i++ {
+			if cmp := compareJSON(a.array[i], b.array[i]); cmp != 0 {
+				return cmp
+			}
+		}
+		return compareInts(len(a.array), len(b.array))
+	case jsonObject:
+		// objects are only defined to be equal when they have the same members,
+		// but they still need a stable order to be sorted
+		if cmp := compareInts(len(a.members), len(b.members)); cmp != 0 {
+			return cmp
+		}
+		for i := range a.members {
+			if cmp := compareJSONKeys(a.members[i].key, b.members[i].key); cmp != 0 {
+				return cmp
+			}
+			if cmp := compareJSON(a.members[i].value, b.members[i].value); cmp != 0 {
+				return cmp
+			}
+		}
+		return 0
+	case jsonTime:
+		ad, _, aok := parseTime(a.str)
+		bd, _, bok := parseTime(b.str)
+		if aok && bok {
+			return compareDurations(ad, bd)
+		}
+		return compareStringsBinary(a.str, b.str)
+	default:
+		// strings are compared as utf8mb4_bin; dates and datetimes are always stored
+		// with the same fixed width and can be compared byte-wise too
+		return compareStringsBinary(a.str, b.str)
+	}
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareStringsBinary(a, b string) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareJSONNumbers(a, b *jsonValue) int {
+	switch {
+	case a.typ == jsonInteger && b.typ == jsonInteger:
+		return compareInts64(a.i, b.i)
+	case a.typ == jsonUnsigned && b.typ == jsonUnsigned:
+		return compareUints64(a.u, b.u)
+	case a.typ == jsonInteger && b.typ == jsonUnsigned:
+		if a.i < 0 {
+			return -1
+		}
+		return compareUints64(uint64(a.i), b.u)
+	case a.typ == jsonUnsigned && b.typ == jsonInteger:
+		if b.i < 0 {
+			return 1
+		}
+		return compareUints64(a.u, uint64(b.i))
+	case a.typ == jsonDecimal || b.typ == jsonDecimal:
+		return a.decimal().Cmp(b.decimal())
+	default:
+		af, bf := a.float64(), b.float64()
+		switch {
+		case af < bf:
+			return -1
+		case af > bf:
+			return 1
+		default:
+			return 0
+		}
+	}
+}
+
+func compareInts64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareUints64(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (v *jsonValue) float64() float64 {
+	switch v.typ {
+	case jsonInteger:
+		return float64(v.i)
+	case jsonUnsigned:
+		return float64(v.u)
+	case jsonDecimal:
+		f, _ := v.dec.Float64()
+		return f
+	default:
+		return v.f
+	}
+}
+
+func (v *jsonValue) decimal() decimal.Decimal {
+	switch v.typ {
+	case jsonInteger:
+		return decimal.NewFromInt(v.i)
+	case jsonUnsigned:
+		return decimal.NewFromUint(v.u)
+	case jsonDecimal:
+		return v.dec
+	default:
+		return decimal.NewFromFloat(v.f)
+	}
+}
+
+// hash returns a hash for this value that is the same for any two values
+// which are equal according to compareJSON
+func (v *jsonValue) hash() uint64 {
+	h := fnv.New64a()
+	v.hashInto(h)
+	return h.Sum64()
+}
+
+func (v *jsonValue) hashInto(h interface{ Write([]byte) (int, error) }) {
+	var buf [9]byte
+	buf[0] = byte(v.typ.rank())
+	switch v.typ {
+	case jsonNull:
+		_, _ = h.Write(buf[:1])
+	case jsonInteger, jsonUnsigned, jsonDouble, jsonDecimal:
+		binary.LittleEndian.PutUint64(buf[1:], math.Float64bits(v.float64()))
+		_, _ = h.Write(buf[:9])
+	case jsonBoolean:
+		if v.b {
+			buf[1] = 1
+		}
+		_, _ = h.Write(buf[:2])
+	case jsonArray:
+		binary.LittleEndian.PutUint64(buf[1:], uint64(len(v.array)))
+		_, _ = h.Write(buf[:9])
+		for _, elem := range v.array {
+			elem.hashInto(h)
+		}
+	case jsonObject:
+		binary.LittleEndian.PutUint64(buf[1:], uint64(len(v.members)))
+		_, _ = h.Write(buf[:9])
+		for _, m := range v.members {
+			_, _ = h.Write([]byte(m.key))
+			m.value.hashInto(h)
+		}
+	case jsonTime:
+		d, _, _ := parseTime(v.str)
+		binary.LittleEndian.PutUint64(buf[1:], uint64(d))
+		_, _ = h.Write(buf[:9])
+	default:
+		_, _ = h.Write(buf[:1])
+		_, _ = h.Write([]byte(v.str))
+	}
+}
+
+// marshal appends the JSON text for this value to buf, in the same format MySQL
+// uses when converting a JSON value to a string
+func (v *jsonValue) marshal(buf []byte) []byte {
+	switch v.typ {
+	case jsonNull:
+		return append(buf, "null"...)
+	case jsonBoolean:
+		if v.b {
+			return append(buf, "true"...)
+		}
+		return append(buf, "false"...)
+	case jsonInteger:
+		return strconv.AppendInt(buf, v.i, 10)
+	case jsonUnsigned:
+		return strconv.AppendUint(buf, v.u, 10)
+	case jsonDouble:
+		return appendJSONDouble(buf, v.f)
+	case jsonDecimal:
+		return append(buf, v.dec.FormatMySQL(v.frac)...)
+	case jsonArray:
+		buf = append(buf, '[')
+		for i, elem := range v.array {
+			if i > 0 {
+				buf = append(buf, ", "...)
+			}
+			buf = elem.marshal(buf)
+		}
+		return append(buf, ']')
+	case jsonObject:
+		buf = append(buf, '{')
+		for i, m := range v.members {
+			if i > 0 {
+				buf = append(buf, ", "...)
+			}
+			buf = appendJSONString(buf, m.key)
+			buf = append(buf, ": "...)
+			buf = m.value.marshal(buf)
+		}
+		return append(buf, '}')
+	default:
+		return appendJSONString(buf, v.str)
+	}
+}
+
+// unquoted returns the value as JSON_UNQUOTE does: strings are returned
+// without quotes or escapes, everything else as JSON text
+func (v *jsonValue) unquoted() []byte {
+	switch v.typ {
+	case jsonString, jsonDate, jsonTime, jsonDatetime, jsonOpaque:
+		return []byte(v.str)
+	default:
+		return v.marshal(nil)
+	}
+}
+
+// appendJSONDouble formats a double like MySQL does inside JSON documents:
+// integral values keep a trailing ".0" so they can be told apart from integers
+func appendJSONDouble(buf []byte, f float64) []byte {
+	start := len(buf)
+	if abs := math.Abs(f); abs == 0 || (abs >= 1e-5 && abs < 1e15) {
+		buf = strconv.AppendFloat(buf, f, 'f', -1, 64)
+	} else {
+		buf = append(buf, FormatFloat(sqltypes.Float64, f)...)
+	}
+	if bytes.IndexAny(buf[start:], ".e") < 0 {
+		buf = append(buf, ".0"...)
+	}
+	return buf
+}
+
+const hexDigits = "0123456789abcdef"
+
+func appendJSONString(buf []byte, s string) []byte {
+	buf = append(buf, '"')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch c {
+		case '"':
+			buf = append(buf, '\\', '"')
+		case '\\':
+			buf = append(buf, '\\', '\\')
+		case '\b':
+			buf = append(buf, '\\', 'b')
+		case '\f':
+			buf = append(buf, '\\', 'f')
+		case '\n':
+			buf = append(buf, '\\', 'n')
+		case '\r':
+			buf = append(buf, '\\', 'r')
+		case '\t':
+			buf = append(buf, '\\', 't')
+		default:
+			if c < 0x20 {
+				buf = append(buf, '\\', 'u', '0', '0', hexDigits[c>>4], hexDigits[c&0xF])
+			} else {
+				buf = append(buf, c)
+			}
+		}
+	}
+	return append(buf, '"')
+}
+
+// jsonSyntaxError is returned when parsing an invalid JSON document; the
+// messages are the same ones MySQL returns
+type jsonSyntaxError struct {
+	msg string
+	pos int
+}
+
+func (err *jsonSyntaxError) Error() string {
+	return fmt.Sprintf("\"%s\" at position %d.", err.msg, err.pos)
+}
+
+// errJSONTooDeep is returned when parsing a JSON document nested deeper than maxJSONDepth
+var errJSONTooDeep = fmt.Errorf("The JSON document exceeds the maximum depth of %d.", maxJSONDepth)
+
+type jsonParser struct {
+	data  []byte
+	pos   int
+	depth int
+}
+
+// parseJSON parses the given JSON text into a value
+func parseJSON(data []byte) (*jsonValue, error) {
+	p := jsonParser{data: data}
+	p.skipSpace()
+	if p.pos == len(p.data) {
+		return nil, p.error("The document is empty.")
+	}
+	v, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if p.pos < len(p.data) {
+		return nil, p.error("The document root must not be followed by other values.")
+	}
+	return v, nil
+}
+
+func (p *jsonParser) error(msg string) error {
+	return &jsonSyntaxError{msg: msg, pos: p.pos}
+}
+
+func (p *jsonParser) skipSpace() {
+	for p.pos < len(p.data) {
+		switch p.data[p.pos] {
+		case ' ', '\t', '\n', '\r':
+			p.pos++
+		default:
+			return
+		}
+	}
+}
+
+func (p *jsonParser) consume(c byte) bool {
+	if p.pos < len(p.data) && p.data[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *jsonParser) parseValue() (*jsonValue, error) {
+	if p.pos == len(p.data) {
+		return nil, p.error("Invalid value.")
+	}
+	switch c := p.data[p.pos]; {
+	case c == '{':
+		return p.parseObject()
+	case c == '[':
+		return p.parseArray()
+	case c == '"':
+		s, err := p.parseString()
+		if err != nil {
+			return nil, err
+		}
+		return newJSONString(s), nil
+	case c == 't':
+		return p.parseLiteral("true", newJSONBool(true))
+	case c == 'f':
+		return p.parseLiteral("false", newJSONBool(false))
+	case c == 'n':
+		return p.parseLiteral("null", jsonNullValue)
+	case c == '-' || isDigit(c):
+		return p.parseNumber()
+	default:
+		return nil, p.error("Invalid value.")
+	}
+}
+
+func (p *jsonParser) parseLiteral(lit string, v *jsonValue) (*jsonValue, error) {
+	if !bytes.HasPrefix(p.data[p.pos:], []byte(lit)) {
+		return nil, p.error("Invalid value.")
+	}
+	p.pos += len(lit)
+	return v, nil
+}
+
+func (p *jsonParser) enter() error {
+	p.depth++
+	if p.depth > maxJSONDepth {
+		return errJSONTooDeep
+	}
+	return nil
+}
+
+func (p *jsonParser) parseObject() (*jsonValue, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	p.pos++
+	p.skipSpace()
+
+	var members []jsonMember
+	if !p.consume('}') {
+		for {
+			if p.pos == len(p.data) || p.data[p.pos] != '"' {
+				return nil, p.error("Missing a name for object member.")
+			}
+			key, err := p.parseString()
+			if err != nil {
+				return nil, err
+			}
+			p.skipSpace()
+			if !p.consume(':') {
+				return nil, p.error("Missing a colon after a name of object member.")
+			}
+			p.skipSpace()
+			value, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			members = append(members, jsonMember{key: key, value: value})
+			p.skipSpace()
+			if p.consume('}') {
+				break
+			}
+			if !p.consume(',') {
+				return nil, p.error("Missing a comma or '}' after an object member.")
+			}
+			p.skipSpace()
+		}
+	}
+	p.depth--
+	return newJSONObject(members), nil
+}
+
+func (p *jsonParser) parseArray() (*jsonValue, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	p.pos++
+	p.skipSpace()
+
+	elems := []*jsonValue{}
+	if !p.consume(']') {
+		for {
+			elem, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			elems = append(elems, elem)
+			p.skipSpace()
+			if p.consume(']') {
+				break
+			}
+			if !p.consume(',') {
+				return nil, p.error("Missing a comma or ']' after an array element.")
+			}
+			p.skipSpace()
+		}
+	}
+	p.depth--
+	return newJSONArray(elems), nil
+}
+
+func (p *jsonParser) parseString() (string, error) {
+	p.pos++
+	var out []byte
+	for {
+		if p.pos == len(p.data) {
+			return "", p.error("Missing a closing quotation mark in string.")
+		}
+		switch c := p.data[p.pos]; {
+		case c == '"':
+			p.pos++
+			return string(out), nil
+		case c == '\\':
+			r, err := p.parseEscape()
+			if err != nil {
+				return "", err
+			}
+			var enc [utf8.UTFMax]byte
+			out = append(out, enc[:utf8.EncodeRune(enc[:], r)]...)
+		case c < 0x20:
+			return "", p.error("Invalid encoding in string.")
+		case c < utf8.RuneSelf:
+			out = append(out, c)
+			p.pos++
+		default:
+			r, size := utf8.DecodeRune(p.data[p.pos:])
+			if r == utf8.RuneError && size <= 1 {
+				return "", p.error("Invalid encoding in string.")
+			}
+			out = append(out, p.data[p.pos:p.pos+size]...)
+			p.pos += size
+		}
+	}
+}
+
+func (p *jsonParser) parseEscape() (rune, error) {
+	p.pos++
+	if p.pos == len(p.data) {
+		return 0, p.error("Invalid escape character in string.")
+	}
+	c := p.data[p.pos]
+	p.pos++
+	switch c {
+	case '"', '\\', '/':
+		return rune(c), nil
+	case 'b':
+		return '\b', nil
+	case 'f':
+		return '\f', nil
+	case 'n':
+		return '\n', nil
+	case 'r':
+		return '\r', nil
+	case 't':
+		return '\t', nil
+	case 'u':
+		r, err := p.parseHex4()
+		if err != nil {
+			return 0, err
+		}
+		if utf16.IsSurrogate(r) {
+			if !bytes.HasPrefix(p.data[p.pos:], []byte(`\u`)) {
+				return 0, p.error("The surrogate pair in string is invalid.")
+			}
+			p.pos += 2
+			r2, err := p.parseHex4()
+			if err != nil {
+				return 0, err
+			}
+			if r = utf16.DecodeRune(r, r2); r == utf8.RuneError {
+				return 0, p.error("The surrogate pair in string is invalid.")
+			}
+		}
+		return r, nil
+	default:
+		p.pos--
+		return 0, p.error("Invalid escape character in string.")
+	}
+}
+
+func (p *jsonParser) parseHex4() (rune, error) {
+	if p.pos+4 > len(p.data) {
+		return 0, p.error("Incorrect hex digit after \\u escape in string.")
+	}
+	var r rune
+	for _, c := range p.data[p.pos : p.pos+4] {
+		r <<= 4
+		switch {
+		case c >= '0' && c <= '9':
+			r |= rune(c - '0')
+		case c >= 'a' && c <= 'f':
+			r |= rune(c - 'a' + 10)
+		case c >= 'A' && c <= 'F':
+			r |= rune(c - 'A' + 10)
+		default:
+			return 0, p.error("Incorrect hex digit after \\u escape in string.")
+		}
+	}
+	p.pos += 4
+	return r, nil
+}
+
+func (p *jsonParser) skipDigits() int {
+	start := p.pos
+	for p.pos < len(p.data) && isDigit(p.data[p.pos]) {
+		p.pos++
+	}
+	return p.pos - start
+}
+
+func (p *jsonParser) parseNumber() (*jsonValue, error) {
+	start := p.pos
+	p.consume('-')
+	if p.consume('0') {
+		// leading zeroes are not allowed
+	} else if p.skipDigits() == 0 {
+		return nil, p.error("Invalid value.")
+	}
+
+	integral := true
+	if p.consume('.') {
+		integral = false
+		if p.skipDigits() == 0 {
+			return nil, p.error("Missing fraction part in number.")
+		}
+	}
+	if p.consume('e') || p.consume('E') {
+		integral = false
+		if !p.consume('+') {
+			p.consume('-')
+		}
+		if p.skipDigits() == 0 {
+			return nil, p.error("Missing exponent in number.")
+		}
+	}
+
+	lit := string(p.data[start:p.pos])
+	if integral {
+		if i, err := strconv.ParseInt(lit, 10, 64); err == nil {
+			return &jsonValue{typ: jsonInteger, i: i}, nil
+		}
+		if u, err := strconv.ParseUint(lit, 10, 64); err == nil {
+			return &jsonValue{typ: jsonUnsigned, u: u}, nil
+		}
+	}
+	f, err := strconv.ParseFloat(lit, 64)
+	if err != nil {
+		p.pos = start
+		return nil, p.error("Number too big to be stored in double.")
+	}
+	return &jsonValue{typ: jsonDouble, f: f}, nil
+}
@@ -168,3 +168,51 @@ func (i *IsExpr) eval(env *ExpressionEnv, result *EvalResult) {
 func (i *IsExpr) typeof(env *ExpressionEnv) (sqltypes.Type, flag) {
 	return sqltypes.Int64, 0
 }
+
+// CaseExpr represents the CASE expression in MySQL.
+// CASE WHEN condition THEN result [WHEN condition THEN result ...] [ELSE result] END
+// The WHEN values of a CASE with a base value are translated into
+// equality comparisons against that value.
+type CaseExpr struct {
+	Cases []WhenThen
+	Else  Expr
+}
+
+// WhenThen is a single WHEN condition THEN result branch of a CaseExpr.
+type WhenThen struct {
+	When Expr
+	Then Expr
+}
+
+func (c *CaseExpr) eval(env *ExpressionEnv, result *EvalResult) {
+	for _, wt := range c.Cases {
+		var when EvalResult
+		when.init(env, wt.When)
+		if when.isTruthy() == boolTrue {
+			result.init(env, wt.Then)
+			result.resolve()
+			return
+		}
+	}
+	if c.Else == nil {
+		result.setNull()
+		return
+	}
+	result.init(env, c.Else)
+	result.resolve()
+}
+
+func (c *CaseExpr) typeof(env *ExpressionEnv) (sqltypes.Type, flag) {
+	var results []Expr
+	for _, wt := range c.Cases {
+		results = append(results, wt.Then)
+	}
+	var f flag
+	if c.Else != nil {
+		results = append(results, c.Else)
+	}
+	if c.Else == nil || nullFlags(env, results...) != 0 {
+		f = flagNullable
+	}
+	return aggregatedType(env, results), f
+}
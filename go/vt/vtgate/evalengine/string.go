@@ -17,7 +17,10 @@ limitations under the License.
 package evalengine
 
 import (
+	"bytes"
+	"math"
 	"strconv"
+
 	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
@@ -25,11 +28,54 @@ import (
 )
 
 type (
-	builtinAscii     struct{}
-	builtinBin       struct{}
-	builtinBitLength struct{}
+	builtinAscii      struct{}
+	builtinBin        struct{}
+	builtinBitLength  struct{}
+	builtinConcat     struct{}
+	builtinConcatWs   struct{}
+	builtinLength     struct{}
+	builtinCharLength struct{}
+	builtinReverse    struct{}
+	builtinRepeat     struct{}
+	builtinReplace    struct{}
+	builtinSubstring  struct{}
+
+	builtinChangeCase struct {
+		name   string
+		upcase bool
+	}
+
+	builtinLeftRight struct {
+		name  string
+		right bool
+	}
+
+	builtinPad struct {
+		name  string
+		right bool
+	}
+
+	builtinTrim struct {
+		name              string
+		leading, trailing bool
+		// remstr is set when the string to remove can be given as a second
+		// argument, which is only possible with the TRIM(remstr FROM str) syntax
+		remstr bool
+	}
+
+	builtinLocate struct {
+		name string
+		// instr is set for INSTR(str, substr), which takes its arguments
+		// in the opposite order as LOCATE(substr, str)
+		instr bool
+	}
 )
 
+// maxStringLength is the largest string that the string functions will build;
+// it mirrors the default value for `max_allowed_packet` in MySQL, past which
+// functions such as REPEAT or LPAD return NULL.
+const maxStringLength = 64 * 1024 * 1024
+
 func (builtinAscii) call(_ *ExpressionEnv, args []EvalResult, result *EvalResult) {
 	toascii := &args[0]
 	if toascii.isNull() {
@@ -109,3 +155,568 @@ func (builtinBitLength) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type,
 	_, f := args[0].typeof(env)
 	return sqltypes.Int64, f
 }
+
+// nullFlags returns the nullability flags for the result of a function that
+// returns NULL whenever any of the given arguments is NULL
+func nullFlags(env *ExpressionEnv, args ...Expr) flag {
+	var f flag
+	for _, arg := range args {
+		_, af := arg.typeof(env)
+		f |= af & (flagNull | flagNullable)
+	}
+	return f
+}
+
+// stringType returns the type of a string function whose result is built from
+// the given arguments: binary strings are only returned if any of them is binary
+func stringType(env *ExpressionEnv, args ...Expr) sqltypes.Type {
+	for _, arg := range args {
+		if tt, _ := arg.typeof(env); sqltypes.IsBinary(tt) {
+			return sqltypes.VarBinary
+		}
+	}
+	return sqltypes.VarChar
+}
+
+// textualArg returns the raw bytes for an argument to a string function, together
+// with the collation they're encoded with. Non-textual arguments are formatted
+// and treated as strings in the connection's default collation.
+func textualArg(env *ExpressionEnv, arg *EvalResult) ([]byte, collations.TypedCollation) {
+	defaultCollation := env.DefaultCollation
+	if defaultCollation == collations.Unknown {
+		defaultCollation = collations.Default()
+	}
+
+	coll := arg.collation()
+	if arg.isTextual() {
+		if coll.Collation == collations.Unknown {
+			coll.Collation = defaultCollation
+		}
+		return arg.bytes(), coll
+	}
+	return arg.toRawBytes(), collations.TypedCollation{
+		Collation:    defaultCollation,
+		Coercibility: collations.CoerceNumeric,
+		Repertoire:   collations.RepertoireASCII,
+	}
+}
+
+// mergeTextualArgs returns the raw bytes for all the given arguments to a string function,
+// transcoded into the collation that results from aggregating all their collations,
+// as MySQL does for functions that build a string result out of several strings.
+// If any of the arguments is a binary string, the result is a binary string.
+func mergeTextualArgs(env *ExpressionEnv, args ...*EvalResult) ([][]byte, collations.TypedCollation) {
+	var (
+		raw      = make([][]byte, len(args))
+		colls    = make([]collations.TypedCollation, len(args))
+		binary   bool
+		environ  = collations.Local()
+		merged   collations.TypedCollation
+		mergeErr error
+	)
+
+	for i, arg := range args {
+		raw[i], colls[i] = textualArg(env, arg)
+		if colls[i].Collation == collations.CollationBinaryID {
+			binary = true
+		}
+	}
+	if binary {
+		return raw, collationBinary
+	}
+
+	merged = colls[0]
+	for _, coll := range colls[1:] {
+		merged, _, _, mergeErr = environ.MergeCollations(merged, coll, collations.CoercionOptions{
+			ConvertToSuperset:   true,
+			ConvertWithCoercion: true,
+		})
+		if mergeErr != nil {
+			throwEvalError(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, mergeErr.Error()))
+		}
+	}
+
+	target := environ.LookupByID(merged.Collation)
+	for i := range raw {
+		if colls[i].Collation == merged.Collation {
+			continue
+		}
+		source := environ.LookupByID(colls[i].Collation)
+		if source.Charset().Name() != target.Charset().Name() {
+			var err error
+			raw[i], err = collations.Convert(nil, target, raw[i], source)
+			if err != nil {
+				throwEvalError(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, err.Error()))
+			}
+		}
+	}
+	return raw, merged
+}
+
+// setStringResult sets the given raw bytes as the result of a string function
+func setStringResult(result *EvalResult, raw []byte, coll collations.TypedCollation) {
+	if coll.Collation == collations.CollationBinaryID {
+		result.setRaw(sqltypes.VarBinary, raw, coll)
+	} else {
+		result.setRaw(sqltypes.VarChar, raw, coll)
+	}
+}
+
+// integralArg returns the value of a numeric argument to a string function as a
+// signed integer, saturating any unsigned values that would not fit in it
+func integralArg(arg *EvalResult) int64 {
+	arg.makeNumeric()
+	if sqltypes.IsUnsigned(arg.typeof()) && arg.uint64() > math.MaxInt64 {
+		return math.MaxInt64
+	}
+	arg.makeSignedIntegral()
+	return arg.int64()
+}
+
+// charLen returns the size in bytes of the first character in raw
+func charLen(collation collations.Collation, raw []byte) int {
+	_, size := collation.Charset().DecodeRune(raw)
+	if size < 1 {
+		size = 1
+	}
+	return size
+}
+
+func (builtinConcat) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	var ptrs = make([]*EvalResult, len(args))
+	for i := range args {
+		if args[i].isNull() {
+			result.setNull()
+			return
+		}
+		ptrs[i] = &args[i]
+	}
+
+	raw, coll := mergeTextualArgs(env, ptrs...)
+	setStringResult(result, bytes.Join(raw, nil), coll)
+}
+
+func (builtinConcat) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) < 1 {
+		throwArgError("CONCAT")
+	}
+	return stringType(env, args...), nullFlags(env, args...)
+}
+
+func (builtinConcatWs) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	if args[0].isNull() {
+		result.setNull()
+		return
+	}
+
+	// NULL arguments after the separator are skipped
+	var ptrs = []*EvalResult{&args[0]}
+	for i := range args[1:] {
+		if arg := &args[i+1]; !arg.isNull() {
+			ptrs = append(ptrs, arg)
+		}
+	}
+
+	raw, coll := mergeTextualArgs(env, ptrs...)
+	setStringResult(result, bytes.Join(raw[1:], raw[0]), coll)
+}
+
+func (builtinConcatWs) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) < 2 {
+		throwArgError("CONCAT_WS")
+	}
+	return stringType(env, args...), nullFlags(env, args[0])
+}
+
+func (builtinLength) call(_ *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	arg1 := &args[0]
+	if arg1.isNull() {
+		result.setNull()
+		return
+	}
+
+	result.setInt64(int64(len(arg1.toRawBytes())))
+}
+
+func (builtinLength) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 {
+		throwArgError("LENGTH")
+	}
+	return sqltypes.Int64, nullFlags(env, args[0])
+}
+
+func (builtinCharLength) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	arg1 := &args[0]
+	if arg1.isNull() {
+		result.setNull()
+		return
+	}
+
+	raw, coll := textualArg(env, arg1)
+	collation := collations.Local().LookupByID(coll.Collation)
+	result.setInt64(int64(collations.Length(collation, raw)))
+}
+
+func (builtinCharLength) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 {
+		throwArgError("CHAR_LENGTH")
+	}
+	return sqltypes.Int64, nullFlags(env, args[0])
+}
+
+func (c builtinChangeCase) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	arg1 := &args[0]
+	if arg1.isNull() {
+		result.setNull()
+		return
+	}
+
+	raw, coll := textualArg(env, arg1)
+	collation := collations.Local().LookupByID(coll.Collation)
+	if c.upcase {
+		raw = collations.ToUpper(nil, collation, raw)
+	} else {
+		raw = collations.ToLower(nil, collation, raw)
+	}
+	setStringResult(result, raw, coll)
+}
+
+func (c builtinChangeCase) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 {
+		throwArgError(c.name)
+	}
+	return stringType(env, args[0]), nullFlags(env, args[0])
+}
+
+func (builtinReverse) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	arg1 := &args[0]
+	if arg1.isNull() {
+		result.setNull()
+		return
+	}
+
+	raw, coll := textualArg(env, arg1)
+	collation := collations.Local().LookupByID(coll.Collation)
+	reversed := make([]byte, len(raw))
+	for pos := 0; pos < len(raw); {
+		size := charLen(collation, raw[pos:])
+		copy(reversed[len(raw)-pos-size:], raw[pos:pos+size])
+		pos += size
+	}
+	setStringResult(result, reversed, coll)
+}
+
+func (builtinReverse) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 {
+		throwArgError("REVERSE")
+	}
+	return stringType(env, args[0]), nullFlags(env, args[0])
+}
+
+func (builtinRepeat) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	str, count := &args[0], &args[1]
+	if str.isNull() || count.isNull() {
+		result.setNull()
+		return
+	}
+
+	raw, coll := textualArg(env, str)
+	n := integralArg(count)
+	if n < 1 || len(raw) == 0 {
+		setStringResult(result, []byte{}, coll)
+		return
+	}
+	if n > maxStringLength/int64(len(raw)) {
+		result.setNull()
+		return
+	}
+	setStringResult(result, bytes.Repeat(raw, int(n)), coll)
+}
+
+func (builtinRepeat) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 2 {
+		throwArgError("REPEAT")
+	}
+	return stringType(env, args[0]), nullFlags(env, args...) | flagNullable
+}
+
+func (builtinReplace) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	for i := range args {
+		if args[i].isNull() {
+			result.setNull()
+			return
+		}
+	}
+
+	raw, coll := mergeTextualArgs(env, &args[0], &args[1], &args[2])
+	str, from, to := raw[0], raw[1], raw[2]
+	if len(from) == 0 {
+		setStringResult(result, str, coll)
+		return
+	}
+
+	// the search is case-sensitive, but it only matches on character boundaries
+	collation := collations.Local().LookupByID(coll.Collation)
+	replaced := make([]byte, 0, len(str))
+	for pos := 0; pos < len(str); {
+		if bytes.HasPrefix(str[pos:], from) {
+			replaced = append(replaced, to...)
+			pos += len(from)
+			continue
+		}
+		size := charLen(collation, str[pos:])
+		replaced = append(replaced, str[pos:pos+size]...)
+		pos += size
+	}
+	setStringResult(result, replaced, coll)
+}
+
+func (builtinReplace) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 3 {
+		throwArgError("REPLACE")
+	}
+	return stringType(env, args...), nullFlags(env, args...)
+}
+
+func (builtinSubstring) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	for i := range args {
+		if args[i].isNull() {
+			result.setNull()
+			return
+		}
+	}
+
+	raw, coll := textualArg(env, &args[0])
+	collation := collations.Local().LookupByID(coll.Collation)
+	length := int64(collations.Length(collation, raw))
+
+	// positions are 1-based; negative positions count from the end of the string
+	pos := integralArg(&args[1])
+	if pos < 0 {
+		pos += length + 1
+	}
+	if pos < 1 || pos > length {
+		setStringResult(result, []byte{}, coll)
+		return
+	}
+
+	from, to := pos-1, length
+	if len(args) > 2 {
+		size := integralArg(&args[2])
+		if size < 1 {
+			setStringResult(result, []byte{}, coll)
+			return
+		}
+		if size < to-from {
+			to = from + size
+		}
+	}
+	setStringResult(result, collations.Slice(collation, raw, int(from), int(to)), coll)
+}
+
+func (builtinSubstring) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 2 && len(args) != 3 {
+		throwArgError("SUBSTRING")
+	}
+	return stringType(env, args[0]), nullFlags(env, args...)
+}
+
+func (lr builtinLeftRight) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	str, size := &args[0], &args[1]
+	if str.isNull() || size.isNull() {
+		result.setNull()
+		return
+	}
+
+	raw, coll := textualArg(env, str)
+	n := integralArg(size)
+	if n < 1 {
+		setStringResult(result, []byte{}, coll)
+		return
+	}
+
+	collation := collations.Local().LookupByID(coll.Collation)
+	length := int64(collations.Length(collation, raw))
+	if n >= length {
+		setStringResult(result, raw, coll)
+		return
+	}
+	if lr.right {
+		raw = collations.Slice(collation, raw, int(length-n), int(length))
+	} else {
+		raw = collations.Slice(collation, raw, 0, int(n))
+	}
+	setStringResult(result, raw, coll)
+}
+
+func (lr builtinLeftRight) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 2 {
+		throwArgError(lr.name)
+	}
+	return stringType(env, args[0]), nullFlags(env, args...)
+}
+
+func (p builtinPad) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	for i := range args {
+		if args[i].isNull() {
+			result.setNull()
+			return
+		}
+	}
+
+	n := integralArg(&args[1])
+	if n < 0 || n > maxStringLength {
+		result.setNull()
+		return
+	}
+
+	raw, coll := mergeTextualArgs(env, &args[0], &args[2])
+	str, pad := raw[0], raw[1]
+	collation := collations.Local().LookupByID(coll.Collation)
+
+	length := int64(collations.Length(collation, str))
+	if n <= length {
+		setStringResult(result, collations.Slice(collation, str, 0, int(n)), coll)
+		return
+	}
+
+	padLength := int64(collations.Length(collation, pad))
+	if padLength == 0 {
+		result.setNull()
+		return
+	}
+
+	missing := n - length
+	padding := bytes.Repeat(pad, int(missing/padLength))
+	padding = append(padding, collations.Slice(collation, pad, 0, int(missing%padLength))...)
+	if len(padding)+len(str) > maxStringLength {
+		result.setNull()
+		return
+	}
+
+	if p.right {
+		setStringResult(result, append(append([]byte(nil), str...), padding...), coll)
+	} else {
+		setStringResult(result, append(padding, str...), coll)
+	}
+}
+
+func (p builtinPad) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 3 {
+		throwArgError(p.name)
+	}
+	return stringType(env, args[0], args[2]), nullFlags(env, args...) | flagNullable
+}
+
+func (t builtinTrim) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	if len(args) != 1 && (!t.remstr || len(args) != 2) {
+		throwArgError(t.name)
+	}
+	for i := range args {
+		if args[i].isNull() {
+			result.setNull()
+			return
+		}
+	}
+
+	var (
+		str, remove []byte
+		coll        collations.TypedCollation
+	)
+	if len(args) > 1 {
+		raw, merged := mergeTextualArgs(env, &args[0], &args[1])
+		str, remove, coll = raw[0], raw[1], merged
+	} else {
+		str, coll = textualArg(env, &args[0])
+		remove = []byte{' '}
+		if collation := collations.Local().LookupByID(coll.Collation); collation != nil {
+			var space [4]byte
+			if n := collation.Charset().EncodeRune(space[:], ' '); n > 0 {
+				remove = space[:n]
+			}
+		}
+	}
+
+	if len(remove) > 0 {
+		if t.leading {
+			for bytes.HasPrefix(str, remove) {
+				str = str[len(remove):]
+			}
+		}
+		if t.trailing {
+			for bytes.HasSuffix(str, remove) {
+				str = str[:len(str)-len(remove)]
+			}
+		}
+	}
+	setStringResult(result, str, coll)
+}
+
+func (t builtinTrim) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 && (!t.remstr || len(args) != 2) {
+		throwArgError(t.name)
+	}
+	return stringType(env, args...), nullFlags(env, args...)
+}
+
+func (l builtinLocate) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	for i := range args {
+		if args[i].isNull() {
+			result.setNull()
+			return
+		}
+	}
+
+	substrArg, strArg := &args[0], &args[1]
+	if l.instr {
+		substrArg, strArg = strArg, substrArg
+	}
+
+	start := int64(1)
+	if len(args) > 2 {
+		start = integralArg(&args[2])
+		if start < 1 {
+			result.setInt64(0)
+			return
+		}
+	}
+
+	raw, coll := mergeTextualArgs(env, substrArg, strArg)
+	substr, str := raw[0], raw[1]
+	collation := collations.Local().LookupByID(coll.Collation)
+
+	// skip the characters before the starting position
+	var offset int
+	for i := int64(1); i < start; i++ {
+		if offset >= len(str) {
+			result.setInt64(0)
+			return
+		}
+		offset += charLen(collation, str[offset:])
+	}
+	if len(substr) == 0 {
+		result.setInt64(start)
+		return
+	}
+
+	// the search is collation-aware, so it can be case-insensitive
+	for pos := start; offset < len(str); pos++ {
+		if collation.Collate(str[offset:], substr, true) == 0 {
+			result.setInt64(pos)
+			return
+		}
+		offset += charLen(collation, str[offset:])
+	}
+	result.setInt64(0)
+}
+
+func (l builtinLocate) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if l.instr {
+		if len(args) != 2 {
+			throwArgError(l.name)
+		}
+	} else if len(args) != 2 && len(args) != 3 {
+		throwArgError(l.name)
+	}
+	return sqltypes.Int64, nullFlags(env, args...)
+}
@@ -0,0 +1,636 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine/internal/decimal"
+)
+
+type (
+	builtinNow struct {
+		name string
+		utc  bool
+		// sysdate is set for SYSDATE(), which returns the time at which it
+		// executes instead of the time at which the query started
+		sysdate bool
+	}
+
+	builtinCurdate struct {
+		name string
+		utc  bool
+	}
+
+	builtinCurtime struct {
+		name string
+		utc  bool
+	}
+
+	builtinExtract struct {
+		name string
+		unit sqlparser.IntervalTypes
+	}
+
+	builtinDate          struct{}
+	builtinDateFormat    struct{}
+	builtinDatediff      struct{}
+	builtinUnixTimestamp struct{}
+	builtinFromUnixtime  struct{}
+
+	// DateAddExpr adds an interval to a temporal value, as DATE_ADD and DATE_SUB
+	// (or their ADDDATE and SUBDATE aliases) do, or as the `expr + INTERVAL n unit`
+	// and `expr - INTERVAL n unit` operators do
+	DateAddExpr struct {
+		Date, Interval Expr
+		Unit           sqlparser.IntervalTypes
+		Sub            bool
+	}
+
+	// ExtractExpr extracts a part from a temporal value: EXTRACT(unit FROM expr)
+	ExtractExpr struct {
+		UnaryExpr
+		Unit sqlparser.IntervalTypes
+	}
+)
+
+var _ Expr = (*DateAddExpr)(nil)
+var _ Expr = (*ExtractExpr)(nil)
+
+// maxUnixtime is the largest UNIX timestamp supported by FROM_UNIXTIME,
+// which corresponds to '3001-01-18 23:59:59.999999' UTC
+const maxUnixtime = 32536771199
+
+// temporalCollation is the collation for the strings returned by temporal functions
+func temporalCollation(env *ExpressionEnv) collations.TypedCollation {
+	return collations.TypedCollation{
+		Collation:    env.DefaultCollation,
+		Coercibility: collations.CoerceCoercible,
+		Repertoire:   collations.RepertoireASCII,
+	}
+}
+
+// optionalPrecision returns the fractional seconds precision given as the only
+// optional argument to functions such as NOW([fsp])
+func optionalPrecision(fname string, args []EvalResult) uint8 {
+	if len(args) == 0 || args[0].isNull() {
+		return 0
+	}
+	return precisionArg(fname, &args[0])
+}
+
+func (b *builtinNow) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	prec := optionalPrecision(b.name, args)
+	now := env.currentTime()
+	if b.sysdate {
+		now = time.Now().In(env.timeZone())
+	}
+	if b.utc {
+		now = now.UTC()
+	}
+	dt := datetimeFromTime(truncateTime(now, prec), prec)
+	result.setRaw(sqltypes.Datetime, dt.format(), collationNumeric)
+}
+
+func (b *builtinNow) typeof(_ *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) > 1 {
+		throwArgError(b.name)
+	}
+	return sqltypes.Datetime, 0
+}
+
+func (b *builtinNow) volatile() {}
+
+func (b *builtinCurdate) call(env *ExpressionEnv, _ []EvalResult, result *EvalResult) {
+	now := env.currentTime()
+	if b.utc {
+		now = now.UTC()
+	}
+	result.setRaw(sqltypes.Date, appendDate(nil, now), collationNumeric)
+}
+
+func (b *builtinCurdate) typeof(_ *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 0 {
+		throwArgError(b.name)
+	}
+	return sqltypes.Date, 0
+}
+
+func (b *builtinCurdate) volatile() {}
+
+func (b *builtinCurtime) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	prec := optionalPrecision(b.name, args)
+	now := env.currentTime()
+	if b.utc {
+		now = now.UTC()
+	}
+	dt := datetimeFromTime(truncateTime(now, prec), prec)
+	result.setRaw(sqltypes.Time, formatTime(dt.timeOfDay(), prec), collationNumeric)
+}
+
+func (b *builtinCurtime) typeof(_ *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) > 1 {
+		throwArgError(b.name)
+	}
+	return sqltypes.Time, 0
+}
+
+func (b *builtinCurtime) volatile() {}
+
+func (builtinDate) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	arg := &args[0]
+	if arg.isNull() {
+		result.setNull()
+		return
+	}
+	dt, ok := datetimeArg(env, arg)
+	if !ok {
+		result.setNull()
+		return
+	}
+	result.setRaw(sqltypes.Date, appendDate(nil, dt.t), collationNumeric)
+}
+
+func (builtinDate) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 {
+		throwArgError("DATE")
+	}
+	return sqltypes.Date, nullFlags(env, args...) | flagNullable
+}
+
+func (builtinDateFormat) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	date, format := &args[0], &args[1]
+	if date.isNull() || format.isNull() {
+		result.setNull()
+		return
+	}
+	dt, ok := datetimeArg(env, date)
+	if !ok {
+		result.setNull()
+		return
+	}
+	raw, _ := textualArg(env, format)
+	result.setRaw(sqltypes.VarChar, appendDateFormat(nil, dt, raw), temporalCollation(env))
+}
+
+func (builtinDateFormat) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 2 {
+		throwArgError("DATE_FORMAT")
+	}
+	return sqltypes.VarChar, nullFlags(env, args...) | flagNullable
+}
+
+func (builtinDatediff) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	left, right := &args[0], &args[1]
+	if left.isNull() || right.isNull() {
+		result.setNull()
+		return
+	}
+	ldt, lok := datetimeArg(env, left)
+	rdt, rok := datetimeArg(env, right)
+	if !lok || !rok {
+		result.setNull()
+		return
+	}
+	result.setInt64(ldt.daynr() - rdt.daynr())
+}
+
+func (builtinDatediff) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 2 {
+		throwArgError("DATEDIFF")
+	}
+	return sqltypes.Int64, nullFlags(env, args...) | flagNullable
+}
+
+func (builtinUnixTimestamp) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	if len(args) == 0 {
+		result.setInt64(env.currentTime().Unix())
+		return
+	}
+
+	arg := &args[0]
+	if arg.isNull() {
+		result.setNull()
+		return
+	}
+	dt, ok := datetimeArg(env, arg)
+	if !ok {
+		result.setInt64(0)
+		return
+	}
+	ts := dt.unix(env.timeZone())
+	if ts.Unix() < 0 {
+		result.setInt64(0)
+		return
+	}
+	if dt.prec == 0 {
+		result.setInt64(ts.Unix())
+		return
+	}
+	// values with fractional seconds return a DECIMAL with the same precision
+	scale := int64(math.Pow10(int(dt.prec)))
+	frac := int64(ts.Nanosecond()) / (int64(time.Second) / scale)
+	result.setDecimal(decimal.New(ts.Unix()*scale+frac, -int32(dt.prec)), int32(dt.prec))
+}
+
+func (builtinUnixTimestamp) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) > 1 {
+		throwArgError("UNIX_TIMESTAMP")
+	}
+	return sqltypes.Int64, nullFlags(env, args...)
+}
+
+func (builtinUnixTimestamp) volatile() {}
+
+// unixtimeArg converts the argument to FROM_UNIXTIME into seconds and nanoseconds
+// since the UNIX epoch, together with their fractional precision
+func unixtimeArg(arg *EvalResult) (sec int64, nsec int, prec uint8, ok bool) {
+	var s string
+	switch tt := arg.typeof(); {
+	case sqltypes.IsIntegral(tt):
+		arg.makeSignedIntegral()
+		return arg.int64(), 0, 0, arg.int64() >= 0
+	case tt == sqltypes.Decimal:
+		s = numericString(arg)
+	case sqltypes.IsFloat(tt):
+		s = strconv.FormatFloat(arg.float64(), 'f', maxTimePrecision, 64)
+	default:
+		s = strconv.FormatFloat(parseStringToFloat(arg.string()), 'f', maxTimePrecision, 64)
+	}
+
+	digits, frac, _ := cut(s, '.')
+	sec, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil || sec < 0 || digits[0] == '-' {
+		return 0, 0, 0, false
+	}
+	nsec, prec, ok = parseFraction(frac)
+	return sec, nsec, prec, ok
+}
+
+func (builtinFromUnixtime) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	arg := &args[0]
+	if arg.isNull() {
+		result.setNull()
+		return
+	}
+	sec, nsec, prec, ok := unixtimeArg(arg)
+	if !ok || sec > maxUnixtime {
+		result.setNull()
+		return
+	}
+	dt := datetimeFromTime(time.Unix(sec, int64(nsec)).In(env.timeZone()), prec)
+
+	if len(args) == 1 {
+		result.setRaw(sqltypes.Datetime, dt.format(), collationNumeric)
+		return
+	}
+
+	format := &args[1]
+	if format.isNull() {
+		result.setNull()
+		return
+	}
+	raw, _ := textualArg(env, format)
+	result.setRaw(sqltypes.VarChar, appendDateFormat(nil, dt, raw), temporalCollation(env))
+}
+
+func (builtinFromUnixtime) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	switch len(args) {
+	case 1:
+		return sqltypes.Datetime, nullFlags(env, args...) | flagNullable
+	case 2:
+		return sqltypes.VarChar, nullFlags(env, args...) | flagNullable
+	default:
+		throwArgError("FROM_UNIXTIME")
+		return sqltypes.Null, 0
+	}
+}
+
+func (builtinFromUnixtime) volatile() {}
+
+// extract returns the given unit from a temporal value as an integer, following
+// the same rules as MySQL's EXTRACT
+func extract(env *ExpressionEnv, unit sqlparser.IntervalTypes, arg *EvalResult, result *EvalResult) {
+	if arg.isNull() {
+		result.setNull()
+		return
+	}
+
+	if intervalHasDate(unit) {
+		dt, ok := datetimeArg(env, arg)
+		if !ok {
+			result.setNull()
+			return
+		}
+
+		year, month, day := dt.t.Date()
+		hour, min, sec := dt.t.Clock()
+		usec := int64(dt.t.Nanosecond() / 1000)
+		daysecond := int64(day*1000000 + hour*10000 + min*100 + sec)
+
+		var n int64
+		switch unit {
+		case sqlparser.IntervalYear:
+			n = int64(year)
+		case sqlparser.IntervalQuarter:
+			n = int64(month+2) / 3
+		case sqlparser.IntervalMonth:
+			n = int64(month)
+		case sqlparser.IntervalWeek:
+			_, week := calcWeek(dt.t, weekFirstWeekday)
+			n = int64(week)
+		case sqlparser.IntervalDay:
+			n = int64(day)
+		case sqlparser.IntervalYearMonth:
+			n = int64(year*100 + int(month))
+		case sqlparser.IntervalDayHour:
+			n = daysecond / 10000
+		case sqlparser.IntervalDayMinute:
+			n = daysecond / 100
+		case sqlparser.IntervalDaySecond:
+			n = daysecond
+		case sqlparser.IntervalDayMicrosecond:
+			n = daysecond*1000000 + usec
+		}
+		result.setInt64(n)
+		return
+	}
+
+	d, _, ok := timeArg(arg)
+	if !ok {
+		result.setNull()
+		return
+	}
+	sign := int64(1)
+	if d < 0 {
+		sign, d = -1, -d
+	}
+	hour := int64(d / time.Hour)
+	min := int64(d / time.Minute % 60)
+	sec := int64(d / time.Second % 60)
+	usec := int64(d % time.Second / time.Microsecond)
+	hoursecond := hour*10000 + min*100 + sec
+
+	var n int64
+	switch unit {
+	case sqlparser.IntervalHour:
+		n = hour
+	case sqlparser.IntervalMinute:
+		n = min
+	case sqlparser.IntervalSecond:
+		n = sec
+	case sqlparser.IntervalMicrosecond:
+		n = usec
+	case sqlparser.IntervalHourMinute:
+		n = hoursecond / 100
+	case sqlparser.IntervalHourSecond:
+		n = hoursecond
+	case sqlparser.IntervalMinuteSecond:
+		n = min*100 + sec
+	case sqlparser.IntervalHourMicrosecond:
+		n = hoursecond*1000000 + usec
+	case sqlparser.IntervalMinuteMicrosecond:
+		n = (min*100+sec)*1000000 + usec
+	case sqlparser.IntervalSecondMicrosecond:
+		n = sec*1000000 + usec
+	}
+	result.setInt64(sign * n)
+}
+
+func (b *builtinExtract) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	extract(env, b.unit, &args[0], result)
+}
+
+func (b *builtinExtract) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 {
+		throwArgError(b.name)
+	}
+	return sqltypes.Int64, nullFlags(env, args...) | flagNullable
+}
+
+func (e *ExtractExpr) eval(env *ExpressionEnv, result *EvalResult) {
+	var inner EvalResult
+	inner.init(env, e.Inner)
+	extract(env, e.Unit, &inner, result)
+}
+
+func (e *ExtractExpr) typeof(env *ExpressionEnv) (sqltypes.Type, flag) {
+	return sqltypes.Int64, nullFlags(env, e.Inner) | flagNullable
+}
+
+func (d *DateAddExpr) eval(env *ExpressionEnv, result *EvalResult) {
+	var date, amount EvalResult
+	date.init(env, d.Date)
+	amount.init(env, d.Interval)
+	if date.isNull() || amount.isNull() {
+		result.setNull()
+		return
+	}
+
+	iv, ok := intervalArg(d.Unit, &amount)
+	if !ok {
+		result.setNull()
+		return
+	}
+	if d.Sub {
+		iv.months, iv.days, iv.dur = -iv.months, -iv.days, -iv.dur
+	}
+
+	tt := date.typeof()
+	if tt == sqltypes.Time && !intervalHasDate(d.Unit) {
+		dur, prec, ok := timeArg(&date)
+		if !ok {
+			result.setNull()
+			return
+		}
+		dur += iv.dur
+		if dur > maxTime || dur < -maxTime {
+			result.setNull()
+			return
+		}
+		if iv.prec > prec {
+			prec = iv.prec
+		}
+		result.setRaw(sqltypes.Time, formatTime(dur, prec), collationNumeric)
+		return
+	}
+
+	dt, ok := datetimeArg(env, &date)
+	if !ok {
+		result.setNull()
+		return
+	}
+	dt, ok = dt.add(iv)
+	if !ok {
+		result.setNull()
+		return
+	}
+
+	switch {
+	case tt == sqltypes.Date && !intervalHasTime(d.Unit):
+		result.setRaw(sqltypes.Date, dt.format(), collationNumeric)
+	case sqltypes.IsDate(tt):
+		dt.date = false
+		result.setRaw(sqltypes.Datetime, dt.format(), collationNumeric)
+	default:
+		// strings are formatted as DATE only if they had no time part and the
+		// interval has no time part either; fractional seconds are only shown
+		// if they are not zero
+		dt.date = dt.date && !intervalHasTime(d.Unit)
+		dt.prec = 0
+		if dt.t.Nanosecond() != 0 {
+			dt.prec = maxTimePrecision
+		}
+		result.setRaw(sqltypes.VarChar, dt.format(), temporalCollation(env))
+	}
+}
+
+func (d *DateAddExpr) typeof(env *ExpressionEnv) (sqltypes.Type, flag) {
+	tt, _ := d.Date.typeof(env)
+	f := nullFlags(env, d.Date, d.Interval) | flagNullable
+	switch {
+	case tt == sqltypes.Date && !intervalHasTime(d.Unit):
+		return sqltypes.Date, f
+	case tt == sqltypes.Time && !intervalHasDate(d.Unit):
+		return sqltypes.Time, f
+	case sqltypes.IsDate(tt):
+		return sqltypes.Datetime, f
+	default:
+		return sqltypes.VarChar, f
+	}
+}
+
+var (
+	weekdayAbbrev = []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+	monthAbbrev   = []string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"}
+)
+
+// appendDateFormat formats a datetime with the format specifiers supported
+// by MySQL's DATE_FORMAT
+func appendDateFormat(buf []byte, dt datetime, format []byte) []byte {
+	t := dt.t
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+	hour12 := (hour+11)%12 + 1
+
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i == len(format)-1 {
+			buf = append(buf, format[i])
+			continue
+		}
+		i++
+		switch format[i] {
+		case 'a':
+			buf = append(buf, weekdayAbbrev[t.Weekday()]...)
+		case 'b':
+			buf = append(buf, monthAbbrev[month-1]...)
+		case 'c':
+			buf = strconv.AppendInt(buf, int64(month), 10)
+		case 'D':
+			buf = strconv.AppendInt(buf, int64(day), 10)
+			switch {
+			case day >= 11 && day <= 13:
+				buf = append(buf, "th"...)
+			case day%10 == 1:
+				buf = append(buf, "st"...)
+			case day%10 == 2:
+				buf = append(buf, "nd"...)
+			case day%10 == 3:
+				buf = append(buf, "rd"...)
+			default:
+				buf = append(buf, "th"...)
+			}
+		case 'd':
+			buf = appendDigits(buf, day, 2)
+		case 'e':
+			buf = strconv.AppendInt(buf, int64(day), 10)
+		case 'f':
+			buf = appendDigits(buf, t.Nanosecond()/1000, 6)
+		case 'H':
+			buf = appendDigits(buf, hour, 2)
+		case 'h', 'I':
+			buf = appendDigits(buf, hour12, 2)
+		case 'i':
+			buf = appendDigits(buf, min, 2)
+		case 'j':
+			buf = appendDigits(buf, t.YearDay(), 3)
+		case 'k':
+			buf = strconv.AppendInt(buf, int64(hour), 10)
+		case 'l':
+			buf = strconv.AppendInt(buf, int64(hour12), 10)
+		case 'M':
+			buf = append(buf, month.String()...)
+		case 'm':
+			buf = appendDigits(buf, int(month), 2)
+		case 'p':
+			if hour < 12 {
+				buf = append(buf, "AM"...)
+			} else {
+				buf = append(buf, "PM"...)
+			}
+		case 'r':
+			buf = appendDigits(buf, hour12, 2)
+			buf = append(buf, ':')
+			buf = appendDigits(buf, min, 2)
+			buf = append(buf, ':')
+			buf = appendDigits(buf, sec, 2)
+			if hour < 12 {
+				buf = append(buf, " AM"...)
+			} else {
+				buf = append(buf, " PM"...)
+			}
+		case 'S', 's':
+			buf = appendDigits(buf, sec, 2)
+		case 'T':
+			buf = appendClock(buf, t, 0)
+		case 'U':
+			_, week := calcWeek(t, weekFirstWeekday)
+			buf = appendDigits(buf, week, 2)
+		case 'u':
+			_, week := calcWeek(t, weekMondayFirst)
+			buf = appendDigits(buf, week, 2)
+		case 'V':
+			_, week := calcWeek(t, weekYear|weekFirstWeekday)
+			buf = appendDigits(buf, week, 2)
+		case 'v':
+			_, week := calcWeek(t, weekYear|weekMondayFirst)
+			buf = appendDigits(buf, week, 2)
+		case 'W':
+			buf = append(buf, t.Weekday().String()...)
+		case 'w':
+			buf = strconv.AppendInt(buf, int64(t.Weekday()), 10)
+		case 'X':
+			wyear, _ := calcWeek(t, weekYear|weekFirstWeekday)
+			buf = appendDigits(buf, wyear, 4)
+		case 'x':
+			wyear, _ := calcWeek(t, weekYear|weekMondayFirst)
+			buf = appendDigits(buf, wyear, 4)
+		case 'Y':
+			buf = appendDigits(buf, year, 4)
+		case 'y':
+			buf = appendDigits(buf, year%100, 2)
+		default:
+			buf = append(buf, format[i])
+		}
+	}
+	return buf
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func TestParseTimeZone(t *testing.T) {
+	tcases := []struct {
+		tz     string
+		offset int
+		err    bool
+	}{
+		{tz: "+00:00", offset: 0},
+		{tz: "-05:30", offset: -(5*3600 + 30*60)},
+		{tz: "+13:00", offset: 13 * 3600},
+		{tz: "+14:00", offset: 14 * 3600},
+		{tz: "-14:00", err: true},
+		{tz: "+15:00", err: true},
+		{tz: "UTC", offset: 0},
+		{tz: "Not/A_Zone", err: true},
+	}
+	ref := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, tcase := range tcases {
+		t.Run(tcase.tz, func(t *testing.T) {
+			loc, err := ParseTimeZone(tcase.tz)
+			if tcase.err {
+				assert.EqualError(t, err, "Unknown or incorrect time zone: '"+tcase.tz+"'")
+				return
+			}
+			require.NoError(t, err)
+			_, offset := ref.In(loc).Zone()
+			assert.Equal(t, tcase.offset, offset)
+		})
+	}
+
+	loc, err := ParseTimeZone("SYSTEM")
+	require.NoError(t, err)
+	assert.Equal(t, systemTimeZone, loc)
+}
+
+func TestTimeZoneFunctions(t *testing.T) {
+	now := time.Date(2021, 3, 4, 23, 30, 15, 123456000, time.UTC)
+	tz, err := ParseTimeZone("+02:00")
+	require.NoError(t, err)
+
+	tcases := []struct {
+		expr, utc, local string
+	}{
+		{expr: "now()", utc: "2021-03-04 23:30:15", local: "2021-03-05 01:30:15"},
+		{expr: "now(3)", utc: "2021-03-04 23:30:15.123", local: "2021-03-05 01:30:15.123"},
+		{expr: "utc_timestamp()", utc: "2021-03-04 23:30:15", local: "2021-03-04 23:30:15"},
+		{expr: "curdate()", utc: "2021-03-04", local: "2021-03-05"},
+		{expr: "current_time", utc: "23:30:15", local: "01:30:15"},
+		{expr: "unix_timestamp()", utc: "1614900615", local: "1614900615"},
+		{expr: "unix_timestamp('2021-03-05 01:30:15')", utc: "1614907815", local: "1614900615"},
+		{expr: "from_unixtime(1614900615)", utc: "2021-03-04 23:30:15", local: "2021-03-05 01:30:15"},
+		{expr: "date_add(now(), interval 1 day)", utc: "2021-03-05 23:30:15", local: "2021-03-06 01:30:15"},
+		{expr: "now() = '2021-03-05 01:30:15'", utc: "0", local: "1"},
+	}
+	for _, tcase := range tcases {
+		t.Run(tcase.expr, func(t *testing.T) {
+			stmt, err := sqlparser.Parse("select " + tcase.expr)
+			require.NoError(t, err)
+			astExpr := stmt.(*sqlparser.Select).SelectExprs[0].(*sqlparser.AliasedExpr).Expr
+			expr, err := Translate(astExpr, LookupDefaultCollation(45))
+			require.NoError(t, err)
+
+			for _, loc := range []*time.Location{time.UTC, tz} {
+				env := EnvWithBindVars(nil, 45)
+				env.TimeZone = loc
+				env.now = now
+				r, err := env.Evaluate(expr)
+				require.NoError(t, err)
+
+				want := tcase.utc
+				if loc != time.UTC {
+					want = tcase.local
+				}
+				assert.Equal(t, want, r.Value().ToString(), "time_zone=%s", loc)
+			}
+		})
+	}
+}
+
+func TestSystemTimeZone(t *testing.T) {
+	defer func(loc *time.Location) { systemTimeZone = loc }(systemTimeZone)
+
+	assert.EqualError(t, SetSystemTimeZone("SYSTEM"), "Unknown or incorrect time zone: 'SYSTEM'")
+	assert.EqualError(t, SetSystemTimeZone("Not/A_Zone"), "Unknown or incorrect time zone: 'Not/A_Zone'")
+	require.NoError(t, SetSystemTimeZone("+05:00"))
+
+	stmt, err := sqlparser.Parse("select from_unixtime(0)")
+	require.NoError(t, err)
+	expr, err := Translate(stmt.(*sqlparser.Select).SelectExprs[0].(*sqlparser.AliasedExpr).Expr, LookupDefaultCollation(45))
+	require.NoError(t, err)
+
+	// sessions without a time zone use the time zone of the MySQL servers
+	r, err := EnvWithBindVars(nil, 45).Evaluate(expr)
+	require.NoError(t, err)
+	assert.Equal(t, "1970-01-01 05:00:00", r.Value().ToString())
+
+	// and so do the sessions whose time zone is SYSTEM
+	env := EnvWithBindVars(nil, 45)
+	env.TimeZone, err = ParseTimeZone("SYSTEM")
+	require.NoError(t, err)
+	r, err = env.Evaluate(expr)
+	require.NoError(t, err)
+	assert.Equal(t, "1970-01-01 05:00:00", r.Value().ToString())
+}
+
+func TestTimePrecision(t *testing.T) {
+	stmt, err := sqlparser.Parse("select now(7)")
+	require.NoError(t, err)
+	expr, err := Translate(stmt.(*sqlparser.Select).SelectExprs[0].(*sqlparser.AliasedExpr).Expr, LookupDefaultCollation(45))
+	require.NoError(t, err)
+
+	_, err = EnvWithBindVars(nil, 45).Evaluate(expr)
+	assert.EqualError(t, err, "Too-big precision 7 specified for 'NOW'. Maximum is 6.")
+
+	stmt, err = sqlparser.Parse("select cast('10:00:00' as time(7))")
+	require.NoError(t, err)
+	_, err = Translate(stmt.(*sqlparser.Select).SelectExprs[0].(*sqlparser.AliasedExpr).Expr, LookupDefaultCollation(45))
+	assert.EqualError(t, err, "Too-big precision 7 specified for ''10:00:00''. Maximum is 6.")
+}
@@ -160,6 +160,35 @@ func translateIsExpr(left sqlparser.Expr, op sqlparser.IsExprOperator, lookup Tr
 	}, nil
 }
 
+func translateCaseExpr(node *sqlparser.CaseExpr, lookup TranslationLookup) (Expr, error) {
+	var result CaseExpr
+	for _, when := range node.Whens {
+		var cond Expr
+		var err error
+		if node.Expr != nil {
+			cond, err = translateComparisonExpr(sqlparser.EqualOp, node.Expr, when.Cond, lookup)
+		} else {
+			cond, err = translateExpr(when.Cond, lookup)
+		}
+		if err != nil {
+			return nil, err
+		}
+		val, err := translateExpr(when.Val, lookup)
+		if err != nil {
+			return nil, err
+		}
+		result.Cases = append(result.Cases, WhenThen{When: cond, Then: val})
+	}
+	if node.Else != nil {
+		var err error
+		result.Else, err = translateExpr(node.Else, lookup)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &result, nil
+}
+
 func getCollation(expr sqlparser.Expr, lookup TranslationLookup) collations.TypedCollation {
 	collation := collations.TypedCollation{
 		Coercibility: collations.CoerceCoercible,
@@ -209,6 +238,18 @@ func translateLiteral(lit *sqlparser.Literal, lookup TranslationLookup) (*Litera
 }
 
 func translateBinaryExpr(binary *sqlparser.BinaryExpr, lookup TranslationLookup) (Expr, error) {
+	// `expr + INTERVAL n unit` and `expr - INTERVAL n unit` are temporal arithmetic,
+	// and so is `INTERVAL n unit + expr`
+	switch binary.Operator {
+	case sqlparser.PlusOp, sqlparser.MinusOp:
+		if interval, ok := binary.Right.(*sqlparser.IntervalExpr); ok {
+			return translateDateAdd(binary.Left, interval, binary.Operator == sqlparser.MinusOp, lookup)
+		}
+		if interval, ok := binary.Left.(*sqlparser.IntervalExpr); ok && binary.Operator == sqlparser.PlusOp {
+			return translateDateAdd(binary.Right, interval, false, lookup)
+		}
+	}
+
 	left, err := translateExpr(binary.Left, lookup)
 	if err != nil {
 		return nil, err
@@ -241,11 +282,35 @@ func translateBinaryExpr(binary *sqlparser.BinaryExpr, lookup TranslationLookup)
 		return &BitwiseExpr{BinaryExpr: binaryExpr, Op: &OpBitShiftLeft{}}, nil
 	case sqlparser.ShiftRightOp:
 		return &BitwiseExpr{BinaryExpr: binaryExpr, Op: &OpBitShiftRight{}}, nil
+	case sqlparser.JSONExtractOp:
+		return translateJSONExtract(left, right, false), nil
+	case sqlparser.JSONUnquoteExtractOp:
+		return translateJSONExtract(left, right, true), nil
 	default:
 		return nil, translateExprNotSupported(binary)
 	}
 }
 
+// translateJSONExtract translates the `column->path` operator, which is a shorthand for
+// JSON_EXTRACT(column, path), and `column->>path`, which also unquotes the result
+func translateJSONExtract(doc, path Expr, unquote bool) Expr {
+	var expr Expr = &CallExpr{
+		Arguments: TupleExpr{doc, path},
+		Aliases:   make([]sqlparser.ColIdent, 2),
+		Method:    "json_extract",
+		F:         builtinJSONExtract{},
+	}
+	if unquote {
+		expr = &CallExpr{
+			Arguments: TupleExpr{expr},
+			Aliases:   make([]sqlparser.ColIdent, 1),
+			Method:    "json_unquote",
+			F:         builtinJSONUnquote{},
+		}
+	}
+	return expr
+}
+
 func translateTuple(tuple sqlparser.ValTuple, lookup TranslationLookup) (Expr, error) {
 	var exprs TupleExpr
 	for _, expr := range tuple {
@@ -318,6 +383,11 @@ func translateIntroducerExpr(introduced *sqlparser.IntroducerExpr, lookup Transl
 }
 
 func translateFuncExpr(fn *sqlparser.FuncExpr, lookup TranslationLookup) (Expr, error) {
+	switch method := fn.Name.Lowered(); method {
+	case "date_add", "date_sub", "adddate", "subdate":
+		return translateDateAddFuncExpr(fn, method, lookup)
+	}
+
 	var args TupleExpr
 	var aliases []sqlparser.ColIdent
 	for _, expr := range fn.Exprs {
@@ -351,6 +421,137 @@ func translateFuncExpr(fn *sqlparser.FuncExpr, lookup TranslationLookup) (Expr,
 	return nil, translateExprNotSupported(fn)
 }
 
+// translateDateAddFuncExpr translates DATE_ADD(date, INTERVAL expr unit) and DATE_SUB,
+// as well as their ADDDATE and SUBDATE aliases, which also accept a number of days
+// instead of an INTERVAL expression
+func translateDateAddFuncExpr(fn *sqlparser.FuncExpr, method string, lookup TranslationLookup) (Expr, error) {
+	var args []sqlparser.Expr
+	for _, expr := range fn.Exprs {
+		aliased, ok := expr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, translateExprNotSupported(fn)
+		}
+		args = append(args, aliased.Expr)
+	}
+	if len(args) != 2 {
+		return nil, argError(strings.ToUpper(method))
+	}
+
+	sub := method == "date_sub" || method == "subdate"
+	interval, ok := args[1].(*sqlparser.IntervalExpr)
+	if !ok {
+		if method != "adddate" && method != "subdate" {
+			return nil, translateExprNotSupported(fn)
+		}
+		interval = &sqlparser.IntervalExpr{Expr: args[1], Unit: sqlparser.DayStr}
+	}
+	return translateDateAdd(args[0], interval, sub, lookup)
+}
+
+func translateDateAdd(date sqlparser.Expr, interval *sqlparser.IntervalExpr, sub bool, lookup TranslationLookup) (Expr, error) {
+	unit, ok := parseIntervalUnit(interval.Unit)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported INTERVAL unit: %s", interval.Unit)
+	}
+	left, err := translateExpr(date, lookup)
+	if err != nil {
+		return nil, err
+	}
+	right, err := translateExpr(interval.Expr, lookup)
+	if err != nil {
+		return nil, err
+	}
+	return &DateAddExpr{Date: left, Interval: right, Unit: unit, Sub: sub}, nil
+}
+
+func translateCurTimeFuncExpr(fn *sqlparser.CurTimeFuncExpr, lookup TranslationLookup) (Expr, error) {
+	var args TupleExpr
+	if fn.Fsp != nil {
+		fsp, err := translateLiteral(fn.Fsp, lookup)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, fsp)
+	}
+
+	method := fn.Name.Lowered()
+	call, ok := builtinFunctions[method]
+	if !ok {
+		return nil, translateExprNotSupported(fn)
+	}
+	return &CallExpr{
+		Arguments: args,
+		Aliases:   make([]sqlparser.ColIdent, len(args)),
+		Method:    method,
+		F:         call,
+	}, nil
+}
+
+func translateExtractFuncExpr(extract *sqlparser.ExtractFuncExpr, lookup TranslationLookup) (Expr, error) {
+	inner, err := translateExpr(extract.Expr, lookup)
+	if err != nil {
+		return nil, err
+	}
+	return &ExtractExpr{UnaryExpr: UnaryExpr{inner}, Unit: extract.IntervalTypes}, nil
+}
+
+func translateSubstrExpr(substr *sqlparser.SubstrExpr, lookup TranslationLookup) (Expr, error) {
+	var args TupleExpr
+	for _, expr := range []sqlparser.Expr{substr.Name, substr.From, substr.To} {
+		if expr == nil {
+			continue
+		}
+		convertedExpr, err := translateExpr(expr, lookup)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, convertedExpr)
+	}
+
+	return &CallExpr{
+		Arguments: args,
+		Aliases:   make([]sqlparser.ColIdent, len(args)),
+		Method:    "substr",
+		F:         builtinFunctions["substr"],
+	}, nil
+}
+
+func translateTrimFuncExpr(trim *sqlparser.TrimFuncExpr, lookup TranslationLookup) (Expr, error) {
+	var method string
+	switch {
+	case trim.TrimFuncType == sqlparser.LTrimType || trim.Type == sqlparser.LeadingTrimType:
+		method = "ltrim"
+	case trim.TrimFuncType == sqlparser.RTrimType || trim.Type == sqlparser.TrailingTrimType:
+		method = "rtrim"
+	default:
+		method = "trim"
+	}
+
+	var args TupleExpr
+	for _, expr := range []sqlparser.Expr{trim.StringArg, trim.TrimArg} {
+		if expr == nil {
+			continue
+		}
+		convertedExpr, err := translateExpr(expr, lookup)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, convertedExpr)
+	}
+
+	// only the TRIM([{BOTH | LEADING | TRAILING} remstr FROM] str) syntax
+	// takes the string to remove: LTRIM and RTRIM take a single argument
+	f := builtinFunctions[method].(builtinTrim)
+	f.remstr = true
+
+	return &CallExpr{
+		Arguments: args,
+		Aliases:   make([]sqlparser.ColIdent, len(args)),
+		Method:    method,
+		F:         f,
+	}, nil
+}
+
 func translateIntegral(lit *sqlparser.Literal, lookup TranslationLookup) (int, bool, error) {
 	if lit == nil {
 		return 0, false, nil
@@ -458,6 +659,12 @@ func translateConvertExpr(expr *sqlparser.ConvertExpr, lookup TranslationLookup)
 				"Too big scale %d specified for column '%s'. Maximum is %d.",
 				convert.Scale, sqlparser.String(expr.Expr), decimal.MyMaxScale)
 		}
+	case "DATETIME", "TIME":
+		if convert.Length > maxTimePrecision {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT,
+				"Too-big precision %d specified for '%s'. Maximum is %d.",
+				convert.Length, sqlparser.String(expr.Expr), maxTimePrecision)
+		}
 	case "NCHAR":
 		convert.Collation = collations.CollationUtf8ID
 	case "CHAR":
@@ -534,16 +741,26 @@ func translateExpr(e sqlparser.Expr, lookup TranslationLookup) (Expr, error) {
 		return translateIntroducerExpr(node, lookup)
 	case *sqlparser.IsExpr:
 		return translateIsExpr(node.Left, node.Right, lookup)
+	case *sqlparser.CaseExpr:
+		return translateCaseExpr(node, lookup)
 	case *sqlparser.FuncExpr:
 		return translateFuncExpr(node, lookup)
 	case *sqlparser.WeightStringFuncExpr:
 		return translateWeightStringFuncExpr(node, lookup)
+	case *sqlparser.SubstrExpr:
+		return translateSubstrExpr(node, lookup)
+	case *sqlparser.TrimFuncExpr:
+		return translateTrimFuncExpr(node, lookup)
 	case *sqlparser.UnaryExpr:
 		return translateUnaryExpr(node, lookup)
 	case *sqlparser.ConvertExpr:
 		return translateConvertExpr(node, lookup)
 	case *sqlparser.ConvertUsingExpr:
 		return translateConvertUsingExpr(node, lookup)
+	case *sqlparser.CurTimeFuncExpr:
+		return translateCurTimeFuncExpr(node, lookup)
+	case *sqlparser.ExtractFuncExpr:
+		return translateExtractFuncExpr(node, lookup)
 	default:
 		return nil, translateExprNotSupported(e)
 	}
@@ -87,7 +87,16 @@ func TestTranslateSimplification(t *testing.T) {
 		{"coalesce(NULL, NULL)", ok("COALESCE(NULL, NULL)"), ok("NULL")},
 		{"coalesce(NULL)", ok("COALESCE(NULL)"), ok("NULL")},
 		{"weight_string('foobar')", ok(`WEIGHT_STRING(VARCHAR("foobar"))`), ok(`VARBINARY("\x00F\x00O\x00O\x00B\x00A\x00R")`)},
+		{"substring('foobar', 2, 3)", ok(`SUBSTR(VARCHAR("foobar"), INT64(2), INT64(3))`), ok(`VARCHAR("oob")`)},
+		{"trim(leading 'x' from 'xxbarxx')", ok(`LTRIM(VARCHAR("xxbarxx"), VARCHAR("x"))`), ok(`VARCHAR("barxx")`)},
+		{"`ltrim`('xxbarxx', 'x')", ok(`LTRIM(VARCHAR("xxbarxx"), VARCHAR("x"))`), err("Incorrect parameter count in the call to native function 'LTRIM'")},
+		{"concat('foo', NULL, 'bar')", ok(`CONCAT(VARCHAR("foo"), NULL, VARCHAR("bar"))`), ok(`NULL`)},
 		{"weight_string('foobar' as char(12))", ok(`WEIGHT_STRING(VARCHAR("foobar") AS CHAR(12))`), ok(`VARBINARY("\x00F\x00O\x00O\x00B\x00A\x00R\x00 \x00 \x00 \x00 \x00 \x00 ")`)},
+		{"date_add('2021-01-31', interval 1 month)", ok(`DATE_ADD(VARCHAR("2021-01-31"), INTERVAL INT64(1) MONTH)`), ok(`VARCHAR("2021-02-28")`)},
+		{"'2021-01-01' - interval 1 week", ok(`DATE_SUB(VARCHAR("2021-01-01"), INTERVAL INT64(1) WEEK)`), ok(`VARCHAR("2020-12-25")`)},
+		{"extract(year_month from '2021-03-04 13:05:09')", ok(`EXTRACT(YEAR_MONTH FROM VARCHAR("2021-03-04 13:05:09"))`), ok(`INT64(202103)`)},
+		{"now(3)", ok(`NOW(INT64(3))`), ok(`NOW(INT64(3))`)},
+		{"date_add(now(), interval 1 day)", ok(`DATE_ADD(NOW(), INTERVAL INT64(1) DAY)`), ok(`DATE_ADD(NOW(), INTERVAL INT64(1) DAY)`)},
 	}
 
 	for _, tc := range testCases {
@@ -263,6 +272,171 @@ func TestEvaluate(t *testing.T) {
 	}, {
 		expression: "false is not false",
 		expected:   False,
+	}, {
+		expression: "concat('foo', 42, :string_bind_variable)",
+		expected:   sqltypes.NewVarChar("foo42bar"),
+	}, {
+		expression: "concat_ws('-', 'foo', null, :string_bind_variable)",
+		expected:   sqltypes.NewVarChar("foo-bar"),
+	}, {
+		expression: "concat(_binary'foo', 'bar')",
+		expected:   sqltypes.NewVarBinary("foobar"),
+	}, {
+		expression: "length('ñandú')",
+		expected:   sqltypes.NewInt64(7),
+	}, {
+		expression: "char_length('ñandú')",
+		expected:   sqltypes.NewInt64(5),
+	}, {
+		expression: "upper('ñandú')",
+		expected:   sqltypes.NewVarChar("ÑANDÚ"),
+	}, {
+		expression: "lower(_binary'FOO')",
+		expected:   sqltypes.NewVarBinary("FOO"),
+	}, {
+		expression: "substring('日本語テスト', -4, 2)",
+		expected:   sqltypes.NewVarChar("語テ"),
+	}, {
+		expression: "substring('foobar' from 0)",
+		expected:   sqltypes.NewVarChar(""),
+	}, {
+		expression: "mid('foobar', 3)",
+		expected:   sqltypes.NewVarChar("obar"),
+	}, {
+		expression: "left('日本語', 2)",
+		expected:   sqltypes.NewVarChar("日本"),
+	}, {
+		expression: "right('日本語', 2)",
+		expected:   sqltypes.NewVarChar("本語"),
+	}, {
+		expression: "lpad('hi', 5, 'ab')",
+		expected:   sqltypes.NewVarChar("abahi"),
+	}, {
+		expression: "rpad('hello', 2, 'ab')",
+		expected:   sqltypes.NewVarChar("he"),
+	}, {
+		expression: "lpad('hi', 5, '')",
+		expected:   NULL,
+	}, {
+		expression: "replace('www.mysql.com', 'w', 'Ww')",
+		expected:   sqltypes.NewVarChar("WwWwWw.mysql.com"),
+	}, {
+		expression: "repeat('ab', 3)",
+		expected:   sqltypes.NewVarChar("ababab"),
+	}, {
+		expression: "reverse('日本語')",
+		expected:   sqltypes.NewVarChar("語本日"),
+	}, {
+		expression: "locate('BAR', 'foobarbar')",
+		expected:   sqltypes.NewInt64(4),
+	}, {
+		expression: "locate('BAR', 'foobarbar' collate utf8mb4_bin)",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "locate('bar', 'foobarbar', 5)",
+		expected:   sqltypes.NewInt64(7),
+	}, {
+		expression: "instr('foobarbar', 'bar')",
+		expected:   sqltypes.NewInt64(4),
+	}, {
+		expression: "trim('  bar  ')",
+		expected:   sqltypes.NewVarChar("bar"),
+	}, {
+		expression: "ltrim('  bar  ')",
+		expected:   sqltypes.NewVarChar("bar  "),
+	}, {
+		expression: "trim(trailing 'xyz' from 'barxxyz')",
+		expected:   sqltypes.NewVarChar("barx"),
+	}, {
+		expression: "date_add('2020-02-29 10:00:00', interval 1 year)",
+		expected:   sqltypes.NewVarChar("2021-02-28 10:00:00"),
+	}, {
+		expression: "date_sub('2021-01-01', interval '1 1:1:1' day_second)",
+		expected:   sqltypes.NewVarChar("2020-12-30 22:58:59"),
+	}, {
+		expression: "date_add('2021-01-01', interval 1.5 second)",
+		expected:   sqltypes.NewVarChar("2021-01-01 00:00:01.500000"),
+	}, {
+		expression: "adddate('2021-01-01', 31)",
+		expected:   sqltypes.NewVarChar("2021-02-01"),
+	}, {
+		expression: "date_add(cast('2021-01-01' as date), interval 1 day)",
+		expected:   sqltypes.MakeTrusted(sqltypes.Date, []byte("2021-01-02")),
+	}, {
+		expression: "date_add(cast('10:00:00' as time), interval 90 minute)",
+		expected:   sqltypes.MakeTrusted(sqltypes.Time, []byte("11:30:00")),
+	}, {
+		expression: "date_add('9999-12-31', interval 1 day)",
+		expected:   NULL,
+	}, {
+		expression: "date_format('2021-03-04 13:05:09.123', '%W %D %M %Y %r %f')",
+		expected:   sqltypes.NewVarChar("Thursday 4th March 2021 01:05:09 PM 123000"),
+	}, {
+		expression: "date_format('2021-01-01', '%U %u %V %v %X %x')",
+		expected:   sqltypes.NewVarChar("00 00 52 53 2020 2020"),
+	}, {
+		expression: "datediff('2021-03-01', '2021-02-01 23:59:59')",
+		expected:   sqltypes.NewInt64(28),
+	}, {
+		expression: "extract(hour from cast('-30:10:00' as time))",
+		expected:   sqltypes.NewInt64(-30),
+	}, {
+		expression: "month(20210304)",
+		expected:   sqltypes.NewInt64(3),
+	}, {
+		expression: "cast('2021-03-04 13:05:09.567' as datetime(2))",
+		expected:   sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2021-03-04 13:05:09.56")),
+	}, {
+		expression: "cast('1 10:00:00' as time)",
+		expected:   sqltypes.MakeTrusted(sqltypes.Time, []byte("34:00:00")),
+	}, {
+		expression: "cast('2021-03-04' as date) = 20210304",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "cast('2021-03-04' as date) < '2021-03-05'",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "md5('abc')",
+		expected:   sqltypes.NewVarChar("900150983cd24fb0d6963f7d28e17f72"),
+	}, {
+		expression: "md5(1)",
+		expected:   sqltypes.NewVarChar("c4ca4238a0b923820dcc509a6f75849b"),
+	}, {
+		expression: "md5(null)",
+		expected:   NULL,
+	}, {
+		expression: "sha1('abc')",
+		expected:   sqltypes.NewVarChar("a9993e364706816aba3e25717850c26c9cd0d89d"),
+	}, {
+		expression: "sha2('abc', 256)",
+		expected:   sqltypes.NewVarChar("ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"),
+	}, {
+		expression: "sha2('abc', 0)",
+		expected:   sqltypes.NewVarChar("ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"),
+	}, {
+		expression: "sha2('abc', 224)",
+		expected:   sqltypes.NewVarChar("23097d223405d8228642a477bda255b32aadbce4bda0b3f7e36c9da7"),
+	}, {
+		expression: "sha2('abc', 1)",
+		expected:   NULL,
+	}, {
+		expression: "case when 1 = 2 then 'a' when 2 = 2 then 'b' else 'c' end",
+		expected:   sqltypes.NewVarChar("b"),
+	}, {
+		expression: "case :exp when 66 then 'x' else 'y' end",
+		expected:   sqltypes.NewVarChar("x"),
+	}, {
+		expression: "case :exp when 1 then 'x' end",
+		expected:   NULL,
+	}, {
+		expression: "if(:exp > 2, 'a', 'b')",
+		expected:   sqltypes.NewVarChar("a"),
+	}, {
+		expression: "if(null, 'a', 'b')",
+		expected:   sqltypes.NewVarChar("b"),
+	}, {
+		expression: "ifnull(null, 42)",
+		expected:   sqltypes.NewInt64(42),
 	}}
 
 	for _, test := range tests {
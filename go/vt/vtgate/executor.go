@@ -106,6 +106,13 @@ type Executor struct {
 
 	// allowScatter will fail planning if set to false and a plan contains any scatter queries
 	allowScatter bool
+
+	// resultCache caches the results of read-only queries, when it is enabled
+	resultCache *ResultCache
+
+	// workloadManager limits the concurrency of the resource groups, when
+	// it is configured
+	workloadManager *WorkloadManager
 }
 
 var executorOnce sync.Once
@@ -1285,6 +1292,9 @@ func (e *Executor) SaveVSchema(vschema *vindexes.VSchema, stats *VSchemaStats) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 	if vschema != nil {
+		if e.vschema != nil {
+			vschema.QueryRules.Inherit(e.vschema.QueryRules)
+		}
 		e.vschema = vschema
 	}
 	e.vschemaStats = stats
@@ -1387,6 +1397,10 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 	plan.Warnings = vcursor.warnings
 	vcursor.warnings = nil
 
+	if e.resultCache != nil {
+		plan.ResultCacheTTL, plan.ResultCacheTables = e.resultCache.TTL(statement, bindVarNeeds, vcursor)
+	}
+
 	if qo.cachePlan() && sqlparser.CachePlan(statement) {
 		e.plans.Set(planKey, plan)
 	}
@@ -1394,6 +1408,12 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 	return e.checkThatPlanIsValid(stmt, plan)
 }
 
+// planPrepareStmt plans a statement prepared with PREPARE. The plan is
+// cached like the one of the statement executed directly would be.
+func (e *Executor) planPrepareStmt(vcursor *vcursorImpl, query string, bindVars map[string]*querypb.BindVariable) (*engine.Plan, error) {
+	return e.getPlan(vcursor, query, sqlparser.MarginComments{}, bindVars, vcursor.safeSession, nil)
+}
+
 func (e *Executor) canNormalizeStatement(stmt sqlparser.Statement, qo iQueryOption, setVarComment string) bool {
 	return (e.normalize && sqlparser.CanNormalize(stmt)) ||
 		sqlparser.MustRewriteAST(stmt, qo.getSelectLimit() > 0) || setVarComment != ""
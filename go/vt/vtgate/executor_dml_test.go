@@ -18,6 +18,7 @@ package vtgate
 
 import (
 	"context"
+	"io"
 	"reflect"
 	"strings"
 	"testing"
@@ -286,6 +287,68 @@ func TestUpdateMultiOwned(t *testing.T) {
 	assertQueries(t, sbclookup, wantQueries)
 }
 
+func TestUpdatePrimaryVindex(t *testing.T) {
+	executor, sbc1, sbc2, sbclookup := createExecutorEnv()
+
+	sbc1.SetResults([]*sqltypes.Result{
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("id|name|lastname|user_index", "int64|int32|varchar|int64"),
+			"1|1|foo|0",
+		),
+		{RowsAffected: 1},
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("id|name|lastname", "int64|int32|varchar"),
+			"3|1|foo",
+		),
+	})
+	qr, err := executorExec(executor, "update user2 set id = 3 where id = 1", nil)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, qr.RowsAffected)
+
+	// The row is updated in -20, then moved to the shard of its new keyspace id.
+	wantQueries := []*querypb.BoundQuery{{
+		Sql:           "select id, `name`, lastname, id = 3 from user2 where id = 1 for update",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "update user2 set id = 3 where id = 1",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "select * from user2 where id = 3 for update",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "delete from user2 where id = 3",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	assertQueries(t, sbc1, wantQueries)
+	wantQueries = []*querypb.BoundQuery{{
+		Sql: "insert into user2(id, `name`, lastname) values (:_c0_0, :_c0_1, :_c0_2)",
+		BindVariables: map[string]*querypb.BindVariable{
+			"_c0_0": sqltypes.Int64BindVariable(3),
+			"_c0_1": sqltypes.Int32BindVariable(1),
+			"_c0_2": sqltypes.StringBindVariable("foo"),
+		},
+	}}
+	assertQueries(t, sbc2, wantQueries)
+
+	// The lookup vindex entry points to the new keyspace id.
+	wantQueries = []*querypb.BoundQuery{{
+		Sql: "delete from name_lastname_keyspace_id_map where `name` = :name and lastname = :lastname and keyspace_id = :keyspace_id",
+		BindVariables: map[string]*querypb.BindVariable{
+			"name":        sqltypes.Int32BindVariable(1),
+			"lastname":    sqltypes.StringBindVariable("foo"),
+			"keyspace_id": sqltypes.BytesBindVariable([]byte("\x16k@\xb4J\xbaK\xd6")),
+		},
+	}, {
+		Sql: "insert into name_lastname_keyspace_id_map(`name`, lastname, keyspace_id) values (:name_0, :lastname_0, :keyspace_id_0)",
+		BindVariables: map[string]*querypb.BindVariable{
+			"name_0":        sqltypes.Int32BindVariable(1),
+			"lastname_0":    sqltypes.StringBindVariable("foo"),
+			"keyspace_id_0": sqltypes.BytesBindVariable([]byte("N\xb1\x90ɢ\xfa\x16\x9c")),
+		},
+	}}
+	assertQueries(t, sbclookup, wantQueries)
+}
+
 func TestUpdateComments(t *testing.T) {
 	executor, sbc1, sbc2, _ := createExecutorEnv()
 
@@ -472,6 +535,51 @@ func TestDeleteScatter(t *testing.T) {
 	assertQueries(t, sbc2, wantQueries)
 }
 
+func TestDeleteScatterLimit(t *testing.T) {
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+	// All the other shards return a row with user_id 1, which lives in -20.
+	sbc1.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("user_id", "int64"), "1")})
+	sbc2.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("user_id", "int64"), "3")})
+	_, err := executorExec(executor, "delete from user_extra limit 10", nil)
+	require.NoError(t, err)
+	selectQuery := &querypb.BoundQuery{
+		Sql: "select user_id from user_extra limit :__upper_limit for update",
+		BindVariables: map[string]*querypb.BindVariable{
+			"__upper_limit": sqltypes.Int64BindVariable(10),
+		},
+	}
+	assertQueries(t, sbc1, []*querypb.BoundQuery{selectQuery, {
+		Sql: "delete from user_extra limit :__dml_limit",
+		BindVariables: map[string]*querypb.BindVariable{
+			"__dml_limit": sqltypes.Int64BindVariable(7),
+		},
+	}})
+	assertQueries(t, sbc2, []*querypb.BoundQuery{selectQuery, {
+		Sql: "delete from user_extra limit :__dml_limit",
+		BindVariables: map[string]*querypb.BindVariable{
+			"__dml_limit": sqltypes.Int64BindVariable(1),
+		},
+	}})
+}
+
+func TestUpdateShardedSubquery(t *testing.T) {
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+	sbc2.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "3")})
+	_, err := executorExec(executor, "update user_extra set col = 2 where user_id in (select id from user where id = 3)", nil)
+	require.NoError(t, err)
+	assertQueries(t, sbc1, nil)
+	assertQueries(t, sbc2, []*querypb.BoundQuery{{
+		Sql:           "select id from `user` where id = 3",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql: "update user_extra set col = 2 where :__sq_has_values1 = 1 and user_id in ::__sq1",
+		BindVariables: map[string]*querypb.BindVariable{
+			"__sq1":            sqltypes.TestBindVariable([]interface{}{int64(3)}),
+			"__sq_has_values1": sqltypes.Int64BindVariable(1),
+		},
+	}})
+}
+
 func TestUpdateEqualWithMultipleLookupVindex(t *testing.T) {
 	executor, sbc1, sbc2, sbcLookup := createCustomExecutorSetValues(executorVSchema, nil)
 
@@ -895,6 +1003,49 @@ func TestInsertSharded(t *testing.T) {
 	testQueryLog(t, logChan, "TestExecute", "INSERT", "insert into `user`(id, v, `name`) values (:vtg1, :vtg2, _binary :vtg3)", 1)
 }
 
+func TestReplaceSharded(t *testing.T) {
+	executor, sbc1, sbc2, sbclookup := createExecutorEnv()
+
+	sbc1.SetResults([]*sqltypes.Result{
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("Id|name", "int64|varchar"),
+			"1|oldname",
+		),
+	})
+	_, err := executorExec(executor, "replace into user(id, v, name) values (1, 2, 'myname')", nil)
+	require.NoError(t, err)
+	wantQueries := []*querypb.BoundQuery{{
+		Sql: "select Id, `name` from `user` where Id in (:__replace_0_0_0) for update",
+		BindVariables: map[string]*querypb.BindVariable{
+			"__replace_0_0_0": sqltypes.Int64BindVariable(1),
+		},
+	}, {
+		Sql: "replace into `user`(id, v, `name`) values (:_Id_0, 2, :_name_0)",
+		BindVariables: map[string]*querypb.BindVariable{
+			"_Id_0":   sqltypes.Int64BindVariable(1),
+			"_name_0": sqltypes.StringBindVariable("myname"),
+			"__seq0":  sqltypes.Int64BindVariable(1),
+		},
+	}}
+	assertQueries(t, sbc1, wantQueries)
+	assertQueries(t, sbc2, nil)
+	// The lookup entry of the replaced row is deleted before the one of the new row is created.
+	wantQueries = []*querypb.BoundQuery{{
+		Sql: "delete from name_user_map where `name` = :name and user_id = :user_id",
+		BindVariables: map[string]*querypb.BindVariable{
+			"name":    sqltypes.StringBindVariable("oldname"),
+			"user_id": sqltypes.Uint64BindVariable(1),
+		},
+	}, {
+		Sql: "insert into name_user_map(`name`, user_id) values (:name_0, :user_id_0)",
+		BindVariables: map[string]*querypb.BindVariable{
+			"name_0":    sqltypes.StringBindVariable("myname"),
+			"user_id_0": sqltypes.Uint64BindVariable(1),
+		},
+	}}
+	assertQueries(t, sbclookup, wantQueries)
+}
+
 func TestInsertShardedKeyrange(t *testing.T) {
 	executor, _, _, _ := createExecutorEnv()
 
@@ -2116,3 +2267,43 @@ func TestTestPartialVindexInsertQueryFailure(t *testing.T) {
 	testQueryLogWithSavepoint(t, logChan, "VindexCreate", "SAVEPOINT_ROLLBACK", "rollback to x", 0, true)
 	testQueryLogWithSavepoint(t, logChan, "TestExecute", "INSERT", "insert into t1(id, unq_col) values (1, 1), (2, 3)", 0, true)
 }
+
+type fakeLocalInfile struct {
+	fileName string
+	content  string
+}
+
+func (f *fakeLocalInfile) RequestLocalInfile(fileName string) (io.ReadCloser, error) {
+	f.fileName = fileName
+	return io.NopCloser(strings.NewReader(f.content)), nil
+}
+
+func TestLoadDataLocalInfile(t *testing.T) {
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+
+	file := &fakeLocalInfile{content: "1\ta\n3\tb\n"}
+	ctx := withLocalInfile(context.Background(), file)
+	qr, err := executor.Execute(ctx, "TestExecute", NewSafeSession(&vtgatepb.Session{TargetString: "@primary"}), "load data local infile 'data.txt' into table user_extra (user_id, col)", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "data.txt", file.fileName)
+	assert.EqualValues(t, 2, qr.RowsAffected)
+
+	assertQueries(t, sbc1, []*querypb.BoundQuery{{
+		Sql: "insert into user_extra(user_id, col) values (:_c0_0, :_c0_1)",
+		BindVariables: map[string]*querypb.BindVariable{
+			"_c0_0": sqltypes.StringBindVariable("1"),
+			"_c0_1": sqltypes.StringBindVariable("a"),
+		},
+	}})
+	assertQueries(t, sbc2, []*querypb.BoundQuery{{
+		Sql: "insert into user_extra(user_id, col) values (:_c1_0, :_c1_1)",
+		BindVariables: map[string]*querypb.BindVariable{
+			"_c1_0": sqltypes.StringBindVariable("3"),
+			"_c1_1": sqltypes.StringBindVariable("b"),
+		},
+	}})
+
+	// without a client connection the file can't be read
+	_, err = executorExec(executor, "load data local infile 'data.txt' into table user_extra (user_id, col)", nil)
+	require.EqualError(t, err, "LOAD DATA LOCAL INFILE is only supported through the MySQL protocol")
+}
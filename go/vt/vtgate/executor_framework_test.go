@@ -166,6 +166,11 @@ var executorVSchema = `
 				"column": "id",
 				"sequence": "user_seq"
 			},
+			"unique_keys": [
+				{
+					"columns": ["Id"]
+				}
+			],
 			"columns": [
 				{
 					"name": "textcol",
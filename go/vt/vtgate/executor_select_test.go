@@ -1925,6 +1925,66 @@ func TestSelectScatterAggregate(t *testing.T) {
 	utils.MustMatch(t, wantResult, gotResult)
 }
 
+func TestGen4SelectScatterAggregateHaving(t *testing.T) {
+	// Special setup: Don't use createExecutorEnv.
+	cell := "aa"
+	hc := discovery.NewFakeHealthCheck(nil)
+	s := createSandbox("TestExecutor")
+	s.VSchema = executorVSchema
+	getSandbox(KsTestUnsharded).VSchema = unshardedVSchema
+	serv := new(sandboxTopo)
+	resolver := newTestResolver(hc, serv, cell)
+	shards := []string{"-20", "20-40", "40-60", "60-80", "80-a0", "a0-c0", "c0-e0", "e0-"}
+	var conns []*sandboxconn.SandboxConn
+	for i, shard := range shards {
+		sbc := hc.AddTestTablet(cell, shard, 1, "TestExecutor", shard, topodatapb.TabletType_PRIMARY, true, 1, nil)
+		sbc.SetResults([]*sqltypes.Result{{
+			Fields: []*querypb.Field{
+				{Name: "col", Type: sqltypes.Int32},
+				{Name: "count(*)", Type: sqltypes.Int64},
+				{Name: "weight_string(col)", Type: sqltypes.VarBinary},
+			},
+			InsertID: 0,
+			Rows: [][]sqltypes.Value{{
+				sqltypes.NewInt32(int32(i % 4)),
+				sqltypes.NewInt64(int64(i + 1)),
+				sqltypes.NULL,
+			}},
+		}})
+		conns = append(conns, sbc)
+	}
+	executor := createExecutor(serv, cell, resolver)
+	*plannerVersion = "gen4"
+	defer func() {
+		// change it back to v3
+		*plannerVersion = "v3"
+	}()
+
+	// the count(*) is not selected, so it is only used to filter the groups at the vtgate level
+	query := "select col from user group by col having count(*) > 8"
+	gotResult, err := executorExec(executor, query, nil)
+	require.NoError(t, err)
+
+	wantQueries := []*querypb.BoundQuery{{
+		Sql:           "select col, count(*), weight_string(col) from `user` group by col, weight_string(col) order by col asc",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	for _, conn := range conns {
+		utils.MustMatch(t, wantQueries, conn.Queries)
+	}
+
+	wantResult := &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Name: "col", Type: sqltypes.Int32},
+		},
+		Rows: [][]sqltypes.Value{
+			{sqltypes.NewInt32(2)},
+			{sqltypes.NewInt32(3)},
+		},
+	}
+	utils.MustMatch(t, wantResult, gotResult)
+}
+
 func TestStreamSelectScatterAggregate(t *testing.T) {
 	// Special setup: Don't use createExecutorEnv.
 	cell := "aa"
@@ -3370,3 +3430,35 @@ func TestMultiColPartial(t *testing.T) {
 		})
 	}
 }
+
+func TestGen4AggregationOnUnion(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+	*plannerVersion = "gen4"
+	defer func() {
+		// change it back to v3
+		*plannerVersion = "v3"
+	}()
+
+	// every shard of the user keyspace and the unsharded keyspace return the same row: (1, 'foo')
+	tcases := []struct {
+		query string
+		rows  string
+	}{{
+		query: "select id, count(*), sum(id), max(id), count(id) from (select id from user union all select id from main1) t group by id",
+		rows:  `[[INT64(1) INT64(9) INT32(9) INT64(1) INT64(9)]]`,
+	}, {
+		query: "select count(*), sum(id) from (select id from user union all select id from main1) t",
+		rows:  `[[INT64(9) INT32(9)]]`,
+	}, {
+		query: "select count(distinct id), count(*) from (select id from user union all select id from main1) t",
+		rows:  `[[INT64(1) INT64(9)]]`,
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.query, func(t *testing.T) {
+			session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary"})
+			result, err := executor.Execute(context.Background(), "TestGen4AggregationOnUnion", session, tcase.query, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.rows, fmt.Sprintf("%v", result.Rows))
+		})
+	}
+}
@@ -2483,3 +2483,39 @@ func exec(executor *Executor, session *SafeSession, sql string) (*sqltypes.Resul
 func makeComments(text string) sqlparser.MarginComments {
 	return sqlparser.MarginComments{Trailing: text}
 }
+
+func TestExecutorPrepareExecute(t *testing.T) {
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary"})
+
+	_, err := executor.Execute(ctx, "TestExecute", session, "prepare prep_user from 'select id from user where id = ?'", nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]*vtgatepb.PrepareData{
+		"prep_user": {PrepareStatement: "select id from user where id = ?", ParamsCount: 1},
+	}, session.PrepareStatement)
+
+	_, err = executor.Execute(ctx, "TestExecute", session, "set @id = 1", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(ctx, "TestExecute", session, "execute prep_user using @id", nil)
+	require.NoError(t, err)
+	assertQueries(t, sbc1, []*querypb.BoundQuery{{
+		Sql: "select id from `user` where id = :v1",
+		BindVariables: map[string]*querypb.BindVariable{
+			"__vtudvid": sqltypes.Int64BindVariable(1),
+			"v1":        sqltypes.Int64BindVariable(1),
+		},
+	}})
+	assertQueries(t, sbc2, nil)
+
+	_, err = executor.Execute(ctx, "TestExecute", session, "execute prep_user", nil)
+	require.EqualError(t, err, "Incorrect arguments to EXECUTE")
+
+	_, err = executor.Execute(ctx, "TestExecute", session, "deallocate prepare prep_user", nil)
+	require.NoError(t, err)
+	assert.Empty(t, session.PrepareStatement)
+
+	_, err = executor.Execute(ctx, "TestExecute", session, "execute prep_user using @id", nil)
+	require.EqualError(t, err, "Unknown prepared statement handler (prep_user) given to EXECUTE")
+	_, err = executor.Execute(ctx, "TestExecute", session, "deallocate prepare prep_user", nil)
+	require.EqualError(t, err, "Unknown prepared statement handler (prep_user) given to DEALLOCATE PREPARE")
+}
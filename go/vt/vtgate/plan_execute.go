@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -59,6 +60,12 @@ func (e *Executor) newExecute(
 		return err
 	}
 
+	query, release, err := e.applyQueryRules(safeSession, vcursor, query, comments)
+	if err != nil {
+		return err
+	}
+	defer release()
+
 	// 2: Create a plan for the query
 	plan, err := e.getPlan(
 		vcursor,
@@ -102,6 +109,15 @@ func (e *Executor) newExecute(
 		return err
 	}
 
+	if e.workloadManager != nil {
+		release, err := e.workloadManager.Admit(ctx, callerid.ImmediateCallerIDFromContext(ctx).GetUsername(), safeSession.GetOptions().GetWorkload().String(), query)
+		if err != nil {
+			logStats.Error = err
+			return err
+		}
+		defer release()
+	}
+
 	if plan.Instructions.NeedsTransaction() {
 		return e.insideTransaction(ctx, safeSession, logStats,
 			func() error {
@@ -209,8 +225,21 @@ func (e *Executor) executePlan(
 	execStart time.Time,
 ) (*sqltypes.Result, error) {
 
+	var token *resultCacheToken
+	if e.resultCache != nil && plan.ResultCacheTTL > 0 && !safeSession.InTransaction() && !safeSession.InReservedConn() {
+		key := e.resultCache.Key(ctx, vcursor.planPrefixKey(), plan, bindVars)
+		if qr, ok := e.resultCache.Get(key); ok {
+			e.setLogStats(logStats, plan, vcursor, execStart, nil, qr)
+			return qr, nil
+		}
+		token = e.resultCache.Begin(key, plan.ResultCacheTables)
+	}
+
 	// 4: Execute!
 	qr, err := vcursor.ExecutePrimitive(plan.Instructions, bindVars, true)
+	if err == nil && token != nil && len(safeSession.GetWarnings()) == 0 {
+		e.resultCache.Set(token, plan, qr)
+	}
 
 	// 5: Log and add statistics
 	e.setLogStats(logStats, plan, vcursor, execStart, err, qr)
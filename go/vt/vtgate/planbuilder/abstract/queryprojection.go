@@ -47,6 +47,10 @@ type (
 		CanPushDownSorting bool
 		HasStar            bool
 		ProjectionError    error
+
+		// AddedColumn is the number of expressions added at the end of SelectExprs
+		// that are needed by the planning but that the query does not ask for
+		AddedColumn int
 	}
 
 	// OrderBy contains the expression to used in order by and also if ordering is needed at VTGate level then what the weight_string function expression to be sent down for evaluation.
@@ -157,16 +161,9 @@ func CreateQPFromSelect(sel *sqlparser.Select, semTable *semantics.SemTable) (*Q
 		return nil, err
 	}
 
-	if qp.HasAggr || len(qp.groupByExprs) > 0 {
-		expr := qp.getNonAggrExprNotMatchingGroupByExprs()
-		// if we have aggregation functions, non aggregating columns and GROUP BY,
-		// the non-aggregating expressions must all be listed in the GROUP BY list
-		if expr != nil {
-			if len(qp.groupByExprs) == 0 {
-				return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.MixOfGroupFuncAndFields, "In aggregated query without GROUP BY, expression of SELECT list contains nonaggregated column '%s'; this is incompatible with sql_mode=only_full_group_by", sqlparser.String(expr))
-			}
-			qp.ProjectionError = vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongFieldWithGroup, "Expression of SELECT list is not in GROUP BY clause and contains nonaggregated column '%s' which is not functionally dependent on columns in GROUP BY clause; this is incompatible with sql_mode=only_full_group_by", sqlparser.String(expr))
-		}
+	err = qp.checkNonAggrExprs()
+	if err != nil {
+		return nil, err
 	}
 
 	if qp.Distinct && !qp.HasAggr {
@@ -206,6 +203,23 @@ func (qp *QueryProjection) addSelectExpressions(sel *sqlparser.Select) error {
 	return nil
 }
 
+// checkNonAggrExprs checks that the non-aggregating expressions of an aggregated query are all listed in the GROUP BY
+func (qp *QueryProjection) checkNonAggrExprs() error {
+	if !qp.NeedsAggregation() {
+		return nil
+	}
+	expr := qp.getNonAggrExprNotMatchingGroupByExprs()
+	// if we have aggregation functions, non aggregating columns and GROUP BY,
+	// the non-aggregating expressions must all be listed in the GROUP BY list
+	if expr != nil {
+		if len(qp.groupByExprs) == 0 {
+			return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.MixOfGroupFuncAndFields, "In aggregated query without GROUP BY, expression of SELECT list contains nonaggregated column '%s'; this is incompatible with sql_mode=only_full_group_by", sqlparser.String(expr))
+		}
+		qp.ProjectionError = vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongFieldWithGroup, "Expression of SELECT list is not in GROUP BY clause and contains nonaggregated column '%s' which is not functionally dependent on columns in GROUP BY clause; this is incompatible with sql_mode=only_full_group_by", sqlparser.String(expr))
+	}
+	return nil
+}
+
 // CreateQPFromUnion creates the QueryProjection for the input *sqlparser.Union
 func CreateQPFromUnion(union *sqlparser.Union, semTable *semantics.SemTable) (*QueryProjection, error) {
 	qp := &QueryProjection{}
@@ -272,12 +286,16 @@ func (qp *QueryProjection) getNonAggrExprNotMatchingGroupByExprs() sqlparser.Sel
 		if expr.Aggr {
 			continue
 		}
+		exp, err := expr.GetExpr()
+		if err != nil {
+			return expr.Col
+		}
+		if sqlparser.IsConstant(exp) {
+			// constant expressions don't depend on any column, so they don't need to be grouped
+			continue
+		}
 		isGroupByOk := false
 		for _, groupByExpr := range qp.groupByExprs {
-			exp, err := expr.GetExpr()
-			if err != nil {
-				return expr.Col
-			}
 			if sqlparser.EqualsExpr(groupByExpr.WeightStrExpr, exp) {
 				isGroupByOk = true
 				break
@@ -289,7 +307,7 @@ func (qp *QueryProjection) getNonAggrExprNotMatchingGroupByExprs() sqlparser.Sel
 	}
 	for _, order := range qp.OrderExprs {
 		// ORDER BY NULL or Aggregation functions need not be present in group by
-		if sqlparser.IsNull(order.Inner.Expr) || sqlparser.IsAggregation(order.WeightStrExpr) {
+		if sqlparser.IsNull(order.Inner.Expr) || sqlparser.IsAggregation(order.WeightStrExpr) || sqlparser.IsConstant(order.WeightStrExpr) {
 			continue
 		}
 		isGroupByOk := false
@@ -479,6 +497,38 @@ func (qp *QueryProjection) AggregationExpressions() (out []Aggr, err error) {
 	return
 }
 
+// AddAggregations adds the aggregations used in the expression that are not already part of the
+// projection at the end of the select expressions, so they get computed with the other aggregations.
+// This is used for the HAVING clause, that can filter on aggregations that are not selected.
+func (qp *QueryProjection) AddAggregations(expr sqlparser.Expr) error {
+	hadAggr := qp.HasAggr
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if !sqlparser.IsAggregation(node) {
+			return true, nil
+		}
+		aggr := node.(sqlparser.Expr)
+		if idx, _ := qp.FindSelectExprIndexForExpr(aggr); idx == nil {
+			qp.SelectExprs = append(qp.SelectExprs, SelectExpr{
+				Col:  &sqlparser.AliasedExpr{Expr: aggr},
+				Aggr: true,
+			})
+			qp.AddedColumn++
+			qp.HasAggr = true
+		}
+		return false, nil
+	}, expr)
+	if hadAggr {
+		return nil
+	}
+	// the query did not aggregate before, so its projection has not been checked for aggregation yet
+	return qp.checkNonAggrExprs()
+}
+
+// GetColumnCount returns the number of columns the query asks for
+func (qp *QueryProjection) GetColumnCount() int {
+	return len(qp.SelectExprs) - qp.AddedColumn
+}
+
 // FindSelectExprIndexForExpr returns the index of the given expression in the select expressions, if it is part of it
 // returns -1 otherwise.
 func (qp *QueryProjection) FindSelectExprIndexForExpr(expr sqlparser.Expr) (*int, *sqlparser.AliasedExpr) {
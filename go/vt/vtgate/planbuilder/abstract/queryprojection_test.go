@@ -39,8 +39,8 @@ func TestQP(t *testing.T) {
 			sql: "select * from user",
 		},
 		{
-			sql:    "select 1, count(1) from user",
-			expErr: "In aggregated query without GROUP BY, expression of SELECT list contains nonaggregated column '1'; this is incompatible with sql_mode=only_full_group_by",
+			// constant columns don't need to be aggregated
+			sql: "select 1, count(1) from user",
 		},
 		{
 			sql: "select max(id) from user",
@@ -50,8 +50,11 @@ func TestQP(t *testing.T) {
 			expErr: "aggregate functions take a single argument 'max(a, b)'",
 		},
 		{
-			sql:    "select 1, count(1) from user order by 1",
-			expErr: "In aggregated query without GROUP BY, expression of SELECT list contains nonaggregated column '1'; this is incompatible with sql_mode=only_full_group_by",
+			// constant columns don't need to be aggregated
+			sql: "select 1, count(1) from user order by 1",
+			expOrder: []OrderBy{
+				{Inner: &sqlparser.Order{Expr: sqlparser.NewIntLiteral("1")}, WeightStrExpr: sqlparser.NewIntLiteral("1")},
+			},
 		},
 		{
 			sql: "select id from user order by col, id, 1",
@@ -35,6 +35,10 @@ func (hp *horizonPlanning) pushAggregation(
 	aggregations []abstract.Aggr,
 	ignoreOutputOrder bool,
 ) (groupingOffsets []offsets, outputAggrsOffset [][]offsets, err error) {
+	if isUnion(plan) {
+		return pushAggrOnUnion(ctx, plan, grouping, aggregations)
+	}
+
 	switch plan := plan.(type) {
 	case *routeGen4:
 		groupingOffsets, outputAggrsOffset, err = pushAggrOnRoute(ctx, plan, aggregations, grouping, ignoreOutputOrder)
@@ -47,7 +51,10 @@ func (hp *horizonPlanning) pushAggregation(
 		return hp.pushAggrOnJoin(ctx, grouping, aggregations, plan)
 
 	case *semiJoin:
-		return hp.pushAggrOnSemiJoin(ctx, grouping, aggregations, plan, ignoreOutputOrder)
+		return hp.pushAggrOnSemiJoin(ctx, grouping, aggregations, plan.lhs, plan.LHSColumns, &plan.cols, ignoreOutputOrder)
+
+	case *correlatedSubquery:
+		return hp.pushAggrOnSemiJoin(ctx, grouping, aggregations, plan.outer, plan.LHSColumns, &plan.cols, ignoreOutputOrder)
 
 	default:
 		return nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "using aggregation on top of a %T plan is not yet supported", plan)
@@ -122,6 +129,44 @@ func pushAggrOnRoute(
 	return groupingOffsets, vtgateAggregation, nil
 }
 
+// pushAggrOnUnion fetches the columns needed to aggregate the rows of a union.
+// Nothing can be aggregated below a union, so only the grouping columns and the arguments of the
+// aggregations are pushed down, and the whole aggregation is done at the vtgate level.
+// The offsets for count(*) are empty, since it does not need any column.
+func pushAggrOnUnion(
+	ctx *plancontext.PlanningContext,
+	plan logicalPlan,
+	grouping []abstract.GroupBy,
+	aggregations []abstract.Aggr,
+) ([]offsets, [][]offsets, error) {
+	groupingOffsets := make([]offsets, 0, len(grouping))
+	for _, expr := range grouping {
+		col, _, err := pushProjection(ctx, &sqlparser.AliasedExpr{Expr: expr.Inner}, plan, true, true, false)
+		if err != nil {
+			return nil, nil, err
+		}
+		groupingOffsets = append(groupingOffsets, newOffset(col))
+	}
+
+	aggrOffsets := make([][]offsets, 0, len(aggregations))
+	for _, aggr := range aggregations {
+		if isCountStar(aggr.Func) {
+			aggrOffsets = append(aggrOffsets, nil)
+			continue
+		}
+		arg, ok := aggr.Func.Exprs[0].(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "syntax error: %s", sqlparser.String(aggr.Original))
+		}
+		col, _, err := pushProjection(ctx, &sqlparser.AliasedExpr{Expr: arg.Expr}, plan, true, true, false)
+		if err != nil {
+			return nil, nil, err
+		}
+		aggrOffsets = append(aggrOffsets, []offsets{newOffset(col)})
+	}
+	return groupingOffsets, aggrOffsets, nil
+}
+
 func pushAggrsAndGroupingInOrder(
 	ctx *plancontext.PlanningContext,
 	plan *routeGen4,
@@ -285,28 +330,51 @@ func (hp *horizonPlanning) pushAggrOnSemiJoin(
 	ctx *plancontext.PlanningContext,
 	grouping []abstract.GroupBy,
 	aggregations []abstract.Aggr,
-	join *semiJoin,
+	lhs logicalPlan,
+	lhsColumns []*sqlparser.ColName,
+	cols *[]int,
 	ignoreOutputOrder bool,
 ) ([]offsets, [][]offsets, error) {
 	// We need to group by the columns used in the join condition.
 	// If we don't, the LHS will not be able to return the column, and it can't be used to send down to the RHS
-	lhsCols, err := hp.createGroupingsForColumns(ctx, join.LHSColumns)
+	lhsCols, err := hp.createGroupingsForColumns(ctx, lhsColumns)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	totalGrouping := append(grouping, lhsCols...)
-	groupingOffsets, aggrParams, err := hp.pushAggregation(ctx, join.lhs, totalGrouping, aggregations, ignoreOutputOrder)
+	groupingOffsets, aggrParams, err := hp.pushAggregation(ctx, lhs, totalGrouping, aggregations, ignoreOutputOrder)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	// the semi-join only returns the columns of the LHS that have been added to its projection,
+	// so we need to pass through the results of the grouping and of the aggregations
+	passThrough := func(offset offsets) offsets {
+		output := newOffset(len(*cols))
+		*cols = append(*cols, -(offset.col + 1))
+		if offset.wsCol > -1 {
+			output.wsCol = len(*cols)
+			*cols = append(*cols, -(offset.wsCol + 1))
+		}
+		return output
+	}
+
 	outputGroupings := make([]offsets, 0, len(grouping))
 	for idx := range grouping {
-		outputGroupings = append(outputGroupings, groupingOffsets[idx])
+		outputGroupings = append(outputGroupings, passThrough(groupingOffsets[idx]))
+	}
+
+	outputAggrs := make([][]offsets, 0, len(aggrParams))
+	for _, aggr := range aggrParams {
+		outputAggr := make([]offsets, 0, len(aggr))
+		for _, offset := range aggr {
+			outputAggr = append(outputAggr, passThrough(offset))
+		}
+		outputAggrs = append(outputAggrs, outputAggr)
 	}
 
-	return outputGroupings, aggrParams, nil
+	return outputGroupings, outputAggrs, nil
 }
 
 // this method takes a slice of aggregations that can have missing spots in the form of `nil`,
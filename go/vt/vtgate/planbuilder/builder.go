@@ -85,6 +85,10 @@ func BuildFromStmt(query string, stmt sqlparser.Statement, reservedVars *sqlpars
 	if err != nil {
 		return nil, err
 	}
+	if exec, ok := instruction.(*engine.ExecStmt); ok {
+		// the executor provides what the prepared statement needs along with the EXECUTE
+		bindVarNeeds.MergeWith(exec.BindVarNeeds)
+	}
 	plan := &engine.Plan{
 		Type:         sqlparser.ASTToStatementType(stmt),
 		Original:     query,
@@ -216,7 +220,7 @@ func createInstructionFor(query string, stmt sqlparser.Statement, reservedVars *
 	case *sqlparser.Set:
 		return buildSetPlan(stmt, vschema)
 	case *sqlparser.Load:
-		return buildLoadPlan(query, vschema)
+		return buildLoadPlan(stmt, query, vschema)
 	case sqlparser.DBDDLStatement:
 		return buildRoutePlan(stmt, reservedVars, vschema, buildDBDDLPlan)
 	case *sqlparser.SetTransaction:
@@ -238,6 +242,12 @@ func createInstructionFor(query string, stmt sqlparser.Statement, reservedVars *
 		return buildStreamPlan(stmt, vschema)
 	case *sqlparser.VStream:
 		return buildVStreamPlan(stmt, vschema)
+	case *sqlparser.PrepareStmt:
+		return buildPrepareStmtPlan(stmt, vschema)
+	case *sqlparser.ExecuteStmt:
+		return buildExecuteStmtPlan(stmt, vschema)
+	case *sqlparser.DeallocateStmt:
+		return buildDeallocateStmtPlan(stmt)
 	}
 
 	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: unexpected statement type: %T", stmt)
@@ -281,29 +291,6 @@ func buildDBDDLPlan(stmt sqlparser.Statement, _ *sqlparser.ReservedVars, vschema
 	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] database ddl not recognized: %s", sqlparser.String(dbDDLstmt))
 }
 
-func buildLoadPlan(query string, vschema plancontext.VSchema) (engine.Primitive, error) {
-	keyspace, err := vschema.DefaultKeyspace()
-	if err != nil {
-		return nil, err
-	}
-
-	destination := vschema.Destination()
-	if destination == nil {
-		if err := vschema.ErrorIfShardedF(keyspace, "LOAD", "LOAD is not supported on sharded database"); err != nil {
-			return nil, err
-		}
-		destination = key.DestinationAnyShard{}
-	}
-
-	return &engine.Send{
-		Keyspace:          keyspace,
-		TargetDestination: destination,
-		Query:             query,
-		IsDML:             true,
-		SingleShardOnly:   true,
-	}, nil
-}
-
 func buildVSchemaDDLPlan(stmt *sqlparser.AlterVschema, vschema plancontext.VSchema) (engine.Primitive, error) {
 	_, keyspace, _, err := vschema.TargetDestination(stmt.Table.Qualifier.String())
 	if err != nil {
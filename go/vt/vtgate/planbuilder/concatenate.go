@@ -63,7 +63,7 @@ func (c *concatenate) SupplyCol(col *sqlparser.ColName) (rc *resultColumn, colNu
 }
 
 func (c *concatenate) SupplyWeightString(colNumber int, alsoAddToGroupBy bool) (weightcolNumber int, err error) {
-	panic("implement me")
+	return 0, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: ordering on the results of a union")
 }
 
 func (c *concatenate) Primitive() engine.Primitive {
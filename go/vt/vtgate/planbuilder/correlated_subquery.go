@@ -0,0 +1,184 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
+)
+
+var _ logicalPlan = (*correlatedSubquery)(nil)
+
+// correlatedSubquery is the logicalPlan for engine.CorrelatedSubquery.
+// This gets built for correlated subqueries that are used in a predicate
+// of the outer query, but that can't be merged with it into a single route.
+type correlatedSubquery struct {
+	gen4Plan
+	outer, inner logicalPlan
+	cols         []int
+
+	vars map[string]int
+
+	// LHSColumns are the columns from the outer plan used to evaluate the subquery:
+	// the ones sent down to the inner plan through vars, and the ones used by the predicate
+	LHSColumns []*sqlparser.ColName
+
+	eSubquery *engine.CorrelatedSubquery
+}
+
+// Primitive implements the logicalPlan interface
+func (cs *correlatedSubquery) Primitive() engine.Primitive {
+	cs.eSubquery.Outer = cs.outer.Primitive()
+	cs.eSubquery.Subquery = cs.inner.Primitive()
+	cs.eSubquery.Vars = cs.vars
+	cs.eSubquery.Cols = cs.cols
+	return cs.eSubquery
+}
+
+// WireupGen4 implements the logicalPlan interface
+func (cs *correlatedSubquery) WireupGen4(semTable *semantics.SemTable) error {
+	if err := cs.outer.WireupGen4(semTable); err != nil {
+		return err
+	}
+	return cs.inner.WireupGen4(semTable)
+}
+
+// Rewrite implements the logicalPlan interface
+func (cs *correlatedSubquery) Rewrite(inputs ...logicalPlan) error {
+	if len(inputs) != 2 {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "correlatedSubquery: wrong number of inputs")
+	}
+	cs.outer = inputs[0]
+	cs.inner = inputs[1]
+	return nil
+}
+
+// ContainsTables implements the logicalPlan interface
+func (cs *correlatedSubquery) ContainsTables() semantics.TableSet {
+	return cs.outer.ContainsTables().Merge(cs.inner.ContainsTables())
+}
+
+// Inputs implements the logicalPlan interface
+func (cs *correlatedSubquery) Inputs() []logicalPlan {
+	return []logicalPlan{cs.outer, cs.inner}
+}
+
+// OutputColumns implements the logicalPlan interface
+func (cs *correlatedSubquery) OutputColumns() []sqlparser.SelectExpr {
+	return cs.outer.OutputColumns()
+}
+
+// batchSubquery changes the inner route so that it can be executed once for all the outer rows,
+// instead of once per outer row. This is possible when the subquery is correlated through a single
+// equality between one of its columns and an outer column: the equality is turned into an IN that
+// receives the list of all outer values, and the inner column is added to the select list so that
+// the rows returned can be matched with the outer rows they belong to.
+func (cs *correlatedSubquery) batchSubquery(semTable *semantics.SemTable) {
+	if len(cs.vars) != 1 {
+		return
+	}
+	var bvName string
+	for k := range cs.vars {
+		bvName = k
+	}
+
+	route, ok := cs.inner.(*routeGen4)
+	if !ok || route.eroute.TruncateColumnCount != 0 {
+		return
+	}
+	sel, ok := route.Select.(*sqlparser.Select)
+	if !ok || sel.Distinct || sel.GroupBy != nil || sel.Having != nil || sel.Limit != nil || sel.Where == nil {
+		return
+	}
+	if sqlparser.ContainsAggregation(sel.SelectExprs) || argumentCount(sel, bvName) != 1 || argumentCount(route.condition, bvName) != 0 {
+		return
+	}
+
+	predicates := sqlparser.SplitAndExpression(nil, sel.Where.Expr)
+	for i, predicate := range predicates {
+		cmp, ok := predicate.(*sqlparser.ComparisonExpr)
+		if !ok || cmp.Operator != sqlparser.EqualOp {
+			continue
+		}
+		col, ok := cmp.Left.(*sqlparser.ColName)
+		if !ok || !isArgument(cmp.Right, bvName) {
+			col, ok = cmp.Right.(*sqlparser.ColName)
+			if !ok || !isArgument(cmp.Left, bvName) {
+				continue
+			}
+		}
+
+		// the values of the outer and of the inner column are hashed to be matched together,
+		// so we need to know how to compare them
+		innerType, outerType := semTable.TypeFor(col), semTable.TypeFor(cs.LHSColumns[0])
+		if innerType == nil || outerType == nil || semTable.NeedsWeightString(col) || semTable.NeedsWeightString(cs.LHSColumns[0]) {
+			return
+		}
+		comparisonType, err := evalengine.CoerceTo(*outerType, *innerType)
+		if err != nil {
+			return
+		}
+		collation := semTable.CollationForExpr(col)
+		if collation != semTable.CollationForExpr(cs.LHSColumns[0]) {
+			return
+		}
+
+		predicates[i] = &sqlparser.ComparisonExpr{
+			Operator: sqlparser.InOp,
+			Left:     col,
+			Right:    sqlparser.NewListArg(bvName),
+		}
+		sel.Where.Expr = sqlparser.AndExpressions(predicates...)
+		cs.eSubquery.BatchKey = len(sel.SelectExprs)
+		sel.SelectExprs = append(sel.SelectExprs, &sqlparser.AliasedExpr{Expr: col})
+		cs.eSubquery.BatchVar = bvName
+		cs.eSubquery.Collation = collation
+		cs.eSubquery.ComparisonType = comparisonType
+		return
+	}
+}
+
+func isArgument(expr sqlparser.Expr, name string) bool {
+	arg, ok := expr.(sqlparser.Argument)
+	return ok && string(arg) == name
+}
+
+// argumentCount returns the number of times the given argument is used in the node
+func argumentCount(node sqlparser.SQLNode, name string) int {
+	if node == nil {
+		return 0
+	}
+	count := 0
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case sqlparser.Argument:
+			if string(node) == name {
+				count++
+			}
+		case sqlparser.ListArg:
+			if string(node) == name {
+				count++
+			}
+		}
+		return true, nil
+	}, node)
+	return count
+}
@@ -0,0 +1,738 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/mysql/collations"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/plancontext"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+// inlineCTEs replaces the references to the non-recursive common table
+// expressions of the statement, and of the statements it contains, with
+// derived tables. The recursive ones stay in the WITH clause of their statement.
+func inlineCTEs(stmt sqlparser.Statement) error {
+	var err error
+	_ = sqlparser.Rewrite(stmt, nil, func(cursor *sqlparser.Cursor) bool {
+		if with := getWith(cursor.Node()); with != nil {
+			err = inlineWith(cursor.Node(), with)
+		}
+		return err == nil
+	})
+	return err
+}
+
+func inlineWith(node sqlparser.SQLNode, with *sqlparser.With) error {
+	setWith(node, nil)
+	var recursive []*sqlparser.CommonTableExpr
+	for i, cte := range with.CTEs {
+		if with.Recursive && referencesCTE(cte.Subquery, cte.TableID) {
+			recursive = append(recursive, cte)
+			continue
+		}
+		body := sqlparser.CloneSelectStatement(cte.Subquery.Select)
+		if err := setCTEColumns(body, cte); err != nil {
+			return err
+		}
+		// A common table expression can be used by the ones defined after it.
+		for _, next := range with.CTEs[i+1:] {
+			replaceCTE(next.Subquery, cte.TableID, body)
+		}
+		replaceCTE(node, cte.TableID, body)
+	}
+	if len(recursive) > 0 {
+		setWith(node, &sqlparser.With{CTEs: recursive, Recursive: true})
+	}
+	return nil
+}
+
+func getWith(node sqlparser.SQLNode) *sqlparser.With {
+	switch node := node.(type) {
+	case *sqlparser.Select:
+		return node.With
+	case *sqlparser.Union:
+		return node.With
+	case *sqlparser.Update:
+		return node.With
+	case *sqlparser.Delete:
+		return node.With
+	}
+	return nil
+}
+
+func setWith(node sqlparser.SQLNode, with *sqlparser.With) {
+	switch node := node.(type) {
+	case *sqlparser.Select:
+		node.With = with
+	case *sqlparser.Union:
+		node.With = with
+	case *sqlparser.Update:
+		node.With = with
+	case *sqlparser.Delete:
+		node.With = with
+	}
+}
+
+// setCTEColumns names the columns of the body of the common table expression
+// after its column list, if it has one.
+func setCTEColumns(body sqlparser.SelectStatement, cte *sqlparser.CommonTableExpr) error {
+	if len(cte.Columns) == 0 {
+		return nil
+	}
+	sel := sqlparser.GetFirstSelect(body)
+	if len(sel.SelectExprs) != len(cte.Columns) {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "In definition of view, derived table or common table expression, SELECT list and column names list have different column counts")
+	}
+	for i, expr := range sel.SelectExprs {
+		aliased, ok := expr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: '*' in common table expression %s with a column list", cte.TableID.String())
+		}
+		if col, ok := aliased.Expr.(*sqlparser.ColName); ok && aliased.As.IsEmpty() && col.Name.Equal(cte.Columns[i]) {
+			continue
+		}
+		aliased.As = cte.Columns[i]
+	}
+	return nil
+}
+
+// replaceCTE replaces the references to the common table expression
+// in the node with derived tables of its body.
+func replaceCTE(node sqlparser.SQLNode, name sqlparser.TableIdent, body sqlparser.SelectStatement) {
+	_ = sqlparser.Rewrite(node, func(cursor *sqlparser.Cursor) bool {
+		switch node := cursor.Node().(type) {
+		case *sqlparser.Select, *sqlparser.Union, *sqlparser.Update, *sqlparser.Delete:
+			// A common table expression of the same name hides this one.
+			return !definesCTE(getWith(node), name)
+		case *sqlparser.AliasedTableExpr:
+			if !isCTERef(node, name) {
+				return true
+			}
+			as := node.As
+			if as.IsEmpty() {
+				as = name
+			}
+			cursor.Replace(&sqlparser.AliasedTableExpr{
+				Expr: &sqlparser.DerivedTable{Select: cloneSelectStatement(body)},
+				As:   as,
+			})
+			return false
+		}
+		return true
+	}, nil)
+}
+
+func definesCTE(with *sqlparser.With, name sqlparser.TableIdent) bool {
+	if with == nil {
+		return false
+	}
+	for _, cte := range with.CTEs {
+		if cte.TableID.String() == name.String() {
+			return true
+		}
+	}
+	return false
+}
+
+func isCTERef(node *sqlparser.AliasedTableExpr, name sqlparser.TableIdent) bool {
+	tbl, ok := node.Expr.(sqlparser.TableName)
+	return ok && tbl.Qualifier.IsEmpty() && tbl.Name.String() == name.String()
+}
+
+// referencesCTE returns true if the node reads the common table expression.
+func referencesCTE(node sqlparser.SQLNode, name sqlparser.TableIdent) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if ate, ok := node.(*sqlparser.AliasedTableExpr); ok && isCTERef(ate, name) {
+			found = true
+		}
+		return !found, nil
+	}, node)
+	return found
+}
+
+// cloneSelectStatement returns a deep clone of the statement. Unlike
+// sqlparser.CloneSelectStatement, it does not share the columns, so that
+// each copy can be analyzed on its own.
+func cloneSelectStatement(stmt sqlparser.SelectStatement) sqlparser.SelectStatement {
+	clone := sqlparser.CloneSelectStatement(stmt)
+	_ = sqlparser.Rewrite(clone, func(cursor *sqlparser.Cursor) bool {
+		if col, ok := cursor.Node().(*sqlparser.ColName); ok {
+			cursor.Replace(&sqlparser.ColName{Name: col.Name, Qualifier: col.Qualifier})
+		}
+		return true
+	}, nil)
+	return clone
+}
+
+// recursiveCTEKeyspace returns the keyspace of the tables read by the statement
+// and its recursive common table expressions, and the names of these tables,
+// if they are all in the same unsharded keyspace. The statement can then be
+// sent whole to that keyspace.
+func recursiveCTEKeyspace(stmt sqlparser.Statement, vschema plancontext.VSchema) (*vindexes.Keyspace, []string, error) {
+	ctes := map[string]bool{}
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if with := getWith(node); with != nil {
+			for _, cte := range with.CTEs {
+				ctes[cte.TableID.String()] = true
+			}
+		}
+		return true, nil
+	}, stmt)
+
+	var ks *vindexes.Keyspace
+	var tables []string
+	unsharded := true
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		ate, ok := node.(*sqlparser.AliasedTableExpr)
+		if !ok {
+			return true, nil
+		}
+		tbl, ok := ate.Expr.(sqlparser.TableName)
+		if !ok || (tbl.Qualifier.IsEmpty() && (ctes[tbl.Name.String()] || tbl.Name.String() == "dual")) {
+			return true, nil
+		}
+		vtbl, _, _, _, err := vschema.FindTable(tbl)
+		if err != nil {
+			return false, err
+		}
+		if vtbl == nil || vtbl.Keyspace.Sharded || (ks != nil && ks.Name != vtbl.Keyspace.Name) {
+			unsharded = false
+			return false, nil
+		}
+		ks = vtbl.Keyspace
+		for _, name := range tables {
+			if name == vtbl.Name.String() {
+				return true, nil
+			}
+		}
+		tables = append(tables, vtbl.Name.String())
+		return true, nil
+	}, stmt)
+	if err != nil || !unsharded || ks == nil {
+		return nil, nil, err
+	}
+	return ks, tables, nil
+}
+
+// buildRecursiveCTEDMLPlan builds the plan of an UPDATE or DELETE with recursive
+// common table expressions. The statement is sent whole to its keyspace, which
+// must be unsharded and hold all its tables.
+func buildRecursiveCTEDMLPlan(stmt sqlparser.Statement, dmlType string, vschema plancontext.VSchema) (*engine.DML, error) {
+	ks, _, err := recursiveCTEKeyspace(stmt, vschema)
+	if err != nil {
+		return nil, err
+	}
+	if ks == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression in %s statement across shards", dmlType)
+	}
+	edml := engine.NewDML()
+	edml.Opcode = engine.Unsharded
+	edml.Keyspace = ks
+	edml.Query = generateQuery(stmt)
+	return edml, nil
+}
+
+// buildUnshardedCTERoute returns the route sending the SELECT with recursive
+// common table expressions whole to its keyspace, if all its tables are in
+// the same unsharded keyspace.
+func buildUnshardedCTERoute(stmt sqlparser.SelectStatement, vschema plancontext.VSchema) (*engine.Route, error) {
+	ks, tables, err := recursiveCTEKeyspace(stmt, vschema)
+	if err != nil || ks == nil {
+		return nil, err
+	}
+	buf := sqlparser.NewTrackedBuffer(impossibleCTEFormatter)
+	buf.Myprintf("%v", stmt)
+	route := engine.NewRoute(engine.Unsharded, ks, generateQuery(stmt), buf.String())
+	route.TableName = strings.Join(tables, ", ")
+	return route, nil
+}
+
+// impossibleCTEFormatter formats the selects of a statement with
+// common table expressions as impossible queries, for the field query.
+func impossibleCTEFormatter(buf *sqlparser.TrackedBuffer, node sqlparser.SQLNode) {
+	switch node := node.(type) {
+	case *sqlparser.Select:
+		if node.With != nil {
+			buf.Myprintf("%v", node.With)
+		}
+		sqlparser.FormatImpossibleQuery(buf, node)
+		return
+	case *sqlparser.Union:
+		if node.With != nil {
+			buf.Myprintf("%v", node.With)
+		}
+		sqlparser.FormatImpossibleQuery(buf, node)
+		return
+	}
+	dmlFormatter(buf, node)
+}
+
+// buildRecursiveCTEPlan builds the plan of a SELECT with recursive common
+// table expressions. The statement is sent whole to its keyspace when all its
+// tables are in the same unsharded keyspace. Otherwise, the expression is
+// evaluated at vtgate.
+func buildRecursiveCTEPlan(stmt sqlparser.SelectStatement, reservedVars *sqlparser.ReservedVars, vschema plancontext.VSchema, version querypb.ExecuteOptions_PlannerVersion) (engine.Primitive, error) {
+	route, err := buildUnshardedCTERoute(stmt, vschema)
+	if err != nil {
+		return nil, err
+	}
+	if route != nil {
+		return route, nil
+	}
+
+	sel, ok := stmt.(*sqlparser.Select)
+	switch {
+	case !ok:
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: union with recursive common table expression across shards")
+	case sel.With == nil:
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: nested recursive common table expression across shards")
+	case len(sel.With.CTEs) != 1:
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: more than one recursive common table expression across shards")
+	}
+	cte := sel.With.CTEs[0]
+	sel = cloneSelectStatement(sel).(*sqlparser.Select)
+	sel.With = nil
+	if hasWith(sel) || hasWith(cte.Subquery) {
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: nested recursive common table expression across shards")
+	}
+
+	plan := func(stmt sqlparser.SelectStatement) (engine.Primitive, error) {
+		plan, err := newBuildSelectPlan(stmt, reservedVars, vschema, version)
+		if err != nil {
+			return nil, err
+		}
+		return plan.Primitive(), nil
+	}
+	rc, columns, err := buildRecurseCTE(cte, plan)
+	if err != nil {
+		return nil, err
+	}
+
+	from, preds, ref, err := removeCTERef(sel.From, cte.TableID)
+	if err != nil {
+		return nil, err
+	}
+	if ref == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression %s not in the FROM clause", cte.TableID.String())
+	}
+	alias := ref.As
+	if alias.IsEmpty() {
+		alias = cte.TableID
+	}
+	if len(from) == 0 {
+		return buildCTEProjection(sel, rc, &cteLookup{alias: alias, columns: columns, collation: vschema.ConnCollation()})
+	}
+
+	// The other tables are joined to each row of the expression.
+	if sel.GroupBy != nil || sel.Having != nil || sel.OrderBy != nil || sel.Limit != nil || sel.Distinct || sqlparser.ContainsAggregation(sel.SelectExprs) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: grouping, ordering or limit on a join with recursive common table expression %s across shards", cte.TableID.String())
+	}
+	sel.From = from
+	if len(preds) > 0 {
+		sel.AddWhere(sqlparser.AndExpressions(preds...))
+	}
+	if err := bindCTEColumns(sel, alias, columns, true); err != nil {
+		return nil, err
+	}
+	right, err := plan(sel)
+	if err != nil {
+		return nil, err
+	}
+	join := &engine.Join{
+		Opcode: engine.InnerJoin,
+		Left:   rc,
+		Right:  right,
+		Vars:   map[string]int{},
+	}
+	for i := range columns {
+		join.Vars[engine.CTEVarName(i)] = i
+	}
+	for i := range sel.SelectExprs {
+		join.Cols = append(join.Cols, i+1)
+	}
+	return join, nil
+}
+
+func hasWith(node sqlparser.SQLNode) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		found = found || getWith(node) != nil
+		return !found, nil
+	}, node)
+	return found
+}
+
+// buildRecurseCTE builds the RecurseCTE primitive evaluating the recursive
+// common table expression. The names of its columns are returned too.
+func buildRecurseCTE(cte *sqlparser.CommonTableExpr, plan func(sqlparser.SelectStatement) (engine.Primitive, error)) (*engine.RecurseCTE, []string, error) {
+	name := cte.TableID.String()
+	union, ok := cte.Subquery.Select.(*sqlparser.Union)
+	if !ok {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Recursive Common Table Expression '%s' should contain a UNION", name)
+	}
+	recurse, ok := union.Right.(*sqlparser.Select)
+	if !ok || referencesCTE(union.Left, cte.TableID) {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: more than one recursive select in common table expression %s", name)
+	}
+	if union.OrderBy != nil || union.Limit != nil {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: ordering or limit of recursive common table expression %s", name)
+	}
+
+	seed := cloneSelectStatement(union.Left)
+	if err := setCTEColumns(seed, cte); err != nil {
+		return nil, nil, err
+	}
+	var columns []string
+	for _, expr := range sqlparser.GetFirstSelect(seed).SelectExprs {
+		aliased, ok := expr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: '*' in recursive common table expression %s", name)
+		}
+		col, isCol := aliased.Expr.(*sqlparser.ColName)
+		switch {
+		case !aliased.As.IsEmpty():
+			columns = append(columns, aliased.As.String())
+		case isCol:
+			columns = append(columns, col.Name.String())
+		default:
+			columns = append(columns, sqlparser.String(aliased.Expr))
+		}
+	}
+
+	recurse = cloneSelectStatement(recurse).(*sqlparser.Select)
+	if len(recurse.SelectExprs) != len(columns) {
+		return nil, nil, engine.ErrWrongNumberOfColumnsInSelect
+	}
+	if recurse.GroupBy != nil || recurse.Having != nil || recurse.OrderBy != nil || recurse.Limit != nil || recurse.Distinct || sqlparser.ContainsAggregation(recurse.SelectExprs) {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: grouping, ordering or limit in the recursive select of common table expression %s", name)
+	}
+	from, preds, ref, err := removeCTERef(recurse.From, cte.TableID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ref == nil {
+		// the recursive select references the expression, but not in its FROM clause
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "In recursive query block of Recursive Common Table Expression '%s', the recursive table must be referenced only once, and not in any subquery", name)
+	}
+	alias := ref.As
+	if alias.IsEmpty() {
+		alias = cte.TableID
+	}
+	if len(from) == 0 {
+		from = sqlparser.TableExprs{&sqlparser.AliasedTableExpr{Expr: sqlparser.TableName{Name: sqlparser.NewTableIdent("dual")}}}
+	}
+	recurse.From = from
+	if len(preds) > 0 {
+		recurse.AddWhere(sqlparser.AndExpressions(preds...))
+	}
+	if err := bindCTEColumns(recurse, alias, columns, len(recurse.From) == 1 && isDual(recurse.From[0])); err != nil {
+		return nil, nil, err
+	}
+
+	rc := &engine.RecurseCTE{Distinct: union.Distinct}
+	if rc.Seed, err = plan(seed); err != nil {
+		return nil, nil, err
+	}
+	if rc.Recurse, err = plan(recurse); err != nil {
+		return nil, nil, err
+	}
+	return rc, columns, nil
+}
+
+func isDual(expr sqlparser.TableExpr) bool {
+	ate, ok := expr.(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return false
+	}
+	tbl, ok := ate.Expr.(sqlparser.TableName)
+	return ok && tbl.Name.String() == "dual"
+}
+
+// removeCTERef removes the reference to the common table expression from the
+// table expressions. The conditions of the joins with the reference are
+// returned, to be added to the WHERE clause, along with the reference itself.
+// The reference cannot be on either side of an outer join.
+func removeCTERef(exprs sqlparser.TableExprs, name sqlparser.TableIdent) (sqlparser.TableExprs, []sqlparser.Expr, *sqlparser.AliasedTableExpr, error) {
+	var (
+		remaining sqlparser.TableExprs
+		preds     []sqlparser.Expr
+		ref       *sqlparser.AliasedTableExpr
+	)
+	for _, expr := range exprs {
+		rest, exprPreds, exprRef, err := removeCTERefFrom(expr, name)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if exprRef != nil {
+			if ref != nil {
+				return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: more than one reference to recursive common table expression %s", name.String())
+			}
+			ref = exprRef
+		}
+		preds = append(preds, exprPreds...)
+		if rest != nil {
+			remaining = append(remaining, rest)
+		}
+	}
+	if ref == nil && referencesCTE(exprs, name) {
+		return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression %s in a derived table", name.String())
+	}
+	return remaining, preds, ref, nil
+}
+
+func removeCTERefFrom(expr sqlparser.TableExpr, name sqlparser.TableIdent) (sqlparser.TableExpr, []sqlparser.Expr, *sqlparser.AliasedTableExpr, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.AliasedTableExpr:
+		if isCTERef(expr, name) {
+			return nil, nil, expr, nil
+		}
+	case *sqlparser.ParenTableExpr:
+		exprs, preds, ref, err := removeCTERef(expr.Exprs, name)
+		if err != nil || ref == nil {
+			return expr, nil, nil, err
+		}
+		if len(exprs) == 0 {
+			return nil, preds, ref, nil
+		}
+		return &sqlparser.ParenTableExpr{Exprs: exprs}, preds, ref, nil
+	case *sqlparser.JoinTableExpr:
+		left, lpreds, lref, err := removeCTERefFrom(expr.LeftExpr, name)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		right, rpreds, rref, err := removeCTERefFrom(expr.RightExpr, name)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		ref := lref
+		if ref == nil {
+			ref = rref
+		} else if rref != nil {
+			return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: more than one reference to recursive common table expression %s", name.String())
+		}
+		if ref == nil {
+			return expr, nil, nil, nil
+		}
+		if expr.Join != sqlparser.NormalJoinType && expr.Join != sqlparser.StraightJoinType {
+			return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression %s in an outer join", name.String())
+		}
+		preds := append(lpreds, rpreds...)
+		if left != nil && right != nil {
+			return &sqlparser.JoinTableExpr{LeftExpr: left, Join: expr.Join, RightExpr: right, Condition: expr.Condition}, preds, ref, nil
+		}
+		if expr.Condition != nil && expr.Condition.Using != nil {
+			return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: join using recursive common table expression %s", name.String())
+		}
+		if expr.Condition != nil && expr.Condition.On != nil {
+			preds = append(preds, expr.Condition.On)
+		}
+		if left != nil {
+			return left, preds, ref, nil
+		}
+		return right, preds, ref, nil
+	}
+	return expr, nil, nil, nil
+}
+
+// bindCTEColumns replaces the columns of the common table expression in the
+// select with the CTEVarName bind variables of their values. The columns
+// are qualified by the alias of the expression, unless it is the only
+// table of the query.
+func bindCTEColumns(sel *sqlparser.Select, alias sqlparser.TableIdent, columns []string, alone bool) error {
+	var err error
+	_ = sqlparser.Rewrite(sel, func(cursor *sqlparser.Cursor) bool {
+		col, ok := cursor.Node().(*sqlparser.ColName)
+		if !ok {
+			return err == nil
+		}
+		if col.Qualifier.IsEmpty() && !alone {
+			for _, column := range columns {
+				if col.Name.EqualString(column) {
+					err = vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: unqualified column %s in a join with recursive common table expression %s", column, alias.String())
+				}
+			}
+			return false
+		}
+		if !col.Qualifier.IsEmpty() && (!col.Qualifier.Qualifier.IsEmpty() || col.Qualifier.Name.String() != alias.String()) {
+			return false
+		}
+		for i, column := range columns {
+			if col.Name.EqualString(column) {
+				// The column keeps its name in the results.
+				if aliased, ok := cursor.Parent().(*sqlparser.AliasedExpr); ok && aliased.As.IsEmpty() {
+					aliased.As = col.Name
+				}
+				cursor.Replace(sqlparser.NewArgument(engine.CTEVarName(i)))
+				return false
+			}
+		}
+		err = vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.BadFieldError, "Unknown column '%s' in '%s'", sqlparser.String(col), alias.String())
+		return false
+	}, nil)
+	return err
+}
+
+// cteLookup resolves the columns of a recursive common table expression
+// to their offsets in the rows of its RecurseCTE primitive.
+type cteLookup struct {
+	alias     sqlparser.TableIdent
+	columns   []string
+	collation collations.ID
+}
+
+var _ evalengine.TranslationLookup = (*cteLookup)(nil)
+
+// ColumnLookup implements the TranslationLookup interface
+func (cl *cteLookup) ColumnLookup(col *sqlparser.ColName) (int, error) {
+	if col.Qualifier.IsEmpty() || (col.Qualifier.Qualifier.IsEmpty() && col.Qualifier.Name.String() == cl.alias.String()) {
+		for i, column := range cl.columns {
+			if col.Name.EqualString(column) {
+				return i, nil
+			}
+		}
+	}
+	return 0, vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.BadFieldError, "Unknown column '%s' in '%s'", sqlparser.String(col), cl.alias.String())
+}
+
+// CollationForExpr implements the TranslationLookup interface
+func (cl *cteLookup) CollationForExpr(sqlparser.Expr) collations.ID {
+	return cl.collation
+}
+
+// DefaultCollation implements the TranslationLookup interface
+func (cl *cteLookup) DefaultCollation() collations.ID {
+	return cl.collation
+}
+
+// buildCTEProjection builds the primitives filtering, ordering, limiting
+// and projecting the rows of a recursive common table expression, when
+// it is the only table of the select.
+func buildCTEProjection(sel *sqlparser.Select, rc *engine.RecurseCTE, lookup *cteLookup) (engine.Primitive, error) {
+	if sel.GroupBy != nil || sel.Having != nil || sel.Distinct || sqlparser.ContainsAggregation(sel.SelectExprs) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: grouping on recursive common table expression %s across shards", lookup.alias.String())
+	}
+	var cols, renamed []int
+	var names []string
+	for _, expr := range sel.SelectExprs {
+		switch expr := expr.(type) {
+		case *sqlparser.StarExpr:
+			if !expr.TableName.IsEmpty() && (!expr.TableName.Qualifier.IsEmpty() || expr.TableName.Name.String() != lookup.alias.String()) {
+				return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.BadTableError, "Unknown table '%s'", sqlparser.String(expr.TableName))
+			}
+			for i := range lookup.columns {
+				cols = append(cols, i)
+			}
+		case *sqlparser.AliasedExpr:
+			col, ok := expr.Expr.(*sqlparser.ColName)
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: expression %s on recursive common table expression %s across shards", sqlparser.String(expr.Expr), lookup.alias.String())
+			}
+			offset, err := lookup.ColumnLookup(col)
+			if err != nil {
+				return nil, err
+			}
+			if !expr.As.IsEmpty() {
+				renamed = append(renamed, len(cols))
+				names = append(names, expr.As.String())
+			}
+			cols = append(cols, offset)
+		default:
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: %s on recursive common table expression %s across shards", sqlparser.String(expr), lookup.alias.String())
+		}
+	}
+
+	var input engine.Primitive = rc
+	if sel.Where != nil {
+		predicate, err := evalengine.Translate(sel.Where.Expr, lookup)
+		if err != nil {
+			return nil, err
+		}
+		input = &engine.Filter{Predicate: predicate, ASTPredicate: sel.Where.Expr, Input: input}
+	}
+	if len(sel.OrderBy) > 0 {
+		ms := &engine.MemorySort{Input: input}
+		for _, order := range sel.OrderBy {
+			offset, err := cteOrderOffset(order.Expr, sel.SelectExprs, cols, lookup)
+			if err != nil {
+				return nil, err
+			}
+			ms.OrderBy = append(ms.OrderBy, engine.OrderByParams{
+				Col:               offset,
+				WeightStringCol:   -1,
+				Desc:              order.Direction == sqlparser.DescOrder,
+				StarColFixedIndex: offset,
+				CollationID:       lookup.collation,
+			})
+		}
+		input = ms
+	}
+	if sel.Limit != nil {
+		limit := &engine.Limit{Input: input}
+		var err error
+		if limit.Count, err = evalengine.Translate(sel.Limit.Rowcount, semantics.EmptySemTable()); err != nil {
+			return nil, vterrors.Wrap(err, "unexpected expression in LIMIT")
+		}
+		if sel.Limit.Offset != nil {
+			if limit.Offset, err = evalengine.Translate(sel.Limit.Offset, semantics.EmptySemTable()); err != nil {
+				return nil, vterrors.Wrap(err, "unexpected expression in OFFSET")
+			}
+		}
+		input = limit
+	}
+	input = &engine.SimpleProjection{Cols: cols, Input: input}
+	if len(renamed) == 0 {
+		return input, nil
+	}
+	return engine.NewRenameField(names, renamed, input)
+}
+
+// cteOrderOffset returns the offset of the ORDER BY expression in the rows
+// of the recursive common table expression. It can be a column of the
+// expression, or an alias or position in the select list.
+func cteOrderOffset(expr sqlparser.Expr, selectExprs sqlparser.SelectExprs, cols []int, lookup *cteLookup) (int, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.Literal:
+		if expr.Type == sqlparser.IntVal {
+			num, err := strconv.Atoi(expr.Val)
+			if err != nil || num < 1 || num > len(cols) {
+				return 0, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.BadFieldError, "Unknown column '%s' in 'order clause'", expr.Val)
+			}
+			return cols[num-1], nil
+		}
+	case *sqlparser.ColName:
+		if expr.Qualifier.IsEmpty() {
+			for i, selectExpr := range selectExprs {
+				if aliased, ok := selectExpr.(*sqlparser.AliasedExpr); ok && aliased.As.Equal(expr.Name) {
+					return cols[i], nil
+				}
+			}
+		}
+		return lookup.ColumnLookup(expr)
+	}
+	return 0, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: complex order by expression: %s", sqlparser.String(expr))
+}
@@ -22,43 +22,57 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder/plancontext"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
 )
 
 // buildDeletePlan builds the instructions for a DELETE statement.
 func buildDeletePlan(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedVars, vschema plancontext.VSchema) (engine.Primitive, error) {
 	del := stmt.(*sqlparser.Delete)
-	if del.With != nil {
-		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: with expression in delete statement")
+	if err := inlineCTEs(del); err != nil {
+		return nil, err
+	}
+	return buildFkDeletePlan(del, reservedVars, vschema, nil)
+}
+
+// buildDeleteDMLPlan builds the instructions for a DELETE statement, without
+// the actions of the foreign keys. The table of the delete is returned too.
+func buildDeleteDMLPlan(del *sqlparser.Delete, reservedVars *sqlparser.ReservedVars, vschema plancontext.VSchema) (engine.Primitive, *vindexes.Table, error) {
+	if hasWith(del) {
+		dml, err := buildRecursiveCTEDMLPlan(del, "delete", vschema)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &engine.Delete{DML: dml}, nil, nil
 	}
 	var err error
 	if len(del.TableExprs) == 1 && len(del.Targets) == 1 {
 		del, err = rewriteSingleTbl(del)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
-	dml, ksidVindex, err := buildDMLPlan(vschema, "delete", del, reservedVars, del.TableExprs, del.Where, del.OrderBy, del.Limit, del.Comments, del.Targets)
+	dml, ksidVindex, pullouts, err := buildDMLPlan(vschema, "delete", del, reservedVars, del.TableExprs, del.Where, del.OrderBy, del.Limit, del.Comments, del.Targets)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	edel := &engine.Delete{DML: dml}
 
 	if dml.Opcode == engine.Unsharded {
-		return edel, nil
+		return pulloutDML(edel, pullouts), edel.Table, nil
 	}
 
 	if len(del.Targets) > 1 {
-		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "multi-table delete statement in not supported in sharded database")
+		return nil, nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "multi-table delete statement in not supported in sharded database")
 	}
 
 	if len(del.Targets) == 1 && del.Targets[0].Name != edel.Table.Name {
-		return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.UnknownTable, "Unknown table '%s' in MULTI DELETE", del.Targets[0].Name.String())
+		return nil, nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.UnknownTable, "Unknown table '%s' in MULTI DELETE", del.Targets[0].Name.String())
 	}
 
 	if len(edel.Table.Owned) > 0 {
 		aTblExpr, ok := del.TableExprs[0].(*sqlparser.AliasedTableExpr)
 		if !ok {
-			return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: delete on complex table expression")
+			return nil, nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: delete on complex table expression")
 		}
 		tblExpr := &sqlparser.AliasedTableExpr{Expr: sqlparser.TableName{Name: edel.Table.Name}, As: aTblExpr.As}
 		edel.OwnedVindexQuery = generateDMLSubquery(tblExpr, del.Where, del.OrderBy, del.Limit, edel.Table, ksidVindex.Columns)
@@ -66,7 +80,7 @@ func buildDeletePlan(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedV
 		edel.KsidLength = len(ksidVindex.Columns)
 	}
 
-	return edel, nil
+	return pulloutDML(edel, pullouts), edel.Table, nil
 }
 
 func rewriteSingleTbl(del *sqlparser.Delete) (*sqlparser.Delete, error) {
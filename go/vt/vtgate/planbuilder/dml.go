@@ -277,38 +277,44 @@ func costForDML(foundVindex *vindexes.ColumnVindex, opcode engine.Opcode) costDM
 	}
 }
 
-func buildDMLPlan(vschema plancontext.VSchema, dmlType string, stmt sqlparser.Statement, reservedVars *sqlparser.ReservedVars, tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, comments sqlparser.Comments, nodes ...sqlparser.SQLNode) (*engine.DML, *vindexes.ColumnVindex, error) {
+func buildDMLPlan(vschema plancontext.VSchema, dmlType string, stmt sqlparser.Statement, reservedVars *sqlparser.ReservedVars, tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, comments sqlparser.Comments, nodes ...sqlparser.SQLNode) (*engine.DML, *vindexes.ColumnVindex, []*engine.PulloutSubquery, error) {
 	edml := engine.NewDML()
 	pb := newPrimitiveBuilder(vschema, newJointab(reservedVars))
 	rb, err := pb.processDMLTable(tableExprs, reservedVars, nil)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	edml.Keyspace = rb.eroute.Keyspace
 	if !edml.Keyspace.Sharded {
 		// We only validate non-table subexpressions because the previous analysis has already validated them.
 		var subqueryArgs []sqlparser.SQLNode
 		subqueryArgs = append(subqueryArgs, nodes...)
-		subqueryArgs = append(subqueryArgs, where, orderBy, limit)
-		if pb.finalizeUnshardedDMLSubqueries(reservedVars, subqueryArgs...) {
+		subqueryArgs = append(subqueryArgs, orderBy, limit)
+		if !pb.finalizeUnshardedDMLSubqueries(reservedVars, subqueryArgs...) {
+			return nil, nil, nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: sharded subqueries in DML")
+		}
+		// The sharded subqueries of the WHERE clause are resolved before the DML.
+		pullouts, err := pb.pulloutDMLSubqueries(where, reservedVars)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if len(pullouts) == 0 {
 			vschema.WarnUnshardedOnly("subqueries can't be sharded in DML")
-		} else {
-			return nil, nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: sharded subqueries in DML")
 		}
 		edml.Opcode = engine.Unsharded
 		// Generate query after all the analysis. Otherwise table name substitutions for
 		// routed tables won't happen.
 		edml.Query = generateQuery(stmt)
-		return edml, nil, nil
+		return edml, nil, pullouts, nil
 	}
 
-	if hasSubquery(stmt) {
-		return nil, nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: subqueries in sharded DML")
+	if hasSubquery(tableExprs, orderBy, limit) || hasSubquery(nodes...) {
+		return nil, nil, nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: subqueries in sharded DML")
+	}
+	pullouts, err := pb.pulloutDMLSubqueries(where, reservedVars)
+	if err != nil {
+		return nil, nil, nil, err
 	}
-
-	// Generate query after all the analysis. Otherwise table name substitutions for
-	// routed tables won't happen.
-	edml.Query = generateQuery(stmt)
 
 	directives := sqlparser.ExtractCommentDirectives(comments)
 	if directives.IsSet(sqlparser.DirectiveMultiShardAutocommit) {
@@ -318,7 +324,7 @@ func buildDMLPlan(vschema plancontext.VSchema, dmlType string, stmt sqlparser.St
 	edml.QueryTimeout = queryTimeout(directives)
 
 	if len(pb.st.tables) != 1 {
-		return nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "multi-table %s statement is not supported in sharded database", dmlType)
+		return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "multi-table %s statement is not supported in sharded database", dmlType)
 	}
 	for _, tval := range pb.st.tables {
 		// There is only one table.
@@ -327,29 +333,125 @@ func buildDMLPlan(vschema plancontext.VSchema, dmlType string, stmt sqlparser.St
 
 	routingType, ksidVindex, vindex, values, err := getDMLRouting(where, edml.Table)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	if rb.eroute.TargetDestination != nil {
 		if rb.eroute.TargetTabletType != topodatapb.TabletType_PRIMARY {
-			return nil, nil, vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.InnodbReadOnly, "unsupported: %s statement with a replica target", dmlType)
+			return nil, nil, nil, vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.InnodbReadOnly, "unsupported: %s statement with a replica target", dmlType)
 		}
 		edml.Opcode = engine.ByDestination
 		edml.TargetDestination = rb.eroute.TargetDestination
-		return edml, ksidVindex, nil
+		edml.Query = generateQuery(stmt)
+		return edml, ksidVindex, pullouts, nil
 	}
 
 	edml.Opcode = routingType
 	if routingType == engine.Scatter {
 		if limit != nil {
-			return nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "multi shard %s with limit is not supported", dmlType)
+			if limit.Offset != nil {
+				return nil, nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: offset in multi shard %s", dmlType)
+			}
+			// The rows to change are selected first, and each shard
+			// is then sent the number of them it holds as limit.
+			edml.LimitQuery, err = pb.buildDMLLimitQuery(tableExprs, where, orderBy, limit, ksidVindex.Columns, reservedVars)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			edml.KsidVindex = ksidVindex.Vindex
+			edml.KsidLength = len(ksidVindex.Columns)
+			limit.Rowcount = sqlparser.NewArgument(engine.DMLLimitVar)
 		}
 	} else {
 		edml.Vindex = vindex
 		edml.Values = values
 	}
 
-	return edml, ksidVindex, nil
+	// Generate query after all the analysis. Otherwise table name substitutions for
+	// routed tables won't happen.
+	edml.Query = generateQuery(stmt)
+	return edml, ksidVindex, pullouts, nil
+}
+
+// pulloutDMLSubqueries pulls the subqueries of the WHERE clause that cannot be sent
+// along with the DML out of it. They are executed first, and their results are
+// passed to the DML as bind variables.
+func (pb *primitiveBuilder) pulloutDMLSubqueries(where *sqlparser.Where, reservedVars *sqlparser.ReservedVars) ([]*engine.PulloutSubquery, error) {
+	if where == nil || !hasSubquery(where) {
+		return nil, nil
+	}
+	pullouts, _, expr, err := pb.findOrigin(where.Expr, reservedVars)
+	if err != nil {
+		return nil, err
+	}
+	where.Expr = expr
+	if rb, ok := pb.plan.(*route); ok {
+		// The subqueries merged into the DML add their own substitutions.
+		for _, sub := range rb.substitutions {
+			*sub.oldExpr = *sub.newExpr
+		}
+	}
+
+	var epullouts []*engine.PulloutSubquery
+	for _, pullout := range pullouts {
+		if err := pullout.subquery.Wireup(pullout.subquery, pb.jt); err != nil {
+			return nil, err
+		}
+		pullout.eSubquery.Subquery = pullout.subquery.Primitive()
+		epullouts = append(epullouts, pullout.eSubquery)
+	}
+	return epullouts, nil
+}
+
+// buildDMLLimitQuery plans the select of the primary vindex columns of the rows
+// changed by a multi-shard DML with a LIMIT clause. The ORDER BY expressions are
+// selected too, so that the rows of all the shards are merged in that order.
+func (pb *primitiveBuilder) buildDMLLimitQuery(tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, ksidCols []sqlparser.ColIdent, reservedVars *sqlparser.ReservedVars) (engine.Primitive, error) {
+	sel := &sqlparser.Select{
+		From:    sqlparser.CloneTableExprs(tableExprs),
+		Where:   sqlparser.CloneRefOfWhere(where),
+		OrderBy: sqlparser.CloneOrderBy(orderBy),
+		Limit:   sqlparser.CloneRefOfLimit(limit),
+		Lock:    sqlparser.ForUpdateLock,
+	}
+	for _, col := range ksidCols {
+		sel.SelectExprs = append(sel.SelectExprs, &sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: col}})
+	}
+	for _, order := range sel.OrderBy {
+		sel.SelectExprs = append(sel.SelectExprs, &sqlparser.AliasedExpr{Expr: sqlparser.CloneExpr(order.Expr)})
+	}
+	// The columns are shared with the DML, which is done being analyzed.
+	resetColumns(sel)
+
+	spb := newPrimitiveBuilder(pb.vschema, pb.jt)
+	if err := spb.processSelect(sel, reservedVars, nil, ""); err != nil {
+		return nil, err
+	}
+	if err := spb.plan.Wireup(spb.plan, spb.jt); err != nil {
+		return nil, err
+	}
+	return spb.plan.Primitive(), nil
+}
+
+// resetColumns clears the analysis cached in the columns
+// of the node, so that they can be analyzed again.
+func resetColumns(node sqlparser.SQLNode) {
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if col, ok := node.(*sqlparser.ColName); ok {
+			col.Metadata = nil
+		}
+		return true, nil
+	}, node)
+}
+
+// pulloutDML makes the DML the underlying primitive
+// of the subqueries pulled out of its WHERE clause.
+func pulloutDML(dml engine.Primitive, pullouts []*engine.PulloutSubquery) engine.Primitive {
+	for _, pullout := range pullouts {
+		pullout.Underlying = dml
+		dml = pullout
+	}
+	return dml
 }
 
 func generateDMLSubquery(tblExpr sqlparser.TableExpr, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, table *vindexes.Table, ksidCols []sqlparser.ColIdent) string {
@@ -196,7 +196,7 @@ func (pb *primitiveBuilder) findOrigin(expr sqlparser.Expr, reservedVars *sqlpar
 
 var dummyErr = vterrors.Errorf(vtrpcpb.Code_INTERNAL, "dummy")
 
-func hasSubquery(node sqlparser.SQLNode) bool {
+func hasSubquery(nodes ...sqlparser.SQLNode) bool {
 	has := false
 	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
 		switch node.(type) {
@@ -205,7 +205,7 @@ func hasSubquery(node sqlparser.SQLNode) bool {
 			return false, dummyErr
 		}
 		return true, nil
-	}, node)
+	}, nodes...)
 	return has
 }
 
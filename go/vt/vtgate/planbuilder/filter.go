@@ -68,7 +68,11 @@ func newFilter(ctx *plancontext.PlanningContext, plan logicalPlan, expr sqlparse
 		ctx:  ctx,
 		plan: plan,
 	}
-	predicate, err := evalengine.Translate(expr, scl)
+	aggrExpr, err := offsetAggregations(ctx, plan, expr)
+	if err != nil {
+		return nil, err
+	}
+	predicate, err := evalengine.Translate(aggrExpr, scl)
 	if err != nil {
 		return nil, err
 	}
@@ -81,6 +85,29 @@ func newFilter(ctx *plancontext.PlanningContext, plan logicalPlan, expr sqlparse
 	}, nil
 }
 
+// offsetAggregations returns a copy of the expression where the aggregations have been
+// replaced with the offset of the column in which the plan returns their result
+func offsetAggregations(ctx *plancontext.PlanningContext, plan logicalPlan, expr sqlparser.Expr) (sqlparser.Expr, error) {
+	var err error
+	result := sqlparser.Rewrite(sqlparser.CloneExpr(expr), func(cursor *sqlparser.Cursor) bool {
+		aggr, isExpr := cursor.Node().(sqlparser.Expr)
+		if !isExpr || !sqlparser.IsAggregation(aggr) {
+			return true
+		}
+		var offset int
+		offset, _, err = pushProjection(ctx, &sqlparser.AliasedExpr{Expr: aggr}, plan, true, true, false)
+		if err != nil {
+			return false
+		}
+		cursor.Replace(sqlparser.Offset(offset))
+		return false
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return result.(sqlparser.Expr), nil
+}
+
 // Primitive implements the logicalPlan interface
 func (l *filter) Primitive() engine.Primitive {
 	l.efilter.Input = l.input.Primitive()
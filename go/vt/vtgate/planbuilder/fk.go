@@ -0,0 +1,339 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/plancontext"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// The foreign keys of the vschema are enforced by vtgate: the parent rows
+// referenced by the rows an INSERT or an UPDATE writes are checked first,
+// and the ON DELETE actions are taken on the child rows before a DELETE.
+// All of it runs in the transaction of the DML.
+
+// buildInsertFkChecks returns the checks of the parent rows referenced by the inserted rows.
+func buildInsertFkChecks(ins *sqlparser.Insert, table *vindexes.Table, reservedVars *sqlparser.ReservedVars, vschema plancontext.VSchema) ([]*engine.FkCheck, error) {
+	if len(table.ChildForeignKeys) > 0 {
+		if ins.Action == sqlparser.ReplaceAct {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: REPLACE INTO table %s referenced by foreign keys", table.Name)
+		}
+		for _, upd := range ins.OnDup {
+			if isFkReferenced(table, upd.Name.Name) {
+				return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: update of column %s referenced by a foreign key", upd.Name.Name)
+			}
+		}
+	}
+	if len(table.ForeignKeys) == 0 {
+		return nil, nil
+	}
+	rows, ok := ins.Rows.(sqlparser.Values)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: INSERT ... SELECT into table %s with foreign keys", table.Name)
+	}
+	for _, upd := range ins.OnDup {
+		if isFkColumn(table, upd.Name.Name) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: ON DUPLICATE KEY UPDATE of foreign key column %s", upd.Name.Name)
+		}
+	}
+	columns := ins.Columns
+	if len(columns) == 0 {
+		if !table.ColumnListAuthoritative {
+			return nil, errors.New("column list required for tables with foreign keys")
+		}
+		for _, col := range table.Columns {
+			columns = append(columns, col.Name)
+		}
+	}
+
+	var checks []*engine.FkCheck
+	for _, fk := range table.ForeignKeys {
+		offsets := make([]int, 0, len(fk.Columns))
+		for _, col := range fk.Columns {
+			if offset := columns.FindColumn(col); offset >= 0 {
+				offsets = append(offsets, offset)
+			}
+		}
+		if len(offsets) != len(fk.Columns) {
+			// The columns left out get their default value, which does
+			// not reference any parent row.
+			continue
+		}
+		check := &engine.FkCheck{}
+		for _, row := range rows {
+			if len(row) != len(columns) {
+				return nil, errors.New("column list doesn't match values")
+			}
+			values := make([]evalengine.Expr, 0, len(offsets))
+			for i, offset := range offsets {
+				value, err := evalengine.Translate(row[offset], semantics.EmptySemTable())
+				if err != nil {
+					return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: Only values are supported for foreign key column: `%s` with expr: [%s]", fk.Columns[i].String(), sqlparser.String(row[offset]))
+				}
+				values = append(values, value)
+			}
+			check.Values = append(check.Values, values)
+		}
+		parent, err := buildFkSelect(fk.ParentTable, fk.ParentColumns, reservedVars, vschema)
+		if err != nil {
+			return nil, err
+		}
+		check.Parent = parent
+		checks = append(checks, check)
+	}
+	return checks, nil
+}
+
+// buildUpdateFkChecks returns the checks of the parent rows referenced by the updated rows.
+func buildUpdateFkChecks(upd *sqlparser.Update, table *vindexes.Table, reservedVars *sqlparser.ReservedVars, vschema plancontext.VSchema) ([]*engine.FkCheck, error) {
+	for _, assignment := range upd.Exprs {
+		if isFkReferenced(table, assignment.Name.Name) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: update of column %s referenced by a foreign key", assignment.Name.Name)
+		}
+	}
+
+	var checks []*engine.FkCheck
+	for _, fk := range table.ForeignKeys {
+		var assignments []*sqlparser.UpdateExpr
+		for _, col := range fk.Columns {
+			for _, assignment := range upd.Exprs {
+				if col.Equal(assignment.Name.Name) {
+					assignments = append(assignments, assignment)
+				}
+			}
+		}
+		if len(assignments) == 0 {
+			continue
+		}
+		if len(assignments) != len(fk.Columns) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: You need to update all the foreign key columns together. Invalid update on table: %s", table.Name)
+		}
+		values := make([]evalengine.Expr, 0, len(assignments))
+		nulls := 0
+		for _, assignment := range assignments {
+			value, err := extractValueFromUpdate(assignment)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := assignment.Expr.(*sqlparser.NullVal); ok {
+				nulls++
+			}
+			values = append(values, value)
+		}
+		if nulls == len(values) {
+			// Setting the foreign key to NULL does not reference any parent row.
+			continue
+		}
+		parent, err := buildFkSelect(fk.ParentTable, fk.ParentColumns, reservedVars, vschema)
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, &engine.FkCheck{Values: [][]evalengine.Expr{values}, Parent: parent})
+	}
+	return checks, nil
+}
+
+// withFkChecks makes the DML run after the checks, if any.
+func withFkChecks(dml engine.Primitive, checks []*engine.FkCheck) engine.Primitive {
+	if len(checks) == 0 {
+		return dml
+	}
+	return &engine.FkVerify{Checks: checks, DML: dml}
+}
+
+// buildFkDeletePlan builds the plan of a DELETE, taking the ON DELETE actions
+// of the foreign keys referencing the deleted rows first. The tables the
+// delete cascades from are given, so that cyclic cascades are rejected.
+func buildFkDeletePlan(del *sqlparser.Delete, reservedVars *sqlparser.ReservedVars, vschema plancontext.VSchema, cascadedFrom []*vindexes.Table) (engine.Primitive, error) {
+	orig := sqlparser.CloneRefOfDelete(del)
+	dml, table, err := buildDeleteDMLPlan(del, reservedVars, vschema)
+	if err != nil {
+		return nil, err
+	}
+	table, err = dmlTable(table, orig.TableExprs, vschema)
+	if err != nil {
+		return nil, err
+	}
+	if table == nil || len(table.ChildForeignKeys) == 0 {
+		return dml, nil
+	}
+	for _, t := range cascadedFrom {
+		if t == table {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cyclic foreign key cascade on table %s", table.Name)
+		}
+	}
+	if len(orig.TableExprs) != 1 || len(orig.Targets) > 1 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table delete on table %s referenced by foreign keys", table.Name)
+	}
+	if hasWith(orig) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression in delete on table %s referenced by foreign keys", table.Name)
+	}
+
+	// Select the referenced columns of the rows to delete, in the order they are deleted.
+	sel := &sqlparser.Select{
+		From:    orig.TableExprs,
+		Where:   orig.Where,
+		OrderBy: orig.OrderBy,
+		Limit:   orig.Limit,
+		Lock:    sqlparser.ForUpdateLock,
+	}
+	var selected []sqlparser.ColIdent
+	cascaded := append(append([]*vindexes.Table{}, cascadedFrom...), table)
+	fkc := &engine.FkCascade{DML: dml}
+	for _, fk := range table.ChildForeignKeys {
+		child := &engine.FkChild{OnDelete: fk.OnDelete}
+		for _, col := range fk.ParentColumns {
+			offset := sqlparser.Columns(selected).FindColumn(col)
+			if offset < 0 {
+				offset = len(selected)
+				selected = append(selected, col)
+				sel.SelectExprs = append(sel.SelectExprs, &sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: col}})
+			}
+			child.Cols = append(child.Cols, offset)
+		}
+		child.Exec, err = buildFkChildPlan(fk, reservedVars, vschema, cascaded)
+		if err != nil {
+			return nil, err
+		}
+		fkc.Children = append(fkc.Children, child)
+	}
+	for _, order := range sel.OrderBy {
+		sel.SelectExprs = append(sel.SelectExprs, &sqlparser.AliasedExpr{Expr: sqlparser.CloneExpr(order.Expr)})
+	}
+	// The columns are shared with the DML, which is done being analyzed.
+	resetColumns(sel)
+	fkc.Selection, err = planFkSelect(sel, reservedVars, vschema)
+	if err != nil {
+		return nil, err
+	}
+	return fkc, nil
+}
+
+// buildFkChildPlan plans the ON DELETE action of a foreign key on the
+// child rows referencing the values bound to the FkVarName variables.
+func buildFkChildPlan(fk *vindexes.ForeignKey, reservedVars *sqlparser.ReservedVars, vschema plancontext.VSchema, cascadedFrom []*vindexes.Table) (engine.Primitive, error) {
+	tableExprs := sqlparser.TableExprs{&sqlparser.AliasedTableExpr{Expr: fkTableName(fk.Table)}}
+	switch fk.OnDelete {
+	case vindexes.FkCascade:
+		return buildFkDeletePlan(&sqlparser.Delete{
+			TableExprs: tableExprs,
+			Where:      fkWhere(fk.Columns),
+		}, reservedVars, vschema, cascadedFrom)
+	case vindexes.FkSetNull:
+		upd := &sqlparser.Update{
+			TableExprs: tableExprs,
+			Where:      fkWhere(fk.Columns),
+		}
+		for _, col := range fk.Columns {
+			if len(fk.Table.ColumnVindexes) > 0 && sqlparser.Columns(fk.Table.ColumnVindexes[0].Columns).FindColumn(col) >= 0 {
+				return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: ON DELETE SET NULL of primary vindex column %s of table %s", col, fk.Table.Name)
+			}
+			upd.Exprs = append(upd.Exprs, &sqlparser.UpdateExpr{Name: &sqlparser.ColName{Name: col}, Expr: &sqlparser.NullVal{}})
+		}
+		return buildUpdatePlan(upd, reservedVars, vschema)
+	default:
+		return buildFkSelect(fk.Table, fk.Columns, reservedVars, vschema)
+	}
+}
+
+// buildFkSelect plans the select of one row of the table
+// matching the values bound to the FkVarName variables.
+func buildFkSelect(table *vindexes.Table, columns []sqlparser.ColIdent, reservedVars *sqlparser.ReservedVars, vschema plancontext.VSchema) (engine.Primitive, error) {
+	return planFkSelect(&sqlparser.Select{
+		SelectExprs: sqlparser.SelectExprs{&sqlparser.AliasedExpr{Expr: sqlparser.NewIntLiteral("1")}},
+		From:        sqlparser.TableExprs{&sqlparser.AliasedTableExpr{Expr: fkTableName(table)}},
+		Where:       fkWhere(columns),
+		Limit:       &sqlparser.Limit{Rowcount: sqlparser.NewIntLiteral("1")},
+		Lock:        sqlparser.ShareModeLock,
+	}, reservedVars, vschema)
+}
+
+func planFkSelect(sel *sqlparser.Select, reservedVars *sqlparser.ReservedVars, vschema plancontext.VSchema) (engine.Primitive, error) {
+	pb := newPrimitiveBuilder(vschema, newJointab(reservedVars))
+	if err := pb.processSelect(sel, reservedVars, nil, ""); err != nil {
+		return nil, err
+	}
+	if err := pb.plan.Wireup(pb.plan, pb.jt); err != nil {
+		return nil, err
+	}
+	return pb.plan.Primitive(), nil
+}
+
+// dmlTable returns the table of a single-table DML. The plans of the
+// unsharded DMLs do not hold it, so it is looked up in the vschema.
+func dmlTable(table *vindexes.Table, tableExprs sqlparser.TableExprs, vschema plancontext.VSchema) (*vindexes.Table, error) {
+	if table != nil || len(tableExprs) != 1 {
+		return table, nil
+	}
+	aliased, ok := tableExprs[0].(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return nil, nil
+	}
+	name, ok := aliased.Expr.(sqlparser.TableName)
+	if !ok {
+		return nil, nil
+	}
+	table, _, _, _, _, err := vschema.FindTableOrVindex(name)
+	return table, err
+}
+
+func fkTableName(table *vindexes.Table) sqlparser.TableName {
+	return sqlparser.TableName{Name: table.Name, Qualifier: sqlparser.NewTableIdent(table.Keyspace.Name)}
+}
+
+// fkWhere returns the condition matching the columns
+// with the values bound to the FkVarName variables.
+func fkWhere(columns []sqlparser.ColIdent) *sqlparser.Where {
+	var filters []sqlparser.Expr
+	for i, col := range columns {
+		filters = append(filters, &sqlparser.ComparisonExpr{
+			Operator: sqlparser.EqualOp,
+			Left:     &sqlparser.ColName{Name: col},
+			Right:    sqlparser.NewArgument(engine.FkVarName(i)),
+		})
+	}
+	return sqlparser.NewWhere(sqlparser.WhereClause, sqlparser.AndExpressions(filters...))
+}
+
+// isFkColumn returns true if the column belongs to a foreign key of the table.
+func isFkColumn(table *vindexes.Table, col sqlparser.ColIdent) bool {
+	for _, fk := range table.ForeignKeys {
+		if sqlparser.Columns(fk.Columns).FindColumn(col) >= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// isFkReferenced returns true if the column is referenced by a foreign key.
+func isFkReferenced(table *vindexes.Table, col sqlparser.ColIdent) bool {
+	for _, fk := range table.ChildForeignKeys {
+		if sqlparser.Columns(fk.ParentColumns).FindColumn(col) >= 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -36,15 +36,11 @@ func gen4Planner(query string, plannerVersion querypb.ExecuteOptions_PlannerVers
 		if !ok {
 			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "%T not yet supported", stmt)
 		}
-		switch node := selStatement.(type) {
-		case *sqlparser.Select:
-			if node.With != nil {
-				return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: with expression in select statement")
-			}
-		case *sqlparser.Union:
-			if node.With != nil {
-				return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: with expression in union statement")
-			}
+		if err := inlineCTEs(selStatement); err != nil {
+			return nil, err
+		}
+		if hasWith(selStatement) {
+			return buildRecursiveCTEPlan(selStatement, reservedVars, vschema, plannerVersion)
 		}
 
 		sel, isSel := selStatement.(*sqlparser.Select)
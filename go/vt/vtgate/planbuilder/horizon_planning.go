@@ -58,6 +58,22 @@ func (hp *horizonPlanning) planHorizon(ctx *plancontext.PlanningContext, plan lo
 		return nil, err
 	}
 
+	if hp.hasWindowFunctions() {
+		funcs, err := hp.windowFunctions(ctx, plan)
+		if err != nil {
+			return nil, err
+		}
+		if !windowsAlignedWithVindex(ctx, funcs) {
+			return hp.planWindowFunctions(ctx, rb, funcs)
+		}
+		// every partition is in a single shard, so the window functions can be computed by the shards
+		sel, isSel := rb.Select.(*sqlparser.Select)
+		if !isSel {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unexpected %T below a window function", rb.Select)
+		}
+		sel.Windows = hp.sel.Windows
+	}
+
 	needsOrdering := len(hp.qp.OrderExprs) > 0
 	canShortcut := isRoute && hp.sel.Having == nil && !needsOrdering
 
@@ -125,12 +141,14 @@ func (hp *horizonPlanning) truncateColumnsIfNeeded(ctx *plancontext.PlanningCont
 	switch p := plan.(type) {
 	case *routeGen4:
 		p.eroute.SetTruncateColumnCount(hp.sel.GetColumnCount())
-	case *joinGen4, *semiJoin, *hashJoin:
+	case *joinGen4, *semiJoin, *correlatedSubquery, *hashJoin:
 		// since this is a join, we can safely add extra columns and not need to truncate them
 	case *orderedAggregate:
 		p.truncateColumnCount = hp.sel.GetColumnCount()
 	case *memorySort:
 		p.truncater.SetTruncateColumnCount(hp.sel.GetColumnCount())
+	case *window:
+		p.eWindow.SetTruncateColumnCount(hp.sel.GetColumnCount())
 	case *pulloutSubquery:
 		newUnderlyingPlan, err := hp.truncateColumnsIfNeeded(ctx, p.underlying)
 		if err != nil {
@@ -143,7 +161,7 @@ func (hp *horizonPlanning) truncateColumnsIfNeeded(ctx *plancontext.PlanningCont
 			eSimpleProj:       &engine.SimpleProjection{},
 		}
 
-		err := pushProjections(ctx, plan, hp.qp.SelectExprs)
+		err := pushProjections(ctx, plan, hp.qp.SelectExprs[:hp.qp.GetColumnCount()])
 		if err != nil {
 			return nil, err
 		}
@@ -321,29 +339,14 @@ func pushProjection(
 			return 0, false, err
 		}
 		return i /* col added */, len(node.eVindexFunc.Cols) > colsBefore, nil
-	case *limit, *projection, *pulloutSubquery, *distinct, *filter:
+	case *limit, *projection, *pulloutSubquery, *distinct, *filter, *window:
 		// All of these either push to the single source, or push to the LHS
 		src := node.Inputs()[0]
 		return pushProjection(ctx, expr, src, inner, reuseCol, hasAggregation)
 	case *semiJoin:
-		passDownReuseCol := reuseCol
-		if !reuseCol {
-			passDownReuseCol = expr.As.IsEmpty()
-		}
-		offset, added, err := pushProjection(ctx, expr, node.lhs, inner, passDownReuseCol, hasAggregation)
-		if err != nil {
-			return 0, false, err
-		}
-		column := -(offset + 1)
-		if reuseCol && !added {
-			for idx, col := range node.cols {
-				if column == col {
-					return idx, false, nil
-				}
-			}
-		}
-		node.cols = append(node.cols, column)
-		return len(node.cols) - 1, true, nil
+		return pushProjectionOnLHS(ctx, expr, node.lhs, &node.cols, inner, reuseCol, hasAggregation)
+	case *correlatedSubquery:
+		return pushProjectionOnLHS(ctx, expr, node.outer, &node.cols, inner, reuseCol, hasAggregation)
 	case *concatenateGen4:
 		if hasAggregation {
 			return 0, false, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: aggregation on unions")
@@ -369,6 +372,29 @@ func pushProjection(
 	}
 }
 
+// pushProjectionOnLHS pushes the projection to the LHS of a plan that only returns columns coming from its LHS,
+// like semi-joins and correlated subqueries, and keeps track of the columns it returns in cols
+func pushProjectionOnLHS(ctx *plancontext.PlanningContext, expr *sqlparser.AliasedExpr, lhs logicalPlan, cols *[]int, inner, reuseCol, hasAggregation bool) (offset int, added bool, err error) {
+	passDownReuseCol := reuseCol
+	if !reuseCol {
+		passDownReuseCol = expr.As.IsEmpty()
+	}
+	offset, added, err = pushProjection(ctx, expr, lhs, inner, passDownReuseCol, hasAggregation)
+	if err != nil {
+		return 0, false, err
+	}
+	column := -(offset + 1)
+	if reuseCol && !added {
+		for idx, col := range *cols {
+			if column == col {
+				return idx, false, nil
+			}
+		}
+	}
+	*cols = append(*cols, column)
+	return len(*cols) - 1, true, nil
+}
+
 func addExpressionToRoute(ctx *plancontext.PlanningContext, rb *routeGen4, expr *sqlparser.AliasedExpr, reuseCol bool) (int, bool, error) {
 	if reuseCol {
 		if i := checkIfAlreadyExists(expr, rb.Select, ctx.SemTable); i != -1 {
@@ -461,7 +487,7 @@ func checkIfAlreadyExists(expr *sqlparser.AliasedExpr, node sqlparser.SelectStat
 }
 
 func (hp *horizonPlanning) planAggregations(ctx *plancontext.PlanningContext, plan logicalPlan) (logicalPlan, error) {
-	isPushable := !isJoin(plan)
+	isPushable := !isJoin(plan) && !isUnion(plan) && !isCorrelatedSubquery(plan)
 	grouping := hp.qp.GetGrouping()
 	vindexOverlapWithGrouping := hasUniqueVindex(ctx.VSchema, ctx.SemTable, grouping)
 	if isPushable && vindexOverlapWithGrouping {
@@ -500,6 +526,15 @@ func (hp *horizonPlanning) planAggrUsingOA(
 		groupByKeys: make([]*engine.GroupByParams, 0, len(grouping)),
 	}
 
+	if hp.sel.Having != nil {
+		// the HAVING clause is evaluated on top of the OA, so the aggregations
+		// it uses have to be computed even when the query does not select them
+		err := hp.qp.AddAggregations(hp.sel.Having.Expr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var order []abstract.OrderBy
 	if hp.qp.CanPushDownSorting {
 		hp.qp.AlignGroupByAndOrderBy()
@@ -527,9 +562,12 @@ func (hp *horizonPlanning) planAggrUsingOA(
 		return nil, err
 	}
 
+	// the rows of a union can't be aggregated by the routes below it, so they are all aggregated at the vtgate level
+	unionInput := isUnion(plan)
+
 	// If we have a distinct aggregating expression,
 	// we handle it by pushing it down to the underlying input as a grouping column
-	distinctGroupBy, distinctOffsets, aggrs, err := hp.handleDistinctAggr(ctx, aggregationExprs)
+	distinctGroupBy, distinctOffsets, aggrs, err := hp.handleDistinctAggr(ctx, aggregationExprs, unionInput)
 	if err != nil {
 		return nil, err
 	}
@@ -560,7 +598,12 @@ func (hp *horizonPlanning) planAggrUsingOA(
 		aggPlan = proj
 	}
 
-	aggrParams, err := generateAggregateParams(aggrs, aggrParamOffsets, proj)
+	var aggrParams []*engine.AggregateParams
+	if unionInput {
+		aggrParams, err = generateUnionAggregateParams(aggrs, aggrParamOffsets, proj)
+	} else {
+		aggrParams, err = generateAggregateParams(aggrs, aggrParamOffsets, proj)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -585,6 +628,24 @@ func (hp *horizonPlanning) planAggrUsingOA(
 		weightStrings:     make(map[*resultColumn]int),
 	}
 
+	// the OA only returns the grouping columns and the aggregations, so the constant
+	// columns of the select list, which don't have to be grouped, can't be produced by it
+	for _, expr := range hp.qp.SelectExprs {
+		if expr.Aggr {
+			continue
+		}
+		ae, err := expr.GetAliasedExpr()
+		if err != nil {
+			return nil, err
+		}
+		if !sqlparser.IsConstant(ae.Expr) {
+			continue
+		}
+		if _, _, err := pushProjection(ctx, ae, oa, true, true, false); err != nil {
+			return nil, err
+		}
+	}
+
 	return hp.planHaving(ctx, oa)
 }
 
@@ -653,6 +714,40 @@ func generateAggregateParams(aggrs []abstract.Aggr, aggrParamOffsets [][]offsets
 	return aggrParams, nil
 }
 
+// generateUnionAggregateParams creates the aggregate params for aggregations on top of the rows of a union.
+// The projection turns every row into the partial aggregate for that single row, so that
+// the ordered aggregate can merge them the same way it merges the results from different shards
+func generateUnionAggregateParams(aggrs []abstract.Aggr, aggrParamOffsets [][]offsets, proj *projection) ([]*engine.AggregateParams, error) {
+	aggrParams := make([]*engine.AggregateParams, len(aggrs))
+	for idx, aggr := range aggrs {
+		var aggrExpr sqlparser.Expr
+		switch {
+		case len(aggrParamOffsets[idx]) == 0:
+			// count(*) counts every row
+			aggrExpr = sqlparser.NewIntLiteral("1")
+		case aggr.OpCode == engine.AggregateCount:
+			aggrExpr = &sqlparser.IsExpr{
+				Left:  sqlparser.Offset(aggrParamOffsets[idx][0].col),
+				Right: sqlparser.IsNotNullOp,
+			}
+		default:
+			aggrExpr = sqlparser.Offset(aggrParamOffsets[idx][0].col)
+		}
+
+		offset, err := proj.addColumn(aggr.Index, aggrExpr, aggr.Alias)
+		if err != nil {
+			return nil, err
+		}
+		aggrParams[idx] = &engine.AggregateParams{
+			Opcode: aggr.OpCode,
+			Col:    offset,
+			Alias:  aggr.Alias,
+			Expr:   aggr.Func,
+		}
+	}
+	return aggrParams, nil
+}
+
 func addColumnsToOA(
 	ctx *plancontext.PlanningContext,
 	oa *orderedAggregate,
@@ -715,8 +810,10 @@ func addColumnsToOA(
 
 // handleDistinctAggr takes in a slice of aggregations and returns GroupBy elements that replace
 // the distinct aggregations in the input, along with a slice of offsets and the non-distinct aggregations left,
-// so we can later reify the original aggregations
-func (hp *horizonPlanning) handleDistinctAggr(ctx *plancontext.PlanningContext, exprs []abstract.Aggr) (
+// so we can later reify the original aggregations.
+// When the input is the result of a union, the same value can come from different shards even if it
+// has a vindex, so all the distinct aggregations are handled at the vtgate level
+func (hp *horizonPlanning) handleDistinctAggr(ctx *plancontext.PlanningContext, exprs []abstract.Aggr, unionInput bool) (
 	distincts []abstract.GroupBy, offsets []int, aggrs []abstract.Aggr, err error) {
 	var distinctExpr sqlparser.Expr
 	for i, expr := range exprs {
@@ -733,7 +830,7 @@ func (hp *horizonPlanning) handleDistinctAggr(ctx *plancontext.PlanningContext,
 		if err != nil {
 			return nil, nil, nil, err
 		}
-		if exprHasVindex(ctx.VSchema, ctx.SemTable, innerWS, false) {
+		if !unionInput && exprHasVindex(ctx.VSchema, ctx.SemTable, innerWS, false) {
 			aggrs = append(aggrs, expr)
 			continue
 		}
@@ -869,11 +966,14 @@ func (hp *horizonPlanning) planOrderBy(ctx *plancontext.PlanningContext, orderEx
 	case *memorySort:
 		return plan, nil
 	case *simpleProjection:
-		return hp.createMemorySortPlan(ctx, plan, orderExprs, true)
+		return hp.createMemorySortPlan(ctx, plan, orderExprs, !isUnion(plan.input))
+	case *concatenateGen4:
+		// weight_string() columns can't be added to the results of a union
+		return hp.createMemorySortPlan(ctx, plan, orderExprs /* useWeightStr */, false)
 	case *vindexFunc:
 		// This is evaluated at VTGate only, so weight_string function cannot be used.
 		return hp.createMemorySortPlan(ctx, plan, orderExprs /* useWeightStr */, false)
-	case *limit, *semiJoin, *filter, *pulloutSubquery, *projection:
+	case *limit, *semiJoin, *correlatedSubquery, *filter, *pulloutSubquery, *projection:
 		inputs := plan.Inputs()
 		if len(inputs) == 0 {
 			break
@@ -1098,6 +1198,10 @@ func findExprInOrderedAggr(plan *orderedAggregate, order abstract.OrderBy) (keyC
 }
 
 func (hp *horizonPlanning) createMemorySortPlan(ctx *plancontext.PlanningContext, plan logicalPlan, orderExprs []abstract.OrderBy, useWeightStr bool) (logicalPlan, error) {
+	if len(orderExprs) == 0 {
+		// nothing to sort, e.g. when aggregating without grouping
+		return plan, nil
+	}
 	primitive := &engine.MemorySort{}
 	ms := &memorySort{
 		resultsBuilder: resultsBuilder{
@@ -1350,6 +1454,36 @@ func isJoin(plan logicalPlan) bool {
 	}
 }
 
+// isCorrelatedSubquery returns true if the rows of the plan are filtered by a correlated subquery
+// evaluated at the vtgate level, in which case the grouping can't be done by the underlying route alone.
+func isCorrelatedSubquery(plan logicalPlan) bool {
+	switch plan := plan.(type) {
+	case *semiJoin, *correlatedSubquery:
+		return true
+	case *pulloutSubquery:
+		return isCorrelatedSubquery(plan.underlying)
+	default:
+		return false
+	}
+}
+
+// isUnion returns true if the rows produced by the plan are the result of a UNION.
+// Only the columns in the select list of the union can be fetched from such a plan,
+// so grouping and ordering on top of it have to be done without weight_string() columns.
+func isUnion(plan logicalPlan) bool {
+	switch plan := plan.(type) {
+	case *concatenateGen4:
+		return true
+	case *routeGen4:
+		_, isUnion := plan.Select.(*sqlparser.Union)
+		return isUnion
+	case *distinct, *simpleProjection:
+		return isUnion(plan.Inputs()[0])
+	default:
+		return false
+	}
+}
+
 func exprHasUniqueVindex(vschema plancontext.VSchema, semTable *semantics.SemTable, expr sqlparser.Expr) bool {
 	return exprHasVindex(vschema, semTable, expr, true)
 }
@@ -1418,6 +1552,7 @@ func stripDownQuery(from, to sqlparser.SelectStatement) error {
 		toNode.Distinct = node.Distinct
 		toNode.GroupBy = node.GroupBy
 		toNode.Having = node.Having
+		toNode.Windows = node.Windows
 		toNode.OrderBy = node.OrderBy
 		toNode.Comments = node.Comments
 		toNode.SelectExprs = node.SelectExprs
@@ -1458,7 +1593,7 @@ func planGroupByGen4(ctx *plancontext.PlanningContext, groupExpr abstract.GroupB
 		return nil
 	case *pulloutSubquery:
 		return planGroupByGen4(ctx, groupExpr, node.underlying, wsAdded)
-	case *semiJoin:
+	case *semiJoin, *correlatedSubquery:
 		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: group by in a query having a correlated subquery")
 	default:
 		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: group by on: %T", plan)
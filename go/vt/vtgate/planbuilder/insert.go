@@ -57,13 +57,21 @@ func buildInsertPlan(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedV
 		// There is only one table.
 		vschemaTable = tval.vschemaTable
 	}
+	// The values of the foreign keys are taken before the rows get rewritten.
+	checks, err := buildInsertFkChecks(ins, vschemaTable, reservedVars, vschema)
+	if err != nil {
+		return nil, err
+	}
+	var eins engine.Primitive
 	if !rb.eroute.Keyspace.Sharded {
-		return buildInsertUnshardedPlan(ins, vschemaTable, reservedVars, vschema)
+		eins, err = buildInsertUnshardedPlan(ins, vschemaTable, reservedVars, vschema)
+	} else {
+		eins, err = buildInsertShardedPlan(ins, vschemaTable, reservedVars, vschema)
 	}
-	if ins.Action == sqlparser.ReplaceAct {
-		return nil, errors.New("unsupported: REPLACE INTO with sharded schema")
+	if err != nil {
+		return nil, err
 	}
-	return buildInsertShardedPlan(ins, vschemaTable, reservedVars, vschema)
+	return withFkChecks(eins, checks), nil
 }
 
 func buildInsertUnshardedPlan(ins *sqlparser.Insert, table *vindexes.Table, reservedVars *sqlparser.ReservedVars, vschema plancontext.VSchema) (engine.Primitive, error) {
@@ -75,16 +83,32 @@ func buildInsertUnshardedPlan(ins *sqlparser.Insert, table *vindexes.Table, rese
 	var rows sqlparser.Values
 	switch insertValues := ins.Rows.(type) {
 	case *sqlparser.Select, *sqlparser.Union:
-		if eins.Table.AutoIncrement != nil {
-			return nil, errors.New("unsupported: auto-inc and select in insert")
+		if eins.Table.AutoIncrement == nil {
+			plan, err := subquerySelectPlan(ins, vschema, reservedVars, false)
+			if err != nil {
+				return nil, err
+			}
+			if route, ok := plan.(*engine.Route); ok && !route.Keyspace.Sharded && table.Keyspace.Name == route.Keyspace.Name {
+				eins.Query = generateQuery(ins)
+				return eins, nil
+			}
+			eins.Input = plan
+			generateInsertSelectQuery(ins, eins)
+			return eins, nil
+		}
+		// The selected rows are fetched by vtgate, which fills in the sequence values.
+		if len(ins.Columns) == 0 {
+			if !table.ColumnListAuthoritative {
+				return nil, errors.New("column list required for tables with auto-inc columns")
+			}
+			populateInsertColumnlist(ins, table)
 		}
-		plan, err := subquerySelectPlan(ins, vschema, reservedVars, false)
+		plan, err := subquerySelectPlan(ins, vschema, reservedVars, true)
 		if err != nil {
 			return nil, err
 		}
-		if route, ok := plan.(*engine.Route); ok && !route.Keyspace.Sharded && table.Keyspace.Name == route.Keyspace.Name {
-			eins.Query = generateQuery(ins)
-			return eins, nil
+		if err := modifyForAutoinc(ins, eins); err != nil {
+			return nil, err
 		}
 		eins.Input = plan
 		generateInsertSelectQuery(ins, eins)
@@ -139,6 +163,11 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table, reserv
 
 	applyCommentDirectives(ins, eins)
 	eins.ColVindexes = getColVindexes(eins.Table.ColumnVindexes)
+	if ins.Action == sqlparser.ReplaceAct {
+		if err := buildReplaceOwnedVindexQuery(table, eins); err != nil {
+			return nil, err
+		}
+	}
 
 	// Till here common plan building done for insert by providing values or select query.
 
@@ -175,6 +204,25 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table, reserv
 			}
 		}
 	}
+	// The replace keys are read before the vindex columns are replaced by bind variables.
+	keyOffsets, err := extractReplaceKeyOffsets(ins, eins)
+	if err != nil {
+		return nil, err
+	}
+	replaceValues := make([][][]evalengine.Expr, len(keyOffsets))
+	for keyIdx, offsets := range keyOffsets {
+		replaceValues[keyIdx] = make([][]evalengine.Expr, len(offsets))
+		for colIdx, colNum := range offsets {
+			replaceValues[keyIdx][colIdx] = make([]evalengine.Expr, len(rows))
+			for rowNum, row := range rows {
+				innerpv, err := evalengine.Translate(row[colNum], semantics.EmptySemTable())
+				if err != nil {
+					return nil, err
+				}
+				replaceValues[keyIdx][colIdx][rowNum] = innerpv
+			}
+		}
+	}
 	for _, colVindex := range colVindexes {
 		for _, col := range colVindex.Columns {
 			colNum := findOrAddColumn(ins, col)
@@ -185,6 +233,7 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table, reserv
 		}
 	}
 	eins.VindexValues = routeValues
+	eins.ReplaceKeyValues = replaceValues
 	eins.Query = generateQuery(ins)
 	generateInsertShardedQuery(ins, eins, rows)
 	return eins, nil
@@ -219,11 +268,48 @@ func buildInsertSelectPlan(ins *sqlparser.Insert, table *vindexes.Table, reserve
 	if err != nil {
 		return nil, err
 	}
+	eins.ReplaceKeyOffsets, err = extractReplaceKeyOffsets(ins, eins)
+	if err != nil {
+		return nil, err
+	}
 
 	generateInsertSelectQuery(ins, eins)
 	return eins, nil
 }
 
+// buildReplaceOwnedVindexQuery generates the query selecting the rows a sharded REPLACE
+// replaces, so that the lookup vindex entries owned by these rows are deleted. Those rows
+// are found through the primary key and the unique keys of the table.
+func buildReplaceOwnedVindexQuery(table *vindexes.Table, eins *engine.Insert) error {
+	if len(table.Owned) == 0 || len(eins.ColVindexes) == 0 {
+		return nil
+	}
+	if len(table.UniqueKeys) == 0 {
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: REPLACE INTO table %s owning lookup vindexes without unique_keys in the vschema", table.Name.String())
+	}
+	buf, _ := initialQuery(eins.ColVindexes[0].Columns, table)
+	buf.Myprintf(" from %v", sqlparser.TableName{Name: table.Name})
+	eins.OwnedVindexQuery = buf.String()
+	eins.ReplaceKeys = table.UniqueKeys
+	return nil
+}
+
+// extractReplaceKeyOffsets returns the positions of the columns of the replace keys
+// on the insert column list. They must all be given by the insert.
+func extractReplaceKeyOffsets(ins *sqlparser.Insert, eins *engine.Insert) ([][]int, error) {
+	offsets := make([][]int, len(eins.ReplaceKeys))
+	for keyIdx, keyCols := range eins.ReplaceKeys {
+		for _, col := range keyCols {
+			colNum := findColumn(ins, col)
+			if colNum == -1 {
+				return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "replace query does not have unique key column '%v' in the column list", col)
+			}
+			offsets[keyIdx] = append(offsets[keyIdx], colNum)
+		}
+	}
+	return offsets, nil
+}
+
 func subquerySelectPlan(ins *sqlparser.Insert, vschema plancontext.VSchema, reservedVars *sqlparser.ReservedVars, sharded bool) (engine.Primitive, error) {
 	selectStmt, queryPlanner, err := getStatementAndPlanner(ins, vschema)
 	if err != nil {
@@ -342,8 +428,8 @@ func generateInsertShardedQuery(node *sqlparser.Insert, eins *engine.Insert, val
 	midBuf := sqlparser.NewTrackedBuffer(dmlFormatter)
 	suffixBuf := sqlparser.NewTrackedBuffer(dmlFormatter)
 	eins.Mid = make([]string, len(valueTuples))
-	prefixBuf.Myprintf("insert %v%sinto %v%v values ",
-		node.Comments, node.Ignore.ToString(),
+	prefixBuf.Myprintf("%s %v%sinto %v%v values ",
+		insertVerb(node), node.Comments, node.Ignore.ToString(),
 		node.Table, node.Columns)
 	eins.Prefix = prefixBuf.String()
 	for rowNum, val := range valueTuples {
@@ -358,14 +444,21 @@ func generateInsertShardedQuery(node *sqlparser.Insert, eins *engine.Insert, val
 func generateInsertSelectQuery(node *sqlparser.Insert, eins *engine.Insert) {
 	prefixBuf := sqlparser.NewTrackedBuffer(dmlFormatter)
 	suffixBuf := sqlparser.NewTrackedBuffer(dmlFormatter)
-	prefixBuf.Myprintf("insert %v%sinto %v%v ",
-		node.Comments, node.Ignore.ToString(),
+	prefixBuf.Myprintf("%s %v%sinto %v%v ",
+		insertVerb(node), node.Comments, node.Ignore.ToString(),
 		node.Table, node.Columns)
 	eins.Prefix = prefixBuf.String()
 	suffixBuf.Myprintf("%v", node.OnDup)
 	eins.Suffix = suffixBuf.String()
 }
 
+func insertVerb(node *sqlparser.Insert) string {
+	if node.Action == sqlparser.ReplaceAct {
+		return "replace"
+	}
+	return "insert"
+}
+
 // modifyForAutoinc modifies the AST and the plan to generate necessary autoinc values.
 // For row values cases, bind variable names are generated using baseName.
 func modifyForAutoinc(ins *sqlparser.Insert, eins *engine.Insert) error {
@@ -0,0 +1,199 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"fmt"
+	"strconv"
+
+	"vitess.io/vitess/go/vt/key"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/plancontext"
+)
+
+// loadBatchSize is the maximum number of rows of the file
+// inserted by a single statement.
+const loadBatchSize = 1000
+
+func buildLoadPlan(stmt *sqlparser.Load, query string, vschema plancontext.VSchema) (engine.Primitive, error) {
+	if stmt.Local && !stmt.Table.IsEmpty() && vschema.Destination() == nil {
+		// the file is on the client, vtgate reads it and inserts its rows itself
+		return buildLoadLocalPlan(stmt, vschema)
+	}
+
+	keyspace, err := vschema.DefaultKeyspace()
+	if err != nil {
+		return nil, err
+	}
+
+	destination := vschema.Destination()
+	if destination == nil {
+		if err := vschema.ErrorIfShardedF(keyspace, "LOAD", "LOAD is not supported on sharded database"); err != nil {
+			return nil, err
+		}
+		destination = key.DestinationAnyShard{}
+	}
+
+	return &engine.Send{
+		Keyspace:          keyspace,
+		TargetDestination: destination,
+		Query:             query,
+		IsDML:             true,
+		SingleShardOnly:   true,
+	}, nil
+}
+
+// buildLoadLocalPlan builds the plan for a LOAD DATA LOCAL INFILE. The rows of the file are
+// inserted the same way as the rows returned by the SELECT of an INSERT ... SELECT.
+func buildLoadLocalPlan(stmt *sqlparser.Load, vschema plancontext.VSchema) (engine.Primitive, error) {
+	table, vindex, _, _, destination, err := vschema.FindTableOrVindex(stmt.Table)
+	if err != nil {
+		return nil, err
+	}
+	if table == nil || vindex != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "table %s not found", sqlparser.String(stmt.Table))
+	}
+	if destination != nil {
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: LOAD DATA LOCAL INFILE with a target destination")
+	}
+	if len(stmt.SetExprs) > 0 {
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: LOAD DATA LOCAL INFILE with a SET clause")
+	}
+	for _, col := range stmt.Columns {
+		if col.AtCount() != sqlparser.NoAt {
+			return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: LOAD DATA LOCAL INFILE into user variables")
+		}
+	}
+
+	format, err := loadFormat(stmt)
+	if err != nil {
+		return nil, err
+	}
+
+	ins := &sqlparser.Insert{
+		Action:     stmt.Action,
+		Ignore:     stmt.Ignore,
+		Table:      sqlparser.TableName{Name: table.Name},
+		Partitions: stmt.Partitions,
+		Columns:    append(sqlparser.Columns(nil), stmt.Columns...),
+	}
+	if len(ins.Columns) == 0 && table.ColumnListAuthoritative {
+		populateInsertColumnlist(ins, table)
+	}
+	eins := &engine.Insert{
+		Opcode:   engine.InsertUnsharded,
+		Ignore:   bool(stmt.Ignore),
+		Table:    table,
+		Keyspace: table.Keyspace,
+	}
+
+	if table.Keyspace.Sharded {
+		if len(ins.Columns) == 0 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "load data should contain column list or the table should have authoritative columns in vschema")
+		}
+		eins.Opcode = engine.InsertSelect
+		eins.ColVindexes = getColVindexes(table.ColumnVindexes)
+		if stmt.Action == sqlparser.ReplaceAct {
+			if err := buildReplaceOwnedVindexQuery(table, eins); err != nil {
+				return nil, err
+			}
+		}
+	} else if len(ins.Columns) == 0 && table.AutoIncrement != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "column list required for tables with auto-inc columns")
+	}
+
+	// the number of fields on each line is known before an auto-increment column is added
+	columns := len(ins.Columns)
+	if table.AutoIncrement != nil {
+		colNum := findOrAddColumn(ins, table.AutoIncrement.Column)
+		eins.Generate = &engine.Generate{
+			Keyspace: table.AutoIncrement.Sequence.Keyspace,
+			Query:    fmt.Sprintf("select next :n values from %s", sqlparser.String(table.AutoIncrement.Sequence.Name)),
+			Offset:   colNum,
+		}
+	}
+	if eins.Opcode == engine.InsertSelect {
+		eins.VindexValueOffset, err = extractColVindexOffsets(ins, eins.ColVindexes)
+		if err != nil {
+			return nil, err
+		}
+		eins.ReplaceKeyOffsets, err = extractReplaceKeyOffsets(ins, eins)
+		if err != nil {
+			return nil, err
+		}
+	}
+	generateLoadQuery(ins, eins)
+
+	return &engine.Load{
+		Insert:    eins,
+		FileName:  stmt.FileName,
+		Format:    format,
+		Columns:   columns,
+		BatchSize: loadBatchSize,
+	}, nil
+}
+
+// loadFormat returns the layout of the file, taking the defaults
+// of MySQL for the options the statement does not specify.
+func loadFormat(stmt *sqlparser.Load) (engine.LoadFormat, error) {
+	format := engine.DefaultLoadFormat()
+	if stmt.FieldsTerminatedBy != nil {
+		format.FieldsTerminatedBy = stmt.FieldsTerminatedBy.Val
+	}
+	if stmt.FieldsEnclosedBy != nil {
+		format.FieldsEnclosedBy = stmt.FieldsEnclosedBy.Val
+	}
+	if stmt.FieldsEscapedBy != nil {
+		format.FieldsEscapedBy = stmt.FieldsEscapedBy.Val
+	}
+	if stmt.LinesStartingBy != nil {
+		format.LinesStartingBy = stmt.LinesStartingBy.Val
+	}
+	if stmt.LinesTerminatedBy != nil {
+		format.LinesTerminatedBy = stmt.LinesTerminatedBy.Val
+	}
+	if stmt.IgnoreLines != nil {
+		ignoreLines, err := strconv.Atoi(stmt.IgnoreLines.Val)
+		if err != nil {
+			return format, vterrors.Wrapf(err, "invalid number of lines to ignore")
+		}
+		format.IgnoreLines = ignoreLines
+	}
+
+	if len(format.FieldsEnclosedBy) > 1 || len(format.FieldsEscapedBy) > 1 {
+		return format, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Field separator argument is not what is expected; check the manual")
+	}
+	if format.FieldsTerminatedBy == "" && format.FieldsEnclosedBy == "" {
+		return format, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: LOAD DATA LOCAL INFILE with fixed-width fields")
+	}
+	if format.LinesTerminatedBy == "" {
+		// MySQL ends the lines with the field terminator in that case
+		format.LinesTerminatedBy = format.FieldsTerminatedBy
+	}
+	return format, nil
+}
+
+func generateLoadQuery(node *sqlparser.Insert, eins *engine.Insert) {
+	prefixBuf := sqlparser.NewTrackedBuffer(dmlFormatter)
+	prefixBuf.Myprintf("%s %sinto %v%v%v ",
+		insertVerb(node), node.Ignore.ToString(),
+		node.Table, node.Partitions, node.Columns)
+	eins.Prefix = prefixBuf.String()
+}
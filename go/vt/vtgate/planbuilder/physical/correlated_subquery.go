@@ -113,11 +113,16 @@ func (c *CorrelatedSubQueryOp) Cost() int {
 func (c *CorrelatedSubQueryOp) Clone() abstract.PhysicalOperator {
 	columns := make([]*sqlparser.ColName, len(c.LHSColumns))
 	copy(columns, c.LHSColumns)
+	vars := make(map[string]int, len(c.Vars))
+	for k, v := range c.Vars {
+		vars[k] = v
+	}
 	result := &CorrelatedSubQueryOp{
 		Outer:      c.Outer.Clone(),
 		Inner:      c.Inner.Clone(),
 		Extracted:  c.Extracted,
 		LHSColumns: columns,
+		Vars:       vars,
 	}
 	return result
 }
@@ -140,6 +140,22 @@ func PushPredicate(ctx *plancontext.PlanningContext, expr sqlparser.Expr, op abs
 		}
 		op.Source = newSrc
 		return op, err
+	case *SubQueryOp:
+		// the tables of the subquery are not visible outside it, so the predicate belongs to the outer side
+		newOuter, err := PushPredicate(ctx, expr, op.Outer)
+		if err != nil {
+			return nil, err
+		}
+		op.Outer = newOuter
+		return op, nil
+	case *CorrelatedSubQueryOp:
+		// the tables of the subquery are not visible outside it, so the predicate belongs to the outer side
+		newOuter, err := PushPredicate(ctx, expr, op.Outer)
+		if err != nil {
+			return nil, err
+		}
+		op.Outer = newOuter
+		return op, nil
 	default:
 		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "we cannot push predicates into %T", op)
 	}
@@ -268,7 +284,20 @@ func RemovePredicate(ctx *plancontext.PlanningContext, expr sqlparser.Expr, op a
 			return nil, err
 		}
 		op.Source = newSrc
-		return op, err
+
+		// the predicate might have been used to decide how to route the query,
+		// so we need to plan the routing again without it
+		var seen []sqlparser.Expr
+		for _, predicate := range op.SeenPredicates {
+			if !sqlparser.EqualsExpr(predicate, expr) {
+				seen = append(seen, predicate)
+			}
+		}
+		if len(seen) == len(op.SeenPredicates) {
+			return op, nil
+		}
+		op.SeenPredicates = seen
+		return op, op.resetRoutingSelections(ctx)
 	case *ApplyJoin:
 		isRemoved := false
 		deps := ctx.SemTable.RecursiveDeps(expr)
@@ -327,6 +356,21 @@ func RemovePredicate(ctx *plancontext.PlanningContext, expr sqlparser.Expr, op a
 		// remove the predicate from this filter
 		op.Predicates = append(op.Predicates[:idx], op.Predicates[idx+1:]...)
 		return op, nil
+	case *Table:
+		var keep []sqlparser.Expr
+		for _, predicate := range op.QTable.Predicates {
+			if !sqlparser.EqualsExpr(predicate, expr) {
+				keep = append(keep, predicate)
+			}
+		}
+		if len(keep) == len(op.QTable.Predicates) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "this should not happen - tried to remove predicate from table op")
+		}
+		// the query table is immutable, so we need a copy of it without the predicate
+		qt := *op.QTable
+		qt.Predicates = keep
+		op.QTable = &qt
+		return op, nil
 
 	default:
 		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "this should not happen - tried to remove predicate from table op")
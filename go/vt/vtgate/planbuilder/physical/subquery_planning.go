@@ -6,6 +6,7 @@ import (
 	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder/abstract"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder/plancontext"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
 
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
@@ -53,16 +54,11 @@ func optimizeSubQuery(ctx *plancontext.PlanningContext, op *abstract.SubQuery) (
 			continue
 		}
 
-		if inner.ExtractedSubquery.OpCode == int(engine.PulloutExists) {
-			correlatedTree, err := createCorrelatedSubqueryOp(ctx, innerOp, outerOp, preds, inner.ExtractedSubquery)
-			if err != nil {
-				return nil, err
-			}
-			outerOp = correlatedTree
-			continue
+		correlatedTree, err := createCorrelatedSubqueryOp(ctx, innerOp, outerOp, preds, inner.ExtractedSubquery)
+		if err != nil {
+			return nil, err
 		}
-
-		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard correlated subquery")
+		outerOp = correlatedTree
 	}
 
 	/*
@@ -235,7 +231,12 @@ func createCorrelatedSubqueryOp(
 ) (*CorrelatedSubQueryOp, error) {
 	newOuter, err := RemovePredicate(ctx, extractedSubquery, outerOp)
 	if err != nil {
-		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "exists sub-queries are only supported with AND clause")
+		if extractedSubquery.OpCode == int(engine.PulloutExists) {
+			return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "exists sub-queries are only supported with AND clause")
+		}
+		// the subquery is not a predicate of its own; it could be in the SELECT list or be part of
+		// a bigger expression, and we can only evaluate correlated subqueries that filter the outer rows
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard correlated subquery")
 	}
 
 	resultOuterOp := newOuter
@@ -282,6 +283,7 @@ func createCorrelatedSubqueryOp(
 		if rewriteError != nil {
 			return nil, rewriteError
 		}
+		forgetDependencies(ctx.SemTable, pred)
 		var err error
 		innerOp, err = PushPredicate(ctx, pred, innerOp)
 		if err != nil {
@@ -296,3 +298,20 @@ func createCorrelatedSubqueryOp(
 		LHSColumns: lhsCols,
 	}, nil
 }
+
+// forgetDependencies removes the dependencies cached for the expression, and for the expressions it
+// contains, so that they get computed again from its columns. This is needed once the columns coming
+// from the outer query have been replaced with arguments, since the expression no longer depends on them.
+func forgetDependencies(semTable *semantics.SemTable, expr sqlparser.Expr) {
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		e, isExpr := node.(sqlparser.Expr)
+		if !isExpr || !semantics.ValidAsMapKey(e) {
+			return true, nil
+		}
+		if _, isCol := e.(*sqlparser.ColName); !isCol {
+			delete(semTable.Recursive, e)
+			delete(semTable.Direct, e)
+		}
+		return true, nil
+	}, expr)
+}
@@ -47,7 +47,9 @@ import (
 	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 )
 
 // hashIndex is a functional, unique Vindex.
@@ -239,6 +241,10 @@ func TestPlan(t *testing.T) {
 	testFile(t, "show_cases_no_default_keyspace.txt", testOutputTempDir, vschemaWrapper)
 	testFile(t, "stream_cases.txt", testOutputTempDir, vschemaWrapper)
 	testFile(t, "systemtables_cases.txt", testOutputTempDir, vschemaWrapper)
+	testFile(t, "window_cases.txt", testOutputTempDir, vschemaWrapper)
+	testFile(t, "load_cases.txt", testOutputTempDir, vschemaWrapper)
+	testFile(t, "fk_cases.txt", testOutputTempDir, vschemaWrapper)
+	testFile(t, "cte_cases.txt", testOutputTempDir, vschemaWrapper)
 }
 
 func TestSysVarSetDisabled(t *testing.T) {
@@ -250,6 +256,21 @@ func TestSysVarSetDisabled(t *testing.T) {
 	testFile(t, "set_sysvar_disabled_cases.txt", makeTestOutput(t), vschemaWrapper)
 }
 
+func TestPreparedStatements(t *testing.T) {
+	vschemaWrapper := &vschemaWrapper{
+		v: loadSchema(t, "schema_test.json", true),
+		udv: map[string]*querypb.BindVariable{
+			"query": sqltypes.StringBindVariable("select id from user where id = ?"),
+		},
+		prepareData: map[string]*vtgatepb.PrepareData{
+			"stmt1": {PrepareStatement: "select id from user where id = ?", ParamsCount: 1},
+			"stmt2": {PrepareStatement: "insert into unsharded(col) values (1)"},
+		},
+	}
+
+	testFile(t, "prepared_statement_cases.txt", makeTestOutput(t), vschemaWrapper)
+}
+
 func TestOne(t *testing.T) {
 	vschema := &vschemaWrapper{
 		v: loadSchema(t, "schema_test.json", true),
@@ -438,6 +459,8 @@ type vschemaWrapper struct {
 	dest          key.Destination
 	sysVarEnabled bool
 	version       plancontext.PlannerVersion
+	udv           map[string]*querypb.BindVariable
+	prepareData   map[string]*vtgatepb.PrepareData
 }
 
 func (vw *vschemaWrapper) ConnCollation() collations.ID {
@@ -451,6 +474,22 @@ func (vw *vschemaWrapper) ForeignKeyMode() string {
 	return "allow"
 }
 
+func (vw *vschemaWrapper) GetUDV(name string) *querypb.BindVariable {
+	return vw.udv[name]
+}
+
+func (vw *vschemaWrapper) GetPrepareData(name string) *vtgatepb.PrepareData {
+	return vw.prepareData[name]
+}
+
+func (vw *vschemaWrapper) PlanPrepareStatement(query string) (*engine.Plan, map[string]*querypb.BindVariable, error) {
+	plan, err := TestBuilder(query, vw, vw.currentDb())
+	if err != nil {
+		return nil, nil, err
+	}
+	return plan, nil, nil
+}
+
 func (vw *vschemaWrapper) AllKeyspace() ([]*vindexes.Keyspace, error) {
 	if vw.keyspace == nil {
 		return nil, errors.New("keyspace not available")
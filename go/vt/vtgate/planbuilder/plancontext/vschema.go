@@ -7,7 +7,9 @@ import (
 	"vitess.io/vitess/go/vt/key"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/semantics"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 )
@@ -49,6 +51,16 @@ type VSchema interface {
 
 	// ForeignKeyMode returns the foreign_key flag value
 	ForeignKeyMode() string
+
+	// GetUDV returns the value of the given user defined variable, or nil if it is not set.
+	GetUDV(name string) *querypb.BindVariable
+
+	// GetPrepareData returns the statement prepared under the given name, or nil if there is none.
+	GetPrepareData(name string) *vtgatepb.PrepareData
+
+	// PlanPrepareStatement plans a statement prepared with PREPARE. The values normalized
+	// out of the statement are returned with the plan, to be bound when executing it.
+	PlanPrepareStatement(query string) (*engine.Plan, map[string]*querypb.BindVariable, error)
 }
 
 // PlannerNameToVersion returns the numerical representation of the planner
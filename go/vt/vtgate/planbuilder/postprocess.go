@@ -105,6 +105,9 @@ func setUpperLimit(plan logicalPlan) (bool, logicalPlan, error) {
 	switch node := plan.(type) {
 	case *join, *joinGen4, *hashJoin:
 		return false, node, nil
+	case *window:
+		// a window needs all the rows of its partitions, so the limit can't be pushed below it
+		return false, node, nil
 	case *memorySort:
 		pv := evalengine.NewBindVar("__upper_limit", collations.TypedCollation{})
 		node.eMemorySort.UpperLimit = pv
@@ -0,0 +1,130 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/plancontext"
+)
+
+// buildPrepareStmtPlan plans the statement being prepared, so that errors are
+// reported by PREPARE and the plan is cached for the following EXECUTEs.
+// The statement itself is kept in the session by the returned primitive.
+func buildPrepareStmtPlan(stmt *sqlparser.PrepareStmt, vschema plancontext.VSchema) (engine.Primitive, error) {
+	query := stmt.Statement
+	if query == "" {
+		udv := vschema.GetUDV(stmt.StatementIdentifier.Lowered())
+		if udv == nil || udv.Type == sqltypes.Null {
+			return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.SyntaxError, "You have an error in your SQL syntax; check the manual that corresponds to your MySQL server version for the right syntax to use near 'NULL'")
+		}
+		query = string(udv.Value)
+	}
+
+	_, reserved, err := sqlparser.Parse2(query)
+	if err != nil {
+		return nil, err
+	}
+	plan, _, err := vschema.PlanPrepareStatement(query)
+	if err != nil {
+		return nil, err
+	}
+	switch plan.Type {
+	case sqlparser.StmtBegin, sqlparser.StmtCommit, sqlparser.StmtRollback, sqlparser.StmtSavepoint, sqlparser.StmtSRollback,
+		sqlparser.StmtRelease, sqlparser.StmtPrepare, sqlparser.StmtExecute, sqlparser.StmtDeallocate:
+		return nil, vterrors.NewErrorf(vtrpcpb.Code_UNIMPLEMENTED, vterrors.UnsupportedPS, "This command is not supported in the prepared statement protocol yet")
+	}
+
+	name := stmt.Name.Lowered()
+	data := &vtgatepb.PrepareData{
+		PrepareStatement: query,
+		ParamsCount:      int32(countParams(reserved)),
+	}
+	return engine.NewSessionPrimitive("PREPARE", func(sa engine.SessionActions) (*sqltypes.Result, error) {
+		sa.StorePrepareData(name, data)
+		return &sqltypes.Result{}, nil
+	}), nil
+}
+
+// buildExecuteStmtPlan returns the plan of the prepared statement, wrapped
+// in a primitive that binds the given user defined variables to its placeholders.
+func buildExecuteStmtPlan(stmt *sqlparser.ExecuteStmt, vschema plancontext.VSchema) (engine.Primitive, error) {
+	name := stmt.Name.Lowered()
+	data := vschema.GetPrepareData(name)
+	if data == nil {
+		return nil, vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.UnknownStmtHandler, "Unknown prepared statement handler (%s) given to EXECUTE", stmt.Name.String())
+	}
+	if int(data.ParamsCount) != len(stmt.Arguments) {
+		return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongArguments, "Incorrect arguments to EXECUTE")
+	}
+
+	plan, bindVars, err := vschema.PlanPrepareStatement(data.PrepareStatement)
+	if err != nil {
+		return nil, err
+	}
+
+	bindVarNeeds := &sqlparser.BindVarNeeds{}
+	if plan.BindVarNeeds != nil {
+		bindVarNeeds.MergeWith(plan.BindVarNeeds)
+	}
+	params := make([]string, 0, len(stmt.Arguments))
+	for _, arg := range stmt.Arguments {
+		param := arg.Lowered()
+		params = append(params, param)
+		bindVarNeeds.AddUserDefVar(param)
+	}
+	return &engine.ExecStmt{
+		Name:         name,
+		Params:       params,
+		BindVars:     bindVars,
+		BindVarNeeds: bindVarNeeds,
+		Input:        plan.Instructions,
+	}, nil
+}
+
+func buildDeallocateStmtPlan(stmt *sqlparser.DeallocateStmt) (engine.Primitive, error) {
+	name := stmt.Name.Lowered()
+	return engine.NewSessionPrimitive("DEALLOCATE PREPARE", func(sa engine.SessionActions) (*sqltypes.Result, error) {
+		if sa.GetPrepareData(name) == nil {
+			return nil, vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.UnknownStmtHandler, "Unknown prepared statement handler (%s) given to DEALLOCATE PREPARE", stmt.Name.String())
+		}
+		sa.ClearPrepareData(name)
+		return &sqltypes.Result{}, nil
+	}), nil
+}
+
+// countParams returns the number of ? placeholders of a statement,
+// which the parser names v1, v2...
+func countParams(reserved sqlparser.BindVars) int {
+	count := 0
+	for name := range reserved {
+		if !strings.HasPrefix(name, "v") {
+			continue
+		}
+		if _, err := strconv.Atoi(name[1:]); err == nil {
+			count++
+		}
+	}
+	return count
+}
@@ -38,8 +38,18 @@ import (
 func buildSelectPlan(query string) selectPlanner {
 	return func(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedVars, vschema plancontext.VSchema) (engine.Primitive, error) {
 		sel := stmt.(*sqlparser.Select)
-		if sel.With != nil {
-			return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: with expression in select statement")
+		if err := inlineCTEs(sel); err != nil {
+			return nil, err
+		}
+		if hasWith(sel) {
+			route, err := buildUnshardedCTERoute(sel, vschema)
+			if err != nil {
+				return nil, err
+			}
+			if route != nil {
+				return route, nil
+			}
+			return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression across shards")
 		}
 
 		p, err := handleDualSelects(sel, vschema)
@@ -212,6 +222,9 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, reservedVars *s
 	if err := pb.checkAggregates(sel); err != nil {
 		return err
 	}
+	if err := pb.checkWindowFunctions(sel); err != nil {
+		return err
+	}
 	if err := pb.pushSelectExprs(sel, reservedVars); err != nil {
 		return err
 	}
@@ -230,6 +243,36 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, reservedVars *s
 	return setMiscFunc(pb.plan, sel)
 }
 
+// checkWindowFunctions only allows window functions in queries that are sent to a single shard,
+// since the v3 planner cannot compute them across shards.
+func (pb *primitiveBuilder) checkWindowFunctions(sel *sqlparser.Select) error {
+	if len(sel.Windows) == 0 && !sqlparser.ContainsWindowFunction(sel.SelectExprs) && !sqlparser.ContainsWindowFunction(sel.OrderBy) {
+		return nil
+	}
+	rb, isRoute := pb.plan.(*route)
+	if !isRoute || !rb.isSingleShard() {
+		return vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: window functions in cross-shard query")
+	}
+	query, isSel := rb.Select.(*sqlparser.Select)
+	if !isSel {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unexpected %T below a window function", rb.Select)
+	}
+	// the columns used in the WINDOW clause have to be resolved before the query can be built
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		if col, isCol := node.(*sqlparser.ColName); isCol {
+			if _, _, err := pb.st.Find(col); err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	}, sel.Windows)
+	if err != nil {
+		return err
+	}
+	query.Windows = sel.Windows
+	return nil
+}
+
 func setMiscFunc(in logicalPlan, sel *sqlparser.Select) error {
 	_, err := visit(in, func(plan logicalPlan) (bool, logicalPlan, error) {
 		switch node := plan.(type) {
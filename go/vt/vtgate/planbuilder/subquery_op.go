@@ -19,6 +19,7 @@ package planbuilder
 import (
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder/plancontext"
 
 	"vitess.io/vitess/go/vt/vtgate/planbuilder/physical"
@@ -59,7 +60,59 @@ func transformCorrelatedSubQueryPlan(ctx *plancontext.PlanningContext, op *physi
 	if err != nil {
 		return nil, err
 	}
-	return newSemiJoin(outer, inner, op.Vars, op.LHSColumns), nil
+	if op.Extracted.OpCode == int(engine.PulloutExists) {
+		return newSemiJoin(outer, inner, op.Vars, op.LHSColumns), nil
+	}
+
+	inner, err = planHorizon(ctx, inner, op.Extracted.Subquery.Select)
+	if err != nil {
+		return nil, err
+	}
+
+	// the predicate that used the subquery is evaluated by the vtgate,
+	// using the columns it needs from the outer plan and the result of the subquery
+	lhsCols := append([]*sqlparser.ColName{}, op.LHSColumns...)
+	if op.Extracted.OtherSide != nil {
+		_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+			col, isCol := node.(*sqlparser.ColName)
+			if !isCol {
+				return true, nil
+			}
+			for _, lhsCol := range lhsCols {
+				if col.Name.Equal(lhsCol.Name) && ctx.SemTable.RecursiveDeps(col).Equals(ctx.SemTable.RecursiveDeps(lhsCol)) {
+					return true, nil
+				}
+			}
+			lhsCols = append(lhsCols, col)
+			return true, nil
+		}, op.Extracted.OtherSide)
+	}
+	ast := op.Extracted.GetAlternative()
+	scl := &simpleConverterLookup{
+		canPushProjection: true,
+		ctx:               ctx,
+		plan:              outer,
+	}
+	predicate, err := evalengine.Translate(ast, scl)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &correlatedSubquery{
+		outer:      outer,
+		inner:      inner,
+		vars:       op.Vars,
+		LHSColumns: lhsCols,
+		eSubquery: &engine.CorrelatedSubquery{
+			Opcode:         engine.PulloutOpcode(op.Extracted.OpCode),
+			SubqueryResult: op.Extracted.GetArgName(),
+			HasValues:      op.Extracted.GetHasValuesArg(),
+			Predicate:      predicate,
+			ASTPredicate:   ast,
+		},
+	}
+	plan.batchSubquery(ctx.SemTable)
+	return plan, nil
 }
 
 func mergeSubQueryOpPlan(ctx *plancontext.PlanningContext, inner, outer logicalPlan, n *physical.SubQueryOp) logicalPlan {
@@ -34,8 +34,18 @@ import (
 func buildUnionPlan(string) selectPlanner {
 	return func(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedVars, vschema plancontext.VSchema) (engine.Primitive, error) {
 		union := stmt.(*sqlparser.Union)
-		if union.With != nil {
-			return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: with expression in union statement")
+		if err := inlineCTEs(union); err != nil {
+			return nil, err
+		}
+		if hasWith(union) {
+			route, err := buildUnshardedCTERoute(union, vschema)
+			if err != nil {
+				return nil, err
+			}
+			if route != nil {
+				return route, nil
+			}
+			return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression across shards")
 		}
 		// For unions, create a pb with anonymous scope.
 		pb := newPrimitiveBuilder(vschema, newJointab(reservedVars))
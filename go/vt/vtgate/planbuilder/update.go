@@ -32,22 +32,51 @@ import (
 // buildUpdatePlan builds the instructions for an UPDATE statement.
 func buildUpdatePlan(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedVars, vschema plancontext.VSchema) (engine.Primitive, error) {
 	upd := stmt.(*sqlparser.Update)
-	if upd.With != nil {
-		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: with expression in update statement")
+	if err := inlineCTEs(upd); err != nil {
+		return nil, err
+	}
+	tableExprs := sqlparser.CloneTableExprs(upd.TableExprs)
+	dml, table, err := buildUpdateDMLPlan(upd, reservedVars, vschema)
+	if err != nil {
+		return nil, err
+	}
+	table, err = dmlTable(table, tableExprs, vschema)
+	if err != nil {
+		return nil, err
+	}
+	if table == nil {
+		return dml, nil
 	}
-	dml, ksidVindex, err := buildDMLPlan(vschema, "update", stmt, reservedVars, upd.TableExprs, upd.Where, upd.OrderBy, upd.Limit, upd.Comments, upd.Exprs)
+	checks, err := buildUpdateFkChecks(upd, table, reservedVars, vschema)
 	if err != nil {
 		return nil, err
 	}
+	return withFkChecks(dml, checks), nil
+}
+
+// buildUpdateDMLPlan builds the instructions for an UPDATE statement, without
+// the checks of the foreign keys. The table of the update is returned too.
+func buildUpdateDMLPlan(upd *sqlparser.Update, reservedVars *sqlparser.ReservedVars, vschema plancontext.VSchema) (engine.Primitive, *vindexes.Table, error) {
+	if hasWith(upd) {
+		dml, err := buildRecursiveCTEDMLPlan(upd, "update", vschema)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &engine.Update{DML: dml}, nil, nil
+	}
+	dml, ksidVindex, pullouts, err := buildDMLPlan(vschema, "update", upd, reservedVars, upd.TableExprs, upd.Where, upd.OrderBy, upd.Limit, upd.Comments, upd.Exprs)
+	if err != nil {
+		return nil, nil, err
+	}
 	eupd := &engine.Update{DML: dml}
 
 	if dml.Opcode == engine.Unsharded {
-		return eupd, nil
+		return pulloutDML(eupd, pullouts), eupd.Table, nil
 	}
 
 	cvv, ovq, err := buildChangedVindexesValues(upd, eupd.Table, ksidVindex.Columns)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	eupd.ChangedVindexValues = cvv
 	eupd.OwnedVindexQuery = ovq
@@ -55,16 +84,54 @@ func buildUpdatePlan(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedV
 		eupd.KsidVindex = ksidVindex.Vindex
 		eupd.KsidLength = len(ksidVindex.Columns)
 	}
-	return eupd, nil
+	if _, ok := eupd.ChangedVindexValues[ksidVindex.Name]; ok {
+		if eupd.MultiShardAutocommit {
+			return nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi shard autocommit with an update of the primary vindex %v", ksidVindex.Name)
+		}
+		eupd.MovedRowsQuery, eupd.MovedRowsDeleteQuery = generateMovedRowsQueries(upd, eupd.Table, ksidVindex.Columns)
+	}
+	return pulloutDML(eupd, pullouts), eupd.Table, nil
+}
+
+// generateMovedRowsQueries returns the queries selecting and deleting the rows
+// updated with the new values of the primary vindex columns. On the shards
+// these values do not belong to, they are the rows to move to another shard.
+func generateMovedRowsQueries(update *sqlparser.Update, table *vindexes.Table, ksidCols []sqlparser.ColIdent) (string, string) {
+	var filters []sqlparser.Expr
+	for _, col := range ksidCols {
+		for _, assignment := range update.Exprs {
+			if col.Equal(assignment.Name.Name) {
+				filters = append(filters, &sqlparser.ComparisonExpr{
+					Operator: sqlparser.EqualOp,
+					Left:     &sqlparser.ColName{Name: col},
+					Right:    assignment.Expr,
+				})
+			}
+		}
+	}
+	where := sqlparser.NewWhere(sqlparser.WhereClause, sqlparser.AndExpressions(filters...))
+	tbl := sqlparser.TableName{Name: table.Name}
+	return sqlparser.String(&sqlparser.Select{
+			SelectExprs: sqlparser.SelectExprs{&sqlparser.StarExpr{}},
+			From:        sqlparser.TableExprs{&sqlparser.AliasedTableExpr{Expr: tbl}},
+			Where:       where,
+			Lock:        sqlparser.ForUpdateLock,
+		}), sqlparser.String(&sqlparser.Delete{
+			TableExprs: sqlparser.TableExprs{&sqlparser.AliasedTableExpr{Expr: tbl}},
+			Where:      where,
+		})
 }
 
-// buildChangedVindexesValues adds to the plan all the lookup vindexes that are changing.
-// Updates can only be performed to secondary lookup vindexes with no complex expressions
-// in the set clause.
+// buildChangedVindexesValues adds to the plan all the vindexes that are changing.
+// Updates can only be performed to the primary vindex or to secondary lookup vindexes,
+// with no complex expressions in the set clause.
 func buildChangedVindexesValues(update *sqlparser.Update, table *vindexes.Table, ksidCols []sqlparser.ColIdent) (map[string]*engine.VindexValues, string, error) {
 	changedVindexes := make(map[string]*engine.VindexValues)
 	buf, offset := initialQuery(ksidCols, table)
 	for i, vindex := range table.ColumnVindexes {
+		if vindex.IgnoreInDML() {
+			continue
+		}
 		vindexValueMap := make(map[string]evalengine.Expr)
 		first := true
 		for _, vcol := range vindex.Columns {
@@ -100,13 +167,17 @@ func buildChangedVindexesValues(update *sqlparser.Update, table *vindexes.Table,
 			return nil, "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: Need to provide order by clause when using limit. Invalid update on vindex: %v", vindex.Name)
 		}
 		if i == 0 {
-			return nil, "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: You can't update primary vindex columns. Invalid update on vindex: %v", vindex.Name)
-		}
-		if _, ok := vindex.Vindex.(vindexes.Lookup); !ok {
-			return nil, "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: You can only update lookup vindexes. Invalid update on vindex: %v", vindex.Name)
-		}
-		if !vindex.Owned {
-			return nil, "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: You can only update owned vindexes. Invalid update on vindex: %v", vindex.Name)
+			// The new keyspace id of the rows needs all the columns of the primary vindex.
+			if len(vindexValueMap) != len(vindex.Columns) {
+				return nil, "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: You need to update all the primary vindex columns together. Invalid update on vindex: %v", vindex.Name)
+			}
+		} else {
+			if _, ok := vindex.Vindex.(vindexes.Lookup); !ok {
+				return nil, "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: You can only update lookup vindexes. Invalid update on vindex: %v", vindex.Name)
+			}
+			if !vindex.Owned {
+				return nil, "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: You can only update owned vindexes. Invalid update on vindex: %v", vindex.Name)
+			}
 		}
 		changedVindexes[vindex.Name] = &engine.VindexValues{
 			PvMap:  vindexValueMap,
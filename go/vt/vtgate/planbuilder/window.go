@@ -0,0 +1,534 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"strconv"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/abstract"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/plancontext"
+)
+
+type (
+	// window is the logicalPlan for engine.Window.
+	window struct {
+		logicalPlanCommon
+		eWindow *engine.Window
+	}
+
+	// windowFunc is a window function used in the select list,
+	// along with the window it is computed over.
+	windowFunc struct {
+		params *engine.WindowFuncParams
+		spec   *sqlparser.WindowSpecification
+		// arg is the argument of the function, nil if it takes none.
+		arg sqlparser.Expr
+		// defaultExpr is the default value of LAG and LEAD, nil if not given.
+		defaultExpr sqlparser.Expr
+	}
+)
+
+var _ logicalPlan = (*window)(nil)
+
+// Primitive implements the logicalPlan interface
+func (w *window) Primitive() engine.Primitive {
+	w.eWindow.Input = w.input.Primitive()
+	return w.eWindow
+}
+
+func (hp *horizonPlanning) hasWindowFunctions() bool {
+	return sqlparser.ContainsWindowFunction(hp.sel.SelectExprs) || sqlparser.ContainsWindowFunction(hp.sel.OrderBy)
+}
+
+// windowFunctions returns the window functions of the select list, indexed by their
+// position in it. It fails for the queries that window functions cannot be planned for.
+// Aggregation and DISTINCT are only supported when the shards can compute the whole query.
+func (hp *horizonPlanning) windowFunctions(ctx *plancontext.PlanningContext, plan logicalPlan) ([]*windowFunc, error) {
+	if _, isRoute := plan.(*routeGen4); !isRoute {
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: window functions in cross-shard join")
+	}
+	funcs := make([]*windowFunc, len(hp.qp.SelectExprs))
+	for idx, expr := range hp.qp.SelectExprs {
+		aliasedExpr, isAliased := expr.Col.(*sqlparser.AliasedExpr)
+		if !isAliased || !sqlparser.ContainsWindowFunction(aliasedExpr.Expr) {
+			continue
+		}
+		if !sqlparser.IsWindowFunction(aliasedExpr.Expr) {
+			return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: complex window function expression")
+		}
+		wf, err := hp.newWindowFunc(aliasedExpr)
+		if err != nil {
+			return nil, err
+		}
+		funcs[idx] = wf
+	}
+
+	for _, order := range hp.qp.OrderExprs {
+		if sqlparser.ContainsWindowFunction(order.WeightStrExpr) && findWindowFunc(funcs, hp.qp.SelectExprs, order.WeightStrExpr) == -1 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: order by window function must reference a column in the select list: %s", sqlparser.String(order.Inner))
+		}
+	}
+	aggregation := hp.qp.NeedsAggregation() || hp.sel.Having != nil || hp.qp.Distinct
+	if aggregation && !(windowsAlignedWithVindex(ctx, funcs) && hasUniqueVindex(ctx.VSchema, ctx.SemTable, hp.qp.GetGrouping())) {
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: window functions with aggregation or DISTINCT in cross-shard query")
+	}
+	return funcs, nil
+}
+
+// findWindowFunc returns the position of the window function in the select list, or -1 if it is not there.
+func findWindowFunc(funcs []*windowFunc, selectExprs []abstract.SelectExpr, expr sqlparser.Expr) int {
+	for idx, wf := range funcs {
+		if wf == nil {
+			continue
+		}
+		selExpr, err := selectExprs[idx].GetExpr()
+		if err == nil && sqlparser.EqualsExpr(selExpr, expr) {
+			return idx
+		}
+	}
+	return -1
+}
+
+func (hp *horizonPlanning) newWindowFunc(aliasedExpr *sqlparser.AliasedExpr) (*windowFunc, error) {
+	wf := &windowFunc{
+		params: &engine.WindowFuncParams{DefaultCol: -1},
+	}
+	if aliasedExpr.As.IsEmpty() {
+		wf.params.Alias = sqlparser.String(aliasedExpr.Expr)
+	} else {
+		wf.params.Alias = aliasedExpr.As.String()
+	}
+	wf.params.Expr = aliasedExpr.Expr
+
+	var over *sqlparser.OverClause
+	switch expr := aliasedExpr.Expr.(type) {
+	case *sqlparser.WindowFuncExpr:
+		over = expr.Over
+		wf.params.Opcode = engine.SupportedWindowFunctions[expr.Type.ToString()]
+		var err error
+		switch expr.Type {
+		case sqlparser.NtileType:
+			wf.params.N, err = windowFuncCount(expr, expr.Exprs[0])
+		case sqlparser.LagType, sqlparser.LeadType:
+			wf.arg = expr.Exprs[0]
+			wf.params.N = 1
+			if len(expr.Exprs) > 1 {
+				wf.params.N, err = windowFuncCount(expr, expr.Exprs[1])
+			}
+			if len(expr.Exprs) > 2 {
+				wf.defaultExpr = expr.Exprs[2]
+			}
+		case sqlparser.NthValueType:
+			wf.arg = expr.Exprs[0]
+			wf.params.N, err = windowFuncCount(expr, expr.Exprs[1])
+			if err == nil && wf.params.N == 0 {
+				err = vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Incorrect arguments to nth_value")
+			}
+		case sqlparser.FirstValueType, sqlparser.LastValueType:
+			wf.arg = expr.Exprs[0]
+		}
+		if err != nil {
+			return nil, err
+		}
+	case *sqlparser.FuncExpr:
+		over = expr.Over
+		funcName := expr.Name.Lowered()
+		opcode, found := engine.SupportedWindowFunctions[funcName]
+		if !found || opcode < engine.WindowCount {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: window function '%s'", funcName)
+		}
+		if expr.Distinct {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: DISTINCT in window function '%s'", funcName)
+		}
+		if len(expr.Exprs) != 1 {
+			return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.SyntaxError, "aggregate functions take a single argument '%s'", sqlparser.String(expr))
+		}
+		if isCountStar(expr) {
+			opcode = engine.WindowCountStar
+		} else {
+			arg, isAliased := expr.Exprs[0].(*sqlparser.AliasedExpr)
+			if !isAliased {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unexpected %T in window function", expr.Exprs[0])
+			}
+			wf.arg = arg.Expr
+		}
+		wf.params.Opcode = opcode
+	}
+
+	spec, err := hp.resolveWindow(over)
+	if err != nil {
+		return nil, err
+	}
+	wf.spec = spec
+	if spec.FrameClause != nil && usesFrame(wf.params.Opcode) {
+		wf.params.Frame, err = newWindowFrame(spec.FrameClause, overWindowName(over))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return wf, nil
+}
+
+// usesFrame returns true for the window functions that are computed over the window frame.
+// The other ones are computed over the whole partition and ignore the frame.
+func usesFrame(opcode engine.WindowOpcode) bool {
+	switch opcode {
+	case engine.WindowRowNumber, engine.WindowRank, engine.WindowDenseRank, engine.WindowPercentRank,
+		engine.WindowCumeDist, engine.WindowNtile, engine.WindowLag, engine.WindowLead:
+		return false
+	}
+	return true
+}
+
+// windowFuncCount returns the value of the integer literal used as a count or offset by a window function.
+func windowFuncCount(wfExpr *sqlparser.WindowFuncExpr, expr sqlparser.Expr) (int64, error) {
+	lit, isLit := expr.(*sqlparser.Literal)
+	if !isLit || lit.Type != sqlparser.IntVal {
+		return 0, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: non-literal argument in window function '%s'", wfExpr.Type.ToString())
+	}
+	n, err := strconv.ParseInt(lit.Val, 10, 64)
+	if err != nil {
+		return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Incorrect arguments to %s", wfExpr.Type.ToString())
+	}
+	return n, nil
+}
+
+// windowName returns the name of a window in error messages: the name of a named window,
+// or the specification of an inline one.
+func windowName(name sqlparser.ColIdent, spec *sqlparser.WindowSpecification) string {
+	if !name.IsEmpty() {
+		return name.String()
+	}
+	return "(" + sqlparser.String(spec) + ")"
+}
+
+// overWindowName returns the name of the window an OVER clause computes the function over.
+func overWindowName(over *sqlparser.OverClause) string {
+	if over.WindowSpec == nil {
+		return over.WindowName.String()
+	}
+	return windowName(sqlparser.ColIdent{}, over.WindowSpec)
+}
+
+// resolveWindow returns the window specification an OVER clause refers to, after
+// replacing the references to named windows with their definitions.
+func (hp *horizonPlanning) resolveWindow(over *sqlparser.OverClause) (*sqlparser.WindowSpecification, error) {
+	if over.WindowSpec == nil {
+		return hp.resolveNamedWindow(over.WindowName, nil)
+	}
+	return hp.resolveWindowSpec(over.WindowSpec, nil)
+}
+
+func (hp *horizonPlanning) resolveNamedWindow(name sqlparser.ColIdent, seen []sqlparser.ColIdent) (*sqlparser.WindowSpecification, error) {
+	for _, prev := range seen {
+		if prev.Equal(name) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "There is a circularity in the window dependency graph.")
+		}
+	}
+	for _, def := range hp.sel.Windows {
+		if def.Name.Equal(name) {
+			return hp.resolveWindowSpec(def.WindowSpec, append(seen, def.Name))
+		}
+	}
+	return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Window name '%s' is not defined.", name.String())
+}
+
+func (hp *horizonPlanning) resolveWindowSpec(spec *sqlparser.WindowSpecification, seen []sqlparser.ColIdent) (*sqlparser.WindowSpecification, error) {
+	if spec.Name.IsEmpty() {
+		return spec, nil
+	}
+	base, err := hp.resolveNamedWindow(spec.Name, seen)
+	if err != nil {
+		return nil, err
+	}
+	// the window being resolved is the last named window seen, if it is not an inline one
+	var name sqlparser.ColIdent
+	if len(seen) > 0 {
+		name = seen[len(seen)-1]
+	}
+	if len(spec.PartitionClause) > 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Window '%s' cannot inherit '%s' since both contain a PARTITION BY clause.", windowName(name, spec), spec.Name.String())
+	}
+	if len(spec.OrderClause) > 0 && len(base.OrderClause) > 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Window '%s' cannot inherit '%s' since both contain an ORDER BY clause.", windowName(name, spec), spec.Name.String())
+	}
+	if base.FrameClause != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Window '%s' has a frame definition, so cannot be referenced by another window.", spec.Name.String())
+	}
+	resolved := &sqlparser.WindowSpecification{
+		PartitionClause: base.PartitionClause,
+		OrderClause:     base.OrderClause,
+		FrameClause:     spec.FrameClause,
+	}
+	if len(spec.OrderClause) > 0 {
+		resolved.OrderClause = spec.OrderClause
+	}
+	return resolved, nil
+}
+
+// newWindowFrame converts a frame clause to the frame used by the engine.
+// Only the RANGE frames that use CURRENT ROW or UNBOUNDED bounds are supported,
+// since the other ones need arithmetic on the ordering column.
+func newWindowFrame(frame *sqlparser.FrameClause, name string) (*engine.WindowFrame, error) {
+	end := frame.End
+	if end == nil {
+		end = &sqlparser.FramePoint{Type: sqlparser.CurrentRowType}
+	}
+	if frame.Start.Type == sqlparser.UnboundedFollowingType || end.Type == sqlparser.UnboundedPrecedingType {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Window '%s': frame start or end is negative, NULL or of non-integral type", name)
+	}
+	isRange := frame.Unit == sqlparser.RangeFrameUnit
+	start, err := newFrameBound(frame.Start, isRange, name)
+	if err != nil {
+		return nil, err
+	}
+	stop, err := newFrameBound(end, isRange, name)
+	if err != nil {
+		return nil, err
+	}
+	return &engine.WindowFrame{Range: isRange, Start: start, End: stop}, nil
+}
+
+func newFrameBound(point *sqlparser.FramePoint, isRange bool, name string) (engine.FrameBound, error) {
+	switch point.Type {
+	case sqlparser.CurrentRowType:
+		return engine.FrameBound{}, nil
+	case sqlparser.UnboundedPrecedingType, sqlparser.UnboundedFollowingType:
+		return engine.FrameBound{Unbounded: true}, nil
+	}
+	lit, isLit := point.Expr.(*sqlparser.Literal)
+	if isRange || !isLit || lit.Type != sqlparser.IntVal {
+		return engine.FrameBound{}, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: window frame bound '%s'", sqlparser.String(point))
+	}
+	offset, err := strconv.ParseInt(lit.Val, 10, 64)
+	if err != nil {
+		return engine.FrameBound{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Window '%s': frame start or end is negative, NULL or of non-integral type", name)
+	}
+	if point.Type == sqlparser.ExprPrecedingType {
+		offset = -offset
+	}
+	return engine.FrameBound{Offset: offset}, nil
+}
+
+// windowsAlignedWithVindex returns true if every window is partitioned by a unique vindex column.
+// All the rows of such a partition live in the same shard, so the shards can compute the window functions.
+func windowsAlignedWithVindex(ctx *plancontext.PlanningContext, funcs []*windowFunc) bool {
+	for _, wf := range funcs {
+		if wf == nil {
+			continue
+		}
+		aligned := false
+		for _, expr := range wf.spec.PartitionClause {
+			if exprHasUniqueVindex(ctx.VSchema, ctx.SemTable, expr) {
+				aligned = true
+				break
+			}
+		}
+		if !aligned {
+			return false
+		}
+	}
+	return true
+}
+
+// planWindowFunctions plans the window functions of a scatter query whose windows
+// are not aligned with the sharding. The route returns the rows sorted by the
+// partitioning and ordering columns, and the window functions are computed at vtgate.
+func (hp *horizonPlanning) planWindowFunctions(ctx *plancontext.PlanningContext, rb *routeGen4, funcs []*windowFunc) (logicalPlan, error) {
+	var spec *sqlparser.WindowSpecification
+	for _, wf := range funcs {
+		if wf == nil {
+			continue
+		}
+		if spec == nil {
+			spec = wf.spec
+			continue
+		}
+		if !sqlparser.EqualsExprs(spec.PartitionClause, wf.spec.PartitionClause) || !sqlparser.EqualsOrderBy(spec.OrderClause, wf.spec.OrderClause) {
+			return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: window functions over different partitions or orderings")
+		}
+	}
+
+	plan := &window{
+		logicalPlanCommon: newBuilderCommon(rb),
+		eWindow:           &engine.Window{},
+	}
+
+	// The select list is pushed without reusing columns, so that every window function
+	// gets a column of its own to write its result to.
+	for idx, expr := range hp.qp.SelectExprs {
+		aliasedExpr, err := expr.GetAliasedExpr()
+		if err != nil {
+			return nil, err
+		}
+		wf := funcs[idx]
+		if wf == nil {
+			if _, _, err := pushProjection(ctx, aliasedExpr, rb, true, false, false); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		arg := wf.arg
+		if arg == nil {
+			arg = &sqlparser.NullVal{}
+		} else {
+			wf.params.CollationID = ctx.SemTable.CollationForExpr(arg)
+		}
+		offset, _, err := pushProjection(ctx, &sqlparser.AliasedExpr{Expr: arg}, rb, true, false, false)
+		if err != nil {
+			return nil, err
+		}
+		wf.params.Col = offset
+		plan.eWindow.Functions = append(plan.eWindow.Functions, wf.params)
+	}
+
+	for _, wf := range funcs {
+		if wf == nil || wf.defaultExpr == nil {
+			continue
+		}
+		offset, _, err := pushProjection(ctx, &sqlparser.AliasedExpr{Expr: wf.defaultExpr}, rb, true, false, false)
+		if err != nil {
+			return nil, err
+		}
+		wf.params.DefaultCol = offset
+	}
+
+	// The rows of a partition have to be next to each other and ordered by the window ordering.
+	for _, expr := range spec.PartitionClause {
+		order := &sqlparser.Order{Expr: expr, Direction: sqlparser.AscOrder}
+		offset, wsOffset, err := pushWindowOrder(ctx, rb, order)
+		if err != nil {
+			return nil, err
+		}
+		plan.eWindow.PartitionBy = append(plan.eWindow.PartitionBy, &engine.GroupByParams{
+			KeyCol:          offset,
+			WeightStringCol: wsOffset,
+			Expr:            expr,
+			CollationID:     ctx.SemTable.CollationForExpr(expr),
+		})
+	}
+	for _, order := range spec.OrderClause {
+		offset, wsOffset, err := pushWindowOrder(ctx, rb, order)
+		if err != nil {
+			return nil, err
+		}
+		plan.eWindow.OrderBy = append(plan.eWindow.OrderBy, engine.OrderByParams{
+			Col:             offset,
+			WeightStringCol: wsOffset,
+			Desc:            order.Direction == sqlparser.DescOrder,
+			CollationID:     ctx.SemTable.CollationForExpr(order.Expr),
+		})
+	}
+
+	var result logicalPlan = plan
+	if len(hp.qp.OrderExprs) > 0 {
+		var err error
+		result, err = hp.planOrderByOnWindow(ctx, plan, funcs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return hp.truncateColumnsIfNeeded(ctx, result)
+}
+
+// pushWindowOrder adds the expression to the ORDER BY of the route, and returns the offsets of the expression
+// and its weight_string in the route output. These columns are compared by the window as they come from the route,
+// so they can reuse the columns that hold window function arguments.
+func pushWindowOrder(ctx *plancontext.PlanningContext, rb *routeGen4, order *sqlparser.Order) (int, int, error) {
+	rb.Select.AddOrder(order)
+	var wsExpr sqlparser.Expr
+	if ctx.SemTable.NeedsWeightString(order.Expr) {
+		wsExpr = order.Expr
+	}
+	offset, wsOffset, err := wrapAndPushExpr(ctx, order.Expr, wsExpr, rb)
+	if err != nil {
+		return 0, 0, err
+	}
+	rb.eroute.OrderBy = append(rb.eroute.OrderBy, engine.OrderByParams{
+		Col:             offset,
+		WeightStringCol: wsOffset,
+		Desc:            order.Direction == sqlparser.DescOrder,
+		CollationID:     ctx.SemTable.CollationForExpr(order.Expr),
+	})
+	return offset, wsOffset, nil
+}
+
+// planOrderByOnWindow sorts the output of the window in memory. The columns holding the
+// window function arguments are overwritten by the results, so the ordering columns
+// are only reused if they are in the select list.
+func (hp *horizonPlanning) planOrderByOnWindow(ctx *plancontext.PlanningContext, plan *window, funcs []*windowFunc) (logicalPlan, error) {
+	primitive := &engine.MemorySort{}
+	ms := &memorySort{
+		resultsBuilder: resultsBuilder{
+			logicalPlanCommon: newBuilderCommon(plan),
+			weightStrings:     make(map[*resultColumn]int),
+			truncater:         primitive,
+		},
+		eMemorySort: primitive,
+	}
+
+	for _, order := range hp.qp.OrderExprs {
+		offset := findWindowFunc(funcs, hp.qp.SelectExprs, order.WeightStrExpr)
+		wsOffset := -1
+		collationID := ctx.SemTable.CollationForExpr(order.Inner.Expr)
+		if offset != -1 {
+			collationID = funcs[offset].params.CollationID
+		} else {
+			offset = findPlainSelectExpr(funcs, hp.qp.SelectExprs, order.WeightStrExpr)
+			if offset == -1 {
+				var err error
+				offset, _, err = pushProjection(ctx, &sqlparser.AliasedExpr{Expr: order.Inner.Expr}, plan.input, true, false, false)
+				if err != nil {
+					return nil, err
+				}
+			}
+			if ctx.SemTable.NeedsWeightString(order.Inner.Expr) {
+				var err error
+				wsOffset, _, err = pushProjection(ctx, &sqlparser.AliasedExpr{Expr: weightStringFor(order.WeightStrExpr)}, plan.input, true, false, false)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+		ms.eMemorySort.OrderBy = append(ms.eMemorySort.OrderBy, engine.OrderByParams{
+			Col:               offset,
+			WeightStringCol:   wsOffset,
+			Desc:              order.Inner.Direction == sqlparser.DescOrder,
+			StarColFixedIndex: offset,
+			CollationID:       collationID,
+		})
+	}
+	return ms, nil
+}
+
+// findPlainSelectExpr returns the position of the expression in the select list, ignoring the window functions.
+func findPlainSelectExpr(funcs []*windowFunc, selectExprs []abstract.SelectExpr, expr sqlparser.Expr) int {
+	for idx, selExpr := range selectExprs {
+		if funcs[idx] != nil {
+			continue
+		}
+		e, err := selExpr.GetExpr()
+		if err == nil && sqlparser.EqualsExpr(e, expr) {
+			return idx
+		}
+	}
+	return -1
+}
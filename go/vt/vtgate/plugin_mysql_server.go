@@ -40,11 +40,11 @@ import (
 	"vitess.io/vitess/go/vt/callinfo"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vttls"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
-	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 
 	"github.com/google/uuid"
 )
@@ -57,6 +57,7 @@ var (
 	mysqlAuthServerImpl           = flag.String("mysql_auth_server_impl", "static", "Which auth server implementation to use. Options: none, ldap, clientcert, static, vault.")
 	mysqlAllowClearTextWithoutTLS = flag.Bool("mysql_allow_clear_text_without_tls", false, "If set, the server will allow the use of a clear text password over non-SSL connections.")
 	mysqlProxyProtocol            = flag.Bool("proxy_protocol", false, "Enable HAProxy PROXY protocol on MySQL listener socket")
+	mysqlAllowLocalInfile         = flag.Bool("mysql_server_allow_local_infile", false, "If set, the server will accept LOAD DATA LOCAL INFILE from the clients that allow it. Off by default like local_infile in MySQL, since such clients send any file the server asks for.")
 
 	mysqlServerRequireSecureTransport = flag.Bool("mysql_server_require_secure_transport", false, "Reject insecure connections but only if mysql_server_ssl_cert and mysql_server_ssl_key are provided")
 
@@ -194,6 +195,7 @@ func (vh *vtgateHandler) ComQuery(c *mysql.Conn, query string, callback func(*sq
 	defer span.Finish()
 
 	ctx = callinfo.MysqlCallInfo(ctx, c)
+	ctx = withLocalInfile(ctx, c)
 
 	// Fill in the ImmediateCallerID with the UserData returned by
 	// the AuthServer plugin for that user. If nothing was
@@ -339,8 +341,31 @@ func (vh *vtgateHandler) WarningCount(c *mysql.Conn) uint16 {
 }
 
 // ComBinlogDumpGTID is part of the mysql.Handler interface.
+// It streams the changes of the keyspace selected on the connection.
 func (vh *vtgateHandler) ComBinlogDumpGTID(c *mysql.Conn, gtidSet mysql.GTIDSet) error {
-	return vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "ComBinlogDumpGTID")
+	// The binlog dump lasts until the replica goes away.
+	ctx, cancel := context.WithCancel(c.BinlogDumpContext(context.Background()))
+	defer cancel()
+
+	ctx = callinfo.MysqlCallInfo(ctx, c)
+	im := c.UserData.Get()
+	ef := callerid.NewEffectiveCallerID(
+		c.User,                  /* principal: who */
+		c.RemoteAddr().String(), /* component: running client process */
+		"VTGate MySQL Connector" /* subcomponent: part of the client */)
+	ctx = callerid.NewContext(ctx, ef, im)
+
+	session := vh.session(c)
+	keyspace, tabletType, _, err := topoproto.ParseDestination(session.TargetString, defaultTabletType)
+	if err != nil {
+		return mysql.NewSQLErrorFromError(err)
+	}
+	if keyspace == "" {
+		return mysql.NewSQLError(mysql.ERNoDb, mysql.SSNoDB, "no keyspace selected for the binlog dump")
+	}
+
+	err = vh.vtg.binlogDump(ctx, keyspace, tabletType, gtidSet, c.WriteBinlogEvent)
+	return mysql.NewSQLErrorFromError(err)
 }
 
 func (vh *vtgateHandler) session(c *mysql.Conn) *vtgatepb.Session {
@@ -450,6 +475,7 @@ func initMySQLProtocol() {
 			_ = initTLSConfig(mysqlListener, *mysqlSslCert, *mysqlSslKey, *mysqlSslCa, *mysqlSslCrl, *mysqlSslServerCA, *mysqlServerRequireSecureTransport, tlsVersion)
 		}
 		mysqlListener.AllowClearTextWithoutTLS.Set(*mysqlAllowClearTextWithoutTLS)
+		mysqlListener.AllowLocalInfile = *mysqlAllowLocalInfile
 		// Check for the connection threshold
 		if *mysqlSlowConnectWarnThreshold != 0 {
 			log.Infof("setting mysql slow connection threshold to %v", mysqlSlowConnectWarnThreshold)
@@ -469,6 +495,7 @@ func initMySQLProtocol() {
 			log.Exitf("mysql.NewListener failed: %v", err)
 			return
 		}
+		mysqlUnixListener.AllowLocalInfile = *mysqlAllowLocalInfile
 		// Listen for unix socket
 		go mysqlUnixListener.Accept()
 	}
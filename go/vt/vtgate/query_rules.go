@@ -0,0 +1,83 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/queryrules"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	queryRuleMatches   = stats.NewCountersWithSingleLabel("QueryRuleMatches", "Queries matching a vtgate query rule", "Rule")
+	queryRuleThrottled = stats.NewCountersWithSingleLabel("QueryRuleThrottled", "Queries rejected by the max concurrency of a vtgate query rule", "Rule")
+)
+
+// applyQueryRules applies the first query rule of the vschema matching the
+// query: it blocks or throttles the query, rewrites it, or changes the
+// keyspace or tablet type of the vcursor. It returns the query to plan, and
+// a function releasing the concurrency slot taken by the rule, to call once
+// the query is executed.
+func (e *Executor) applyQueryRules(safeSession *SafeSession, vcursor *vcursorImpl, query string, comments sqlparser.MarginComments) (string, func(), error) {
+	noop := func() {}
+	rules := vcursor.vschema.QueryRules
+	if rules.Len() == 0 {
+		return query, noop, nil
+	}
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		// The planner reports the error.
+		return query, noop, nil
+	}
+	rule := rules.Match(&queryrules.Query{
+		Statement:      stmt,
+		MarginComments: comments,
+		Keyspace:       vcursor.keyspace,
+		User:           callerid.ImmediateCallerIDFromContext(vcursor.ctx).GetUsername(),
+	})
+	if rule == nil {
+		return query, noop, nil
+	}
+	queryRuleMatches.Add(rule.Name, 1)
+
+	if rule.Block {
+		return "", noop, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "query blocked by query rule %s", rule.Name)
+	}
+	if !rule.TryAcquire() {
+		queryRuleThrottled.Add(rule.Name, 1)
+		return "", noop, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "too many concurrent queries for query rule %s", rule.Name)
+	}
+	if rewritten, ok, err := rule.Rewrite(stmt); err != nil {
+		rule.Release()
+		return "", noop, err
+	} else if ok {
+		query = rewritten
+	}
+	if rule.Keyspace != "" {
+		vcursor.keyspace = rule.Keyspace
+	}
+	// Transactions stay on the tablet type they started on.
+	if rule.TabletType != topodatapb.TabletType_UNKNOWN && !safeSession.InTransaction() {
+		vcursor.tabletType = rule.TabletType
+	}
+	return query, rule.Release, nil
+}
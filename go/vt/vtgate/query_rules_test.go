@@ -0,0 +1,154 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/queryrules"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func setQueryRules(t *testing.T, executor *Executor, rules ...*vschemapb.QueryRule) {
+	t.Helper()
+	qr, err := queryrules.Build(&vschemapb.QueryRules{Rules: rules})
+	require.NoError(t, err)
+	executor.vschema.QueryRules = qr
+	executor.plans.Clear()
+}
+
+func TestExecutorQueryRules(t *testing.T) {
+	executor, sbc1, _, sbclookup := createExecutorEnv()
+	session := &vtgatepb.Session{TargetString: "@primary", Autocommit: true}
+	exec := func(ctx context.Context, query string) (*sqltypes.Result, error) {
+		return executor.Execute(ctx, "TestExecute", NewSafeSession(session), query, nil)
+	}
+	ctx := context.Background()
+
+	setQueryRules(t, executor, &vschemapb.QueryRule{
+		Name:        "block",
+		Fingerprint: "select * from user where id = 1",
+		Block:       true,
+	}, &vschemapb.QueryRule{
+		Name:   "users",
+		Tables: []string{"TestExecutor.user"},
+		Users:  []string{"batch"},
+		Hints:  "MAX_EXECUTION_TIME(1000)",
+	}, &vschemapb.QueryRule{
+		Name:         "rewrite",
+		Comment:      "legacy",
+		RewriteQuery: "select id from music where user_id = 1",
+	}, &vschemapb.QueryRule{
+		Name:     "redirect",
+		Tables:   []string{"user_extra"},
+		Keyspace: KsTestUnsharded,
+	})
+
+	_, err := exec(ctx, "select * from user where id = 2")
+	require.EqualError(t, err, "query blocked by query rule block")
+	assert.EqualValues(t, 1, queryRuleMatches.Counts()["block"])
+
+	batchCtx := callerid.NewContext(ctx, nil, &querypb.VTGateCallerID{Username: "batch"})
+	_, err = exec(batchCtx, "select id from user where id = 3")
+	require.NoError(t, err)
+	_, err = exec(ctx, "select id from user where id = 3")
+	require.NoError(t, err)
+	assertQueries(t, sbc1, []*querypb.BoundQuery{{
+		Sql:           "select /*+ MAX_EXECUTION_TIME(1000) */ id from `user` where id = 3",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "select id from `user` where id = 3",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}})
+
+	sbc1.Queries = nil
+	_, err = exec(ctx, "/* legacy */ select id from user where id = 1")
+	require.NoError(t, err)
+	assertQueries(t, sbc1, []*querypb.BoundQuery{{
+		Sql:           "/* legacy */ select id from music where user_id = 1",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}})
+
+	_, err = exec(ctx, "select * from user_extra")
+	require.NoError(t, err)
+	assertQueries(t, sbclookup, []*querypb.BoundQuery{{
+		Sql:           "select * from user_extra",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}})
+}
+
+func TestExecutorQueryRulesTabletType(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	replica := executor.scatterConn.gateway.(*TabletGateway).hc.(*discovery.FakeHealthCheck).AddTestTablet("aa", "-20", 2, "TestExecutor", "-20", topodatapb.TabletType_REPLICA, true, 1, nil)
+	setQueryRules(t, executor, &vschemapb.QueryRule{
+		Name:       "replica",
+		Comment:    "report",
+		TabletType: "replica",
+	})
+
+	session := &vtgatepb.Session{TargetString: "@primary", Autocommit: true}
+	_, err := executorExecSession(executor, "select /* report */ id from user where id = 1", nil, session)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, sbc1.ExecCount.Get())
+	assert.EqualValues(t, 1, replica.ExecCount.Get())
+
+	// Transactions stay on the primary.
+	_, err = executorExec(executor, "select /* report */ id from user where id = 1", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbc1.ExecCount.Get())
+	assert.EqualValues(t, 1, replica.ExecCount.Get())
+}
+
+func TestExecutorQueryRulesConcurrency(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	setQueryRules(t, executor, &vschemapb.QueryRule{
+		Name:           "throttle",
+		Tables:         []string{"user"},
+		MaxConcurrency: 1,
+	})
+	stmt, err := sqlparser.Parse("select * from user")
+	require.NoError(t, err)
+	rule := executor.vschema.QueryRules.Match(&queryrules.Query{Statement: stmt})
+	require.NotNil(t, rule)
+
+	session := &vtgatepb.Session{TargetString: "@primary", Autocommit: true}
+	// The slot is released after each execution.
+	for i := 0; i < 2; i++ {
+		_, err := executorExecSession(executor, "select id from user where id = 1", nil, session)
+		require.NoError(t, err)
+	}
+
+	require.True(t, rule.TryAcquire())
+	_, err = executorExecSession(executor, "select id from user where id = 1", nil, session)
+	require.EqualError(t, err, "too many concurrent queries for query rule throttle")
+	assert.EqualValues(t, 1, queryRuleThrottled.Counts()["throttle"])
+	rule.Release()
+
+	assert.EqualValues(t, 2, sbc1.ExecCount.Get())
+}
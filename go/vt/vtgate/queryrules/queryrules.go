@@ -0,0 +1,404 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package queryrules implements the vtgate query rules: rules stored in the
+// topo next to the routing rules that match queries on their fingerprint,
+// tables, user or comments, and block, rewrite, hint, redirect or throttle
+// them.
+package queryrules
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// Rules is the compiled list of query rules. The first matching rule
+// applies to a query.
+type Rules struct {
+	rules []*Rule
+}
+
+// Rule is a compiled query rule. Rules that failed to compile keep their
+// Error and never match.
+type Rule struct {
+	Name         string
+	Block        bool
+	RewriteQuery string
+	Hints        string
+	Keyspace     string
+	TabletType   topodatapb.TabletType
+	Error        error
+
+	source      *vschemapb.QueryRule
+	fingerprint string
+	tables      map[string]bool
+	users       map[string]bool
+	comment     *regexp.Regexp
+	rewrite     sqlparser.Statement
+	concurrency *sync2.Semaphore
+	maxConc     int32
+}
+
+// Query is a query the rules are matched against.
+type Query struct {
+	Statement      sqlparser.Statement
+	MarginComments sqlparser.MarginComments
+	// Keyspace is the default keyspace of the session, used to qualify
+	// the tables of the query.
+	Keyspace string
+	// User is the immediate caller of vtgate.
+	User string
+
+	fingerprint string
+}
+
+// Build compiles the query rules. The rules that are not valid are kept
+// with their error, and the aggregate of the errors is returned.
+func Build(source *vschemapb.QueryRules) (*Rules, error) {
+	rs := &Rules{}
+	if source == nil {
+		return rs, nil
+	}
+	var errs []error
+	for _, qr := range source.Rules {
+		rule := buildRule(qr)
+		if rule.Error != nil {
+			errs = append(errs, rule.Error)
+		}
+		rs.rules = append(rs.rules, rule)
+	}
+	return rs, vterrors.Aggregate(errs)
+}
+
+func buildRule(qr *vschemapb.QueryRule) *Rule {
+	rule := &Rule{
+		Name:         qr.Name,
+		Block:        qr.Block,
+		RewriteQuery: qr.RewriteQuery,
+		Hints:        qr.Hints,
+		Keyspace:     qr.Keyspace,
+		source:       qr,
+	}
+	fail := func(format string, args ...interface{}) *Rule {
+		rule.Error = vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "query rule %s: %s", qr.Name, fmt.Sprintf(format, args...))
+		return rule
+	}
+
+	if qr.Name == "" {
+		return fail("missing name")
+	}
+	if qr.Fingerprint == "" && len(qr.Tables) == 0 && len(qr.Users) == 0 && qr.Comment == "" {
+		return fail("at least one of fingerprint, tables, users or comment is required")
+	}
+	if !qr.Block && qr.RewriteQuery == "" && qr.Hints == "" && qr.Keyspace == "" && qr.TabletType == "" && qr.MaxConcurrency == 0 {
+		return fail("no action")
+	}
+	if qr.Block && (qr.RewriteQuery != "" || qr.Hints != "" || qr.Keyspace != "" || qr.TabletType != "" || qr.MaxConcurrency != 0) {
+		return fail("a blocking rule cannot have other actions")
+	}
+
+	if qr.Fingerprint != "" {
+		stmt, err := sqlparser.Parse(qr.Fingerprint)
+		if err != nil {
+			return fail("invalid fingerprint: %s", err.Error())
+		}
+		rule.fingerprint = Fingerprint(stmt)
+	}
+	if len(qr.Tables) > 0 {
+		rule.tables = make(map[string]bool, len(qr.Tables))
+		for _, table := range qr.Tables {
+			rule.tables[table] = true
+		}
+	}
+	if len(qr.Users) > 0 {
+		rule.users = make(map[string]bool, len(qr.Users))
+		for _, user := range qr.Users {
+			rule.users[user] = true
+		}
+	}
+	if qr.Comment != "" {
+		re, err := regexp.Compile(qr.Comment)
+		if err != nil {
+			return fail("invalid comment regexp: %s", err.Error())
+		}
+		rule.comment = re
+	}
+	if qr.RewriteQuery != "" {
+		stmt, err := sqlparser.Parse(qr.RewriteQuery)
+		if err != nil {
+			return fail("invalid rewrite query: %s", err.Error())
+		}
+		rule.rewrite = stmt
+	}
+	if qr.Hints != "" && strings.Contains(qr.Hints, "*/") {
+		return fail("invalid hints: %s", qr.Hints)
+	}
+	if qr.TabletType != "" {
+		tabletType, err := topoproto.ParseTabletType(qr.TabletType)
+		if err != nil {
+			return fail("%s", err.Error())
+		}
+		rule.TabletType = tabletType
+	}
+	switch {
+	case qr.MaxConcurrency < 0:
+		return fail("invalid max concurrency: %d", qr.MaxConcurrency)
+	case qr.MaxConcurrency > 0:
+		rule.concurrency = sync2.NewSemaphore(int(qr.MaxConcurrency), 0)
+		rule.maxConc = qr.MaxConcurrency
+	}
+	return rule
+}
+
+// Len returns the number of rules.
+func (rs *Rules) Len() int {
+	if rs == nil {
+		return 0
+	}
+	return len(rs.rules)
+}
+
+// Inherit makes the rules share the concurrency slots of the previous
+// rules with the same name and limit, so that rebuilding the rules does
+// not reset the queries they are throttling.
+func (rs *Rules) Inherit(previous *Rules) {
+	if rs.Len() == 0 || previous.Len() == 0 {
+		return
+	}
+	byName := make(map[string]*Rule, len(previous.rules))
+	for _, rule := range previous.rules {
+		byName[rule.Name] = rule
+	}
+	for _, rule := range rs.rules {
+		prev := byName[rule.Name]
+		if rule.concurrency == nil || prev == nil || prev.concurrency == nil {
+			continue
+		}
+		if prev.maxConc == rule.maxConc {
+			rule.concurrency = prev.concurrency
+		}
+	}
+}
+
+// Match returns the first valid rule matching the query, or nil.
+func (rs *Rules) Match(q *Query) *Rule {
+	if rs.Len() == 0 {
+		return nil
+	}
+	var (
+		tables   []sqlparser.TableName
+		comments []string
+		walked   bool
+	)
+	walk := func() {
+		if walked {
+			return
+		}
+		walked = true
+		tables, comments = walkStatement(q.Statement)
+		if q.MarginComments.Leading != "" {
+			comments = append(comments, q.MarginComments.Leading)
+		}
+		if q.MarginComments.Trailing != "" {
+			comments = append(comments, q.MarginComments.Trailing)
+		}
+	}
+
+	for _, rule := range rs.rules {
+		if rule.Error != nil {
+			continue
+		}
+		if rule.users != nil && !rule.users[q.User] {
+			continue
+		}
+		if rule.fingerprint != "" {
+			if q.fingerprint == "" {
+				q.fingerprint = Fingerprint(q.Statement)
+			}
+			if rule.fingerprint != q.fingerprint {
+				continue
+			}
+		}
+		if rule.tables != nil {
+			walk()
+			if !rule.matchTables(tables, q.Keyspace) {
+				continue
+			}
+		}
+		if rule.comment != nil {
+			walk()
+			if !rule.matchComments(comments) {
+				continue
+			}
+		}
+		return rule
+	}
+	return nil
+}
+
+func (rule *Rule) matchTables(tables []sqlparser.TableName, keyspace string) bool {
+	for _, table := range tables {
+		name := table.Name.String()
+		qualifier := table.Qualifier.String()
+		if qualifier == "" {
+			qualifier = keyspace
+		}
+		if rule.tables[name] || rule.tables[qualifier+"."+name] {
+			return true
+		}
+	}
+	return false
+}
+
+func (rule *Rule) matchComments(comments []string) bool {
+	for _, comment := range comments {
+		if rule.comment.MatchString(comment) {
+			return true
+		}
+	}
+	return false
+}
+
+// walkStatement returns the tables and the comments of the statement.
+func walkStatement(stmt sqlparser.Statement) (tables []sqlparser.TableName, comments []string) {
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.AliasedTableExpr:
+			if table, ok := node.Expr.(sqlparser.TableName); ok {
+				tables = append(tables, table)
+			}
+		case *sqlparser.Insert:
+			tables = append(tables, node.Table)
+		case sqlparser.DDLStatement:
+			tables = append(tables, node.AffectedTables()...)
+		case sqlparser.Comments:
+			comments = append(comments, node...)
+		}
+		return true, nil
+	}, stmt)
+	return tables, comments
+}
+
+// Fingerprint returns the normalized form of the statement the rules match
+// on: its literals and bind variables are replaced by ?, and its comments
+// are removed.
+func Fingerprint(stmt sqlparser.Statement) string {
+	stmt = sqlparser.CloneStatement(stmt)
+	// The errors only happen on bind variables the normalizer does not
+	// know, and leave the statement as it is.
+	_ = sqlparser.Normalize(stmt, sqlparser.NewReservedVars("fp", sqlparser.BindVars{}), map[string]*querypb.BindVariable{})
+	buf := sqlparser.NewTrackedBuffer(func(buf *sqlparser.TrackedBuffer, node sqlparser.SQLNode) {
+		switch node.(type) {
+		case sqlparser.Argument, sqlparser.ListArg:
+			buf.WriteString("?")
+		case sqlparser.Comments:
+		default:
+			node.Format(buf)
+		}
+	})
+	return buf.WriteNode(stmt).String()
+}
+
+// TryAcquire reserves a slot for a query when the rule limits the
+// concurrency of its queries. It returns false when all the slots are
+// taken.
+func (rule *Rule) TryAcquire() bool {
+	if rule.concurrency == nil {
+		return true
+	}
+	return rule.concurrency.TryAcquire()
+}
+
+// Release releases the slot reserved by TryAcquire.
+func (rule *Rule) Release() {
+	if rule.concurrency != nil {
+		rule.concurrency.Release()
+	}
+}
+
+// Rewrite returns the query to execute in place of the statement: the
+// rewrite query of the rule, with its hints added. It returns false when
+// the rule does not change the query.
+func (rule *Rule) Rewrite(stmt sqlparser.Statement) (string, bool, error) {
+	if rule.RewriteQuery == "" && rule.Hints == "" {
+		return "", false, nil
+	}
+	if rule.rewrite != nil {
+		stmt = sqlparser.CloneStatement(rule.rewrite)
+	}
+	if rule.Hints != "" {
+		if err := addHints(stmt, rule.Hints); err != nil {
+			return "", false, err
+		}
+	}
+	return sqlparser.String(stmt), true, nil
+}
+
+// addHints adds the optimizer hints to the statements that support them.
+func addHints(stmt sqlparser.Statement, hints string) error {
+	switch stmt := stmt.(type) {
+	case sqlparser.SelectStatement:
+		comments, err := stmt.GetComments().AddQueryHint(hints)
+		if err != nil {
+			return err
+		}
+		stmt.SetComments(comments)
+	case *sqlparser.Insert:
+		comments, err := stmt.Comments.AddQueryHint(hints)
+		if err != nil {
+			return err
+		}
+		stmt.Comments = comments
+	case *sqlparser.Update:
+		comments, err := stmt.Comments.AddQueryHint(hints)
+		if err != nil {
+			return err
+		}
+		stmt.Comments = comments
+	case *sqlparser.Delete:
+		comments, err := stmt.Comments.AddQueryHint(hints)
+		if err != nil {
+			return err
+		}
+		stmt.Comments = comments
+	}
+	return nil
+}
+
+// MarshalJSON returns a JSON representation of Rules.
+func (rs *Rules) MarshalJSON() ([]byte, error) {
+	return json.Marshal(rs.rules)
+}
+
+// MarshalJSON returns a JSON representation of Rule.
+func (rule *Rule) MarshalJSON() ([]byte, error) {
+	if rule.Error != nil {
+		return json.Marshal(rule.Error.Error())
+	}
+	return json.Marshal(rule.source)
+}
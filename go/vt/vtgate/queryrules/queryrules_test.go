@@ -0,0 +1,225 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queryrules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+func TestFingerprint(t *testing.T) {
+	tcases := []struct {
+		query, fingerprint string
+	}{{
+		query:       "select * from t where id = 1",
+		fingerprint: "select * from t where id = ?",
+	}, {
+		query:       "SELECT /* c */ *  FROM t WHERE id = :id AND name IN ('a', 'b')",
+		fingerprint: "select * from t where id = ? and `name` in ?",
+	}, {
+		query:       "select * from t where id = ?",
+		fingerprint: "select * from t where id = ?",
+	}, {
+		query:       "update /*+ hint */ t set a = 'x' where id = 3",
+		fingerprint: "update t set a = ? where id = ?",
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.query, func(t *testing.T) {
+			stmt, err := sqlparser.Parse(tcase.query)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.fingerprint, Fingerprint(stmt))
+		})
+	}
+}
+
+func TestBuildErrors(t *testing.T) {
+	tcases := []struct {
+		rule *vschemapb.QueryRule
+		err  string
+	}{{
+		rule: &vschemapb.QueryRule{Tables: []string{"t"}, Block: true},
+		err:  "query rule : missing name",
+	}, {
+		rule: &vschemapb.QueryRule{Name: "r", Block: true},
+		err:  "query rule r: at least one of fingerprint, tables, users or comment is required",
+	}, {
+		rule: &vschemapb.QueryRule{Name: "r", Tables: []string{"t"}},
+		err:  "query rule r: no action",
+	}, {
+		rule: &vschemapb.QueryRule{Name: "r", Tables: []string{"t"}, Block: true, Hints: "h"},
+		err:  "query rule r: a blocking rule cannot have other actions",
+	}, {
+		rule: &vschemapb.QueryRule{Name: "r", Fingerprint: "selec", Block: true},
+		err:  "query rule r: invalid fingerprint: syntax error at position 6 near 'selec'",
+	}, {
+		rule: &vschemapb.QueryRule{Name: "r", Comment: "(", Block: true},
+		err:  "query rule r: invalid comment regexp: error parsing regexp: missing closing ): `(`",
+	}, {
+		rule: &vschemapb.QueryRule{Name: "r", Tables: []string{"t"}, RewriteQuery: "selec"},
+		err:  "query rule r: invalid rewrite query: syntax error at position 6 near 'selec'",
+	}, {
+		rule: &vschemapb.QueryRule{Name: "r", Tables: []string{"t"}, Hints: "a */ b"},
+		err:  "query rule r: invalid hints: a */ b",
+	}, {
+		rule: &vschemapb.QueryRule{Name: "r", Tables: []string{"t"}, TabletType: "foo"},
+		err:  "query rule r: unknown TabletType foo",
+	}, {
+		rule: &vschemapb.QueryRule{Name: "r", Tables: []string{"t"}, MaxConcurrency: -1},
+		err:  "query rule r: invalid max concurrency: -1",
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.err, func(t *testing.T) {
+			rs, err := Build(&vschemapb.QueryRules{Rules: []*vschemapb.QueryRule{tcase.rule}})
+			require.EqualError(t, err, tcase.err)
+			require.Equal(t, 1, rs.Len())
+			assert.EqualError(t, rs.rules[0].Error, tcase.err)
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	rs, err := Build(&vschemapb.QueryRules{Rules: []*vschemapb.QueryRule{{
+		Name:  "invalid",
+		Block: true,
+	}, {
+		Name:        "fingerprint",
+		Fingerprint: "select * from t where id = 1",
+		Block:       true,
+	}, {
+		Name:   "tables",
+		Tables: []string{"ks.u", "v"},
+		Users:  []string{"alice", "bob"},
+		Hints:  "MAX_EXECUTION_TIME(1000)",
+	}, {
+		Name:       "comment",
+		Comment:    "report",
+		TabletType: "replica",
+	}}})
+	require.Error(t, err)
+
+	match := func(query, keyspace, user string) string {
+		t.Helper()
+		sql, comments := sqlparser.SplitMarginComments(query)
+		stmt, err := sqlparser.Parse(sql)
+		require.NoError(t, err)
+		rule := rs.Match(&Query{Statement: stmt, MarginComments: comments, Keyspace: keyspace, User: user})
+		if rule == nil {
+			return ""
+		}
+		return rule.Name
+	}
+
+	assert.Equal(t, "fingerprint", match("select * from t where id = 42", "ks", "alice"))
+	assert.Equal(t, "", match("select * from t where id > 42", "ks", "alice"))
+	assert.Equal(t, "tables", match("select * from u join w on u.id = w.id", "ks", "alice"))
+	assert.Equal(t, "tables", match("select * from ks.u", "", "bob"))
+	assert.Equal(t, "tables", match("insert into other.v(id) values (1)", "", "bob"))
+	assert.Equal(t, "", match("select * from u", "other", "alice"))
+	assert.Equal(t, "", match("select * from u", "ks", "carol"))
+	assert.Equal(t, "", match("select u.id from w as u", "ks", "alice"))
+	assert.Equal(t, "comment", match("/* report */ select * from w", "ks", "carol"))
+	assert.Equal(t, "comment", match("select /* daily report */ * from w", "ks", "carol"))
+	assert.Equal(t, "", match("select /* daily */ * from w", "ks", "carol"))
+
+	var nilRules *Rules
+	assert.Nil(t, nilRules.Match(&Query{}))
+}
+
+func TestRewrite(t *testing.T) {
+	rs, err := Build(&vschemapb.QueryRules{Rules: []*vschemapb.QueryRule{{
+		Name:   "hints",
+		Tables: []string{"t"},
+		Hints:  "MAX_EXECUTION_TIME(1000)",
+	}, {
+		Name:         "rewrite",
+		Tables:       []string{"u"},
+		RewriteQuery: "select id from u_archive where id = :id",
+		Hints:        "NO_INDEX_MERGE(u_archive)",
+	}, {
+		Name:       "redirect",
+		Tables:     []string{"v"},
+		Keyspace:   "other",
+		TabletType: "rdonly",
+	}}})
+	require.NoError(t, err)
+
+	rewrite := func(query string) (*Rule, string, bool) {
+		t.Helper()
+		stmt, err := sqlparser.Parse(query)
+		require.NoError(t, err)
+		rule := rs.Match(&Query{Statement: stmt})
+		require.NotNil(t, rule)
+		sql, changed, err := rule.Rewrite(stmt)
+		require.NoError(t, err)
+		return rule, sql, changed
+	}
+
+	_, sql, changed := rewrite("select * from t")
+	assert.True(t, changed)
+	assert.Equal(t, "select /*+ MAX_EXECUTION_TIME(1000) */ * from t", sql)
+
+	_, sql, _ = rewrite("update /*+ SET_VAR(sort_buffer_size = 16M) */ t set a = 1")
+	assert.Equal(t, "update /*+ SET_VAR(sort_buffer_size = 16M) MAX_EXECUTION_TIME(1000) */ t set a = 1", sql)
+
+	_, sql, _ = rewrite("select * from u where id = :id")
+	assert.Equal(t, "select /*+ NO_INDEX_MERGE(u_archive) */ id from u_archive where id = :id", sql)
+
+	rule, _, changed := rewrite("select * from v")
+	assert.False(t, changed)
+	assert.Equal(t, "other", rule.Keyspace)
+	assert.Equal(t, topodatapb.TabletType_RDONLY, rule.TabletType)
+}
+
+func TestConcurrency(t *testing.T) {
+	source := &vschemapb.QueryRules{Rules: []*vschemapb.QueryRule{{
+		Name:           "throttle",
+		Tables:         []string{"t"},
+		MaxConcurrency: 2,
+	}}}
+	rs, err := Build(source)
+	require.NoError(t, err)
+	rule := rs.rules[0]
+
+	assert.True(t, rule.TryAcquire())
+	assert.True(t, rule.TryAcquire())
+	assert.False(t, rule.TryAcquire())
+
+	// The rebuilt rules keep the slots taken.
+	rebuilt, err := Build(source)
+	require.NoError(t, err)
+	rebuilt.Inherit(rs)
+	assert.False(t, rebuilt.rules[0].TryAcquire())
+	rule.Release()
+	assert.True(t, rebuilt.rules[0].TryAcquire())
+
+	// A new limit starts over.
+	source.Rules[0].MaxConcurrency = 3
+	changed, err := Build(source)
+	require.NoError(t, err)
+	changed.Inherit(rebuilt)
+	for i := 0; i < 3; i++ {
+		assert.True(t, changed.rules[0].TryAcquire())
+	}
+	assert.False(t, changed.rules[0].TryAcquire())
+}
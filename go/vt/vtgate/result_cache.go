@@ -0,0 +1,630 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/hack"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+const pathResultCache = "/debug/result_cache"
+
+// resultCacheRetryDelay is the time to wait before restarting a failed
+// stream of row events.
+var resultCacheRetryDelay = 5 * time.Second
+
+// nonDeterministicFuncs are the functions whose results change between two
+// executions of the same query. The results of queries using them are not
+// cached.
+var nonDeterministicFuncs = map[string]bool{
+	"rand":              true,
+	"uuid":              true,
+	"uuid_short":        true,
+	"now":               true,
+	"sysdate":           true,
+	"curdate":           true,
+	"curtime":           true,
+	"current_date":      true,
+	"current_time":      true,
+	"current_timestamp": true,
+	"localtime":         true,
+	"localtimestamp":    true,
+	"unix_timestamp":    true,
+	"utc_date":          true,
+	"utc_time":          true,
+	"utc_timestamp":     true,
+	"connection_id":     true,
+	"database":          true,
+	"schema":            true,
+	"user":              true,
+	"current_user":      true,
+	"session_user":      true,
+	"system_user":       true,
+	"found_rows":        true,
+	"row_count":         true,
+	"last_insert_id":    true,
+	"sleep":             true,
+	"get_lock":          true,
+	"release_lock":      true,
+	"is_free_lock":      true,
+	"is_used_lock":      true,
+}
+
+type (
+	// ResultCache caches the results of read-only queries at vtgate.
+	// A query is cached when it only reads tables opted in with
+	// -result_cache_tables, or when it has a RESULT_CACHE_TTL_MS comment
+	// directive. The cached results expire after their TTL, and they are
+	// invalidated by the row events of their tables, read from a VStream of
+	// each keyspace.
+	ResultCache struct {
+		maxMemory     int64
+		maxResultSize int64
+		defaultTTL    time.Duration
+		// tables are the keyspace.table names opted in.
+		tables   map[string]bool
+		streamer resultCacheStreamer
+		now      func() time.Time
+
+		mu      sync.Mutex
+		lru     *list.List
+		entries map[string]*list.Element
+		size    int64
+		// byTable are the keys of the entries reading each table.
+		byTable map[string]map[string]bool
+		// generations is bumped by each invalidation of a table, so that the
+		// results read while the table changed are not stored.
+		generations map[string]uint64
+		watchers    map[string]*resultCacheWatcher
+
+		hits, misses, evictions, invalidations int64
+	}
+
+	resultCacheEntry struct {
+		key    string
+		query  string
+		tables []string
+		result *sqltypes.Result
+		size   int64
+		expire time.Time
+		hits   int64
+	}
+
+	// resultCacheWatcher streams the row events of the cached tables of a keyspace.
+	resultCacheWatcher struct {
+		keyspace string
+		tables   map[string]bool
+		cancel   context.CancelFunc
+		// ready is set with the first event of the stream. Until then, the
+		// changes of the tables may be missed, so no result is stored.
+		ready bool
+	}
+
+	// resultCacheStreamer streams the events of the tables of the filter in
+	// the keyspace, from the current position.
+	resultCacheStreamer func(ctx context.Context, keyspace string, filter *binlogdatapb.Filter, send func([]*binlogdatapb.VEvent) error) error
+
+	// resultCacheToken is the state of the tables of a query when it starts
+	// executing. Its results are only stored if the tables did not change.
+	resultCacheToken struct {
+		key         string
+		generations map[string]uint64
+	}
+)
+
+// NewResultCache creates a ResultCache using at most maxMemory bytes, and
+// keeping no result bigger than maxResultSize bytes. The results of the
+// tables, given as keyspace.table, are cached for defaultTTL.
+func NewResultCache(maxMemory, maxResultSize int64, defaultTTL time.Duration, tables []string, stream resultCacheStreamer) *ResultCache {
+	rc := &ResultCache{
+		maxMemory:     maxMemory,
+		maxResultSize: maxResultSize,
+		defaultTTL:    defaultTTL,
+		tables:        make(map[string]bool),
+		streamer:      stream,
+		now:           time.Now,
+		lru:           list.New(),
+		entries:       make(map[string]*list.Element),
+		byTable:       make(map[string]map[string]bool),
+		generations:   make(map[string]uint64),
+		watchers:      make(map[string]*resultCacheWatcher),
+	}
+	for _, table := range tables {
+		if table = strings.TrimSpace(table); table != "" {
+			rc.tables[table] = true
+		}
+	}
+	return rc
+}
+
+// vstreamResultCacheStreamer streams the events of a keyspace through the vstream manager.
+func vstreamResultCacheStreamer(vsm *vstreamManager) resultCacheStreamer {
+	return func(ctx context.Context, keyspace string, filter *binlogdatapb.Filter, send func([]*binlogdatapb.VEvent) error) error {
+		vgtid := &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{Keyspace: keyspace, Gtid: "current"}}}
+		flags := &vtgatepb.VStreamFlags{HeartbeatInterval: 1}
+		return vsm.VStream(ctx, topodatapb.TabletType_PRIMARY, vgtid, filter, flags, send)
+	}
+}
+
+// RegisterStats exports the statistics of the cache, and its debug page.
+func (rc *ResultCache) RegisterStats() {
+	stats.NewGaugeFunc("ResultCacheLength", "Result cache length", func() int64 {
+		rc.mu.Lock()
+		defer rc.mu.Unlock()
+		return int64(rc.lru.Len())
+	})
+	stats.NewGaugeFunc("ResultCacheSize", "Result cache size in bytes", func() int64 {
+		rc.mu.Lock()
+		defer rc.mu.Unlock()
+		return rc.size
+	})
+	stats.NewGaugeFunc("ResultCacheCapacity", "Result cache capacity in bytes", func() int64 {
+		return rc.maxMemory
+	})
+	stats.NewCounterFunc("ResultCacheHits", "Result cache hits", func() int64 {
+		rc.mu.Lock()
+		defer rc.mu.Unlock()
+		return rc.hits
+	})
+	stats.NewCounterFunc("ResultCacheMisses", "Result cache misses", func() int64 {
+		rc.mu.Lock()
+		defer rc.mu.Unlock()
+		return rc.misses
+	})
+	stats.NewCounterFunc("ResultCacheEvictions", "Result cache evictions", func() int64 {
+		rc.mu.Lock()
+		defer rc.mu.Unlock()
+		return rc.evictions
+	})
+	stats.NewCounterFunc("ResultCacheInvalidations", "Result cache entries invalidated by changes of their tables", func() int64 {
+		rc.mu.Lock()
+		defer rc.mu.Unlock()
+		return rc.invalidations
+	})
+	http.Handle(pathResultCache, rc)
+}
+
+// TTL returns how long the results of the statement can be cached, and the
+// keyspace.table names it reads. A zero TTL means the results are not cached.
+func (rc *ResultCache) TTL(stmt sqlparser.Statement, bindVarNeeds *sqlparser.BindVarNeeds, vcursor *vcursorImpl) (time.Duration, []string) {
+	sel, ok := stmt.(sqlparser.SelectStatement)
+	if !ok {
+		return 0, nil
+	}
+	if bindVarNeeds != nil && (len(bindVarNeeds.NeedFunctionResult) > 0 || len(bindVarNeeds.NeedSystemVariable) > 0 || len(bindVarNeeds.NeedUserDefinedVariables) > 0) {
+		return 0, nil
+	}
+
+	ttl := time.Duration(-1)
+	if first := sqlparser.GetFirstSelect(sel); first != nil {
+		directives := sqlparser.ExtractCommentDirectives(first.Comments)
+		if val, ok := directives[sqlparser.DirectiveResultCacheTTL]; ok {
+			ms, ok := val.(int)
+			if !ok || ms <= 0 {
+				return 0, nil
+			}
+			ttl = time.Duration(ms) * time.Millisecond
+		}
+	}
+
+	tableSet := make(map[string]bool)
+	cacheable := true
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.Select:
+			if node.Lock != sqlparser.NoLock || node.SQLCalcFoundRows || node.Into != nil {
+				cacheable = false
+			}
+		case *sqlparser.FuncExpr:
+			if nonDeterministicFuncs[node.Name.Lowered()] {
+				cacheable = false
+			}
+		case *sqlparser.CurTimeFuncExpr:
+			cacheable = false
+		case *sqlparser.AliasedTableExpr:
+			tableName, ok := node.Expr.(sqlparser.TableName)
+			if !ok {
+				return true, nil
+			}
+			if tableName.Qualifier.IsEmpty() && tableName.Name.String() == "dual" {
+				return true, nil
+			}
+			if sqlparser.SystemSchema(tableName.Qualifier.String()) {
+				cacheable = false
+				return true, nil
+			}
+			table, err := vcursor.FindRoutedTable(tableName)
+			if err != nil || table == nil || table.Keyspace == nil {
+				cacheable = false
+				return true, nil
+			}
+			tableSet[table.Keyspace.Name+"."+table.Name.String()] = true
+		}
+		return cacheable, nil
+	}, sel)
+	if !cacheable || len(tableSet) == 0 {
+		return 0, nil
+	}
+
+	tables := make([]string, 0, len(tableSet))
+	optedIn := true
+	for table := range tableSet {
+		tables = append(tables, table)
+		optedIn = optedIn && rc.tables[table]
+	}
+	sort.Strings(tables)
+	if ttl < 0 {
+		if !optedIn {
+			return 0, nil
+		}
+		ttl = rc.defaultTTL
+	}
+	return ttl, tables
+}
+
+// Key returns the key of the results of the query of the plan, executed
+// with the bind variables by the caller of the context.
+func (rc *ResultCache) Key(ctx context.Context, prefix string, plan *engine.Plan, bindVars map[string]*querypb.BindVariable) string {
+	hash := sha256.New()
+	_, _ = hash.Write([]byte(callerid.ImmediateCallerIDFromContext(ctx).GetUsername()))
+	_, _ = hash.Write([]byte{':'})
+	_, _ = hash.Write([]byte(prefix))
+	_, _ = hash.Write([]byte{':'})
+	_, _ = hash.Write(hack.StringBytes(plan.Original))
+	names := make([]string, 0, len(bindVars))
+	for name := range bindVars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		_, _ = fmt.Fprintf(hash, ":%s=%v", name, bindVars[name])
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// Get returns the unexpired results cached for the key.
+func (rc *ResultCache) Get(key string) (*sqltypes.Result, bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	element, ok := rc.entries[key]
+	if !ok {
+		rc.misses++
+		return nil, false
+	}
+	entry := element.Value.(*resultCacheEntry)
+	if !rc.now().Before(entry.expire) {
+		rc.remove(element)
+		rc.misses++
+		return nil, false
+	}
+	rc.lru.MoveToFront(element)
+	entry.hits++
+	rc.hits++
+	return entry.result.Copy(), true
+}
+
+// Begin is called before executing a query whose results may be stored
+// with the key. It makes sure the changes of the tables are watched.
+func (rc *ResultCache) Begin(key string, tables []string) *resultCacheToken {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	token := &resultCacheToken{key: key, generations: make(map[string]uint64, len(tables))}
+	for _, table := range tables {
+		// Registering the generation lets invalidateKeyspace find the table.
+		generation := rc.generations[table]
+		rc.generations[table] = generation
+		token.generations[table] = generation
+	}
+	rc.watch(tables)
+	return token
+}
+
+// Set stores the results of the query of the plan, unless its tables
+// changed since the token was created.
+func (rc *ResultCache) Set(token *resultCacheToken, plan *engine.Plan, result *sqltypes.Result) {
+	size := result.CachedSize(true)
+	if size > rc.maxResultSize || size > rc.maxMemory {
+		return
+	}
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	for table, generation := range token.generations {
+		if rc.generations[table] != generation {
+			return
+		}
+		keyspace, name := splitTableName(table)
+		w := rc.watchers[keyspace]
+		if w == nil || !w.ready || !w.tables[name] {
+			return
+		}
+	}
+
+	if element, ok := rc.entries[token.key]; ok {
+		rc.remove(element)
+	}
+	entry := &resultCacheEntry{
+		key:    token.key,
+		query:  plan.Original,
+		tables: plan.ResultCacheTables,
+		result: result.Copy(),
+		size:   size,
+		expire: rc.now().Add(plan.ResultCacheTTL),
+	}
+	rc.entries[entry.key] = rc.lru.PushFront(entry)
+	rc.size += size
+	for _, table := range entry.tables {
+		keys := rc.byTable[table]
+		if keys == nil {
+			keys = make(map[string]bool)
+			rc.byTable[table] = keys
+		}
+		keys[entry.key] = true
+	}
+	for rc.size > rc.maxMemory {
+		rc.remove(rc.lru.Back())
+		rc.evictions++
+	}
+}
+
+// remove removes the entry of the element. It must be called with the lock held.
+func (rc *ResultCache) remove(element *list.Element) {
+	entry := rc.lru.Remove(element).(*resultCacheEntry)
+	delete(rc.entries, entry.key)
+	rc.size -= entry.size
+	for _, table := range entry.tables {
+		delete(rc.byTable[table], entry.key)
+		if len(rc.byTable[table]) == 0 {
+			delete(rc.byTable, table)
+		}
+	}
+}
+
+// Invalidate drops the results read from the keyspace.table.
+func (rc *ResultCache) Invalidate(table string) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.invalidate(table)
+}
+
+// invalidate drops the results read from the table. It must be called with the lock held.
+func (rc *ResultCache) invalidate(table string) {
+	rc.generations[table]++
+	for key := range rc.byTable[table] {
+		rc.remove(rc.entries[key])
+		rc.invalidations++
+	}
+}
+
+// invalidateKeyspace drops the results read from the tables of the keyspace.
+// It must be called with the lock held.
+func (rc *ResultCache) invalidateKeyspace(keyspace string) {
+	for table := range rc.generations {
+		if ks, _ := splitTableName(table); ks == keyspace {
+			rc.invalidate(table)
+		}
+	}
+}
+
+// Clear drops all the cached results.
+func (rc *ResultCache) Clear() {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	for table := range rc.generations {
+		rc.invalidate(table)
+	}
+}
+
+// Close stops watching the changes of the tables.
+func (rc *ResultCache) Close() {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	for keyspace, w := range rc.watchers {
+		w.cancel()
+		delete(rc.watchers, keyspace)
+	}
+}
+
+// watch makes sure the changes of the tables are streamed. The stream of a
+// keyspace is restarted when a table is added to it. It must be called with
+// the lock held.
+func (rc *ResultCache) watch(tables []string) {
+	added := make(map[string][]string)
+	for _, table := range tables {
+		keyspace, name := splitTableName(table)
+		if w := rc.watchers[keyspace]; w == nil || !w.tables[name] {
+			added[keyspace] = append(added[keyspace], name)
+		}
+	}
+	for keyspace, names := range added {
+		w := &resultCacheWatcher{keyspace: keyspace, tables: make(map[string]bool)}
+		if old := rc.watchers[keyspace]; old != nil {
+			old.cancel()
+			for name := range old.tables {
+				w.tables[name] = true
+			}
+		}
+		for _, name := range names {
+			w.tables[name] = true
+		}
+		rc.watchers[keyspace] = w
+		// The changes of the tables are not watched until the new stream is ready.
+		rc.invalidateKeyspace(keyspace)
+
+		var ctx context.Context
+		ctx, w.cancel = context.WithCancel(context.Background())
+		go rc.stream(ctx, w)
+	}
+}
+
+// stream streams the events of the tables of the watcher until it is cancelled.
+func (rc *ResultCache) stream(ctx context.Context, w *resultCacheWatcher) {
+	filter := &binlogdatapb.Filter{}
+	names := make([]string, 0, len(w.tables))
+	for name := range w.tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		filter.Rules = append(filter.Rules, &binlogdatapb.Rule{Match: name})
+	}
+
+	for {
+		err := rc.streamer(ctx, w.keyspace, filter, func(events []*binlogdatapb.VEvent) error {
+			rc.handleEvents(w, events)
+			return nil
+		})
+		rc.mu.Lock()
+		if rc.watchers[w.keyspace] == w && w.ready {
+			w.ready = false
+			rc.invalidateKeyspace(w.keyspace)
+		}
+		rc.mu.Unlock()
+		if ctx.Err() != nil {
+			return
+		}
+		log.Warningf("result cache: stream of the row events of keyspace %s ended, retrying: %v", w.keyspace, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(resultCacheRetryDelay):
+		}
+	}
+}
+
+// handleEvents invalidates the results of the tables changed by the events.
+func (rc *ResultCache) handleEvents(w *resultCacheWatcher, events []*binlogdatapb.VEvent) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if rc.watchers[w.keyspace] != w {
+		return
+	}
+	w.ready = true
+	for _, event := range events {
+		switch event.Type {
+		case binlogdatapb.VEventType_ROW:
+			rc.invalidate(event.RowEvent.TableName)
+		case binlogdatapb.VEventType_DDL:
+			rc.invalidateKeyspace(w.keyspace)
+		}
+	}
+}
+
+// splitTableName splits a keyspace.table name.
+func splitTableName(table string) (string, string) {
+	if i := strings.IndexByte(table, '.'); i >= 0 {
+		return table[:i], table[i+1:]
+	}
+	return "", table
+}
+
+type resultCacheDebugEntry struct {
+	Query     string
+	Tables    []string
+	Size      int64
+	Hits      int64
+	ExpiresIn string
+}
+
+type resultCacheDebugWatcher struct {
+	Keyspace string
+	Tables   []string
+	Ready    bool
+}
+
+type resultCacheDebug struct {
+	Capacity      int64
+	Size          int64
+	Length        int
+	Hits          int64
+	Misses        int64
+	Evictions     int64
+	Invalidations int64
+	Watchers      []resultCacheDebugWatcher
+	Entries       []resultCacheDebugEntry
+}
+
+func (rc *ResultCache) debug() *resultCacheDebug {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	now := rc.now()
+	d := &resultCacheDebug{
+		Capacity:      rc.maxMemory,
+		Size:          rc.size,
+		Length:        rc.lru.Len(),
+		Hits:          rc.hits,
+		Misses:        rc.misses,
+		Evictions:     rc.evictions,
+		Invalidations: rc.invalidations,
+	}
+	for _, w := range rc.watchers {
+		dw := resultCacheDebugWatcher{Keyspace: w.keyspace, Ready: w.ready}
+		for name := range w.tables {
+			dw.Tables = append(dw.Tables, name)
+		}
+		sort.Strings(dw.Tables)
+		d.Watchers = append(d.Watchers, dw)
+	}
+	sort.Slice(d.Watchers, func(i, j int) bool {
+		return d.Watchers[i].Keyspace < d.Watchers[j].Keyspace
+	})
+	for element := rc.lru.Front(); element != nil; element = element.Next() {
+		entry := element.Value.(*resultCacheEntry)
+		d.Entries = append(d.Entries, resultCacheDebugEntry{
+			Query:     entry.query,
+			Tables:    entry.tables,
+			Size:      entry.size,
+			Hits:      entry.hits,
+			ExpiresIn: entry.expire.Sub(now).String(),
+		})
+	}
+	return d
+}
+
+// ServeHTTP shows the state of the cache. The cache is cleared by a POST
+// or with the clear parameter.
+func (rc *ResultCache) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	if err := acl.CheckAccessHTTP(request, acl.DEBUGGING); err != nil {
+		acl.SendError(response, err)
+		return
+	}
+	if request.Method == http.MethodPost || request.URL.Query().Has("clear") {
+		rc.Clear()
+	}
+	returnAsJSON(response, rc.debug())
+}
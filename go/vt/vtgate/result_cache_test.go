@@ -0,0 +1,222 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+// fakeResultCacheStreams records the streams of the result cache, and
+// sends them events on demand.
+type fakeResultCacheStreams struct {
+	mu      sync.Mutex
+	sends   map[string]func([]*binlogdatapb.VEvent) error
+	filters map[string]*binlogdatapb.Filter
+}
+
+func newFakeResultCacheStreams() *fakeResultCacheStreams {
+	return &fakeResultCacheStreams{
+		sends:   make(map[string]func([]*binlogdatapb.VEvent) error),
+		filters: make(map[string]*binlogdatapb.Filter),
+	}
+}
+
+func (f *fakeResultCacheStreams) stream(ctx context.Context, keyspace string, filter *binlogdatapb.Filter, send func([]*binlogdatapb.VEvent) error) error {
+	f.mu.Lock()
+	f.sends[keyspace] = send
+	f.filters[keyspace] = filter
+	f.mu.Unlock()
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+// send waits for the stream of the keyspace with the filter of the tables,
+// and sends it the events.
+func (f *fakeResultCacheStreams) send(t *testing.T, keyspace string, tables []string, events ...*binlogdatapb.VEvent) {
+	t.Helper()
+	var send func([]*binlogdatapb.VEvent) error
+	require.Eventually(t, func() bool {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		filter := f.filters[keyspace]
+		if filter == nil || len(filter.Rules) != len(tables) {
+			return false
+		}
+		for i, rule := range filter.Rules {
+			if rule.Match != tables[i] {
+				return false
+			}
+		}
+		send = f.sends[keyspace]
+		return true
+	}, 5*time.Second, time.Millisecond)
+	require.NoError(t, send(events))
+}
+
+var heartbeatEvent = &binlogdatapb.VEvent{Type: binlogdatapb.VEventType_HEARTBEAT}
+
+func rowEvent(table string) *binlogdatapb.VEvent {
+	return &binlogdatapb.VEvent{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{TableName: table}}
+}
+
+func TestResultCache(t *testing.T) {
+	streams := newFakeResultCacheStreams()
+	rc := NewResultCache(1000, 500, time.Second, nil, streams.stream)
+	defer rc.Close()
+	now := time.Now()
+	rc.now = func() time.Time { return now }
+
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1", "2")
+	plan := &engine.Plan{Original: "select id from t", ResultCacheTTL: time.Second, ResultCacheTables: []string{"ks.t"}}
+
+	// Nothing is stored until the changes of the tables are streamed.
+	token := rc.Begin("a", plan.ResultCacheTables)
+	rc.Set(token, plan, result)
+	_, ok := rc.Get("a")
+	assert.False(t, ok)
+	streams.send(t, "ks", []string{"t"}, heartbeatEvent)
+
+	token = rc.Begin("a", plan.ResultCacheTables)
+	rc.Set(token, plan, result)
+	cached, ok := rc.Get("a")
+	require.True(t, ok)
+	assert.Equal(t, result.Rows, cached.Rows)
+
+	// The results read while the table changed are not stored.
+	token = rc.Begin("b", plan.ResultCacheTables)
+	streams.send(t, "ks", []string{"t"}, rowEvent("ks.t"))
+	rc.Set(token, plan, result)
+	_, ok = rc.Get("a")
+	assert.False(t, ok, "a is invalidated")
+	_, ok = rc.Get("b")
+	assert.False(t, ok, "b read a changing table")
+
+	// The results expire after their TTL.
+	token = rc.Begin("a", plan.ResultCacheTables)
+	rc.Set(token, plan, result)
+	now = now.Add(time.Second)
+	_, ok = rc.Get("a")
+	assert.False(t, ok)
+
+	// The least recently used results are evicted.
+	size := result.CachedSize(true)
+	for _, key := range []string{"a", "b", "c"} {
+		token = rc.Begin(key, plan.ResultCacheTables)
+		rc.Set(token, plan, result)
+	}
+	assert.LessOrEqual(t, rc.size, rc.maxMemory)
+	assert.EqualValues(t, rc.maxMemory/size, rc.lru.Len())
+	_, ok = rc.Get("c")
+	assert.True(t, ok)
+
+	// A new table of the keyspace restarts its stream.
+	other := &engine.Plan{Original: "select id from u", ResultCacheTTL: time.Second, ResultCacheTables: []string{"ks.u"}}
+	token = rc.Begin("d", other.ResultCacheTables)
+	rc.Set(token, other, result)
+	_, ok = rc.Get("d")
+	assert.False(t, ok)
+	_, ok = rc.Get("c")
+	assert.False(t, ok, "c is dropped with the stream of its keyspace")
+	streams.send(t, "ks", []string{"t", "u"}, heartbeatEvent)
+	token = rc.Begin("d", other.ResultCacheTables)
+	rc.Set(token, other, result)
+	_, ok = rc.Get("d")
+	assert.True(t, ok)
+
+	// Too big results are not stored.
+	big := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1", "2", "3", "4", "5", "6", "7", "8")
+	token = rc.Begin("e", other.ResultCacheTables)
+	rc.Set(token, other, big)
+	_, ok = rc.Get("e")
+	assert.False(t, ok)
+}
+
+func TestExecutorResultCache(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	streams := newFakeResultCacheStreams()
+	executor.resultCache = NewResultCache(1024*1024, 1024*1024, time.Minute, []string{"TestExecutor.user"}, streams.stream)
+	defer executor.resultCache.Close()
+	session := &vtgatepb.Session{TargetString: "@primary", Autocommit: true}
+	exec := func(query string) (*sqltypes.Result, error) {
+		return executorExecSession(executor, query, nil, session)
+	}
+
+	query := "select id from user where id = 1"
+	_, err := exec(query)
+	require.NoError(t, err)
+	streams.send(t, "TestExecutor", []string{"user"}, heartbeatEvent)
+	_, err = exec(query)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, sbc1.ExecCount.Get())
+
+	// The next executions read the cache.
+	for i := 0; i < 3; i++ {
+		result, err := exec(query)
+		require.NoError(t, err)
+		assert.Equal(t, sandboxconn.SingleRowResult.Rows, result.Rows)
+	}
+	assert.EqualValues(t, 2, sbc1.ExecCount.Get())
+
+	// A change of the table invalidates the results.
+	streams.send(t, "TestExecutor", []string{"user"}, rowEvent("TestExecutor.user"))
+	_, err = exec(query)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, sbc1.ExecCount.Get())
+
+	// Transactions read the tablets.
+	_, err = executorExec(executor, query, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 4, sbc1.ExecCount.Get())
+
+	// The tables not opted in are only cached with a comment directive,
+	// and the non-deterministic queries are not cached.
+	sbc1.ExecCount.Set(0)
+	for _, query := range []string{
+		"select id from music where user_id = 1",
+		"select /*vt+ RESULT_CACHE_TTL_MS=0 */ id from music where user_id = 1",
+		"select id, now() from user where id = 1",
+	} {
+		for i := 0; i < 2; i++ {
+			_, err = exec(query)
+			require.NoError(t, err)
+		}
+	}
+	assert.EqualValues(t, 6, sbc1.ExecCount.Get())
+
+	query = "select /*vt+ RESULT_CACHE_TTL_MS=60000 */ id from music where user_id = 1"
+	_, err = exec(query)
+	require.NoError(t, err)
+	streams.send(t, "TestExecutor", []string{"music", "user"}, heartbeatEvent)
+	for i := 0; i < 3; i++ {
+		_, err = exec(query)
+		require.NoError(t, err)
+	}
+	assert.EqualValues(t, 8, sbc1.ExecCount.Get())
+}
@@ -370,6 +370,37 @@ func (session *SafeSession) SetUserDefinedVariable(key string, value *querypb.Bi
 	session.UserDefinedVariables[key] = value
 }
 
+// GetUDV returns the value of the user defined variable, or nil if it is not set.
+func (session *SafeSession) GetUDV(name string) *querypb.BindVariable {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.UserDefinedVariables[name]
+}
+
+// StorePrepareData stores the prepared statement with the given name in the session.
+func (session *SafeSession) StorePrepareData(name string, data *vtgatepb.PrepareData) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if session.PrepareStatement == nil {
+		session.PrepareStatement = make(map[string]*vtgatepb.PrepareData)
+	}
+	session.PrepareStatement[name] = data
+}
+
+// GetPrepareData returns the prepared statement with the given name, or nil if there is none.
+func (session *SafeSession) GetPrepareData(name string) *vtgatepb.PrepareData {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.PrepareStatement[name]
+}
+
+// ClearPrepareData removes the prepared statement with the given name from the session.
+func (session *SafeSession) ClearPrepareData(name string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	delete(session.PrepareStatement, name)
+}
+
 // SetTargetString sets the target string in the session.
 func (session *SafeSession) SetTargetString(target string) {
 	session.mu.Lock()
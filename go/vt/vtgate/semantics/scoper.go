@@ -91,6 +91,12 @@ func (s *scoper) down(cursor *sqlparser.Cursor) error {
 		}
 		wScope.tables = []TableInfo{createVTableInfoForExpressions(node, s.currentScope().tables, s.org)}
 	case sqlparser.OrderBy:
+		if _, isWindowSpec := cursor.Parent().(*sqlparser.WindowSpecification); isWindowSpec {
+			// the ORDER BY of a window can only see the tables in the FROM clause,
+			// so it gets the same scope as the rest of the window specification
+			s.push(s.currentScope())
+			break
+		}
 		err := s.createSpecialScopePostProjection(cursor.Parent())
 		if err != nil {
 			return err
@@ -67,6 +67,13 @@ func (t *typer) up(cursor *sqlparser.Cursor) error {
 				t.exprTypes[node] = Type{Type: typ}
 			}
 		}
+	case *sqlparser.WindowFuncExpr:
+		switch node.Type {
+		case sqlparser.RowNumberType, sqlparser.RankType, sqlparser.DenseRankType, sqlparser.NtileType:
+			t.exprTypes[node] = Type{Type: sqltypes.Int64}
+		case sqlparser.PercentRankType, sqlparser.CumeDistType:
+			t.exprTypes[node] = floatval
+		}
 	}
 	return nil
 }
@@ -19,6 +19,7 @@ package vtgate
 import (
 	"context"
 	"fmt"
+	"io"
 	"sort"
 	"strings"
 	"sync/atomic"
@@ -51,6 +52,7 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/buffer"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder"
 	"vitess.io/vitess/go/vt/vtgate/semantics"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
@@ -72,6 +74,9 @@ type iExecute interface {
 	ExecuteMessageStream(ctx context.Context, rss []*srvtopo.ResolvedShard, name string, callback func(*sqltypes.Result) error) error
 	ExecuteVStream(ctx context.Context, rss []*srvtopo.ResolvedShard, filter *binlogdatapb.Filter, gtid string, callback func(evs []*binlogdatapb.VEvent) error) error
 
+	// planPrepareStmt plans a statement prepared with PREPARE
+	planPrepareStmt(vcursor *vcursorImpl, query string, bindVars map[string]*querypb.BindVariable) (*engine.Plan, error)
+
 	// TODO: remove when resolver is gone
 	ParseDestinationTarget(targetString string) (string, topodatapb.TabletType, key.Destination, error)
 	VSchema() *vindexes.VSchema
@@ -182,6 +187,37 @@ func (vc *vcursorImpl) ConnCollation() collations.ID {
 	return vc.collation
 }
 
+// TimeZone returns the time zone of this session, as set by the time_zone system variable.
+// If it has not been set, it returns nil: like for the SYSTEM time zone, temporal
+// expressions are then evaluated in the time zone of the MySQL servers, as configured
+// with -mysql_system_time_zone.
+func (vc *vcursorImpl) TimeZone() *time.Location {
+	var tz string
+	vc.safeSession.GetSystemVariables(func(k, v string) {
+		if k == "time_zone" {
+			tz = v
+		}
+	})
+	if tz == "" {
+		return nil
+	}
+
+	// system variables are stored as SQL expressions, e.g. '+08:00'
+	expr, err := sqlparser.ParseExpr(tz)
+	if err != nil {
+		return nil
+	}
+	lit, ok := expr.(*sqlparser.Literal)
+	if !ok {
+		return nil
+	}
+	loc, err := evalengine.ParseTimeZone(lit.Val)
+	if err != nil {
+		return nil
+	}
+	return loc
+}
+
 // Context returns the current Context.
 func (vc *vcursorImpl) Context() context.Context {
 	return vc.ctx
@@ -769,6 +805,38 @@ func (vc *vcursorImpl) GetWarnings() []*querypb.QueryWarning {
 	return vc.safeSession.GetWarnings()
 }
 
+// StorePrepareData implements the SessionActions interface
+func (vc *vcursorImpl) StorePrepareData(name string, data *vtgatepb.PrepareData) {
+	vc.safeSession.StorePrepareData(name, data)
+}
+
+// GetPrepareData implements the SessionActions and VSchema interfaces
+func (vc *vcursorImpl) GetPrepareData(name string) *vtgatepb.PrepareData {
+	return vc.safeSession.GetPrepareData(name)
+}
+
+// ClearPrepareData implements the SessionActions interface
+func (vc *vcursorImpl) ClearPrepareData(name string) {
+	vc.safeSession.ClearPrepareData(name)
+}
+
+// GetUDV implements the VSchema interface
+func (vc *vcursorImpl) GetUDV(name string) *querypb.BindVariable {
+	return vc.safeSession.GetUDV(name)
+}
+
+// PlanPrepareStatement implements the VSchema interface
+func (vc *vcursorImpl) PlanPrepareStatement(query string) (*engine.Plan, map[string]*querypb.BindVariable, error) {
+	bindVars := make(map[string]*querypb.BindVariable)
+	plan, err := vc.executor.planPrepareStmt(vc, query, bindVars)
+	if err != nil {
+		return nil, nil, err
+	}
+	// the warnings of the prepared statement are yielded by the statement using it
+	vc.warnings = append(vc.warnings, plan.Warnings...)
+	return plan, bindVars, nil
+}
+
 // GetDBDDLPluginName implements the VCursor interface
 func (vc *vcursorImpl) GetDBDDLPluginName() string {
 	return *dbDDLPlugin
@@ -902,3 +970,28 @@ func (vc *vcursorImpl) MessageStream(rss []*srvtopo.ResolvedShard, tableName str
 func (vc *vcursorImpl) VStream(rss []*srvtopo.ResolvedShard, filter *binlogdatapb.Filter, gtid string, callback func(evs []*binlogdatapb.VEvent) error) error {
 	return vc.executor.ExecuteVStream(vc.ctx, rss, filter, gtid, callback)
 }
+
+// localInfileRequester is implemented by the client connections
+// that can send files for LOAD DATA LOCAL INFILE.
+type localInfileRequester interface {
+	RequestLocalInfile(fileName string) (io.ReadCloser, error)
+}
+
+type localInfileKeyType int
+
+var localInfileKey localInfileKeyType
+
+// withLocalInfile returns a context through which LOAD DATA LOCAL INFILE
+// can ask the client for the content of a file.
+func withLocalInfile(ctx context.Context, requester localInfileRequester) context.Context {
+	return context.WithValue(ctx, localInfileKey, requester)
+}
+
+// LocalInfile implements the VCursor interface
+func (vc *vcursorImpl) LocalInfile(fileName string) (io.ReadCloser, error) {
+	requester, ok := vc.ctx.Value(localInfileKey).(localInfileRequester)
+	if !ok {
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "LOAD DATA LOCAL INFILE is only supported through the MySQL protocol")
+	}
+	return requester.RequestLocalInfile(fileName)
+}
@@ -150,6 +150,36 @@ func (cached *ConsistentLookupUnique) CachedSize(alloc bool) int64 {
 	size += cached.clCommon.CachedSize(true)
 	return size
 }
+func (cached *ForeignKey) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(80)
+	}
+	// field Table *vitess.io/vitess/go/vt/vtgate/vindexes.Table
+	size += cached.Table.CachedSize(true)
+	// field Columns []vitess.io/vitess/go/vt/sqlparser.ColIdent
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.Columns)) * int64(40))
+		for _, elem := range cached.Columns {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field ParentTable *vitess.io/vitess/go/vt/vtgate/vindexes.Table
+	size += cached.ParentTable.CachedSize(true)
+	// field ParentColumns []vitess.io/vitess/go/vt/sqlparser.ColIdent
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.ParentColumns)) * int64(40))
+		for _, elem := range cached.ParentColumns {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field OnDelete string
+	size += hack.RuntimeAllocSize(int64(len(cached.OnDelete)))
+	return size
+}
 func (cached *Hash) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -406,7 +436,7 @@ func (cached *Table) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(176)
+		size += int64(256)
 	}
 	// field Type string
 	size += hack.RuntimeAllocSize(int64(len(cached.Type)))
@@ -448,6 +478,32 @@ func (cached *Table) CachedSize(alloc bool) int64 {
 	{
 		size += hack.RuntimeAllocSize(int64(cap(cached.Pinned)))
 	}
+	// field ForeignKeys []*vitess.io/vitess/go/vt/vtgate/vindexes.ForeignKey
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.ForeignKeys)) * int64(8))
+		for _, elem := range cached.ForeignKeys {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field ChildForeignKeys []*vitess.io/vitess/go/vt/vtgate/vindexes.ForeignKey
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.ChildForeignKeys)) * int64(8))
+		for _, elem := range cached.ChildForeignKeys {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field UniqueKeys [][]vitess.io/vitess/go/vt/sqlparser.ColIdent
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.UniqueKeys)) * int64(24))
+		for _, elem := range cached.UniqueKeys {
+			{
+				size += hack.RuntimeAllocSize(int64(cap(elem)) * int64(40))
+				for _, elem := range elem {
+					size += elem.CachedSize(false)
+				}
+			}
+		}
+	}
 	return size
 }
 func (cached *UnicodeLooseMD5) CachedSize(alloc bool) int64 {
@@ -31,6 +31,7 @@ import (
 	"vitess.io/vitess/go/json2"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/queryrules"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -63,6 +64,7 @@ type VSchema struct {
 	uniqueTables   map[string]*Table
 	uniqueVindexes map[string]Vindex
 	Keyspaces      map[string]*KeyspaceSchema `json:"keyspaces"`
+	QueryRules     *queryrules.Rules          `json:"query_rules,omitempty"`
 }
 
 // RoutingRule represents one routing rule.
@@ -96,6 +98,48 @@ type Table struct {
 	Columns                 []Column             `json:"columns,omitempty"`
 	Pinned                  []byte               `json:"pinned,omitempty"`
 	ColumnListAuthoritative bool                 `json:"column_list_authoritative,omitempty"`
+	// ForeignKeys are the foreign keys of the table, ChildForeignKeys
+	// are the foreign keys of the tables referencing it.
+	ForeignKeys      []*ForeignKey `json:"foreign_keys,omitempty"`
+	ChildForeignKeys []*ForeignKey `json:"-"`
+	// UniqueKeys are the primary key and the unique keys of the table.
+	UniqueKeys [][]sqlparser.ColIdent `json:"unique_keys,omitempty"`
+}
+
+// The actions taken on the child rows when their parent row is deleted.
+const (
+	FkRestrict = "restrict"
+	FkCascade  = "cascade"
+	FkSetNull  = "set_null"
+)
+
+// ForeignKey is a foreign key enforced by vtgate: the Columns of
+// Table reference the ParentColumns of ParentTable.
+type ForeignKey struct {
+	Table         *Table
+	Columns       []sqlparser.ColIdent
+	ParentTable   *Table
+	ParentColumns []sqlparser.ColIdent
+	OnDelete      string
+}
+
+// MarshalJSON returns a JSON representation of ForeignKey.
+func (fk *ForeignKey) MarshalJSON() ([]byte, error) {
+	parent := ""
+	if fk.ParentTable != nil {
+		parent = fk.ParentTable.Keyspace.Name + "." + fk.ParentTable.Name.String()
+	}
+	return json.Marshal(struct {
+		Columns       []sqlparser.ColIdent `json:"columns"`
+		ParentTable   string               `json:"parent_table"`
+		ParentColumns []sqlparser.ColIdent `json:"parent_columns"`
+		OnDelete      string               `json:"on_delete"`
+	}{
+		Columns:       fk.Columns,
+		ParentTable:   parent,
+		ParentColumns: fk.ParentColumns,
+		OnDelete:      fk.OnDelete,
+	})
 }
 
 // Keyspace contains the keyspcae info for each Table.
@@ -196,8 +240,10 @@ func BuildVSchema(source *vschemapb.SrvVSchema) (vschema *VSchema) {
 	}
 	buildKeyspaces(source, vschema)
 	resolveAutoIncrement(source, vschema)
+	resolveForeignKeys(source, vschema)
 	addDual(vschema)
 	buildRoutingRule(source, vschema)
+	buildQueryRules(source, vschema)
 	return vschema
 }
 
@@ -304,6 +350,40 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 			t.Columns = append(t.Columns, Column{Name: name, Type: col.Type})
 		}
 
+		// Initialize ForeignKeys. The parent tables are resolved
+		// once all the keyspaces are built.
+		for _, fk := range table.ForeignKeys {
+			if len(fk.Columns) == 0 || len(fk.Columns) != len(fk.ParentColumns) {
+				return fmt.Errorf("foreign key of table %s must have as many columns as parent columns", tname)
+			}
+			foreignKey := &ForeignKey{Table: t}
+			switch fk.OnDelete {
+			case "", FkRestrict:
+				foreignKey.OnDelete = FkRestrict
+			case FkCascade, FkSetNull:
+				foreignKey.OnDelete = fk.OnDelete
+			default:
+				return fmt.Errorf("unidentified on_delete action %s for foreign key of table %s", fk.OnDelete, tname)
+			}
+			for i := range fk.Columns {
+				foreignKey.Columns = append(foreignKey.Columns, sqlparser.NewColIdent(fk.Columns[i]))
+				foreignKey.ParentColumns = append(foreignKey.ParentColumns, sqlparser.NewColIdent(fk.ParentColumns[i]))
+			}
+			t.ForeignKeys = append(t.ForeignKeys, foreignKey)
+		}
+
+		// Initialize UniqueKeys.
+		for _, uk := range table.UniqueKeys {
+			if len(uk.Columns) == 0 {
+				return fmt.Errorf("unique key of table %s must have columns", tname)
+			}
+			var uniqueKey []sqlparser.ColIdent
+			for _, col := range uk.Columns {
+				uniqueKey = append(uniqueKey, sqlparser.NewColIdent(col))
+			}
+			t.UniqueKeys = append(t.UniqueKeys, uniqueKey)
+		}
+
 		// Initialize ColumnVindexes.
 		for i, ind := range table.ColumnVindexes {
 			vindexInfo, ok := ks.Vindexes[ind.Name]
@@ -429,6 +509,49 @@ func resolveAutoIncrement(source *vschemapb.SrvVSchema, vschema *VSchema) {
 	}
 }
 
+func resolveForeignKeys(source *vschemapb.SrvVSchema, vschema *VSchema) {
+	for ksname, ks := range source.Keyspaces {
+		ksvschema := vschema.Keyspaces[ksname]
+		for tname, table := range ks.Tables {
+			t := ksvschema.Tables[tname]
+			if t == nil {
+				continue
+			}
+			for i, fk := range table.ForeignKeys {
+				parentks, parenttab, err := sqlparser.ParseTable(fk.ParentTable)
+				var parent *Table
+				if err == nil {
+					if parentks == "" {
+						parentks = ksname
+					}
+					parent, err = vschema.FindTable(parentks, parenttab)
+				}
+				if err != nil {
+					// Better to remove the table than to leave it partially initialized.
+					delete(ksvschema.Tables, tname)
+					delete(vschema.uniqueTables, tname)
+					ksvschema.Error = fmt.Errorf("cannot resolve parent table %s of foreign key: %v", fk.ParentTable, err)
+					break
+				}
+				t.ForeignKeys[i].ParentTable = parent
+				parent.ChildForeignKeys = append(parent.ChildForeignKeys, t.ForeignKeys[i])
+			}
+		}
+	}
+	// Keep the order of the child foreign keys stable across builds.
+	for _, ksvschema := range vschema.Keyspaces {
+		for _, t := range ksvschema.Tables {
+			sort.SliceStable(t.ChildForeignKeys, func(i, j int) bool {
+				a, b := t.ChildForeignKeys[i].Table, t.ChildForeignKeys[j].Table
+				if a.Keyspace.Name != b.Keyspace.Name {
+					return a.Keyspace.Name < b.Keyspace.Name
+				}
+				return a.Name.String() < b.Name.String()
+			})
+		}
+	}
+}
+
 // addDual adds dual as a valid table to all keyspaces.
 // For sharded keyspaces, it gets pinned against keyspace id '0x00'.
 func addDual(vschema *VSchema) {
@@ -468,6 +591,15 @@ func escapeQualifiedTable(qualifiedTableName string) (string, error) {
 	return "", fmt.Errorf("invalid table name: %s, it must be of the qualified form <keyspace_name>.<table_name> (dots are not allowed in either name)", qualifiedTableName)
 }
 
+// buildQueryRules compiles the query rules. The rules in error are kept
+// in the vschema to be reported, and are never applied.
+func buildQueryRules(source *vschemapb.SrvVSchema, vschema *VSchema) {
+	if len(source.GetQueryRules().GetRules()) == 0 {
+		return
+	}
+	vschema.QueryRules, _ = queryrules.Build(source.QueryRules)
+}
+
 func buildRoutingRule(source *vschemapb.SrvVSchema, vschema *VSchema) {
 	var err error
 	if source.RoutingRules == nil {
@@ -34,6 +34,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/queryrules"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -837,6 +838,39 @@ func TestVSchemaRoutingRules(t *testing.T) {
 	assert.Equal(t, string(wantb), string(gotb), string(gotb))
 }
 
+func TestVSchemaQueryRules(t *testing.T) {
+	input := vschemapb.SrvVSchema{
+		QueryRules: &vschemapb.QueryRules{
+			Rules: []*vschemapb.QueryRule{{
+				Name:   "block",
+				Tables: []string{"ks.t1"},
+				Block:  true,
+			}, {
+				Name:   "invalid",
+				Tables: []string{"t2"},
+			}},
+		},
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ks": {},
+		},
+	}
+	got := BuildVSchema(&input)
+	require.Equal(t, 2, got.QueryRules.Len())
+
+	stmt, err := sqlparser.Parse("select * from t1")
+	require.NoError(t, err)
+	rule := got.QueryRules.Match(&queryrules.Query{Statement: stmt, Keyspace: "ks"})
+	require.NotNil(t, rule)
+	assert.Equal(t, "block", rule.Name)
+
+	out, err := json.Marshal(got.QueryRules)
+	require.NoError(t, err)
+	assert.Equal(t, `[{"name":"block","tables":["ks.t1"],"block":true},"query rule invalid: no action"]`, string(out))
+
+	// No rules, nothing to match.
+	assert.Nil(t, BuildVSchema(&vschemapb.SrvVSchema{}).QueryRules)
+}
+
 func TestChooseVindexForType(t *testing.T) {
 	testcases := []struct {
 		in  querypb.Type
@@ -2164,6 +2198,147 @@ func TestBadShardedSequence(t *testing.T) {
 	}
 }
 
+func TestForeignKeys(t *testing.T) {
+	good := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"unsharded": {
+				Tables: map[string]*vschemapb.Table{
+					"country": {},
+				},
+			},
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"stfu1": {
+						Type: "stfu",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"parent": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "stfu1"}},
+						ForeignKeys: []*vschemapb.ForeignKey{{
+							Columns:       []string{"country_id"},
+							ParentTable:   "unsharded.country",
+							ParentColumns: []string{"id"},
+						}},
+					},
+					"child": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "stfu1"}},
+						ForeignKeys: []*vschemapb.ForeignKey{{
+							Columns:       []string{"parent_id"},
+							ParentTable:   "parent",
+							ParentColumns: []string{"id"},
+							OnDelete:      "cascade",
+						}},
+					},
+				},
+			},
+		},
+	}
+	got := BuildVSchema(&good)
+	require.NoError(t, got.Keyspaces["sharded"].Error)
+	country := got.Keyspaces["unsharded"].Tables["country"]
+	parent := got.Keyspaces["sharded"].Tables["parent"]
+	child := got.Keyspaces["sharded"].Tables["child"]
+
+	require.Len(t, parent.ForeignKeys, 1)
+	assert.Equal(t, country, parent.ForeignKeys[0].ParentTable)
+	assert.Equal(t, FkRestrict, parent.ForeignKeys[0].OnDelete)
+	assert.Equal(t, []*ForeignKey{parent.ForeignKeys[0]}, country.ChildForeignKeys)
+
+	require.Len(t, child.ForeignKeys, 1)
+	assert.Equal(t, parent, child.ForeignKeys[0].ParentTable)
+	assert.Equal(t, child, child.ForeignKeys[0].Table)
+	assert.Equal(t, FkCascade, child.ForeignKeys[0].OnDelete)
+	assert.Equal(t, []*ForeignKey{child.ForeignKeys[0]}, parent.ChildForeignKeys)
+
+	out, err := json.Marshal(child.ForeignKeys[0])
+	require.NoError(t, err)
+	assert.Equal(t, `{"columns":["parent_id"],"parent_table":"sharded.parent","parent_columns":["id"],"on_delete":"cascade"}`, string(out))
+}
+
+func TestBadForeignKeys(t *testing.T) {
+	testcases := []struct {
+		fk  *vschemapb.ForeignKey
+		err string
+	}{{
+		fk:  &vschemapb.ForeignKey{Columns: []string{"c1", "c2"}, ParentTable: "t1", ParentColumns: []string{"c1"}},
+		err: "foreign key of table t2 must have as many columns as parent columns",
+	}, {
+		fk:  &vschemapb.ForeignKey{ParentTable: "t1"},
+		err: "foreign key of table t2 must have as many columns as parent columns",
+	}, {
+		fk:  &vschemapb.ForeignKey{Columns: []string{"c1"}, ParentTable: "t1", ParentColumns: []string{"c1"}, OnDelete: "no_action"},
+		err: "unidentified on_delete action no_action for foreign key of table t2",
+	}, {
+		fk:  &vschemapb.ForeignKey{Columns: []string{"c1"}, ParentTable: "t3", ParentColumns: []string{"c1"}},
+		err: "cannot resolve parent table t3 of foreign key: table t3 not found",
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.err, func(t *testing.T) {
+			bad := vschemapb.SrvVSchema{
+				Keyspaces: map[string]*vschemapb.Keyspace{
+					"sharded": {
+						Sharded: true,
+						Vindexes: map[string]*vschemapb.Vindex{
+							"stfu1": {
+								Type: "stfu",
+							},
+						},
+						Tables: map[string]*vschemapb.Table{
+							"t1": {
+								ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "c1", Name: "stfu1"}},
+							},
+							"t2": {
+								ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "c1", Name: "stfu1"}},
+								ForeignKeys:    []*vschemapb.ForeignKey{tc.fk},
+							},
+						},
+					},
+				},
+			}
+			got := BuildVSchema(&bad)
+			err := got.Keyspaces["sharded"].Error
+			require.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestUniqueKeys(t *testing.T) {
+	input := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"stfu1": {
+						Type: "stfu",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "c1", Name: "stfu1"}},
+						UniqueKeys: []*vschemapb.UniqueKey{
+							{Columns: []string{"id"}},
+							{Columns: []string{"c2", "C3"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	got := BuildVSchema(&input)
+	require.NoError(t, got.Keyspaces["sharded"].Error)
+	want := [][]sqlparser.ColIdent{
+		{sqlparser.NewColIdent("id")},
+		{sqlparser.NewColIdent("c2"), sqlparser.NewColIdent("C3")},
+	}
+	assert.Equal(t, want, got.Keyspaces["sharded"].Tables["t1"].UniqueKeys)
+
+	input.Keyspaces["sharded"].Tables["t1"].UniqueKeys = []*vschemapb.UniqueKey{{}}
+	got = BuildVSchema(&input)
+	require.EqualError(t, got.Keyspaces["sharded"].Error, "unique key of table t1 must have columns")
+}
+
 func TestFindTable(t *testing.T) {
 	input := vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
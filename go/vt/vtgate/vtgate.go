@@ -44,6 +44,7 @@ import (
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vtgate/vtgateservice"
 
 	vtschema "vitess.io/vitess/go/vt/vtgate/schema"
@@ -69,6 +70,7 @@ var (
 	defaultDDLStrategy   = flag.String("ddl_strategy", string(schema.DDLStrategyDirect), "Set default strategy for DDL statements. Override with @@ddl_strategy session variable")
 	dbDDLPlugin          = flag.String("dbddl_plugin", "fail", "controls how to handle CREATE/DROP DATABASE. use it if you are using your own database provisioning service")
 	noScatter            = flag.Bool("no_scatter", false, "when set to true, the planner will fail instead of producing a plan that includes scatter queries")
+	mysqlSystemTimeZone  = flag.String("mysql_system_time_zone", "", "The time zone of the MySQL servers, e.g. UTC, +08:00 or Europe/Madrid, used to evaluate temporal expressions in vtgate for the sessions whose time_zone is SYSTEM. Defaults to the local time zone of vtgate.")
 
 	// TODO(deepthi): change these two vars to unexported and move to healthcheck.go when LegacyHealthcheck is removed
 
@@ -94,6 +96,13 @@ var (
 	enableOnlineDDL = flag.Bool("enable_online_ddl", true, "Allow users to submit, review and control Online DDL")
 	enableDirectDDL = flag.Bool("enable_direct_ddl", true, "Allow users to submit direct DDL statements")
 
+	resultCacheMemory        = flag.Int64("result_cache_memory", 0, "The maximum number of bytes of query results cached by vtgate. Results are only cached for the tables of -result_cache_tables, or for the selects with a RESULT_CACHE_TTL_MS comment directive. 0 disables the result cache.")
+	resultCacheMaxResultSize = flag.Int64("result_cache_max_result_size", 1024*1024, "The maximum size in bytes of a query result cached by vtgate.")
+	resultCacheTTL           = flag.Duration("result_cache_ttl", 10*time.Second, "How long vtgate caches the results of the queries reading the tables of -result_cache_tables.")
+	resultCacheTables        = flag.String("result_cache_tables", "", "Comma separated list of keyspace.table names whose query results are cached by vtgate.")
+
+	workloadManagerConfig = flag.String("workload_manager_config", "", "Path to the JSON configuration of the resource groups of the workload manager, which limits the number of concurrent queries per user, workload or query fingerprint and queues the others.")
+
 	enableSchemaChangeSignal = flag.Bool("schema_change_signal", false, "Enable the schema tracker; requires queryserver-config-schema-change-signal to be enabled on the underlying vttablets for this to work")
 	schemaChangeUser         = flag.String("schema_change_signal_user", "", "User to be used to send down query to vttablet to retrieve schema changes")
 )
@@ -196,12 +205,23 @@ func Init(ctx context.Context, hc discovery.HealthCheck, serv srvtopo.Server, ce
 	if _, err := schema.ParseDDLStrategy(*defaultDDLStrategy); err != nil {
 		log.Fatalf("Invalid value for -ddl_strategy: %v", err.Error())
 	}
+	if *mysqlSystemTimeZone != "" {
+		if err := evalengine.SetSystemTimeZone(*mysqlSystemTimeZone); err != nil {
+			log.Fatalf("Invalid value for -mysql_system_time_zone: %v", err.Error())
+		}
+	}
 	tc := NewTxConn(gw, getTxMode())
 	// ScatterConn depends on TxConn to perform forced rollbacks.
 	sc := NewScatterConn("VttabletCall", tc, gw)
 	srvResolver := srvtopo.NewResolver(serv, gw, cell)
 	resolver := NewResolver(srvResolver, serv, cell, sc)
 	vsm := newVStreamManager(srvResolver, serv, cell)
+	if ts, err := serv.GetTopoServer(); err == nil && ts != nil {
+		binlogDumpGTIDs = newPseudoGTIDs(ts)
+		go binlogDumpGTIDs.expireLoop(ctx)
+	} else {
+		log.Warningf("The pseudo-GTIDs of binlog dumps are only kept in memory, the topo server is not available: %v", err)
+	}
 
 	var si SchemaInfo // default nil
 	var st *vtschema.Tracker
@@ -230,6 +250,24 @@ func Init(ctx context.Context, hc discovery.HealthCheck, serv srvtopo.Server, ce
 		*noScatter,
 	)
 
+	if *resultCacheMemory > 0 {
+		executor.resultCache = NewResultCache(*resultCacheMemory, *resultCacheMaxResultSize, *resultCacheTTL, strings.Split(*resultCacheTables, ","), vstreamResultCacheStreamer(vsm))
+		executor.resultCache.RegisterStats()
+		servenv.OnTerm(executor.resultCache.Close)
+	}
+
+	if *workloadManagerConfig != "" {
+		config, err := LoadWorkloadManagerConfig(*workloadManagerConfig)
+		if err != nil {
+			log.Fatalf("error loading the workload manager config: %v", err)
+		}
+		executor.workloadManager, err = NewWorkloadManager(config)
+		if err != nil {
+			log.Fatalf("invalid workload manager config %s: %v", *workloadManagerConfig, err)
+		}
+		executor.workloadManager.RegisterStats()
+	}
+
 	// connect the schema tracker with the vschema manager
 	if *enableSchemaChangeSignal {
 		st.RegisterSignalReceiver(executor.vm.Rebuild)
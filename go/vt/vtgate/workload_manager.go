@@ -0,0 +1,363 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/queryrules"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+const pathWorkloadManager = "/debug/workload_manager"
+
+var (
+	workloadAdmitted  = stats.NewCountersWithSingleLabel("WorkloadManagerAdmitted", "Queries admitted by the workload manager, by resource group", "Group")
+	workloadRejected  = stats.NewCountersWithSingleLabel("WorkloadManagerRejected", "Queries rejected by the workload manager because the queue of their resource group was full", "Group")
+	workloadTimeouts  = stats.NewCountersWithSingleLabel("WorkloadManagerTimeouts", "Queries that timed out in the queue of their resource group", "Group")
+	workloadQueueTime = stats.NewTimings("WorkloadManagerQueueTime", "Time spent by the queries in the queue of their resource group", "Group")
+)
+
+// WorkloadManagerConfig is the configuration of the workload manager.
+type WorkloadManagerConfig struct {
+	// MaxConcurrency limits the queries running in all the resource
+	// groups. When it is reached, the queued queries of the groups with the
+	// highest priority are admitted first. 0 means no limit.
+	MaxConcurrency int `json:"max_concurrency,omitempty"`
+	// Groups are the resource groups, in the order they are matched.
+	Groups []*WorkloadGroupConfig `json:"groups"`
+}
+
+// WorkloadGroupConfig is the configuration of a resource group. A query
+// belongs to the first group it matches: the users, workloads and
+// fingerprints that are set must all match. A group with none of them
+// matches all the queries. The queries matching no group are not limited.
+type WorkloadGroupConfig struct {
+	Name string `json:"name"`
+	// Users are the immediate callers of vtgate.
+	Users []string `json:"users,omitempty"`
+	// Workloads are the workloads of the sessions: OLTP, OLAP or DBA.
+	Workloads []string `json:"workloads,omitempty"`
+	// Fingerprints are queries, matched once normalized like the
+	// fingerprints of the query rules.
+	Fingerprints []string `json:"fingerprints,omitempty"`
+	// MaxConcurrency is the maximum number of queries of the group running
+	// at the same time. 0 means no limit.
+	MaxConcurrency int `json:"max_concurrency,omitempty"`
+	// MaxQueueLength is the maximum number of queries of the group waiting
+	// for a slot. The queries are rejected once it is reached.
+	MaxQueueLength int `json:"max_queue_length,omitempty"`
+	// QueueTimeoutMs is how long the queries of the group wait for a slot.
+	// 0 means until the deadline of the query.
+	QueueTimeoutMs int `json:"queue_timeout_ms,omitempty"`
+	Priority       int `json:"priority,omitempty"`
+}
+
+// WorkloadManager is the admission control of vtgate: it limits the
+// concurrency of the resource groups, and queues their queries.
+type WorkloadManager struct {
+	maxConcurrency int
+	// groups are in config order, byPriority in the order their
+	// queued queries are admitted.
+	groups          []*workloadGroup
+	byPriority      []*workloadGroup
+	hasFingerprints bool
+
+	mu      sync.Mutex
+	running int
+}
+
+type workloadGroup struct {
+	config       *WorkloadGroupConfig
+	users        map[string]bool
+	workloads    map[string]bool
+	fingerprints map[string]bool
+	queueTimeout time.Duration
+
+	// running and queue are protected by WorkloadManager.mu.
+	running int
+	queue   []*workloadWaiter
+}
+
+type workloadWaiter struct {
+	ready    chan struct{}
+	admitted bool
+}
+
+// LoadWorkloadManagerConfig reads the JSON configuration of the workload
+// manager from a file.
+func LoadWorkloadManagerConfig(path string) (*WorkloadManagerConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	config := &WorkloadManagerConfig{}
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("cannot parse workload manager config %s: %v", path, err)
+	}
+	return config, nil
+}
+
+// NewWorkloadManager creates a WorkloadManager.
+func NewWorkloadManager(config *WorkloadManagerConfig) (*WorkloadManager, error) {
+	if config.MaxConcurrency < 0 {
+		return nil, fmt.Errorf("invalid max_concurrency: %d", config.MaxConcurrency)
+	}
+	wm := &WorkloadManager{maxConcurrency: config.MaxConcurrency}
+	names := make(map[string]bool)
+	for _, gc := range config.Groups {
+		if gc.Name == "" {
+			return nil, fmt.Errorf("resource group without a name")
+		}
+		if names[gc.Name] {
+			return nil, fmt.Errorf("duplicate resource group %s", gc.Name)
+		}
+		names[gc.Name] = true
+		if gc.MaxConcurrency < 0 || gc.MaxQueueLength < 0 || gc.QueueTimeoutMs < 0 {
+			return nil, fmt.Errorf("resource group %s: negative limit", gc.Name)
+		}
+
+		g := &workloadGroup{
+			config:       gc,
+			queueTimeout: time.Duration(gc.QueueTimeoutMs) * time.Millisecond,
+		}
+		if len(gc.Users) > 0 {
+			g.users = make(map[string]bool)
+			for _, user := range gc.Users {
+				g.users[user] = true
+			}
+		}
+		if len(gc.Workloads) > 0 {
+			g.workloads = make(map[string]bool)
+			for _, workload := range gc.Workloads {
+				workload = strings.ToUpper(workload)
+				if _, ok := querypb.ExecuteOptions_Workload_value[workload]; !ok {
+					return nil, fmt.Errorf("resource group %s: unknown workload %s", gc.Name, workload)
+				}
+				g.workloads[workload] = true
+			}
+		}
+		if len(gc.Fingerprints) > 0 {
+			g.fingerprints = make(map[string]bool)
+			for _, query := range gc.Fingerprints {
+				stmt, err := sqlparser.Parse(query)
+				if err != nil {
+					return nil, fmt.Errorf("resource group %s: invalid fingerprint %s: %s", gc.Name, query, err.Error())
+				}
+				g.fingerprints[queryrules.Fingerprint(stmt)] = true
+			}
+			wm.hasFingerprints = true
+		}
+		wm.groups = append(wm.groups, g)
+	}
+	wm.byPriority = append([]*workloadGroup(nil), wm.groups...)
+	sort.SliceStable(wm.byPriority, func(i, j int) bool {
+		return wm.byPriority[i].config.Priority > wm.byPriority[j].config.Priority
+	})
+	return wm, nil
+}
+
+// RegisterStats registers the gauges of the resource groups, and the
+// debug page of the workload manager.
+func (wm *WorkloadManager) RegisterStats() {
+	stats.NewGaugesFuncWithMultiLabels("WorkloadManagerRunning", "Queries running in each resource group of the workload manager", []string{"Group"}, func() map[string]int64 {
+		return wm.counts(func(g *workloadGroup) int { return g.running })
+	})
+	stats.NewGaugesFuncWithMultiLabels("WorkloadManagerQueued", "Queries queued in each resource group of the workload manager", []string{"Group"}, func() map[string]int64 {
+		return wm.counts(func(g *workloadGroup) int { return len(g.queue) })
+	})
+	http.Handle(pathWorkloadManager, wm)
+}
+
+func (wm *WorkloadManager) counts(count func(g *workloadGroup) int) map[string]int64 {
+	wm.mu.Lock()
+	defer wm.mu.Unlock()
+	counts := make(map[string]int64, len(wm.groups))
+	for _, g := range wm.groups {
+		counts[g.config.Name] = int64(count(g))
+	}
+	return counts
+}
+
+// match returns the resource group of the query, or nil.
+func (wm *WorkloadManager) match(user, workload, query string) *workloadGroup {
+	var fingerprint string
+	if wm.hasFingerprints {
+		if stmt, err := sqlparser.Parse(query); err == nil {
+			fingerprint = queryrules.Fingerprint(stmt)
+		}
+	}
+	for _, g := range wm.groups {
+		if g.users != nil && !g.users[user] {
+			continue
+		}
+		if g.workloads != nil && !g.workloads[workload] {
+			continue
+		}
+		if g.fingerprints != nil && !g.fingerprints[fingerprint] {
+			continue
+		}
+		return g
+	}
+	return nil
+}
+
+// canRun returns true if a query of the group can start. It must be called
+// with the lock held.
+func (wm *WorkloadManager) canRun(g *workloadGroup) bool {
+	if wm.maxConcurrency > 0 && wm.running >= wm.maxConcurrency {
+		return false
+	}
+	return g.config.MaxConcurrency == 0 || g.running < g.config.MaxConcurrency
+}
+
+// start counts a query of the group as running. It must be called with the
+// lock held.
+func (wm *WorkloadManager) start(g *workloadGroup) {
+	wm.running++
+	g.running++
+	workloadAdmitted.Add(g.config.Name, 1)
+}
+
+// dispatch admits the queued queries while there are slots for them, from
+// the groups with the highest priority first. It must be called with the
+// lock held.
+func (wm *WorkloadManager) dispatch() {
+	for _, g := range wm.byPriority {
+		for len(g.queue) > 0 && wm.canRun(g) {
+			w := g.queue[0]
+			g.queue = g.queue[1:]
+			w.admitted = true
+			wm.start(g)
+			close(w.ready)
+		}
+	}
+}
+
+// Admit waits until the query can run in its resource group. It returns
+// the function to call once the query is done.
+func (wm *WorkloadManager) Admit(ctx context.Context, user, workload, query string) (func(), error) {
+	g := wm.match(user, workload, query)
+	if g == nil {
+		return func() {}, nil
+	}
+	release := func() {
+		wm.mu.Lock()
+		defer wm.mu.Unlock()
+		wm.running--
+		g.running--
+		wm.dispatch()
+	}
+
+	wm.mu.Lock()
+	if len(g.queue) == 0 && wm.canRun(g) {
+		wm.start(g)
+		wm.mu.Unlock()
+		return release, nil
+	}
+	if len(g.queue) >= g.config.MaxQueueLength {
+		wm.mu.Unlock()
+		workloadRejected.Add(g.config.Name, 1)
+		return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "workload manager: too many queries in resource group %s", g.config.Name)
+	}
+	w := &workloadWaiter{ready: make(chan struct{})}
+	g.queue = append(g.queue, w)
+	wm.mu.Unlock()
+
+	start := time.Now()
+	var timeout <-chan time.Time
+	if g.queueTimeout > 0 {
+		timer := time.NewTimer(g.queueTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	var err error
+	select {
+	case <-w.ready:
+	case <-timeout:
+		err = vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "workload manager: timed out after %v in the queue of resource group %s", g.queueTimeout, g.config.Name)
+	case <-ctx.Done():
+		err = vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "workload manager: %v in the queue of resource group %s", ctx.Err(), g.config.Name)
+	}
+	workloadQueueTime.Record(g.config.Name, start)
+	if err == nil {
+		return release, nil
+	}
+
+	wm.mu.Lock()
+	defer wm.mu.Unlock()
+	if w.admitted {
+		// The query got its slot while timing out.
+		return release, nil
+	}
+	for i, queued := range g.queue {
+		if queued == w {
+			g.queue = append(g.queue[:i], g.queue[i+1:]...)
+			break
+		}
+	}
+	workloadTimeouts.Add(g.config.Name, 1)
+	return nil, err
+}
+
+type workloadGroupDebug struct {
+	*WorkloadGroupConfig
+	Running int `json:"running"`
+	Queued  int `json:"queued"`
+}
+
+type workloadManagerDebug struct {
+	MaxConcurrency int                  `json:"max_concurrency"`
+	Running        int                  `json:"running"`
+	Groups         []workloadGroupDebug `json:"groups"`
+}
+
+// ServeHTTP shows the resource groups and their queries.
+func (wm *WorkloadManager) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	if err := acl.CheckAccessHTTP(request, acl.DEBUGGING); err != nil {
+		acl.SendError(response, err)
+		return
+	}
+	wm.mu.Lock()
+	d := &workloadManagerDebug{
+		MaxConcurrency: wm.maxConcurrency,
+		Running:        wm.running,
+	}
+	for _, g := range wm.groups {
+		d.Groups = append(d.Groups, workloadGroupDebug{
+			WorkloadGroupConfig: g.config,
+			Running:             g.running,
+			Queued:              len(g.queue),
+		})
+	}
+	wm.mu.Unlock()
+	returnAsJSON(response, d)
+}
@@ -0,0 +1,245 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/callerid"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+// admitAsync runs Admit in a goroutine, and returns the channel of its
+// release function, or of nil on errors.
+func admitAsync(wm *WorkloadManager, ctx context.Context, user, workload, query string) chan func() {
+	ch := make(chan func(), 1)
+	go func() {
+		release, err := wm.Admit(ctx, user, workload, query)
+		if err != nil {
+			ch <- nil
+			return
+		}
+		ch <- release
+	}()
+	return ch
+}
+
+func queuedCount(wm *WorkloadManager, group string) int64 {
+	return wm.counts(func(g *workloadGroup) int { return len(g.queue) })[group]
+}
+
+func TestWorkloadManagerConfig(t *testing.T) {
+	tcases := []struct {
+		config string
+		err    string
+	}{{
+		config: `{"groups": [{"users": ["a"]}]}`,
+		err:    "resource group without a name",
+	}, {
+		config: `{"groups": [{"name": "a"}, {"name": "a"}]}`,
+		err:    "duplicate resource group a",
+	}, {
+		config: `{"groups": [{"name": "a", "max_queue_length": -1}]}`,
+		err:    "resource group a: negative limit",
+	}, {
+		config: `{"groups": [{"name": "a", "workloads": ["batch"]}]}`,
+		err:    "resource group a: unknown workload BATCH",
+	}, {
+		config: `{"groups": [{"name": "a", "fingerprints": ["selec"]}]}`,
+		err:    "resource group a: invalid fingerprint selec: syntax error at position 6 near 'selec'",
+	}, {
+		config: `{"max_concurrency": -1}`,
+		err:    "invalid max_concurrency: -1",
+	}, {
+		config: `{"max_concurrency": 10, "groups": [{"name": "a", "workloads": ["olap"], "max_concurrency": 2}]}`,
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.config, func(t *testing.T) {
+			file := path.Join(t.TempDir(), "config.json")
+			require.NoError(t, os.WriteFile(file, []byte(tcase.config), 0600))
+			config, err := LoadWorkloadManagerConfig(file)
+			require.NoError(t, err)
+			_, err = NewWorkloadManager(config)
+			if tcase.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tcase.err)
+		})
+	}
+}
+
+func TestWorkloadManagerMatch(t *testing.T) {
+	wm, err := NewWorkloadManager(&WorkloadManagerConfig{Groups: []*WorkloadGroupConfig{{
+		Name:         "report",
+		Users:        []string{"report"},
+		Fingerprints: []string{"select * from t where id = 1"},
+	}, {
+		Name:      "olap",
+		Workloads: []string{"olap"},
+	}, {
+		Name:  "batch",
+		Users: []string{"batch", "report"},
+	}}})
+	require.NoError(t, err)
+
+	name := func(user, workload, query string) string {
+		g := wm.match(user, workload, query)
+		if g == nil {
+			return ""
+		}
+		return g.config.Name
+	}
+	assert.Equal(t, "report", name("report", "OLTP", "select * from t where id = 5"))
+	assert.Equal(t, "batch", name("report", "OLTP", "select * from t"))
+	assert.Equal(t, "olap", name("report", "OLAP", "select * from t"))
+	assert.Equal(t, "olap", name("app", "OLAP", "select * from t"))
+	assert.Equal(t, "", name("app", "OLTP", "select * from t"))
+}
+
+func TestWorkloadManagerQueue(t *testing.T) {
+	wm, err := NewWorkloadManager(&WorkloadManagerConfig{Groups: []*WorkloadGroupConfig{{
+		Name:           "wmq",
+		MaxConcurrency: 2,
+		MaxQueueLength: 1,
+		QueueTimeoutMs: 50,
+	}}})
+	require.NoError(t, err)
+	ctx := context.Background()
+	rejected := workloadRejected.Counts()["wmq"]
+	timeouts := workloadTimeouts.Counts()["wmq"]
+
+	release1, err := wm.Admit(ctx, "", "", "select 1")
+	require.NoError(t, err)
+	release2, err := wm.Admit(ctx, "", "", "select 1")
+	require.NoError(t, err)
+
+	// The third query waits for a slot, the fourth one is rejected.
+	queued := admitAsync(wm, ctx, "", "", "select 1")
+	require.Eventually(t, func() bool { return queuedCount(wm, "wmq") == 1 }, 5*time.Second, time.Millisecond)
+	_, err = wm.Admit(ctx, "", "", "select 1")
+	require.EqualError(t, err, "workload manager: too many queries in resource group wmq")
+	assert.EqualValues(t, rejected+1, workloadRejected.Counts()["wmq"])
+
+	release1()
+	release3 := <-queued
+	require.NotNil(t, release3)
+	assert.EqualValues(t, 2, wm.counts(func(g *workloadGroup) int { return g.running })["wmq"])
+
+	// The queued queries time out.
+	_, err = wm.Admit(ctx, "", "", "select 1")
+	require.EqualError(t, err, "workload manager: timed out after 50ms in the queue of resource group wmq")
+	assert.EqualValues(t, timeouts+1, workloadTimeouts.Counts()["wmq"])
+	assert.EqualValues(t, 0, queuedCount(wm, "wmq"))
+
+	// And stop waiting when their context is done.
+	cancelCtx, cancel := context.WithCancel(ctx)
+	queued = admitAsync(wm, cancelCtx, "", "", "select 1")
+	require.Eventually(t, func() bool { return queuedCount(wm, "wmq") == 1 }, 5*time.Second, time.Millisecond)
+	cancel()
+	assert.Nil(t, <-queued)
+	assert.EqualValues(t, 0, queuedCount(wm, "wmq"))
+
+	release2()
+	release3()
+	assert.Zero(t, wm.running)
+}
+
+func TestWorkloadManagerPriority(t *testing.T) {
+	wm, err := NewWorkloadManager(&WorkloadManagerConfig{
+		MaxConcurrency: 1,
+		Groups: []*WorkloadGroupConfig{{
+			Name:           "wmlow",
+			Users:          []string{"low"},
+			MaxQueueLength: 10,
+		}, {
+			Name:           "wmhigh",
+			Users:          []string{"high"},
+			MaxQueueLength: 10,
+			Priority:       10,
+		}},
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	release, err := wm.Admit(ctx, "low", "", "select 1")
+	require.NoError(t, err)
+	low := admitAsync(wm, ctx, "low", "", "select 1")
+	require.Eventually(t, func() bool { return queuedCount(wm, "wmlow") == 1 }, 5*time.Second, time.Millisecond)
+	high := admitAsync(wm, ctx, "high", "", "select 1")
+	require.Eventually(t, func() bool { return queuedCount(wm, "wmhigh") == 1 }, 5*time.Second, time.Millisecond)
+
+	// The query of the group with the highest priority runs first, although
+	// it was queued last.
+	release()
+	releaseHigh := <-high
+	require.NotNil(t, releaseHigh)
+	assert.EqualValues(t, 1, queuedCount(wm, "wmlow"))
+	releaseHigh()
+	releaseLow := <-low
+	require.NotNil(t, releaseLow)
+	releaseLow()
+
+	// The debug page shows the groups.
+	response := httptest.NewRecorder()
+	wm.ServeHTTP(response, httptest.NewRequest("GET", pathWorkloadManager, nil))
+	var debug workloadManagerDebug
+	require.NoError(t, json.Unmarshal(response.Body.Bytes(), &debug))
+	assert.Equal(t, 1, debug.MaxConcurrency)
+	require.Len(t, debug.Groups, 2)
+	assert.Equal(t, "wmhigh", debug.Groups[1].Name)
+	assert.Equal(t, 10, debug.Groups[1].Priority)
+}
+
+func TestExecutorWorkloadManager(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	wm, err := NewWorkloadManager(&WorkloadManagerConfig{Groups: []*WorkloadGroupConfig{{
+		Name:           "wmexec",
+		Users:          []string{"batch"},
+		MaxConcurrency: 1,
+	}}})
+	require.NoError(t, err)
+	executor.workloadManager = wm
+
+	session := &vtgatepb.Session{TargetString: "@primary", Autocommit: true}
+	ctx := callerid.NewContext(context.Background(), nil, &querypb.VTGateCallerID{Username: "batch"})
+	exec := func(ctx context.Context) error {
+		_, err := executor.Execute(ctx, "TestExecute", NewSafeSession(session), "select id from user where id = 1", nil)
+		return err
+	}
+
+	require.NoError(t, exec(ctx))
+	release, err := wm.Admit(ctx, "batch", "", "select 1")
+	require.NoError(t, err)
+	require.EqualError(t, exec(ctx), "workload manager: too many queries in resource group wmexec")
+	// The other users are not limited.
+	require.NoError(t, exec(context.Background()))
+	release()
+	require.NoError(t, exec(ctx))
+	assert.EqualValues(t, 3, sbc1.ExecCount.Get())
+}
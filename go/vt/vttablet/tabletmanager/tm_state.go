@@ -278,6 +278,12 @@ func (ts *tmState) updateLocked(ctx context.Context) {
 		}
 	}
 
+	// The vdiff engine uses the vreplication engine: it is opened after it,
+	// and closed before it.
+	if ts.tm.VDiffEngine != nil && ts.tablet.Type != topodatapb.TabletType_PRIMARY {
+		ts.tm.VDiffEngine.Close()
+	}
+
 	if ts.tm.VREngine != nil {
 		if ts.tablet.Type == topodatapb.TabletType_PRIMARY {
 			ts.tm.VREngine.Open(ts.tm.BatchCtx)
@@ -286,6 +292,10 @@ func (ts *tmState) updateLocked(ctx context.Context) {
 		}
 	}
 
+	if ts.tm.VDiffEngine != nil && ts.tablet.Type == topodatapb.TabletType_PRIMARY {
+		ts.tm.VDiffEngine.Open(ts.tm.BatchCtx)
+	}
+
 	if ts.isShardServing[ts.tablet.Type] {
 		ts.isInSrvKeyspace = true
 		statsIsInSrvKeyspace.Set(1)
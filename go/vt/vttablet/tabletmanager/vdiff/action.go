@@ -0,0 +1,281 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// The actions of a VDiffRequest.
+const (
+	CreateAction = "create"
+	ShowAction   = "show"
+	StopAction   = "stop"
+	ResumeAction = "resume"
+	DeleteAction = "delete"
+)
+
+// The states of a vdiff, and of its tables.
+const (
+	PendingState   = "pending"
+	StartedState   = "started"
+	StoppedState   = "stopped"
+	CompletedState = "completed"
+	ErrorState     = "error"
+)
+
+// PerformVDiffAction runs the action of the request on the vdiffs of a
+// workflow of this tablet, and returns their status.
+func (vde *Engine) PerformVDiffAction(ctx context.Context, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	if !vde.isOpen {
+		return nil, vterrors.New(vtrpcpb.Code_UNAVAILABLE, "vdiff engine is closed, the tablet is not a primary")
+	}
+	if req.Workflow == "" {
+		return nil, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "workflow is required")
+	}
+	action := strings.ToLower(req.Action)
+	if action != ShowAction && req.VdiffUuid == "" {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "the vdiff uuid is required for the %s action", action)
+	}
+
+	dbClient := vde.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		return nil, err
+	}
+	defer dbClient.Close()
+
+	switch action {
+	case CreateAction:
+		return vde.createVDiff(ctx, dbClient, req)
+	case ShowAction:
+		return vde.showVDiffs(dbClient, req)
+	case StopAction:
+		return vde.stopVDiff(dbClient, req)
+	case ResumeAction:
+		return vde.resumeVDiff(dbClient, req)
+	case DeleteAction:
+		return vde.deleteVDiff(dbClient, req)
+	}
+	return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unknown vdiff action: %s", req.Action)
+}
+
+func (vde *Engine) createVDiff(ctx context.Context, dbClient binlogplayer.DBClient, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	qr, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlGetWorkflowStreams, encodeString(vde.dbName), encodeString(req.Workflow)), -1)
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "workflow %s not found on tablet %v", req.Workflow, vde.tablet.Alias)
+	}
+	options := req.Options
+	if options == nil {
+		options = &tabletmanagerdatapb.VDiffOptions{}
+	}
+	optionsJSON, err := protojson.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+	query := fmt.Sprintf(sqlNewVDiff, encodeString(req.VdiffUuid), encodeString(req.Workflow), encodeString(req.Keyspace),
+		encodeString(vde.tablet.Shard), encodeString(vde.dbName), encodeString(PendingState), encodeString(string(optionsJSON)))
+	if _, err := withDDL.Exec(ctx, query, dbClient.ExecuteFetch, dbClient.ExecuteFetch); err != nil {
+		return nil, err
+	}
+	row, err := vde.getVDiff(dbClient, req.Workflow, req.VdiffUuid)
+	if err != nil {
+		return nil, err
+	}
+	ct, err := newController(row, vde)
+	if err != nil {
+		return nil, err
+	}
+	vde.startLocked(ct)
+	return vde.vdiffResponse(dbClient, row)
+}
+
+func (vde *Engine) showVDiffs(dbClient binlogplayer.DBClient, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	if req.VdiffUuid != "" {
+		row, err := vde.getVDiff(dbClient, req.Workflow, req.VdiffUuid)
+		if err != nil {
+			return nil, err
+		}
+		return vde.vdiffResponse(dbClient, row)
+	}
+	qr, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlGetVDiffs, encodeString(vde.dbName), encodeString(req.Workflow)), -1)
+	if err != nil {
+		return nil, err
+	}
+	return vde.vdiffResponse(dbClient, qr.Named().Rows...)
+}
+
+func (vde *Engine) stopVDiff(dbClient binlogplayer.DBClient, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	row, err := vde.getVDiff(dbClient, req.Workflow, req.VdiffUuid)
+	if err != nil {
+		return nil, err
+	}
+	switch state := row.AsString("state", ""); state {
+	case PendingState, StartedState:
+	default:
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "vdiff %s is %s, only pending or started vdiffs can be stopped", req.VdiffUuid, state)
+	}
+	vde.stopLocked(req.VdiffUuid)
+	id := row.AsInt64("id", 0)
+	if _, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlUpdateVDiffState, encodeString(StoppedState), encodeString(""), id), 1); err != nil {
+		return nil, err
+	}
+	if row, err = vde.getVDiff(dbClient, req.Workflow, req.VdiffUuid); err != nil {
+		return nil, err
+	}
+	return vde.vdiffResponse(dbClient, row)
+}
+
+// resumeVDiff restarts a vdiff where it stopped. If the vdiff was completed,
+// its tables are compared again from the last compared primary keys, which
+// only compares the rows that were added since.
+func (vde *Engine) resumeVDiff(dbClient binlogplayer.DBClient, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	row, err := vde.getVDiff(dbClient, req.Workflow, req.VdiffUuid)
+	if err != nil {
+		return nil, err
+	}
+	id := row.AsInt64("id", 0)
+	switch state := row.AsString("state", ""); state {
+	case StoppedState, ErrorState:
+	case CompletedState:
+		if _, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlResetVDiffTables, id), -1); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "vdiff %s is %s, only stopped, completed or failed vdiffs can be resumed", req.VdiffUuid, state)
+	}
+	if _, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlUpdateVDiffState, encodeString(PendingState), encodeString(""), id), 1); err != nil {
+		return nil, err
+	}
+	if row, err = vde.getVDiff(dbClient, req.Workflow, req.VdiffUuid); err != nil {
+		return nil, err
+	}
+	ct, err := newController(row, vde)
+	if err != nil {
+		return nil, err
+	}
+	vde.startLocked(ct)
+	return vde.vdiffResponse(dbClient, row)
+}
+
+func (vde *Engine) deleteVDiff(dbClient binlogplayer.DBClient, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	row, err := vde.getVDiff(dbClient, req.Workflow, req.VdiffUuid)
+	if err != nil {
+		return nil, err
+	}
+	vde.stopLocked(req.VdiffUuid)
+	id := row.AsInt64("id", 0)
+	if _, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlDeleteVDiffTables, id), -1); err != nil {
+		return nil, err
+	}
+	if _, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlDeleteVDiff, id), 1); err != nil {
+		return nil, err
+	}
+	return &tabletmanagerdatapb.VDiffResponse{}, nil
+}
+
+// getVDiff returns the _vt.vdiff row of the vdiff.
+func (vde *Engine) getVDiff(dbClient binlogplayer.DBClient, workflow, uuid string) (sqltypes.RowNamedValues, error) {
+	qr, err := withDDL.ExecIgnore(context.Background(), fmt.Sprintf(sqlGetVDiffByUUID, encodeString(vde.dbName), encodeString(workflow), encodeString(uuid)), dbClient.ExecuteFetch)
+	if err != nil {
+		return nil, err
+	}
+	row := qr.Named().Row()
+	if row == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "vdiff %s of workflow %s not found on tablet %v", uuid, workflow, vde.tablet.Alias)
+	}
+	return row, nil
+}
+
+// vdiffResponse builds the status of the vdiffs of the _vt.vdiff rows.
+func (vde *Engine) vdiffResponse(dbClient binlogplayer.DBClient, rows ...sqltypes.RowNamedValues) (*tabletmanagerdatapb.VDiffResponse, error) {
+	resp := &tabletmanagerdatapb.VDiffResponse{}
+	for _, row := range rows {
+		status := &tabletmanagerdatapb.VDiffStatus{
+			VdiffUuid:   row.AsString("vdiff_uuid", ""),
+			Keyspace:    row.AsString("keyspace", ""),
+			Shard:       row.AsString("shard", ""),
+			Workflow:    row.AsString("workflow", ""),
+			State:       row.AsString("state", ""),
+			LastError:   row.AsString("last_error", ""),
+			CreatedAt:   row.AsString("created_at", ""),
+			StartedAt:   row.AsString("started_at", ""),
+			CompletedAt: row.AsString("completed_at", ""),
+		}
+		options, err := unmarshalOptions(row)
+		if err != nil {
+			return nil, err
+		}
+		status.Options = options
+		qr, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlGetVDiffTables, row.AsInt64("id", 0)), -1)
+		if err != nil {
+			return nil, err
+		}
+		for _, trow := range qr.Named().Rows {
+			lastpk, err := formatLastPK(trow.AsBytes("lastpk", nil))
+			if err != nil {
+				return nil, err
+			}
+			status.Tables = append(status.Tables, &tabletmanagerdatapb.VDiffTableStatus{
+				TableName:       trow.AsString("table_name", ""),
+				State:           trow.AsString("state", ""),
+				TableRows:       trow.AsInt64("table_rows", 0),
+				Lastpk:          lastpk,
+				ProcessedRows:   trow.AsInt64("processed_rows", 0),
+				MatchingRows:    trow.AsInt64("matching_rows", 0),
+				MismatchedRows:  trow.AsInt64("mismatched_rows", 0),
+				ExtraRowsSource: trow.AsInt64("extra_rows_source", 0),
+				ExtraRowsTarget: trow.AsInt64("extra_rows_target", 0),
+				Report:          trow.AsString("report", ""),
+			})
+		}
+		resp.Vdiffs = append(resp.Vdiffs, status)
+	}
+	return resp, nil
+}
+
+// unmarshalOptions returns the options of the _vt.vdiff row.
+func unmarshalOptions(row sqltypes.RowNamedValues) (*tabletmanagerdatapb.VDiffOptions, error) {
+	options := &tabletmanagerdatapb.VDiffOptions{}
+	if data := row.AsBytes("options", nil); len(data) != 0 {
+		if err := protojson.Unmarshal(data, options); err != nil {
+			return nil, vterrors.Wrapf(err, "invalid options of vdiff %s", row.AsString("vdiff_uuid", ""))
+		}
+	}
+	return options, nil
+}
+
+func encodeString(in string) string {
+	var buf strings.Builder
+	sqltypes.NewVarChar(in).EncodeSQL(&buf)
+	return buf.String()
+}
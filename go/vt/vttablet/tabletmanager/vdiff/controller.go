@@ -0,0 +1,147 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"fmt"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/log"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// controller runs one vdiff. The vdiffs of a workflow run one at a time.
+type controller struct {
+	vde      *Engine
+	id       int64
+	uuid     string
+	workflow string
+	options  *tabletmanagerdatapb.VDiffOptions
+	// sem is the semaphore of the workflow.
+	sem chan struct{}
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// newController creates a controller for the _vt.vdiff row. It must be
+// called with the engine lock held.
+func newController(row sqltypes.RowNamedValues, vde *Engine) (*controller, error) {
+	id, err := row.ToInt64("id")
+	if err != nil {
+		return nil, err
+	}
+	options, err := unmarshalOptions(row)
+	if err != nil {
+		return nil, err
+	}
+	workflow := row.AsString("workflow", "")
+	return &controller{
+		vde:      vde,
+		id:       id,
+		uuid:     row.AsString("vdiff_uuid", ""),
+		workflow: workflow,
+		options:  options,
+		sem:      vde.workflowSemaphoreLocked(workflow),
+		done:     make(chan struct{}),
+	}, nil
+}
+
+func (ct *controller) start(ctx context.Context) {
+	ctx, ct.cancel = context.WithCancel(ctx)
+	go ct.run(ctx)
+}
+
+// Stop interrupts the vdiff and waits for it to exit. Its state is left
+// unchanged.
+func (ct *controller) Stop() {
+	ct.cancel()
+	<-ct.done
+}
+
+func (ct *controller) run(ctx context.Context) {
+	defer close(ct.done)
+
+	select {
+	case ct.sem <- struct{}{}:
+	case <-ctx.Done():
+		return
+	}
+	defer func() { <-ct.sem }()
+
+	dbClient := ct.vde.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		// The vdiff is left pending, and runs again when the engine is
+		// reopened.
+		log.Errorf("vdiff %s: could not connect to the database: %v", ct.uuid, err)
+		return
+	}
+	defer dbClient.Close()
+
+	log.Infof("vdiff %s of workflow %s: starting", ct.uuid, ct.workflow)
+	err := ct.diff(ctx, dbClient)
+	switch {
+	case err == nil:
+		if _, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlCompleteVDiff, ct.id), 1); err != nil {
+			log.Errorf("vdiff %s: could not save its completion: %v", ct.uuid, err)
+			return
+		}
+		log.Infof("vdiff %s of workflow %s: completed", ct.uuid, ct.workflow)
+	case ctx.Err() != nil:
+		// The vdiff was stopped, or the engine is closing.
+		log.Infof("vdiff %s of workflow %s: interrupted: %v", ct.uuid, ct.workflow, err)
+	default:
+		log.Errorf("vdiff %s of workflow %s: failed: %v", ct.uuid, ct.workflow, err)
+		query := fmt.Sprintf(sqlUpdateVDiffState, encodeString(ErrorState), encodeString(truncateError(err)), ct.id)
+		if _, err := dbClient.ExecuteFetch(query, 1); err != nil {
+			log.Errorf("vdiff %s: could not save its error: %v", ct.uuid, err)
+		}
+	}
+}
+
+// diff compares the tables of the workflow one by one, skipping the ones
+// that were completed by a previous run.
+func (ct *controller) diff(ctx context.Context, dbClient binlogplayer.DBClient) error {
+	if _, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlStartVDiff, ct.id), 1); err != nil {
+		return err
+	}
+	wd, err := newWorkflowDiffer(ctx, ct, dbClient)
+	if err != nil {
+		return err
+	}
+	defer wd.close()
+	for _, td := range wd.tableDiffers {
+		if err := wd.diffTable(ctx, dbClient, td); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// truncateError returns the error message, truncated to the size of the
+// last_error column.
+func truncateError(err error) string {
+	const maxLen = 512
+	msg := err.Error()
+	if len(msg) > maxLen {
+		msg = msg[:maxLen-3] + "..."
+	}
+	return msg
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package vdiff runs the vdiffs of the vreplication workflows of a target
+primary. A vdiff compares the rows of the tables of a workflow on its
+sources with the rows on this target shard, and records its progress
+(the last compared primary key, the row counts and samples of the
+differences) in the _vt.vdiff and _vt.vdiff_table tables. This allows a
+vdiff to survive restarts of the tablet, to be stopped and resumed, and
+to be re-run incrementally from where the previous run stopped.
+*/
+package vdiff
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// VReplication is the part of the vreplication engine that the vdiffs use
+// to stop the streams of their workflow and to catch them up with the
+// sources. It is satisfied by *vreplication.Engine.
+type VReplication interface {
+	Exec(query string) (*sqltypes.Result, error)
+	WaitForPos(ctx context.Context, id int, pos string) error
+}
+
+// Engine runs the vdiffs of the workflows of a target primary.
+type Engine struct {
+	// mu synchronizes isOpen, cancelRetry, controllers and workflows.
+	mu     sync.Mutex
+	isOpen bool
+	// If cancelRetry is set, then a retry loop is running.
+	cancelRetry context.CancelFunc
+	// controllers are the running vdiffs, by uuid.
+	controllers map[string]*controller
+	// workflows has a semaphore per workflow, which makes the vdiffs of a
+	// workflow run one at a time, since they stop its streams.
+	workflows map[string]chan struct{}
+
+	// ctx is the root context for all controllers.
+	ctx context.Context
+	// cancel will cancel the root context, thereby all controllers.
+	cancel context.CancelFunc
+
+	ts              *topo.Server
+	tablet          *topodatapb.Tablet
+	mysqld          mysqlctl.MysqlDaemon
+	vre             VReplication
+	dbClientFactory func() binlogplayer.DBClient
+	tmClientFactory func() tmclient.TabletManagerClient
+	dbName          string
+}
+
+// NewEngine creates a new Engine for the given tablet.
+// A nil ts means that the Engine is disabled.
+func NewEngine(ts *topo.Server, tablet *topodatapb.Tablet, mysqld mysqlctl.MysqlDaemon, vre VReplication) *Engine {
+	return &Engine{
+		controllers:     make(map[string]*controller),
+		workflows:       make(map[string]chan struct{}),
+		ts:              ts,
+		tablet:          tablet,
+		mysqld:          mysqld,
+		vre:             vre,
+		tmClientFactory: tmclient.NewTabletManagerClient,
+	}
+}
+
+// InitDBConfig should be invoked after the db name is computed.
+func (vde *Engine) InitDBConfig(dbcfgs *dbconfigs.DBConfigs) {
+	// If we're already initilized, it's a test engine. Ignore the call.
+	if vde.dbClientFactory != nil {
+		return
+	}
+	vde.dbClientFactory = func() binlogplayer.DBClient {
+		return binlogplayer.NewDBClient(dbcfgs.DbaWithDB())
+	}
+	vde.dbName = dbcfgs.DBName
+}
+
+// NewTestEngine creates a new Engine for testing.
+func NewTestEngine(ts *topo.Server, tablet *topodatapb.Tablet, mysqld mysqlctl.MysqlDaemon, vre VReplication, dbClientFactory func() binlogplayer.DBClient, tmc tmclient.TabletManagerClient, dbName string) *Engine {
+	vde := NewEngine(ts, tablet, mysqld, vre)
+	vde.dbClientFactory = dbClientFactory
+	vde.tmClientFactory = func() tmclient.TabletManagerClient { return tmc }
+	vde.dbName = dbName
+	return vde
+}
+
+// Open starts the Engine service, and resumes the vdiffs that were
+// running or pending.
+func (vde *Engine) Open(ctx context.Context) {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+
+	if vde.ts == nil || vde.isOpen {
+		return
+	}
+	log.Infof("VDiff Engine: opening")
+
+	if vde.cancelRetry != nil {
+		vde.cancelRetry()
+		vde.cancelRetry = nil
+	}
+	if err := vde.openLocked(ctx); err != nil {
+		ctx, cancel := context.WithCancel(ctx)
+		vde.cancelRetry = cancel
+		go vde.retry(ctx, err)
+	}
+}
+
+func (vde *Engine) openLocked(ctx context.Context) error {
+	dbClient := vde.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		return err
+	}
+	defer dbClient.Close()
+
+	// Make sure the tables exist, so that the queries don't need withDDL.
+	_, _ = withDDL.ExecIgnore(ctx, queryToTriggerWithDDL, dbClient.ExecuteFetch)
+	qr, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlGetRunnableVDiffs, encodeString(vde.dbName)), -1)
+	if err != nil {
+		return err
+	}
+
+	vde.ctx, vde.cancel = context.WithCancel(ctx)
+	vde.isOpen = true
+	for _, row := range qr.Named().Rows {
+		ct, err := newController(row, vde)
+		if err != nil {
+			log.Errorf("VDiff could not be initialized for %v: %v", row, err)
+			continue
+		}
+		vde.startLocked(ct)
+	}
+	return nil
+}
+
+var openRetryInterval = sync2.NewAtomicDuration(1 * time.Second)
+
+func (vde *Engine) retry(ctx context.Context, err error) {
+	log.Errorf("Error starting vdiff engine: %v, will keep retrying.", err)
+	for {
+		timer := time.NewTimer(openRetryInterval.Get())
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		vde.mu.Lock()
+		select {
+		case <-ctx.Done():
+			vde.mu.Unlock()
+			return
+		default:
+		}
+		if err := vde.openLocked(ctx); err == nil {
+			vde.cancelRetry = nil
+			vde.mu.Unlock()
+			return
+		}
+		vde.mu.Unlock()
+	}
+}
+
+// IsOpen returns true if Engine is open.
+func (vde *Engine) IsOpen() bool {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	return vde.isOpen
+}
+
+// Close closes the Engine service. The running vdiffs are interrupted,
+// and continue where they left off when the Engine is opened again.
+func (vde *Engine) Close() {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+
+	if vde.cancelRetry != nil {
+		vde.cancelRetry()
+		vde.cancelRetry = nil
+		return
+	}
+	if !vde.isOpen {
+		return
+	}
+
+	vde.cancel()
+	for _, ct := range vde.controllers {
+		ct.Stop()
+	}
+	vde.controllers = make(map[string]*controller)
+	vde.isOpen = false
+	log.Infof("VDiff Engine: closed")
+}
+
+// startLocked starts the controller, after stopping the previous one of
+// the same vdiff, if any.
+func (vde *Engine) startLocked(ct *controller) {
+	if old := vde.controllers[ct.uuid]; old != nil {
+		old.Stop()
+	}
+	vde.controllers[ct.uuid] = ct
+	ct.start(vde.ctx)
+}
+
+// stopLocked stops the controller of the vdiff, if it is running.
+func (vde *Engine) stopLocked(uuid string) {
+	if ct := vde.controllers[uuid]; ct != nil {
+		ct.Stop()
+		delete(vde.controllers, uuid)
+	}
+}
+
+// workflowSemaphoreLocked returns the semaphore of the workflow.
+func (vde *Engine) workflowSemaphoreLocked(workflow string) chan struct{} {
+	sem, ok := vde.workflows[workflow]
+	if !ok {
+		sem = make(chan struct{}, 1)
+		vde.workflows[workflow] = sem
+	}
+	return sem
+}
@@ -0,0 +1,268 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+const testUUID = "4d9c5ba1-1c62-4e4f-9d1f-4a5d7a1fb2b8"
+
+func vdiffAction(t *testing.T, action, uuid string) *tabletmanagerdatapb.VDiffResponse {
+	t.Helper()
+	resp, err := env.vde.PerformVDiffAction(context.Background(), &tabletmanagerdatapb.VDiffRequest{
+		Keyspace:  "target",
+		Workflow:  testWorkflow,
+		Action:    action,
+		VdiffUuid: uuid,
+	})
+	require.NoError(t, err)
+	return resp
+}
+
+func showTable(t *testing.T, uuid string) *tabletmanagerdatapb.VDiffTableStatus {
+	t.Helper()
+	resp := vdiffAction(t, ShowAction, uuid)
+	require.Len(t, resp.Vdiffs, 1)
+	require.Len(t, resp.Vdiffs[0].Tables, 1)
+	return resp.Vdiffs[0].Tables[0]
+}
+
+func TestVDiff(t *testing.T) {
+	newTestEnv(t)
+	env.source.setRows("1|a", "2|b", "3|c", "4|d", "5|e")
+	env.target.setRows("1|a", "2|b", "3|x", "4|d")
+
+	resp := vdiffAction(t, CreateAction, testUUID)
+	require.Len(t, resp.Vdiffs, 1)
+	assert.Equal(t, "0", resp.Vdiffs[0].Shard)
+	waitForState(t, testUUID, CompletedState)
+
+	table := showTable(t, testUUID)
+	assert.Equal(t, "t1", table.TableName)
+	assert.Equal(t, CompletedState, table.State)
+	assert.EqualValues(t, 5, table.TableRows)
+	assert.Equal(t, "id=5", table.Lastpk)
+	assert.EqualValues(t, 5, table.ProcessedRows)
+	assert.EqualValues(t, 3, table.MatchingRows)
+	assert.EqualValues(t, 1, table.MismatchedRows)
+	assert.EqualValues(t, 1, table.ExtraRowsSource)
+	assert.EqualValues(t, 0, table.ExtraRowsTarget)
+	assert.Contains(t, table.Report, `"id":"3 (INT64)"`)
+
+	// The streams were synchronized with the source snapshot, and restarted.
+	assert.Equal(t, fmt.Sprintf(sqlSyncWorkflowStream, encodeString(testSourceGtid), 1), env.vre.queries[1])
+	assert.Equal(t, fmt.Sprintf(sqlStartWorkflowStreams, encodeString(testDBName), encodeString(testWorkflow)), env.vre.lastQuery())
+
+	// Resuming a completed vdiff only compares the new rows.
+	env.source.setRows("1|a", "2|b", "3|c", "4|d", "5|e", "6|f")
+	env.target.setRows("1|a", "2|b", "3|x", "4|d", "6|f")
+	vdiffAction(t, ResumeAction, testUUID)
+	waitForState(t, testUUID, CompletedState)
+
+	table = showTable(t, testUUID)
+	assert.Equal(t, "id=6", table.Lastpk)
+	assert.EqualValues(t, 6, table.ProcessedRows)
+	assert.EqualValues(t, 4, table.MatchingRows)
+	assert.EqualValues(t, 1, table.MismatchedRows)
+	assert.EqualValues(t, 1, table.ExtraRowsSource)
+
+	// Resuming it again without new rows compares nothing, twice.
+	for i := 0; i < 2; i++ {
+		vdiffAction(t, ResumeAction, testUUID)
+		waitForState(t, testUUID, CompletedState)
+
+		table = showTable(t, testUUID)
+		assert.Equal(t, "id=6", table.Lastpk)
+		assert.EqualValues(t, 6, table.ProcessedRows)
+		assert.EqualValues(t, 4, table.MatchingRows)
+		assert.EqualValues(t, 1, table.MismatchedRows)
+		assert.EqualValues(t, 1, table.ExtraRowsSource)
+	}
+
+	vdiffAction(t, DeleteAction, testUUID)
+	assert.Empty(t, vdiffAction(t, ShowAction, "").Vdiffs)
+}
+
+func TestVDiffStopResume(t *testing.T) {
+	newTestEnv(t)
+	env.source.setRows("1|a", "2|b", "3|c", "4|d", "5|e")
+	env.target.setRows("1|a", "2|b", "3|c", "4|d", "5|e")
+	env.source.blockAfter = 2
+
+	vdiffAction(t, CreateAction, testUUID)
+	waitForState(t, testUUID, StartedState)
+	require.Eventually(t, func() bool {
+		env.db.mu.Lock()
+		defer env.db.mu.Unlock()
+		vt := env.db.tables[1]["t1"]
+		return vt != nil && vt.processed == 2
+	}, 10*time.Second, time.Millisecond)
+
+	resp := vdiffAction(t, StopAction, testUUID)
+	assert.Equal(t, StoppedState, resp.Vdiffs[0].State)
+	table := showTable(t, testUUID)
+	assert.Equal(t, StartedState, table.State)
+	assert.Equal(t, "id=2", table.Lastpk)
+
+	// A stopped vdiff can't be stopped again.
+	_, err := env.vde.PerformVDiffAction(context.Background(), &tabletmanagerdatapb.VDiffRequest{
+		Workflow:  testWorkflow,
+		Action:    StopAction,
+		VdiffUuid: testUUID,
+	})
+	assert.Equal(t, vtrpcpb.Code_FAILED_PRECONDITION, vterrors.Code(err))
+
+	// The vdiff continues from the last compared row.
+	env.source.mu.Lock()
+	env.source.blockAfter = 0
+	env.source.mu.Unlock()
+	vdiffAction(t, ResumeAction, testUUID)
+	waitForState(t, testUUID, CompletedState)
+	table = showTable(t, testUUID)
+	assert.Equal(t, "id=5", table.Lastpk)
+	assert.EqualValues(t, 5, table.ProcessedRows)
+	assert.EqualValues(t, 5, table.MatchingRows)
+}
+
+func TestVDiffMaxRows(t *testing.T) {
+	newTestEnv(t)
+	env.source.setRows("1|a", "2|b", "3|c", "4|d", "5|e")
+	env.target.setRows("1|a", "2|b", "3|c", "4|d", "5|e")
+
+	_, err := env.vde.PerformVDiffAction(context.Background(), &tabletmanagerdatapb.VDiffRequest{
+		Workflow:  testWorkflow,
+		Action:    CreateAction,
+		VdiffUuid: testUUID,
+		Options:   &tabletmanagerdatapb.VDiffOptions{MaxRows: 3},
+	})
+	require.NoError(t, err)
+	waitForState(t, testUUID, CompletedState)
+	table := showTable(t, testUUID)
+	assert.Equal(t, "id=3", table.Lastpk)
+	assert.EqualValues(t, 3, table.ProcessedRows)
+
+	// Each run compares at most 3 rows.
+	vdiffAction(t, ResumeAction, testUUID)
+	waitForState(t, testUUID, CompletedState)
+	table = showTable(t, testUUID)
+	assert.Equal(t, "id=5", table.Lastpk)
+	assert.EqualValues(t, 5, table.MatchingRows)
+}
+
+func TestVDiffError(t *testing.T) {
+	newTestEnv(t)
+	env.source.setRows("1|a")
+	env.target.setRows("1|a")
+	env.db.streams[1].pos = ""
+
+	vdiffAction(t, CreateAction, testUUID)
+	waitForState(t, testUUID, ErrorState)
+	resp := vdiffAction(t, ShowAction, testUUID)
+	assert.Contains(t, resp.Vdiffs[0].LastError, "stream 1 has not started")
+	// The streams are restarted.
+	assert.Equal(t, fmt.Sprintf(sqlStartWorkflowStreams, encodeString(testDBName), encodeString(testWorkflow)), env.vre.lastQuery())
+
+	// A failed vdiff can be resumed.
+	env.db.mu.Lock()
+	env.db.streams[1].pos = testStreamPosition
+	env.db.mu.Unlock()
+	vdiffAction(t, ResumeAction, testUUID)
+	waitForState(t, testUUID, CompletedState)
+}
+
+func TestEngineOpenResumesVDiffs(t *testing.T) {
+	newTestEnv(t)
+	env.source.setRows("1|a", "2|b")
+	env.target.setRows("1|a", "2|b")
+	env.vde.Close()
+
+	env.db.vdiffs[1] = &fakeVDiff{
+		id:       1,
+		uuid:     testUUID,
+		workflow: testWorkflow,
+		keyspace: "target",
+		shard:    "0",
+		dbName:   testDBName,
+		state:    StartedState,
+		options:  "{}",
+	}
+	env.db.nextID = 2
+	env.vde.Open(context.Background())
+	waitForState(t, testUUID, CompletedState)
+	assert.EqualValues(t, 2, showTable(t, testUUID).MatchingRows)
+}
+
+func TestPerformVDiffActionErrors(t *testing.T) {
+	newTestEnv(t)
+	ctx := context.Background()
+
+	testcases := []struct {
+		name string
+		req  *tabletmanagerdatapb.VDiffRequest
+		code vtrpcpb.Code
+		err  string
+	}{{
+		name: "no workflow",
+		req:  &tabletmanagerdatapb.VDiffRequest{Action: ShowAction},
+		code: vtrpcpb.Code_INVALID_ARGUMENT,
+		err:  "workflow is required",
+	}, {
+		name: "no uuid",
+		req:  &tabletmanagerdatapb.VDiffRequest{Workflow: testWorkflow, Action: StopAction},
+		code: vtrpcpb.Code_INVALID_ARGUMENT,
+		err:  "the vdiff uuid is required for the stop action",
+	}, {
+		name: "unknown action",
+		req:  &tabletmanagerdatapb.VDiffRequest{Workflow: testWorkflow, Action: "restart", VdiffUuid: testUUID},
+		code: vtrpcpb.Code_INVALID_ARGUMENT,
+		err:  "unknown vdiff action: restart",
+	}, {
+		name: "unknown workflow",
+		req:  &tabletmanagerdatapb.VDiffRequest{Workflow: "wf2", Action: CreateAction, VdiffUuid: testUUID},
+		code: vtrpcpb.Code_NOT_FOUND,
+		err:  "workflow wf2 not found",
+	}, {
+		name: "unknown vdiff",
+		req:  &tabletmanagerdatapb.VDiffRequest{Workflow: testWorkflow, Action: ResumeAction, VdiffUuid: testUUID},
+		code: vtrpcpb.Code_NOT_FOUND,
+		err:  "vdiff " + testUUID + " of workflow wf1 not found",
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := env.vde.PerformVDiffAction(ctx, tc.req)
+			require.Error(t, err)
+			assert.Equal(t, tc.code, vterrors.Code(err))
+			assert.Contains(t, err.Error(), tc.err)
+		})
+	}
+
+	env.vde.Close()
+	_, err := env.vde.PerformVDiffAction(ctx, &tabletmanagerdatapb.VDiffRequest{Workflow: testWorkflow, Action: ShowAction})
+	assert.Equal(t, vtrpcpb.Code_UNAVAILABLE, vterrors.Code(err))
+}
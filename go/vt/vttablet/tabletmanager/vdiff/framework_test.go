@@ -0,0 +1,638 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/mysqlctl/fakemysqldaemon"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/queryservice/fakes"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+const (
+	testWorkflow = "wf1"
+	testDBName   = "vt_target"
+	// testStreamPosition is the position of the stream of the workflow.
+	testStreamPosition = "MariaDB/5-456-892"
+	// testSourceGtid is the position of the snapshots of the source.
+	testSourceGtid = "MariaDB/5-456-893"
+)
+
+var testFields = sqltypes.MakeTestFields("id|val", "int64|varchar")
+
+// testEnv is a target primary with one vreplication stream of workflow wf1,
+// which copies table t1 from a source replica. The tables of the vdiff
+// engine are emulated by fakeDB.
+type testEnv struct {
+	ts     *topo.Server
+	db     *fakeDB
+	vre    *fakeVReplication
+	vde    *Engine
+	source *fakeTablet
+	target *fakeTablet
+
+	mu      sync.Mutex
+	tablets map[uint32]*fakeTablet
+}
+
+// env has to be a global for RegisterDialer to work.
+var env *testEnv
+
+func init() {
+	tabletconn.RegisterDialer("VDiffEngineTest", func(tablet *topodatapb.Tablet, failFast grpcclient.FailFast) (queryservice.QueryService, error) {
+		env.mu.Lock()
+		defer env.mu.Unlock()
+		if qs, ok := env.tablets[tablet.Alias.Uid]; ok {
+			return qs, nil
+		}
+		return nil, fmt.Errorf("tablet %d not found", tablet.Alias.Uid)
+	})
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+	flag.Set("tablet_protocol", "VDiffEngineTest")
+	saved := progressInterval
+	progressInterval = 0
+	t.Cleanup(func() { progressInterval = saved })
+
+	ctx := context.Background()
+	env = &testEnv{
+		ts:      memorytopo.NewServer("cell1"),
+		db:      newFakeDB(),
+		vre:     &fakeVReplication{},
+		tablets: make(map[uint32]*fakeTablet),
+	}
+	env.vre.db = env.db
+	sourceTablet := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: 100},
+		Keyspace: "source",
+		Shard:    "0",
+		Type:     topodatapb.TabletType_REPLICA,
+		PortMap:  map[string]int32{"test": 100},
+	}
+	targetTablet := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: 200},
+		Keyspace: "target",
+		Shard:    "0",
+		Type:     topodatapb.TabletType_PRIMARY,
+		PortMap:  map[string]int32{"test": 200},
+	}
+	for _, tablet := range []*topodatapb.Tablet{sourceTablet, targetTablet} {
+		require.NoError(t, env.ts.InitTablet(ctx, tablet, false /* allowPrimaryOverride */, true /* createShardAndKeyspace */, false /* allowUpdate */))
+	}
+	env.source = newFakeTablet(sourceTablet)
+	env.target = newFakeTablet(targetTablet)
+	env.tablets[100] = env.source
+	env.tablets[200] = env.target
+
+	bls := &binlogdatapb.BinlogSource{
+		Keyspace: "source",
+		Shard:    "0",
+		Filter: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{Match: "t1"}},
+		},
+	}
+	env.db.streams[1] = &fakeStream{workflow: testWorkflow, source: prototext.Format(bls), pos: testStreamPosition}
+
+	mysqld := fakemysqldaemon.NewFakeMysqlDaemon(nil)
+	mysqld.Schema = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:              "t1",
+			Columns:           []string{"id", "val"},
+			PrimaryKeyColumns: []string{"id"},
+			Fields:            testFields,
+		}},
+	}
+	dbClientFactory := func() binlogplayer.DBClient { return env.db }
+	env.vde = NewTestEngine(env.ts, targetTablet, mysqld, env.vre, dbClientFactory, &fakeTMClient{}, testDBName)
+	env.vde.Open(ctx)
+	t.Cleanup(env.vde.Close)
+	return env
+}
+
+// setRows sets the rows of t1 on the tablet, as id and val pairs.
+func (ft *fakeTablet) setRows(rows ...string) {
+	ft.mu.Lock()
+	defer ft.mu.Unlock()
+	ft.rows = ft.rows[:0]
+	for _, row := range rows {
+		parts := strings.SplitN(row, "|", 2)
+		id, _ := strconv.ParseInt(parts[0], 10, 64)
+		ft.rows = append(ft.rows, []sqltypes.Value{sqltypes.NewInt64(id), sqltypes.NewVarChar(parts[1])})
+	}
+}
+
+//----------------------------------------------
+// fakeTablet
+
+// fakeTablet streams the rows of t1 with VStreamRows.
+type fakeTablet struct {
+	queryservice.QueryService
+	tablet *topodatapb.Tablet
+
+	mu   sync.Mutex
+	rows [][]sqltypes.Value
+	// If blockAfter is set, the stream blocks after sending that many rows,
+	// until its context is canceled.
+	blockAfter int
+}
+
+func newFakeTablet(tablet *topodatapb.Tablet) *fakeTablet {
+	return &fakeTablet{
+		QueryService: fakes.ErrorQueryService,
+		tablet:       tablet,
+	}
+}
+
+// VStreamRows is part of the QueryService interface.
+func (ft *fakeTablet) VStreamRows(ctx context.Context, target *querypb.Target, query string, lastpk *querypb.QueryResult, send func(*binlogdatapb.VStreamRowsResponse) error) error {
+	if !strings.Contains(query, "from t1") {
+		return fmt.Errorf("unexpected query: %s", query)
+	}
+	ft.mu.Lock()
+	rows := ft.rows
+	blockAfter := ft.blockAfter
+	ft.mu.Unlock()
+
+	var after int64 = -1
+	if lastpk != nil {
+		after, _ = sqltypes.Proto3ToResult(lastpk).Rows[0][0].ToInt64()
+	}
+	if err := send(&binlogdatapb.VStreamRowsResponse{
+		Fields:   testFields,
+		Pkfields: testFields[:1],
+		Gtid:     testSourceGtid,
+	}); err != nil {
+		return err
+	}
+	sent := 0
+	for _, row := range rows {
+		if id, _ := row[0].ToInt64(); id <= after {
+			continue
+		}
+		if blockAfter > 0 && sent == blockAfter {
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		if err := send(&binlogdatapb.VStreamRowsResponse{Rows: []*querypb.Row{sqltypes.RowToProto3(row)}}); err != nil {
+			return err
+		}
+		sent++
+	}
+	return nil
+}
+
+//----------------------------------------------
+// fakeTMClient
+
+type fakeTMClient struct {
+	tmclient.TabletManagerClient
+}
+
+// WaitForPosition is part of the tmclient.TabletManagerClient interface.
+func (tmc *fakeTMClient) WaitForPosition(ctx context.Context, tablet *topodatapb.Tablet, pos string) error {
+	return nil
+}
+
+// Close is part of the tmclient.TabletManagerClient interface.
+func (tmc *fakeTMClient) Close() {}
+
+//----------------------------------------------
+// fakeVReplication
+
+// fakeVReplication records the queries of the vdiffs, and fast forwards the
+// streams to their stop position.
+type fakeVReplication struct {
+	db *fakeDB
+
+	mu      sync.Mutex
+	queries []string
+}
+
+var reSyncStream = matcher(sqlSyncWorkflowStream)
+
+// Exec is part of the VReplication interface.
+func (vre *fakeVReplication) Exec(query string) (*sqltypes.Result, error) {
+	vre.mu.Lock()
+	vre.queries = append(vre.queries, query)
+	vre.mu.Unlock()
+	if m := reSyncStream.FindStringSubmatch(query); m != nil {
+		id, _ := strconv.Atoi(m[2])
+		vre.db.mu.Lock()
+		vre.db.streams[id].pos = decodeString(m[1])
+		vre.db.mu.Unlock()
+	}
+	return &sqltypes.Result{}, nil
+}
+
+// WaitForPos is part of the VReplication interface.
+func (vre *fakeVReplication) WaitForPos(ctx context.Context, id int, pos string) error {
+	return nil
+}
+
+func (vre *fakeVReplication) lastQuery() string {
+	vre.mu.Lock()
+	defer vre.mu.Unlock()
+	if len(vre.queries) == 0 {
+		return ""
+	}
+	return vre.queries[len(vre.queries)-1]
+}
+
+//----------------------------------------------
+// fakeDB
+
+type fakeVDiff struct {
+	id                                                                            int64
+	uuid, workflow, keyspace, shard, dbName, state, options, lastError, startedAt string
+}
+
+type fakeVDiffTable struct {
+	state, lastpk, report                                          string
+	tableRows, processed, matching, mismatched, extraSrc, extraTgt int64
+}
+
+type fakeStream struct {
+	workflow, source, pos string
+}
+
+// fakeDB emulates the _vt.vdiff and _vt.vdiff_table tables, and the
+// _vt.vreplication streams, for the queries of the vdiff engine.
+type fakeDB struct {
+	mu      sync.Mutex
+	nextID  int64
+	vdiffs  map[int64]*fakeVDiff
+	tables  map[int64]map[string]*fakeVDiffTable
+	streams map[int]*fakeStream
+}
+
+func newFakeDB() *fakeDB {
+	return &fakeDB{
+		nextID:  1,
+		vdiffs:  make(map[int64]*fakeVDiff),
+		tables:  make(map[int64]map[string]*fakeVDiffTable),
+		streams: make(map[int]*fakeStream),
+	}
+}
+
+// matcher converts a query format of schema.go to a regexp which captures
+// its arguments.
+func matcher(format string) *regexp.Regexp {
+	re := regexp.QuoteMeta(format)
+	re = strings.ReplaceAll(re, "%s", `('(?:[^'\\]|\\.)*'|null)`)
+	re = strings.ReplaceAll(re, "%d", `(-?\d+)`)
+	return regexp.MustCompile("^" + re + "$")
+}
+
+// decodeString decodes an argument encoded by encodeString.
+func decodeString(arg string) string {
+	if arg == "null" {
+		return ""
+	}
+	stmt, err := sqlparser.Parse("select " + arg + " from dual")
+	if err != nil {
+		panic(err)
+	}
+	return stmt.(*sqlparser.Select).SelectExprs[0].(*sqlparser.AliasedExpr).Expr.(*sqlparser.Literal).Val
+}
+
+func decodeInt(arg string) int64 {
+	v, _ := strconv.ParseInt(arg, 10, 64)
+	return v
+}
+
+var (
+	vdiffFields = sqltypes.MakeTestFields(
+		"id|vdiff_uuid|workflow|keyspace|shard|state|options|last_error|created_at|started_at|completed_at",
+		"int64|varchar|varbinary|varbinary|varchar|varbinary|json|varbinary|timestamp|timestamp|timestamp")
+	vdiffTableFields = sqltypes.MakeTestFields(
+		"table_name|state|table_rows|lastpk|processed_rows|matching_rows|mismatched_rows|extra_rows_source|extra_rows_target|report",
+		"varbinary|varbinary|int64|varbinary|int64|int64|int64|int64|int64|json")
+	streamFields = sqltypes.MakeTestFields("id|source|pos", "int64|varbinary|varbinary")
+)
+
+func (vd *fakeVDiff) row() []sqltypes.Value {
+	return []sqltypes.Value{
+		sqltypes.NewInt64(vd.id),
+		sqltypes.NewVarChar(vd.uuid),
+		sqltypes.NewVarBinary(vd.workflow),
+		sqltypes.NewVarBinary(vd.keyspace),
+		sqltypes.NewVarChar(vd.shard),
+		sqltypes.NewVarBinary(vd.state),
+		sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(vd.options)),
+		sqltypes.NewVarBinary(vd.lastError),
+		sqltypes.MakeTrusted(sqltypes.Timestamp, []byte("2022-01-01 00:00:00")),
+		sqltypes.MakeTrusted(sqltypes.Timestamp, []byte(vd.startedAt)),
+		sqltypes.NULL,
+	}
+}
+
+func (vt *fakeVDiffTable) row(name string) []sqltypes.Value {
+	lastpk := sqltypes.NULL
+	if vt.lastpk != "" {
+		lastpk = sqltypes.NewVarBinary(vt.lastpk)
+	}
+	report := sqltypes.NULL
+	if vt.report != "" {
+		report = sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(vt.report))
+	}
+	return []sqltypes.Value{
+		sqltypes.NewVarBinary(name),
+		sqltypes.NewVarBinary(vt.state),
+		sqltypes.NewInt64(vt.tableRows),
+		lastpk,
+		sqltypes.NewInt64(vt.processed),
+		sqltypes.NewInt64(vt.matching),
+		sqltypes.NewInt64(vt.mismatched),
+		sqltypes.NewInt64(vt.extraSrc),
+		sqltypes.NewInt64(vt.extraTgt),
+		report,
+	}
+}
+
+type fakeQuery struct {
+	re   *regexp.Regexp
+	exec func(db *fakeDB, args []string) *sqltypes.Result
+}
+
+var fakeQueries = []fakeQuery{{
+	re: matcher(queryToTriggerWithDDL),
+	exec: func(db *fakeDB, args []string) *sqltypes.Result {
+		return &sqltypes.Result{}
+	},
+}, {
+	re: matcher(sqlNewVDiff),
+	exec: func(db *fakeDB, args []string) *sqltypes.Result {
+		vd := &fakeVDiff{
+			id:       db.nextID,
+			uuid:     decodeString(args[0]),
+			workflow: decodeString(args[1]),
+			keyspace: decodeString(args[2]),
+			shard:    decodeString(args[3]),
+			dbName:   decodeString(args[4]),
+			state:    decodeString(args[5]),
+			options:  decodeString(args[6]),
+		}
+		db.vdiffs[vd.id] = vd
+		db.nextID++
+		return &sqltypes.Result{RowsAffected: 1, InsertID: uint64(vd.id)}
+	},
+}, {
+	re: matcher(sqlGetVDiffByUUID),
+	exec: func(db *fakeDB, args []string) *sqltypes.Result {
+		return db.selectVDiffs(func(vd *fakeVDiff) bool {
+			return vd.dbName == decodeString(args[0]) && vd.workflow == decodeString(args[1]) && vd.uuid == decodeString(args[2])
+		})
+	},
+}, {
+	re: matcher(sqlGetVDiffs),
+	exec: func(db *fakeDB, args []string) *sqltypes.Result {
+		return db.selectVDiffs(func(vd *fakeVDiff) bool {
+			return vd.dbName == decodeString(args[0]) && vd.workflow == decodeString(args[1])
+		})
+	},
+}, {
+	re: matcher(sqlGetRunnableVDiffs),
+	exec: func(db *fakeDB, args []string) *sqltypes.Result {
+		return db.selectVDiffs(func(vd *fakeVDiff) bool {
+			return vd.dbName == decodeString(args[0]) && (vd.state == PendingState || vd.state == StartedState)
+		})
+	},
+}, {
+	re: matcher(sqlStartVDiff),
+	exec: func(db *fakeDB, args []string) *sqltypes.Result {
+		vd := db.vdiffs[decodeInt(args[0])]
+		vd.state, vd.lastError, vd.startedAt = StartedState, "", "2022-01-01 00:00:01"
+		return &sqltypes.Result{RowsAffected: 1}
+	},
+}, {
+	re: matcher(sqlCompleteVDiff),
+	exec: func(db *fakeDB, args []string) *sqltypes.Result {
+		db.vdiffs[decodeInt(args[0])].state = CompletedState
+		return &sqltypes.Result{RowsAffected: 1}
+	},
+}, {
+	re: matcher(sqlUpdateVDiffState),
+	exec: func(db *fakeDB, args []string) *sqltypes.Result {
+		vd := db.vdiffs[decodeInt(args[2])]
+		vd.state, vd.lastError = decodeString(args[0]), decodeString(args[1])
+		return &sqltypes.Result{RowsAffected: 1}
+	},
+}, {
+	re: matcher(sqlDeleteVDiff),
+	exec: func(db *fakeDB, args []string) *sqltypes.Result {
+		delete(db.vdiffs, decodeInt(args[0]))
+		return &sqltypes.Result{RowsAffected: 1}
+	},
+}, {
+	re: matcher(sqlNewVDiffTable),
+	exec: func(db *fakeDB, args []string) *sqltypes.Result {
+		id, name := decodeInt(args[0]), decodeString(args[1])
+		if db.tables[id] == nil {
+			db.tables[id] = make(map[string]*fakeVDiffTable)
+		}
+		if db.tables[id][name] == nil {
+			db.tables[id][name] = &fakeVDiffTable{state: PendingState}
+		}
+		return &sqltypes.Result{}
+	},
+}, {
+	re: matcher(sqlGetVDiffTable),
+	exec: func(db *fakeDB, args []string) *sqltypes.Result {
+		qr := &sqltypes.Result{Fields: sqltypes.MakeTestFields("state|lastpk|report", "varbinary|varbinary|json")}
+		if vt := db.tables[decodeInt(args[0])][decodeString(args[1])]; vt != nil {
+			row := vt.row("")
+			qr.Rows = append(qr.Rows, []sqltypes.Value{row[1], row[3], row[9]})
+		}
+		return qr
+	},
+}, {
+	re: matcher(sqlGetVDiffTables),
+	exec: func(db *fakeDB, args []string) *sqltypes.Result {
+		tables := db.tables[decodeInt(args[0])]
+		names := make([]string, 0, len(tables))
+		for name := range tables {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		qr := &sqltypes.Result{Fields: vdiffTableFields}
+		for _, name := range names {
+			qr.Rows = append(qr.Rows, tables[name].row(name))
+		}
+		return qr
+	},
+}, {
+	re: matcher(sqlStartVDiffTable),
+	exec: func(db *fakeDB, args []string) *sqltypes.Result {
+		vt := db.tables[decodeInt(args[1])][decodeString(args[2])]
+		vt.state, vt.tableRows = StartedState, decodeInt(args[0])
+		return &sqltypes.Result{RowsAffected: 1}
+	},
+}, {
+	re: matcher(sqlUpdateVDiffTable),
+	exec: func(db *fakeDB, args []string) *sqltypes.Result {
+		vt := db.tables[decodeInt(args[8])][decodeString(args[9])]
+		vt.state = decodeString(args[0])
+		vt.lastpk = decodeString(args[1])
+		vt.processed, vt.matching, vt.mismatched = decodeInt(args[2]), decodeInt(args[3]), decodeInt(args[4])
+		vt.extraSrc, vt.extraTgt = decodeInt(args[5]), decodeInt(args[6])
+		vt.report = decodeString(args[7])
+		return &sqltypes.Result{RowsAffected: 1}
+	},
+}, {
+	re: matcher(sqlResetVDiffTables),
+	exec: func(db *fakeDB, args []string) *sqltypes.Result {
+		for _, vt := range db.tables[decodeInt(args[0])] {
+			vt.state = PendingState
+		}
+		return &sqltypes.Result{}
+	},
+}, {
+	re: matcher(sqlDeleteVDiffTables),
+	exec: func(db *fakeDB, args []string) *sqltypes.Result {
+		delete(db.tables, decodeInt(args[0]))
+		return &sqltypes.Result{}
+	},
+}, {
+	re: matcher(sqlGetTableRows),
+	exec: func(db *fakeDB, args []string) *sqltypes.Result {
+		return sqltypes.MakeTestResult(sqltypes.MakeTestFields("table_rows", "int64"), "5")
+	},
+}, {
+	re: matcher(sqlGetWorkflowStreams),
+	exec: func(db *fakeDB, args []string) *sqltypes.Result {
+		qr := &sqltypes.Result{Fields: streamFields}
+		ids := make([]int, 0, len(db.streams))
+		for id := range db.streams {
+			ids = append(ids, id)
+		}
+		sort.Ints(ids)
+		for _, id := range ids {
+			stream := db.streams[id]
+			if decodeString(args[0]) != testDBName || decodeString(args[1]) != stream.workflow {
+				continue
+			}
+			qr.Rows = append(qr.Rows, []sqltypes.Value{
+				sqltypes.NewInt64(int64(id)),
+				sqltypes.NewVarBinary(stream.source),
+				sqltypes.NewVarBinary(stream.pos),
+			})
+		}
+		return qr
+	},
+}}
+
+func (db *fakeDB) selectVDiffs(match func(vd *fakeVDiff) bool) *sqltypes.Result {
+	ids := make([]int64, 0, len(db.vdiffs))
+	for id := range db.vdiffs {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	qr := &sqltypes.Result{Fields: vdiffFields}
+	for _, id := range ids {
+		if vd := db.vdiffs[id]; match(vd) {
+			qr.Rows = append(qr.Rows, vd.row())
+		}
+	}
+	return qr
+}
+
+// DBName is part of the binlogplayer.DBClient interface.
+func (db *fakeDB) DBName() string {
+	return testDBName
+}
+
+// Connect is part of the binlogplayer.DBClient interface.
+func (db *fakeDB) Connect() error {
+	return nil
+}
+
+// Begin is part of the binlogplayer.DBClient interface.
+func (db *fakeDB) Begin() error {
+	return nil
+}
+
+// Commit is part of the binlogplayer.DBClient interface.
+func (db *fakeDB) Commit() error {
+	return nil
+}
+
+// Rollback is part of the binlogplayer.DBClient interface.
+func (db *fakeDB) Rollback() error {
+	return nil
+}
+
+// Close is part of the binlogplayer.DBClient interface.
+func (db *fakeDB) Close() {}
+
+// ExecuteFetch is part of the binlogplayer.DBClient interface.
+func (db *fakeDB) ExecuteFetch(query string, maxrows int) (*sqltypes.Result, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	for _, fq := range fakeQueries {
+		if m := fq.re.FindStringSubmatch(query); m != nil {
+			return fq.exec(db, m[1:]), nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected query: %s", query)
+}
+
+func (db *fakeDB) vdiffState(uuid string) string {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	for _, vd := range db.vdiffs {
+		if vd.uuid == uuid {
+			return vd.state
+		}
+	}
+	return ""
+}
+
+// waitForState waits for the vdiff to reach the state.
+func waitForState(t *testing.T, uuid, state string) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		return env.db.vdiffState(uuid) == state
+	}, 10*time.Second, time.Millisecond, "vdiff %s did not reach state %s", uuid, state)
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"vitess.io/vitess/go/vt/withddl"
+)
+
+const (
+	createVDiffTable = `create table if not exists _vt.vdiff (
+  id bigint auto_increment,
+  vdiff_uuid varchar(64) not null,
+  workflow varbinary(1000),
+  keyspace varbinary(256),
+  shard varchar(255) not null,
+  db_name varbinary(255),
+  state varbinary(64),
+  options json,
+  created_at timestamp not null default current_timestamp,
+  started_at timestamp null,
+  completed_at timestamp null,
+  last_error varbinary(512),
+  primary key (id),
+  unique key uuid_idx (vdiff_uuid),
+  key workflow_idx (db_name, workflow))`
+
+	createVDiffTableTable = `create table if not exists _vt.vdiff_table (
+  vdiff_id bigint not null,
+  table_name varbinary(128) not null,
+  state varbinary(64),
+  lastpk varbinary(2000),
+  table_rows bigint not null default 0,
+  processed_rows bigint not null default 0,
+  matching_rows bigint not null default 0,
+  mismatched_rows bigint not null default 0,
+  extra_rows_source bigint not null default 0,
+  extra_rows_target bigint not null default 0,
+  report json,
+  created_at timestamp not null default current_timestamp,
+  updated_at timestamp not null default current_timestamp on update current_timestamp,
+  primary key (vdiff_id, table_name))`
+
+	// queryToTriggerWithDDL fails on purpose, which makes withDDL create the
+	// tables that are missing.
+	queryToTriggerWithDDL = "select _vt_no_such_column__init_schema from _vt.vdiff limit 1"
+)
+
+var withDDL = withddl.New([]string{
+	"create database if not exists _vt",
+	createVDiffTable,
+	createVDiffTableTable,
+})
+
+const (
+	sqlNewVDiff          = "insert into _vt.vdiff(vdiff_uuid, workflow, keyspace, shard, db_name, state, options) values(%s, %s, %s, %s, %s, %s, %s)"
+	sqlGetVDiffByUUID    = "select id, vdiff_uuid, workflow, keyspace, shard, state, options, last_error, created_at, started_at, completed_at from _vt.vdiff where db_name = %s and workflow = %s and vdiff_uuid = %s"
+	sqlGetVDiffs         = "select id, vdiff_uuid, workflow, keyspace, shard, state, options, last_error, created_at, started_at, completed_at from _vt.vdiff where db_name = %s and workflow = %s order by id"
+	sqlGetRunnableVDiffs = "select id, vdiff_uuid, workflow, keyspace, shard, state, options from _vt.vdiff where db_name = %s and state in ('pending', 'started') order by id"
+	sqlStartVDiff        = "update _vt.vdiff set state = 'started', started_at = now(), completed_at = null, last_error = '' where id = %d"
+	sqlCompleteVDiff     = "update _vt.vdiff set state = 'completed', completed_at = now() where id = %d"
+	sqlUpdateVDiffState  = "update _vt.vdiff set state = %s, last_error = %s where id = %d"
+	sqlDeleteVDiff       = "delete from _vt.vdiff where id = %d"
+
+	sqlNewVDiffTable        = "insert ignore into _vt.vdiff_table(vdiff_id, table_name, state) values(%d, %s, 'pending')"
+	sqlGetVDiffTable        = "select state, lastpk, report from _vt.vdiff_table where vdiff_id = %d and table_name = %s"
+	sqlGetVDiffTables       = "select table_name, state, table_rows, lastpk, processed_rows, matching_rows, mismatched_rows, extra_rows_source, extra_rows_target, report from _vt.vdiff_table where vdiff_id = %d order by table_name"
+	sqlStartVDiffTable      = "update _vt.vdiff_table set state = 'started', table_rows = %d where vdiff_id = %d and table_name = %s"
+	sqlUpdateVDiffTable     = "update _vt.vdiff_table set state = %s, lastpk = %s, processed_rows = %d, matching_rows = %d, mismatched_rows = %d, extra_rows_source = %d, extra_rows_target = %d, report = %s where vdiff_id = %d and table_name = %s"
+	sqlResetVDiffTables     = "update _vt.vdiff_table set state = 'pending' where vdiff_id = %d"
+	sqlDeleteVDiffTables    = "delete from _vt.vdiff_table where vdiff_id = %d"
+	sqlGetTableRows         = "select table_rows from information_schema.tables where table_schema = %s and table_name = %s"
+	sqlGetWorkflowStreams   = "select id, source, pos from _vt.vreplication where db_name = %s and workflow = %s"
+	sqlStopWorkflowStreams  = "update _vt.vreplication set state = 'Stopped', message = 'for vdiff' where db_name = %s and workflow = %s"
+	sqlSyncWorkflowStream   = "update _vt.vreplication set state = 'Running', stop_pos = %s, message = 'synchronizing for vdiff' where id = %d"
+	sqlStartWorkflowStreams = "update _vt.vreplication set state = 'Running', message = '', stop_pos = '' where db_name = %s and workflow = %s"
+)
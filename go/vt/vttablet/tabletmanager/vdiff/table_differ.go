@@ -0,0 +1,611 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// At most how many samples we should keep for row differences in the report.
+const maxVDiffReportSampleRows = 10
+
+// DiffReport is the summary of differences for one table. It is saved in
+// _vt.vdiff_table, and accumulates the results of all the runs of a vdiff.
+type DiffReport struct {
+	TableName            string
+	ProcessedRows        int64
+	MatchingRows         int64
+	MismatchedRows       int64
+	ExtraRowsSource      int64
+	ExtraRowsSourceDiffs []*RowDiff
+	ExtraRowsTarget      int64
+	ExtraRowsTargetDiffs []*RowDiff
+	MismatchedRowsSample []*DiffMismatch
+
+	// lastpk has the primary key of the last compared row.
+	lastpk *querypb.QueryResult
+}
+
+// DiffMismatch is a sample of row diffs between source and target.
+type DiffMismatch struct {
+	Source *RowDiff
+	Target *RowDiff
+}
+
+// RowDiff is a row that didn't match as part of the comparison. The values
+// of the row are formatted with their type.
+type RowDiff struct {
+	Row   map[string]string
+	Query string `json:",omitempty"`
+}
+
+// compareColInfo contains the metadata for a column of the table being diffed
+type compareColInfo struct {
+	colIndex  int                  // index of the column in the filter's select
+	collation collations.Collation // is the collation of the column, if any
+	isPK      bool                 // is this column part of the primary key
+}
+
+// tableDiffer performs a diff for one table in the workflow.
+type tableDiffer struct {
+	table *tabletmanagerdatapb.TableDefinition
+	// sourceExpression and targetExpression are the queries streamed by
+	// VStreamRows, which returns the rows in primary key order.
+	sourceExpression string
+	targetExpression string
+
+	// compareCols is the list of columns to compare.
+	compareCols []compareColInfo
+	// comparePKs is the list of pk columns to compare. The logic
+	// for comparing pk columns is different from compareCols
+	comparePKs []compareColInfo
+	// pkFields are the fields of the primary key, in the order of comparePKs.
+	pkFields []*querypb.Field
+
+	// selectPks is the list of pk columns as they appear in the select clause for the diff.
+	selectPks []int
+
+	// sourcePrimitive and targetPrimitive are used for streaming
+	sourcePrimitive engine.Primitive
+	targetPrimitive engine.Primitive
+}
+
+// buildTableDiffers builds the differs of the tables of the workflow,
+// sorted by table name.
+func buildTableDiffers(filter *binlogdatapb.Filter, schm *tabletmanagerdatapb.SchemaDefinition, options *tabletmanagerdatapb.VDiffOptions) ([]*tableDiffer, error) {
+	include := make(map[string]bool)
+	for _, table := range options.Tables {
+		include[table] = true
+	}
+	differs := make(map[string]*tableDiffer)
+	for _, table := range schm.TableDefinitions {
+		if schema.IsInternalOperationTableName(table.Name) {
+			continue
+		}
+		if len(include) > 0 && !include[table.Name] {
+			continue
+		}
+		rule, err := vreplication.MatchTable(table.Name, filter)
+		if err != nil {
+			return nil, err
+		}
+		if rule == nil || rule.Filter == "exclude" {
+			continue
+		}
+		if differs[table.Name], err = buildTablePlan(table, rule.Filter); err != nil {
+			return nil, vterrors.Wrapf(err, "table %s", table.Name)
+		}
+	}
+	for table := range include {
+		if differs[table] == nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "table %s is not present in the workflow", table)
+		}
+	}
+	return sortedTableDiffers(differs), nil
+}
+
+// buildTablePlan builds one tableDiffer. The source query is the filter of
+// the workflow, including its in_keyrange expression, which makes the
+// sources only stream the rows of this target shard.
+func buildTablePlan(table *tabletmanagerdatapb.TableDefinition, filter string) (*tableDiffer, error) {
+	query := filter
+	if filter == "" || key.IsKeyRange(filter) {
+		buf := sqlparser.NewTrackedBuffer(nil)
+		buf.Myprintf("select * from %v", sqlparser.NewTableIdent(table.Name))
+		if filter != "" {
+			buf.Myprintf(" where in_keyrange(%v)", sqlparser.NewStrLiteral(filter))
+		}
+		query = buf.String()
+	}
+	statement, err := sqlparser.Parse(query)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := statement.(*sqlparser.Select)
+	if !ok {
+		return nil, fmt.Errorf("unexpected: %v", sqlparser.String(statement))
+	}
+	if sel.GroupBy != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "vdiff of filters with group by is not supported: %v", sqlparser.String(sel))
+	}
+	td := &tableDiffer{
+		table: table,
+	}
+	sourceSelect := &sqlparser.Select{}
+	targetSelect := &sqlparser.Select{}
+	for _, selExpr := range sel.SelectExprs {
+		switch selExpr := selExpr.(type) {
+		case *sqlparser.StarExpr:
+			// If it's a '*' expression, expand column list from the schema.
+			for _, fld := range table.Fields {
+				aliased := &sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent(fld.Name)}}
+				sourceSelect.SelectExprs = append(sourceSelect.SelectExprs, aliased)
+				targetSelect.SelectExprs = append(targetSelect.SelectExprs, aliased)
+			}
+		case *sqlparser.AliasedExpr:
+			if sqlparser.IsAggregation(selExpr.Expr) {
+				return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "vdiff of aggregates is not supported: %v", sqlparser.String(selExpr))
+			}
+			var targetCol *sqlparser.ColName
+			if !selExpr.As.IsEmpty() {
+				targetCol = &sqlparser.ColName{Name: selExpr.As}
+			} else {
+				if colAs, ok := selExpr.Expr.(*sqlparser.ColName); ok {
+					targetCol = colAs
+				} else {
+					return nil, fmt.Errorf("expression needs an alias: %v", sqlparser.String(selExpr))
+				}
+			}
+			// If the input was "select a as b", then source will use "a" and target will use "b".
+			sourceSelect.SelectExprs = append(sourceSelect.SelectExprs, selExpr)
+			targetSelect.SelectExprs = append(targetSelect.SelectExprs, &sqlparser.AliasedExpr{Expr: targetCol})
+		default:
+			return nil, fmt.Errorf("unexpected: %v", sqlparser.String(statement))
+		}
+	}
+	fields := make(map[string]*querypb.Field)
+	for _, field := range table.Fields {
+		fields[strings.ToLower(field.Name)] = field
+	}
+
+	// Start with adding all columns for comparison.
+	td.compareCols = make([]compareColInfo, len(sourceSelect.SelectExprs))
+	for i := range td.compareCols {
+		td.compareCols[i].colIndex = i
+		colname := targetSelect.SelectExprs[i].(*sqlparser.AliasedExpr).Expr.(*sqlparser.ColName).Name.Lowered()
+		if _, ok := fields[colname]; !ok {
+			return nil, fmt.Errorf("column %v not found in table %v", colname, table.Name)
+		}
+	}
+	if err := findPKs(table, targetSelect, fields, td); err != nil {
+		return nil, err
+	}
+
+	sourceSelect.From = sel.From
+	sourceSelect.Where = sel.Where
+	// The target table name should the one that matched the rule.
+	// It can be different from the source table.
+	targetSelect.From = sqlparser.TableExprs{
+		&sqlparser.AliasedTableExpr{
+			Expr: &sqlparser.TableName{
+				Name: sqlparser.NewTableIdent(table.Name),
+			},
+		},
+	}
+	td.sourceExpression = sqlparser.String(sourceSelect)
+	td.targetExpression = sqlparser.String(targetSelect)
+	return td, nil
+}
+
+// findPKs identifies the PK columns in the select list of the target.
+func findPKs(table *tabletmanagerdatapb.TableDefinition, targetSelect *sqlparser.Select, fields map[string]*querypb.Field, td *tableDiffer) error {
+	for _, pk := range table.PrimaryKeyColumns {
+		found := false
+		for i, selExpr := range targetSelect.SelectExprs {
+			colname := selExpr.(*sqlparser.AliasedExpr).Expr.(*sqlparser.ColName).Name.String()
+			if strings.EqualFold(pk, colname) {
+				td.compareCols[i].isPK = true
+				td.comparePKs = append(td.comparePKs, td.compareCols[i])
+				td.selectPks = append(td.selectPks, i)
+				td.pkFields = append(td.pkFields, fields[strings.ToLower(pk)])
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("primary key column %v is not selected by the filter of table %v", pk, table.Name)
+		}
+	}
+	return nil
+}
+
+// newMergeSorter creates an engine.MergeSort based on the shard streamers and pk columns.
+func newMergeSorter(participants map[string]*shardStreamer, comparePKs []compareColInfo) *engine.MergeSort {
+	prims := make([]engine.StreamExecutor, 0, len(participants))
+	for _, participant := range participants {
+		prims = append(prims, participant)
+	}
+	ob := make([]engine.OrderByParams, 0, len(comparePKs))
+	for _, cpk := range comparePKs {
+		// if the collation is nil or unknown, use binary collation to compare as bytes
+		collationID := collations.ID(collations.CollationBinaryID)
+		if cpk.collation != nil {
+			collationID = cpk.collation.ID()
+		}
+		ob = append(ob, engine.OrderByParams{Col: cpk.colIndex, WeightStringCol: -1, CollationID: collationID})
+	}
+	return &engine.MergeSort{
+		Primitives: prims,
+		OrderBy:    ob,
+	}
+}
+
+// diff compares the streams of the sources and of the target, and adds the
+// results to dr. It saves the progress every progressInterval, and when
+// the table is completed.
+func (td *tableDiffer) diff(ctx context.Context, dr *DiffReport, options *tabletmanagerdatapb.VDiffOptions, save func(state string) error) error {
+	sourceExecutor := newPrimitiveExecutor(ctx, td.sourcePrimitive)
+	targetExecutor := newPrimitiveExecutor(ctx, td.targetPrimitive)
+	maxExtraRowsToCompare := options.MaxExtraRowsToCompare
+	if maxExtraRowsToCompare <= 0 {
+		maxExtraRowsToCompare = defaultMaxExtraRowsToCompare
+	}
+	var sourceRow, targetRow []sqltypes.Value
+	var err error
+	var rowsCompared int64
+	lastSave := time.Now()
+	advanceSource := true
+	advanceTarget := true
+	for {
+		if time.Since(lastSave) >= progressInterval {
+			if err := save(StartedState); err != nil {
+				return err
+			}
+			lastSave = time.Now()
+		}
+		if options.MaxRows > 0 && rowsCompared >= options.MaxRows {
+			log.Infof("Stopping the diff of table %s, specified limit reached", td.table.Name)
+			break
+		}
+		if advanceSource {
+			if sourceRow, err = sourceExecutor.next(); err != nil {
+				return err
+			}
+		}
+		if advanceTarget {
+			if targetRow, err = targetExecutor.next(); err != nil {
+				return err
+			}
+		}
+		if sourceRow == nil && targetRow == nil {
+			break
+		}
+		advanceSource = true
+		advanceTarget = true
+		rowsCompared++
+		dr.ProcessedRows++
+
+		var c int
+		switch {
+		case sourceRow == nil:
+			c = 1
+		case targetRow == nil:
+			c = -1
+		default:
+			// Compare pk values.
+			if c, err = td.compare(sourceRow, targetRow, td.comparePKs, false); err != nil {
+				return err
+			}
+		}
+		switch {
+		case c < 0:
+			if dr.ExtraRowsSource < maxExtraRowsToCompare {
+				dr.ExtraRowsSourceDiffs = append(dr.ExtraRowsSourceDiffs, td.genRowDiff(td.sourceExpression, sourceRow, options))
+			}
+			dr.ExtraRowsSource++
+			td.setLastPK(dr, sourceRow)
+			advanceTarget = false
+			continue
+		case c > 0:
+			if dr.ExtraRowsTarget < maxExtraRowsToCompare {
+				dr.ExtraRowsTargetDiffs = append(dr.ExtraRowsTargetDiffs, td.genRowDiff(td.targetExpression, targetRow, options))
+			}
+			dr.ExtraRowsTarget++
+			td.setLastPK(dr, targetRow)
+			advanceSource = false
+			continue
+		}
+
+		// c == 0
+		// Compare the non-pk values.
+		td.setLastPK(dr, targetRow)
+		c, err = td.compare(sourceRow, targetRow, td.compareCols, true)
+		switch {
+		case err != nil:
+			return err
+		case c != 0:
+			// We don't do a second pass to compare mismatched rows so we can cap the slice here
+			if len(dr.MismatchedRowsSample) < maxVDiffReportSampleRows {
+				dr.MismatchedRowsSample = append(dr.MismatchedRowsSample, &DiffMismatch{
+					Source: td.genRowDiff(td.targetExpression, sourceRow, options),
+					Target: td.genRowDiff(td.targetExpression, targetRow, options),
+				})
+			}
+			dr.MismatchedRows++
+		default:
+			dr.MatchingRows++
+		}
+	}
+	dr.reconcileExtraRows(maxExtraRowsToCompare)
+	return save(CompletedState)
+}
+
+// setLastPK records the primary key of the row as the last compared one.
+func (td *tableDiffer) setLastPK(dr *DiffReport, row []sqltypes.Value) {
+	values := make([]sqltypes.Value, 0, len(td.comparePKs))
+	for _, cpk := range td.comparePKs {
+		values = append(values, row[cpk.colIndex])
+	}
+	dr.lastpk = sqltypes.ResultToProto3(&sqltypes.Result{
+		Fields: td.pkFields,
+		Rows:   [][]sqltypes.Value{values},
+	})
+}
+
+func (td *tableDiffer) compare(sourceRow, targetRow []sqltypes.Value, cols []compareColInfo, compareOnlyNonPKs bool) (int, error) {
+	for _, col := range cols {
+		if col.isPK && compareOnlyNonPKs {
+			continue
+		}
+		// if the collation is nil or unknown, use binary collation to compare as bytes
+		collationID := collations.ID(collations.CollationBinaryID)
+		if col.collation != nil {
+			collationID = col.collation.ID()
+		}
+		c, err := evalengine.NullsafeCompare(sourceRow[col.colIndex], targetRow[col.colIndex], collationID)
+		if err != nil {
+			return 0, err
+		}
+		if c != 0 {
+			return c, nil
+		}
+	}
+	return 0, nil
+}
+
+func (td *tableDiffer) genRowDiff(queryStmt string, row []sqltypes.Value, options *tabletmanagerdatapb.VDiffOptions) *RowDiff {
+	drp := &RowDiff{Row: make(map[string]string)}
+	// The expressions were built by buildTablePlan, they always parse.
+	statement, _ := sqlparser.Parse(queryStmt)
+	sel := statement.(*sqlparser.Select)
+	if options.DebugQuery {
+		drp.Query = td.genDebugQueryDiff(sel, row, options.OnlyPks)
+	}
+	for i, selExpr := range sel.SelectExprs {
+		if options.OnlyPks && !td.compareCols[i].isPK {
+			continue
+		}
+		drp.Row[sqlparser.String(selExpr)] = formatValue(row[i])
+	}
+	return drp
+}
+
+func (td *tableDiffer) genDebugQueryDiff(sel *sqlparser.Select, row []sqltypes.Value, onlyPks bool) string {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select ")
+
+	if onlyPks {
+		for i, pkI := range td.selectPks {
+			sel.SelectExprs[pkI].Format(buf)
+			if i != len(td.selectPks)-1 {
+				buf.Myprintf(", ")
+			}
+		}
+	} else {
+		sel.SelectExprs.Format(buf)
+	}
+	buf.Myprintf(" from %v where ", sel.From)
+	for i, pkI := range td.selectPks {
+		sel.SelectExprs[pkI].Format(buf)
+		buf.Myprintf("=")
+		row[pkI].EncodeSQL(buf)
+		if i != len(td.selectPks)-1 {
+			buf.Myprintf(" AND ")
+		}
+	}
+	buf.Myprintf(";")
+	return buf.String()
+}
+
+// reconcileExtraRows removes the extra rows that are found on both sides.
+// If the only difference is the order in which the rows were returned by
+// MySQL on each side, e.g. because of collations, we'll have the same number
+// of extras on both sides. The samples are then trimmed for the report.
+func (dr *DiffReport) reconcileExtraRows(maxExtraRowsToCompare int64) {
+	if dr.ExtraRowsSource == dr.ExtraRowsTarget && dr.ExtraRowsSource <= maxExtraRowsToCompare {
+	nextSourceRow:
+		for i := 0; i < len(dr.ExtraRowsSourceDiffs); {
+			for j := range dr.ExtraRowsTargetDiffs {
+				if reflect.DeepEqual(dr.ExtraRowsSourceDiffs[i].Row, dr.ExtraRowsTargetDiffs[j].Row) {
+					dr.ExtraRowsSourceDiffs = append(dr.ExtraRowsSourceDiffs[:i], dr.ExtraRowsSourceDiffs[i+1:]...)
+					dr.ExtraRowsSource--
+					dr.ExtraRowsTargetDiffs = append(dr.ExtraRowsTargetDiffs[:j], dr.ExtraRowsTargetDiffs[j+1:]...)
+					dr.ExtraRowsTarget--
+					dr.ProcessedRows--
+					dr.MatchingRows++
+					continue nextSourceRow
+				}
+			}
+			// If we didn't find a match then the tables are in fact
+			// different and we can short circuit.
+			break
+		}
+	}
+	if len(dr.ExtraRowsSourceDiffs) > maxVDiffReportSampleRows {
+		dr.ExtraRowsSourceDiffs = dr.ExtraRowsSourceDiffs[:maxVDiffReportSampleRows]
+	}
+	if len(dr.ExtraRowsTargetDiffs) > maxVDiffReportSampleRows {
+		dr.ExtraRowsTargetDiffs = dr.ExtraRowsTargetDiffs[:maxVDiffReportSampleRows]
+	}
+}
+
+//-----------------------------------------------------------------
+// primitiveExecutor
+
+// primitiveExecutor starts execution on the top level primitive
+// and provides convenience functions for row-by-row iteration.
+type primitiveExecutor struct {
+	prim     engine.Primitive
+	rows     [][]sqltypes.Value
+	resultch chan *sqltypes.Result
+	err      error
+}
+
+func newPrimitiveExecutor(ctx context.Context, prim engine.Primitive) *primitiveExecutor {
+	pe := &primitiveExecutor{
+		prim:     prim,
+		resultch: make(chan *sqltypes.Result, 1),
+	}
+	vcursor := &contextVCursor{ctx: ctx}
+	go func() {
+		defer close(pe.resultch)
+		pe.err = vcursor.StreamExecutePrimitive(pe.prim, make(map[string]*querypb.BindVariable), true, func(qr *sqltypes.Result) error {
+			select {
+			case pe.resultch <- qr:
+			case <-ctx.Done():
+				return vterrors.Wrap(ctx.Err(), "Outer Stream")
+			}
+			return nil
+		})
+	}()
+	return pe
+}
+
+// next returns the next row, or nil at the end of the stream.
+func (pe *primitiveExecutor) next() ([]sqltypes.Value, error) {
+	for len(pe.rows) == 0 {
+		qr, ok := <-pe.resultch
+		if !ok {
+			return nil, pe.err
+		}
+		pe.rows = qr.Rows
+	}
+
+	row := pe.rows[0]
+	pe.rows = pe.rows[1:]
+	return row, nil
+}
+
+//-----------------------------------------------------------------
+// shardStreamer
+
+// StreamExecute is part of the engine.StreamExecutor interface.
+func (sm *shardStreamer) StreamExecute(vcursor engine.VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	for result := range sm.result {
+		if err := callback(result); err != nil {
+			return err
+		}
+	}
+	return sm.err
+}
+
+//-----------------------------------------------------------------
+// contextVCursor
+
+// contextVCursor satisfies VCursor, but only implements Context().
+// MergeSort only requires Context to be implemented.
+type contextVCursor struct {
+	engine.VCursor
+	ctx context.Context
+}
+
+func (vc *contextVCursor) ConnCollation() collations.ID {
+	return collations.CollationBinaryID
+}
+
+func (vc *contextVCursor) ExecutePrimitive(primitive engine.Primitive, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	return primitive.TryExecute(vc, bindVars, wantfields)
+}
+
+func (vc *contextVCursor) StreamExecutePrimitive(primitive engine.Primitive, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	return primitive.TryStreamExecute(vc, bindVars, wantfields, callback)
+}
+
+func (vc *contextVCursor) Context() context.Context {
+	return vc.ctx
+}
+
+//-----------------------------------------------------------------
+// Utility functions
+
+func formatValue(val sqltypes.Value) string {
+	if val.Type() == sqltypes.Null {
+		return "null (NULL_TYPE)"
+	}
+	if val.IsQuoted() || val.Type() == sqltypes.Bit {
+		if len(val.Raw()) >= 20 {
+			rawBytes := val.Raw()[:20]
+			rawBytes = append(rawBytes, []byte("...[TRUNCATED]")...)
+			return fmt.Sprintf("%q (%v)", rawBytes, val.Type())
+		}
+		return fmt.Sprintf("%q (%v)", val.Raw(), val.Type())
+	}
+	return fmt.Sprintf("%s (%v)", val.Raw(), val.Type())
+}
+
+// formatLastPK formats the lastpk saved in _vt.vdiff_table as col=value
+// pairs.
+func formatLastPK(data []byte) (string, error) {
+	if len(data) == 0 {
+		return "", nil
+	}
+	lastpk := &querypb.QueryResult{}
+	if err := prototext.Unmarshal(data, lastpk); err != nil {
+		return "", err
+	}
+	qr := sqltypes.Proto3ToResult(lastpk)
+	if len(qr.Rows) != 1 {
+		return "", vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected lastpk: %v", lastpk)
+	}
+	pairs := make([]string, 0, len(qr.Fields))
+	for i, field := range qr.Fields {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", field.Name, qr.Rows[0][i].ToString()))
+	}
+	return strings.Join(pairs, ","), nil
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+func TestBuildTablePlan(t *testing.T) {
+	table := &tabletmanagerdatapb.TableDefinition{
+		Name:              "t1",
+		Columns:           []string{"c1", "c2", "c3"},
+		PrimaryKeyColumns: []string{"c1"},
+		Fields:            sqltypes.MakeTestFields("c1|c2|c3", "int64|varchar|int64"),
+	}
+	testcases := []struct {
+		filter string
+		source string
+		target string
+		pks    []int
+		err    string
+	}{{
+		filter: "",
+		source: "select c1, c2, c3 from t1",
+		target: "select c1, c2, c3 from t1",
+		pks:    []int{0},
+	}, {
+		filter: "-80",
+		source: "select c1, c2, c3 from t1 where in_keyrange('-80')",
+		target: "select c1, c2, c3 from t1",
+		pks:    []int{0},
+	}, {
+		filter: "select c2, c1 from src where c3 > 10",
+		source: "select c2, c1 from src where c3 > 10",
+		target: "select c2, c1 from t1",
+		pks:    []int{1},
+	}, {
+		filter: "select c1, c4 as c2 from src",
+		source: "select c1, c4 as c2 from src",
+		target: "select c1, c2 from t1",
+		pks:    []int{0},
+	}, {
+		filter: "select c2 from t1",
+		err:    "primary key column c1 is not selected by the filter of table t1",
+	}, {
+		filter: "select c1, c2 + 1 from t1",
+		err:    "expression needs an alias: c2 + 1",
+	}, {
+		filter: "select c1, c9 from t1",
+		err:    "column c9 not found in table t1",
+	}, {
+		filter: "select c1, count(*) as c3 from t1 group by c1",
+		err:    "vdiff of filters with group by is not supported",
+	}, {
+		filter: "select c1, max(c2) as c2 from t1",
+		err:    "vdiff of aggregates is not supported",
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.filter, func(t *testing.T) {
+			td, err := buildTablePlan(table, tc.filter)
+			if tc.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.source, td.sourceExpression)
+			assert.Equal(t, tc.target, td.targetExpression)
+			assert.Equal(t, tc.pks, td.selectPks)
+			assert.Equal(t, []*querypb.Field{table.Fields[0]}, td.pkFields)
+		})
+	}
+}
+
+func TestBuildTableDiffers(t *testing.T) {
+	schm := &tabletmanagerdatapb.SchemaDefinition{}
+	for _, name := range []string{"t1", "t2", "t3", "_vt_HOLD_6ace8bcef73211ea87e9f875a4d24e90_20200915120410"} {
+		schm.TableDefinitions = append(schm.TableDefinitions, &tabletmanagerdatapb.TableDefinition{
+			Name:              name,
+			Columns:           []string{"id"},
+			PrimaryKeyColumns: []string{"id"},
+			Fields:            sqltypes.MakeTestFields("id", "int64"),
+		})
+	}
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t3",
+			Filter: "exclude",
+		}, {
+			Match: "/.*",
+		}},
+	}
+
+	tableNames := func(differs []*tableDiffer) []string {
+		var names []string
+		for _, td := range differs {
+			names = append(names, td.table.Name)
+		}
+		return names
+	}
+	differs, err := buildTableDiffers(filter, schm, &tabletmanagerdatapb.VDiffOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"t1", "t2"}, tableNames(differs))
+
+	differs, err = buildTableDiffers(filter, schm, &tabletmanagerdatapb.VDiffOptions{Tables: []string{"t2"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"t2"}, tableNames(differs))
+
+	_, err = buildTableDiffers(filter, schm, &tabletmanagerdatapb.VDiffOptions{Tables: []string{"t3"}})
+	assert.EqualError(t, err, "table t3 is not present in the workflow")
+}
+
+func TestReconcileExtraRows(t *testing.T) {
+	row := func(id string) *RowDiff {
+		return &RowDiff{Row: map[string]string{"id": id}}
+	}
+	dr := &DiffReport{
+		ProcessedRows:        4,
+		ExtraRowsSource:      2,
+		ExtraRowsSourceDiffs: []*RowDiff{row("1"), row("2")},
+		ExtraRowsTarget:      2,
+		ExtraRowsTargetDiffs: []*RowDiff{row("2"), row("1")},
+	}
+	dr.reconcileExtraRows(10)
+	assert.EqualValues(t, 2, dr.ProcessedRows)
+	assert.EqualValues(t, 2, dr.MatchingRows)
+	assert.EqualValues(t, 0, dr.ExtraRowsSource)
+	assert.EqualValues(t, 0, dr.ExtraRowsTarget)
+	assert.Empty(t, dr.ExtraRowsSourceDiffs)
+	assert.Empty(t, dr.ExtraRowsTargetDiffs)
+
+	// The rows are not compared when there are too many of them.
+	dr = &DiffReport{
+		ProcessedRows:        4,
+		ExtraRowsSource:      2,
+		ExtraRowsSourceDiffs: []*RowDiff{row("1"), row("2")},
+		ExtraRowsTarget:      2,
+		ExtraRowsTargetDiffs: []*RowDiff{row("2"), row("1")},
+	}
+	dr.reconcileExtraRows(1)
+	assert.EqualValues(t, 2, dr.ExtraRowsSource)
+	assert.EqualValues(t, 2, dr.ExtraRowsTarget)
+}
+
+func TestFormatLastPK(t *testing.T) {
+	lastpk := sqltypes.ResultToProto3(sqltypes.MakeTestResult(sqltypes.MakeTestFields("c1|c2", "int64|varchar"), "1|a"))
+	data, err := prototext.Marshal(lastpk)
+	require.NoError(t, err)
+	got, err := formatLastPK(data)
+	require.NoError(t, err)
+	assert.Equal(t, "c1=1,c2=a", got)
+
+	got, err = formatLastPK(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "", got)
+}
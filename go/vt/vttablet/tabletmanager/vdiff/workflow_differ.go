@@ -0,0 +1,428 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+const (
+	defaultTabletTypes                 = "primary,replica,rdonly"
+	defaultFilteredReplicationWaitTime = 30 * time.Second
+	defaultMaxExtraRowsToCompare       = 1000
+)
+
+// progressInterval is how often the progress of a table is saved. It can be
+// changed to a smaller value for tests.
+var progressInterval = 10 * time.Second
+
+// workflowDiffer compares the tables of the workflow of a vdiff between the
+// sources and this target shard.
+type workflowDiffer struct {
+	ct  *controller
+	tmc tmclient.TabletManagerClient
+
+	// sources are the source shards, by name.
+	sources map[string]*shardStreamer
+	// target streams from this tablet.
+	target *shardStreamer
+	// streams are the source shards of the vreplication streams, by id.
+	streams map[int64]string
+
+	tableDiffers []*tableDiffer
+}
+
+func newWorkflowDiffer(ctx context.Context, ct *controller, dbClient binlogplayer.DBClient) (*workflowDiffer, error) {
+	vde := ct.vde
+	qr, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlGetWorkflowStreams, encodeString(vde.dbName), encodeString(ct.workflow)), -1)
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "workflow %s not found", ct.workflow)
+	}
+	target := proto.Clone(vde.tablet).(*topodatapb.Tablet)
+	target.Type = topodatapb.TabletType_PRIMARY
+	wd := &workflowDiffer{
+		ct:      ct,
+		sources: make(map[string]*shardStreamer),
+		target:  &shardStreamer{tablet: target, keyspace: target.Keyspace, shard: target.Shard},
+		streams: make(map[int64]string),
+	}
+	var filter *binlogdatapb.Filter
+	for _, row := range qr.Named().Rows {
+		id, err := row.ToInt64("id")
+		if err != nil {
+			return nil, err
+		}
+		var bls binlogdatapb.BinlogSource
+		if err := prototext.Unmarshal(row.AsBytes("source", nil), &bls); err != nil {
+			return nil, err
+		}
+		if bls.ExternalCluster != "" {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "workflow %s: vdiff of external sources is not supported", ct.workflow)
+		}
+		wd.streams[id] = bls.Shard
+		if _, ok := wd.sources[bls.Shard]; !ok {
+			wd.sources[bls.Shard] = &shardStreamer{keyspace: bls.Keyspace, shard: bls.Shard}
+		}
+		filter = bls.Filter
+	}
+
+	schm, err := vde.mysqld.GetSchema(ctx, vde.dbName, nil, nil, false)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "GetSchema")
+	}
+	if wd.tableDiffers, err = buildTableDiffers(filter, schm, ct.options); err != nil {
+		return nil, err
+	}
+	for _, td := range wd.tableDiffers {
+		td.sourcePrimitive = newMergeSorter(wd.sources, td.comparePKs)
+		td.targetPrimitive = newMergeSorter(map[string]*shardStreamer{wd.target.shard: wd.target}, td.comparePKs)
+	}
+	if err := wd.selectSourceTablets(ctx); err != nil {
+		return nil, err
+	}
+	wd.tmc = vde.tmClientFactory()
+	return wd, nil
+}
+
+func (wd *workflowDiffer) close() {
+	if wd.tmc != nil {
+		wd.tmc.Close()
+	}
+}
+
+// selectSourceTablets picks the tablets of the sources to stream from.
+func (wd *workflowDiffer) selectSourceTablets(ctx context.Context) error {
+	vde := wd.ct.vde
+	var cells []string
+	if wd.ct.options.SourceCell != "" {
+		cells = strings.Split(wd.ct.options.SourceCell, ",")
+	} else {
+		var err error
+		if cells, err = vde.ts.GetCellInfoNames(ctx); err != nil {
+			return err
+		}
+	}
+	tabletTypes := wd.ct.options.TabletTypes
+	if tabletTypes == "" {
+		tabletTypes = defaultTabletTypes
+	}
+	return forAll(wd.sources, func(source *shardStreamer) error {
+		tp, err := discovery.NewTabletPicker(vde.ts, cells, source.keyspace, source.shard, tabletTypes)
+		if err != nil {
+			return err
+		}
+		source.tablet, err = tp.PickForStreaming(ctx)
+		return err
+	})
+}
+
+// diffTable compares one table, from the last compared primary key of the
+// previous runs, if any.
+func (wd *workflowDiffer) diffTable(ctx context.Context, dbClient binlogplayer.DBClient, td *tableDiffer) error {
+	id := wd.ct.id
+	table := encodeString(td.table.Name)
+	if _, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlNewVDiffTable, id, table), 1); err != nil {
+		return err
+	}
+	qr, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlGetVDiffTable, id, table), 1)
+	if err != nil {
+		return err
+	}
+	row := qr.Named().Row()
+	if row == nil {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "table %s of vdiff %s not found", td.table.Name, wd.ct.uuid)
+	}
+	if row.AsString("state", "") == CompletedState {
+		return nil
+	}
+	var lastpk *querypb.QueryResult
+	if data := row.AsBytes("lastpk", nil); len(data) != 0 {
+		lastpk = &querypb.QueryResult{}
+		if err := prototext.Unmarshal(data, lastpk); err != nil {
+			return vterrors.Wrapf(err, "invalid lastpk of table %s", td.table.Name)
+		}
+	}
+	dr := &DiffReport{TableName: td.table.Name}
+	if data := row.AsBytes("report", nil); len(data) != 0 {
+		if err := json.Unmarshal(data, dr); err != nil {
+			return vterrors.Wrapf(err, "invalid report of table %s", td.table.Name)
+		}
+	}
+	// The lastpk is kept if no row is compared, else the next resume would
+	// compare the table again from the start.
+	dr.lastpk = lastpk
+	qr, err = dbClient.ExecuteFetch(fmt.Sprintf(sqlGetTableRows, encodeString(wd.ct.vde.dbName), table), 1)
+	if err != nil {
+		return err
+	}
+	var tableRows int64
+	if row := qr.Named().Row(); row != nil {
+		tableRows = row.AsInt64("table_rows", 0)
+	}
+	if _, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlStartVDiffTable, tableRows, id, table), 1); err != nil {
+		return err
+	}
+
+	log.Infof("vdiff %s: starting the diff of table %s from %v", wd.ct.uuid, td.table.Name, lastpk)
+	// A cancelable context aborts all the streams if one of them fails.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if err := wd.startStreams(ctx, dbClient, td, lastpk); err != nil {
+		return err
+	}
+	save := func(state string) error {
+		return wd.saveProgress(dbClient, td, dr, state)
+	}
+	return td.diff(ctx, dr, wd.ct.options, save)
+}
+
+// saveProgress saves the counters, the samples and the last compared
+// primary key of the table.
+func (wd *workflowDiffer) saveProgress(dbClient binlogplayer.DBClient, td *tableDiffer, dr *DiffReport, state string) error {
+	lastpk := "null"
+	if dr.lastpk != nil {
+		data, err := prototext.Marshal(dr.lastpk)
+		if err != nil {
+			return err
+		}
+		lastpk = encodeString(string(data))
+	}
+	report, err := json.Marshal(dr)
+	if err != nil {
+		return err
+	}
+	query := fmt.Sprintf(sqlUpdateVDiffTable, encodeString(state), lastpk, dr.ProcessedRows, dr.MatchingRows, dr.MismatchedRows,
+		dr.ExtraRowsSource, dr.ExtraRowsTarget, encodeString(string(report)), wd.ct.id, encodeString(td.table.Name))
+	_, err = dbClient.ExecuteFetch(query, 1)
+	return err
+}
+
+// startStreams stops the vreplication streams of the workflow, starts
+// streaming the table from the sources, catches the streams up with the
+// snapshots of the sources, and starts streaming the table from this tablet.
+// The streams are restarted before it returns.
+func (wd *workflowDiffer) startStreams(ctx context.Context, dbClient binlogplayer.DBClient, td *tableDiffer, lastpk *querypb.QueryResult) error {
+	vde := wd.ct.vde
+	dbName, workflow := encodeString(vde.dbName), encodeString(wd.ct.workflow)
+	defer func() {
+		if _, err := vde.vre.Exec(fmt.Sprintf(sqlStartWorkflowStreams, dbName, workflow)); err != nil {
+			log.Errorf("vdiff %s: could not restart the streams of workflow %s, please restart them manually: %v", wd.ct.uuid, wd.ct.workflow, err)
+		}
+	}()
+	if _, err := vde.vre.Exec(fmt.Sprintf(sqlStopWorkflowStreams, dbName, workflow)); err != nil {
+		return vterrors.Wrap(err, "stopping the streams")
+	}
+	qr, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlGetWorkflowStreams, dbName, workflow), -1)
+	if err != nil {
+		return err
+	}
+	for _, source := range wd.sources {
+		source.position = mysql.Position{}
+	}
+	for _, row := range qr.Named().Rows {
+		id, err := row.ToInt64("id")
+		if err != nil {
+			return err
+		}
+		source, ok := wd.sources[wd.streams[id]]
+		if !ok {
+			return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "the streams of workflow %s changed during the vdiff", wd.ct.workflow)
+		}
+		pos, err := binlogplayer.DecodePosition(row.AsString("pos", ""))
+		if err != nil {
+			return err
+		}
+		if pos.IsZero() {
+			return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "workflow %s: stream %d has not started", wd.ct.workflow, id)
+		}
+		if source.position.IsZero() || !source.position.AtLeast(pos) {
+			source.position = pos
+		}
+	}
+
+	waitTime := time.Duration(wd.ct.options.FilteredReplicationWaitTime)
+	if waitTime <= 0 {
+		waitTime = defaultFilteredReplicationWaitTime
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, waitTime)
+	defer cancel()
+
+	// Make sure the sources are past the positions of the streams, and
+	// start streaming the table as of their current positions.
+	err = forAll(wd.sources, func(source *shardStreamer) error {
+		if err := wd.tmc.WaitForPosition(waitCtx, source.tablet, mysql.EncodePosition(source.position)); err != nil {
+			return vterrors.Wrapf(err, "WaitForPosition for tablet %v", topoproto.TabletAliasString(source.tablet.Alias))
+		}
+		return source.startStream(ctx, td.sourceExpression, lastpk)
+	})
+	if err != nil {
+		return vterrors.Wrap(err, "starting the source streams")
+	}
+
+	// Fast forward the streams to the positions of the source snapshots.
+	for id, shard := range wd.streams {
+		pos := wd.sources[shard].snapshotPosition
+		if _, err := vde.vre.Exec(fmt.Sprintf(sqlSyncWorkflowStream, encodeString(pos), id)); err != nil {
+			return vterrors.Wrap(err, "synchronizing the streams")
+		}
+		if err := vde.vre.WaitForPos(waitCtx, int(id), pos); err != nil {
+			return vterrors.Wrapf(err, "waiting for stream %d to reach %s", id, pos)
+		}
+	}
+
+	// The target is in sync with the sources.
+	return wd.target.startStream(ctx, td.targetExpression, lastpk)
+}
+
+// forAll runs f on all the participants in parallel.
+func forAll(participants map[string]*shardStreamer, f func(*shardStreamer) error) error {
+	var wg sync.WaitGroup
+	allErrors := &concurrency.AllErrorRecorder{}
+	for _, participant := range participants {
+		wg.Add(1)
+		go func(participant *shardStreamer) {
+			defer wg.Done()
+			if err := f(participant); err != nil {
+				allErrors.RecordError(err)
+			}
+		}(participant)
+	}
+	wg.Wait()
+	return allErrors.AggrError(vterrors.Aggregate)
+}
+
+//-----------------------------------------------------------------
+// shardStreamer
+
+// shardStreamer streams the rows of a table from one shard. This works for
+// the sources as well as the target. It satisfies engine.StreamExecutor,
+// and can be added to the Primitives of engine.MergeSort.
+type shardStreamer struct {
+	tablet   *topodatapb.Tablet
+	keyspace string
+	shard    string
+	// position is the position the source must reach before streaming.
+	position mysql.Position
+	// snapshotPosition is the position of the snapshot the rows are
+	// streamed from.
+	snapshotPosition string
+	result           chan *sqltypes.Result
+	err              error
+}
+
+// startStream starts streaming the rows of the query after lastpk, and
+// waits for the position of the snapshot.
+func (sm *shardStreamer) startStream(ctx context.Context, query string, lastpk *querypb.QueryResult) error {
+	sm.result = make(chan *sqltypes.Result, 1)
+	gtidch := make(chan string, 1)
+	go sm.streamOne(ctx, query, lastpk, gtidch)
+
+	// If the gtid is not received, there was an error, which is stored in
+	// sm.err.
+	gtid, ok := <-gtidch
+	if !ok {
+		return sm.err
+	}
+	sm.snapshotPosition = gtid
+	return nil
+}
+
+// streamOne is called as a goroutine. It first sends the snapshot gtid to
+// gtidch, then streams results to sm.result. Before returning, it sets
+// sm.err, and closes all channels.
+func (sm *shardStreamer) streamOne(ctx context.Context, query string, lastpk *querypb.QueryResult, gtidch chan string) {
+	defer close(sm.result)
+	defer close(gtidch)
+
+	sm.err = func() error {
+		conn, err := tabletconn.GetDialer()(sm.tablet, grpcclient.FailFast(false))
+		if err != nil {
+			return err
+		}
+		defer conn.Close(ctx)
+
+		target := &querypb.Target{
+			Keyspace:   sm.keyspace,
+			Shard:      sm.shard,
+			TabletType: sm.tablet.Type,
+		}
+		var fields []*querypb.Field
+		return conn.VStreamRows(ctx, target, query, lastpk, func(vrs *binlogdatapb.VStreamRowsResponse) error {
+			if vrs.Fields != nil {
+				fields = vrs.Fields
+				gtidch <- vrs.Gtid
+			}
+			result := sqltypes.Proto3ToResult(&querypb.QueryResult{
+				Fields: fields,
+				Rows:   vrs.Rows,
+			})
+			// Fields should be received only once, and sent only once.
+			if vrs.Fields == nil {
+				result.Fields = nil
+			}
+			select {
+			case sm.result <- result:
+			case <-ctx.Done():
+				return vterrors.Wrap(ctx.Err(), "VStreamRows")
+			}
+			return nil
+		})
+	}()
+}
+
+// sortedTableDiffers returns the differs sorted by table name.
+func sortedTableDiffers(differs map[string]*tableDiffer) []*tableDiffer {
+	names := make([]string, 0, len(differs))
+	for name := range differs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	sorted := make([]*tableDiffer, 0, len(names))
+	for _, name := range names {
+		sorted = append(sorted, differs[name])
+	}
+	return sorted
+}
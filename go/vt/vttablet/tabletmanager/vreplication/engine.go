@@ -63,6 +63,15 @@ const (
   primary key (vrepl_id, table_name))`
 )
 
+// alterCopyState adds the columns of copy_state used when tables are copied
+// concurrently. While copy_pos is set, the rows of the table up to caught_up_pk
+// are at the replication position of the stream, and the rows after it, up to
+// lastpk, were copied from a snapshot of the source at copy_pos.
+var alterCopyState = []string{
+	"alter table _vt.copy_state add column caught_up_pk varbinary(2000)",
+	"alter table _vt.copy_state add column copy_pos varbinary(10000)",
+}
+
 var withDDL *withddl.WithDDL
 var withDDLInitialQueries []string
 
@@ -75,6 +84,7 @@ func init() {
 	allddls := append([]string{}, binlogplayer.CreateVReplicationTable()...)
 	allddls = append(allddls, binlogplayer.AlterVReplicationTable...)
 	allddls = append(allddls, createReshardingJournalTable, createCopyState)
+	allddls = append(allddls, alterCopyState...)
 	allddls = append(allddls, createVReplicationLogTable)
 	withDDL = withddl.New(allddls)
 
@@ -511,6 +511,8 @@ func TestCreateDBAndTable(t *testing.T) {
 			"ALTER TABLE _vt.vreplication ADD COLUMN time_heartbeat.*",
 			"create table if not exists _vt.resharding_journal.*",
 			"create table if not exists _vt.copy_state.*",
+			"alter table _vt.copy_state add column caught_up_pk.*",
+			"alter table _vt.copy_state add column copy_pos.*",
 		}
 		for _, ddl := range ddls {
 			dbClient.ExpectRequestRE(ddl, &sqltypes.Result{}, nil)
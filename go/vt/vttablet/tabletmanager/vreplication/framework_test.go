@@ -138,6 +138,9 @@ func TestMain(m *testing.M) {
 			fmt.Fprintf(os.Stderr, "%v", err)
 			return 1
 		}
+		for _, query := range alterCopyState {
+			env.Mysqld.ExecuteSuperQuery(context.Background(), query)
+		}
 
 		if err := env.Mysqld.ExecuteSuperQuery(context.Background(), createVReplicationLogTable); err != nil {
 			fmt.Fprintf(os.Stderr, "%v", err)
@@ -82,7 +82,7 @@ func (rp *ReplicatorPlan) buildExecutionPlan(fieldEvent *binlogdatapb.FieldEvent
 		return &tplanv, nil
 	}
 	// select * construct was used. We need to use the field names.
-	tplan, err := rp.buildFromFields(prelim.TargetName, prelim.Lastpk, fieldEvent.Fields)
+	tplan, err := rp.buildFromFields(prelim, fieldEvent.Fields)
 	if err != nil {
 		return nil, err
 	}
@@ -93,10 +93,11 @@ func (rp *ReplicatorPlan) buildExecutionPlan(fieldEvent *binlogdatapb.FieldEvent
 // buildFromFields builds a full TablePlan, but uses the field info as the
 // full column list. This happens when the query used was a 'select *', which
 // requires us to wait for the field info sent by the source.
-func (rp *ReplicatorPlan) buildFromFields(tableName string, lastpk *sqltypes.Result, fields []*querypb.Field) (*TablePlan, error) {
+func (rp *ReplicatorPlan) buildFromFields(prelim *TablePlan, fields []*querypb.Field) (*TablePlan, error) {
+	tableName := prelim.TargetName
 	tpb := &tablePlanBuilder{
 		name:     sqlparser.NewTableIdent(tableName),
-		lastpk:   lastpk,
+		lastpk:   prelim.Lastpk,
 		colInfos: rp.ColInfoMap[tableName],
 		stats:    rp.stats,
 	}
@@ -131,7 +132,14 @@ func (rp *ReplicatorPlan) buildFromFields(tableName string, lastpk *sqltypes.Res
 	if err := tpb.analyzePK(rp.ColInfoMap[tableName]); err != nil {
 		return nil, err
 	}
-	return tpb.generate(), nil
+	if err := tpb.analyzeConflictPolicy(prelim.ConflictPolicy, prelim.ConflictColumn, prelim.SourcePriority); err != nil {
+		return nil, err
+	}
+	tplan := tpb.generate()
+	tplan.ConflictPolicy = prelim.ConflictPolicy
+	tplan.ConflictColumn = prelim.ConflictColumn
+	tplan.SourcePriority = prelim.SourcePriority
+	return tplan, nil
 }
 
 // MarshalJSON performs a custom JSON Marshalling.
@@ -201,6 +209,11 @@ type TablePlan struct {
 	FieldsToSkip            map[string]bool
 	ConvertCharset          map[string](*binlogdatapb.CharsetConversion)
 	HasExtraSourcePkColumns bool
+	// ConflictPolicy, ConflictColumn and SourcePriority come from
+	// the rule, and are used to build the final plan of a "select *".
+	ConflictPolicy binlogdatapb.ConflictPolicy
+	ConflictColumn string
+	SourcePriority int64
 }
 
 // MarshalJSON performs a custom JSON Marshalling.
@@ -414,6 +414,217 @@ func TestBuildPlayerPlan(t *testing.T) {
 				},
 			},
 		},
+	}, {
+		// Masking expressions.
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "t1",
+				Filter: "select c1, sha2(c2, 256) as c2, case when c3 > 0 then 'y' else 'n' end as c3, null as c4 from t1",
+			}},
+		},
+		plan: &TestReplicatorPlan{
+			VStreamFilter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t1",
+					Filter: "select c1, c2, c3 from t1",
+				}},
+			},
+			TargetTables: []string{"t1"},
+			TablePlans: map[string]*TestTablePlan{
+				"t1": {
+					TargetName:   "t1",
+					SendRule:     "t1",
+					PKReferences: []string{"c1"},
+					InsertFront:  "insert into t1(c1,c2,c3,c4)",
+					InsertValues: "(:a_c1,sha2(:a_c2, 256),case when :a_c3 > 0 then 'y' else 'n' end,null)",
+					Insert:       "insert into t1(c1,c2,c3,c4) values (:a_c1,sha2(:a_c2, 256),case when :a_c3 > 0 then 'y' else 'n' end,null)",
+					Update:       "update t1 set c2=sha2(:a_c2, 256), c3=case when :a_c3 > 0 then 'y' else 'n' end, c4=null where c1=:b_c1",
+					Delete:       "delete from t1 where c1=:b_c1",
+				},
+			},
+		},
+		planpk: &TestReplicatorPlan{
+			VStreamFilter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t1",
+					Filter: "select c1, c2, c3, pk1, pk2 from t1",
+				}},
+			},
+			TargetTables: []string{"t1"},
+			TablePlans: map[string]*TestTablePlan{
+				"t1": {
+					TargetName:   "t1",
+					SendRule:     "t1",
+					PKReferences: []string{"c1", "pk1", "pk2"},
+					InsertFront:  "insert into t1(c1,c2,c3,c4)",
+					InsertValues: "(:a_c1,sha2(:a_c2, 256),case when :a_c3 > 0 then 'y' else 'n' end,null)",
+					Insert:       "insert into t1(c1,c2,c3,c4) select :a_c1, sha2(:a_c2, 256), case when :a_c3 > 0 then 'y' else 'n' end, null from dual where (:a_pk1,:a_pk2) <= (1,'aaa')",
+					Update:       "update t1 set c2=sha2(:a_c2, 256), c3=case when :a_c3 > 0 then 'y' else 'n' end, c4=null where c1=:b_c1 and (:b_pk1,:b_pk2) <= (1,'aaa')",
+					Delete:       "delete from t1 where c1=:b_c1 and (:b_pk1,:b_pk2) <= (1,'aaa')",
+				},
+			},
+		},
+	}, {
+		// Conflict policy: ignore. Updates and deletes only change the rows
+		// that still hold the before image, which were written by this source.
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:          "t1",
+				Filter:         "select c1, c2 from t1",
+				ConflictPolicy: binlogdatapb.ConflictPolicy_CONFLICT_IGNORE,
+			}},
+		},
+		plan: &TestReplicatorPlan{
+			VStreamFilter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t1",
+					Filter: "select c1, c2 from t1",
+				}},
+			},
+			TargetTables: []string{"t1"},
+			TablePlans: map[string]*TestTablePlan{
+				"t1": {
+					TargetName:   "t1",
+					SendRule:     "t1",
+					PKReferences: []string{"c1"},
+					InsertFront:  "insert ignore into t1(c1,c2)",
+					InsertValues: "(:a_c1,:a_c2)",
+					Insert:       "insert ignore into t1(c1,c2) values (:a_c1,:a_c2)",
+					Update:       "update t1 set c2=:a_c2 where c1=:b_c1 and c2<=>:b_c2",
+					Delete:       "delete from t1 where c1=:b_c1 and c2<=>:b_c2",
+				},
+			},
+		},
+		planpk: &TestReplicatorPlan{
+			VStreamFilter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t1",
+					Filter: "select c1, c2, pk1, pk2 from t1",
+				}},
+			},
+			TargetTables: []string{"t1"},
+			TablePlans: map[string]*TestTablePlan{
+				"t1": {
+					TargetName:   "t1",
+					SendRule:     "t1",
+					PKReferences: []string{"c1", "pk1", "pk2"},
+					InsertFront:  "insert ignore into t1(c1,c2)",
+					InsertValues: "(:a_c1,:a_c2)",
+					Insert:       "insert ignore into t1(c1,c2) select :a_c1, :a_c2 from dual where (:a_pk1,:a_pk2) <= (1,'aaa')",
+					Update:       "update t1 set c2=:a_c2 where c1=:b_c1 and (:b_pk1,:b_pk2) <= (1,'aaa') and c2<=>:b_c2",
+					Delete:       "delete from t1 where c1=:b_c1 and (:b_pk1,:b_pk2) <= (1,'aaa') and c2<=>:b_c2",
+				},
+			},
+		},
+	}, {
+		// Conflict policy: last writer wins.
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:          "t1",
+				Filter:         "select c1, c2, ts from t1",
+				ConflictPolicy: binlogdatapb.ConflictPolicy_CONFLICT_LAST_WRITER_WINS,
+				ConflictColumn: "ts",
+			}},
+		},
+		plan: &TestReplicatorPlan{
+			VStreamFilter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t1",
+					Filter: "select c1, c2, ts from t1",
+				}},
+			},
+			TargetTables: []string{"t1"},
+			TablePlans: map[string]*TestTablePlan{
+				"t1": {
+					TargetName:   "t1",
+					SendRule:     "t1",
+					PKReferences: []string{"c1"},
+					InsertFront:  "insert into t1(c1,c2,ts)",
+					InsertValues: "(:a_c1,:a_c2,:a_ts)",
+					InsertOnDup:  "on duplicate key update c2=if(values(ts)>=ts, values(c2), c2), ts=if(values(ts)>=ts, values(ts), ts)",
+					Insert:       "insert into t1(c1,c2,ts) values (:a_c1,:a_c2,:a_ts) on duplicate key update c2=if(values(ts)>=ts, values(c2), c2), ts=if(values(ts)>=ts, values(ts), ts)",
+					Update:       "update t1 set c2=:a_c2, ts=:a_ts where c1=:b_c1 and ts<=:a_ts",
+					Delete:       "delete from t1 where c1=:b_c1 and ts<=:b_ts",
+				},
+			},
+		},
+		planpk: &TestReplicatorPlan{
+			VStreamFilter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t1",
+					Filter: "select c1, c2, ts, pk1, pk2 from t1",
+				}},
+			},
+			TargetTables: []string{"t1"},
+			TablePlans: map[string]*TestTablePlan{
+				"t1": {
+					TargetName:   "t1",
+					SendRule:     "t1",
+					PKReferences: []string{"c1", "pk1", "pk2"},
+					InsertFront:  "insert into t1(c1,c2,ts)",
+					InsertValues: "(:a_c1,:a_c2,:a_ts)",
+					InsertOnDup:  "on duplicate key update c2=if(values(ts)>=ts, values(c2), c2), ts=if(values(ts)>=ts, values(ts), ts)",
+					Insert:       "insert into t1(c1,c2,ts) select :a_c1, :a_c2, :a_ts from dual where (:a_pk1,:a_pk2) <= (1,'aaa') on duplicate key update c2=if(values(ts)>=ts, values(c2), c2), ts=if(values(ts)>=ts, values(ts), ts)",
+					Update:       "update t1 set c2=:a_c2, ts=:a_ts where c1=:b_c1 and (:b_pk1,:b_pk2) <= (1,'aaa') and ts<=:a_ts",
+					Delete:       "delete from t1 where c1=:b_c1 and (:b_pk1,:b_pk2) <= (1,'aaa') and ts<=:b_ts",
+				},
+			},
+		},
+	}, {
+		// Conflict policy: source priority.
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:          "t1",
+				Filter:         "select c1, c2 from t1",
+				ConflictPolicy: binlogdatapb.ConflictPolicy_CONFLICT_SOURCE_PRIORITY,
+				ConflictColumn: "prio",
+				SourcePriority: 2,
+			}},
+		},
+		plan: &TestReplicatorPlan{
+			VStreamFilter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t1",
+					Filter: "select c1, c2 from t1",
+				}},
+			},
+			TargetTables: []string{"t1"},
+			TablePlans: map[string]*TestTablePlan{
+				"t1": {
+					TargetName:   "t1",
+					SendRule:     "t1",
+					PKReferences: []string{"c1"},
+					InsertFront:  "insert into t1(c1,c2,prio)",
+					InsertValues: "(:a_c1,:a_c2,2)",
+					InsertOnDup:  "on duplicate key update c2=if(values(prio)>=prio, values(c2), c2), prio=if(values(prio)>=prio, values(prio), prio)",
+					Insert:       "insert into t1(c1,c2,prio) values (:a_c1,:a_c2,2) on duplicate key update c2=if(values(prio)>=prio, values(c2), c2), prio=if(values(prio)>=prio, values(prio), prio)",
+					Update:       "update t1 set c2=:a_c2, prio=2 where c1=:b_c1 and prio<=2",
+					Delete:       "delete from t1 where c1=:b_c1 and prio<=2",
+				},
+			},
+		},
+		planpk: &TestReplicatorPlan{
+			VStreamFilter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t1",
+					Filter: "select c1, c2, pk1, pk2 from t1",
+				}},
+			},
+			TargetTables: []string{"t1"},
+			TablePlans: map[string]*TestTablePlan{
+				"t1": {
+					TargetName:   "t1",
+					SendRule:     "t1",
+					PKReferences: []string{"c1", "pk1", "pk2"},
+					InsertFront:  "insert into t1(c1,c2,prio)",
+					InsertValues: "(:a_c1,:a_c2,2)",
+					InsertOnDup:  "on duplicate key update c2=if(values(prio)>=prio, values(c2), c2), prio=if(values(prio)>=prio, values(prio), prio)",
+					Insert:       "insert into t1(c1,c2,prio) select :a_c1, :a_c2, 2 from dual where (:a_pk1,:a_pk2) <= (1,'aaa') on duplicate key update c2=if(values(prio)>=prio, values(c2), c2), prio=if(values(prio)>=prio, values(prio), prio)",
+					Update:       "update t1 set c2=:a_c2, prio=2 where c1=:b_c1 and (:b_pk1,:b_pk2) <= (1,'aaa') and prio<=2",
+					Delete:       "delete from t1 where c1=:b_c1 and (:b_pk1,:b_pk2) <= (1,'aaa') and prio<=2",
+				},
+			},
+		},
 	}, {
 		// Keywords as names.
 		input: &binlogdatapb.Filter{
@@ -667,6 +878,60 @@ func TestBuildPlayerPlan(t *testing.T) {
 			}},
 		},
 		err: "group by expression is not allowed to reference an aggregate expression: a",
+	}, {
+		// conflict policy with group by
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:          "t1",
+				Filter:         "select c1, count(*) as c2 from t1 group by c1",
+				ConflictPolicy: binlogdatapb.ConflictPolicy_CONFLICT_LAST_WRITER_WINS,
+				ConflictColumn: "c2",
+			}},
+		},
+		err: "conflict policy CONFLICT_LAST_WRITER_WINS is not supported with group by",
+	}, {
+		// conflict policy without a column
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:          "t1",
+				Filter:         "select c1, c2 from t1",
+				ConflictPolicy: binlogdatapb.ConflictPolicy_CONFLICT_SOURCE_PRIORITY,
+			}},
+		},
+		err: "conflict policy CONFLICT_SOURCE_PRIORITY requires a conflict column",
+	}, {
+		// last writer wins column not selected
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:          "t1",
+				Filter:         "select c1, c2 from t1",
+				ConflictPolicy: binlogdatapb.ConflictPolicy_CONFLICT_LAST_WRITER_WINS,
+				ConflictColumn: "ts",
+			}},
+		},
+		err: "conflict column ts not found in select list",
+	}, {
+		// last writer wins column in pk
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:          "t1",
+				Filter:         "select c1, c2 from t1",
+				ConflictPolicy: binlogdatapb.ConflictPolicy_CONFLICT_LAST_WRITER_WINS,
+				ConflictColumn: "c1",
+			}},
+		},
+		err: "conflict column c1 cannot be part of the primary key",
+	}, {
+		// source priority column selected
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:          "t1",
+				Filter:         "select c1, c2 from t1",
+				ConflictPolicy: binlogdatapb.ConflictPolicy_CONFLICT_SOURCE_PRIORITY,
+				ConflictColumn: "c2",
+			}},
+		},
+		err: "conflict column c2 is set to the source priority and cannot be in the select list",
 	}}
 
 	PrimaryKeyInfos := map[string][]*ColumnInfo{
@@ -30,6 +30,7 @@ import (
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	"vitess.io/vitess/go/vt/schema"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/vstreamer"
 )
 
 // This file contains just the builders for ReplicatorPlan and TablePlan.
@@ -37,8 +38,7 @@ import (
 // TODO(sougou): reorganize this in a better fashion.
 
 // ExcludeStr is the filter value for excluding tables that match a rule.
-// TODO(sougou): support this on vstreamer side also.
-const ExcludeStr = "exclude"
+const ExcludeStr = vstreamer.ExcludeStr
 
 // tablePlanBuilder contains the metadata needed for building a TablePlan.
 type tablePlanBuilder struct {
@@ -54,6 +54,12 @@ type tablePlanBuilder struct {
 	lastpk            *sqltypes.Result
 	colInfos          []*ColumnInfo
 	stats             *binlogplayer.Stats
+	// conflictPolicy specifies how rows written by other sources
+	// of the target table are handled. conflictCol is set to the
+	// expression of the column that resolves the conflicts for
+	// the policies that need one.
+	conflictPolicy binlogdatapb.ConflictPolicy
+	conflictCol    *colExpr
 }
 
 // colExpr describes the processing to be performed to
@@ -234,6 +240,9 @@ func buildTablePlan(tableName string, rule *binlogdatapb.Rule, colInfos []*Colum
 			Stats:          stats,
 			EnumValuesMap:  enumValuesMap,
 			ConvertCharset: rule.ConvertCharset,
+			ConflictPolicy: rule.ConflictPolicy,
+			ConflictColumn: rule.ConflictColumn,
+			SourcePriority: rule.SourcePriority,
 		}
 
 		return tablePlan, nil
@@ -276,6 +285,9 @@ func buildTablePlan(tableName string, rule *binlogdatapb.Rule, colInfos []*Colum
 	if err := tpb.analyzePK(pkColsInfo); err != nil {
 		return nil, err
 	}
+	if err := tpb.analyzeConflictPolicy(rule.ConflictPolicy, rule.ConflictColumn, rule.SourcePriority); err != nil {
+		return nil, err
+	}
 
 	sourceKeyTargetColumnNames, err := textutil.SplitUnescape(rule.SourceUniqueKeyTargetColumns, ",")
 	if err != nil {
@@ -310,6 +322,9 @@ func buildTablePlan(tableName string, rule *binlogdatapb.Rule, colInfos []*Colum
 	tablePlan.SendRule = sendRule
 	tablePlan.EnumValuesMap = enumValuesMap
 	tablePlan.ConvertCharset = rule.ConvertCharset
+	tablePlan.ConflictPolicy = rule.ConflictPolicy
+	tablePlan.ConflictColumn = rule.ConflictColumn
+	tablePlan.SourcePriority = rule.SourcePriority
 	return tablePlan, nil
 }
 
@@ -570,6 +585,62 @@ func (tpb *tablePlanBuilder) analyzePK(cols []*ColumnInfo) error {
 	return nil
 }
 
+// analyzeConflictPolicy validates the conflict policy of a rule and
+// sets up tpb.conflictCol. Conflicts are resolved by comparing the value
+// of the conflict column of the incoming row against the existing one:
+// for CONFLICT_LAST_WRITER_WINS, the column must be in the select list,
+// and for CONFLICT_SOURCE_PRIORITY, it's set to the priority of the source.
+// It must be called after analyzeGroupBy and analyzePK.
+func (tpb *tablePlanBuilder) analyzeConflictPolicy(policy binlogdatapb.ConflictPolicy, column string, priority int64) error {
+	switch policy {
+	case binlogdatapb.ConflictPolicy_CONFLICT_ERROR:
+		return nil
+	case binlogdatapb.ConflictPolicy_CONFLICT_IGNORE, binlogdatapb.ConflictPolicy_CONFLICT_SOURCE_PRIORITY, binlogdatapb.ConflictPolicy_CONFLICT_LAST_WRITER_WINS:
+	default:
+		return fmt.Errorf("unsupported conflict policy: %v", policy)
+	}
+	if tpb.onInsert != insertNormal {
+		return fmt.Errorf("conflict policy %v is not supported with group by", policy)
+	}
+	tpb.conflictPolicy = policy
+	if policy == binlogdatapb.ConflictPolicy_CONFLICT_IGNORE {
+		return nil
+	}
+	if column == "" {
+		return fmt.Errorf("conflict policy %v requires a conflict column", policy)
+	}
+	colName := sqlparser.NewColIdent(column)
+	if tpb.isColumnGenerated(colName) {
+		return fmt.Errorf("conflict column %s cannot be a generated column", column)
+	}
+	cexpr := tpb.findCol(colName)
+	if policy == binlogdatapb.ConflictPolicy_CONFLICT_SOURCE_PRIORITY {
+		if cexpr != nil {
+			return fmt.Errorf("conflict column %s is set to the source priority and cannot be in the select list", column)
+		}
+		cexpr = &colExpr{
+			colName:    colName,
+			operation:  opExpr,
+			expr:       sqlparser.NewIntLiteral(fmt.Sprintf("%d", priority)),
+			references: make(map[string]bool),
+		}
+		tpb.colExprs = append(tpb.colExprs, cexpr)
+		tpb.conflictCol = cexpr
+		return nil
+	}
+	if cexpr == nil {
+		return fmt.Errorf("conflict column %s not found in select list", column)
+	}
+	if cexpr.operation != opExpr {
+		return fmt.Errorf("conflict column %s is not allowed to reference an aggregate expression", column)
+	}
+	if cexpr.isPK {
+		return fmt.Errorf("conflict column %s cannot be part of the primary key", column)
+	}
+	tpb.conflictCol = cexpr
+	return nil
+}
+
 // analyzeExtraSourcePkCols builds tpb.extraSourcePkCols.
 // Vreplication allows source and target tables to use different unique keys. Normally, both will
 // use same PRIMARY KEY. Other times, same other UNIQUE KEY. Byut it's possible that cource and target
@@ -641,7 +712,7 @@ func (tpb *tablePlanBuilder) generateInsertStatement() *sqlparser.ParsedQuery {
 }
 
 func (tpb *tablePlanBuilder) generateInsertPart(buf *sqlparser.TrackedBuffer) *sqlparser.ParsedQuery {
-	if tpb.onInsert == insertIgnore {
+	if tpb.onInsert == insertIgnore || tpb.conflictPolicy == binlogdatapb.ConflictPolicy_CONFLICT_IGNORE {
 		buf.Myprintf("insert ignore into %v(", tpb.name)
 	} else {
 		buf.Myprintf("insert into %v(", tpb.name)
@@ -710,6 +781,9 @@ func (tpb *tablePlanBuilder) generateSelectPart(buf *sqlparser.TrackedBuffer, bv
 }
 
 func (tpb *tablePlanBuilder) generateOnDupPart(buf *sqlparser.TrackedBuffer) *sqlparser.ParsedQuery {
+	if tpb.conflictCol != nil {
+		return tpb.generateConflictOnDupPart(buf)
+	}
 	if tpb.onInsert != insertOnDup {
 		return nil
 	}
@@ -744,6 +818,65 @@ func (tpb *tablePlanBuilder) generateOnDupPart(buf *sqlparser.TrackedBuffer) *sq
 	return buf.ParsedQuery()
 }
 
+// generateConflictOnDupPart generates an "on duplicate key" clause that overwrites
+// an existing row only if the incoming value of the conflict column is greater
+// than or equal to the existing one. The conflict column is assigned last because
+// MySQL evaluates the assignments in order, and the later ones would otherwise
+// see its new value.
+func (tpb *tablePlanBuilder) generateConflictOnDupPart(buf *sqlparser.TrackedBuffer) *sqlparser.ParsedQuery {
+	conflictCol := tpb.conflictCol.colName
+	buf.Myprintf(" on duplicate key update ")
+	separator := ""
+	for _, cexpr := range tpb.colExprs {
+		if cexpr.isPK || cexpr == tpb.conflictCol {
+			continue
+		}
+		if tpb.isColumnGenerated(cexpr.colName) {
+			continue
+		}
+		buf.Myprintf("%s%v=if(values(%v)>=%v, values(%v), %v)", separator, cexpr.colName, conflictCol, conflictCol, cexpr.colName, cexpr.colName)
+		separator = ", "
+	}
+	buf.Myprintf("%s%v=if(values(%v)>=%v, values(%v), %v)", separator, conflictCol, conflictCol, conflictCol, conflictCol, conflictCol)
+	return buf.ParsedQuery()
+}
+
+// generateConflictConstraint restricts updates and deletes to the rows that
+// were not written by a newer row or a source of a higher priority.
+func (tpb *tablePlanBuilder) generateConflictConstraint(buf *sqlparser.TrackedBuffer, bvf *bindvarFormatter, mode bindvarMode) {
+	bvf.mode = mode
+	switch tpb.conflictCol.expr.(type) {
+	case *sqlparser.ColName, *sqlparser.Literal:
+		buf.Myprintf(" and %v<=%v", tpb.conflictCol.colName, tpb.conflictCol.expr)
+	default:
+		// Parenthesize non-trivial expressions.
+		buf.Myprintf(" and %v<=(%v)", tpb.conflictCol.colName, tpb.conflictCol.expr)
+	}
+}
+
+// generateIgnoreConstraint restricts updates and deletes to the rows that still
+// hold the values of the before image, so that the rows kept from another source
+// by CONFLICT_IGNORE are not changed. JSON columns are not compared, since their
+// values are not sent in a form that compares equal to the stored documents.
+func (tpb *tablePlanBuilder) generateIgnoreConstraint(buf *sqlparser.TrackedBuffer, bvf *bindvarFormatter) {
+	bvf.mode = bvBefore
+	for _, cexpr := range tpb.colExprs {
+		if cexpr.isPK || cexpr.colType == querypb.Type_JSON {
+			continue
+		}
+		if tpb.isColumnGenerated(cexpr.colName) {
+			continue
+		}
+		switch cexpr.expr.(type) {
+		case *sqlparser.ColName, *sqlparser.Literal:
+			buf.Myprintf(" and %v<=>%v", cexpr.colName, cexpr.expr)
+		default:
+			// Parenthesize non-trivial expressions.
+			buf.Myprintf(" and %v<=>(%v)", cexpr.colName, cexpr.expr)
+		}
+	}
+}
+
 func (tpb *tablePlanBuilder) generateUpdateStatement() *sqlparser.ParsedQuery {
 	if tpb.onInsert == insertIgnore {
 		return tpb.generateInsertStatement()
@@ -780,6 +913,12 @@ func (tpb *tablePlanBuilder) generateUpdateStatement() *sqlparser.ParsedQuery {
 		}
 	}
 	tpb.generateWhere(buf, bvf)
+	if tpb.conflictCol != nil {
+		tpb.generateConflictConstraint(buf, bvf, bvAfter)
+	}
+	if tpb.conflictPolicy == binlogdatapb.ConflictPolicy_CONFLICT_IGNORE {
+		tpb.generateIgnoreConstraint(buf, bvf)
+	}
 	return buf.ParsedQuery()
 }
 
@@ -790,6 +929,12 @@ func (tpb *tablePlanBuilder) generateDeleteStatement() *sqlparser.ParsedQuery {
 	case insertNormal:
 		buf.Myprintf("delete from %v", tpb.name)
 		tpb.generateWhere(buf, bvf)
+		if tpb.conflictCol != nil {
+			tpb.generateConflictConstraint(buf, bvf, bvBefore)
+		}
+		if tpb.conflictPolicy == binlogdatapb.ConflictPolicy_CONFLICT_IGNORE {
+			tpb.generateIgnoreConstraint(buf, bvf)
+		}
 	case insertOnDup:
 		bvf.mode = bvBefore
 		buf.Myprintf("update %v set ", tpb.name)
@@ -42,6 +42,8 @@ import (
 type vcopier struct {
 	vr        *vreplicator
 	tablePlan *TablePlan
+	// sessionQueries set up the connections used to copy rows concurrently.
+	sessionQueries []string
 }
 
 func newVCopier(vr *vreplicator) *vcopier {
@@ -108,35 +110,151 @@ func (vc *vcopier) initTablesForCopy(ctx context.Context) error {
 // copyNext also builds the copyState metadata that contains the tables and their last
 // primary key that was copied. A nil Result means that nothing has been copied.
 // A table that was fully copied is removed from copyState.
+// If the tables are copied concurrently, copyNext first fast-forwards the rows
+// copied from the snapshot of each table to the replication position, see
+// catchupCopyPositions.
 func (vc *vcopier) copyNext(ctx context.Context, settings binlogplayer.VRSettings) error {
-	qr, err := vc.vr.dbClient.Execute(fmt.Sprintf("select table_name, lastpk from _vt.copy_state where vrepl_id=%d", vc.vr.id))
+	query := fmt.Sprintf("select table_name, lastpk, caught_up_pk, copy_pos from _vt.copy_state where vrepl_id=%d", vc.vr.id)
+	qr, err := withDDL.Exec(ctx, query, vc.vr.dbClient.ExecuteFetch, vc.vr.dbClient.ExecuteFetch)
 	if err != nil {
 		return err
 	}
-	var tableToCopy string
-	copyState := make(map[string]*sqltypes.Result)
+	var tables []*tableCopyState
 	for _, row := range qr.Rows {
-		tableName := row[0].ToString()
-		lastpk := row[1].ToString()
-		if tableToCopy == "" {
-			tableToCopy = tableName
+		table := &tableCopyState{name: row[0].ToString()}
+		if table.lastpk, err = decodeLastPK(row[1].ToString()); err != nil {
+			return err
 		}
-		copyState[tableName] = nil
-		if lastpk != "" {
-			var r querypb.QueryResult
-			if err := prototext.Unmarshal([]byte(lastpk), &r); err != nil {
+		if copyPos := row[3].ToString(); copyPos != "" {
+			if table.caughtUpPK, err = decodeLastPK(row[2].ToString()); err != nil {
+				return err
+			}
+			if table.copyPos, err = mysql.DecodePosition(copyPos); err != nil {
 				return err
 			}
-			copyState[tableName] = sqltypes.Proto3ToResult(&r)
 		}
+		tables = append(tables, table)
 	}
-	if len(copyState) == 0 {
+	if len(tables) == 0 {
 		return fmt.Errorf("unexpected: there are no tables to copy")
 	}
+	if err := vc.catchupCopyPositions(ctx, tables); err != nil {
+		return err
+	}
+	copyState := make(map[string]*sqltypes.Result)
+	for _, table := range tables {
+		copyState[table.name] = table.lastpk
+	}
 	if err := vc.catchup(ctx, copyState); err != nil {
 		return err
 	}
-	return vc.copyTable(ctx, tableToCopy, copyState)
+	if *copyPhaseMaxConcurrency > 1 {
+		return vc.copyTables(ctx, copyState)
+	}
+	return vc.copyTable(ctx, tables[0].name, copyState)
+}
+
+// tableCopyState is the copy_state of a table.
+type tableCopyState struct {
+	name   string
+	lastpk *sqltypes.Result
+	// If copyPos is set, the rows after caughtUpPK, up to lastpk, were
+	// copied as of copyPos, which is ahead of the replication position.
+	caughtUpPK *sqltypes.Result
+	copyPos    mysql.Position
+}
+
+func decodeLastPK(lastpk string) (*sqltypes.Result, error) {
+	if lastpk == "" {
+		return nil, nil
+	}
+	var r querypb.QueryResult
+	if err := prototext.Unmarshal([]byte(lastpk), &r); err != nil {
+		return nil, err
+	}
+	return sqltypes.Proto3ToResult(&r), nil
+}
+
+// catchupCopyPositions fast-forwards the tables that were copied concurrently,
+// each from its own snapshot of the source, to the replication position.
+// The snapshot positions are played in order: each segment of replication
+// is applied to the rows that are already at its start position, and stops
+// at the next snapshot position, where the rows copied from that snapshot
+// catch up with the rest of their table.
+func (vc *vcopier) catchupCopyPositions(ctx context.Context, tables []*tableCopyState) error {
+	var pending []*tableCopyState
+	for _, table := range tables {
+		if !table.copyPos.IsZero() {
+			pending = append(pending, table)
+		}
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	defer vc.vr.stats.PhaseTimings.Record("fastforward", time.Now())
+
+	settings, err := binlogplayer.ReadVRSettings(vc.vr.dbClient, vc.vr.id)
+	if err != nil {
+		return err
+	}
+	// If there's no start position, the tables were copied for the first time.
+	// Replication starts at the oldest of their snapshots.
+	if settings.StartPos.IsZero() {
+		pos := minCopyPos(pending)
+		update := binlogplayer.GenerateUpdatePos(vc.vr.id, pos, time.Now().Unix(), 0, vc.vr.stats.CopyRowCount.Get(), *vreplicationStoreCompressedGTID)
+		if _, err := vc.vr.dbClient.Execute(update); err != nil {
+			return err
+		}
+		settings.StartPos = pos
+	}
+	for {
+		var remaining []*tableCopyState
+		for _, table := range pending {
+			if !settings.StartPos.AtLeast(table.copyPos) {
+				remaining = append(remaining, table)
+				continue
+			}
+			query := fmt.Sprintf("update _vt.copy_state set caught_up_pk=null, copy_pos=null where vrepl_id=%d and table_name=%s", vc.vr.id, encodeString(table.name))
+			if _, err := vc.vr.dbClient.Execute(query); err != nil {
+				return err
+			}
+			table.caughtUpPK = nil
+			table.copyPos = mysql.Position{}
+		}
+		pending = remaining
+		if len(pending) == 0 {
+			return nil
+		}
+
+		copyState := make(map[string]*sqltypes.Result)
+		for _, table := range tables {
+			copyState[table.name] = table.lastpk
+			if !table.copyPos.IsZero() {
+				copyState[table.name] = table.caughtUpPK
+			}
+		}
+		stopPos := minCopyPos(pending)
+		if err := newVPlayer(vc.vr, settings, copyState, stopPos, "fastforward").play(ctx); err != nil {
+			return err
+		}
+		if settings, err = binlogplayer.ReadVRSettings(vc.vr.dbClient, vc.vr.id); err != nil {
+			return err
+		}
+		// The vplayer returns without an error if the context is canceled.
+		if !settings.StartPos.AtLeast(stopPos) {
+			return io.EOF
+		}
+	}
+}
+
+func minCopyPos(tables []*tableCopyState) mysql.Position {
+	var pos mysql.Position
+	for _, table := range tables {
+		if pos.IsZero() || pos.AtLeast(table.copyPos) {
+			pos = table.copyPos
+		}
+	}
+	return pos
 }
 
 // catchup replays events to the subset of the tables that have been copied
@@ -225,6 +343,7 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 	var updateCopyState *sqlparser.ParsedQuery
 	var bv map[string]*querypb.BindVariable
 	var sqlbuffer bytes2.Buffer
+	var inserter *copyInserter
 	err = vc.vr.sourceVStreamer.VStreamRows(ctx, initialPlan.SendRule.Filter, lastpkpb, func(rows *binlogdatapb.VStreamRowsResponse) error {
 		for {
 			select {
@@ -259,10 +378,23 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 			buf := sqlparser.NewTrackedBuffer(nil)
 			buf.Myprintf("update _vt.copy_state set lastpk=%a where vrepl_id=%s and table_name=%s", ":lastpk", strconv.Itoa(int(vc.vr.id)), encodeString(tableName))
 			updateCopyState = buf.ParsedQuery()
+			if *copyPhaseInsertWorkers > 1 {
+				if inserter, err = vc.newCopyInserter(ctx, *copyPhaseInsertWorkers); err != nil {
+					return err
+				}
+			}
 		}
 		if len(rows.Rows) == 0 {
 			return nil
 		}
+		if inserter != nil {
+			packet, err := newCopyPacket(vc.tablePlan, updateCopyState, pkfields, rows)
+			if err != nil {
+				return err
+			}
+			bv = packet.bindVars
+			return inserter.insert(packet)
+		}
 
 		// The number of rows we receive depends on the packet size set
 		// for the row streamer. Since the packet size is roughly equivalent
@@ -315,6 +447,12 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 		}
 		return nil
 	})
+	if inserter != nil {
+		// The error of a worker is the reason why the stream was interrupted.
+		if ierr := inserter.close(); ierr != nil {
+			err = ierr
+		}
+	}
 	// If there was a timeout, return without an error.
 	select {
 	case <-ctx.Done():
@@ -0,0 +1,439 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"vitess.io/vitess/go/bytes2"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// copyTables copies up to copyPhaseMaxConcurrency tables at a time, until
+// all the tables are copied, or a timeout. Each table is streamed from its
+// own snapshot of the source, and its rows are inserted through its own
+// connections to the target. Unlike copyTable, copyTables does not
+// fast-forward the target to the position of the snapshots, because there
+// is one per table. It saves the position in copy_state instead, and the
+// next copyNext fast-forwards the tables, see catchupCopyPositions.
+func (vc *vcopier) copyTables(ctx context.Context, copyState map[string]*sqltypes.Result) error {
+	defer vc.vr.stats.PhaseTimings.Record("copy", time.Now())
+	defer vc.vr.stats.CopyLoopCount.Add(1)
+
+	settings, err := binlogplayer.ReadVRSettings(vc.vr.dbClient, vc.vr.id)
+	if err != nil {
+		return err
+	}
+	if _, err := vc.copySessionQueries(); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, *copyPhaseDuration)
+	defer cancel()
+
+	var tableNames []string
+	for tableName := range copyState {
+		tableNames = append(tableNames, tableName)
+	}
+	sort.Strings(tableNames)
+	tables := make(chan string, len(tableNames))
+	for _, tableName := range tableNames {
+		tables <- tableName
+	}
+	close(tables)
+
+	var wg sync.WaitGroup
+	var rec concurrency.FirstErrorRecorder
+	workers := *copyPhaseMaxConcurrency
+	if workers > len(tableNames) {
+		workers = len(tableNames)
+	}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for tableName := range tables {
+				if ctx.Err() != nil {
+					return
+				}
+				if err := vc.copyTableConcurrently(ctx, tableName, copyState[tableName], settings.StartPos.IsZero()); err != nil {
+					rec.RecordError(fmt.Errorf("copy of table %s failed: %v", tableName, err))
+					cancel()
+					return
+				}
+			}
+		}()
+	}
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	rowsCopiedTicker := time.NewTicker(rowsCopiedUpdateInterval)
+	defer rowsCopiedTicker.Stop()
+	for {
+		select {
+		case <-rowsCopiedTicker.C:
+			update := binlogplayer.GenerateUpdateRowsCopied(vc.vr.id, vc.vr.stats.CopyRowCount.Get())
+			_, _ = vc.vr.dbClient.Execute(update)
+		case <-done:
+			return rec.Error()
+		}
+	}
+}
+
+// copyTableConcurrently copies the next set of rows of a table, concurrently
+// with other tables. When the copy starts, the rows that were copied so far are
+// marked as caught up, and the position of the snapshot of the new rows is saved
+// in copy_state. Each packet of rows is then committed with its lastpk, like
+// in copyTable.
+// A table whose copy finishes is removed from copy_state only if none of its
+// rows were copied by this call: otherwise, the new rows must be fast-forwarded
+// first, and the next call removes the table.
+func (vc *vcopier) copyTableConcurrently(ctx context.Context, tableName string, lastpk *sqltypes.Result, noStartPos bool) error {
+	log.Infof("Copying table %s concurrently, lastpk: %v", tableName, lastpk)
+
+	dbClient, err := vc.newCopyDBClient()
+	if err != nil {
+		return err
+	}
+	defer dbClient.Close()
+
+	plan, err := buildReplicatorPlan(vc.vr.source.Filter, vc.vr.colInfoMap, nil, vc.vr.stats)
+	if err != nil {
+		return err
+	}
+	initialPlan, ok := plan.TargetTables[tableName]
+	if !ok {
+		return fmt.Errorf("plan not found for table: %s, current plans are: %#v", tableName, plan.TargetTables)
+	}
+
+	var lastpkpb *querypb.QueryResult
+	if lastpk != nil {
+		lastpkpb = sqltypes.ResultToProto3(lastpk)
+	}
+
+	var tablePlan *TablePlan
+	var pkfields []*querypb.Field
+	var updateCopyState *sqlparser.ParsedQuery
+	var bv map[string]*querypb.BindVariable
+	var inserter *copyInserter
+	err = vc.vr.sourceVStreamer.VStreamRows(ctx, initialPlan.SendRule.Filter, lastpkpb, func(rows *binlogdatapb.VStreamRowsResponse) error {
+		for {
+			select {
+			case <-ctx.Done():
+				return io.EOF
+			default:
+			}
+			// verify throttler is happy, otherwise keep looping
+			if vc.vr.vre.throttlerClient.ThrottleCheckOKOrWait(ctx) {
+				break
+			}
+		}
+		if tablePlan == nil {
+			if len(rows.Fields) == 0 {
+				return fmt.Errorf("expecting field event first, got: %v", rows)
+			}
+			query := fmt.Sprintf("update _vt.copy_state set caught_up_pk=lastpk, copy_pos=%s where vrepl_id=%d and table_name=%s",
+				encodeString(rows.Gtid), vc.vr.id, encodeString(tableName))
+			if _, err := dbClient.Execute(query); err != nil {
+				return err
+			}
+			fieldEvent := &binlogdatapb.FieldEvent{
+				TableName: initialPlan.SendRule.Match,
+			}
+			fieldEvent.Fields = append(fieldEvent.Fields, rows.Fields...)
+			tablePlan, err = plan.buildExecutionPlan(fieldEvent)
+			if err != nil {
+				return err
+			}
+			pkfields = append(pkfields, rows.Pkfields...)
+			buf := sqlparser.NewTrackedBuffer(nil)
+			buf.Myprintf("update _vt.copy_state set lastpk=%a where vrepl_id=%s and table_name=%s", ":lastpk", strconv.Itoa(int(vc.vr.id)), encodeString(tableName))
+			updateCopyState = buf.ParsedQuery()
+			if inserter, err = vc.newCopyInserter(ctx, *copyPhaseInsertWorkers); err != nil {
+				return err
+			}
+		}
+		if len(rows.Rows) == 0 {
+			return nil
+		}
+		packet, err := newCopyPacket(tablePlan, updateCopyState, pkfields, rows)
+		if err != nil {
+			return err
+		}
+		bv = packet.bindVars
+		return inserter.insert(packet)
+	})
+	if inserter != nil {
+		// The error of a worker is the reason why the stream was interrupted.
+		if ierr := inserter.close(); ierr != nil {
+			err = ierr
+		}
+	}
+	// If there was a timeout, return without an error.
+	select {
+	case <-ctx.Done():
+		log.Infof("Copy of %v stopped at lastpk: %v", tableName, bv)
+		return nil
+	default:
+	}
+	if err != nil {
+		return err
+	}
+	// If there is no start position yet, the table stays in copy_state until
+	// the position is set from the snapshots of the tables.
+	if bv != nil || noStartPos {
+		log.Infof("Copy of %v finished at lastpk: %v, waiting for fast-forward", tableName, bv)
+		return nil
+	}
+	log.Infof("Copy of %v finished", tableName)
+	query := fmt.Sprintf("delete from _vt.copy_state where vrepl_id=%d and table_name=%s", vc.vr.id, encodeString(tableName))
+	if _, err := dbClient.Execute(query); err != nil {
+		return err
+	}
+	return nil
+}
+
+// copyPacket is a packet of rows to be copied.
+type copyPacket struct {
+	insert          string
+	updateCopyState string
+	bindVars        map[string]*querypb.BindVariable
+	// prev is closed once the previous packet is committed,
+	// and committed once this one is.
+	prev      <-chan struct{}
+	committed chan struct{}
+}
+
+// newCopyPacket generates the statements that insert a packet of rows, and
+// save its lastpk in copy_state. The packet may be reused by the streamer
+// once newCopyPacket returns.
+func newCopyPacket(tablePlan *TablePlan, updateCopyState *sqlparser.ParsedQuery, pkfields []*querypb.Field, rows *binlogdatapb.VStreamRowsResponse) (*copyPacket, error) {
+	packet := &copyPacket{}
+	var sqlbuffer bytes2.Buffer
+	_, err := tablePlan.applyBulkInsert(&sqlbuffer, rows, func(sql string) (*sqltypes.Result, error) {
+		packet.insert = sql
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	buf, err := prototext.Marshal(&querypb.QueryResult{
+		Fields: pkfields,
+		Rows:   []*querypb.Row{rows.Lastpk},
+	})
+	if err != nil {
+		return nil, err
+	}
+	packet.bindVars = map[string]*querypb.BindVariable{
+		"lastpk": {
+			Type:  sqltypes.VarBinary,
+			Value: buf,
+		},
+	}
+	if packet.updateCopyState, err = updateCopyState.GenerateQuery(packet.bindVars, nil); err != nil {
+		return nil, err
+	}
+	return packet, nil
+}
+
+// copyInserter inserts the packets of rows of a table concurrently, using
+// workers with their own connections. The packets still come from the single
+// stream of the table, which reads its rows in primary key order: the table is
+// not split in ranges copied independently, and copy_state keeps one lastpk
+// for it. Each packet is inserted in its own transaction, which also saves
+// the lastpk of the packet in copy_state.
+// The transactions are committed in the order of the packets, so that
+// copy_state never points past a row that is not committed: if the copy
+// is interrupted, the packets that were not committed are rolled back,
+// and copied again when the copy resumes from lastpk.
+type copyInserter struct {
+	vc      *vcopier
+	ctx     context.Context
+	cancel  context.CancelFunc
+	packets chan *copyPacket
+	wg      sync.WaitGroup
+	// committed is closed once the last packet that was sent is committed.
+	committed chan struct{}
+
+	mu  sync.Mutex
+	err error
+}
+
+func (vc *vcopier) newCopyInserter(ctx context.Context, workers int) (*copyInserter, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	ins := &copyInserter{
+		vc:        vc,
+		ctx:       ctx,
+		cancel:    cancel,
+		packets:   make(chan *copyPacket, workers),
+		committed: make(chan struct{}),
+	}
+	close(ins.committed)
+	for i := 0; i < workers; i++ {
+		dbClient, err := vc.newCopyDBClient()
+		if err != nil {
+			ins.close()
+			return nil, err
+		}
+		ins.wg.Add(1)
+		go ins.run(dbClient)
+	}
+	return ins, nil
+}
+
+// insert sends a packet to the workers. It blocks while all of them are busy.
+func (ins *copyInserter) insert(packet *copyPacket) error {
+	packet.prev = ins.committed
+	packet.committed = make(chan struct{})
+	ins.committed = packet.committed
+	select {
+	case ins.packets <- packet:
+		return nil
+	case <-ins.ctx.Done():
+		if err := ins.error(); err != nil {
+			return err
+		}
+		return io.EOF
+	}
+}
+
+// close waits for the packets that were sent to be committed, and returns
+// the first error of the workers.
+func (ins *copyInserter) close() error {
+	close(ins.packets)
+	ins.wg.Wait()
+	ins.cancel()
+	return ins.error()
+}
+
+func (ins *copyInserter) error() error {
+	ins.mu.Lock()
+	defer ins.mu.Unlock()
+	return ins.err
+}
+
+func (ins *copyInserter) run(dbClient *vdbClient) {
+	defer ins.wg.Done()
+	defer dbClient.Close()
+
+	for packet := range ins.packets {
+		if ins.ctx.Err() != nil {
+			continue
+		}
+		if err := ins.apply(dbClient, packet); err != nil {
+			dbClient.Rollback()
+			ins.mu.Lock()
+			if ins.err == nil && ins.ctx.Err() == nil {
+				ins.err = err
+			}
+			ins.mu.Unlock()
+			ins.cancel()
+		}
+	}
+}
+
+func (ins *copyInserter) apply(dbClient *vdbClient, packet *copyPacket) error {
+	stats := ins.vc.vr.stats
+	if err := dbClient.Begin(); err != nil {
+		return err
+	}
+	start := time.Now()
+	qr, err := dbClient.ExecuteWithRetry(ins.ctx, packet.insert)
+	if err != nil {
+		return err
+	}
+	stats.QueryTimings.Record("copy", start)
+	stats.CopyRowCount.Add(int64(qr.RowsAffected))
+	stats.QueryCount.Add("copy", 1)
+
+	select {
+	case <-packet.prev:
+	default:
+		select {
+		case <-packet.prev:
+		case <-ins.ctx.Done():
+			return io.EOF
+		}
+	}
+	if _, err := dbClient.Execute(packet.updateCopyState); err != nil {
+		return err
+	}
+	if err := dbClient.Commit(); err != nil {
+		return err
+	}
+	close(packet.committed)
+	return nil
+}
+
+// newCopyDBClient opens a new connection to the target, with the same
+// session settings as the connection of the vreplicator.
+func (vc *vcopier) newCopyDBClient() (*vdbClient, error) {
+	queries, err := vc.copySessionQueries()
+	if err != nil {
+		return nil, err
+	}
+	dbClient := newVDBClient(vc.vr.vre.dbClientFactoryFiltered(), vc.vr.stats)
+	if err := dbClient.Connect(); err != nil {
+		return nil, err
+	}
+	for _, query := range queries {
+		if _, err := dbClient.Execute(query); err != nil {
+			dbClient.Close()
+			return nil, err
+		}
+	}
+	return dbClient, nil
+}
+
+// copySessionQueries returns the queries that set up the session of the
+// connections opened by newCopyDBClient. It must be called before
+// newCopyDBClient is called concurrently, because it uses the connection
+// of the vreplicator.
+func (vc *vcopier) copySessionQueries() ([]string, error) {
+	if vc.sessionQueries != nil {
+		return vc.sessionQueries, nil
+	}
+	qr, err := vc.vr.dbClient.Execute(getSQLModeQuery)
+	if err != nil {
+		return nil, fmt.Errorf("could not get the sql_mode on target: %v", err)
+	}
+	vc.sessionQueries = []string{
+		"set @@session.time_zone = '+00:00'",
+		"set names binary",
+		fmt.Sprintf(setSQLModeQueryf, qr.Named().Row().AsString("sql_mode", "")),
+		"set foreign_key_checks=0",
+	}
+	return vc.sessionQueries, nil
+}
@@ -0,0 +1,313 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/vstreamer"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+func TestPlayerCopyTablesConcurrently(t *testing.T) {
+	defer deleteTablet(addTablet(100))
+
+	reset := vstreamer.AdjustPacketSize(1)
+	defer reset()
+
+	savedMaxConcurrency, savedInsertWorkers := *copyPhaseMaxConcurrency, *copyPhaseInsertWorkers
+	*copyPhaseMaxConcurrency, *copyPhaseInsertWorkers = 2, 3
+	defer func() {
+		*copyPhaseMaxConcurrency, *copyPhaseInsertWorkers = savedMaxConcurrency, savedInsertWorkers
+	}()
+
+	execStatements(t, []string{
+		"create table src1(id int, val varbinary(128), primary key(id))",
+		"insert into src1 values(1, 'a'), (2, 'b'), (3, 'c'), (4, 'd'), (5, 'e')",
+		fmt.Sprintf("create table %s.dst1(id int, val varbinary(128), primary key(id))", vrepldb),
+		"create table src2(id int, val varbinary(128), primary key(id))",
+		"insert into src2 values(1, 'aa'), (2, 'bb'), (3, 'cc')",
+		fmt.Sprintf("create table %s.dst2(id int, val varbinary(128), primary key(id))", vrepldb),
+		"create table src3(id int, val varbinary(128), primary key(id))",
+		fmt.Sprintf("create table %s.dst3(id int, val varbinary(128), primary key(id))", vrepldb),
+	})
+	defer execStatements(t, []string{
+		"drop table src1",
+		fmt.Sprintf("drop table %s.dst1", vrepldb),
+		"drop table src2",
+		fmt.Sprintf("drop table %s.dst2", vrepldb),
+		"drop table src3",
+		fmt.Sprintf("drop table %s.dst3", vrepldb),
+	})
+	env.SchemaEngine.Reload(context.Background())
+
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "dst1",
+			Filter: "select * from src1",
+		}, {
+			Match:  "dst2",
+			Filter: "select * from src2",
+		}, {
+			Match:  "dst3",
+			Filter: "select * from src3",
+		}},
+	}
+	bls := &binlogdatapb.BinlogSource{
+		Keyspace: env.KeyspaceName,
+		Shard:    env.ShardName,
+		Filter:   filter,
+		OnDdl:    binlogdatapb.OnDDLAction_IGNORE,
+	}
+	query := binlogplayer.CreateVReplicationState("test", bls, "", binlogplayer.VReplicationInit, playerEngine.dbName)
+	qr, err := playerEngine.Exec(query)
+	require.NoError(t, err)
+	defer func() {
+		query := fmt.Sprintf("delete from _vt.vreplication where id = %d", qr.InsertID)
+		if _, err := playerEngine.Exec(query); err != nil {
+			t.Fatal(err)
+		}
+		expectDeleteQueries(t)
+	}()
+
+	// The queries of the tables that are copied concurrently are interleaved.
+	timeout := time.After(30 * time.Second)
+	for running := false; !running; {
+		select {
+		case q := <-globalDBQueries:
+			running = strings.HasPrefix(q, "update _vt.vreplication set state='Running'")
+		case <-timeout:
+			t.Fatal("copy phase did not complete")
+		}
+	}
+
+	expectData(t, "dst1", [][]string{
+		{"1", "a"},
+		{"2", "b"},
+		{"3", "c"},
+		{"4", "d"},
+		{"5", "e"},
+	})
+	expectData(t, "dst2", [][]string{
+		{"1", "aa"},
+		{"2", "bb"},
+		{"3", "cc"},
+	})
+	expectData(t, "dst3", [][]string{})
+	expectQueryResult(t, fmt.Sprintf("select count(*) from _vt.copy_state where vrepl_id=%d", qr.InsertID), [][]string{{"0"}})
+	validateCopyRowCountStat(t, 8)
+}
+
+// TestPlayerCopyTableConcurrentContinuation tests that the rows which were copied
+// from a snapshot that is ahead of the replication position are fast-forwarded
+// separately from the rows that were already caught up.
+func TestPlayerCopyTableConcurrentContinuation(t *testing.T) {
+	defer deleteTablet(addTablet(100))
+
+	savedMaxConcurrency := *copyPhaseMaxConcurrency
+	*copyPhaseMaxConcurrency = 2
+	defer func() { *copyPhaseMaxConcurrency = savedMaxConcurrency }()
+
+	execStatements(t, []string{
+		"create table src1(id1 int, id2 int, val varbinary(128), primary key(id1, id2))",
+		"insert into src1 values(1,1,'a'), (2,2,'b'), (3,3,'c')",
+		fmt.Sprintf("create table %s.dst1(id int, val varbinary(128), primary key(id))", vrepldb),
+		// Row 1 was copied as of pos, and row 2 as of copyPos.
+		fmt.Sprintf("insert into %s.dst1 values(1,'a'), (2,'b1')", vrepldb),
+	})
+	defer execStatements(t, []string{
+		"drop table src1",
+		fmt.Sprintf("drop table %s.dst1", vrepldb),
+	})
+	env.SchemaEngine.Reload(context.Background())
+
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "dst1",
+			Filter: "select id1 as id, val from src1",
+		}},
+	}
+	pos := primaryPosition(t)
+	execStatements(t, []string{
+		"update src1 set val='b1' where id1=2",
+		"update src1 set val='a1' where id1=1",
+	})
+	copyPos := primaryPosition(t)
+
+	bls := &binlogdatapb.BinlogSource{
+		Keyspace: env.KeyspaceName,
+		Shard:    env.ShardName,
+		Filter:   filter,
+		OnDdl:    binlogdatapb.OnDDLAction_IGNORE,
+	}
+	query := binlogplayer.CreateVReplicationState("test", bls, "", binlogplayer.BlpStopped, playerEngine.dbName)
+	qr, err := playerEngine.Exec(query)
+	require.NoError(t, err)
+	id := qr.InsertID
+	lastpk := func(id1, id2 int) string {
+		r := sqltypes.ResultToProto3(sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id1|id2",
+				"int32|int32",
+			),
+			fmt.Sprintf("%d|%d", id1, id2),
+		))
+		r.RowsAffected = 0
+		return encodeString(fmt.Sprintf("%v", r))
+	}
+	execStatements(t, []string{
+		fmt.Sprintf("insert into _vt.copy_state(vrepl_id, table_name, lastpk, caught_up_pk, copy_pos) values(%d, 'dst1', %s, %s, %s)",
+			id, lastpk(2, 2), lastpk(1, 1), encodeString(copyPos)),
+	})
+	_, err = playerEngine.Exec(fmt.Sprintf("update _vt.vreplication set state='Copying', pos=%s where id=%d", encodeString(pos), id))
+	require.NoError(t, err)
+	defer func() {
+		query := fmt.Sprintf("delete from _vt.vreplication where id = %d", id)
+		if _, err := playerEngine.Exec(query); err != nil {
+			t.Fatal(err)
+		}
+		expectDeleteQueries(t)
+	}()
+
+	for q := range globalDBQueries {
+		if strings.HasPrefix(q, "update") {
+			break
+		}
+	}
+
+	expectNontxQueries(t, []string{
+		"/update _vt.vreplication set message='Picked source tablet.*",
+		// Fast-forward to copyPos: only the caught up rows get the events.
+		"update dst1 set val='b1' where id=2 and (2,2) <= (1,1)",
+		"update dst1 set val='a1' where id=1 and (1,1) <= (1,1)",
+		"/update _vt.copy_state set caught_up_pk=null, copy_pos=null where vrepl_id=.* and table_name='dst1'",
+		// Copy
+		"/update _vt.copy_state set caught_up_pk=lastpk, copy_pos=.* and table_name='dst1'",
+		"insert into dst1(id,val) values (3,'c')",
+		`/update _vt.copy_state set lastpk='fields:{name:\\"id1\\" type:INT32} fields:{name:\\"id2\\" type:INT32} rows:{lengths:1 lengths:1 values:\\"33\\"}' where vrepl_id=.*`,
+		// The copied row is fast-forwarded. The table is removed from copy_state
+		// once there is nothing left to copy.
+		"/update _vt.copy_state set caught_up_pk=null, copy_pos=null where vrepl_id=.* and table_name='dst1'",
+		"/update _vt.copy_state set caught_up_pk=lastpk, copy_pos=.* and table_name='dst1'",
+		"/delete from _vt.copy_state.*dst1",
+		"/update _vt.vreplication set state='Running'",
+	})
+	expectData(t, "dst1", [][]string{
+		{"1", "a1"},
+		{"2", "b1"},
+		{"3", "c"},
+	})
+}
+
+// fakeCopyDBClient is a connection of a copyInserter. It records the
+// copy_state updates that are committed.
+type fakeCopyDBClient struct {
+	mu        *sync.Mutex
+	committed *[]string
+	// delays and errors are keyed by insert statement.
+	delays map[string]time.Duration
+	errors map[string]error
+	update string
+}
+
+func (dc *fakeCopyDBClient) DBName() string  { return "db" }
+func (dc *fakeCopyDBClient) Connect() error  { return nil }
+func (dc *fakeCopyDBClient) Begin() error    { return nil }
+func (dc *fakeCopyDBClient) Rollback() error { dc.update = ""; return nil }
+func (dc *fakeCopyDBClient) Close()          {}
+
+func (dc *fakeCopyDBClient) Commit() error {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	*dc.committed = append(*dc.committed, dc.update)
+	dc.update = ""
+	return nil
+}
+
+func (dc *fakeCopyDBClient) ExecuteFetch(query string, maxrows int) (*sqltypes.Result, error) {
+	if strings.HasPrefix(query, "update") {
+		dc.update = query
+		return &sqltypes.Result{RowsAffected: 1}, nil
+	}
+	time.Sleep(dc.delays[query])
+	if err := dc.errors[query]; err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{RowsAffected: 1}, nil
+}
+
+func newTestCopyInserter(t *testing.T, workers int, delays map[string]time.Duration, errors map[string]error) (*copyInserter, *[]string) {
+	var mu sync.Mutex
+	var committed []string
+	vc := newVCopier(&vreplicator{
+		stats: binlogplayer.NewStats(),
+		vre: &Engine{
+			dbClientFactoryFiltered: func() binlogplayer.DBClient {
+				return &fakeCopyDBClient{mu: &mu, committed: &committed, delays: delays, errors: errors}
+			},
+		},
+	})
+	vc.sessionQueries = []string{}
+	ins, err := vc.newCopyInserter(context.Background(), workers)
+	require.NoError(t, err)
+	return ins, &committed
+}
+
+func TestCopyInserterCommitsInOrder(t *testing.T) {
+	// The first packets are the slowest to insert.
+	ins, committed := newTestCopyInserter(t, 3, map[string]time.Duration{
+		"insert 1": 100 * time.Millisecond,
+		"insert 2": 50 * time.Millisecond,
+	}, nil)
+	var want []string
+	for i := 1; i <= 6; i++ {
+		update := fmt.Sprintf("update %d", i)
+		want = append(want, update)
+		require.NoError(t, ins.insert(&copyPacket{insert: fmt.Sprintf("insert %d", i), updateCopyState: update}))
+	}
+	require.NoError(t, ins.close())
+	assert.Equal(t, want, *committed)
+}
+
+func TestCopyInserterError(t *testing.T) {
+	ins, committed := newTestCopyInserter(t, 2, map[string]time.Duration{
+		"insert 1": 50 * time.Millisecond,
+	}, map[string]error{
+		"insert 2": &mysql.SQLError{Num: mysql.ERDupEntry, Message: "insert failed"},
+	})
+	var err error
+	for i := 1; i <= 4 && err == nil; i++ {
+		err = ins.insert(&copyPacket{insert: fmt.Sprintf("insert %d", i), updateCopyState: fmt.Sprintf("update %d", i)})
+	}
+	err = ins.close()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "insert failed")
+	// The packets after the one that failed are not committed.
+	assert.Equal(t, []string{"update 1"}, *committed)
+}
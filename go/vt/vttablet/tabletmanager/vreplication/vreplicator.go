@@ -54,6 +54,13 @@ var (
 
 	copyPhaseDuration   = flag.Duration("vreplication_copy_phase_duration", 1*time.Hour, "Duration for each copy phase loop (before running the next catchup: default 1h)")
 	replicaLagTolerance = flag.Duration("vreplication_replica_lag_tolerance", 1*time.Minute, "Replica lag threshold duration: once lag is below this we switch from copy phase to the replication (streaming) phase")
+	// copyPhaseMaxConcurrency and copyPhaseInsertWorkers are the number of tables copied concurrently
+	// by a stream, and the number of workers inserting the rows of each table. With the defaults,
+	// the copy phase copies one table at a time, and inserts its rows in the streaming goroutine.
+	// A table is not split in primary key ranges: its rows are still read by a single stream from
+	// the source, and copy_state keeps one lastpk per table, so only the inserts are concurrent.
+	copyPhaseMaxConcurrency = flag.Int("vreplication_copy_phase_max_concurrency", 1, "Maximum number of tables a vreplication stream copies concurrently during the copy phase")
+	copyPhaseInsertWorkers  = flag.Int("vreplication_copy_phase_insert_workers", 1, "Number of workers, each with its own connection, concurrently inserting the rows of a table during the copy phase. The rows of a table are still read from the source by a single stream, in primary key order, and committed in that order: only the inserts are concurrent, the table is not split in primary key ranges")
 
 	// vreplicationHeartbeatUpdateInterval determines how often the time_updated column is updated if there are no real events on the source and the source
 	// vstream is only sending heartbeats for this long. Keep this low if you expect high QPS and are monitoring this column to alert about potential
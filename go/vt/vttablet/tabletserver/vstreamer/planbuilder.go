@@ -76,8 +76,14 @@ const (
 	GreaterThanEqual
 	// NotEqual is used to filter a comparable column if != specific value
 	NotEqual
+	// ExprMatch is used to filter a row on a condition that is not a simple
+	// comparison, like OR, IN, LIKE or IS NULL, using the evalengine
+	ExprMatch
 )
 
+// ExcludeStr is the filter value for excluding tables that match a rule.
+const ExcludeStr = "exclude"
+
 // Filter contains opcodes for filtering.
 type Filter struct {
 	Opcode Opcode
@@ -92,6 +98,10 @@ type Filter struct {
 	Vindex        vindexes.Vindex
 	VindexColumns []int
 	KeyRange      *topodatapb.KeyRange
+
+	// Expr is the condition evaluated for ExprMatch.
+	// Its columns refer to the column numbers of the table.
+	Expr evalengine.Expr
 }
 
 // ColExpr represents a column expression.
@@ -106,6 +116,11 @@ type ColExpr struct {
 	Vindex        vindexes.Vindex
 	VindexColumns []int
 
+	// Expr, if set, is evaluated against the row to generate the value.
+	// If so, ColNum is ignored. Its columns refer to the column numbers
+	// of the table.
+	Expr evalengine.Expr
+
 	Field *querypb.Field
 
 	FixedValue sqltypes.Value
@@ -211,6 +226,7 @@ func (plan *Plan) filter(values, result []sqltypes.Value, charsets []collations.
 	if len(result) != len(plan.ColExprs) {
 		return false, fmt.Errorf("expected %d values in result slice", len(plan.ColExprs))
 	}
+	var env *evalengine.ExpressionEnv
 	for _, filter := range plan.Filters {
 		switch filter.Opcode {
 		case VindexMatch:
@@ -221,6 +237,17 @@ func (plan *Plan) filter(values, result []sqltypes.Value, charsets []collations.
 			if !key.KeyRangeContains(filter.KeyRange, ksid) {
 				return false, nil
 			}
+		case ExprMatch:
+			if env == nil {
+				env = newRowEnv(values)
+			}
+			match, err := evaluateFilter(env, filter.Expr)
+			if err != nil {
+				return false, err
+			}
+			if !match {
+				return false, nil
+			}
 		default:
 			match, err := compare(filter.Opcode, values[filter.ColNum], filter.Value, charsets[filter.ColNum])
 			if err != nil {
@@ -232,6 +259,17 @@ func (plan *Plan) filter(values, result []sqltypes.Value, charsets []collations.
 		}
 	}
 	for i, colExpr := range plan.ColExprs {
+		if colExpr.Expr != nil {
+			if env == nil {
+				env = newRowEnv(values)
+			}
+			evalResult, err := env.Evaluate(colExpr.Expr)
+			if err != nil {
+				return false, err
+			}
+			result[i] = evalResult.Value()
+			continue
+		}
 		if colExpr.ColNum == -1 {
 			result[i] = colExpr.FixedValue
 			continue
@@ -252,6 +290,25 @@ func (plan *Plan) filter(values, result []sqltypes.Value, charsets []collations.
 	return true, nil
 }
 
+// newRowEnv returns the environment to evaluate the filters and
+// the column expressions of a plan against a row.
+func newRowEnv(values []sqltypes.Value) *evalengine.ExpressionEnv {
+	return &evalengine.ExpressionEnv{
+		DefaultCollation: collations.Default(),
+		Row:              values,
+	}
+}
+
+// evaluateFilter returns true if the condition is true for the row.
+// Like in a MySQL where clause, a result matches if it is neither NULL nor zero.
+func evaluateFilter(env *evalengine.ExpressionEnv, expr evalengine.Expr) (bool, error) {
+	result, err := env.Evaluate(expr)
+	if err != nil {
+		return false, err
+	}
+	return result.ToBoolean(), nil
+}
+
 func getKeyspaceID(values []sqltypes.Value, vindex vindexes.Vindex, vindexColumns []int, fields []*querypb.Field) (key.DestinationKeyspaceID, error) {
 	vindexValues := make([]sqltypes.Value, 0, len(vindexColumns))
 	for _, col := range vindexColumns {
@@ -322,9 +379,9 @@ func ruleMatches(tableName string, filter *binlogdatapb.Filter) bool {
 			if !result {
 				continue
 			}
-			return true
+			return rule.Filter != ExcludeStr
 		case tableName == rule.Match:
-			return true
+			return rule.Filter != ExcludeStr
 		}
 	}
 	return false
@@ -338,6 +395,8 @@ func tableMatches(table sqlparser.TableName, dbname string, filter *binlogdatapb
 	return ruleMatches(table.Name.String(), filter)
 }
 
+// buildPlan returns a nil plan if the table does not match any rule, or if
+// the first rule it matches excludes it.
 func buildPlan(ti *Table, vschema *localVSchema, filter *binlogdatapb.Filter) (*Plan, error) {
 	for _, rule := range filter.Rules {
 		switch {
@@ -350,8 +409,14 @@ func buildPlan(ti *Table, vschema *localVSchema, filter *binlogdatapb.Filter) (*
 			if !result {
 				continue
 			}
+			if rule.Filter == ExcludeStr {
+				return nil, nil
+			}
 			return buildREPlan(ti, vschema, rule.Filter)
 		case rule.Match == ti.Name:
+			if rule.Filter == ExcludeStr {
+				return nil, nil
+			}
 			return buildTablePlan(ti, vschema, rule.Filter)
 		}
 	}
@@ -482,14 +547,17 @@ func (plan *Plan) analyzeWhere(vschema *localVSchema, where *sqlparser.Where) er
 	for _, expr := range exprs {
 		switch expr := expr.(type) {
 		case *sqlparser.ComparisonExpr:
+			if !isSimpleComparison(expr) {
+				if err := plan.analyzeExprFilter(expr); err != nil {
+					return err
+				}
+				continue
+			}
 			opcode, err := getOpcode(expr)
 			if err != nil {
 				return err
 			}
-			qualifiedName, ok := expr.Left.(*sqlparser.ColName)
-			if !ok {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
+			qualifiedName := expr.Left.(*sqlparser.ColName)
 			if !qualifiedName.Qualifier.IsEmpty() {
 				return fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(qualifiedName))
 			}
@@ -497,15 +565,7 @@ func (plan *Plan) analyzeWhere(vschema *localVSchema, where *sqlparser.Where) er
 			if err != nil {
 				return err
 			}
-			val, ok := expr.Right.(*sqlparser.Literal)
-			if !ok {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			//StrVal is varbinary, we do not support varchar since we would have to implement all collation types
-			if val.Type != sqlparser.IntVal && val.Type != sqlparser.StrVal {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			pv, err := evalengine.Translate(val, semantics.EmptySemTable())
+			pv, err := evalengine.Translate(expr.Right, semantics.EmptySemTable())
 			if err != nil {
 				return err
 			}
@@ -521,18 +581,109 @@ func (plan *Plan) analyzeWhere(vschema *localVSchema, where *sqlparser.Where) er
 			})
 		case *sqlparser.FuncExpr:
 			if !expr.Name.EqualString("in_keyrange") {
-				return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
+				if err := plan.analyzeExprFilter(expr); err != nil {
+					return err
+				}
+				continue
 			}
 			if err := plan.analyzeInKeyRange(vschema, expr.Exprs); err != nil {
 				return err
 			}
 		default:
-			return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
+			if err := plan.analyzeExprFilter(expr); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// isSimpleComparison returns true if the expression compares a column
+// with an integer or string literal. Such comparisons are filtered
+// without going through the evalengine.
+func isSimpleComparison(expr *sqlparser.ComparisonExpr) bool {
+	if _, err := getOpcode(expr); err != nil {
+		return false
+	}
+	if _, ok := expr.Left.(*sqlparser.ColName); !ok {
+		return false
+	}
+	val, ok := expr.Right.(*sqlparser.Literal)
+	if !ok {
+		return false
+	}
+	//StrVal is varbinary, we do not support varchar since we would have to implement all collation types
+	return val.Type == sqlparser.IntVal || val.Type == sqlparser.StrVal
+}
+
+// analyzeExprFilter translates any other condition, like an OR, IN, LIKE
+// or IS NULL, into an evalengine expression that is evaluated for every row.
+// Conditions that need more than the row itself to be evaluated are not supported.
+func (plan *Plan) analyzeExprFilter(expr sqlparser.Expr) error {
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.Subquery, sqlparser.Argument, sqlparser.ListArg:
+			return false, fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
+		case *sqlparser.FuncExpr:
+			if node.Name.EqualString("in_keyrange") {
+				return false, fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
+			}
+		}
+		if sqlparser.IsAggregation(node) {
+			return false, fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
 		}
+		return true, nil
+	}, expr)
+	if err != nil {
+		return err
+	}
+	// The expression is not simplified: constant IN lists would be folded
+	// into tuple literals, which the evalengine cannot typecheck.
+	translated, err := evalengine.TranslateEx(expr, &filterLookup{table: plan.Table}, false)
+	if err != nil {
+		return err
 	}
+	plan.Filters = append(plan.Filters, Filter{
+		Opcode: ExprMatch,
+		Expr:   translated,
+	})
 	return nil
 }
 
+// filterLookup resolves the columns of a where clause condition
+// for the evalengine, using the column numbers of the table.
+type filterLookup struct {
+	table *Table
+}
+
+var _ evalengine.TranslationLookup = (*filterLookup)(nil)
+
+// ColumnLookup implements the evalengine.TranslationLookup interface.
+func (fl *filterLookup) ColumnLookup(col *sqlparser.ColName) (int, error) {
+	if !col.Qualifier.IsEmpty() {
+		return 0, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(col))
+	}
+	return findColumn(fl.table, col.Name)
+}
+
+// CollationForExpr implements the evalengine.TranslationLookup interface.
+func (fl *filterLookup) CollationForExpr(expr sqlparser.Expr) collations.ID {
+	col, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return collations.Unknown
+	}
+	colnum := fl.table.FindColumn(col.Name)
+	if colnum == -1 {
+		return collations.Unknown
+	}
+	return collations.ID(fl.table.Fields[colnum].Charset)
+}
+
+// DefaultCollation implements the evalengine.TranslationLookup interface.
+func (fl *filterLookup) DefaultCollation() collations.ID {
+	return collations.Default()
+}
+
 // splitAndExpression breaks up the Expr into AND-separated conditions
 // and appends them to filters, which can be shuffled and recombined
 // as needed.
@@ -594,7 +745,10 @@ func (plan *Plan) analyzeExpr(vschema *localVSchema, selExpr sqlparser.SelectExp
 		}, nil
 	case *sqlparser.FuncExpr:
 		if inner.Name.Lowered() != "keyspace_id" {
-			return ColExpr{}, fmt.Errorf("unsupported function: %v", sqlparser.String(inner))
+			if inner.IsAggregate() {
+				return ColExpr{}, fmt.Errorf("unsupported function: %v", sqlparser.String(inner))
+			}
+			return plan.analyzeColExpr(aliased)
 		}
 		if len(inner.Exprs) != 0 {
 			return ColExpr{}, fmt.Errorf("unexpected: %v", sqlparser.String(inner))
@@ -616,6 +770,10 @@ func (plan *Plan) analyzeExpr(vschema *localVSchema, selExpr sqlparser.SelectExp
 			VindexColumns: vindexColumns,
 		}, nil
 	case *sqlparser.Literal:
+		// Aliased literals are constant column expressions, like '***' as email.
+		if !aliased.As.IsEmpty() {
+			return plan.analyzeColExpr(aliased)
+		}
 		//allow only intval 1
 		if inner.Type != sqlparser.IntVal {
 			return ColExpr{}, fmt.Errorf("only integer literals are supported")
@@ -647,11 +805,60 @@ func (plan *Plan) analyzeExpr(vschema *localVSchema, selExpr sqlparser.SelectExp
 			Field:  field,
 		}, nil
 	default:
-		log.Infof("Unsupported expression: %v", inner)
-		return ColExpr{}, fmt.Errorf("unsupported: %v", sqlparser.String(aliased.Expr))
+		return plan.analyzeColExpr(aliased)
 	}
 }
 
+// analyzeColExpr translates any other select expression, like sha2(email, 256)
+// or a CASE, into an evalengine expression that generates the value of the column
+// from the row. The expression must have an alias, which is the name of the field.
+func (plan *Plan) analyzeColExpr(aliased *sqlparser.AliasedExpr) (ColExpr, error) {
+	if aliased.As.IsEmpty() {
+		return ColExpr{}, fmt.Errorf("expression needs an alias: %v", sqlparser.String(aliased))
+	}
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.Subquery, sqlparser.Argument, sqlparser.ListArg:
+			return false, fmt.Errorf("unsupported: %v", sqlparser.String(aliased.Expr))
+		case *sqlparser.FuncExpr:
+			if node.Name.EqualString("keyspace_id") || node.Name.EqualString("in_keyrange") {
+				return false, fmt.Errorf("unsupported: %v", sqlparser.String(aliased.Expr))
+			}
+		}
+		if sqlparser.IsAggregation(node) {
+			return false, fmt.Errorf("unsupported function: %v", sqlparser.String(node))
+		}
+		return true, nil
+	}, aliased.Expr)
+	if err != nil {
+		return ColExpr{}, err
+	}
+	expr, err := evalengine.TranslateEx(aliased.Expr, &filterLookup{table: plan.Table}, false)
+	if err != nil {
+		return ColExpr{}, err
+	}
+	env := &evalengine.ExpressionEnv{
+		DefaultCollation: collations.Default(),
+		Fields:           plan.Table.Fields,
+	}
+	typ, err := env.TypeOf(expr)
+	if err != nil {
+		return ColExpr{}, err
+	}
+	charset := collations.ID(collations.CollationBinaryID)
+	if sqltypes.IsText(typ) {
+		charset = collations.Default()
+	}
+	return ColExpr{
+		Expr: expr,
+		Field: &querypb.Field{
+			Name:    aliased.As.String(),
+			Type:    typ,
+			Charset: uint32(charset),
+		},
+	}, nil
+}
+
 // analyzeInKeyRange allows the following constructs: "in_keyrange('-80')",
 // "in_keyrange(col, 'hash', '-80')", "in_keyrange(col, 'local_vindex', '-80')", or
 // "in_keyrange(col, 'ks.external_vindex', '-80')".
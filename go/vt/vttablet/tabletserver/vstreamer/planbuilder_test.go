@@ -96,6 +96,9 @@ func init() {
 func TestMustSendDDL(t *testing.T) {
 	filter := &binlogdatapb.Filter{
 		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1x",
+			Filter: "exclude",
+		}, {
 			Match: "/t1.*/",
 		}, {
 			Match: "t2",
@@ -156,6 +159,9 @@ func TestMustSendDDL(t *testing.T) {
 	}, {
 		sql:    "drop table t2",
 		output: true,
+	}, {
+		sql:    "create table t1x(id int)",
+		output: false,
 	}, {
 		sql:    "create table t1a(id int)",
 		db:     "db",
@@ -401,6 +407,12 @@ func TestPlanBuilder(t *testing.T) {
 	}, {
 		inTable: t2,
 		inRule:  &binlogdatapb.Rule{Match: "/t1/"},
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "exclude"},
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "/t.*/", Filter: "exclude"},
 	}, {
 		inTable: regional,
 		inRule:  &binlogdatapb.Rule{Match: "regional", Filter: "select val, id from regional where in_keyrange('-80')"},
@@ -498,6 +510,26 @@ func TestPlanBuilder(t *testing.T) {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where max(id)"},
 		outErr:  `unsupported constraint: max(id)`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id = 1 or in_keyrange('-80')"},
+		outErr:  `unsupported constraint: id = 1 or in_keyrange('-80')`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id in (select id from t2)"},
+		outErr:  `unsupported constraint: id in (select id from t2)`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where val like :val"},
+		outErr:  `unsupported constraint: val like :val`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where none is null"},
+		outErr:  "column `none` not found in table t1",
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where t1.id in (1, 2)"},
+		outErr:  `unsupported qualifier for column: t1.id`,
 	}, {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where in_keyrange(id)"},
@@ -538,7 +570,23 @@ func TestPlanBuilder(t *testing.T) {
 	}, {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id+1, val from t1"},
-		outErr:  `unsupported: id + 1`,
+		outErr:  `expression needs an alias: id + 1`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, (select max(id) from t2) as m from t1"},
+		outErr:  `unsupported: (select max(id) from t2)`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, concat(val, :suffix) as val from t1"},
+		outErr:  `unsupported: concat(val, :suffix)`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, hex(keyspace_id()) as ksid from t1"},
+		outErr:  `unsupported: hex(keyspace_id())`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, sha2(val) as val from t1"},
+		outErr:  `Incorrect parameter count in the call to native function 'SHA2'`,
 	}, {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select t1.id, val from t1"},
@@ -663,6 +711,99 @@ func TestPlanBuilderFilterComparison(t *testing.T) {
 	}
 }
 
+func TestPlanBuilderFilterExpression(t *testing.T) {
+	t1 := &Table{
+		Name: "t1",
+		Fields: []*querypb.Field{{
+			Name: "id",
+			Type: sqltypes.Int64,
+		}, {
+			Name: "val",
+			Type: sqltypes.VarBinary,
+		}},
+	}
+	rows := [][]sqltypes.Value{
+		{sqltypes.NewInt64(1), sqltypes.NewVarBinary("aaa")},
+		{sqltypes.NewInt64(2), sqltypes.NewVarBinary("abc")},
+		{sqltypes.NewInt64(3), sqltypes.NULL},
+		{sqltypes.NewInt64(4), sqltypes.NewVarBinary("xyz")},
+	}
+	testcases := []struct {
+		inFilter string
+		outIDs   []int64
+	}{{
+		inFilter: "select * from t1 where id in (1, 3)",
+		outIDs:   []int64{1, 3},
+	}, {
+		inFilter: "select * from t1 where id not in (1, 3)",
+		outIDs:   []int64{2, 4},
+	}, {
+		inFilter: "select * from t1 where id = 1 or val = 'xyz'",
+		outIDs:   []int64{1, 4},
+	}, {
+		inFilter: "select * from t1 where val like 'a%'",
+		outIDs:   []int64{1, 2},
+	}, {
+		inFilter: "select * from t1 where val not like 'a%'",
+		outIDs:   []int64{4},
+	}, {
+		inFilter: "select * from t1 where val is null",
+		outIDs:   []int64{3},
+	}, {
+		inFilter: "select * from t1 where val is not null and id > 1",
+		outIDs:   []int64{2, 4},
+	}, {
+		// The condition is NULL for id 3, which does not match.
+		inFilter: "select * from t1 where not (id = 1 or val like 'x%')",
+		outIDs:   []int64{2},
+	}, {
+		inFilter: "select * from t1 where 2 < id",
+		outIDs:   []int64{3, 4},
+	}, {
+		inFilter: "select * from t1 where in_keyrange(id, 'hash', '-80') and id in (1, 2, 3, 4)",
+		outIDs:   []int64{1, 2, 3},
+	}, {
+		// Like in MySQL, any result that is neither NULL nor zero matches.
+		inFilter: "select * from t1 where id - 1",
+		outIDs:   []int64{2, 3, 4},
+	}, {
+		inFilter: "select * from t1 where id - 2.0",
+		outIDs:   []int64{1, 3, 4},
+	}, {
+		inFilter: "select * from t1 where id / 4 - 0.5e0",
+		outIDs:   []int64{1, 3, 4},
+	}, {
+		inFilter: "select * from t1 where val",
+		outIDs:   nil,
+	}, {
+		inFilter: "select * from t1 where '2.5'",
+		outIDs:   []int64{1, 2, 3, 4},
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.inFilter, func(t *testing.T) {
+			plan, err := buildPlan(t1, testLocalVSchema, &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: tcase.inFilter}},
+			})
+			require.NoError(t, err)
+			require.NotNil(t, plan)
+
+			var ids []int64
+			charsets := []collations.ID{collations.Unknown, collations.CollationBinaryID}
+			for _, row := range rows {
+				result := make([]sqltypes.Value, len(plan.ColExprs))
+				ok, err := plan.filter(row, result, charsets)
+				require.NoError(t, err)
+				if ok {
+					id, err := result[0].ToInt64()
+					require.NoError(t, err)
+					ids = append(ids, id)
+				}
+			}
+			assert.Equal(t, tcase.outIDs, ids)
+		})
+	}
+}
+
 func TestCompare(t *testing.T) {
 	type testcase struct {
 		opcode                   Opcode
@@ -698,3 +839,66 @@ func TestCompare(t *testing.T) {
 		})
 	}
 }
+
+func TestPlanBuilderColumnExpression(t *testing.T) {
+	t1 := &Table{
+		Name: "t1",
+		Fields: []*querypb.Field{{
+			Name: "id",
+			Type: sqltypes.Int64,
+		}, {
+			Name: "email",
+			Type: sqltypes.VarChar,
+		}, {
+			Name: "created",
+			Type: sqltypes.Datetime,
+		}},
+	}
+	plan, err := buildPlan(t1, testLocalVSchema, &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match: "t1",
+			Filter: "select id, sha2(email, 256) as email, concat(id, '@example.com') as contact, " +
+				"case when id > 1 then 'high' else 'low' end as tier, date(created) as created, null as phone, '***' as secret " +
+				"from t1",
+		}},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, plan)
+
+	var names []string
+	var types []querypb.Type
+	for _, field := range plan.fields() {
+		names = append(names, field.Name)
+		types = append(types, field.Type)
+	}
+	assert.Equal(t, []string{"id", "email", "contact", "tier", "created", "phone", "secret"}, names)
+	assert.Equal(t, []querypb.Type{
+		sqltypes.Int64, sqltypes.VarChar, sqltypes.VarChar, sqltypes.VarChar, sqltypes.Date, sqltypes.Null, sqltypes.VarChar,
+	}, types)
+
+	row := []sqltypes.Value{
+		sqltypes.NewInt64(2),
+		sqltypes.NewVarChar("abc"),
+		sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2022-03-04 10:11:12")),
+	}
+	result := make([]sqltypes.Value, len(plan.ColExprs))
+	charsets := []collations.ID{collations.CollationBinaryID, collations.CollationUtf8mb4ID, collations.CollationBinaryID}
+	ok, err := plan.filter(row, result, charsets)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	var values []string
+	for _, val := range result {
+		values = append(values, val.ToString())
+	}
+	assert.Equal(t, []string{
+		"2",
+		"ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad",
+		"2@example.com",
+		"high",
+		"2022-03-04",
+		"",
+		"***",
+	}, values)
+	assert.True(t, result[5].IsNull())
+}
@@ -103,16 +103,21 @@ type streamerPlan struct {
 // startPos: a flavor compliant position to stream from. This can also contain the special
 //   value "current", which means start from the current position.
 // filter: the list of filtering rules. If a rule has a select expression for its filter,
-//   the select list can only reference direct columns. No other expressions are allowed.
+//   the select list can reference direct columns, or contain any expression on the columns
+//   of the row that the evalengine can evaluate, which must then have an alias.
 //   The select expression is allowed to contain the special 'keyspace_id()' function which
 //   will return the keyspace id of the row. Examples:
 //   "select * from t", same as an empty Filter,
 //   "select * from t where in_keyrange('-80')", same as "-80",
 //   "select * from t where in_keyrange(col1, 'hash', '-80')",
 //   "select col1, col2 from t where...",
-//   "select col1, keyspace_id() from t where...".
-//   Only "in_keyrange" and limited comparison operators (see enum Opcode in planbuilder.go) are supported in the where clause.
-//   Other constructs like joins, group by, etc. are not supported.
+//   "select col1, keyspace_id() from t where...",
+//   "select col1, sha2(col2, 256) as col2, null as col3 from t where...".
+//   "select * from t where col1 in (1, 2) and (col2 like 'a%' or col3 is null)".
+//   The where clause can contain "in_keyrange" and any condition on the columns of the row
+//   that the evalengine can evaluate, like OR, IN, LIKE or IS NULL. Subqueries, bind variables
+//   and aggregates are not supported. Other constructs like joins, group by, etc. are not supported.
+//   A rule with the special "exclude" filter excludes the tables it matches.
 // vschema: the current vschema. This value can later be changed through the SetVSchema method.
 // send: callback function to send events.
 func newVStreamer(ctx context.Context, cp dbconfigs.Connector, se *schema.Engine, startPos string, stopPos string, filter *binlogdatapb.Filter, vschema *localVSchema, send func([]*binlogdatapb.VEvent) error, phase string, vse *Engine) *vstreamer {
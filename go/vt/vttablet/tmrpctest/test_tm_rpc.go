@@ -905,6 +905,45 @@ func tmRPCTestVReplicationWaitForPosPanic(ctx context.Context, t *testing.T, cli
 	expectHandleRPCPanic(t, "VReplicationWaitForPos", true /*verbose*/, err)
 }
 
+var (
+	testVDiffRequest = &tabletmanagerdatapb.VDiffRequest{
+		Keyspace:  "ks",
+		Workflow:  "wf",
+		Action:    "show",
+		VdiffUuid: "uuid",
+	}
+	testVDiffResponse = &tabletmanagerdatapb.VDiffResponse{
+		Vdiffs: []*tabletmanagerdatapb.VDiffStatus{{
+			VdiffUuid: "uuid",
+			Workflow:  "wf",
+			State:     "completed",
+			Tables: []*tabletmanagerdatapb.VDiffTableStatus{{
+				TableName:     "t1",
+				ProcessedRows: 10,
+				MatchingRows:  10,
+			}},
+		}},
+	}
+)
+
+func (fra *fakeRPCTM) VDiff(ctx context.Context, req *tabletmanagerdatapb.VDiffRequest) (*tabletmanagerdatapb.VDiffResponse, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "VDiff request", req, testVDiffRequest)
+	return testVDiffResponse, nil
+}
+
+func tmRPCTestVDiff(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	resp, err := client.VDiff(ctx, tablet, testVDiffRequest)
+	compareError(t, "VDiff", err, resp, testVDiffResponse)
+}
+
+func tmRPCTestVDiffPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.VDiff(ctx, tablet, testVDiffRequest)
+	expectHandleRPCPanic(t, "VDiff", true /*verbose*/, err)
+}
+
 //
 // Reparenting related functions
 //
@@ -1317,6 +1356,7 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	// VReplication methods
 	tmRPCTestVReplicationExec(ctx, t, client, tablet)
 	tmRPCTestVReplicationWaitForPos(ctx, t, client, tablet)
+	tmRPCTestVDiff(ctx, t, client, tablet)
 
 	// Reparenting related functions
 	tmRPCTestResetReplication(ctx, t, client, tablet)
@@ -1368,6 +1408,7 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	// VReplication methods
 	tmRPCTestVReplicationExecPanic(ctx, t, client, tablet)
 	tmRPCTestVReplicationWaitForPosPanic(ctx, t, client, tablet)
+	tmRPCTestVDiffPanic(ctx, t, client, tablet)
 
 	// Reparenting related functions
 	tmRPCTestResetReplicationPanic(ctx, t, client, tablet)